@@ -0,0 +1,215 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// The number of unchanged lines around the changes in a diff.
+const contextLines = 3
+
+// Beyond this number of changed lines, the lines between the first and the
+// last change are all reported as changed, which bounds the space used.
+const maxEdits = 1024
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = string(line)
+	}
+	return result
+}
+
+// Returns the edits which turn a into b, computed with the algorithm of
+// Myers.
+func edits(a, b []string) []editKind {
+	var prefix, suffix []editKind
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		prefix = append(prefix, editEqual)
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		suffix = append(suffix, editEqual)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	var trace [][]int
+	found := false
+	for d := 0; d <= max && d <= maxEdits && !found; d++ {
+		// Only the diagonals from -d-1 to d+1 are used for the edit d.
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	var middle []editKind
+	if !found {
+		for range a {
+			middle = append(middle, editDelete)
+		}
+		for range b {
+			middle = append(middle, editInsert)
+		}
+	} else {
+		// Walk back from the end through the furthest reaching paths.
+		x, y := n, m
+		for d := len(trace) - 1; d >= 0; d-- {
+			v, offset := trace[d], d+1
+			k := x - y
+			var prevK int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				prevK = k + 1
+			} else {
+				prevK = k - 1
+			}
+			prevX := v[offset+prevK]
+			prevY := prevX - prevK
+			for x > prevX && y > prevY {
+				middle = append(middle, editEqual)
+				x, y = x-1, y-1
+			}
+			if d > 0 {
+				if x == prevX {
+					middle = append(middle, editInsert)
+				} else {
+					middle = append(middle, editDelete)
+				}
+			}
+			x, y = prevX, prevY
+		}
+		for i, j := 0, len(middle)-1; i < j; i, j = i+1, j-1 {
+			middle[i], middle[j] = middle[j], middle[i]
+		}
+	}
+	return append(append(prefix, middle...), suffix...)
+}
+
+// Returns the differences between the old and the new content of filename,
+// in the unified format.
+func diff(filename string, old, new []byte) []byte {
+	a, b := splitLines(old), splitLines(new)
+	script := edits(a, b)
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "--- %s\n+++ %s\n", filename, filename)
+	// The positions in a and b at each edit.
+	ai, bi := make([]int, len(script)+1), make([]int, len(script)+1)
+	for i, edit := range script {
+		ai[i+1], bi[i+1] = ai[i], bi[i]
+		if edit != editInsert {
+			ai[i+1]++
+		}
+		if edit != editDelete {
+			bi[i+1]++
+		}
+	}
+	for i := 0; i < len(script); {
+		if script[i] == editEqual {
+			i++
+			continue
+		}
+		// A hunk goes on while the changes are less than twice the context
+		// apart.
+		start := i - contextLines
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(script) && j < end+2*contextLines+1; j++ {
+			if script[j] != editEqual {
+				end = j + 1
+			}
+		}
+		i = end
+		end += contextLines
+		if end > len(script) {
+			end = len(script)
+		}
+		fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(ai[start], ai[end]), hunkRange(bi[start], bi[end]))
+		for j := start; j < end; j++ {
+			var line string
+			switch script[j] {
+			case editEqual:
+				line = " " + a[ai[j]]
+			case editDelete:
+				line = "-" + a[ai[j]]
+			case editInsert:
+				line = "+" + b[bi[j]]
+			}
+			buf.WriteString(line)
+			if line[len(line)-1] != '\n' {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+func hunkRange(start, end int) string {
+	switch end - start {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, end-start)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		old, new string
+		diff     string
+	}{
+		{"a\nb\nc\n", "a\nb\nc\n", "--- f\n+++ f\n"},
+		{"", "a\nb\n", "--- f\n+++ f\n@@ -0,0 +1,2 @@\n+a\n+b\n"},
+		{"a\nb\n", "", "--- f\n+++ f\n@@ -1,2 +0,0 @@\n-a\n-b\n"},
+		{"a\nb\nc", "a\nx\nc", "--- f\n+++ f\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n\\ No newline at end of file\n"},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n",
+			"1\n2\n3\nfour\n5\n6\n7\n8\n9\n10\n11\n12\n14\n15\n16\n",
+			"--- f\n+++ f\n@@ -1,7 +1,7 @@\n 1\n 2\n 3\n-4\n+four\n 5\n 6\n 7\n@@ -10,7 +10,6 @@\n 10\n 11\n 12\n-13\n 14\n 15\n 16\n",
+		},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			"1\n2\nthree\n4\n5\n6\n7\neight\n9\n",
+			"--- f\n+++ f\n@@ -1,9 +1,9 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n 7\n-8\n+eight\n 9\n",
+		},
+	}
+	for _, test := range tests {
+		diff := string(diff("f", []byte(test.old), []byte(test.new)))
+		if diff != test.diff {
+			t.Errorf("diff of %q and %q: expected\n%s\ngot\n%s", test.old, test.new, test.diff, diff)
+		}
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	// Beyond maxEdits, the changes are reported as one block.
+	var old, new []byte
+	for i := 0; i < 2*maxEdits; i++ {
+		old = append(old, "a\n"...)
+		new = append(new, "b\n"...)
+	}
+	script := edits(splitLines(old), splitLines(new))
+	if len(script) != 4*maxEdits {
+		t.Fatalf("expected %d edits, got %d", 4*maxEdits, len(script))
+	}
+	for i, edit := range script {
+		if (i < 2*maxEdits) != (edit == editDelete) {
+			t.Fatalf("edit %d is %v", i, edit)
+		}
+	}
+}
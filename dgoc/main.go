@@ -0,0 +1,226 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+dgoc generates Go code for .proto files like protoc --dgo_out, but without
+protoc. It parses the files itself, or reads their descriptors from a
+FileDescriptorSet, and runs the same generator as protoc-gen-dgo.
+
+Usage:
+
+	dgoc [flags] file.proto...
+
+The files are looked up in the -I paths like protoc does, and are
+generated to the directory given by -out:
+
+	dgoc -I . -I $GOPATH/src -out . file.proto
+
+The flags are:
+
+	-I path
+		Looks up imports in path, which may be a list of paths
+		separated by the OS path list separator. Repeatable; the
+		default is the current directory.
+	-descriptor_set_in file
+		Reads the descriptors of the files and of their imports from
+		file, a FileDescriptorSet like written by protoc
+		--include_imports --include_source_info -o, instead of parsing
+		them. The files are named as in the set.
+	-out dir
+		Writes the generated files to dir.
+	-param params
+		Passes params to the generator, like protoc --dgo_out=params:dir.
+	-layout import|flat
+		Writes the generated files to the paths given by the generator
+		in dir, like protoc, or directly in dir.
+	-dry_run
+		Prints the files which would be written instead of writing them.
+	-diff
+		Prints the differences between the generated files and the
+		files in dir instead of writing them, and exits with status 1
+		if there are any.
+*/
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/dropbox/goprotoc/parser"
+	"github.com/dropbox/goprotoc/proto"
+	"github.com/dropbox/goprotoc/protoc-gen-dgo/command"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+	plugin "github.com/dropbox/goprotoc/protoc-gen-dgo/plugin"
+)
+
+// The values of a repeatable flag.
+type stringList []string
+
+func (this *stringList) String() string {
+	return strings.Join(*this, string(os.PathListSeparator))
+}
+
+func (this *stringList) Set(value string) error {
+	*this = append(*this, filepath.SplitList(value)...)
+	return nil
+}
+
+var (
+	paths           stringList
+	descriptorSetIn = flag.String("descriptor_set_in", "", "read the descriptors from this FileDescriptorSet instead of parsing the files")
+	out             = flag.String("out", ".", "directory to write the generated files to")
+	param           = flag.String("param", "", "parameters of the generator")
+	layout          = flag.String("layout", "import", "layout of the generated files in the output directory: import or flat")
+	dryRun          = flag.Bool("dry_run", false, "print the files which would be written instead of writing them")
+	diffOnly        = flag.Bool("diff", false, "print the differences with the existing files instead of writing them")
+)
+
+func init() {
+	flag.Var(&paths, "I", "path to look up imports in (repeatable)")
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "dgoc:", err)
+	os.Exit(1)
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dgoc [flags] file.proto...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *layout != "import" && *layout != "flat" {
+		fail(fmt.Errorf("unknown layout %q", *layout))
+	}
+
+	req, err := request(flag.Args())
+	if err != nil {
+		if errs, ok := err.(parser.ErrorList); ok {
+			for _, err := range errs {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			os.Exit(1)
+		}
+		fail(err)
+	}
+	resp := command.Generate(req)
+	if resp.Error != nil {
+		// The generator reports one problem per line, like the parser.
+		for _, line := range strings.Split(resp.GetError(), "\n") {
+			fmt.Fprintln(os.Stderr, line)
+		}
+		os.Exit(1)
+	}
+
+	changed := false
+	for _, file := range resp.File {
+		name := file.GetName()
+		if *layout == "flat" {
+			name = path.Base(name)
+		}
+		filename := filepath.Join(*out, filepath.FromSlash(name))
+		content := []byte(file.GetContent())
+		switch {
+		case *dryRun:
+			fmt.Println(filename)
+		case *diffOnly:
+			old, err := ioutil.ReadFile(filename)
+			if err != nil && !os.IsNotExist(err) {
+				fail(err)
+			}
+			if !bytes.Equal(old, content) {
+				changed = true
+				os.Stdout.Write(diff(filename, old, content))
+			}
+		default:
+			if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+				fail(err)
+			}
+			if err := ioutil.WriteFile(filename, content, 0644); err != nil {
+				fail(err)
+			}
+		}
+	}
+	if changed {
+		os.Exit(1)
+	}
+}
+
+// Returns the request to generate files, which are parsed or read from the
+// descriptor set.
+func request(files []string) (*plugin.CodeGeneratorRequest, error) {
+	req := &plugin.CodeGeneratorRequest{}
+	if *param != "" {
+		req.Parameter = param
+	}
+	if *descriptorSetIn == "" {
+		set, err := parser.ParseFiles(files, paths...)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			name, err := parser.RelativeName(file, paths...)
+			if err != nil {
+				return nil, err
+			}
+			req.FileToGenerate = append(req.FileToGenerate, name)
+		}
+		req.ProtoFile = set.File
+		return req, nil
+	}
+
+	data, err := ioutil.ReadFile(*descriptorSetIn)
+	if err != nil {
+		return nil, err
+	}
+	set := &descriptor.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, file := range set.File {
+		names[file.GetName()] = true
+	}
+	for _, file := range files {
+		if !names[file] {
+			return nil, fmt.Errorf("%s: not in %s", file, *descriptorSetIn)
+		}
+		req.FileToGenerate = append(req.FileToGenerate, file)
+	}
+	req.ProtoFile = set.File
+	return req, nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/dropbox/godropbox/errors"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// Unmarshal merges data, in the wire format, into m. Repeated scalar fields
+// are accepted both packed and unpacked. The fields that are not known, or
+// that have an unexpected wire type, are kept and written back by Marshal.
+func (m *Message) Unmarshal(data []byte) error {
+	index := 0
+	for index < len(data) {
+		start := index
+		key, n := proto.DecodeVarint(data[index:])
+		if n == 0 {
+			return io.ErrUnexpectedEOF
+		}
+		index += n
+		number := int32(key >> 3)
+		wire := int(key & 0x7)
+		if wire == proto.WireEndGroup {
+			return errors.Newf("dynamic: unexpected end group of field %d in %s", number, m.typ.name)
+		}
+		f, ok := m.typ.byNumber[number]
+		if ok {
+			next, known, err := m.unmarshalField(f, wire, data, index)
+			if err != nil {
+				return err
+			}
+			if known {
+				index = next
+				continue
+			}
+		}
+		skipped, err := proto.Skip(data[start:])
+		if err != nil {
+			return err
+		}
+		if start+skipped > len(data) {
+			return io.ErrUnexpectedEOF
+		}
+		index = start + skipped
+		m.unknown = append(m.unknown, data[start:index]...)
+	}
+	return nil
+}
+
+// Reads the value of f that follows its key at data[index:]. It returns the
+// index following the value, or false if the wire type is not the one of f.
+func (m *Message) unmarshalField(f *field, wire int, data []byte, index int) (int, bool, error) {
+	if f.IsRepeated() && f.packable() && wire == proto.WireBytes {
+		length, n := proto.DecodeVarint(data[index:])
+		if n == 0 {
+			return 0, false, io.ErrUnexpectedEOF
+		}
+		index += n
+		end := index + int(length)
+		if int(length) < 0 || end > len(data) {
+			return 0, false, io.ErrUnexpectedEOF
+		}
+		for index < end {
+			v, n, err := decodeScalar(f, data[index:end])
+			if err != nil {
+				return 0, false, err
+			}
+			m.append(f, v)
+			index += n
+		}
+		return end, true, nil
+	}
+	if wire != f.WireType() {
+		return 0, false, nil
+	}
+	var v interface{}
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		body, n, err := proto.SkipGroup(data[index:], f.GetNumber())
+		if err != nil {
+			return 0, false, err
+		}
+		if err := m.unmarshalMessage(f, data[index:index+body]); err != nil {
+			return 0, false, err
+		}
+		return index + n, true, nil
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES:
+		length, n := proto.DecodeVarint(data[index:])
+		if n == 0 {
+			return 0, false, io.ErrUnexpectedEOF
+		}
+		index += n
+		end := index + int(length)
+		if int(length) < 0 || end > len(data) {
+			return 0, false, io.ErrUnexpectedEOF
+		}
+		switch f.GetType() {
+		case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+			if err := m.unmarshalMessage(f, data[index:end]); err != nil {
+				return 0, false, err
+			}
+			return end, true, nil
+		case descriptor.FieldDescriptorProto_TYPE_STRING:
+			v = string(data[index:end])
+		default:
+			v = append([]byte{}, data[index:end]...)
+		}
+		index = end
+	default:
+		var n int
+		var err error
+		v, n, err = decodeScalar(f, data[index:])
+		if err != nil {
+			return 0, false, err
+		}
+		index += n
+	}
+	if f.IsRepeated() {
+		m.append(f, v)
+	} else {
+		m.setSingular(f, v)
+	}
+	return index, true, nil
+}
+
+// Unmarshals data into a new element of the repeated message field f, or
+// merges it into the value of the singular message field f.
+func (m *Message) unmarshalMessage(f *field, data []byte) error {
+	var v *Message
+	if f.IsRepeated() {
+		v = f.message.New()
+		m.append(f, v)
+	} else {
+		v = m.mutate(f)
+	}
+	return v.Unmarshal(data)
+}
+
+// Returns true if a repeated f can be packed.
+func (f *field) packable() bool {
+	return packable(f.FieldDescriptorProto)
+}
+
+// Reads the value of a scalar field at the start of data, and returns it
+// with the number of bytes read.
+func decodeScalar(f *field, data []byte) (interface{}, int, error) {
+	switch f.WireType() {
+	case proto.WireFixed64:
+		if len(data) < 8 {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		x := binary.LittleEndian.Uint64(data)
+		switch f.GetType() {
+		case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+			return math.Float64frombits(x), 8, nil
+		case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+			return int64(x), 8, nil
+		}
+		return x, 8, nil
+	case proto.WireFixed32:
+		if len(data) < 4 {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		x := binary.LittleEndian.Uint32(data)
+		switch f.GetType() {
+		case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+			return math.Float32frombits(x), 4, nil
+		case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+			return int32(x), 4, nil
+		}
+		return x, 4, nil
+	}
+	x, n := proto.DecodeVarint(data)
+	if n == 0 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		return int64(x), n, nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		return x, n, nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return int32(x), n, nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32:
+		return uint32(x), n, nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return int64(x>>1) ^ -int64(x&1), n, nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		return int32(uint32(x)>>1) ^ -int32(x&1), n, nil
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return x != 0, n, nil
+	}
+	return nil, 0, errors.Newf("dynamic: field %s has no scalar type", f.name)
+}
@@ -0,0 +1,418 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package dynamic provides messages whose type is only known at runtime, from
+its descriptor, for tools such as proxies and log inspectors that handle
+messages of arbitrary schemas.
+
+The descriptors usually come as a FileDescriptorSet, from parser.ParseFile or
+from the Description method generated by the description plugin:
+
+	types, err := dynamic.NewTypes(set)
+	if err != nil {
+		return err
+	}
+	m, err := types.New("my.package.Message")
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return err
+	}
+	name := m.Get("name").(string)
+
+A Message implements proto.Message and can be marshaled and unmarshaled with
+the proto package, both in the binary and in the text format. Its fields are
+accessed by name or by number, with values of the following Go types:
+
+	double                      float64
+	float                       float32
+	int32, sint32, sfixed32     int32
+	int64, sint64, sfixed64     int64
+	uint32, fixed32             uint32
+	uint64, fixed64             uint64
+	bool                        bool
+	string                      string
+	bytes                       []byte
+	enum                        int32
+	message, group              *Message
+
+The value of a repeated field is a []interface{} of such values. A map field
+is a repeated field of its entry messages, which hold a key and a value field,
+as it is on the wire.
+
+The extensions declared in the FileDescriptorSet are fields of the messages
+they extend, named by their full name, such as "my.package.extension".
+*/
+package dynamic
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/godropbox/errors"
+
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// Types holds the message types of a set of files.
+type Types struct {
+	messages   map[string]*Type
+	enums      map[string]*descriptor.EnumDescriptorProto
+	extensions []*extension
+}
+
+type extension struct {
+	name  string
+	field *descriptor.FieldDescriptorProto
+}
+
+// Type is a message type.
+type Type struct {
+	types    *Types
+	name     string
+	desc     *descriptor.DescriptorProto
+	proto3   bool
+	fields   []*field // Sorted by number.
+	byNumber map[int32]*field
+	byName   map[string]*field
+	byText   map[string]*field
+}
+
+// field is a field of a message type, or an extension of it.
+type field struct {
+	*descriptor.FieldDescriptorProto
+	// The name of the field, or the full name of the extension.
+	name string
+	// The name of the field in text format.
+	textName   string
+	extension  bool
+	packed     bool
+	message    *Type
+	enumNames  map[int32]string
+	enumValues map[string]int32
+	firstEnum  int32
+	// The value of the field when it is not set.
+	def interface{}
+}
+
+// NewTypes returns the types of the messages of the files in set. The types
+// referred to by the fields of the messages must be in set as well.
+func NewTypes(set *descriptor.FileDescriptorSet) (*Types, error) {
+	ts := newTypes()
+	for _, file := range set.GetFile() {
+		proto3 := file.GetSyntax() == "proto3"
+		pkg := file.GetPackage()
+		for _, desc := range file.GetMessageType() {
+			ts.addMessage(pkg, desc, proto3)
+		}
+		for _, enum := range file.GetEnumType() {
+			ts.enums[join(pkg, enum.GetName())] = enum
+		}
+		for _, ext := range file.GetExtension() {
+			ts.extensions = append(ts.extensions, &extension{join(pkg, ext.GetName()), ext})
+		}
+	}
+	if err := ts.resolve(); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// NewMessage returns an empty message of the type described by desc. The
+// types referred to by the fields of the message must be desc or nested in
+// it.
+func NewMessage(desc *descriptor.DescriptorProto) (*Message, error) {
+	ts := newTypes()
+	ts.addMessage("", desc, false)
+	if err := ts.resolve(); err != nil {
+		return nil, err
+	}
+	return ts.messages[desc.GetName()].New(), nil
+}
+
+// Type returns the message type with the given full name, such as
+// "my.package.Message", or nil if there is none.
+func (ts *Types) Type(name string) *Type {
+	return ts.messages[strings.TrimPrefix(name, ".")]
+}
+
+// New returns an empty message of the type with the given full name.
+func (ts *Types) New(name string) (*Message, error) {
+	t := ts.Type(name)
+	if t == nil {
+		return nil, errors.Newf("dynamic: unknown message type %s", name)
+	}
+	return t.New(), nil
+}
+
+// Name returns the full name of the type.
+func (t *Type) Name() string {
+	return t.name
+}
+
+// Descriptor returns the descriptor of the type.
+func (t *Type) Descriptor() *descriptor.DescriptorProto {
+	return t.desc
+}
+
+// New returns an empty message of the type.
+func (t *Type) New() *Message {
+	return &Message{typ: t}
+}
+
+func newTypes() *Types {
+	return &Types{
+		messages: make(map[string]*Type),
+		enums:    make(map[string]*descriptor.EnumDescriptorProto),
+	}
+}
+
+func join(scope string, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+func (ts *Types) addMessage(scope string, desc *descriptor.DescriptorProto, proto3 bool) {
+	name := join(scope, desc.GetName())
+	ts.messages[name] = &Type{
+		types:    ts,
+		name:     name,
+		desc:     desc,
+		proto3:   proto3,
+		byNumber: make(map[int32]*field),
+		byName:   make(map[string]*field),
+		byText:   make(map[string]*field),
+	}
+	for _, nested := range desc.GetNestedType() {
+		ts.addMessage(name, nested, proto3)
+	}
+	for _, enum := range desc.GetEnumType() {
+		ts.enums[join(name, enum.GetName())] = enum
+	}
+	for _, ext := range desc.GetExtension() {
+		ts.extensions = append(ts.extensions, &extension{join(name, ext.GetName()), ext})
+	}
+}
+
+// Returns the name in ts of the type named typeName in a descriptor. Type
+// names are fully qualified, but the package is not known for a lone
+// message, so the shortest suffix of the name that is known is used.
+func (ts *Types) lookup(typeName string) string {
+	name := strings.TrimPrefix(typeName, ".")
+	for {
+		if _, ok := ts.messages[name]; ok {
+			return name
+		}
+		if _, ok := ts.enums[name]; ok {
+			return name
+		}
+		i := strings.Index(name, ".")
+		if i < 0 {
+			return ""
+		}
+		name = name[i+1:]
+	}
+}
+
+// Resolves the types of the fields of all the messages and adds the
+// extensions to the messages they extend.
+func (ts *Types) resolve() error {
+	for _, t := range ts.messages {
+		for _, desc := range t.desc.GetField() {
+			if err := t.addField(desc, desc.GetName(), false); err != nil {
+				return err
+			}
+		}
+	}
+	for _, ext := range ts.extensions {
+		t := ts.messages[ts.lookup(ext.field.GetExtendee())]
+		if t == nil {
+			return errors.Newf("dynamic: unknown type %s extended by %s", ext.field.GetExtendee(), ext.name)
+		}
+		if err := t.addField(ext.field, ext.name, true); err != nil {
+			return err
+		}
+	}
+	for _, t := range ts.messages {
+		sort.Sort(byNumber(t.fields))
+	}
+	return nil
+}
+
+type byNumber []*field
+
+func (s byNumber) Len() int           { return len(s) }
+func (s byNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byNumber) Less(i, j int) bool { return s[i].GetNumber() < s[j].GetNumber() }
+
+func (t *Type) addField(desc *descriptor.FieldDescriptorProto, name string, ext bool) error {
+	f := &field{
+		FieldDescriptorProto: desc,
+		name:                 name,
+		textName:             desc.GetName(),
+		extension:            ext,
+	}
+	if ext {
+		f.textName = "[" + name + "]"
+	}
+	switch desc.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		f.message = t.types.messages[t.types.lookup(desc.GetTypeName())]
+		if f.message == nil {
+			return errors.Newf("dynamic: unknown type %s of field %s of %s", desc.GetTypeName(), name, t.name)
+		}
+		if desc.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP && !ext {
+			f.textName = f.message.desc.GetName()
+		}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		enum := t.types.enums[t.types.lookup(desc.GetTypeName())]
+		if enum == nil {
+			return errors.Newf("dynamic: unknown type %s of field %s of %s", desc.GetTypeName(), name, t.name)
+		}
+		f.enumNames = make(map[int32]string)
+		f.enumValues = make(map[string]int32)
+		if len(enum.GetValue()) > 0 {
+			f.firstEnum = enum.GetValue()[0].GetNumber()
+		}
+		for _, v := range enum.GetValue() {
+			if _, ok := f.enumNames[v.GetNumber()]; !ok {
+				f.enumNames[v.GetNumber()] = v.GetName()
+			}
+			f.enumValues[v.GetName()] = v.GetNumber()
+		}
+	}
+	// Repeated scalars are packed by default in proto3.
+	explicit := desc.GetOptions() != nil && desc.GetOptions().Packed != nil
+	f.packed = desc.IsRepeated() && packable(desc) && (desc.IsPacked() || (t.proto3 && !explicit))
+	def, err := f.defaultValue()
+	if err != nil {
+		return errors.Newf("dynamic: invalid default value of field %s of %s: %v", name, t.name, err)
+	}
+	f.def = def
+	if _, ok := t.byNumber[desc.GetNumber()]; ok {
+		return errors.Newf("dynamic: duplicate field number %d in %s", desc.GetNumber(), t.name)
+	}
+	t.fields = append(t.fields, f)
+	t.byNumber[desc.GetNumber()] = f
+	t.byName[name] = f
+	t.byText[f.textName] = f
+	return nil
+}
+
+// Returns true if a repeated field of the type of desc can be packed.
+func packable(desc *descriptor.FieldDescriptorProto) bool {
+	switch desc.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return false
+	}
+	return true
+}
+
+// Returns the value of the field when it is not set.
+func (f *field) defaultValue() (interface{}, error) {
+	s := f.GetDefaultValue()
+	hasDefault := f.DefaultValue != nil
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		if !hasDefault {
+			return float64(0), nil
+		}
+		return strconv.ParseFloat(s, 64)
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		if !hasDefault {
+			return float32(0), nil
+		}
+		v, err := strconv.ParseFloat(s, 32)
+		return float32(v), err
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		if !hasDefault {
+			return int64(0), nil
+		}
+		return strconv.ParseInt(s, 0, 64)
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		if !hasDefault {
+			return uint64(0), nil
+		}
+		return strconv.ParseUint(s, 0, 64)
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		if !hasDefault {
+			return int32(0), nil
+		}
+		v, err := strconv.ParseInt(s, 0, 32)
+		return int32(v), err
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		if !hasDefault {
+			return uint32(0), nil
+		}
+		v, err := strconv.ParseUint(s, 0, 32)
+		return uint32(v), err
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if !hasDefault {
+			return false, nil
+		}
+		return strconv.ParseBool(s)
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return s, nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		if !hasDefault {
+			return []byte(nil), nil
+		}
+		// The default value of bytes is escaped like a C string.
+		v, err := strconv.Unquote(`"` + s + `"`)
+		if err != nil {
+			return []byte(s), nil
+		}
+		return []byte(v), nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if hasDefault {
+			v, ok := f.enumValues[s]
+			if !ok {
+				return nil, errors.Newf("unknown enum value %s", s)
+			}
+			return v, nil
+		}
+		// The default value of an enum is its first value.
+		return f.firstEnum, nil
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return (*Message)(nil), nil
+	}
+	return nil, errors.Newf("unknown type %v", f.GetType())
+}
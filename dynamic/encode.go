@@ -0,0 +1,142 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"math"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// Marshal returns m in the wire format. The fields are written in the order
+// of their numbers, followed by the unrecognized fields.
+func (m *Message) Marshal() ([]byte, error) {
+	b := proto.NewBuffer(nil)
+	m.marshal(b)
+	if b.Bytes() == nil {
+		return []byte{}, nil
+	}
+	return b.Bytes(), nil
+}
+
+// Size returns the size of m in the wire format.
+func (m *Message) Size() int {
+	b := proto.NewBuffer(nil)
+	m.marshal(b)
+	return len(b.Bytes())
+}
+
+func (m *Message) marshal(b *proto.Buffer) {
+	for _, f := range m.typ.fields {
+		v, ok := m.values[f.GetNumber()]
+		if !ok {
+			continue
+		}
+		if !f.IsRepeated() {
+			marshalValue(b, f, v)
+			continue
+		}
+		list := v.([]interface{})
+		if !f.packed {
+			for _, v := range list {
+				marshalValue(b, f, v)
+			}
+			continue
+		}
+		packed := proto.NewBuffer(nil)
+		for _, v := range list {
+			encodeScalar(packed, f, v)
+		}
+		b.EncodeVarint(uint64(f.GetNumber())<<3 | proto.WireBytes)
+		b.EncodeRawBytes(packed.Bytes())
+	}
+	b.SetBuf(append(b.Bytes(), m.unknown...))
+}
+
+func marshalValue(b *proto.Buffer, f *field, v interface{}) {
+	key := uint64(f.GetNumber()) << 3
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		b.EncodeVarint(key | proto.WireStartGroup)
+		v.(*Message).marshal(b)
+		b.EncodeVarint(key | proto.WireEndGroup)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		nested := proto.NewBuffer(nil)
+		v.(*Message).marshal(nested)
+		b.EncodeVarint(key | proto.WireBytes)
+		b.EncodeRawBytes(nested.Bytes())
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		b.EncodeVarint(key | proto.WireBytes)
+		b.EncodeStringBytes(v.(string))
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		b.EncodeVarint(key | proto.WireBytes)
+		b.EncodeRawBytes(v.([]byte))
+	default:
+		b.EncodeVarint(key | uint64(f.WireType()))
+		encodeScalar(b, f, v)
+	}
+}
+
+// Writes the value of a scalar field without its key.
+func encodeScalar(b *proto.Buffer, f *field, v interface{}) {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		b.EncodeFixed64(math.Float64bits(v.(float64)))
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		b.EncodeFixed32(uint64(math.Float32bits(v.(float32))))
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		b.EncodeVarint(uint64(v.(int64)))
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		b.EncodeVarint(v.(uint64))
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		// Written in 32 bits, like the generated marshalers do.
+		b.EncodeVarint(uint64(uint32(v.(int32))))
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		b.EncodeVarint(uint64(v.(int32)))
+	case descriptor.FieldDescriptorProto_TYPE_UINT32:
+		b.EncodeVarint(uint64(v.(uint32)))
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		b.EncodeFixed64(v.(uint64))
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		b.EncodeFixed64(uint64(v.(int64)))
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		b.EncodeFixed32(uint64(v.(uint32)))
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		b.EncodeFixed32(uint64(uint32(v.(int32))))
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		b.EncodeZigzag64(uint64(v.(int64)))
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		b.EncodeZigzag32(uint64(v.(int32)))
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if v.(bool) {
+			b.EncodeVarint(1)
+		} else {
+			b.EncodeVarint(0)
+		}
+	}
+}
@@ -0,0 +1,455 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"bytes"
+
+	"github.com/dropbox/godropbox/errors"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// Message is a message of a Type. The zero value is not usable; messages are
+// created by the New methods of Type and Types, or by NewMessage.
+type Message struct {
+	typ *Type
+	// The values of the fields that are set, by number. The value of a
+	// repeated field is a non-empty []interface{}.
+	values map[int32]interface{}
+	// The fields that were not recognized when unmarshaling.
+	unknown []byte
+}
+
+// Type returns the type of m.
+func (m *Message) Type() *Type {
+	return m.typ
+}
+
+func (m *Message) Reset() {
+	m.values = nil
+	m.unknown = nil
+}
+
+func (m *Message) String() string {
+	return proto.CompactTextString(m)
+}
+
+func (*Message) ProtoMessage() {}
+
+// Unknown returns the fields that were not recognized when unmarshaling m,
+// in the wire format.
+func (m *Message) Unknown() []byte {
+	return m.unknown
+}
+
+func (m *Message) fieldByName(name string) (*field, error) {
+	f, ok := m.typ.byName[name]
+	if !ok {
+		return nil, errors.Newf("dynamic: %s has no field %s", m.typ.name, name)
+	}
+	return f, nil
+}
+
+func (m *Message) fieldByNumber(number int32) (*field, error) {
+	f, ok := m.typ.byNumber[number]
+	if !ok {
+		return nil, errors.Newf("dynamic: %s has no field %d", m.typ.name, number)
+	}
+	return f, nil
+}
+
+// Get returns the value of the field with the given name, or its default
+// value if it is not set. It returns nil if there is no such field.
+func (m *Message) Get(name string) interface{} {
+	f, err := m.fieldByName(name)
+	if err != nil {
+		return nil
+	}
+	return m.get(f)
+}
+
+// GetByNumber returns the value of the field with the given number, or its
+// default value if it is not set. It returns nil if there is no such field.
+func (m *Message) GetByNumber(number int32) interface{} {
+	f, err := m.fieldByNumber(number)
+	if err != nil {
+		return nil
+	}
+	return m.get(f)
+}
+
+func (m *Message) get(f *field) interface{} {
+	v, ok := m.values[f.GetNumber()]
+	if f.IsRepeated() {
+		if !ok {
+			return []interface{}(nil)
+		}
+		// Copied, so that the elements cannot be replaced by values of
+		// the wrong type.
+		return append([]interface{}(nil), v.([]interface{})...)
+	}
+	if !ok {
+		return f.def
+	}
+	return v
+}
+
+// Has returns true if the field with the given name is set, or, if it is
+// repeated, is not empty.
+func (m *Message) Has(name string) bool {
+	f, err := m.fieldByName(name)
+	if err != nil {
+		return false
+	}
+	_, ok := m.values[f.GetNumber()]
+	return ok
+}
+
+// HasByNumber is like Has, for the field with the given number.
+func (m *Message) HasByNumber(number int32) bool {
+	_, ok := m.values[number]
+	return ok
+}
+
+// Clear clears the field with the given name.
+func (m *Message) Clear(name string) error {
+	f, err := m.fieldByName(name)
+	if err != nil {
+		return err
+	}
+	delete(m.values, f.GetNumber())
+	return nil
+}
+
+// ClearByNumber clears the field with the given number.
+func (m *Message) ClearByNumber(number int32) error {
+	f, err := m.fieldByNumber(number)
+	if err != nil {
+		return err
+	}
+	delete(m.values, f.GetNumber())
+	return nil
+}
+
+// Set sets the field with the given name. The value of a repeated field is
+// a []interface{} of its elements.
+func (m *Message) Set(name string, value interface{}) error {
+	f, err := m.fieldByName(name)
+	if err != nil {
+		return err
+	}
+	return m.set(f, value)
+}
+
+// SetByNumber sets the field with the given number. The value of a repeated
+// field is a []interface{} of its elements.
+func (m *Message) SetByNumber(number int32, value interface{}) error {
+	f, err := m.fieldByNumber(number)
+	if err != nil {
+		return err
+	}
+	return m.set(f, value)
+}
+
+func (m *Message) set(f *field, value interface{}) error {
+	if f.IsRepeated() {
+		list, ok := value.([]interface{})
+		if !ok {
+			return errors.Newf("dynamic: repeated field %s of %s takes a []interface{}, not %T", f.name, m.typ.name, value)
+		}
+		for _, v := range list {
+			if err := m.check(f, v); err != nil {
+				return err
+			}
+		}
+		if len(list) == 0 {
+			delete(m.values, f.GetNumber())
+		} else {
+			m.store(f, append([]interface{}(nil), list...))
+		}
+		return nil
+	}
+	if err := m.check(f, value); err != nil {
+		return err
+	}
+	m.setSingular(f, value)
+	return nil
+}
+
+func (m *Message) setSingular(f *field, value interface{}) {
+	if m.typ.proto3 && !f.IsOneof() && f.message == nil && isZero(value) {
+		// Fields without presence are not set by their zero value.
+		delete(m.values, f.GetNumber())
+		return
+	}
+	m.store(f, value)
+}
+
+// Stores the value of f, clearing the other fields of its oneof.
+func (m *Message) store(f *field, value interface{}) {
+	if m.values == nil {
+		m.values = make(map[int32]interface{})
+	}
+	if f.IsOneof() && !f.extension {
+		for _, other := range m.typ.fields {
+			if other.IsOneof() && !other.extension && other.GetOneofIndex() == f.GetOneofIndex() {
+				delete(m.values, other.GetNumber())
+			}
+		}
+	}
+	m.values[f.GetNumber()] = value
+}
+
+// Add appends value to the repeated field with the given name.
+func (m *Message) Add(name string, value interface{}) error {
+	f, err := m.fieldByName(name)
+	if err != nil {
+		return err
+	}
+	return m.add(f, value)
+}
+
+// AddByNumber appends value to the repeated field with the given number.
+func (m *Message) AddByNumber(number int32, value interface{}) error {
+	f, err := m.fieldByNumber(number)
+	if err != nil {
+		return err
+	}
+	return m.add(f, value)
+}
+
+func (m *Message) add(f *field, value interface{}) error {
+	if !f.IsRepeated() {
+		return errors.Newf("dynamic: field %s of %s is not repeated", f.name, m.typ.name)
+	}
+	if err := m.check(f, value); err != nil {
+		return err
+	}
+	m.append(f, value)
+	return nil
+}
+
+func (m *Message) append(f *field, value interface{}) {
+	list, _ := m.values[f.GetNumber()].([]interface{})
+	m.store(f, append(list, value))
+}
+
+// Mutate returns the message held by the singular message field with the
+// given name, setting the field to an empty message if it is not set.
+func (m *Message) Mutate(name string) (*Message, error) {
+	f, err := m.fieldByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if f.message == nil || f.IsRepeated() {
+		return nil, errors.Newf("dynamic: field %s of %s is not a singular message", name, m.typ.name)
+	}
+	return m.mutate(f), nil
+}
+
+func (m *Message) mutate(f *field) *Message {
+	if v, ok := m.values[f.GetNumber()]; ok {
+		return v.(*Message)
+	}
+	v := f.message.New()
+	m.store(f, v)
+	return v
+}
+
+// AddMessage appends an empty message to the repeated message field with the
+// given name and returns it.
+func (m *Message) AddMessage(name string) (*Message, error) {
+	f, err := m.fieldByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if f.message == nil || !f.IsRepeated() {
+		return nil, errors.Newf("dynamic: field %s of %s is not a repeated message", name, m.typ.name)
+	}
+	v := f.message.New()
+	m.append(f, v)
+	return v, nil
+}
+
+// Checks that value has the Go type of the values of f.
+func (m *Message) check(f *field, value interface{}) error {
+	ok := false
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		_, ok = value.(float64)
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		_, ok = value.(float32)
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		_, ok = value.(int64)
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		_, ok = value.(uint64)
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_ENUM:
+		_, ok = value.(int32)
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		_, ok = value.(uint32)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		_, ok = value.(bool)
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		_, ok = value.(string)
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		_, ok = value.([]byte)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		var v *Message
+		v, ok = value.(*Message)
+		if ok && (v == nil || v.typ != f.message) {
+			if v == nil {
+				return errors.Newf("dynamic: field %s of %s cannot hold a nil message", f.name, m.typ.name)
+			}
+			return errors.Newf("dynamic: field %s of %s takes a %s, not a %s", f.name, m.typ.name, f.message.name, v.typ.name)
+		}
+	}
+	if !ok {
+		return errors.Newf("dynamic: field %s of %s takes a %T, not a %T", f.name, m.typ.name, f.def, value)
+	}
+	return nil
+}
+
+// Returns true if value is the zero value of its type.
+func isZero(value interface{}) bool {
+	switch v := value.(type) {
+	case float64:
+		return v == 0
+	case float32:
+		return v == 0
+	case int64:
+		return v == 0
+	case uint64:
+		return v == 0
+	case int32:
+		return v == 0
+	case uint32:
+		return v == 0
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case []byte:
+		return len(v) == 0
+	}
+	return false
+}
+
+// Clone returns a deep copy of m.
+func (m *Message) Clone() proto.Message {
+	c := m.typ.New()
+	c.MergeFrom(m)
+	return c
+}
+
+// MergeFrom merges src, which must be a *Message of the same type, into m.
+// Singular fields that are set in src replace those of m, except messages,
+// which are merged, and repeated fields are appended.
+func (m *Message) MergeFrom(src proto.Message) {
+	s, ok := src.(*Message)
+	if !ok || (s != nil && s.typ != m.typ) {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for _, f := range m.typ.fields {
+		v, ok := s.values[f.GetNumber()]
+		if !ok {
+			continue
+		}
+		if f.IsRepeated() {
+			for _, v := range v.([]interface{}) {
+				m.append(f, cloneValue(v))
+			}
+		} else if f.message != nil {
+			m.mutate(f).MergeFrom(v.(*Message))
+		} else {
+			m.store(f, cloneValue(v))
+		}
+	}
+	m.unknown = append(m.unknown, s.unknown...)
+}
+
+func cloneValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []byte:
+		return append([]byte{}, v...)
+	case *Message:
+		return v.Clone()
+	}
+	return v
+}
+
+// Equal returns true if that is a *Message of the same type as m, with the
+// same fields set to the same values.
+func (m *Message) Equal(that interface{}) bool {
+	o, ok := that.(*Message)
+	if !ok || m == nil || o == nil {
+		return ok && m == o
+	}
+	if m.typ != o.typ || len(m.values) != len(o.values) {
+		return false
+	}
+	for number, v := range m.values {
+		ov, ok := o.values[number]
+		if !ok || !equalValues(v, ov) {
+			return false
+		}
+	}
+	return bytes.Equal(m.unknown, o.unknown)
+}
+
+func equalValues(a, b interface{}) bool {
+	switch a := a.(type) {
+	case []interface{}:
+		b := b.([]interface{})
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equalValues(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case []byte:
+		return bytes.Equal(a, b.([]byte))
+	case *Message:
+		return a.Equal(b)
+	}
+	return a == b
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// MarshalTextFields writes the fields of m in text format. It is called by
+// proto.MarshalText and its variants.
+func (m *Message) MarshalTextFields(w *proto.TextWriter) {
+	for _, f := range m.typ.fields {
+		v, ok := m.values[f.GetNumber()]
+		if !ok {
+			continue
+		}
+		if !f.IsRepeated() {
+			writeValue(w, f, v)
+			continue
+		}
+		for _, v := range v.([]interface{}) {
+			writeValue(w, f, v)
+		}
+	}
+	w.Unknown(m.unknown)
+}
+
+func writeValue(w *proto.TextWriter, f *field, v interface{}) {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		w.GroupField(f.textName)
+		w.Group(v.(*Message))
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		w.Field(f.textName)
+		w.Message(v.(*Message))
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		w.Field(f.textName)
+		w.Enum(v.(int32), f.enumNames)
+	default:
+		w.Field(f.textName)
+		w.Value(v)
+	}
+}
+
+// UnmarshalTextField reads the value of the field with the given name in text
+// format. It is called by proto.UnmarshalText for each field.
+func (m *Message) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	f, ok := m.typ.byText[name]
+	if !ok {
+		return false, nil
+	}
+	var v interface{}
+	var err error
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		if f.IsRepeated() {
+			nested := f.message.New()
+			m.append(f, nested)
+			return true, p.ReadMessage(nested)
+		}
+		return true, p.ReadMessage(m.mutate(f))
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		v, err = p.ReadEnum(f.enumValues)
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		v, err = p.ReadFloat64()
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		v, err = p.ReadFloat32()
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		v, err = p.ReadInt64()
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		v, err = p.ReadUint64()
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		v, err = p.ReadInt32()
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		v, err = p.ReadUint32()
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		v, err = p.ReadBool()
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		v, err = p.ReadString()
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		v, err = p.ReadBytes()
+	}
+	if err != nil {
+		return true, err
+	}
+	if f.IsRepeated() {
+		m.append(f, v)
+	} else {
+		m.setSingular(f, v)
+	}
+	return true, nil
+}
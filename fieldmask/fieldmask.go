@@ -0,0 +1,255 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package fieldmask implements the semantics of google.protobuf.FieldMask for
+the messages generated by protoc-gen-dgo, to make partial updates such as
+"update only a.b.c and d".
+
+A mask is a set of dotted field paths, validated against a message type:
+
+	mask, err := fieldmask.New(&User{}, "profile.name", "email")
+	if err != nil {
+		return err
+	}
+	if err := fieldmask.ApplyMask(stored, update, mask); err != nil {
+		return err
+	}
+
+Every field of a path but the last must be a singular message field. The
+fields are accessed through the reflection tables of the generated code, see
+proto.Reflect.
+*/
+package fieldmask
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/dropbox/godropbox/errors"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+// Mask is a set of field paths of a message type.
+type Mask struct {
+	typeName string
+	root     *node
+}
+
+// node is a field of a path. The fields below a leaf are all in the mask.
+type node struct {
+	leaf     bool
+	children map[string]*node
+}
+
+// New returns the mask of the given paths of the type of m. It returns an
+// error naming the first path which is not valid. A path covered by another
+// path, such as "a.b" by "a", is redundant.
+func New(m proto.Message, paths ...string) (*Mask, error) {
+	info := proto.Reflect(m)
+	if info == nil {
+		return nil, errors.Newf("fieldmask: %T has no reflection table", m)
+	}
+	mask := &Mask{typeName: info.Name, root: &node{}}
+	for _, path := range paths {
+		if err := mask.add(info, path); err != nil {
+			return nil, err
+		}
+	}
+	return mask, nil
+}
+
+func (mask *Mask) add(info *proto.MessageInfo, path string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		f := info.FieldByName(name)
+		if f == nil {
+			return errors.Newf("fieldmask: unknown field %q in path %q of %s", name, path, info.Name)
+		}
+		if i == len(names)-1 {
+			break
+		}
+		if f.New == nil || f.Label == proto.RepeatedLabel {
+			return errors.Newf("fieldmask: field %q in path %q of %s is not a singular message", name, path, info.Name)
+		}
+		info = proto.Reflect(f.New())
+		if info == nil {
+			return errors.Newf("fieldmask: field %q in path %q has no reflection table", name, path)
+		}
+	}
+	n := mask.root
+	for _, name := range names {
+		if n.leaf {
+			// Already covered by a shorter path.
+			return nil
+		}
+		if n.children == nil {
+			n.children = make(map[string]*node)
+		}
+		child, ok := n.children[name]
+		if !ok {
+			child = &node{}
+			n.children[name] = child
+		}
+		n = child
+	}
+	n.leaf = true
+	n.children = nil
+	return nil
+}
+
+// Paths returns the paths of the mask, sorted and without the redundant
+// ones.
+func (mask *Mask) Paths() []string {
+	var paths []string
+	var walk func(prefix string, n *node)
+	walk = func(prefix string, n *node) {
+		if n.leaf {
+			paths = append(paths, prefix)
+			return
+		}
+		for name, child := range n.children {
+			if prefix != "" {
+				walk(prefix+"."+name, child)
+			} else {
+				walk(name, child)
+			}
+		}
+	}
+	walk("", mask.root)
+	sort.Strings(paths)
+	return paths
+}
+
+func (mask *Mask) String() string {
+	return strings.Join(mask.Paths(), ",")
+}
+
+// Returns the reflection table of m, checking that m has the type of the
+// mask.
+func (mask *Mask) info(m proto.Message) (*proto.MessageInfo, error) {
+	info := proto.Reflect(m)
+	if info == nil || info.Name != mask.typeName {
+		return nil, errors.Newf("fieldmask: mask of %s used with a %T", mask.typeName, m)
+	}
+	return info, nil
+}
+
+// ApplyMask copies the fields in the mask from src to dst, which must be of
+// the same type. A field which is not set in src is cleared in dst. As for
+// an update with a FieldMask, a message field at the end of a path is merged
+// into the one of dst, while a repeated field is replaced.
+func ApplyMask(dst proto.Message, src proto.Message, mask *Mask) error {
+	info, err := mask.info(dst)
+	if err != nil {
+		return err
+	}
+	if reflect.TypeOf(src) != reflect.TypeOf(dst) {
+		return errors.Newf("fieldmask: cannot apply a %T to a %T", src, dst)
+	}
+	return apply(info, dst, src, mask.root)
+}
+
+func apply(info *proto.MessageInfo, dst proto.Message, src proto.Message, n *node) error {
+	for _, f := range info.Fields {
+		child, ok := n.children[f.Name]
+		if !ok {
+			continue
+		}
+		if !child.leaf {
+			// A message in the middle of a path: apply the rest of the path
+			// to it, as if it was empty in src if it is not set.
+			if !f.Has(dst) {
+				if !f.Has(src) {
+					continue
+				}
+				if err := f.Set(dst, f.New()); err != nil {
+					return err
+				}
+			}
+			sub := f.New()
+			if f.Has(src) {
+				sub = f.Get(src).(proto.Message)
+			}
+			d := f.Get(dst).(proto.Message)
+			if err := apply(proto.Reflect(d), d, sub, child); err != nil {
+				return err
+			}
+			continue
+		}
+		switch {
+		case !f.Has(src):
+			f.Clear(dst)
+		case f.New != nil && f.Label != proto.RepeatedLabel && f.Has(dst):
+			proto.Merge(f.Get(dst).(proto.Message), f.Get(src).(proto.Message))
+		default:
+			if err := f.Set(dst, f.Get(src)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ClearExcept clears the fields of m which are not in the mask, leaving only
+// the masked ones.
+func ClearExcept(m proto.Message, mask *Mask) error {
+	info, err := mask.info(m)
+	if err != nil {
+		return err
+	}
+	clearExcept(info, m, mask.root)
+	return nil
+}
+
+func clearExcept(info *proto.MessageInfo, m proto.Message, n *node) {
+	for _, f := range info.Fields {
+		child, ok := n.children[f.Name]
+		switch {
+		case !ok:
+			f.Clear(m)
+		case !child.leaf && f.Has(m):
+			sub := f.Get(m).(proto.Message)
+			clearExcept(proto.Reflect(sub), sub, child)
+		}
+	}
+}
+
+// PruneToMask returns a new message of the type of m which holds only the
+// fields of m in the mask. Unlike ClearExcept, it leaves m unchanged and
+// drops the fields that are not known to the generated code.
+func PruneToMask(m proto.Message, mask *Mask) (proto.Message, error) {
+	if _, err := mask.info(m); err != nil {
+		return nil, err
+	}
+	pruned := reflect.New(reflect.TypeOf(m).Elem()).Interface().(proto.Message)
+	if err := ApplyMask(pruned, m, mask); err != nil {
+		return nil, err
+	}
+	return pruned, nil
+}
@@ -39,11 +39,13 @@ More Canonical Go Structures
 
 A lot of time working with a goprotobuf struct will lead you to a place where you create another struct that is easier to work with and then have a function to copy the values between the two structs.
 You might also find that basic structs that started their life as part of an API need to be sent over the wire. With gob, you could just send it. With goprotobuf, you need to make a parallel struct.
-Gogoprotobuf tries to fix these problems with the nullable, embed, customtype and customname field extensions.
+Gogoprotobuf tries to fix these problems with the nullable, embed, customtype, casttype, castkey and customname field extensions.
 
-  - nullable, if false, a field is generated without a pointer (see warning below).
+  - nullable, if false, a field is generated without a pointer (see warning below). protoc-gen-dgo only honors it on message fields, which then hold their messages by value. The accessors still return pointers into the message, and presence is still tracked.
   - embed, if true, the field is generated as an embedded field.
   - customtype, It works with the Marshal and Unmarshal methods, to allow you to have your own types in your struct, but marshal to bytes. For example, custom.Uuid or custom.Fixed128
+  - casttype, the scalar field is generated with the given named type, whose underlying type must be the Go type of the field. The accessors take and return the named type and the codecs convert it to and from the underlying type. For example, a UserID defined as an int64.
+  - castkey, like casttype, but for the keys of a map field.
   - customname (beta), Changes the generated fieldname. This is especially useful when generated methods conflict with fieldnames.
 
 Warning about nullable: According to the Protocol Buffer specification, you should be able to tell whether a field is set or unset. With the option nullable=false this feature is lost, since your non-nullable fields will always be set. It can be seen as a layer on top of Protocol Buffers, where before and after marshalling all non-nullable fields are set and they cannot be unset.
@@ -138,7 +140,8 @@ The enumprefix, getters and stringer extensions can be used to remove some of th
   - goproto_enum_prefix, if false, generates the enum constant names without the messagetype prefix
   - goproto_enum_stringer (experimental), if false, the enum is generated without the default string method, this is useful for rather using enum_stringer, or allowing you to write your own string method.
   - goproto_getters, if false, the message is generated without get methods, this is useful when you would rather want to use face
-  - goproto_stringer, if false, the message is generated without the default string method, this is useful for rather using stringer, or allowing you to write your own string method.
+  - goproto_stringer, if false, the message is generated without the default string method, this is useful for rather using stringer, or allowing you to write your own string method. The message must then provide a String method itself or enable stringer, since proto.Message requires one.
+  - goproto_jsonpb, if false, the message is generated without the MarshalJSONPB and UnmarshalJSONPB methods, allowing you to write your own JSON representation.
   - goproto_extensions_map (beta), if false, the extensions field is generated as type []byte instead of type map[int32]proto.Extension
 
 Less Typing and Peace of Mind is explained in their specific plugin folders godoc:
@@ -54,6 +54,14 @@ var E_GoprotoEnumPrefixAll = &proto.ExtensionDesc{
 	Tag:           "varint,63002,opt,name=goproto_enum_prefix_all",
 }
 
+var E_GoprotoStringerAll = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.FileOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         63003,
+	Name:          "gogoproto.goproto_stringer_all",
+	Tag:           "varint,63003,opt,name=goproto_stringer_all",
+}
+
 var E_VerboseEqualAll = &proto.ExtensionDesc{
 	ExtendedType:  (*google_protobuf.FileOptions)(nil),
 	ExtensionType: (*bool)(nil),
@@ -190,6 +198,38 @@ var E_GoprotoExtensionsMapAll = &proto.ExtensionDesc{
 	Tag:           "varint,63025,opt,name=goproto_extensions_map_all",
 }
 
+var E_GoprotoStringer = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.MessageOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         64003,
+	Name:          "gogoproto.goproto_stringer",
+	Tag:           "varint,64003,opt,name=goproto_stringer",
+}
+
+var E_PoolAll = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.FileOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         63027,
+	Name:          "gogoproto.pool_all",
+	Tag:           "varint,63027,opt,name=pool_all",
+}
+
+var E_ZeroCopyAll = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.FileOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         63028,
+	Name:          "gogoproto.zero_copy_all",
+	Tag:           "varint,63028,opt,name=zero_copy_all",
+}
+
+var E_GoprotoJsonpbAll = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.FileOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         63029,
+	Name:          "gogoproto.goproto_jsonpb_all",
+	Tag:           "varint,63029,opt,name=goproto_jsonpb_all",
+}
+
 var E_VerboseEqual = &proto.ExtensionDesc{
 	ExtendedType:  (*google_protobuf.MessageOptions)(nil),
 	ExtensionType: (*bool)(nil),
@@ -302,6 +342,30 @@ var E_GoprotoExtensionsMap = &proto.ExtensionDesc{
 	Tag:           "varint,64025,opt,name=goproto_extensions_map",
 }
 
+var E_Pool = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.MessageOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         64027,
+	Name:          "gogoproto.pool",
+	Tag:           "varint,64027,opt,name=pool",
+}
+
+var E_ZeroCopy = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.MessageOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         64028,
+	Name:          "gogoproto.zero_copy",
+	Tag:           "varint,64028,opt,name=zero_copy",
+}
+
+var E_GoprotoJsonpb = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.MessageOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         64029,
+	Name:          "gogoproto.goproto_jsonpb",
+	Tag:           "varint,64029,opt,name=goproto_jsonpb",
+}
+
 var E_Nullable = &proto.ExtensionDesc{
 	ExtendedType:  (*google_protobuf.FieldOptions)(nil),
 	ExtensionType: (*bool)(nil),
@@ -350,11 +414,28 @@ var E_Moretags = &proto.ExtensionDesc{
 	Tag:           "bytes,65006,opt,name=moretags",
 }
 
+var E_Casttype = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.FieldOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         65007,
+	Name:          "gogoproto.casttype",
+	Tag:           "bytes,65007,opt,name=casttype",
+}
+
+var E_Castkey = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.FieldOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         65008,
+	Name:          "gogoproto.castkey",
+	Tag:           "bytes,65008,opt,name=castkey",
+}
+
 func init() {
 	proto.RegisterExtension(E_GoprotoEnumPrefix)
 	proto.RegisterExtension(E_GoprotoEnumStringer)
 	proto.RegisterExtension(E_EnumStringer)
 	proto.RegisterExtension(E_GoprotoEnumPrefixAll)
+	proto.RegisterExtension(E_GoprotoStringerAll)
 	proto.RegisterExtension(E_VerboseEqualAll)
 	proto.RegisterExtension(E_FaceAll)
 	proto.RegisterExtension(E_PopulateAll)
@@ -371,6 +452,10 @@ func init() {
 	proto.RegisterExtension(E_GoprotoEnumStringerAll)
 	proto.RegisterExtension(E_EnumStringerAll)
 	proto.RegisterExtension(E_GoprotoExtensionsMapAll)
+	proto.RegisterExtension(E_PoolAll)
+	proto.RegisterExtension(E_ZeroCopyAll)
+	proto.RegisterExtension(E_GoprotoJsonpbAll)
+	proto.RegisterExtension(E_GoprotoStringer)
 	proto.RegisterExtension(E_VerboseEqual)
 	proto.RegisterExtension(E_Face)
 	proto.RegisterExtension(E_Populate)
@@ -385,10 +470,15 @@ func init() {
 	proto.RegisterExtension(E_Bufferto)
 	proto.RegisterExtension(E_Sizer)
 	proto.RegisterExtension(E_GoprotoExtensionsMap)
+	proto.RegisterExtension(E_Pool)
+	proto.RegisterExtension(E_ZeroCopy)
+	proto.RegisterExtension(E_GoprotoJsonpb)
 	proto.RegisterExtension(E_Nullable)
 	proto.RegisterExtension(E_Embed)
 	proto.RegisterExtension(E_Customtype)
 	proto.RegisterExtension(E_Customname)
 	proto.RegisterExtension(E_Jsontag)
 	proto.RegisterExtension(E_Moretags)
+	proto.RegisterExtension(E_Casttype)
+	proto.RegisterExtension(E_Castkey)
 }
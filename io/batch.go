@@ -0,0 +1,444 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package io
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/godropbox/errors"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+var errClosed = errors.New("io: batch writer is closed")
+
+// BatchOptions configures NewBatchWriter and NewBatchReader. The zero value
+// of every field selects its default.
+type BatchOptions struct {
+	// The number of goroutines marshaling or unmarshaling messages. The
+	// default is runtime.GOMAXPROCS(0).
+	Workers int
+	// The number of messages in flight. WriteMsg blocks when the queue is
+	// full, and the reader stops reading ahead. The default is 1024.
+	QueueSize int
+	// The writer writes to the underlying writer once it has buffered
+	// FlushSize bytes. The default is 64KiB.
+	FlushSize int
+	// If not 0, buffered bytes are written after at most FlushInterval.
+	FlushInterval time.Duration
+	// If not nil, OnError is called with every message that could not be
+	// marshaled, from the goroutine which writes to the underlying writer,
+	// instead of the errors being returned by Flush and Close.
+	OnError func(err *MessageError)
+}
+
+func (o *BatchOptions) withDefaults() BatchOptions {
+	opts := BatchOptions{}
+	if o != nil {
+		opts = *o
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = 1024
+	}
+	if opts.FlushSize <= 0 {
+		opts.FlushSize = 64 * 1024
+	}
+	return opts
+}
+
+// MessageError is the error of a message which was not written.
+type MessageError struct {
+	// The position of the message among the messages passed to WriteMsg,
+	// counting from 0.
+	Seq uint64
+	Msg proto.Message
+	Err error
+}
+
+func (e *MessageError) Error() string {
+	return fmt.Sprintf("io: message %d: %v", e.Seq, e.Err)
+}
+
+// BatchError is returned by Flush and Close when messages could not be
+// written.
+type BatchError []*MessageError
+
+func (e BatchError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// BatchWriter is a WriteCloser which is safe for concurrent use.
+type BatchWriter interface {
+	WriteCloser
+	// Flush waits until the messages passed to WriteMsg so far are written
+	// to the underlying writer. It returns the first error of the
+	// underlying writer, or else a BatchError with the messages which could
+	// not be marshaled since the last Flush.
+	Flush() error
+}
+
+type batchJob struct {
+	msg  proto.Message
+	data []byte
+	err  error
+	done chan struct{}
+	// Set for the jobs sent by Flush instead of a message.
+	flushed chan error
+}
+
+// NewBatchWriter returns a writer of messages delimited by their length as a
+// varint, like NewDelimitedWriter, which marshals messages on a pool of
+// goroutines and writes them in the order they were passed to WriteMsg.
+// WriteMsg returns once the message is queued, so the message must not be
+// modified until Flush returns. It returns the first error of the
+// underlying writer, if any.
+func NewBatchWriter(w io.Writer, opts *BatchOptions) BatchWriter {
+	this := &batchWriter{
+		w:        w,
+		opts:     opts.withDefaults(),
+		finished: make(chan struct{}),
+		failed:   make(chan struct{}),
+	}
+	this.order = make(chan *batchJob, this.opts.QueueSize)
+	this.work = make(chan *batchJob, this.opts.QueueSize)
+	for i := 0; i < this.opts.Workers; i++ {
+		go this.marshal()
+	}
+	go this.run()
+	return this
+}
+
+type batchWriter struct {
+	w    io.Writer
+	opts BatchOptions
+	// The jobs in the order they are written, and the jobs to marshal.
+	order chan *batchJob
+	work  chan *batchJob
+	// Closed when run has returned.
+	finished chan struct{}
+	// Closed by run once it has set err, the first error of the underlying
+	// writer.
+	failed chan struct{}
+	err    error
+
+	// Guards closed and the registration of calls in pending, but is never
+	// held while sending to or receiving from the channels, which may block.
+	mu     sync.Mutex
+	closed bool
+	// The WriteMsg and Flush calls which Close waits for before it stops
+	// the goroutines.
+	pending sync.WaitGroup
+
+	// Owned by run.
+	buffer  []byte
+	seq     uint64
+	msgErrs BatchError
+}
+
+func (this *batchWriter) marshal() {
+	for job := range this.work {
+		var data []byte
+		var err error
+		if m, ok := job.msg.(marshaler); ok {
+			n := m.Size()
+			data = make([]byte, binary.MaxVarintLen64+n)
+			k := binary.PutUvarint(data, uint64(n))
+			if _, err = m.MarshalTo(data[k:]); err == nil {
+				data = data[:k+n]
+			}
+		} else if body, err2 := proto.Marshal(job.msg); err2 != nil {
+			err = err2
+		} else {
+			data = make([]byte, binary.MaxVarintLen64+len(body))
+			k := binary.PutUvarint(data, uint64(len(body)))
+			data = data[:k+copy(data[k:], body)]
+		}
+		job.data, job.err = data, err
+		close(job.done)
+	}
+}
+
+// Returns the error set by run, if any, from any goroutine.
+func (this *batchWriter) getErr() error {
+	select {
+	case <-this.failed:
+		return this.err
+	default:
+		return nil
+	}
+}
+
+// Writes the buffered bytes to the underlying writer.
+func (this *batchWriter) writeBuffer() {
+	if len(this.buffer) == 0 {
+		return
+	}
+	if this.err == nil {
+		if _, err := this.w.Write(this.buffer); err != nil {
+			this.err = err
+			close(this.failed)
+		}
+	}
+	this.buffer = this.buffer[:0]
+}
+
+// Writes the marshaled messages in order.
+func (this *batchWriter) run() {
+	defer close(this.finished)
+	var tick <-chan time.Time
+	if this.opts.FlushInterval > 0 {
+		ticker := time.NewTicker(this.opts.FlushInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case job, ok := <-this.order:
+			if !ok {
+				this.writeBuffer()
+				return
+			}
+			if job.flushed != nil {
+				this.writeBuffer()
+				err := this.err
+				if err == nil && len(this.msgErrs) > 0 {
+					err = this.msgErrs
+				}
+				this.msgErrs = nil
+				job.flushed <- err
+				continue
+			}
+			<-job.done
+			if job.err != nil {
+				msgErr := &MessageError{Seq: this.seq, Msg: job.msg, Err: job.err}
+				if this.opts.OnError != nil {
+					this.opts.OnError(msgErr)
+				} else {
+					this.msgErrs = append(this.msgErrs, msgErr)
+				}
+			} else {
+				this.buffer = append(this.buffer, job.data...)
+				if len(this.buffer) >= this.opts.FlushSize {
+					this.writeBuffer()
+				}
+			}
+			this.seq++
+		case <-tick:
+			this.writeBuffer()
+		}
+	}
+}
+
+// Registers a WriteMsg or Flush call, which must call pending.Done when it
+// returns. It returns false if the writer is closed.
+func (this *batchWriter) enter() bool {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if this.closed {
+		return false
+	}
+	this.pending.Add(1)
+	return true
+}
+
+func (this *batchWriter) WriteMsg(msg proto.Message) error {
+	if !this.enter() {
+		return errClosed
+	}
+	defer this.pending.Done()
+	if err := this.getErr(); err != nil {
+		return err
+	}
+	job := &batchJob{msg: msg, done: make(chan struct{})}
+	this.order <- job
+	this.work <- job
+	return nil
+}
+
+func (this *batchWriter) flush() error {
+	job := &batchJob{flushed: make(chan error, 1)}
+	this.order <- job
+	return <-job.flushed
+}
+
+func (this *batchWriter) Flush() error {
+	if !this.enter() {
+		return errClosed
+	}
+	defer this.pending.Done()
+	return this.flush()
+}
+
+// Close flushes the writer, stops its goroutines and closes the underlying
+// writer if it is an io.Closer.
+func (this *batchWriter) Close() error {
+	this.mu.Lock()
+	if this.closed {
+		this.mu.Unlock()
+		return errClosed
+	}
+	this.closed = true
+	this.mu.Unlock()
+	// No call sends to the channels once the pending ones have returned.
+	this.pending.Wait()
+	err := this.flush()
+	close(this.work)
+	close(this.order)
+	<-this.finished
+	if closer, ok := this.w.(io.Closer); ok {
+		if closeErr := closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// BatchReader reads messages which are decoded ahead of time by a pool of
+// goroutines.
+type BatchReader interface {
+	// Next returns the next message, or io.EOF at the end of the input.
+	Next() (proto.Message, error)
+	// Close stops the goroutines of the reader and closes the underlying
+	// reader if it is an io.Closer, which unblocks a pending Read. Otherwise
+	// the goroutine reading the input only exits once its pending Read
+	// returns.
+	Close() error
+}
+
+type batchReadJob struct {
+	data []byte
+	msg  proto.Message
+	err  error
+	done chan struct{}
+	// Set for the job carrying the error which ended the input.
+	last bool
+}
+
+// NewBatchReader returns a reader of messages delimited by their length as a
+// varint, as written by NewBatchWriter or NewDelimitedWriter. The messages
+// are unmarshaled into new messages returned by newMsg, on a pool of
+// goroutines, and returned by Next in the order they were read. Messages
+// larger than maxSize bytes are rejected with a *TooLargeError.
+func NewBatchReader(r io.Reader, maxSize int, newMsg func() proto.Message, opts *BatchOptions) BatchReader {
+	this := &batchReader{
+		r:      r,
+		newMsg: newMsg,
+		opts:   opts.withDefaults(),
+		quit:   make(chan struct{}),
+	}
+	this.order = make(chan *batchReadJob, this.opts.QueueSize)
+	this.work = make(chan *batchReadJob, this.opts.QueueSize)
+	for i := 0; i < this.opts.Workers; i++ {
+		go this.unmarshal()
+	}
+	go this.read(NewDelimitedReader(r, maxSize))
+	return this
+}
+
+type batchReader struct {
+	r      io.Reader
+	newMsg func() proto.Message
+	opts   BatchOptions
+	order  chan *batchReadJob
+	work   chan *batchReadJob
+	quit   chan struct{}
+	// The error which ended the input, returned by every later Next.
+	err       error
+	closeOnce sync.Once
+}
+
+func (this *batchReader) unmarshal() {
+	for job := range this.work {
+		job.msg = this.newMsg()
+		job.err = proto.Unmarshal(job.data, job.msg)
+		close(job.done)
+	}
+}
+
+// Reads the frames of the input, until an error or Close.
+func (this *batchReader) read(reader DelimitedReader) {
+	defer close(this.order)
+	defer close(this.work)
+	for {
+		data, err := reader.Peek()
+		if err != nil {
+			job := &batchReadJob{err: err, done: make(chan struct{}), last: true}
+			close(job.done)
+			select {
+			case this.order <- job:
+			case <-this.quit:
+			}
+			return
+		}
+		job := &batchReadJob{data: append([]byte(nil), data...), done: make(chan struct{})}
+		reader.Skip()
+		select {
+		case this.order <- job:
+		case <-this.quit:
+			return
+		}
+		this.work <- job
+	}
+}
+
+func (this *batchReader) Next() (proto.Message, error) {
+	if this.err != nil {
+		return nil, this.err
+	}
+	job, ok := <-this.order
+	if !ok {
+		this.err = errClosed
+		return nil, this.err
+	}
+	<-job.done
+	if job.last {
+		this.err = job.err
+	}
+	return job.msg, job.err
+}
+
+func (this *batchReader) Close() error {
+	this.closeOnce.Do(func() {
+		close(this.quit)
+	})
+	if closer, ok := this.r.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,356 @@
+// Extensions for Protocol Buffers to create more go like structures.
+//
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package io_test
+
+import (
+	"bytes"
+	"github.com/dropbox/godropbox/errors"
+	"github.com/dropbox/goprotoc/io"
+	"github.com/dropbox/goprotoc/proto"
+	"github.com/dropbox/goprotoc/test"
+	goio "io"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A message which fails to marshal.
+type badMessage struct{}
+
+func (*badMessage) Reset()                             {}
+func (*badMessage) String() string                     { return "bad" }
+func (*badMessage) ProtoMessage()                      {}
+func (*badMessage) Size() int                          { return 1 }
+func (*badMessage) MarshalTo(data []byte) (int, error) { return 0, errors.New("bad message") }
+
+// A buffer which records the size of every write.
+type syncBuffer struct {
+	sync.Mutex
+	bytes.Buffer
+	writes int
+}
+
+func (this *syncBuffer) Write(p []byte) (int, error) {
+	this.Lock()
+	defer this.Unlock()
+	this.writes++
+	return this.Buffer.Write(p)
+}
+
+func (this *syncBuffer) Len() int {
+	this.Lock()
+	defer this.Unlock()
+	return this.Buffer.Len()
+}
+
+func newNinOptNative() proto.Message {
+	return &test.NinOptNative{}
+}
+
+func TestBatch(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	msgs := make([]*test.NinOptNative, 1000)
+	for i := range msgs {
+		msgs[i] = test.NewPopulatedNinOptNative(r, true)
+	}
+	buf := &syncBuffer{}
+	writer := io.NewBatchWriter(buf, &io.BatchOptions{Workers: 4, QueueSize: 16, FlushSize: 1024})
+	for _, msg := range msgs {
+		if err := writer.WriteMsg(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.writes < 2 {
+		t.Fatalf("Expected several writes, got %d", buf.writes)
+	}
+
+	// The output is readable by the delimited reader.
+	reader := io.NewDelimitedReader(bytes.NewReader(buf.Bytes()), 1024*1024)
+	for i := range msgs {
+		msg := &test.NinOptNative{}
+		if err := reader.ReadMsg(msg); err != nil {
+			t.Fatal(err)
+		}
+		if err := msgs[i].VerboseEqual(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	batchReader := io.NewBatchReader(bytes.NewReader(buf.Bytes()), 1024*1024, newNinOptNative, &io.BatchOptions{Workers: 4, QueueSize: 16})
+	for i := range msgs {
+		msg, err := batchReader.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := msgs[i].VerboseEqual(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := batchReader.Next(); err != goio.EOF {
+			t.Fatalf("Expected EOF, got %v", err)
+		}
+	}
+	if err := batchReader.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBatchConcurrent(t *testing.T) {
+	buf := &syncBuffer{}
+	writer := io.NewBatchWriter(buf, &io.BatchOptions{QueueSize: 4})
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				msg := &test.NinOptNative{}
+				msg.SetField3(int32(g))
+				msg.SetField4(int64(i))
+				if err := writer.WriteMsg(msg); err != nil {
+					t.Error(err)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.WriteMsg(&test.NinOptNative{}); err == nil {
+		t.Fatalf("Wrote to a closed writer")
+	}
+
+	// The messages of every goroutine are in order.
+	next := make([]int64, 8)
+	reader := io.NewBatchReader(bytes.NewReader(buf.Bytes()), 1024, newNinOptNative, nil)
+	defer reader.Close()
+	for n := 0; ; n++ {
+		msg, err := reader.Next()
+		if err == goio.EOF {
+			if n != 800 {
+				t.Fatalf("Read %d messages", n)
+			}
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		m := msg.(*test.NinOptNative)
+		if m.GetField4() != next[m.GetField3()] {
+			t.Fatalf("Message %d of goroutine %d out of order", m.GetField4(), m.GetField3())
+		}
+		next[m.GetField3()]++
+	}
+}
+
+// A writer which takes a while to write.
+type slowWriter struct {
+	syncBuffer
+}
+
+func (this *slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(time.Millisecond)
+	return this.syncBuffer.Write(p)
+}
+
+func TestBatchConcurrentClose(t *testing.T) {
+	buf := &slowWriter{}
+	writer := io.NewBatchWriter(buf, &io.BatchOptions{QueueSize: 1, FlushSize: 1})
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	written := 0
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				msg := &test.NinOptNative{}
+				msg.SetField4(int64(i))
+				if err := writer.WriteMsg(msg); err != nil {
+					return
+				}
+				mu.Lock()
+				written++
+				mu.Unlock()
+			}
+		}()
+	}
+	closed := make(chan error, 1)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		closed <- writer.Close()
+	}()
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Close did not return")
+	}
+	wg.Wait()
+
+	// Every message accepted by WriteMsg was written before Close returned.
+	reader := io.NewBatchReader(bytes.NewReader(buf.Bytes()), 1024, newNinOptNative, nil)
+	defer reader.Close()
+	n := 0
+	for ; ; n++ {
+		if _, err := reader.Next(); err == goio.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n != written {
+		t.Fatalf("Read %d messages, %d were written", n, written)
+	}
+}
+
+func TestBatchErrors(t *testing.T) {
+	buf := &syncBuffer{}
+	writer := io.NewBatchWriter(buf, nil)
+	good := &test.NinOptNative{}
+	good.SetField3(1)
+	bad := &badMessage{}
+	for _, msg := range []proto.Message{good, bad, good, bad} {
+		if err := writer.WriteMsg(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := writer.Flush()
+	batchErr, ok := err.(io.BatchError)
+	if !ok || len(batchErr) != 2 {
+		t.Fatalf("Expected 2 message errors, got %v", err)
+	}
+	if batchErr[0].Seq != 1 || batchErr[1].Seq != 3 || batchErr[0].Msg != bad {
+		t.Fatalf("Wrong message errors: %v", err)
+	}
+	if err := writer.Flush(); err != nil {
+		t.Fatalf("Errors were reported twice: %v", err)
+	}
+
+	var failed []uint64
+	opts := &io.BatchOptions{OnError: func(err *io.MessageError) {
+		failed = append(failed, err.Seq)
+	}}
+	writer = io.NewBatchWriter(buf, opts)
+	for _, msg := range []proto.Message{bad, good} {
+		if err := writer.WriteMsg(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(failed) != 1 || failed[0] != 0 {
+		t.Fatalf("Wrong message errors: %v", failed)
+	}
+
+	reader := io.NewBatchReader(bytes.NewReader(buf.Bytes()), 1024, newNinOptNative, nil)
+	defer reader.Close()
+	for i := 0; i < 3; i++ {
+		if _, err := reader.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := reader.Next(); err != goio.EOF {
+		t.Fatalf("Expected EOF, got %v", err)
+	}
+}
+
+func TestBatchFlushInterval(t *testing.T) {
+	buf := &syncBuffer{}
+	writer := io.NewBatchWriter(buf, &io.BatchOptions{FlushInterval: 10 * time.Millisecond})
+	defer writer.Close()
+	msg := &test.NinOptNative{}
+	msg.SetField3(1)
+	if err := writer.WriteMsg(msg); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for buf.Len() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("The message was not written")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBatchReaderErrors(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer := io.NewDelimitedWriter(buf)
+	small := &test.NinOptNative{}
+	small.SetField3(1)
+	writer.WriteMsg(small)
+	buf.Write([]byte{3, 0xff, 0xff, 0xff})
+	writer.WriteMsg(small)
+
+	reader := io.NewBatchReader(bytes.NewReader(buf.Bytes()), 1024, newNinOptNative, nil)
+	defer reader.Close()
+	if _, err := reader.Next(); err != nil {
+		t.Fatal(err)
+	}
+	// A message which does not unmarshal does not end the input.
+	if _, err := reader.Next(); err == nil {
+		t.Fatalf("Expected an unmarshaling error")
+	}
+	if _, err := reader.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader = io.NewBatchReader(bytes.NewReader(buf.Bytes()), 1, newNinOptNative, nil)
+	defer reader.Close()
+	for i := 0; i < 2; i++ {
+		if _, err := reader.Next(); err == nil {
+			t.Fatalf("Expected a TooLargeError")
+		} else if _, ok := err.(*io.TooLargeError); !ok {
+			t.Fatalf("Expected a TooLargeError, got %v", err)
+		}
+	}
+}
+
+func TestBatchReaderClose(t *testing.T) {
+	r, w := goio.Pipe()
+	reader := io.NewBatchReader(r, 1024, newNinOptNative, nil)
+	// The reader is blocked reading the pipe, until Close closes it.
+	if err := reader.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte{0}); err != goio.ErrClosedPipe {
+		t.Fatalf("Expected ErrClosedPipe, got %v", err)
+	}
+	if _, err := reader.Next(); err == nil {
+		t.Fatalf("Expected an error after Close")
+	}
+}
@@ -51,6 +51,10 @@ type ReadCloser interface {
 	io.Closer
 }
 
+type unmarshaler interface {
+	Unmarshal(data []byte) error
+}
+
 type marshaler interface {
 	MarshalTo(data []byte) (n int, err error)
 	Size() (n int)
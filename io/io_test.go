@@ -35,7 +35,9 @@ import (
 	"github.com/dropbox/goprotoc/test"
 	goio "io"
 	"math/rand"
+	"strings"
 	"testing"
+	goiotest "testing/iotest"
 	"time"
 )
 
@@ -135,3 +137,99 @@ func TestFull(t *testing.T) {
 		panic(err)
 	}
 }
+
+func TestVarintShortReads(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	writer := io.NewDelimitedWriter(buf)
+	reader := io.NewDelimitedReader(goiotest.OneByteReader(buf), 1024*1024)
+	iotest(writer, reader)
+}
+
+func TestVarintTooLarge(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	writer := io.NewDelimitedWriter(buf)
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	small := &test.NinOptNative{}
+	small.SetField3(1)
+	large := test.NewPopulatedNinOptNative(r, true)
+	large.SetField14(strings.Repeat("a", 100))
+	for _, msg := range []*test.NinOptNative{large, small} {
+		if err := writer.WriteMsg(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reader := io.NewDelimitedReader(goiotest.HalfReader(buf), 50)
+	msg := &test.NinOptNative{}
+	err := reader.ReadMsg(msg)
+	if e, ok := err.(*io.TooLargeError); !ok || e.Size != uint64(large.Size()) || e.MaxSize != 50 {
+		t.Fatalf("Expected TooLargeError, got %v", err)
+	}
+	if err := reader.Skip(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := reader.Peek()
+	if err != nil || len(data) != small.Size() {
+		t.Fatalf("Peek returned %x, %v", data, err)
+	}
+	if err := reader.ReadMsg(msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := small.VerboseEqual(msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := reader.ReadMsg(msg); err != goio.EOF {
+		t.Fatalf("Expected EOF, got %v", err)
+	}
+}
+
+func TestVarintTruncated(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	writer := io.NewDelimitedWriter(buf)
+	msg := &test.NinOptNative{}
+	msg.SetField14("truncated")
+	if err := writer.WriteMsg(msg); err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{1, buf.Len() - 1} {
+		reader := io.NewDelimitedReader(bytes.NewReader(buf.Bytes()[:n]), 1024)
+		if err := reader.ReadMsg(msg); err != goio.ErrUnexpectedEOF {
+			t.Errorf("Expected ErrUnexpectedEOF reading %d bytes, got %v", n, err)
+		}
+		reader = io.NewDelimitedReader(bytes.NewReader(buf.Bytes()[:n]), 1024)
+		if err := reader.Skip(); err != goio.ErrUnexpectedEOF {
+			t.Errorf("Expected ErrUnexpectedEOF skipping %d bytes, got %v", n, err)
+		}
+	}
+}
+
+// Returns no bytes and no error on every other read.
+type stutterReader struct {
+	r     goio.Reader
+	empty bool
+}
+
+func (this *stutterReader) Read(p []byte) (int, error) {
+	this.empty = !this.empty
+	if this.empty {
+		return 0, nil
+	}
+	return this.r.Read(p)
+}
+
+// Never returns any bytes or error.
+type emptyReader struct{}
+
+func (emptyReader) Read(p []byte) (int, error) {
+	return 0, nil
+}
+
+func TestVarintEmptyReads(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	writer := io.NewDelimitedWriter(buf)
+	reader := io.NewDelimitedReader(&stutterReader{r: goiotest.OneByteReader(buf)}, 1024*1024)
+	iotest(writer, reader)
+	reader = io.NewDelimitedReader(emptyReader{}, 1024)
+	if err := reader.ReadMsg(&test.NinOptNative{}); err != goio.ErrNoProgress {
+		t.Fatalf("Expected ErrNoProgress, got %v", err)
+	}
+}
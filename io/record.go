@@ -0,0 +1,478 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package io
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/dropbox/godropbox/errors"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+/*
+A record file is a sequence of blocks, each holding one or more messages,
+followed by an index of the blocks and a footer:
+
+	block:  header payload crc32c(payload)
+	header: magic flags count first length crc32c(magic..length)
+	footer: offset of the index block, footerMagic
+
+The header is 25 bytes: a 4 byte magic, 1 byte of flags, and the number of
+messages in the block, the number of messages before it in the file and the
+length of the payload, as 4, 8 and 4 little endian bytes. The payload is the
+varint delimited messages, compressed with DEFLATE if the block has the
+compressed flag.
+
+The index block has the index flag, a count of 0 and the total number of
+messages as first. Its payload is the offset and first message of every
+block, as pairs of 8 byte little endian integers.
+
+Since every header has a checksum and the number of messages before it, a
+reader can find the next valid block after a corrupted region and tell how
+many messages it lost.
+*/
+
+var (
+	recordMagic = [4]byte{0x8a, 'P', 'B', 'R'}
+	footerMagic = [4]byte{0x8a, 'P', 'B', 'I'}
+	castagnoli  = crc32.MakeTable(crc32.Castagnoli)
+
+	errNoIndex = errors.New("io: record file has no index")
+)
+
+const (
+	recordHeaderSize = 25
+	recordFooterSize = 12
+
+	flagCompressed = 1 << 0
+	flagIndex      = 1 << 1
+)
+
+// RecordWriterOptions configures NewRecordWriter.
+type RecordWriterOptions struct {
+	// Messages are gathered in blocks of at least BlockSize bytes before
+	// they are written. If it is 0, every message is written in its own
+	// block.
+	BlockSize int
+	// Compress the blocks with DEFLATE.
+	Compress bool
+}
+
+// RecordWriter writes a record file.
+type RecordWriter interface {
+	WriteCloser
+	// Flush writes the messages of the current block.
+	Flush() error
+}
+
+// RecordReader reads a record file.
+type RecordReader interface {
+	ReadCloser
+	// Skipped returns the number of messages lost in corrupted regions so
+	// far.
+	Skipped() uint64
+	// SeekRecord positions the reader at the message with the given index.
+	// The underlying reader must be an io.ReadSeeker and the file must have
+	// been closed by its writer, so that it has an index.
+	SeekRecord(n uint64) error
+}
+
+type recordIndexEntry struct {
+	offset uint64
+	first  uint64
+}
+
+// NewRecordWriter returns a writer of record files. Close must be called to
+// write the index; a file without one can still be read, but not seeked.
+func NewRecordWriter(w io.Writer, opts *RecordWriterOptions) RecordWriter {
+	if opts == nil {
+		opts = &RecordWriterOptions{}
+	}
+	return &recordWriter{w: w, opts: *opts}
+}
+
+type recordWriter struct {
+	w    io.Writer
+	opts RecordWriterOptions
+	// The delimited messages of the current block.
+	block bytes.Buffer
+	count uint32
+	// The number of messages written before the current block.
+	first   uint64
+	offset  uint64
+	index   []recordIndexEntry
+	lenBuf  [binary.MaxVarintLen64]byte
+	buffer  []byte
+	deflate *flate.Writer
+}
+
+func (this *recordWriter) WriteMsg(msg proto.Message) (err error) {
+	var data []byte
+	if m, ok := msg.(marshaler); ok {
+		n := m.Size()
+		if n >= len(this.buffer) {
+			this.buffer = make([]byte, n)
+		}
+		_, err = m.MarshalTo(this.buffer)
+		if err != nil {
+			return err
+		}
+		data = this.buffer[:n]
+	} else {
+		data, err = proto.Marshal(msg)
+		if err != nil {
+			return err
+		}
+	}
+	n := binary.PutUvarint(this.lenBuf[:], uint64(len(data)))
+	this.block.Write(this.lenBuf[:n])
+	this.block.Write(data)
+	this.count++
+	if this.block.Len() >= this.opts.BlockSize {
+		return this.Flush()
+	}
+	return nil
+}
+
+func (this *recordWriter) Flush() error {
+	if this.count == 0 {
+		return nil
+	}
+	payload := this.block.Bytes()
+	var flags byte
+	if this.opts.Compress {
+		var compressed bytes.Buffer
+		if this.deflate == nil {
+			this.deflate, _ = flate.NewWriter(&compressed, flate.DefaultCompression)
+		} else {
+			this.deflate.Reset(&compressed)
+		}
+		this.deflate.Write(payload)
+		if err := this.deflate.Close(); err != nil {
+			return err
+		}
+		payload = compressed.Bytes()
+		flags |= flagCompressed
+	}
+	offset := this.offset
+	if err := this.writeBlock(flags, this.count, this.first, payload); err != nil {
+		return err
+	}
+	this.index = append(this.index, recordIndexEntry{offset, this.first})
+	this.first += uint64(this.count)
+	this.count = 0
+	this.block.Reset()
+	return nil
+}
+
+func (this *recordWriter) writeBlock(flags byte, count uint32, first uint64, payload []byte) error {
+	var header [recordHeaderSize]byte
+	copy(header[:], recordMagic[:])
+	header[4] = flags
+	binary.LittleEndian.PutUint32(header[5:], count)
+	binary.LittleEndian.PutUint64(header[9:], first)
+	binary.LittleEndian.PutUint32(header[17:], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(header[21:], crc32.Checksum(header[:21], castagnoli))
+	var crc [4]byte
+	binary.LittleEndian.PutUint32(crc[:], crc32.Checksum(payload, castagnoli))
+	for _, data := range [][]byte{header[:], payload, crc[:]} {
+		if _, err := this.w.Write(data); err != nil {
+			return err
+		}
+	}
+	this.offset += uint64(len(header) + len(payload) + len(crc))
+	return nil
+}
+
+// Close flushes the current block, writes the index and the footer, and
+// closes the underlying writer if it is an io.Closer.
+func (this *recordWriter) Close() error {
+	if err := this.Flush(); err != nil {
+		return err
+	}
+	indexOffset := this.offset
+	payload := make([]byte, 16*len(this.index))
+	for i, entry := range this.index {
+		binary.LittleEndian.PutUint64(payload[16*i:], entry.offset)
+		binary.LittleEndian.PutUint64(payload[16*i+8:], entry.first)
+	}
+	if err := this.writeBlock(flagIndex, 0, this.first, payload); err != nil {
+		return err
+	}
+	var footer [recordFooterSize]byte
+	binary.LittleEndian.PutUint64(footer[:], indexOffset)
+	copy(footer[8:], footerMagic[:])
+	if _, err := this.w.Write(footer[:]); err != nil {
+		return err
+	}
+	if closer, ok := this.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// NewRecordReader returns a reader of record files. Blocks, once
+// decompressed, and messages larger than maxSize bytes are treated as
+// corrupted, so maxSize must be larger than the block size of the writer
+// plus the size of the largest message. Corrupted regions are
+// skipped, and the number of messages lost is reported by Skipped. A file
+// which ends in the middle of a block returns io.ErrUnexpectedEOF.
+func NewRecordReader(r io.Reader, maxSize int) RecordReader {
+	return &recordReader{r: r, br: bufio.NewReader(r), maxSize: maxSize}
+}
+
+type recordReader struct {
+	r       io.Reader
+	br      *bufio.Reader
+	maxSize int
+	// The delimited messages of the current block which were not read yet.
+	block []byte
+	// The index of the next message of the file.
+	next    uint64
+	skipped uint64
+	done    bool
+	index   []recordIndexEntry
+}
+
+type recordHeader struct {
+	flags  byte
+	count  uint32
+	first  uint64
+	length uint32
+}
+
+// Returns the header in data if it is valid.
+func parseRecordHeader(data []byte, maxSize int) (recordHeader, bool) {
+	if !bytes.Equal(data[:4], recordMagic[:]) {
+		return recordHeader{}, false
+	}
+	if binary.LittleEndian.Uint32(data[21:]) != crc32.Checksum(data[:21], castagnoli) {
+		return recordHeader{}, false
+	}
+	h := recordHeader{
+		flags:  data[4],
+		count:  binary.LittleEndian.Uint32(data[5:]),
+		first:  binary.LittleEndian.Uint64(data[9:]),
+		length: binary.LittleEndian.Uint32(data[17:]),
+	}
+	if h.flags&flagIndex == 0 && int64(h.length) > int64(maxSize) {
+		return recordHeader{}, false
+	}
+	return h, true
+}
+
+// Reads the next valid header, skipping the bytes before it.
+func (this *recordReader) readHeader() (recordHeader, error) {
+	for {
+		data, err := this.br.Peek(recordHeaderSize)
+		if err != nil {
+			if err == io.EOF && len(data) > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return recordHeader{}, err
+		}
+		if h, ok := parseRecordHeader(data, this.maxSize); ok {
+			this.br.Discard(recordHeaderSize)
+			return h, nil
+		}
+		// Resynchronize on the next magic.
+		this.br.Discard(1)
+		for {
+			data, err := this.br.Peek(len(recordMagic))
+			if err != nil || bytes.Equal(data, recordMagic[:]) {
+				break
+			}
+			this.br.Discard(1)
+		}
+	}
+}
+
+// Reads the next block into this.block. It returns io.EOF at the index.
+func (this *recordReader) readBlock() error {
+	for {
+		h, err := this.readHeader()
+		if err != nil {
+			return err
+		}
+		if h.first > this.next {
+			this.skipped += h.first - this.next
+			this.next = h.first
+		}
+		if h.flags&flagIndex != 0 {
+			this.done = true
+			return io.EOF
+		}
+		payload := make([]byte, int(h.length)+4)
+		if _, err := io.ReadFull(this.br, payload); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		crc := binary.LittleEndian.Uint32(payload[h.length:])
+		payload = payload[:h.length]
+		if crc != crc32.Checksum(payload, castagnoli) {
+			// The messages of the block are counted as skipped by
+			// the next valid block.
+			continue
+		}
+		if h.flags&flagCompressed != 0 {
+			limited := io.LimitReader(flate.NewReader(bytes.NewReader(payload)), int64(this.maxSize)+1)
+			if payload, err = ioutil.ReadAll(limited); err != nil || len(payload) > this.maxSize {
+				continue
+			}
+		}
+		if h.first < this.next {
+			// The beginning of the block was already read, after
+			// SeekRecord.
+			for i := h.first; i < this.next && len(payload) > 0; i++ {
+				if payload, err = this.splitMsg(payload, nil); err != nil {
+					break
+				}
+			}
+			if err != nil {
+				continue
+			}
+		}
+		this.block = payload
+		return nil
+	}
+}
+
+// Removes the first delimited message of data and returns the rest. If msg
+// is not nil the message is decoded into it.
+func (this *recordReader) splitMsg(data []byte, msg proto.Message) ([]byte, error) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) || length > uint64(this.maxSize) {
+		return nil, errors.New("io: corrupted record block")
+	}
+	if msg != nil {
+		if err := proto.Unmarshal(data[n:n+int(length)], msg); err != nil {
+			return nil, err
+		}
+	}
+	return data[n+int(length):], nil
+}
+
+func (this *recordReader) ReadMsg(msg proto.Message) error {
+	for len(this.block) == 0 {
+		if this.done {
+			return io.EOF
+		}
+		if err := this.readBlock(); err != nil {
+			return err
+		}
+	}
+	rest, err := this.splitMsg(this.block, msg)
+	if err != nil {
+		this.block = nil
+		return err
+	}
+	this.block = rest
+	this.next++
+	return nil
+}
+
+func (this *recordReader) Skipped() uint64 {
+	return this.skipped
+}
+
+// Reads the index of the file from the footer.
+func (this *recordReader) readIndex(rs io.ReadSeeker) error {
+	var footer [recordFooterSize]byte
+	if _, err := rs.Seek(-recordFooterSize, io.SeekEnd); err != nil {
+		return errNoIndex
+	}
+	if _, err := io.ReadFull(rs, footer[:]); err != nil || !bytes.Equal(footer[8:], footerMagic[:]) {
+		return errNoIndex
+	}
+	if _, err := rs.Seek(int64(binary.LittleEndian.Uint64(footer[:])), io.SeekStart); err != nil {
+		return err
+	}
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(rs, header[:]); err != nil {
+		return errNoIndex
+	}
+	h, ok := parseRecordHeader(header[:], this.maxSize)
+	if !ok || h.flags&flagIndex == 0 {
+		return errNoIndex
+	}
+	payload := make([]byte, int(h.length)+4)
+	if _, err := io.ReadFull(rs, payload); err != nil {
+		return errNoIndex
+	}
+	if binary.LittleEndian.Uint32(payload[h.length:]) != crc32.Checksum(payload[:h.length], castagnoli) {
+		return errNoIndex
+	}
+	this.index = make([]recordIndexEntry, h.length/16)
+	for i := range this.index {
+		this.index[i].offset = binary.LittleEndian.Uint64(payload[16*i:])
+		this.index[i].first = binary.LittleEndian.Uint64(payload[16*i+8:])
+	}
+	return nil
+}
+
+func (this *recordReader) SeekRecord(n uint64) error {
+	rs, ok := this.r.(io.ReadSeeker)
+	if !ok {
+		return errors.New("io: record file is not seekable")
+	}
+	if this.index == nil {
+		if err := this.readIndex(rs); err != nil {
+			return err
+		}
+	}
+	i := sort.Search(len(this.index), func(i int) bool {
+		return this.index[i].first > n
+	}) - 1
+	var offset uint64
+	if i >= 0 {
+		offset = this.index[i].offset
+	}
+	if _, err := rs.Seek(int64(offset), io.SeekStart); err != nil {
+		return err
+	}
+	this.br.Reset(rs)
+	this.block = nil
+	this.done = false
+	this.next = n
+	return nil
+}
+
+func (this *recordReader) Close() error {
+	if closer, ok := this.r.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package io_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/dropbox/goprotoc/io"
+	"github.com/dropbox/goprotoc/test"
+	goio "io"
+	"math/rand"
+	"testing"
+)
+
+func writeRecords(t *testing.T, opts *io.RecordWriterOptions, n int) ([]*test.NinOptNative, []int, []byte) {
+	buf := bytes.NewBuffer(nil)
+	writer := io.NewRecordWriter(buf, opts)
+	r := rand.New(rand.NewSource(1))
+	msgs := make([]*test.NinOptNative, n)
+	ends := make([]int, n)
+	for i := range msgs {
+		msgs[i] = test.NewPopulatedNinOptNative(r, true)
+		if err := writer.WriteMsg(msgs[i]); err != nil {
+			t.Fatal(err)
+		}
+		ends[i] = buf.Len()
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return msgs, ends, buf.Bytes()
+}
+
+// Reads the messages up to the end of the file, and returns the error that
+// ended the reading.
+func readRecords(reader io.RecordReader) ([]*test.NinOptNative, error) {
+	var msgs []*test.NinOptNative
+	for {
+		msg := &test.NinOptNative{}
+		if err := reader.ReadMsg(msg); err != nil {
+			return msgs, err
+		}
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestRecords(t *testing.T) {
+	for _, opts := range []*io.RecordWriterOptions{
+		nil,
+		{BlockSize: 1024},
+		{BlockSize: 1024, Compress: true},
+	} {
+		msgs, _, data := writeRecords(t, opts, 1000)
+		reader := io.NewRecordReader(bytes.NewReader(data), 1024*1024)
+		got, err := readRecords(reader)
+		if err != goio.EOF {
+			t.Fatalf("Expected EOF, got %v", err)
+		}
+		if len(got) != len(msgs) || reader.Skipped() != 0 {
+			t.Fatalf("Read %d messages and skipped %d, want %d", len(got), reader.Skipped(), len(msgs))
+		}
+		for i := range msgs {
+			if err := msgs[i].VerboseEqual(got[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestRecordsCorrupted(t *testing.T) {
+	msgs, ends, data := writeRecords(t, nil, 10)
+	// Corrupt the payload of message 3 and the header of message 6.
+	data[ends[3]-6]++
+	data[ends[5]+5]++
+	reader := io.NewRecordReader(bytes.NewReader(data), 1024*1024)
+	got, err := readRecords(reader)
+	if err != goio.EOF {
+		t.Fatalf("Expected EOF, got %v", err)
+	}
+	if len(got) != 8 || reader.Skipped() != 2 {
+		t.Fatalf("Read %d messages and skipped %d", len(got), reader.Skipped())
+	}
+	if err := msgs[4].VerboseEqual(got[3]); err != nil {
+		t.Fatal(err)
+	}
+	if err := msgs[7].VerboseEqual(got[5]); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecordsTorn(t *testing.T) {
+	msgs, ends, data := writeRecords(t, nil, 10)
+	// Garbage in the middle, as left by a write which did not complete.
+	torn := append(append(append([]byte{}, data[:ends[2]]...), data[ends[3]:ends[3]+20]...), data[ends[4]:]...)
+	reader := io.NewRecordReader(bytes.NewReader(torn), 1024*1024)
+	got, err := readRecords(reader)
+	if err != goio.EOF || len(got) != 8 || reader.Skipped() != 2 {
+		t.Fatalf("Read %d messages and skipped %d: %v", len(got), reader.Skipped(), err)
+	}
+	if err := msgs[5].VerboseEqual(got[3]); err != nil {
+		t.Fatal(err)
+	}
+
+	// A file which ends in the middle of a block.
+	reader = io.NewRecordReader(bytes.NewReader(data[:ends[6]-1]), 1024*1024)
+	got, err = readRecords(reader)
+	if err != goio.ErrUnexpectedEOF || len(got) != 6 {
+		t.Fatalf("Read %d messages: %v", len(got), err)
+	}
+	if err := reader.SeekRecord(1); err == nil {
+		t.Fatalf("Seeked in a file without an index")
+	}
+}
+
+func TestRecordsSeek(t *testing.T) {
+	for _, opts := range []*io.RecordWriterOptions{nil, {BlockSize: 1024, Compress: true}} {
+		msgs, _, data := writeRecords(t, opts, 100)
+		reader := io.NewRecordReader(bytes.NewReader(data), 1024*1024)
+		for _, n := range []int{57, 0, 99, 10} {
+			if err := reader.SeekRecord(uint64(n)); err != nil {
+				t.Fatal(err)
+			}
+			got, err := readRecords(reader)
+			if err != goio.EOF || len(got) != len(msgs)-n {
+				t.Fatalf("Read %d messages after seeking %d: %v", len(got), n, err)
+			}
+			if err := msgs[n].VerboseEqual(got[0]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := reader.SeekRecord(100); err != nil {
+			t.Fatal(err)
+		}
+		if err := reader.ReadMsg(&test.NinOptNative{}); err != goio.EOF {
+			t.Fatalf("Expected EOF after the last message, got %v", err)
+		}
+		if reader.Skipped() != 0 {
+			t.Fatalf("Skipped %d messages", reader.Skipped())
+		}
+	}
+}
+
+// Fails the first write, and passes the later ones to w.
+type failOnceWriter struct {
+	w      goio.Writer
+	failed bool
+}
+
+func (this *failOnceWriter) Write(p []byte) (int, error) {
+	if !this.failed {
+		this.failed = true
+		return 0, errors.New("write failed")
+	}
+	return this.w.Write(p)
+}
+
+func TestRecordsFlushRetry(t *testing.T) {
+	msg := test.NewPopulatedNinOptNative(rand.New(rand.NewSource(1)), true)
+	write := func(w goio.Writer) io.RecordWriter {
+		writer := io.NewRecordWriter(w, &io.RecordWriterOptions{BlockSize: 1024})
+		if err := writer.WriteMsg(msg); err != nil {
+			t.Fatal(err)
+		}
+		return writer
+	}
+	want := bytes.NewBuffer(nil)
+	if err := write(want).Close(); err != nil {
+		t.Fatal(err)
+	}
+	got := bytes.NewBuffer(nil)
+	writer := write(&failOnceWriter{w: got})
+	if err := writer.Flush(); err == nil {
+		t.Fatalf("Expected the first flush to fail")
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// A failed flush must not leave an entry in the index.
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatalf("Expected %x, got %x", want.Bytes(), got.Bytes())
+	}
+}
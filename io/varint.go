@@ -30,14 +30,15 @@ package io
 
 import (
 	"encoding/binary"
+	"fmt"
 	"github.com/dropbox/godropbox/errors"
 	"github.com/dropbox/goprotoc/proto"
 	"io"
+	"io/ioutil"
 )
 
 var (
-	errSmallBuffer = errors.New("Buffer Too Small")
-	errLargeValue  = errors.New("Value is Larger than 64 bits")
+	errLargeValue = errors.New("Value is Larger than 64 bits")
 )
 
 func NewDelimitedWriter(w io.Writer) WriteCloser {
@@ -85,38 +86,186 @@ func (this *varintWriter) Close() error {
 	return nil
 }
 
-func NewDelimitedReader(r io.Reader, maxSize int) ReadCloser {
-	return &varintReader{r, make([]byte, 10), nil, maxSize}
+// DelimitedReader reads messages which are each preceded by their length as a
+// varint, as written by NewDelimitedWriter.
+type DelimitedReader interface {
+	ReadCloser
+	// Skip discards the next message without decoding it. Messages larger
+	// than the maximum size can be skipped.
+	Skip() error
+	// Peek returns the encoded next message without consuming it. The slice
+	// is only valid until the next call to the reader.
+	Peek() ([]byte, error)
+}
+
+// TooLargeError is returned when the length prefix of a message is larger
+// than the maximum size of the reader. The message is not consumed, so it can
+// be skipped with Skip.
+type TooLargeError struct {
+	Size    uint64
+	MaxSize int
+}
+
+func (e *TooLargeError) Error() string {
+	return fmt.Sprintf("message of %d bytes exceeds the maximum size of %d bytes", e.Size, e.MaxSize)
+}
+
+// The number of consecutive reads returning no bytes and no error after which
+// a varintReader gives up with io.ErrNoProgress, like bufio.Reader.
+const maxConsecutiveEmptyReads = 100
+
+// The initial size of the buffer of a varintReader. It grows as needed to
+// hold the largest message read, up to the maximum size.
+const minReadBufferSize = 4096
+
+// NewDelimitedReader returns a reader of messages preceded by their length
+// as a varint. Messages larger than maxSize bytes are rejected with a
+// *TooLargeError. The reader is buffered, so it may read past the last
+// message it returns.
+func NewDelimitedReader(r io.Reader, maxSize int) DelimitedReader {
+	size := minReadBufferSize
+	if maxSize+binary.MaxVarintLen64 < size {
+		size = maxSize + binary.MaxVarintLen64
+	}
+	return &varintReader{r: r, buf: make([]byte, size), maxSize: maxSize}
 }
 
 type varintReader struct {
-	r       io.Reader
-	lenBuf  []byte
-	buf     []byte
-	maxSize int
+	r io.Reader
+	// The bytes read but not consumed yet are buf[start:end].
+	buf        []byte
+	start, end int
+	maxSize    int
+	// The error returned by r, which is reported once the buffered bytes
+	// are consumed.
+	err error
+}
+
+// Reads until at least n bytes are buffered, moving the buffered bytes to
+// the front of buf, or growing it, to make room. An io.EOF from the
+// underlying reader is returned as is if no bytes are buffered, and as
+// io.ErrUnexpectedEOF otherwise.
+func (this *varintReader) fill(n int) error {
+	empty := 0
+	for this.end-this.start < n {
+		if this.err != nil {
+			err := this.err
+			if err == io.EOF && this.end > this.start {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		if this.start+n > len(this.buf) {
+			buf := this.buf
+			if n > len(buf) {
+				buf = make([]byte, n)
+			}
+			this.end = copy(buf, this.buf[this.start:this.end])
+			this.start = 0
+			this.buf = buf
+		}
+		read, err := this.r.Read(this.buf[this.end:])
+		this.end += read
+		if err != nil {
+			this.err = err
+		} else if read > 0 {
+			empty = 0
+		} else {
+			empty++
+			if empty == maxConsecutiveEmptyReads {
+				this.err = io.ErrNoProgress
+			}
+		}
+	}
+	return nil
 }
 
+// Decodes the length prefix of the next message without consuming it, and
+// returns the length of the message and of the prefix.
+func (this *varintReader) peekLength() (length uint64, prefixLen int, err error) {
+	var shift uint
+	for prefixLen = 0; ; prefixLen++ {
+		if prefixLen == binary.MaxVarintLen64 {
+			return 0, 0, errLargeValue
+		}
+		if err := this.fill(prefixLen + 1); err != nil {
+			return 0, 0, err
+		}
+		b := this.buf[this.start+prefixLen]
+		if prefixLen == binary.MaxVarintLen64-1 && b > 1 {
+			return 0, 0, errLargeValue
+		}
+		length |= uint64(b&0x7f) << shift
+		shift += 7
+		if b < 0x80 {
+			return length, prefixLen + 1, nil
+		}
+	}
+}
+
+// Buffers the next message, and returns it along with the length of its
+// prefix.
+func (this *varintReader) peek() ([]byte, int, error) {
+	length, prefixLen, err := this.peekLength()
+	if err != nil {
+		return nil, 0, err
+	}
+	if length > uint64(this.maxSize) {
+		return nil, 0, &TooLargeError{Size: length, MaxSize: this.maxSize}
+	}
+	if err := this.fill(prefixLen + int(length)); err != nil {
+		return nil, 0, err
+	}
+	begin := this.start + prefixLen
+	return this.buf[begin : begin+int(length)], prefixLen, nil
+}
+
+func (this *varintReader) Peek() ([]byte, error) {
+	data, _, err := this.peek()
+	return data, err
+}
+
+// ReadMsg decodes the next message into msg. Messages with an Unmarshal
+// method decode directly from the buffer of the reader.
 func (this *varintReader) ReadMsg(msg proto.Message) error {
-	firstLen, err := this.r.Read(this.lenBuf)
+	data, prefixLen, err := this.peek()
 	if err != nil {
 		return err
 	}
-	length64, lenLen := binary.Uvarint(this.lenBuf)
-	if lenLen <= 0 {
-		if lenLen == 0 {
-			return errSmallBuffer
-		}
-		return errLargeValue
+	this.start += prefixLen + len(data)
+	if m, ok := msg.(unmarshaler); ok {
+		msg.Reset()
+		return m.Unmarshal(data)
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+func (this *varintReader) Skip() error {
+	length, prefixLen, err := this.peekLength()
+	if err != nil {
+		return err
+	}
+	this.start += prefixLen
+	if buffered := uint64(this.end - this.start); length <= buffered {
+		this.start += int(length)
+		return nil
 	}
-	msgLen := int(length64)
-	if len(this.buf) < msgLen {
-		this.buf = make([]byte, msgLen)
+	length -= uint64(this.end - this.start)
+	this.start, this.end = 0, 0
+	if this.err != nil {
+		if this.err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return this.err
 	}
-	prefixN := copy(this.buf, this.lenBuf[lenLen:firstLen])
-	if _, err := io.ReadFull(this.r, this.buf[prefixN:msgLen]); err != nil {
+	if _, err := io.CopyN(ioutil.Discard, this.r, int64(length)); err != nil {
+		this.err = err
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
 		return err
 	}
-	return proto.Unmarshal(this.buf[:msgLen], msg)
+	return nil
 }
 
 func (this *varintReader) Close() error {
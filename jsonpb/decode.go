@@ -0,0 +1,310 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/godropbox/errors"
+)
+
+var null = []byte("null")
+
+// Fields holds the members of a JSON object that is being unmarshaled into a
+// message. It is used by the generated UnmarshalJSONPB methods, which take
+// the members they know about with Get, and then call Done.
+type Fields struct {
+	u   *Unmarshaler
+	raw map[string]json.RawMessage
+}
+
+// Fields parses data, which must be a JSON object or null.
+func (u *Unmarshaler) Fields(data []byte) (*Fields, error) {
+	f := &Fields{u: u}
+	if err := json.Unmarshal(data, &f.raw); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Get removes and returns the value of a field, which may be keyed by either
+// name. A null value is treated as if the field was absent.
+func (f *Fields) Get(jsonName string, origName string) ([]byte, bool) {
+	value, ok := f.raw[jsonName]
+	delete(f.raw, jsonName)
+	if v, found := f.raw[origName]; found {
+		value, ok = v, true
+		delete(f.raw, origName)
+	}
+	if !ok || string(value) == string(null) {
+		return nil, false
+	}
+	return value, true
+}
+
+// Rest removes and returns the fields which were not consumed yet, as a JSON
+// object. It is used by messages such as google.protobuf.Any which hold
+// another message.
+func (f *Fields) Rest() []byte {
+	rest := f.raw
+	f.raw = nil
+	if len(rest) == 0 {
+		return []byte("{}")
+	}
+	data, _ := json.Marshal(rest)
+	return data
+}
+
+// Done returns an error if any fields were not consumed, unless the
+// Unmarshaler allows unknown fields.
+func (f *Fields) Done() error {
+	if f.u.AllowUnknownFields || len(f.raw) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(f.raw))
+	for name := range f.raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return errors.Newf("jsonpb: unknown fields %s", strings.Join(names, ", "))
+}
+
+// Message merges the JSON object in data into pb.
+func (u *Unmarshaler) Message(data []byte, pb Message) error {
+	return pb.UnmarshalJSONPB(u, data)
+}
+
+// Array returns the elements of a JSON array.
+func Array(data []byte) ([][]byte, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	elems := make([][]byte, len(raw))
+	for i, elem := range raw {
+		elems[i] = elem
+	}
+	return elems, nil
+}
+
+// Object returns the members of a JSON object, which is how maps are
+// represented.
+func Object(data []byte) (map[string][]byte, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	members := make(map[string][]byte, len(raw))
+	for key, value := range raw {
+		members[key] = value
+	}
+	return members, nil
+}
+
+// Key returns the map key as a JSON string, so it can be parsed with the
+// value functions below.
+func Key(key string) []byte {
+	data, _ := json.Marshal(key)
+	return data
+}
+
+// Returns the contents of a JSON string, or the literal if data is not a
+// string.
+func unquote(data []byte) (string, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	return string(data), nil
+}
+
+// Bool parses true or false, which may be quoted as for map keys.
+func Bool(data []byte) (bool, error) {
+	s, err := unquote(data)
+	if err != nil {
+		return false, err
+	}
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, errors.Newf("jsonpb: invalid bool %s", data)
+}
+
+// Parses an integer given as a JSON number or string. Numbers in exponent
+// notation are accepted as long as they are integral.
+func parseInt(data []byte, bitSize int) (int64, error) {
+	s, err := unquote(data)
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseInt(s, 10, bitSize)
+	if err == nil {
+		return v, nil
+	}
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) {
+		return 0, errors.Newf("jsonpb: invalid integer %s", data)
+	}
+	v = int64(f)
+	if float64(v) != f || (bitSize == 32 && int64(int32(v)) != v) {
+		return 0, errors.Newf("jsonpb: integer %s out of range", data)
+	}
+	return v, nil
+}
+
+func parseUint(data []byte, bitSize int) (uint64, error) {
+	s, err := unquote(data)
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseUint(s, 10, bitSize)
+	if err == nil {
+		return v, nil
+	}
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) || f < 0 {
+		return 0, errors.Newf("jsonpb: invalid unsigned integer %s", data)
+	}
+	v = uint64(f)
+	if float64(v) != f || (bitSize == 32 && uint64(uint32(v)) != v) {
+		return 0, errors.Newf("jsonpb: unsigned integer %s out of range", data)
+	}
+	return v, nil
+}
+
+func Int32(data []byte) (int32, error) {
+	v, err := parseInt(data, 32)
+	return int32(v), err
+}
+
+func Int64(data []byte) (int64, error) {
+	return parseInt(data, 64)
+}
+
+func Uint32(data []byte) (uint32, error) {
+	v, err := parseUint(data, 32)
+	return uint32(v), err
+}
+
+func Uint64(data []byte) (uint64, error) {
+	return parseUint(data, 64)
+}
+
+func parseFloat(data []byte, bitSize int) (float64, error) {
+	s, err := unquote(data)
+	if err != nil {
+		return 0, err
+	}
+	switch s {
+	case "NaN":
+		return math.NaN(), nil
+	case "Infinity":
+		return math.Inf(1), nil
+	case "-Infinity":
+		return math.Inf(-1), nil
+	}
+	v, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		return 0, errors.Newf("jsonpb: invalid number %s", data)
+	}
+	return v, nil
+}
+
+func Float32(data []byte) (float32, error) {
+	v, err := parseFloat(data, 32)
+	return float32(v), err
+}
+
+func Float64(data []byte) (float64, error) {
+	return parseFloat(data, 64)
+}
+
+func String(data []byte) (string, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// Base64 decodes a base64 string, accepting both the standard and the URL
+// alphabet, with or without padding.
+func Base64(data []byte) ([]byte, error) {
+	s, err := String(data)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	}
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// Enum parses an enum value given either by name or by number.
+func Enum(data []byte, values map[string]int32) (int32, error) {
+	if len(data) > 0 && data[0] == '"' {
+		name, err := String(data)
+		if err != nil {
+			return 0, err
+		}
+		if v, ok := values[name]; ok {
+			return v, nil
+		}
+		return 0, errors.Newf("jsonpb: unknown enum value %s", data)
+	}
+	return Int32(data)
+}
+
+// ClosedEnum parses an enum value like Enum, but also rejects numbers which
+// are not declared values of the enum, as proto2 enums are closed.
+func ClosedEnum(data []byte, values map[string]int32, names map[int32]string) (int32, error) {
+	v, err := Enum(data, values)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := names[v]; !ok {
+		return 0, errors.Newf("jsonpb: unknown enum value %s", data)
+	}
+	return v, nil
+}
+
+// Decode unmarshals data into v using encoding/json. It is used for custom
+// types.
+func Decode(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/dropbox/godropbox/errors"
+)
+
+// Writer builds the JSON representation of a message. It is used by the
+// generated MarshalJSONPB methods; the first error encountered is kept and
+// returned by Bytes.
+type Writer struct {
+	m   *Marshaler
+	buf bytes.Buffer
+	// For each open object or array, whether a value has been written to it.
+	nonEmpty []bool
+	afterKey bool
+	err      error
+}
+
+// NewWriter returns a Writer using the options of m.
+func NewWriter(m *Marshaler) *Writer {
+	return &Writer{m: m}
+}
+
+// EmitDefaults reports whether fields that are not set should be written.
+func (w *Writer) EmitDefaults() bool {
+	return w.m.EmitDefaults
+}
+
+// Bytes returns the JSON written so far.
+func (w *Writer) Bytes() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buf.Bytes(), nil
+}
+
+func (w *Writer) setErr(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// Writes the separator needed before a new value.
+func (w *Writer) beginValue() {
+	if w.afterKey {
+		w.afterKey = false
+		return
+	}
+	if n := len(w.nonEmpty); n > 0 {
+		if w.nonEmpty[n-1] {
+			w.buf.WriteByte(',')
+		}
+		w.nonEmpty[n-1] = true
+	}
+}
+
+func (w *Writer) BeginObject() {
+	w.beginValue()
+	w.buf.WriteByte('{')
+	w.nonEmpty = append(w.nonEmpty, false)
+}
+
+func (w *Writer) EndObject() {
+	w.nonEmpty = w.nonEmpty[:len(w.nonEmpty)-1]
+	w.buf.WriteByte('}')
+}
+
+func (w *Writer) BeginArray() {
+	w.beginValue()
+	w.buf.WriteByte('[')
+	w.nonEmpty = append(w.nonEmpty, false)
+}
+
+func (w *Writer) EndArray() {
+	w.nonEmpty = w.nonEmpty[:len(w.nonEmpty)-1]
+	w.buf.WriteByte(']')
+}
+
+// Field writes the key of a message field, picking the name according to the
+// OrigName option. It must be followed by exactly one value.
+func (w *Writer) Field(jsonName string, origName string) {
+	if w.m.OrigName {
+		w.Key(origName)
+	} else {
+		w.Key(jsonName)
+	}
+}
+
+// Key writes the key of an object member. It must be followed by exactly
+// one value.
+func (w *Writer) Key(key string) {
+	w.String(key)
+	w.buf.WriteByte(':')
+	w.afterKey = true
+}
+
+func (w *Writer) BoolKey(key bool) {
+	w.Key(strconv.FormatBool(key))
+}
+
+func (w *Writer) IntKey(key int64) {
+	w.Key(strconv.FormatInt(key, 10))
+}
+
+func (w *Writer) UintKey(key uint64) {
+	w.Key(strconv.FormatUint(key, 10))
+}
+
+func (w *Writer) Null() {
+	w.beginValue()
+	w.buf.WriteString("null")
+}
+
+func (w *Writer) Bool(v bool) {
+	w.beginValue()
+	w.buf.WriteString(strconv.FormatBool(v))
+}
+
+func (w *Writer) Int32(v int32) {
+	w.beginValue()
+	w.buf.WriteString(strconv.FormatInt(int64(v), 10))
+}
+
+func (w *Writer) Uint32(v uint32) {
+	w.beginValue()
+	w.buf.WriteString(strconv.FormatUint(uint64(v), 10))
+}
+
+// Int64 writes v as a string, since JSON numbers cannot represent every
+// 64 bit integer.
+func (w *Writer) Int64(v int64) {
+	w.String(strconv.FormatInt(v, 10))
+}
+
+// Uint64 writes v as a string, since JSON numbers cannot represent every
+// 64 bit integer.
+func (w *Writer) Uint64(v uint64) {
+	w.String(strconv.FormatUint(v, 10))
+}
+
+func (w *Writer) Float32(v float32) {
+	w.float(float64(v), 32)
+}
+
+func (w *Writer) Float64(v float64) {
+	w.float(v, 64)
+}
+
+func (w *Writer) float(v float64, bitSize int) {
+	switch {
+	case math.IsNaN(v):
+		w.String("NaN")
+	case math.IsInf(v, 1):
+		w.String("Infinity")
+	case math.IsInf(v, -1):
+		w.String("-Infinity")
+	default:
+		w.beginValue()
+		w.buf.WriteString(strconv.FormatFloat(v, 'g', -1, bitSize))
+	}
+}
+
+func (w *Writer) String(v string) {
+	w.beginValue()
+	data, err := json.Marshal(v)
+	if err != nil {
+		w.setErr(err)
+		return
+	}
+	w.buf.Write(data)
+}
+
+// Base64 writes v as a base64 string, using the standard encoding.
+func (w *Writer) Base64(v []byte) {
+	w.String(base64.StdEncoding.EncodeToString(v))
+}
+
+// Enum writes the name of the enum value, or its number if the value is not
+// known.
+func (w *Writer) Enum(v int32, names map[int32]string) {
+	if name, ok := names[v]; ok {
+		w.String(name)
+	} else {
+		w.Int32(v)
+	}
+}
+
+// Message writes a nested message.
+func (w *Writer) Message(v Message) {
+	if err := v.MarshalJSONPB(w); err != nil {
+		w.setErr(err)
+	}
+}
+
+// Embed writes the fields of v as if they were fields of the object being
+// written. It is used by messages such as google.protobuf.Any which hold
+// another message.
+func (w *Writer) Embed(v Message) {
+	sub := NewWriter(w.m)
+	sub.Message(v)
+	data, err := sub.Bytes()
+	if err != nil {
+		w.setErr(err)
+		return
+	}
+	if len(data) < 2 || data[0] != '{' {
+		w.setErr(errors.Newf("jsonpb: cannot embed %s, which is not an object", data))
+		return
+	}
+	if len(data) > 2 {
+		w.beginValue()
+		w.buf.Write(data[1 : len(data)-1])
+	}
+}
+
+// JSON writes v using encoding/json. It is used for custom types.
+func (w *Writer) JSON(v interface{}) {
+	w.beginValue()
+	data, err := json.Marshal(v)
+	if err != nil {
+		w.setErr(err)
+		return
+	}
+	w.buf.Write(data)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package jsonpb marshals and unmarshals protocol buffers using the canonical
+protobuf JSON mapping.
+
+The fields of the messages generated by protoc-gen-dgo are private, so this
+package does not use reflection. Instead every generated message has a
+MarshalJSONPB and an UnmarshalJSONPB method, which walk the fields using the
+Writer and Fields types of this package. The generated messages also
+implement json.Marshaler and json.Unmarshaler with the default options, so
+they can be nested in types handled by encoding/json.
+
+The mapping is:
+
+	message          object, keyed by the lowerCamelCase field names
+	                 (or the gogoproto.jsontag if it is set)
+	enum             the name of the value, or its number if it is unknown
+	map<K, V>        object, keyed by the key formatted as a string
+	repeated V       array
+	bool             true, false
+	string           string
+	bytes            base64 string
+	int32, uint32    number
+	int64, uint64    string
+	float, double    number, or "NaN", "Infinity", "-Infinity"
+
+Fields that are not set are omitted, unless EmitDefaults is set. When
+unmarshaling both the JSON name and the original proto field name are
+accepted, and numbers are accepted as either JSON numbers or strings.
+
+	m := jsonpb.Marshaler{OrigName: true}
+	s, err := m.MarshalToString(msg)
+*/
+package jsonpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+)
+
+// Message is implemented by the messages generated by protoc-gen-dgo.
+type Message interface {
+	MarshalJSONPB(w *Writer) error
+	UnmarshalJSONPB(u *Unmarshaler, data []byte) error
+}
+
+// Marshaler controls how messages are written as JSON.
+type Marshaler struct {
+	// Use the field names from the .proto file instead of lowerCamelCase.
+	OrigName bool
+	// Write fields that are not set, using their default values.
+	EmitDefaults bool
+	// A string used to indent every level of nesting. If empty, the output
+	// is compact.
+	Indent string
+}
+
+// Marshal writes the JSON representation of pb to out.
+func (m *Marshaler) Marshal(out io.Writer, pb Message) error {
+	data, err := m.MarshalBytes(pb)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// MarshalToString returns the JSON representation of pb as a string.
+func (m *Marshaler) MarshalToString(pb Message) (string, error) {
+	data, err := m.MarshalBytes(pb)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// MarshalBytes returns the JSON representation of pb.
+func (m *Marshaler) MarshalBytes(pb Message) ([]byte, error) {
+	w := NewWriter(m)
+	w.Message(pb)
+	data, err := w.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	if m.Indent == "" {
+		return data, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", m.Indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshaler controls how JSON is read into messages.
+type Unmarshaler struct {
+	// Ignore fields that are not part of the message instead of failing.
+	AllowUnknownFields bool
+}
+
+// Unmarshal reads a JSON object from in and merges it into pb.
+func (u *Unmarshaler) Unmarshal(in io.Reader, pb Message) error {
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	return pb.UnmarshalJSONPB(u, data)
+}
+
+// UnmarshalString merges the JSON object in str into pb.
+func (u *Unmarshaler) UnmarshalString(str string, pb Message) error {
+	return pb.UnmarshalJSONPB(u, []byte(str))
+}
+
+// Marshal returns the JSON representation of pb, using the default options.
+func Marshal(pb Message) ([]byte, error) {
+	return new(Marshaler).MarshalBytes(pb)
+}
+
+// Unmarshal merges the JSON object in data into pb, using the default
+// options.
+func Unmarshal(data []byte, pb Message) error {
+	return pb.UnmarshalJSONPB(new(Unmarshaler), data)
+}
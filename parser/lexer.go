@@ -0,0 +1,504 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenSymbol
+)
+
+// A token of a .proto file. Lines and columns count from 0, and a tab
+// advances the column to the next multiple of 8, like in protoc.
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	col    int
+	endCol int
+	// The comment after the previous token and the comment before this
+	// token, as defined by SourceCodeInfo.Location.
+	trailing string
+	leading  string
+}
+
+type lexer struct {
+	filename string
+	data     []byte
+	offset   int
+	line     int
+	col      int
+}
+
+func (this *lexer) errorf(format string, args ...interface{}) {
+	panic(&Error{
+		Filename: this.filename,
+		Line:     this.line + 1,
+		Column:   this.col + 1,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (this *lexer) atEnd() bool {
+	return this.offset >= len(this.data)
+}
+
+func (this *lexer) peek() byte {
+	return this.peekAt(0)
+}
+
+func (this *lexer) peekAt(i int) byte {
+	if this.offset+i < len(this.data) {
+		return this.data[this.offset+i]
+	}
+	return 0
+}
+
+func (this *lexer) advance() {
+	switch this.data[this.offset] {
+	case '\n':
+		this.line++
+		this.col = 0
+	case '\t':
+		this.col += 8 - this.col%8
+	default:
+		this.col++
+	}
+	this.offset++
+}
+
+func (this *lexer) consume(c byte) bool {
+	if !this.atEnd() && this.peek() == c {
+		this.advance()
+		return true
+	}
+	return false
+}
+
+func (this *lexer) skipSpace() {
+	for !this.atEnd() {
+		switch this.peek() {
+		case ' ', '\t', '\r', '\v', '\f':
+			this.advance()
+		default:
+			return
+		}
+	}
+}
+
+const (
+	noComment = iota
+	lineComment
+	blockComment
+)
+
+func (this *lexer) commentStart() int {
+	if this.peek() == '/' {
+		switch this.peekAt(1) {
+		case '/':
+			this.advance()
+			this.advance()
+			return lineComment
+		case '*':
+			this.advance()
+			this.advance()
+			return blockComment
+		}
+	}
+	return noComment
+}
+
+// Returns the text after "//", including the newline.
+func (this *lexer) lineComment() string {
+	start := this.offset
+	for !this.atEnd() && this.peek() != '\n' {
+		this.advance()
+	}
+	this.consume('\n')
+	return string(this.data[start:this.offset])
+}
+
+// Returns the text between "/*" and "*/", without the whitespace and the
+// asterisk at the start of each line.
+func (this *lexer) blockComment() string {
+	var text []byte
+	start := this.offset
+	for {
+		for !this.atEnd() {
+			if c := this.peek(); c == '*' || c == '/' || c == '\n' {
+				break
+			}
+			this.advance()
+		}
+		if this.consume('\n') {
+			text = append(text, this.data[start:this.offset]...)
+			this.skipSpace()
+			if this.consume('*') && this.consume('/') {
+				return string(text)
+			}
+			start = this.offset
+		} else if this.consume('*') {
+			if this.consume('/') {
+				text = append(text, this.data[start:this.offset-2]...)
+				return string(text)
+			}
+		} else if this.consume('/') {
+			if this.peek() == '*' {
+				this.errorf("\"/*\" inside block comment.  Block comments cannot be nested.")
+			}
+		} else {
+			this.errorf("End-of-file inside block comment.")
+		}
+	}
+}
+
+// Collects the comments between two tokens, like the CommentCollector of
+// protoc.
+type commentCollector struct {
+	trailing        string
+	buffer          string
+	hasComment      bool
+	isLineComment   bool
+	canAttachToPrev bool
+}
+
+func (this *commentCollector) startLineComment() {
+	if this.hasComment && !this.isLineComment {
+		this.flush()
+	}
+	this.hasComment = true
+	this.isLineComment = true
+}
+
+func (this *commentCollector) startBlockComment() {
+	if this.hasComment {
+		this.flush()
+	}
+	this.hasComment = true
+	this.isLineComment = false
+}
+
+// Attaches the buffered comment to the previous token if it still can be,
+// and otherwise drops it, as detached comments are not recorded.
+func (this *commentCollector) flush() {
+	if this.hasComment {
+		if this.canAttachToPrev {
+			this.trailing += this.buffer
+			this.canAttachToPrev = false
+		}
+		this.clear()
+	}
+}
+
+func (this *commentCollector) clear() {
+	this.buffer = ""
+	this.hasComment = false
+}
+
+// next returns the next token, with the comment after the previous token
+// and the comment before the next one.
+func (this *lexer) next(first bool) token {
+	comments := &commentCollector{}
+	if first {
+		if len(this.data) >= 3 && string(this.data[:3]) == "\xef\xbb\xbf" {
+			this.offset = 3
+		}
+	} else {
+		// A comment on the same line belongs to the previous token.
+		comments.canAttachToPrev = true
+		this.skipSpace()
+		switch this.commentStart() {
+		case lineComment:
+			comments.startLineComment()
+			comments.buffer += this.lineComment()
+			comments.flush()
+		case blockComment:
+			comments.startBlockComment()
+			comments.buffer += this.blockComment()
+			this.skipSpace()
+			if !this.consume('\n') {
+				return this.token()
+			}
+			comments.flush()
+		default:
+			if !this.consume('\n') {
+				return this.token()
+			}
+		}
+	}
+	for {
+		this.skipSpace()
+		switch this.commentStart() {
+		case lineComment:
+			comments.startLineComment()
+			comments.buffer += this.lineComment()
+		case blockComment:
+			comments.startBlockComment()
+			comments.buffer += this.blockComment()
+			this.skipSpace()
+			this.consume('\n')
+		default:
+			if this.consume('\n') {
+				comments.flush()
+				comments.canAttachToPrev = false
+				continue
+			}
+			tok := this.token()
+			if tok.kind == tokenEOF || tok.text == "}" || tok.text == "]" || tok.text == ")" {
+				// There is no declaration after the comments.
+				comments.flush()
+			}
+			tok.trailing = comments.trailing
+			if comments.hasComment {
+				tok.leading = comments.buffer
+			}
+			return tok
+		}
+	}
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isOctalDigit(c byte) bool {
+	return '0' <= c && c <= '7'
+}
+
+// Reads the token at the current offset, which is not whitespace.
+func (this *lexer) token() token {
+	tok := token{line: this.line, col: this.col}
+	start := this.offset
+	c := this.peek()
+	switch {
+	case this.atEnd():
+		tok.kind = tokenEOF
+	case isLetter(c):
+		for !this.atEnd() && (isLetter(this.peek()) || isDigit(this.peek())) {
+			this.advance()
+		}
+		tok.kind = tokenIdent
+	case isDigit(c) || c == '.' && isDigit(this.peekAt(1)):
+		tok.kind = this.number()
+	case c == '"' || c == '\'':
+		this.str(c)
+		tok.kind = tokenString
+	case c < ' ' || c >= 0x7f:
+		this.errorf("Invalid character in text: 0x%02x.", c)
+	default:
+		this.advance()
+		tok.kind = tokenSymbol
+	}
+	tok.text = string(this.data[start:this.offset])
+	tok.endCol = this.col
+	return tok
+}
+
+func (this *lexer) digits(valid func(byte) bool) {
+	for !this.atEnd() && valid(this.peek()) {
+		this.advance()
+	}
+}
+
+func (this *lexer) number() tokenKind {
+	kind := tokenInt
+	if this.peek() == '0' && (this.peekAt(1) == 'x' || this.peekAt(1) == 'X') {
+		this.advance()
+		this.advance()
+		if !isHexDigit(this.peek()) {
+			this.errorf("\"0x\" must be followed by hex digits.")
+		}
+		this.digits(isHexDigit)
+	} else if this.peek() == '0' && isDigit(this.peekAt(1)) {
+		this.advance()
+		for !this.atEnd() && isDigit(this.peek()) {
+			if !isOctalDigit(this.peek()) {
+				this.errorf("Numbers starting with leading zero must be in octal.")
+			}
+			this.advance()
+		}
+	} else {
+		this.digits(isDigit)
+		if this.consume('.') {
+			kind = tokenFloat
+			this.digits(isDigit)
+		}
+		if this.consume('e') || this.consume('E') {
+			kind = tokenFloat
+			if !this.consume('-') {
+				this.consume('+')
+			}
+			if !isDigit(this.peek()) {
+				this.errorf("\"e\" must be followed by exponent.")
+			}
+			this.digits(isDigit)
+		}
+	}
+	if isLetter(this.peek()) {
+		this.errorf("Need space between number and identifier.")
+	} else if this.peek() == '.' {
+		if kind == tokenFloat {
+			this.errorf("Already saw decimal point or exponent; can't have another one.")
+		}
+		this.errorf("Hexadecimal and octal numbers must be integers.")
+	}
+	return kind
+}
+
+func (this *lexer) str(quote byte) {
+	this.advance()
+	for {
+		if this.atEnd() {
+			this.errorf("Unexpected end of string.")
+		}
+		switch c := this.peek(); c {
+		case quote:
+			this.advance()
+			return
+		case '\n':
+			this.errorf("String literals cannot cross line boundaries.")
+		case '\\':
+			this.advance()
+			switch c := this.peek(); {
+			case isOctalDigit(c):
+				for i := 0; i < 3 && isOctalDigit(this.peek()); i++ {
+					this.advance()
+				}
+			case c == 'x' || c == 'X':
+				this.advance()
+				if !isHexDigit(this.peek()) {
+					this.errorf("Expected hex digits for escape sequence.")
+				}
+				for i := 0; i < 2 && isHexDigit(this.peek()); i++ {
+					this.advance()
+				}
+			case c == 'u' || c == 'U':
+				this.advance()
+				n := 4
+				if c == 'U' {
+					n = 8
+				}
+				for i := 0; i < n; i++ {
+					if !isHexDigit(this.peek()) {
+						this.errorf("Expected %d hex digits for unicode escape sequence.", n)
+					}
+					this.advance()
+				}
+			case c != 0 && len(simpleEscapes[c]) > 0:
+				this.advance()
+			default:
+				this.errorf("Invalid escape sequence in string literal.")
+			}
+		default:
+			this.advance()
+		}
+	}
+}
+
+var simpleEscapes = map[byte]string{
+	'a':  "\a",
+	'b':  "\b",
+	'f':  "\f",
+	'n':  "\n",
+	'r':  "\r",
+	't':  "\t",
+	'v':  "\v",
+	'\\': "\\",
+	'?':  "?",
+	'\'': "'",
+	'"':  "\"",
+}
+
+// unquote returns the value of a string token, which the lexer has
+// validated.
+func unquote(text string) string {
+	text = text[1 : len(text)-1]
+	value := make([]byte, 0, len(text))
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' {
+			value = append(value, text[i])
+			continue
+		}
+		i++
+		switch c := text[i]; {
+		case isOctalDigit(c):
+			j := i
+			for j < len(text) && j < i+3 && isOctalDigit(text[j]) {
+				j++
+			}
+			n, _ := strconv.ParseUint(text[i:j], 8, 16)
+			value = append(value, byte(n))
+			i = j - 1
+		case c == 'x' || c == 'X':
+			j := i + 1
+			for j < len(text) && j < i+3 && isHexDigit(text[j]) {
+				j++
+			}
+			n, _ := strconv.ParseUint(text[i+1:j], 16, 8)
+			value = append(value, byte(n))
+			i = j - 1
+		case c == 'u' || c == 'U':
+			n := 4
+			if c == 'U' {
+				n = 8
+			}
+			r, _ := strconv.ParseUint(text[i+1:i+1+n], 16, 32)
+			i += n
+			if 0xd800 <= r && r < 0xdc00 && i+6 < len(text) && text[i+1:i+3] == "\\u" {
+				// A surrogate pair.
+				if low, err := strconv.ParseUint(text[i+3:i+7], 16, 32); err == nil && 0xdc00 <= low && low < 0xe000 {
+					r = 0x10000 + (r-0xd800)<<10 + low - 0xdc00
+					i += 6
+				}
+			}
+			var buf [utf8.UTFMax]byte
+			value = append(value, buf[:utf8.EncodeRune(buf[:], rune(r))]...)
+		default:
+			value = append(value, simpleEscapes[c]...)
+		}
+	}
+	return string(value)
+}
@@ -0,0 +1,701 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+type symbolKind int
+
+const (
+	packageSymbol symbolKind = iota
+	messageSymbol
+	enumSymbol
+	enumValueSymbol
+	fieldSymbol
+	oneofSymbol
+	serviceSymbol
+	methodSymbol
+)
+
+// A named element of the parsed files.
+type symbol struct {
+	kind  symbolKind
+	file  *file
+	path  []int32
+	msg   *descriptor.DescriptorProto
+	enum  *descriptor.EnumDescriptorProto
+	field *descriptor.FieldDescriptorProto
+	// The enum of an enum value.
+	parent string
+}
+
+func (this *symbol) isType() bool {
+	return this.kind == messageSymbol || this.kind == enumSymbol
+}
+
+// A symbol which can contain other symbols.
+func (this *symbol) isAggregate() bool {
+	switch this.kind {
+	case packageSymbol, messageSymbol, enumSymbol, serviceSymbol:
+		return true
+	}
+	return false
+}
+
+// The linker resolves the names in the parsed files, checks them and
+// interprets their options, like the DescriptorBuilder of protoc.
+type linker struct {
+	paths   []string
+	files   map[string]*file
+	loading []string
+	// The files in the order they were loaded, each after its imports.
+	order   []*file
+	symbols map[string]*symbol
+	// The full names of the messages and enums.
+	names map[interface{}]string
+	errs  ErrorList
+}
+
+func newLinker(paths []string) *linker {
+	return &linker{
+		paths:   paths,
+		files:   make(map[string]*file),
+		symbols: make(map[string]*symbol),
+		names:   make(map[interface{}]string),
+	}
+}
+
+// Records an error at the location of path in f.
+func (this *linker) errorf(f *file, path []int32, format string, args ...interface{}) {
+	err := &Error{Filename: f.name, Msg: fmt.Sprintf(format, args...)}
+	for n := len(path); n >= 0; n-- {
+		if loc := f.locs[pathKey(path[:n])]; loc != nil {
+			err.Line = int(loc.Span[0]) + 1
+			err.Column = int(loc.Span[1]) + 1
+			break
+		}
+	}
+	this.errs = append(this.errs, err)
+}
+
+func (this *linker) errorAt(f *file, tok *token, format string, args ...interface{}) {
+	this.errs = append(this.errs, &Error{
+		Filename: f.name,
+		Line:     tok.line + 1,
+		Column:   tok.col + 1,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (this *linker) link() {
+	for _, f := range this.order {
+		f.visible[f] = true
+		for _, dep := range f.deps {
+			dep.addPublic(f.visible)
+		}
+	}
+	for _, f := range this.order {
+		this.addSymbols(f)
+	}
+	if len(this.errs) > 0 {
+		return
+	}
+	for _, f := range this.order {
+		this.resolveFile(f)
+	}
+	if len(this.errs) > 0 {
+		return
+	}
+	for _, f := range this.order {
+		this.checkFile(f)
+	}
+	for _, f := range this.order {
+		this.interpretFile(f)
+	}
+	if len(this.errs) > 0 {
+		return
+	}
+	for _, f := range this.order {
+		this.checkOptions(f)
+	}
+}
+
+// Adds this file and the files it imports publicly to visible.
+func (this *file) addPublic(visible map[*file]bool) {
+	if visible[this] {
+		return
+	}
+	visible[this] = true
+	for _, i := range this.desc.PublicDependency {
+		if int(i) < len(this.deps) {
+			this.deps[i].addPublic(visible)
+		}
+	}
+}
+
+func join(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+func (this *linker) addSymbol(f *file, scope, name string, sym *symbol) bool {
+	full := join(scope, name)
+	if other := this.symbols[full]; other != nil {
+		path := appendPath(sym.path, 1)
+		switch {
+		case other.file != f:
+			this.errorf(f, path, "\"%s\" is already defined in file \"%s\".", full, other.file.name)
+		case scope == "":
+			this.errorf(f, path, "\"%s\" is already defined.", full)
+		default:
+			this.errorf(f, path, "\"%s\" is already defined in \"%s\".", name, scope)
+		}
+		return false
+	}
+	sym.file = f
+	this.symbols[full] = sym
+	return true
+}
+
+func (this *linker) addSymbols(f *file) {
+	pkg := f.desc.GetPackage()
+	if pkg != "" {
+		parts := strings.Split(pkg, ".")
+		for i := range parts {
+			name := strings.Join(parts[:i+1], ".")
+			if other := this.symbols[name]; other == nil {
+				this.symbols[name] = &symbol{kind: packageSymbol, file: f}
+			} else if other.kind != packageSymbol {
+				this.errorf(f, []int32{filePackageTag}, "\"%s\" is already defined (as something other than a package) in file \"%s\".", name, other.file.name)
+				break
+			}
+		}
+	}
+	for i, msg := range f.desc.MessageType {
+		this.addMessage(f, pkg, msg, []int32{fileMessageTypeTag, int32(i)})
+	}
+	for i, enum := range f.desc.EnumType {
+		this.addEnum(f, pkg, enum, []int32{fileEnumTypeTag, int32(i)})
+	}
+	for i, field := range f.desc.Extension {
+		this.addSymbol(f, pkg, field.GetName(), &symbol{kind: fieldSymbol, field: field, path: []int32{fileExtensionTag, int32(i)}})
+	}
+	for i, service := range f.desc.Service {
+		path := []int32{fileServiceTag, int32(i)}
+		if !this.addSymbol(f, pkg, service.GetName(), &symbol{kind: serviceSymbol, path: path}) {
+			continue
+		}
+		name := join(pkg, service.GetName())
+		for j, method := range service.Method {
+			this.addSymbol(f, name, method.GetName(), &symbol{kind: methodSymbol, path: appendPath(path, serviceMethodTag, int32(j))})
+		}
+	}
+}
+
+func (this *linker) addMessage(f *file, scope string, msg *descriptor.DescriptorProto, path []int32) {
+	if !this.addSymbol(f, scope, msg.GetName(), &symbol{kind: messageSymbol, msg: msg, path: path}) {
+		return
+	}
+	name := join(scope, msg.GetName())
+	this.names[msg] = name
+	for i, field := range msg.Field {
+		this.addSymbol(f, name, field.GetName(), &symbol{kind: fieldSymbol, field: field, path: appendPath(path, messageFieldTag, int32(i))})
+	}
+	for i, oneof := range msg.OneofDecl {
+		this.addSymbol(f, name, oneof.GetName(), &symbol{kind: oneofSymbol, path: appendPath(path, messageOneofTag, int32(i))})
+	}
+	for i, nested := range msg.NestedType {
+		this.addMessage(f, name, nested, appendPath(path, messageNestedTypeTag, int32(i)))
+	}
+	for i, enum := range msg.EnumType {
+		this.addEnum(f, name, enum, appendPath(path, messageEnumTypeTag, int32(i)))
+	}
+	for i, field := range msg.Extension {
+		this.addSymbol(f, name, field.GetName(), &symbol{kind: fieldSymbol, field: field, path: appendPath(path, messageExtensionTag, int32(i))})
+	}
+}
+
+func (this *linker) addEnum(f *file, scope string, enum *descriptor.EnumDescriptorProto, path []int32) {
+	if !this.addSymbol(f, scope, enum.GetName(), &symbol{kind: enumSymbol, enum: enum, path: path}) {
+		return
+	}
+	name := join(scope, enum.GetName())
+	this.names[enum] = name
+	inner := make(map[string]bool)
+	for i, value := range enum.Value {
+		// Enum values are siblings of their enum, like in C++.
+		valuePath := appendPath(path, enumValueTag, int32(i))
+		duplicate := inner[value.GetName()]
+		inner[value.GetName()] = true
+		if !this.addSymbol(f, scope, value.GetName(), &symbol{kind: enumValueSymbol, path: valuePath, parent: name}) && !duplicate {
+			outer := "the global scope"
+			if scope != "" {
+				outer = "\"" + scope + "\""
+			}
+			this.errorf(f, appendPath(valuePath, enumValueNameTag), "Note that enum values use C++ scoping rules, meaning that enum values are siblings of their type, not children of it.  Therefore, \"%s\" must be unique within %s, not just within \"%s\".", value.GetName(), outer, enum.GetName())
+		}
+	}
+}
+
+// Returns the symbol with the full name name if f can use it, and otherwise
+// the symbol in a file f does not import.
+func (this *linker) findSymbol(f *file, name string) (sym *symbol, hidden *symbol) {
+	sym = this.symbols[name]
+	if sym == nil || sym.kind == packageSymbol || f.visible[sym.file] {
+		return sym, nil
+	}
+	return nil, sym
+}
+
+// Looks up name in the scope of the element relativeTo, searching the
+// innermost scope first, like protoc. It returns the full name of the
+// symbol, or the error if there is no such symbol.
+func (this *linker) lookup(f *file, name, relativeTo string, typesOnly bool) (string, *symbol, string) {
+	if strings.HasPrefix(name, ".") {
+		sym, hidden := this.findSymbol(f, name[1:])
+		if sym == nil {
+			return "", nil, this.notFound(f, name, "", hidden)
+		}
+		return name[1:], sym, ""
+	}
+	first := name
+	if i := strings.Index(name, "."); i >= 0 {
+		first = name[:i]
+	}
+	scope := relativeTo
+	var hidden *symbol
+	for {
+		i := strings.LastIndex(scope, ".")
+		if i < 0 {
+			sym, h := this.findSymbol(f, name)
+			if sym == nil {
+				if h != nil {
+					hidden = h
+				}
+				return "", nil, this.notFound(f, name, "", hidden)
+			}
+			return name, sym, ""
+		}
+		scope = scope[:i]
+		sym, h := this.findSymbol(f, scope+"."+first)
+		if h != nil && hidden == nil {
+			hidden = h
+		}
+		if sym == nil {
+			continue
+		}
+		if first != name {
+			if sym.isAggregate() {
+				full := scope + "." + name
+				sym, h := this.findSymbol(f, full)
+				if sym == nil {
+					if h != nil {
+						return "", nil, this.notFound(f, name, "", h)
+					}
+					return "", nil, this.notFound(f, name, full, nil)
+				}
+				return full, sym, ""
+			}
+		} else if !typesOnly || sym.isType() {
+			return scope + "." + name, sym, ""
+		}
+	}
+}
+
+func (this *linker) notFound(f *file, name, resolved string, hidden *symbol) string {
+	switch {
+	case hidden != nil:
+		return fmt.Sprintf("\"%s\" seems to be defined in \"%s\", which is not imported by \"%s\".  To use it here, please add the necessary import.", name, hidden.file.name, f.name)
+	case resolved != "":
+		return fmt.Sprintf("\"%s\" is resolved to \"%s\", which is not defined. The innermost scope is searched first in name resolution. Consider using a leading '.'(i.e., \".%s\") to start from the outermost scope.", name, resolved, name)
+	}
+	return fmt.Sprintf("\"%s\" is not defined.", name)
+}
+
+// Like lookup, but records the error at path.
+func (this *linker) resolve(f *file, name, relativeTo string, typesOnly bool, path []int32) (string, *symbol) {
+	full, sym, err := this.lookup(f, name, relativeTo, typesOnly)
+	if sym == nil {
+		this.errorf(f, path, "%s", err)
+	}
+	return full, sym
+}
+
+func (this *linker) resolveFile(f *file) {
+	pkg := f.desc.GetPackage()
+	for i, msg := range f.desc.MessageType {
+		this.resolveMessage(f, pkg, msg, []int32{fileMessageTypeTag, int32(i)})
+	}
+	for i, field := range f.desc.Extension {
+		this.resolveField(f, pkg, field, []int32{fileExtensionTag, int32(i)})
+	}
+	for i, service := range f.desc.Service {
+		name := join(pkg, service.GetName())
+		for j, method := range service.Method {
+			path := []int32{fileServiceTag, int32(i), serviceMethodTag, int32(j)}
+			relativeTo := join(name, method.GetName())
+			method.InputType = this.resolveMessageType(f, method.GetInputType(), relativeTo, appendPath(path, methodInputTypeTag))
+			method.OutputType = this.resolveMessageType(f, method.GetOutputType(), relativeTo, appendPath(path, methodOutputTypeTag))
+		}
+	}
+}
+
+func (this *linker) resolveMessageType(f *file, name, relativeTo string, path []int32) *string {
+	full, sym := this.resolve(f, name, relativeTo, false, path)
+	if sym == nil {
+		return proto.String(name)
+	}
+	if sym.kind != messageSymbol {
+		this.errorf(f, path, "\"%s\" is not a message type.", name)
+		return proto.String(name)
+	}
+	return proto.String("." + full)
+}
+
+func (this *linker) resolveMessage(f *file, scope string, msg *descriptor.DescriptorProto, path []int32) {
+	name := join(scope, msg.GetName())
+	for i, field := range msg.Field {
+		this.resolveField(f, name, field, appendPath(path, messageFieldTag, int32(i)))
+	}
+	for i, field := range msg.Extension {
+		this.resolveField(f, name, field, appendPath(path, messageExtensionTag, int32(i)))
+	}
+	for i, nested := range msg.NestedType {
+		this.resolveMessage(f, name, nested, appendPath(path, messageNestedTypeTag, int32(i)))
+	}
+}
+
+func (this *linker) resolveField(f *file, scope string, field *descriptor.FieldDescriptorProto, path []int32) {
+	relativeTo := join(scope, field.GetName())
+	if field.Extendee != nil {
+		field.Extendee = this.resolveMessageType(f, field.GetExtendee(), relativeTo, appendPath(path, fieldExtendeeTag))
+	}
+	if field.TypeName == nil {
+		return
+	}
+	typePath := appendPath(path, fieldTypeNameTag)
+	full, sym := this.resolve(f, field.GetTypeName(), relativeTo, true, typePath)
+	if sym == nil {
+		return
+	}
+	switch {
+	case !sym.isType():
+		this.errorf(f, typePath, "\"%s\" is not a type.", field.GetTypeName())
+		return
+	case field.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP:
+		if sym.kind != messageSymbol {
+			this.errorf(f, typePath, "\"%s\" is not a message type.", field.GetTypeName())
+			return
+		}
+	case sym.kind == messageSymbol:
+		field.Type = descriptor.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	default:
+		field.Type = descriptor.FieldDescriptorProto_TYPE_ENUM.Enum()
+	}
+	field.TypeName = proto.String("." + full)
+}
+
+// Returns the message or enum with the full name name, with a leading dot.
+func (this *linker) lookupType(name string) *symbol {
+	return this.symbols[strings.TrimPrefix(name, ".")]
+}
+
+func (this *linker) checkFile(f *file) {
+	pkg := f.desc.GetPackage()
+	for i, msg := range f.desc.MessageType {
+		this.checkMessage(f, pkg, msg, []int32{fileMessageTypeTag, int32(i)})
+	}
+	for i, enum := range f.desc.EnumType {
+		this.checkEnum(f, pkg, enum, []int32{fileEnumTypeTag, int32(i)})
+	}
+	for i, field := range f.desc.Extension {
+		this.checkField(f, pkg, field, []int32{fileExtensionTag, int32(i)})
+	}
+}
+
+func (this *linker) checkMessage(f *file, scope string, msg *descriptor.DescriptorProto, path []int32) {
+	name := join(scope, msg.GetName())
+	numbers := make(map[int32]*descriptor.FieldDescriptorProto)
+	for i, field := range msg.Field {
+		fieldPath := appendPath(path, messageFieldTag, int32(i))
+		this.checkField(f, name, field, fieldPath)
+		if other := numbers[field.GetNumber()]; other != nil {
+			this.errorf(f, appendPath(fieldPath, fieldNumberTag), "Field number %d has already been used in \"%s\" by field \"%s\".", field.GetNumber(), name, other.GetName())
+		} else {
+			numbers[field.GetNumber()] = field
+		}
+		for _, r := range f.reserved[msg] {
+			if r.name == "" && r.start <= int64(field.GetNumber()) && int64(field.GetNumber()) <= r.end {
+				this.errorf(f, appendPath(fieldPath, fieldNumberTag), "Field \"%s\" uses reserved number %d.", field.GetName(), field.GetNumber())
+			} else if r.name != "" && r.name == field.GetName() {
+				this.errorf(f, appendPath(fieldPath, fieldNameTag), "Field name \"%s\" is reserved.", field.GetName())
+			}
+		}
+	}
+	for i, r := range msg.ExtensionRange {
+		rangePath := appendPath(path, messageExtensionRangeTag, int32(i))
+		start, end := r.GetStart(), r.GetEnd()
+		switch {
+		case start <= 0:
+			this.errorf(f, rangePath, "Extension numbers must be positive integers.")
+		case end > maxFieldNumber+1 && !(end == math.MaxInt32 && isMessageSet(msg)):
+			this.errorf(f, rangePath, "Extension numbers cannot be greater than %d.", maxFieldNumber)
+		case start >= end:
+			this.errorf(f, rangePath, "Extension range end number must be greater than start number.")
+		}
+		for _, field := range msg.Field {
+			if start <= field.GetNumber() && field.GetNumber() < end {
+				this.errorf(f, rangePath, "Extension range %d to %d includes field \"%s\" (%d).", start, end-1, field.GetName(), field.GetNumber())
+			}
+		}
+		for _, other := range msg.ExtensionRange[:i] {
+			if start < other.GetEnd() && other.GetStart() < end {
+				this.errorf(f, rangePath, "Extension range %d to %d overlaps with already-defined range %d to %d.", start, end-1, other.GetStart(), other.GetEnd()-1)
+			}
+		}
+		for _, res := range f.reserved[msg] {
+			if res.name == "" && int64(start) <= res.end && res.start < int64(end) {
+				this.errorf(f, rangePath, "Extension range %d to %d overlaps with reserved range %d to %d.", start, end-1, res.start, res.end)
+			}
+		}
+		if f.proto3 {
+			this.errorf(f, rangePath, "Extension ranges are not allowed in proto3.")
+		}
+	}
+	this.checkReserved(f, msg)
+	for i, oneof := range msg.OneofDecl {
+		empty := true
+		for _, field := range msg.Field {
+			if field.OneofIndex != nil && field.GetOneofIndex() == int32(i) {
+				empty = false
+			}
+		}
+		if empty {
+			this.errorf(f, appendPath(path, messageOneofTag, int32(i)), "Oneof \"%s\" must have at least one field.", oneof.GetName())
+		}
+	}
+	for i, field := range msg.Extension {
+		this.checkField(f, name, field, appendPath(path, messageExtensionTag, int32(i)))
+	}
+	for i, nested := range msg.NestedType {
+		this.checkMessage(f, name, nested, appendPath(path, messageNestedTypeTag, int32(i)))
+	}
+	for i, enum := range msg.EnumType {
+		this.checkEnum(f, name, enum, appendPath(path, messageEnumTypeTag, int32(i)))
+	}
+	if f.mapEntries[msg] {
+		this.checkMapEntry(f, msg, path)
+	}
+}
+
+func (this *linker) checkReserved(f *file, elem interface{}) {
+	ranges := f.reserved[elem]
+	for i, r := range ranges {
+		for _, other := range ranges[:i] {
+			if r.name != "" && r.name == other.name {
+				this.errorAt(f, &r.tok, "Field name \"%s\" is reserved multiple times.", r.name)
+			} else if r.name == "" && other.name == "" && r.start <= other.end && other.start <= r.end {
+				this.errorAt(f, &r.tok, "Reserved range %d to %d overlaps with already-defined range %d to %d.", r.start, r.end, other.start, other.end)
+			}
+		}
+	}
+}
+
+func (this *linker) checkMapEntry(f *file, entry *descriptor.DescriptorProto, path []int32) {
+	key := entry.Field[0]
+	switch key.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		this.errorf(f, path, "Key in map fields cannot be float/double, bytes or message types.")
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		this.errorf(f, path, "Key in map fields cannot be enum types.")
+	}
+	if entry.Field[1].GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP {
+		this.errorf(f, path, "Map values cannot be groups.")
+	}
+}
+
+func (this *linker) checkField(f *file, scope string, field *descriptor.FieldDescriptorProto, path []int32) {
+	numberPath := appendPath(path, fieldNumberTag)
+	switch number := field.GetNumber(); {
+	case number <= 0:
+		this.errorf(f, numberPath, "Field numbers must be positive integers.")
+	case number > maxFieldNumber:
+		this.errorf(f, numberPath, "Field numbers cannot be greater than %d.", maxFieldNumber)
+	case 19000 <= number && number <= 19999:
+		this.errorf(f, numberPath, "Field numbers 19000 through 19999 are reserved for the protocol buffer library implementation.")
+	}
+
+	if field.Extendee != nil {
+		extendee := this.lookupType(field.GetExtendee())
+		declared := false
+		for _, r := range extendee.msg.ExtensionRange {
+			if r.GetStart() <= field.GetNumber() && field.GetNumber() < r.GetEnd() {
+				declared = true
+			}
+		}
+		if !declared {
+			this.errorf(f, numberPath, "\"%s\" does not declare %d as an extension number.", field.GetExtendee()[1:], field.GetNumber())
+		}
+		if f.proto3 && !strings.HasPrefix(field.GetExtendee(), ".google.protobuf.") {
+			this.errorf(f, appendPath(path, fieldExtendeeTag), "Extensions in proto3 are only allowed for defining options.")
+		}
+	}
+
+	if field.DefaultValue != nil {
+		defaultPath := appendPath(path, fieldDefaultTag)
+		switch {
+		case f.proto3:
+			this.errorf(f, defaultPath, "Explicit default values are not allowed in proto3.")
+		case field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED:
+			this.errorf(f, defaultPath, "Repeated fields can't have default values.")
+		case field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE || field.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP:
+			this.errorf(f, defaultPath, "Messages can't have default values.")
+		case field.GetType() == descriptor.FieldDescriptorProto_TYPE_ENUM:
+			enum := this.lookupType(field.GetTypeName()).enum
+			found := false
+			for _, value := range enum.Value {
+				found = found || value.GetName() == field.GetDefaultValue()
+			}
+			if !found {
+				this.errorf(f, defaultPath, "Enum type \"%s\" has no value named \"%s\".", field.GetTypeName()[1:], field.GetDefaultValue())
+			}
+		}
+	}
+
+	if f.proto3 {
+		switch {
+		case field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED:
+			this.errorf(f, appendPath(path, fieldLabelTag), "Required fields are not allowed in proto3.")
+		case field.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP:
+			this.errorf(f, appendPath(path, fieldTypeTag), "Groups are not supported in proto3 syntax.")
+		case field.GetType() == descriptor.FieldDescriptorProto_TYPE_ENUM:
+			enum := this.lookupType(field.GetTypeName())
+			if !enum.file.proto3 {
+				this.errorf(f, appendPath(path, fieldTypeNameTag), "Enum type \"%s\" is not a proto3 enum, but is used in \"%s\" which is a proto3 message type.", field.GetTypeName()[1:], scope)
+			}
+		}
+	}
+}
+
+func (this *linker) checkEnum(f *file, scope string, enum *descriptor.EnumDescriptorProto, path []int32) {
+	if len(enum.Value) == 0 {
+		this.errorf(f, path, "Enums must contain at least one value.")
+		return
+	}
+	if f.proto3 && enum.Value[0].GetNumber() != 0 {
+		this.errorf(f, appendPath(path, enumValueTag, 0, enumValueNumberTag), "The first enum value must be zero in proto3.")
+	}
+	for i, value := range enum.Value {
+		for _, r := range f.reserved[enum] {
+			valuePath := appendPath(path, enumValueTag, int32(i))
+			if r.name == "" && r.start <= int64(value.GetNumber()) && int64(value.GetNumber()) <= r.end {
+				this.errorf(f, appendPath(valuePath, enumValueNumberTag), "Enum value \"%s\" uses reserved number %d.", value.GetName(), value.GetNumber())
+			} else if r.name != "" && r.name == value.GetName() {
+				this.errorf(f, appendPath(valuePath, enumValueNameTag), "Enum value \"%s\" is reserved.", value.GetName())
+			}
+		}
+	}
+	this.checkReserved(f, enum)
+}
+
+// Checks the elements whose rules depend on their options.
+func (this *linker) checkOptions(f *file) {
+	pkg := f.desc.GetPackage()
+	var checkMessage func(scope string, msg *descriptor.DescriptorProto, path []int32)
+	checkField := func(field *descriptor.FieldDescriptorProto, path []int32) {
+		if !field.GetOptions().GetPacked() {
+			return
+		}
+		switch field.GetType() {
+		case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES,
+			descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		default:
+			if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+				return
+			}
+		}
+		this.errorf(f, appendPath(path, fieldOptionsTag), "[packed = true] can only be specified for repeated primitive fields.")
+	}
+	checkMessage = func(scope string, msg *descriptor.DescriptorProto, path []int32) {
+		name := join(scope, msg.GetName())
+		if msg.GetOptions().GetMapEntry() && !f.mapEntries[msg] {
+			this.errorf(f, appendPath(path, messageOptionsTag), "map_entry should not be set explicitly. Use map<KeyType, ValueType> instead.")
+		}
+		for i, field := range msg.Field {
+			checkField(field, appendPath(path, messageFieldTag, int32(i)))
+		}
+		for i, field := range msg.Extension {
+			checkField(field, appendPath(path, messageExtensionTag, int32(i)))
+		}
+		for i, nested := range msg.NestedType {
+			checkMessage(name, nested, appendPath(path, messageNestedTypeTag, int32(i)))
+		}
+		for i, enum := range msg.EnumType {
+			this.checkAliases(f, name, enum, appendPath(path, messageEnumTypeTag, int32(i)))
+		}
+	}
+	for i, msg := range f.desc.MessageType {
+		checkMessage(pkg, msg, []int32{fileMessageTypeTag, int32(i)})
+	}
+	for i, field := range f.desc.Extension {
+		checkField(field, []int32{fileExtensionTag, int32(i)})
+	}
+	for i, enum := range f.desc.EnumType {
+		this.checkAliases(f, pkg, enum, []int32{fileEnumTypeTag, int32(i)})
+	}
+}
+
+func (this *linker) checkAliases(f *file, scope string, enum *descriptor.EnumDescriptorProto, path []int32) {
+	opts := enum.GetOptions()
+	allowAlias := opts != nil && opts.AllowAlias != nil && opts.GetAllowAlias()
+	numbers := make(map[int32]string)
+	aliases := false
+	for i, value := range enum.Value {
+		other, ok := numbers[value.GetNumber()]
+		if !ok {
+			numbers[value.GetNumber()] = value.GetName()
+			continue
+		}
+		aliases = true
+		if !allowAlias {
+			this.errorf(f, appendPath(path, enumValueTag, int32(i), enumValueNumberTag), "\"%s\" uses the same enum value as \"%s\". If this is intended, set 'option allow_alias = true;' to the enum definition.", join(scope, value.GetName()), join(scope, other))
+		}
+	}
+	if !aliases && allowAlias {
+		this.errorf(f, path, "\"%s\" declares support for enum aliases but no enum values share field numbers. Please remove the unnecessary 'option allow_alias = true;' declaration.", join(scope, enum.GetName()))
+	}
+}
@@ -0,0 +1,732 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// The options messages, whose fields are set from the Go types of the
+// descriptor package, so that they are known even when descriptor.proto is
+// not parsed.
+var optionsTypes = map[string]reflect.Type{
+	"google.protobuf.FileOptions":      reflect.TypeOf(descriptor.FileOptions{}),
+	"google.protobuf.MessageOptions":   reflect.TypeOf(descriptor.MessageOptions{}),
+	"google.protobuf.FieldOptions":     reflect.TypeOf(descriptor.FieldOptions{}),
+	"google.protobuf.EnumOptions":      reflect.TypeOf(descriptor.EnumOptions{}),
+	"google.protobuf.EnumValueOptions": reflect.TypeOf(descriptor.EnumValueOptions{}),
+	"google.protobuf.ServiceOptions":   reflect.TypeOf(descriptor.ServiceOptions{}),
+	"google.protobuf.MethodOptions":    reflect.TypeOf(descriptor.MethodOptions{}),
+}
+
+var optionsEnums = map[string]map[string]int32{
+	"google.protobuf.FileOptions_OptimizeMode": descriptor.FileOptions_OptimizeMode_value,
+	"google.protobuf.FieldOptions_CType":       descriptor.FieldOptions_CType_value,
+}
+
+// A message whose fields can be set by options: an options message, or a
+// message of the parsed files used as the type of a custom option.
+type optionMessage struct {
+	name   string
+	goType reflect.Type
+	desc   *descriptor.DescriptorProto
+}
+
+// A field which can be set by an option.
+type optionField struct {
+	fullName string
+	name     string
+	number   int32
+	repeated bool
+	typ      descriptor.FieldDescriptorProto_Type
+	// The full name and values of an enum field.
+	enumName   string
+	enumValues map[string]int32
+	// The type of a message or group field.
+	msg *optionMessage
+}
+
+func (this *linker) optionMessage(name string) *optionMessage {
+	if t, ok := optionsTypes[name]; ok {
+		return &optionMessage{name: name, goType: t}
+	}
+	if sym := this.symbols[name]; sym != nil && sym.kind == messageSymbol {
+		return &optionMessage{name: name, desc: sym.msg}
+	}
+	return nil
+}
+
+// Returns the field of msg called name, or nil.
+func (this *linker) optionFieldByName(msg *optionMessage, name string) *optionField {
+	if msg.goType == nil {
+		for _, field := range msg.desc.Field {
+			if field.GetName() == name {
+				return this.optionField(msg.name, field)
+			}
+		}
+		// A group is named after its type in the text format.
+		for _, field := range msg.desc.Field {
+			if field.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP && strings.HasSuffix(field.GetTypeName(), "."+name) {
+				return this.optionField(msg.name, field)
+			}
+		}
+		return nil
+	}
+	for _, prop := range proto.GetProperties(msg.goType).Prop {
+		if prop.OrigName != name || prop.Tag == uninterpretedOptionTag {
+			continue
+		}
+		field := &optionField{
+			fullName: msg.name + "." + name,
+			name:     name,
+			number:   int32(prop.Tag),
+			repeated: prop.Repeated,
+		}
+		switch {
+		case prop.Enum != "":
+			field.typ = descriptor.FieldDescriptorProto_TYPE_ENUM
+			field.enumName = strings.Replace(prop.Enum, "_", ".", -1)
+			field.enumValues = optionsEnums[prop.Enum]
+		case prop.Wire == "bytes":
+			field.typ = descriptor.FieldDescriptorProto_TYPE_STRING
+		default:
+			field.typ = descriptor.FieldDescriptorProto_TYPE_BOOL
+		}
+		return field
+	}
+	return nil
+}
+
+func (this *linker) optionField(scope string, field *descriptor.FieldDescriptorProto) *optionField {
+	result := &optionField{
+		fullName: join(scope, field.GetName()),
+		name:     field.GetName(),
+		number:   field.GetNumber(),
+		repeated: field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED,
+		typ:      field.GetType(),
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		result.enumName = field.GetTypeName()[1:]
+		result.enumValues = make(map[string]int32)
+		for _, value := range this.lookupType(field.GetTypeName()).enum.Value {
+			result.enumValues[value.GetName()] = value.GetNumber()
+		}
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		result.msg = this.optionMessage(field.GetTypeName()[1:])
+	}
+	return result
+}
+
+// Returns the extension of msg called name, looked up in scope, or the
+// error.
+func (this *linker) optionExtension(f *file, msg *optionMessage, name, scope string) (*optionField, string) {
+	full, sym, _ := this.lookup(f, name, scope, false)
+	if sym == nil || sym.kind != fieldSymbol || sym.field.Extendee == nil {
+		return nil, ""
+	}
+	if sym.field.GetExtendee() != "."+msg.name {
+		return nil, fmt.Sprintf("\"%s\" is not a field or extension of message \"%s\".", full, msg.name)
+	}
+	field := this.optionField("", sym.field)
+	field.fullName = full
+	return field, ""
+}
+
+// Interprets the options of every element of f, and updates the paths of
+// the locations of the options like protoc.
+func (this *linker) interpretFile(f *file) {
+	interpreter := &optionsInterpreter{
+		linker:      this,
+		file:        f,
+		interpreted: make(map[string][]int32),
+	}
+	pkg := f.desc.GetPackage()
+	if opts := f.desc.Options; opts != nil {
+		// Like protoc, extensions are looked up from the scope of the
+		// parent of the element, which is the package for the file.
+		interpreter.interpret(opts, &opts.UninterpretedOption, "google.protobuf.FileOptions", join(pkg, "dummy"), []int32{fileOptionsTag})
+	}
+	for i, msg := range f.desc.MessageType {
+		interpreter.message(pkg, msg, []int32{fileMessageTypeTag, int32(i)})
+	}
+	for i, enum := range f.desc.EnumType {
+		interpreter.enum(pkg, enum, []int32{fileEnumTypeTag, int32(i)})
+	}
+	for i, field := range f.desc.Extension {
+		interpreter.field(pkg, field, []int32{fileExtensionTag, int32(i)})
+	}
+	for i, service := range f.desc.Service {
+		path := []int32{fileServiceTag, int32(i)}
+		name := join(pkg, service.GetName())
+		if opts := service.Options; opts != nil {
+			interpreter.interpret(opts, &opts.UninterpretedOption, "google.protobuf.ServiceOptions", name, appendPath(path, serviceOptionsTag))
+		}
+		for j, method := range service.Method {
+			if opts := method.Options; opts != nil {
+				interpreter.interpret(opts, &opts.UninterpretedOption, "google.protobuf.MethodOptions", join(name, method.GetName()), appendPath(path, serviceMethodTag, int32(j), methodOptionsTag))
+			}
+		}
+	}
+	if len(interpreter.interpreted) == 0 {
+		return
+	}
+
+	// Each location of an option gets the path of the field it sets, and the
+	// locations within it are removed.
+	var locs []*descriptor.SourceCodeInfo_Location
+	var removed []int32
+	for _, loc := range f.desc.SourceCodeInfo.Location {
+		if removed != nil && len(loc.Path) >= len(removed) && pathKey(loc.Path[:len(removed)]) == pathKey(removed) {
+			continue
+		}
+		removed = nil
+		if path, ok := interpreter.interpreted[pathKey(loc.Path)]; ok {
+			removed = loc.Path
+			loc = proto.Clone(loc).(*descriptor.SourceCodeInfo_Location)
+			loc.Path = path
+		}
+		locs = append(locs, loc)
+	}
+	f.desc.SourceCodeInfo.Location = locs
+}
+
+type optionsInterpreter struct {
+	*linker
+	file *file
+	// The paths of the fields set by the options, by the paths of the
+	// options.
+	interpreted map[string][]int32
+}
+
+func (this *optionsInterpreter) message(scope string, msg *descriptor.DescriptorProto, path []int32) {
+	name := join(scope, msg.GetName())
+	if opts := msg.Options; opts != nil {
+		this.interpret(opts, &opts.UninterpretedOption, "google.protobuf.MessageOptions", name, appendPath(path, messageOptionsTag))
+	}
+	for i, field := range msg.Field {
+		this.field(name, field, appendPath(path, messageFieldTag, int32(i)))
+	}
+	for i, nested := range msg.NestedType {
+		this.message(name, nested, appendPath(path, messageNestedTypeTag, int32(i)))
+	}
+	for i, enum := range msg.EnumType {
+		this.enum(name, enum, appendPath(path, messageEnumTypeTag, int32(i)))
+	}
+	for i, field := range msg.Extension {
+		this.field(name, field, appendPath(path, messageExtensionTag, int32(i)))
+	}
+}
+
+func (this *optionsInterpreter) field(scope string, field *descriptor.FieldDescriptorProto, path []int32) {
+	if opts := field.Options; opts != nil {
+		this.interpret(opts, &opts.UninterpretedOption, "google.protobuf.FieldOptions", join(scope, field.GetName()), appendPath(path, fieldOptionsTag))
+	}
+}
+
+func (this *optionsInterpreter) enum(scope string, enum *descriptor.EnumDescriptorProto, path []int32) {
+	if opts := enum.Options; opts != nil {
+		this.interpret(opts, &opts.UninterpretedOption, "google.protobuf.EnumOptions", join(scope, enum.GetName()), appendPath(path, enumOptionsTag))
+	}
+	for i, value := range enum.Value {
+		if opts := value.Options; opts != nil {
+			this.interpret(opts, &opts.UninterpretedOption, "google.protobuf.EnumValueOptions", join(scope, value.GetName()), appendPath(path, enumValueTag, int32(i), enumValueOptionsTag))
+		}
+	}
+}
+
+// Sets the fields of opts from its uninterpreted options. The extensions
+// they name are looked up in scope, and path is the location of opts.
+func (this *optionsInterpreter) interpret(opts proto.Message, uninterpreted *[]*descriptor.UninterpretedOption, msgName, scope string, path []int32) {
+	if len(*uninterpreted) == 0 {
+		return
+	}
+	msg := this.optionMessage(msgName)
+	var data []byte
+	set := make(map[string]bool)
+	counts := make(map[string]int32)
+	for i, opt := range *uninterpreted {
+		optPath := appendPath(path, uninterpretedOptionTag, int32(i))
+		namePath := appendPath(optPath, optionNameTag)
+		destPath := appendPath(path)
+		current := msg
+		var fields []*optionField
+		name := ""
+		for j, part := range opt.Name {
+			if j > 0 {
+				name += "."
+			}
+			var field *optionField
+			if part.GetIsExtension() {
+				name += "(" + part.GetNamePart() + ")"
+				var err string
+				if field, err = this.optionExtension(this.file, current, part.GetNamePart(), scope); err != "" {
+					this.errorf(this.file, namePath, "Option field \"%s\" is not a field or extension of message \"%s\".", name, current.name)
+					break
+				}
+			} else {
+				name += part.GetNamePart()
+				if part.GetNamePart() == "uninterpreted_option" {
+					this.errorf(this.file, namePath, "Option must not use reserved name \"uninterpreted_option\".")
+					break
+				}
+				field = this.optionFieldByName(current, part.GetNamePart())
+			}
+			if field == nil {
+				this.errorf(this.file, namePath, "Option \"%s\" unknown.", name)
+				break
+			}
+			destPath = append(destPath, field.number)
+			fields = append(fields, field)
+			if j == len(opt.Name)-1 {
+				break
+			}
+			if field.msg == nil {
+				this.errorf(this.file, namePath, "Option \"%s\" is an atomic type, not a message.", name)
+				fields = nil
+				break
+			}
+			if field.repeated {
+				this.errorf(this.file, namePath, "Option field \"%s\" is a repeated message. Repeated message options must be initialized using an aggregate value.", name)
+				fields = nil
+				break
+			}
+			current = field.msg
+		}
+		if len(fields) != len(opt.Name) {
+			continue
+		}
+
+		leaf := fields[len(fields)-1]
+		key := pathKey(destPath)
+		if leaf.repeated {
+			destPath = append(destPath, counts[key])
+			counts[key]++
+		} else if set[key] {
+			this.errorf(this.file, namePath, "Option \"%s\" was already set.", name)
+			continue
+		}
+		set[key] = true
+		value, err := this.encodeOption(leaf, opt, scope)
+		if err != "" {
+			this.errorf(this.file, optionValuePath(optPath, opt), "%s", err)
+			continue
+		}
+		for j := len(fields) - 2; j >= 0; j-- {
+			value = encodeMessage(nil, fields[j], value)
+		}
+		data = append(data, value...)
+		this.interpreted[pathKey(optPath)] = destPath
+	}
+	*uninterpreted = nil
+	base, err := proto.Marshal(opts)
+	if err == nil {
+		err = proto.Unmarshal(append(base, data...), opts)
+	}
+	if err != nil {
+		this.errorf(this.file, path, "%v", err)
+	}
+}
+
+// Returns the path of the location of the value of opt.
+func optionValuePath(path []int32, opt *descriptor.UninterpretedOption) []int32 {
+	switch {
+	case opt.IdentifierValue != nil:
+		return appendPath(path, optionIdentifierTag)
+	case opt.PositiveIntValue != nil:
+		return appendPath(path, optionPositiveIntTag)
+	case opt.NegativeIntValue != nil:
+		return appendPath(path, optionNegativeIntTag)
+	case opt.DoubleValue != nil:
+		return appendPath(path, optionDoubleTag)
+	case opt.StringValue != nil:
+		return appendPath(path, optionStringTag)
+	}
+	return appendPath(path, optionAggregateTag)
+}
+
+// Returns the encoding of field set to the value of opt, or the error.
+func (this *optionsInterpreter) encodeOption(field *optionField, opt *descriptor.UninterpretedOption, scope string) ([]byte, string) {
+	if field.msg == nil {
+		return encodeScalar(nil, field, opt)
+	}
+	if opt.AggregateValue == nil {
+		return nil, fmt.Sprintf("Option \"%s\" is a message. To set the entire message, use syntax like \"%s = { <proto text format> }\". To set fields within it, use syntax like \"%s.foo = value\".", field.fullName, field.name, field.name)
+	}
+	body, err := this.parseAggregate(field.msg, opt.GetAggregateValue(), scope)
+	if err != "" {
+		return nil, fmt.Sprintf("Error while parsing option value for \"%s\": %s", field.name, err)
+	}
+	return encodeMessage(nil, field, body), ""
+}
+
+func appendTag(data []byte, number int32, wireType int) []byte {
+	return append(data, proto.EncodeVarint(uint64(number)<<3|uint64(wireType))...)
+}
+
+func appendFixed32(data []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(data, buf[:]...)
+}
+
+func appendFixed64(data []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(data, buf[:]...)
+}
+
+// Appends the message or group field with the encoded fields body.
+func encodeMessage(data []byte, field *optionField, body []byte) []byte {
+	if field.typ == descriptor.FieldDescriptorProto_TYPE_GROUP {
+		data = appendTag(data, field.number, proto.WireStartGroup)
+		data = append(data, body...)
+		return appendTag(data, field.number, proto.WireEndGroup)
+	}
+	data = appendTag(data, field.number, proto.WireBytes)
+	data = append(data, proto.EncodeVarint(uint64(len(body)))...)
+	return append(data, body...)
+}
+
+// Appends the scalar field set to the value of opt, or returns the error.
+func encodeScalar(data []byte, field *optionField, opt *descriptor.UninterpretedOption) ([]byte, string) {
+	switch field.typ {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		typeName, min, max := "int64", int64(math.MinInt64), uint64(math.MaxInt64)
+		if is32Bit(field.typ) {
+			typeName, min, max = "int32", math.MinInt32, math.MaxInt32
+		}
+		var n int64
+		switch {
+		case opt.PositiveIntValue != nil:
+			if opt.GetPositiveIntValue() > max {
+				return nil, fmt.Sprintf("Value out of range for %s option \"%s\".", typeName, field.fullName)
+			}
+			n = int64(opt.GetPositiveIntValue())
+		case opt.NegativeIntValue != nil:
+			if opt.GetNegativeIntValue() < min {
+				return nil, fmt.Sprintf("Value out of range for %s option \"%s\".", typeName, field.fullName)
+			}
+			n = opt.GetNegativeIntValue()
+		default:
+			return nil, fmt.Sprintf("Value must be integer for %s option \"%s\".", typeName, field.fullName)
+		}
+		switch field.typ {
+		case descriptor.FieldDescriptorProto_TYPE_SINT32:
+			data = appendTag(data, field.number, proto.WireVarint)
+			return append(data, proto.EncodeVarint(uint64(uint32(n<<1)^uint32(n>>31)))...), ""
+		case descriptor.FieldDescriptorProto_TYPE_SINT64:
+			data = appendTag(data, field.number, proto.WireVarint)
+			return append(data, proto.EncodeVarint(uint64(n<<1)^uint64(n>>63))...), ""
+		case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+			return appendFixed32(appendTag(data, field.number, proto.WireFixed32), uint32(n)), ""
+		case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+			return appendFixed64(appendTag(data, field.number, proto.WireFixed64), uint64(n)), ""
+		}
+		data = appendTag(data, field.number, proto.WireVarint)
+		return append(data, proto.EncodeVarint(uint64(n))...), ""
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		typeName, max := "uint64", uint64(math.MaxUint64)
+		if is32Bit(field.typ) {
+			typeName, max = "uint32", math.MaxUint32
+		}
+		if opt.PositiveIntValue == nil {
+			return nil, fmt.Sprintf("Value must be non-negative integer for %s option \"%s\".", typeName, field.fullName)
+		}
+		n := opt.GetPositiveIntValue()
+		if n > max {
+			return nil, fmt.Sprintf("Value out of range for %s option \"%s\".", typeName, field.fullName)
+		}
+		switch field.typ {
+		case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+			return appendFixed32(appendTag(data, field.number, proto.WireFixed32), uint32(n)), ""
+		case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+			return appendFixed64(appendTag(data, field.number, proto.WireFixed64), n), ""
+		}
+		data = appendTag(data, field.number, proto.WireVarint)
+		return append(data, proto.EncodeVarint(n)...), ""
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		var value float64
+		switch {
+		case opt.DoubleValue != nil:
+			value = opt.GetDoubleValue()
+		case opt.PositiveIntValue != nil:
+			value = float64(opt.GetPositiveIntValue())
+		case opt.NegativeIntValue != nil:
+			value = float64(opt.GetNegativeIntValue())
+		case opt.GetIdentifierValue() == "inf":
+			value = math.Inf(1)
+		case opt.GetIdentifierValue() == "nan":
+			value = math.NaN()
+		default:
+			typeName := "double"
+			if field.typ == descriptor.FieldDescriptorProto_TYPE_FLOAT {
+				typeName = "float"
+			}
+			return nil, fmt.Sprintf("Value must be number for %s option \"%s\".", typeName, field.fullName)
+		}
+		if field.typ == descriptor.FieldDescriptorProto_TYPE_FLOAT {
+			return appendFixed32(appendTag(data, field.number, proto.WireFixed32), math.Float32bits(float32(value))), ""
+		}
+		return appendFixed64(appendTag(data, field.number, proto.WireFixed64), math.Float64bits(value)), ""
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if opt.IdentifierValue == nil {
+			return nil, fmt.Sprintf("Value must be identifier for boolean option \"%s\".", field.fullName)
+		}
+		var n uint64
+		switch opt.GetIdentifierValue() {
+		case "true":
+			n = 1
+		case "false":
+		default:
+			return nil, fmt.Sprintf("Value must be \"true\" or \"false\" for boolean option \"%s\".", field.fullName)
+		}
+		return append(appendTag(data, field.number, proto.WireVarint), byte(n)), ""
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if opt.IdentifierValue == nil {
+			return nil, fmt.Sprintf("Value must be identifier for enum-valued option \"%s\".", field.fullName)
+		}
+		n, ok := field.enumValues[opt.GetIdentifierValue()]
+		if !ok {
+			return nil, fmt.Sprintf("Enum type \"%s\" has no value named \"%s\" for option \"%s\".", field.enumName, opt.GetIdentifierValue(), field.fullName)
+		}
+		data = appendTag(data, field.number, proto.WireVarint)
+		return append(data, proto.EncodeVarint(uint64(int64(n)))...), ""
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES:
+		if opt.StringValue == nil {
+			return nil, fmt.Sprintf("Value must be quoted string for string option \"%s\".", field.fullName)
+		}
+		data = appendTag(data, field.number, proto.WireBytes)
+		data = append(data, proto.EncodeVarint(uint64(len(opt.StringValue)))...)
+		return append(data, opt.StringValue...), ""
+	}
+	return nil, fmt.Sprintf("Option \"%s\" has an unsupported type.", field.fullName)
+}
+
+// An error in an aggregate option value.
+type aggregateError string
+
+// Parses an aggregate option value, which is a message in the text format,
+// and returns its encoding or the error.
+func (this *optionsInterpreter) parseAggregate(msg *optionMessage, value, scope string) (data []byte, err string) {
+	parser := &aggregateParser{optionsInterpreter: this, scope: scope}
+	defer func() {
+		if r := recover(); r != nil {
+			switch e := r.(type) {
+			case aggregateError:
+				err = string(e)
+			case *Error:
+				err = e.Msg
+			default:
+				panic(r)
+			}
+		}
+	}()
+	lex := &lexer{data: []byte(value)}
+	for {
+		tok := lex.next(len(parser.tokens) == 0)
+		parser.tokens = append(parser.tokens, tok)
+		if tok.kind == tokenEOF {
+			break
+		}
+	}
+	return parser.parseFields(msg, ""), ""
+}
+
+type aggregateParser struct {
+	*optionsInterpreter
+	scope  string
+	tokens []token
+	index  int
+}
+
+func (this *aggregateParser) tok() *token {
+	return &this.tokens[this.index]
+}
+
+func (this *aggregateParser) next() {
+	if this.index < len(this.tokens)-1 {
+		this.index++
+	}
+}
+
+func (this *aggregateParser) errorf(format string, args ...interface{}) {
+	panic(aggregateError(fmt.Sprintf("%d:%d: ", this.tok().line+1, this.tok().col+1) + fmt.Sprintf(format, args...)))
+}
+
+func (this *aggregateParser) tryConsume(text string) bool {
+	if this.tok().kind != tokenString && this.tok().text == text {
+		this.next()
+		return true
+	}
+	return false
+}
+
+func (this *aggregateParser) consume(text string) {
+	if !this.tryConsume(text) {
+		this.errorf("Expected \"%s\", found \"%s\".", text, this.tok().text)
+	}
+}
+
+func (this *aggregateParser) consumeIdent() string {
+	if this.tok().kind != tokenIdent {
+		this.errorf("Expected identifier, got: %s", this.tok().text)
+	}
+	text := this.tok().text
+	this.next()
+	return text
+}
+
+// Parses the fields of msg up to end, which is empty at the end of the
+// value.
+func (this *aggregateParser) parseFields(msg *optionMessage, end string) []byte {
+	var data []byte
+	for {
+		if end == "" && this.tok().kind == tokenEOF {
+			return data
+		}
+		if end != "" && this.tryConsume(end) {
+			return data
+		}
+		if this.tok().kind == tokenEOF {
+			this.errorf("Expected \"%s\".", end)
+		}
+		var field *optionField
+		if this.tryConsume("[") {
+			name := this.consumeIdent()
+			for this.tryConsume(".") {
+				name += "." + this.consumeIdent()
+			}
+			this.consume("]")
+			field, _ = this.optionExtension(this.file, msg, name, this.scope)
+			if field == nil {
+				this.errorf("Extension \"%s\" is not defined or is not an extension of \"%s\".", name, msg.name)
+			}
+		} else {
+			name := this.consumeIdent()
+			if field = this.optionFieldByName(msg, name); field == nil {
+				this.errorf("Message type \"%s\" has no field named \"%s\".", msg.name, name)
+			}
+		}
+		if field.msg != nil {
+			this.tryConsume(":")
+		} else {
+			this.consume(":")
+		}
+		if field.repeated && this.tryConsume("[") {
+			for !this.tryConsume("]") {
+				data = this.parseValue(data, field)
+				if this.tok().text != "]" {
+					this.consume(",")
+				}
+			}
+		} else {
+			data = this.parseValue(data, field)
+		}
+		if !this.tryConsume(";") {
+			this.tryConsume(",")
+		}
+	}
+}
+
+func (this *aggregateParser) parseValue(data []byte, field *optionField) []byte {
+	if field.msg != nil {
+		end := "}"
+		if this.tryConsume("<") {
+			end = ">"
+		} else {
+			this.consume("{")
+		}
+		return encodeMessage(data, field, this.parseFields(field.msg, end))
+	}
+	opt := &descriptor.UninterpretedOption{}
+	negative := this.tryConsume("-")
+	tok := this.tok()
+	switch tok.kind {
+	case tokenInt:
+		n, err := strconv.ParseUint(tok.text, 0, 64)
+		if err != nil {
+			this.errorf("Integer out of range (%s)", tok.text)
+		}
+		if negative {
+			if n > math.MaxInt64+1 {
+				this.errorf("Integer out of range (-%s)", tok.text)
+			}
+			opt.NegativeIntValue = proto.Int64(-int64(n))
+		} else {
+			opt.PositiveIntValue = proto.Uint64(n)
+		}
+	case tokenFloat:
+		value, _ := strconv.ParseFloat(tok.text, 64)
+		if negative {
+			value = -value
+		}
+		opt.DoubleValue = proto.Float64(value)
+	case tokenIdent:
+		if negative {
+			if tok.text != "inf" && tok.text != "nan" {
+				this.errorf("Invalid '-' symbol before identifier.")
+			}
+			opt.DoubleValue = proto.Float64(math.Inf(-1))
+			if tok.text == "nan" {
+				opt.DoubleValue = proto.Float64(math.NaN())
+			}
+		} else {
+			opt.IdentifierValue = proto.String(tok.text)
+		}
+	case tokenString:
+		value := ""
+		for this.tok().kind == tokenString {
+			value += unquote(this.tok().text)
+			this.next()
+		}
+		opt.StringValue = []byte(value)
+		data, err := encodeScalar(data, field, opt)
+		if err != "" {
+			this.errorf("%s", err)
+		}
+		return data
+	default:
+		this.errorf("Expected value for field \"%s\", found \"%s\".", field.name, tok.text)
+	}
+	this.next()
+	data, err := encodeScalar(data, field, opt)
+	if err != "" {
+		this.errorf("%s", err)
+	}
+	return data
+}
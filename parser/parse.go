@@ -29,43 +29,194 @@
 package parser
 
 import (
-	"github.com/dropbox/goprotoc/proto"
-	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
-	"os/exec"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
+
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
 )
 
-type errCmd struct {
-	output []byte
-	err    error
+// Error is an error in a .proto file. Line and Column count from 1, and are
+// 0 for errors about the whole file.
+type Error struct {
+	Filename string
+	Line     int
+	Column   int
+	Msg      string
+}
+
+func (this *Error) Error() string {
+	if this.Line == 0 {
+		return fmt.Sprintf("%s: %s", this.Filename, this.Msg)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", this.Filename, this.Line, this.Column, this.Msg)
 }
 
-func (this *errCmd) Error() string {
-	return this.err.Error() + ":" + string(this.output)
+// ErrorList is the error returned by ParseFile when the files are not
+// valid, with every error found.
+type ErrorList []*Error
+
+func (this ErrorList) Error() string {
+	msgs := make([]string, len(this))
+	for i, err := range this {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
 }
 
+// ParseFile parses filename and the files it imports, which are looked up
+// in paths like the --proto_path flags of protoc, and returns their
+// descriptors, each file after the files it imports. The names of the files
+// in the descriptors are relative to the path they were found in. If the
+// files are not valid, the error is an ErrorList.
 func ParseFile(filename string, paths ...string) (*descriptor.FileDescriptorSet, error) {
-	return parseFile(filename, false, true, paths...)
+	return parseFile([]string{filename}, false, true, paths...)
 }
 
-func parseFile(filename string, includeSourceInfo bool, includeImports bool, paths ...string) (*descriptor.FileDescriptorSet, error) {
-	args := []string{"--proto_path=" + strings.Join(paths, ":")}
-	if includeSourceInfo {
-		args = append(args, "--include_source_info")
+// ParseFiles is like ParseFile for several files, but keeps the source code
+// info of the descriptors, like protoc gives its plugins.
+func ParseFiles(filenames []string, paths ...string) (*descriptor.FileDescriptorSet, error) {
+	return parseFile(filenames, true, true, paths...)
+}
+
+func parseFile(filenames []string, includeSourceInfo bool, includeImports bool, paths ...string) (*descriptor.FileDescriptorSet, error) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	linker := newLinker(paths)
+	var roots []*file
+	for _, filename := range filenames {
+		name, err := RelativeName(filename, paths...)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, linker.load(name, nil, nil))
+	}
+	if len(linker.errs) == 0 {
+		linker.link()
 	}
+	if len(linker.errs) > 0 {
+		return nil, linker.errs
+	}
+	files := roots
 	if includeImports {
-		args = append(args, "--include_imports")
+		files = linker.order
+	}
+	set := &descriptor.FileDescriptorSet{}
+	for _, f := range files {
+		if !includeSourceInfo {
+			f.desc.SourceCodeInfo = nil
+		}
+		set.File = append(set.File, f.desc)
+	}
+	return set, nil
+}
+
+// RelativeName returns the name of filename in the descriptors returned by
+// ParseFile, which is relative to the path it is in, or filename itself if it
+// does not exist, in which case it is looked up in the paths.
+func RelativeName(filename string, paths ...string) (string, error) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return filepath.ToSlash(filepath.Clean(filename)), nil
+	}
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return "", err
+	}
+	for _, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+		if rel, err := filepath.Rel(absPath, abs); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+	return "", &Error{
+		Filename: filename,
+		Msg:      "File does not reside within any of the import paths.",
+	}
+}
+
+// A parsed .proto file.
+type file struct {
+	*parser
+	name string
+	desc *descriptor.FileDescriptorProto
+	// The location of every path, for the errors of the linker.
+	locs map[string]*descriptor.SourceCodeInfo_Location
+	deps []*file
+	// The files whose symbols this file can use: itself, the files it
+	// imports and the files they import publicly.
+	visible map[*file]bool
+}
+
+// Loads and parses the file name, and the files it imports. The import
+// statement at path of from is reported as the location of the errors.
+func (this *linker) load(name string, from *file, path []int32) *file {
+	if f, ok := this.files[name]; ok {
+		if f == nil {
+			cycle := append(append([]string(nil), this.loading...), name)
+			this.errorf(from, path, "File recursively imports itself: %s", strings.Join(cycle, " -> "))
+		}
+		return f
+	}
+	var data []byte
+	err := os.ErrNotExist
+	for _, dir := range this.paths {
+		if data, err = ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name))); err == nil {
+			break
+		}
 	}
-	args = append(args, "--descriptor_set_out=/dev/stdout")
-	args = append(args, filename)
-	cmd := exec.Command("protoc", args...)
-	data, err := cmd.CombinedOutput()
 	if err != nil {
-		return nil, &errCmd{data, err}
+		if from == nil {
+			this.errs = append(this.errs, &Error{Filename: name, Msg: "File not found."})
+		} else {
+			this.errorf(from, path, "Import \"%s\" was not found.", name)
+		}
+		return nil
 	}
-	fileDesc := &descriptor.FileDescriptorSet{}
-	if err := proto.Unmarshal(data, fileDesc); err != nil {
-		return nil, err
+	parser := newParser(name, data)
+	if err := parser.parse(); err != nil {
+		this.errs = append(this.errs, err.(*Error))
+		return nil
+	}
+	f := &file{
+		parser:  parser,
+		name:    name,
+		desc:    parser.file,
+		locs:    make(map[string]*descriptor.SourceCodeInfo_Location),
+		visible: make(map[*file]bool),
+	}
+	for _, loc := range parser.locs {
+		if key := pathKey(loc.Path); f.locs[key] == nil {
+			f.locs[key] = loc
+		}
+	}
+
+	this.files[name] = nil
+	this.loading = append(this.loading, name)
+	seen := make(map[string]bool)
+	for i, dep := range f.desc.Dependency {
+		path := []int32{fileDependencyTag, int32(i)}
+		if seen[dep] {
+			this.errorf(f, path, "Import \"%s\" was listed twice.", dep)
+			continue
+		}
+		seen[dep] = true
+		if depFile := this.load(dep, f, path); depFile != nil {
+			f.deps = append(f.deps, depFile)
+		} else {
+			f.deps = append(f.deps, nil)
+		}
 	}
-	return fileDesc, nil
+	this.loading = this.loading[:len(this.loading)-1]
+	this.files[name] = f
+	this.order = append(this.order, f)
+	return f
 }
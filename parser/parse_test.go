@@ -29,12 +29,200 @@
 package parser
 
 import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/dropbox/goprotoc/gogoproto"
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
 )
 
+// Writes the files to a temporary directory, and returns it.
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "parser")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
 func TestParse(t *testing.T) {
 	_, err := ParseFile("../protobuf/google/protobuf/descriptor.proto", "../protobuf/")
 	if err != nil {
 		panic(err)
 	}
 }
+
+func TestParseImports(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.proto": `package a;
+import public "b.proto";
+message A { optional b.B b = 1; optional c.C c = 2; }`,
+		"b.proto": `package b;
+import "c.proto";
+message B {}`,
+		"c.proto": `syntax = "proto3";
+package c;
+message C {}`,
+	})
+	defer os.RemoveAll(dir)
+	_, err := ParseFile("a.proto", dir)
+	want := "a.proto:3:42: \"c.C\" seems to be defined in \"c.proto\", which is not imported by \"a.proto\".  To use it here, please add the necessary import."
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected error %q, got %v", want, err)
+	}
+	set, err := ParseFile("b.proto", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, file := range set.File {
+		names = append(names, file.GetName())
+	}
+	if !reflect.DeepEqual(names, []string{"c.proto", "b.proto"}) {
+		t.Fatalf("expected the imports before the file, got %v", names)
+	}
+	if set.File[0].GetSyntax() != "proto3" || set.File[1].Syntax != nil {
+		t.Fatalf("wrong syntax %q and %q", set.File[0].GetSyntax(), set.File[1].GetSyntax())
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		errs   []string
+	}{
+		{"message M { optional int32 x = 1 }", []string{
+			"1:34: Expected \";\".",
+		}},
+		{"message M {\n  optional int32 x = 1;\n  optional string y = 1;\n}", []string{
+			"3:23: Field number 1 has already been used in \"M\" by field \"x\".",
+		}},
+		{"syntax = \"proto3\";\nmessage M { required int32 x = 1; }\nenum E { A = 1; }", []string{
+			"2:13: Required fields are not allowed in proto3.",
+			"3:14: The first enum value must be zero in proto3.",
+		}},
+		{"message M { optional N n = 1; }", []string{
+			"1:22: \"N\" is not defined.",
+		}},
+		{"message M { option (x) = 1; }", []string{
+			"1:20: Option \"(x)\" unknown.",
+		}},
+		{"enum E { A = 0; B = 0; }", []string{
+			"1:21: \"B\" uses the same enum value as \"A\". If this is intended, set 'option allow_alias = true;' to the enum definition.",
+		}},
+		{"message M { optional string s = 1 [default = 1]; }", []string{
+			"1:46: Expected string for field default value.",
+		}},
+	}
+	for _, test := range tests {
+		dir := writeFiles(t, map[string]string{"a.proto": test.source})
+		_, err := ParseFile("a.proto", dir)
+		os.RemoveAll(dir)
+		errs, ok := err.(ErrorList)
+		if !ok {
+			t.Errorf("%q: expected an ErrorList, got %v", test.source, err)
+			continue
+		}
+		msgs := []string{}
+		for _, err := range errs {
+			msgs = append(msgs, strings.TrimPrefix(err.Error(), "a.proto:"))
+		}
+		if !reflect.DeepEqual(msgs, test.errs) {
+			t.Errorf("%q: expected errors %q, got %q", test.source, test.errs, msgs)
+		}
+	}
+}
+
+func TestParseOptions(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.proto": `package a;
+import "github.com/dropbox/goprotoc/gogoproto/gogo.proto";
+option (gogoproto.marshaler_all) = true;
+option optimize_for = SPEED;
+message M {
+  option (gogoproto.stringer) = false;
+  optional M m = 1 [(gogoproto.nullable) = false, (gogoproto.customname) = "Sub", packed = false];
+  map<string, int64> counts = 2;
+}`,
+	})
+	defer os.RemoveAll(dir)
+	pkg, err := build.Import("github.com/dropbox/goprotoc/gogoproto", "", build.FindOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := ParseFile("a.proto", dir, pkg.SrcRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := set.File[len(set.File)-1]
+	if !gogoproto.IsMarshaler(file, file.MessageType[0]) {
+		t.Errorf("expected marshaler_all to be set")
+	}
+	if file.GetOptions().GetOptimizeFor() != descriptor.FileOptions_SPEED {
+		t.Errorf("expected optimize_for = SPEED, got %v", file.GetOptions().GetOptimizeFor())
+	}
+	msg := file.MessageType[0]
+	if gogoproto.IsStringer(file, msg) {
+		t.Errorf("expected stringer to be false")
+	}
+	field := msg.Field[0]
+	if proto.GetBoolExtension(field.Options, gogoproto.E_Nullable, true) || gogoproto.GetCustomName(field) != "Sub" || field.GetOptions().Packed == nil {
+		t.Errorf("wrong field options %v", proto.CompactTextString(field.Options))
+	}
+	if len(msg.NestedType) != 1 || msg.NestedType[0].GetName() != "CountsEntry" || !msg.NestedType[0].GetOptions().GetMapEntry() {
+		t.Fatalf("expected a map entry, got %v", msg.NestedType)
+	}
+	if field := msg.Field[1]; field.GetTypeName() != ".a.M.CountsEntry" || field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		t.Errorf("wrong map field %v", proto.CompactTextString(field))
+	}
+}
+
+func TestParseSourceInfo(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.proto": `// Detached.
+
+// Leading.
+message M {
+  optional int32 x = 1; // Trailing.
+  /* Block
+   * comment. */
+  option (y) = 2;
+}
+import "google/protobuf/descriptor.proto";
+extend google.protobuf.MessageOptions { optional int32 y = 50000; }
+`,
+	})
+	defer os.RemoveAll(dir)
+	set, err := parseFile([]string{"a.proto"}, true, false, dir, "../protobuf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	locs := make(map[string]*descriptor.SourceCodeInfo_Location)
+	for _, loc := range set.File[0].SourceCodeInfo.Location {
+		locs[pathKey(loc.Path)] = loc
+	}
+	check := func(path []int32, span []int32, leading, trailing string) {
+		loc := locs[pathKey(path)]
+		if loc == nil {
+			t.Errorf("no location for %v", path)
+			return
+		}
+		if !reflect.DeepEqual(loc.Span, span) || loc.GetLeadingComments() != leading || loc.GetTrailingComments() != trailing {
+			t.Errorf("location %v: got span %v, comments %q and %q", path, loc.Span, loc.GetLeadingComments(), loc.GetTrailingComments())
+		}
+	}
+	check([]int32{fileMessageTypeTag, 0}, []int32{3, 0, 8, 1}, " Leading.\n", "")
+	check([]int32{fileMessageTypeTag, 0, messageFieldTag, 0}, []int32{4, 2, 23}, "", " Trailing.\n")
+	check([]int32{fileMessageTypeTag, 0, messageOptionsTag, 50000}, []int32{7, 2, 17}, " Block\n comment. ", "")
+}
@@ -0,0 +1,1251 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// The field numbers of the descriptor messages, used in the paths of the
+// source code locations.
+const (
+	fileMessageTypeTag = 4
+	fileEnumTypeTag    = 5
+	fileServiceTag     = 6
+	fileExtensionTag   = 7
+	fileOptionsTag     = 8
+	filePackageTag     = 2
+	fileDependencyTag  = 3
+	filePublicDepTag   = 10
+	fileWeakDepTag     = 11
+	fileSyntaxTag      = 12
+
+	messageNameTag           = 1
+	messageFieldTag          = 2
+	messageNestedTypeTag     = 3
+	messageEnumTypeTag       = 4
+	messageExtensionRangeTag = 5
+	messageExtensionTag      = 6
+	messageOptionsTag        = 7
+	messageOneofTag          = 8
+
+	fieldNameTag     = 1
+	fieldExtendeeTag = 2
+	fieldNumberTag   = 3
+	fieldLabelTag    = 4
+	fieldTypeTag     = 5
+	fieldTypeNameTag = 6
+	fieldDefaultTag  = 7
+	fieldOptionsTag  = 8
+
+	oneofNameTag = 1
+
+	extensionRangeStartTag = 1
+	extensionRangeEndTag   = 2
+
+	enumNameTag    = 1
+	enumValueTag   = 2
+	enumOptionsTag = 3
+
+	enumValueNameTag    = 1
+	enumValueNumberTag  = 2
+	enumValueOptionsTag = 3
+
+	serviceNameTag    = 1
+	serviceMethodTag  = 2
+	serviceOptionsTag = 3
+
+	methodNameTag       = 1
+	methodInputTypeTag  = 2
+	methodOutputTypeTag = 3
+	methodOptionsTag    = 4
+
+	uninterpretedOptionTag = 999
+	optionNameTag          = 2
+	optionNamePartTag      = 1
+	optionIdentifierTag    = 3
+	optionPositiveIntTag   = 4
+	optionNegativeIntTag   = 5
+	optionDoubleTag        = 6
+	optionStringTag        = 7
+	optionAggregateTag     = 8
+)
+
+const maxFieldNumber = 536870911
+
+// The end of an extension range up to max, until the message is parsed.
+const maxRange = -1
+
+var scalarTypes = map[string]descriptor.FieldDescriptorProto_Type{
+	"double":   descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descriptor.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descriptor.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descriptor.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descriptor.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descriptor.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descriptor.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descriptor.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descriptor.FieldDescriptorProto_TYPE_STRING,
+	"group":    descriptor.FieldDescriptorProto_TYPE_GROUP,
+	"bytes":    descriptor.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descriptor.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descriptor.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descriptor.FieldDescriptorProto_TYPE_SINT64,
+}
+
+// A reserved field number range or name of a message or enum. The
+// descriptors have no field for them, so they are only checked by the
+// linker.
+type reserved struct {
+	start, end int64 // inclusive
+	name       string
+	path       []int32
+	tok        token
+}
+
+// The parser of a single .proto file, which builds its descriptor and the
+// locations of its elements like protoc does.
+type parser struct {
+	filename string
+	data     []byte
+	tokens   []token
+	index    int
+	file     *descriptor.FileDescriptorProto
+	locs     []*descriptor.SourceCodeInfo_Location
+	proto3   bool
+	// The leading comment of the declaration being parsed.
+	upcoming string
+	reserved map[interface{}][]*reserved
+	// The messages generated for map fields.
+	mapEntries map[*descriptor.DescriptorProto]bool
+}
+
+func newParser(filename string, data []byte) *parser {
+	return &parser{
+		filename:   filename,
+		data:       data,
+		file:       &descriptor.FileDescriptorProto{Name: proto.String(filename)},
+		reserved:   make(map[interface{}][]*reserved),
+		mapEntries: make(map[*descriptor.DescriptorProto]bool),
+	}
+}
+
+func (this *parser) tokenize() {
+	lex := &lexer{filename: this.filename, data: this.data}
+	for {
+		tok := lex.next(len(this.tokens) == 0)
+		this.tokens = append(this.tokens, tok)
+		if tok.kind == tokenEOF {
+			return
+		}
+	}
+}
+
+func (this *parser) tok() *token {
+	return &this.tokens[this.index]
+}
+
+func (this *parser) prev() *token {
+	if this.index == 0 {
+		return &token{}
+	}
+	return &this.tokens[this.index-1]
+}
+
+func (this *parser) next() {
+	if this.index < len(this.tokens)-1 {
+		this.index++
+	}
+}
+
+func (this *parser) errorAt(tok *token, format string, args ...interface{}) {
+	panic(&Error{
+		Filename: this.filename,
+		Line:     tok.line + 1,
+		Column:   tok.col + 1,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (this *parser) errorf(format string, args ...interface{}) {
+	this.errorAt(this.tok(), format, args...)
+}
+
+func (this *parser) atEnd() bool {
+	return this.tok().kind == tokenEOF
+}
+
+func (this *parser) lookingAt(text string) bool {
+	return this.tok().kind != tokenString && this.tok().text == text
+}
+
+func (this *parser) tryConsume(text string) bool {
+	if this.lookingAt(text) {
+		this.next()
+		return true
+	}
+	return false
+}
+
+func (this *parser) consume(text string, msg ...string) {
+	if !this.tryConsume(text) {
+		if len(msg) > 0 {
+			this.errorf("%s", msg[0])
+		}
+		this.errorf("Expected \"%s\".", text)
+	}
+}
+
+func (this *parser) consumeIdent(msg string) string {
+	if this.tok().kind != tokenIdent {
+		this.errorf("%s", msg)
+	}
+	text := this.tok().text
+	this.next()
+	return text
+}
+
+func (this *parser) consumeInt64(max uint64, msg string) uint64 {
+	if this.tok().kind != tokenInt {
+		this.errorf("%s", msg)
+	}
+	value, err := strconv.ParseUint(this.tok().text, 0, 64)
+	if err != nil || value > max {
+		this.errorf("Integer out of range.")
+	}
+	this.next()
+	return value
+}
+
+func (this *parser) consumeInt(msg string) int32 {
+	return int32(this.consumeInt64(math.MaxInt32, msg))
+}
+
+func (this *parser) consumeSignedInt(msg string) int32 {
+	if this.tryConsume("-") {
+		return int32(-int64(this.consumeInt64(math.MaxInt32+1, msg)))
+	}
+	return this.consumeInt(msg)
+}
+
+func (this *parser) consumeNumber(msg string) float64 {
+	tok := this.tok()
+	var value float64
+	switch {
+	case tok.kind == tokenFloat:
+		value, _ = strconv.ParseFloat(tok.text, 64)
+	case tok.kind == tokenInt:
+		n, err := strconv.ParseUint(tok.text, 0, 64)
+		if err != nil {
+			this.errorf("Integer out of range.")
+		}
+		value = float64(n)
+	case tok.kind == tokenIdent && tok.text == "inf":
+		value = math.Inf(1)
+	case tok.kind == tokenIdent && tok.text == "nan":
+		value = math.NaN()
+	default:
+		this.errorf("%s", msg)
+	}
+	this.next()
+	return value
+}
+
+// Adjacent strings are concatenated, like in C.
+func (this *parser) consumeString(msg string) string {
+	if this.tok().kind != tokenString {
+		this.errorf("%s", msg)
+	}
+	value := ""
+	for this.tok().kind == tokenString {
+		value += unquote(this.tok().text)
+		this.next()
+	}
+	return value
+}
+
+func appendPath(path []int32, elems ...int32) []int32 {
+	return append(append([]int32(nil), path...), elems...)
+}
+
+// Starts the location of the element at path at the current token.
+func (this *parser) startLoc(path []int32) *descriptor.SourceCodeInfo_Location {
+	loc := &descriptor.SourceCodeInfo_Location{
+		Path: appendPath(path),
+		Span: []int32{int32(this.tok().line), int32(this.tok().col)},
+	}
+	this.locs = append(this.locs, loc)
+	return loc
+}
+
+// Ends the location at the previous token.
+func (this *parser) endLoc(loc *descriptor.SourceCodeInfo_Location) {
+	this.endLocAt(loc, this.prev())
+}
+
+func (this *parser) endLocAt(loc *descriptor.SourceCodeInfo_Location, tok *token) {
+	if int32(tok.line) != loc.Span[0] {
+		loc.Span = append(loc.Span, int32(tok.line))
+	}
+	loc.Span = append(loc.Span, int32(tok.endCol))
+}
+
+// Records the location of the single token tok.
+func (this *parser) tokenLoc(path []int32, tok *token) {
+	loc := &descriptor.SourceCodeInfo_Location{
+		Path: appendPath(path),
+		Span: []int32{int32(tok.line), int32(tok.col)},
+	}
+	this.locs = append(this.locs, loc)
+	this.endLocAt(loc, tok)
+}
+
+// Consumes the token which ends a declaration, like ";", "{" or "}", and
+// attaches the comments before the declaration and after the token to loc.
+func (this *parser) tryConsumeEndOfDecl(text string, loc *descriptor.SourceCodeInfo_Location) bool {
+	if !this.lookingAt(text) {
+		return false
+	}
+	leading := this.upcoming
+	this.next()
+	trailing := this.tok().trailing
+	this.upcoming = this.tok().leading
+	if loc != nil {
+		if len(leading) > 0 {
+			loc.LeadingComments = proto.String(leading)
+		}
+		if len(trailing) > 0 {
+			loc.TrailingComments = proto.String(trailing)
+		}
+	}
+	return true
+}
+
+func (this *parser) consumeEndOfDecl(text string, loc *descriptor.SourceCodeInfo_Location) {
+	if !this.tryConsumeEndOfDecl(text, loc) {
+		this.errorf("Expected \"%s\".", text)
+	}
+}
+
+// parse returns the descriptor of the file, with the locations of its
+// elements, or an *Error.
+func (this *parser) parse() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(*Error); ok {
+				err = e
+				return
+			}
+			panic(r)
+		}
+	}()
+	this.tokenize()
+	this.upcoming = this.tok().leading
+	root := this.startLoc(nil)
+	if this.lookingAt("syntax") {
+		this.parseSyntax()
+	}
+	for !this.atEnd() {
+		this.parseTopLevel()
+	}
+	this.endLoc(root)
+	this.file.SourceCodeInfo = &descriptor.SourceCodeInfo{Location: this.locs}
+	return nil
+}
+
+func (this *parser) parseSyntax() {
+	loc := this.startLoc([]int32{fileSyntaxTag})
+	this.consume("syntax")
+	this.consume("=")
+	tok := *this.tok()
+	syntax := this.consumeString("Expected syntax identifier.")
+	this.consumeEndOfDecl(";", loc)
+	this.endLoc(loc)
+	switch syntax {
+	case "proto2":
+	case "proto3":
+		this.proto3 = true
+		this.file.Syntax = proto.String(syntax)
+	default:
+		this.errorAt(&tok, "Unrecognized syntax identifier \"%s\".  This parser only recognizes \"proto2\" and \"proto3\".", syntax)
+	}
+}
+
+func (this *parser) parseTopLevel() {
+	file := this.file
+	switch {
+	case this.tryConsumeEndOfDecl(";", nil):
+	case this.lookingAt("message"):
+		msg := &descriptor.DescriptorProto{}
+		loc := this.startLoc([]int32{fileMessageTypeTag, int32(len(file.MessageType))})
+		file.MessageType = append(file.MessageType, msg)
+		this.parseMessage(msg, loc)
+		this.endLoc(loc)
+	case this.lookingAt("enum"):
+		enum := &descriptor.EnumDescriptorProto{}
+		loc := this.startLoc([]int32{fileEnumTypeTag, int32(len(file.EnumType))})
+		file.EnumType = append(file.EnumType, enum)
+		this.parseEnum(enum, loc)
+		this.endLoc(loc)
+	case this.lookingAt("service"):
+		service := &descriptor.ServiceDescriptorProto{}
+		loc := this.startLoc([]int32{fileServiceTag, int32(len(file.Service))})
+		file.Service = append(file.Service, service)
+		this.parseService(service, loc)
+		this.endLoc(loc)
+	case this.lookingAt("extend"):
+		loc := this.startLoc([]int32{fileExtensionTag})
+		this.parseExtend(&file.Extension, &file.MessageType, nil, fileMessageTypeTag, loc)
+		this.endLoc(loc)
+	case this.lookingAt("import"):
+		this.parseImport()
+	case this.lookingAt("package"):
+		this.parsePackage()
+	case this.lookingAt("option"):
+		if file.Options == nil {
+			file.Options = &descriptor.FileOptions{}
+		}
+		loc := this.startLoc([]int32{fileOptionsTag})
+		this.parseOption(&file.Options.UninterpretedOption, loc.Path, true)
+		this.endLoc(loc)
+	default:
+		this.errorf("Expected top-level statement (e.g. \"message\").")
+	}
+}
+
+func (this *parser) parseImport() {
+	file := this.file
+	loc := this.startLoc([]int32{fileDependencyTag, int32(len(file.Dependency))})
+	this.consume("import")
+	if this.lookingAt("public") {
+		this.tokenLoc([]int32{filePublicDepTag, int32(len(file.PublicDependency))}, this.tok())
+		this.next()
+		file.PublicDependency = append(file.PublicDependency, int32(len(file.Dependency)))
+	} else if this.lookingAt("weak") {
+		this.tokenLoc([]int32{fileWeakDepTag, int32(len(file.WeakDependency))}, this.tok())
+		this.next()
+		file.WeakDependency = append(file.WeakDependency, int32(len(file.Dependency)))
+	}
+	name := this.consumeString("Expected a string naming the file to import.")
+	file.Dependency = append(file.Dependency, name)
+	this.consumeEndOfDecl(";", loc)
+	this.endLoc(loc)
+}
+
+func (this *parser) parsePackage() {
+	if this.file.Package != nil {
+		this.errorf("Multiple package definitions.")
+	}
+	loc := this.startLoc([]int32{filePackageTag})
+	this.consume("package")
+	var name []string
+	for {
+		name = append(name, this.consumeIdent("Expected identifier."))
+		if !this.tryConsume(".") {
+			break
+		}
+	}
+	this.file.Package = proto.String(strings.Join(name, "."))
+	this.consumeEndOfDecl(";", loc)
+	this.endLoc(loc)
+}
+
+// Parses an option into opts, whose location is at path. An option
+// statement starts with "option" and ends with ";", while the options of
+// fields and enum values are between brackets.
+func (this *parser) parseOption(opts *[]*descriptor.UninterpretedOption, path []int32, statement bool) {
+	opt := &descriptor.UninterpretedOption{}
+	loc := this.startLoc(appendPath(path, uninterpretedOptionTag, int32(len(*opts))))
+	*opts = append(*opts, opt)
+	if statement {
+		this.consume("option")
+	}
+
+	nameLoc := this.startLoc(appendPath(loc.Path, optionNameTag))
+	for {
+		partLoc := this.startLoc(appendPath(nameLoc.Path, int32(len(opt.Name))))
+		part := &descriptor.UninterpretedOption_NamePart{}
+		if this.tryConsume("(") {
+			partNameLoc := this.startLoc(appendPath(partLoc.Path, optionNamePartTag))
+			name := ""
+			if this.tok().kind == tokenIdent {
+				name = this.consumeIdent("Expected identifier.")
+			}
+			for this.tryConsume(".") {
+				name += "." + this.consumeIdent("Expected identifier.")
+			}
+			this.endLoc(partNameLoc)
+			this.consume(")")
+			part.NamePart = proto.String(name)
+			part.IsExtension = proto.Bool(true)
+		} else {
+			partNameLoc := this.startLoc(appendPath(partLoc.Path, optionNamePartTag))
+			part.NamePart = proto.String(this.consumeIdent("Expected identifier."))
+			part.IsExtension = proto.Bool(false)
+			this.endLoc(partNameLoc)
+		}
+		this.endLoc(partLoc)
+		opt.Name = append(opt.Name, part)
+		if !this.tryConsume(".") {
+			break
+		}
+	}
+	this.endLoc(nameLoc)
+
+	this.consume("=")
+	valueLoc := this.startLoc(loc.Path)
+	negative := this.tryConsume("-")
+	switch this.tok().kind {
+	case tokenEOF:
+		this.errorf("Unexpected end of stream while parsing option value.")
+	case tokenIdent:
+		valueLoc.Path = append(valueLoc.Path, optionIdentifierTag)
+		if negative {
+			this.errorf("Invalid '-' symbol before identifier.")
+		}
+		opt.IdentifierValue = proto.String(this.consumeIdent("Expected identifier."))
+	case tokenInt:
+		if negative {
+			valueLoc.Path = append(valueLoc.Path, optionNegativeIntTag)
+			opt.NegativeIntValue = proto.Int64(-int64(this.consumeInt64(math.MaxInt64+1, "Expected integer.")))
+		} else {
+			valueLoc.Path = append(valueLoc.Path, optionPositiveIntTag)
+			opt.PositiveIntValue = proto.Uint64(this.consumeInt64(math.MaxUint64, "Expected integer."))
+		}
+	case tokenFloat:
+		valueLoc.Path = append(valueLoc.Path, optionDoubleTag)
+		value := this.consumeNumber("Expected number.")
+		if negative {
+			value = -value
+		}
+		opt.DoubleValue = proto.Float64(value)
+	case tokenString:
+		valueLoc.Path = append(valueLoc.Path, optionStringTag)
+		if negative {
+			this.errorf("Invalid '-' symbol before string.")
+		}
+		opt.StringValue = []byte(this.consumeString("Expected string."))
+	default:
+		if !this.lookingAt("{") {
+			this.errorf("Expected option value.")
+		}
+		valueLoc.Path = append(valueLoc.Path, optionAggregateTag)
+		opt.AggregateValue = proto.String(this.parseAggregate())
+	}
+	this.endLoc(valueLoc)
+
+	if statement {
+		this.consumeEndOfDecl(";", loc)
+	}
+	this.endLoc(loc)
+}
+
+// Returns the tokens of an aggregate option value between braces, joined
+// by spaces.
+func (this *parser) parseAggregate() string {
+	this.consume("{")
+	var value []string
+	depth := 1
+	for !this.atEnd() {
+		if this.lookingAt("{") {
+			depth++
+		} else if this.lookingAt("}") {
+			depth--
+			if depth == 0 {
+				this.next()
+				return strings.Join(value, " ")
+			}
+		}
+		value = append(value, this.tok().text)
+		this.next()
+	}
+	this.errorf("Unexpected end of stream while parsing aggregate value.")
+	return ""
+}
+
+func (this *parser) parseMessage(msg *descriptor.DescriptorProto, loc *descriptor.SourceCodeInfo_Location) {
+	this.consume("message")
+	nameLoc := this.startLoc(appendPath(loc.Path, messageNameTag))
+	msg.Name = proto.String(this.consumeIdent("Expected message name."))
+	this.endLoc(nameLoc)
+	this.parseMessageBlock(msg, loc)
+}
+
+func (this *parser) parseMessageBlock(msg *descriptor.DescriptorProto, loc *descriptor.SourceCodeInfo_Location) {
+	this.consumeEndOfDecl("{", loc)
+	for !this.tryConsumeEndOfDecl("}", nil) {
+		if this.atEnd() {
+			this.errorf("Reached end of input in message definition (missing '}').")
+		}
+		this.parseMessageStatement(msg, loc.Path)
+	}
+	// Like protoc, the extension ranges of message sets go up to the largest
+	// int32 instead of the largest field number.
+	max := int32(maxFieldNumber + 1)
+	if isMessageSet(msg) {
+		max = math.MaxInt32
+	}
+	for _, r := range msg.ExtensionRange {
+		if r.GetEnd() == maxRange {
+			r.End = proto.Int32(max)
+		}
+	}
+}
+
+// Returns whether msg sets message_set_wire_format, before its options are
+// interpreted.
+func isMessageSet(msg *descriptor.DescriptorProto) bool {
+	for _, opt := range msg.GetOptions().GetUninterpretedOption() {
+		if len(opt.Name) == 1 && opt.Name[0].GetNamePart() == "message_set_wire_format" && opt.GetIdentifierValue() == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *parser) parseMessageStatement(msg *descriptor.DescriptorProto, path []int32) {
+	switch {
+	case this.tryConsumeEndOfDecl(";", nil):
+	case this.lookingAt("message"):
+		nested := &descriptor.DescriptorProto{}
+		loc := this.startLoc(appendPath(path, messageNestedTypeTag, int32(len(msg.NestedType))))
+		msg.NestedType = append(msg.NestedType, nested)
+		this.parseMessage(nested, loc)
+		this.endLoc(loc)
+	case this.lookingAt("enum"):
+		enum := &descriptor.EnumDescriptorProto{}
+		loc := this.startLoc(appendPath(path, messageEnumTypeTag, int32(len(msg.EnumType))))
+		msg.EnumType = append(msg.EnumType, enum)
+		this.parseEnum(enum, loc)
+		this.endLoc(loc)
+	case this.lookingAt("extensions"):
+		loc := this.startLoc(appendPath(path, messageExtensionRangeTag))
+		this.parseExtensions(msg, loc)
+		this.endLoc(loc)
+	case this.lookingAt("reserved"):
+		this.parseReserved(msg, path, maxFieldNumber)
+	case this.lookingAt("extend"):
+		loc := this.startLoc(appendPath(path, messageExtensionTag))
+		this.parseExtend(&msg.Extension, &msg.NestedType, path, messageNestedTypeTag, loc)
+		this.endLoc(loc)
+	case this.lookingAt("option"):
+		if msg.Options == nil {
+			msg.Options = &descriptor.MessageOptions{}
+		}
+		loc := this.startLoc(appendPath(path, messageOptionsTag))
+		this.parseOption(&msg.Options.UninterpretedOption, loc.Path, true)
+		this.endLoc(loc)
+	case this.lookingAt("oneof"):
+		this.parseOneof(msg, path)
+	default:
+		field := &descriptor.FieldDescriptorProto{}
+		loc := this.startLoc(appendPath(path, messageFieldTag, int32(len(msg.Field))))
+		msg.Field = append(msg.Field, field)
+		this.parseField(field, &msg.NestedType, path, messageNestedTypeTag, loc)
+		this.endLoc(loc)
+	}
+}
+
+func (this *parser) parseExtensions(msg *descriptor.DescriptorProto, loc *descriptor.SourceCodeInfo_Location) {
+	this.consume("extensions")
+	for {
+		rangeLoc := this.startLoc(appendPath(loc.Path, int32(len(msg.ExtensionRange))))
+		startTok := *this.tok()
+		startLoc := this.startLoc(appendPath(rangeLoc.Path, extensionRangeStartTag))
+		start := this.consumeInt("Expected field number range.")
+		this.endLoc(startLoc)
+		end := start
+		if this.tryConsume("to") {
+			endLoc := this.startLoc(appendPath(rangeLoc.Path, extensionRangeEndTag))
+			if this.tryConsume("max") {
+				end = maxRange - 1
+			} else {
+				end = this.consumeInt("Expected integer.")
+			}
+			this.endLoc(endLoc)
+		} else {
+			this.tokenLoc(appendPath(rangeLoc.Path, extensionRangeEndTag), &startTok)
+		}
+		this.endLoc(rangeLoc)
+		// The end of the range in the descriptor is exclusive.
+		msg.ExtensionRange = append(msg.ExtensionRange, &descriptor.DescriptorProto_ExtensionRange{
+			Start: proto.Int32(start),
+			End:   proto.Int32(end + 1),
+		})
+		if !this.tryConsume(",") {
+			break
+		}
+	}
+	this.consumeEndOfDecl(";", loc)
+}
+
+// Parses the reserved field numbers or names of a message, or the reserved
+// values or names of an enum.
+func (this *parser) parseReserved(elem interface{}, path []int32, max int64) {
+	this.consume("reserved")
+	if this.tok().kind == tokenString {
+		for {
+			tok := *this.tok()
+			name := this.consumeString("Expected field name.")
+			this.reserved[elem] = append(this.reserved[elem], &reserved{name: name, path: path, tok: tok})
+			if !this.tryConsume(",") {
+				break
+			}
+		}
+	} else {
+		for {
+			tok := *this.tok()
+			var start, end int64
+			if max == maxFieldNumber {
+				start = int64(this.consumeInt("Expected field number range."))
+			} else {
+				start = int64(this.consumeSignedInt("Expected enum value range."))
+			}
+			end = start
+			if this.tryConsume("to") {
+				if this.tryConsume("max") {
+					end = max
+				} else if max == maxFieldNumber {
+					end = int64(this.consumeInt("Expected integer."))
+				} else {
+					end = int64(this.consumeSignedInt("Expected integer."))
+				}
+			}
+			if end < start {
+				this.errorAt(&tok, "Reserved range end number must be greater than start number.")
+			}
+			this.reserved[elem] = append(this.reserved[elem], &reserved{start: start, end: end, path: path, tok: tok})
+			if !this.tryConsume(",") {
+				break
+			}
+		}
+	}
+	this.consumeEndOfDecl(";", nil)
+}
+
+// Parses an extend block into fields. The groups it declares are added to
+// messages, whose location is at path.
+func (this *parser) parseExtend(fields *[]*descriptor.FieldDescriptorProto, messages *[]*descriptor.DescriptorProto, path []int32, messagesTag int32, loc *descriptor.SourceCodeInfo_Location) {
+	this.consume("extend")
+	extendeeStart := *this.tok()
+	extendee := this.parseUserType()
+	extendeeEnd := *this.prev()
+	this.consumeEndOfDecl("{", loc)
+	for {
+		if this.atEnd() {
+			this.errorf("Reached end of input in extend definition (missing '}').")
+		}
+		if this.lookingAt("option") {
+			this.errorf("\"option\" is not allowed in an extend block.")
+		}
+		field := &descriptor.FieldDescriptorProto{Extendee: proto.String(extendee)}
+		fieldLoc := this.startLoc(appendPath(loc.Path, int32(len(*fields))))
+		*fields = append(*fields, field)
+		extendeeLoc := &descriptor.SourceCodeInfo_Location{
+			Path: appendPath(fieldLoc.Path, fieldExtendeeTag),
+			Span: []int32{int32(extendeeStart.line), int32(extendeeStart.col)},
+		}
+		this.endLocAt(extendeeLoc, &extendeeEnd)
+		this.locs = append(this.locs, extendeeLoc)
+		this.parseField(field, messages, path, messagesTag, fieldLoc)
+		this.endLoc(fieldLoc)
+		if this.tryConsumeEndOfDecl("}", nil) {
+			return
+		}
+	}
+}
+
+func (this *parser) parseOneof(msg *descriptor.DescriptorProto, path []int32) {
+	index := int32(len(msg.OneofDecl))
+	oneof := &descriptor.OneofDescriptorProto{}
+	msg.OneofDecl = append(msg.OneofDecl, oneof)
+	loc := this.startLoc(appendPath(path, messageOneofTag, index))
+	this.consume("oneof")
+	nameLoc := this.startLoc(appendPath(loc.Path, oneofNameTag))
+	oneof.Name = proto.String(this.consumeIdent("Expected oneof name."))
+	this.endLoc(nameLoc)
+	this.consumeEndOfDecl("{", loc)
+	for {
+		if this.atEnd() {
+			this.errorf("Reached end of input in oneof definition (missing '}').")
+		}
+		if this.lookingAt("option") {
+			this.errorf("Options are not supported on oneofs.")
+		}
+		if this.lookingAt("required") || this.lookingAt("optional") || this.lookingAt("repeated") {
+			this.errorf("Fields in oneofs must not have labels (required / optional / repeated).")
+		}
+		field := &descriptor.FieldDescriptorProto{
+			Label:      descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			OneofIndex: proto.Int32(index),
+		}
+		fieldLoc := this.startLoc(appendPath(path, messageFieldTag, int32(len(msg.Field))))
+		msg.Field = append(msg.Field, field)
+		this.parseFieldNoLabel(field, &msg.NestedType, path, messageNestedTypeTag, fieldLoc)
+		this.endLoc(fieldLoc)
+		if this.tryConsumeEndOfDecl("}", nil) {
+			break
+		}
+	}
+	this.endLoc(loc)
+}
+
+func (this *parser) parseField(field *descriptor.FieldDescriptorProto, messages *[]*descriptor.DescriptorProto, path []int32, messagesTag int32, loc *descriptor.SourceCodeInfo_Location) {
+	for _, label := range []string{"optional", "repeated", "required"} {
+		if this.lookingAt(label) {
+			this.tokenLoc(appendPath(loc.Path, fieldLabelTag), this.tok())
+			if label == "optional" && this.proto3 {
+				this.errorf("Explicit 'optional' labels are disallowed in the Proto3 syntax. To define 'optional' fields in Proto3, simply remove the 'optional' label, as fields are 'optional' by default.")
+			}
+			value := descriptor.FieldDescriptorProto_Label_value["LABEL_"+strings.ToUpper(label)]
+			field.Label = descriptor.FieldDescriptorProto_Label(value).Enum()
+			this.next()
+			break
+		}
+	}
+	this.parseFieldNoLabel(field, messages, path, messagesTag, loc)
+}
+
+func (this *parser) parseFieldNoLabel(field *descriptor.FieldDescriptorProto, messages *[]*descriptor.DescriptorProto, path []int32, messagesTag int32, loc *descriptor.SourceCodeInfo_Location) {
+	var mapKey, mapValue *descriptor.FieldDescriptorProto
+	typeLoc := this.startLoc(loc.Path)
+	if this.lookingAt("map") && this.tokens[this.index+1].text == "<" {
+		switch {
+		case field.OneofIndex != nil:
+			this.errorf("Map fields are not allowed in oneofs.")
+		case field.Label != nil:
+			this.errorf("Field labels (required/optional/repeated) are not allowed on map fields.")
+		case field.Extendee != nil:
+			this.errorf("Map fields are not allowed to be extensions.")
+		}
+		field.Label = descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		this.consume("map")
+		this.consume("<")
+		mapKey = this.parseType()
+		this.consume(",")
+		mapValue = this.parseType()
+		this.consume(">")
+		typeLoc.Path = append(typeLoc.Path, fieldTypeNameTag)
+	} else {
+		if field.Label == nil {
+			if !this.proto3 {
+				this.errorf("Expected \"required\", \"optional\", or \"repeated\".")
+			}
+			field.Label = descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+		}
+		typ := this.parseType()
+		field.Type, field.TypeName = typ.Type, typ.TypeName
+		if field.Type != nil {
+			typeLoc.Path = append(typeLoc.Path, fieldTypeTag)
+		} else {
+			typeLoc.Path = append(typeLoc.Path, fieldTypeNameTag)
+		}
+	}
+	this.endLoc(typeLoc)
+
+	nameTok := *this.tok()
+	nameLoc := this.startLoc(appendPath(loc.Path, fieldNameTag))
+	field.Name = proto.String(this.consumeIdent("Expected field name."))
+	this.endLoc(nameLoc)
+	this.consume("=", "Missing field number.")
+	numberLoc := this.startLoc(appendPath(loc.Path, fieldNumberTag))
+	field.Number = proto.Int32(this.consumeInt("Expected field number."))
+	this.endLoc(numberLoc)
+
+	if this.lookingAt("[") {
+		optionsLoc := this.startLoc(appendPath(loc.Path, fieldOptionsTag))
+		this.next()
+		for {
+			if this.lookingAt("default") {
+				this.parseDefault(field, loc.Path)
+			} else {
+				if field.Options == nil {
+					field.Options = &descriptor.FieldOptions{}
+				}
+				this.parseOption(&field.Options.UninterpretedOption, optionsLoc.Path, false)
+			}
+			if !this.tryConsume(",") {
+				break
+			}
+		}
+		this.consume("]")
+		this.endLoc(optionsLoc)
+	}
+
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP {
+		// A group declares both a message and a field, named after the
+		// message in lower case.
+		group := &descriptor.DescriptorProto{Name: proto.String(field.GetName())}
+		groupLoc := &descriptor.SourceCodeInfo_Location{
+			Path: appendPath(path, messagesTag, int32(len(*messages))),
+			Span: loc.Span[:2:2],
+		}
+		this.locs = append(this.locs, groupLoc)
+		*messages = append(*messages, group)
+		this.tokenLoc(appendPath(groupLoc.Path, messageNameTag), &nameTok)
+		this.tokenLoc(appendPath(loc.Path, fieldTypeNameTag), &nameTok)
+		if c := nameTok.text[0]; c < 'A' || 'Z' < c {
+			this.errorAt(&nameTok, "Group names must start with a capital letter.")
+		}
+		field.Name = proto.String(strings.ToLower(field.GetName()))
+		field.TypeName = proto.String(group.GetName())
+		if !this.lookingAt("{") {
+			this.errorf("Missing group body.")
+		}
+		this.parseMessageBlock(group, groupLoc)
+		this.endLoc(groupLoc)
+	} else {
+		this.consumeEndOfDecl(";", loc)
+	}
+
+	if mapKey != nil {
+		entry := &descriptor.DescriptorProto{
+			Name: proto.String(mapEntryName(field.GetName())),
+			Field: []*descriptor.FieldDescriptorProto{
+				mapKey,
+				mapValue,
+			},
+			Options: &descriptor.MessageOptions{MapEntry: proto.Bool(true)},
+		}
+		mapKey.Name, mapKey.Number = proto.String("key"), proto.Int32(1)
+		mapValue.Name, mapValue.Number = proto.String("value"), proto.Int32(2)
+		mapKey.Label = descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+		mapValue.Label = descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+		field.TypeName = entry.Name
+		*messages = append(*messages, entry)
+		this.mapEntries[entry] = true
+	}
+}
+
+// Returns the name of the message of the entries of a map field, like
+// "FooBarEntry" for "foo_bar".
+func mapEntryName(name string) string {
+	entry := ""
+	upper := true
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+		} else if upper {
+			entry += strings.ToUpper(string(c))
+			upper = false
+		} else {
+			entry += string(c)
+		}
+	}
+	return entry + "Entry"
+}
+
+// Returns a field with the Type of a scalar type or the TypeName of another
+// type.
+func (this *parser) parseType() *descriptor.FieldDescriptorProto {
+	if typ, ok := scalarTypes[this.tok().text]; ok && this.tok().kind == tokenIdent {
+		this.next()
+		return &descriptor.FieldDescriptorProto{Type: typ.Enum()}
+	}
+	return &descriptor.FieldDescriptorProto{TypeName: proto.String(this.parseUserType())}
+}
+
+// Returns a type name as written, which is fully qualified if it starts
+// with a dot.
+func (this *parser) parseUserType() string {
+	if _, ok := scalarTypes[this.tok().text]; ok && this.tok().kind == tokenIdent {
+		this.errorf("Expected message type.")
+	}
+	name := ""
+	if this.tryConsume(".") {
+		name = "."
+	}
+	name += this.consumeIdent("Expected type name.")
+	for this.tryConsume(".") {
+		name += "." + this.consumeIdent("Expected identifier.")
+	}
+	return name
+}
+
+func (this *parser) parseDefault(field *descriptor.FieldDescriptorProto, path []int32) {
+	if field.DefaultValue != nil {
+		this.errorf("Already set option \"default\".")
+	}
+	loc := this.startLoc(appendPath(path, fieldDefaultTag))
+	defer this.endLoc(loc)
+	this.consume("default")
+	this.consume("=")
+	if field.Type == nil {
+		// An enum or message, which the linker checks.
+		field.DefaultValue = proto.String(this.tok().text)
+		this.next()
+		return
+	}
+	value := ""
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		max := uint64(math.MaxInt64)
+		if is32Bit(field.GetType()) {
+			max = math.MaxInt32
+		}
+		if this.tryConsume("-") {
+			value = "-"
+			max++
+		}
+		value += strconv.FormatUint(this.consumeInt64(max, "Expected integer for field default value."), 10)
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		max := uint64(math.MaxUint64)
+		if is32Bit(field.GetType()) {
+			max = math.MaxUint32
+		}
+		if this.lookingAt("-") {
+			this.errorf("Unsigned field can't have negative default value.")
+		}
+		value = strconv.FormatUint(this.consumeInt64(max, "Expected integer for field default value."), 10)
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		negative := this.tryConsume("-")
+		number := this.consumeNumber("Expected number.")
+		if negative {
+			number = -number
+		}
+		value = formatFloat(number)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if this.tryConsume("true") {
+			value = "true"
+		} else if this.tryConsume("false") {
+			value = "false"
+		} else {
+			this.errorf("Expected \"true\" or \"false\".")
+		}
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		value = this.consumeString("Expected string for field default value.")
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		value = cEscape(this.consumeString("Expected string."))
+	default:
+		this.errorf("Messages can't have default values.")
+	}
+	field.DefaultValue = proto.String(value)
+}
+
+func is32Bit(typ descriptor.FieldDescriptorProto_Type) bool {
+	switch typ {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return true
+	}
+	return false
+}
+
+// Formats a default value like protoc, which uses the shortest of 15 or 17
+// significant digits that parses back to the same value, for floats too.
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	case math.IsNaN(value):
+		return "nan"
+	}
+	text := strconv.FormatFloat(value, 'g', 15, 64)
+	if parsed, _ := strconv.ParseFloat(text, 64); parsed != value {
+		text = strconv.FormatFloat(value, 'g', 17, 64)
+	}
+	return text
+}
+
+// Escapes the bytes of a default value like protoc.
+func cEscape(value string) string {
+	escaped := ""
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '\n':
+			escaped += "\\n"
+		case '\r':
+			escaped += "\\r"
+		case '\t':
+			escaped += "\\t"
+		case '"':
+			escaped += "\\\""
+		case '\'':
+			escaped += "\\'"
+		case '\\':
+			escaped += "\\\\"
+		default:
+			if c < ' ' || c >= 0x7f {
+				escaped += fmt.Sprintf("\\%03o", c)
+			} else {
+				escaped += string(c)
+			}
+		}
+	}
+	return escaped
+}
+
+func (this *parser) parseEnum(enum *descriptor.EnumDescriptorProto, enumLoc *descriptor.SourceCodeInfo_Location) {
+	path := enumLoc.Path
+	this.consume("enum")
+	nameLoc := this.startLoc(appendPath(path, enumNameTag))
+	enum.Name = proto.String(this.consumeIdent("Expected enum name."))
+	this.endLoc(nameLoc)
+	this.consumeEndOfDecl("{", enumLoc)
+	for !this.tryConsumeEndOfDecl("}", nil) {
+		if this.atEnd() {
+			this.errorf("Reached end of input in enum definition (missing '}').")
+		}
+		switch {
+		case this.tryConsumeEndOfDecl(";", nil):
+		case this.lookingAt("option"):
+			if enum.Options == nil {
+				enum.Options = &descriptor.EnumOptions{}
+			}
+			loc := this.startLoc(appendPath(path, enumOptionsTag))
+			this.parseOption(&enum.Options.UninterpretedOption, loc.Path, true)
+			this.endLoc(loc)
+		case this.lookingAt("reserved"):
+			this.parseReserved(enum, path, math.MaxInt32)
+		default:
+			value := &descriptor.EnumValueDescriptorProto{}
+			loc := this.startLoc(appendPath(path, enumValueTag, int32(len(enum.Value))))
+			enum.Value = append(enum.Value, value)
+			this.parseEnumValue(value, loc)
+			this.endLoc(loc)
+		}
+	}
+}
+
+func (this *parser) parseEnumValue(value *descriptor.EnumValueDescriptorProto, loc *descriptor.SourceCodeInfo_Location) {
+	nameLoc := this.startLoc(appendPath(loc.Path, enumValueNameTag))
+	value.Name = proto.String(this.consumeIdent("Expected enum constant name."))
+	this.endLoc(nameLoc)
+	this.consume("=", "Missing numeric value for enum constant.")
+	numberLoc := this.startLoc(appendPath(loc.Path, enumValueNumberTag))
+	value.Number = proto.Int32(this.consumeSignedInt("Expected integer."))
+	this.endLoc(numberLoc)
+	if this.lookingAt("[") {
+		optionsLoc := this.startLoc(appendPath(loc.Path, enumValueOptionsTag))
+		this.next()
+		value.Options = &descriptor.EnumValueOptions{}
+		for {
+			this.parseOption(&value.Options.UninterpretedOption, optionsLoc.Path, false)
+			if !this.tryConsume(",") {
+				break
+			}
+		}
+		this.consume("]")
+		this.endLoc(optionsLoc)
+	}
+	this.consumeEndOfDecl(";", loc)
+}
+
+func (this *parser) parseService(service *descriptor.ServiceDescriptorProto, serviceLoc *descriptor.SourceCodeInfo_Location) {
+	path := serviceLoc.Path
+	this.consume("service")
+	nameLoc := this.startLoc(appendPath(path, serviceNameTag))
+	service.Name = proto.String(this.consumeIdent("Expected service name."))
+	this.endLoc(nameLoc)
+	this.consumeEndOfDecl("{", serviceLoc)
+	for !this.tryConsumeEndOfDecl("}", nil) {
+		if this.atEnd() {
+			this.errorf("Reached end of input in service definition (missing '}').")
+		}
+		switch {
+		case this.tryConsumeEndOfDecl(";", nil):
+		case this.lookingAt("option"):
+			if service.Options == nil {
+				service.Options = &descriptor.ServiceOptions{}
+			}
+			loc := this.startLoc(appendPath(path, serviceOptionsTag))
+			this.parseOption(&service.Options.UninterpretedOption, loc.Path, true)
+			this.endLoc(loc)
+		default:
+			method := &descriptor.MethodDescriptorProto{}
+			loc := this.startLoc(appendPath(path, serviceMethodTag, int32(len(service.Method))))
+			service.Method = append(service.Method, method)
+			this.parseMethod(method, loc)
+			this.endLoc(loc)
+		}
+	}
+}
+
+func (this *parser) parseMethod(method *descriptor.MethodDescriptorProto, loc *descriptor.SourceCodeInfo_Location) {
+	this.consume("rpc")
+	nameLoc := this.startLoc(appendPath(loc.Path, methodNameTag))
+	method.Name = proto.String(this.consumeIdent("Expected method name."))
+	this.endLoc(nameLoc)
+	for i, tag := range []int32{methodInputTypeTag, methodOutputTypeTag} {
+		if i == 1 {
+			this.consume("returns")
+		}
+		this.consume("(")
+		if this.lookingAt("stream") && this.tokens[this.index+1].kind == tokenIdent {
+			this.errorf("Streaming methods are not supported.")
+		}
+		typeLoc := this.startLoc(appendPath(loc.Path, tag))
+		typeName := proto.String(this.parseUserType())
+		if i == 0 {
+			method.InputType = typeName
+		} else {
+			method.OutputType = typeName
+		}
+		this.endLoc(typeLoc)
+		this.consume(")")
+	}
+	if !this.lookingAt("{") {
+		this.consumeEndOfDecl(";", loc)
+		return
+	}
+	this.consumeEndOfDecl("{", loc)
+	for !this.tryConsumeEndOfDecl("}", nil) {
+		if this.atEnd() {
+			this.errorf("Reached end of input in method options (missing '}').")
+		}
+		if this.tryConsumeEndOfDecl(";", nil) {
+			continue
+		}
+		if method.Options == nil {
+			method.Options = &descriptor.MethodOptions{}
+		}
+		optionsLoc := this.startLoc(appendPath(loc.Path, methodOptionsTag))
+		this.parseOption(&method.Options.UninterpretedOption, optionsLoc.Path, true)
+		this.endLoc(optionsLoc)
+	}
+}
+
+// Returns a path as a map key.
+func pathKey(path []int32) string {
+	key := make([]string, len(path))
+	for i, n := range path {
+		key[i] = strconv.Itoa(int(n))
+	}
+	return strings.Join(key, ",")
+}
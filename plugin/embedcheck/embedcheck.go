@@ -103,22 +103,19 @@ func (p *plugin) Generate(file *generator.FileDescriptor) {
 		p.checkNameSpace(msg)
 		for _, field := range msg.GetField() {
 			if gogoproto.IsEmbed(field) && gogoproto.IsCustomName(field) {
-				fmt.Fprintf(os.Stderr, "ERROR: field %v with custom name %v cannot be embedded", *field.Name, gogoproto.GetCustomName(field))
-				os.Exit(1)
+				p.FailAt(msg, field, "field with custom name", gogoproto.GetCustomName(field), "cannot be embedded")
 			}
 		}
 		p.checkRepeated(msg)
 	}
 	for _, e := range file.GetExtension() {
 		if gogoproto.IsEmbed(e) {
-			fmt.Fprintf(os.Stderr, "ERROR: extended field %v cannot be embedded", generator.CamelCase(*e.Name))
-			os.Exit(1)
+			p.Fail("extended field", generator.CamelCase(*e.Name), "cannot be embedded")
 		}
 	}
 }
 
 func (p *plugin) checkNameSpace(message *generator.Descriptor) map[string]bool {
-	ccTypeName := generator.CamelCaseSlice(message.TypeName())
 	names := make(map[string]bool)
 	for _, field := range message.Field {
 		fieldname := generator.CamelCase(*field.Name)
@@ -127,15 +124,13 @@ func (p *plugin) checkNameSpace(message *generator.Descriptor) map[string]bool {
 			moreNames := p.checkNameSpace(desc.(*generator.Descriptor))
 			for another := range moreNames {
 				if names[another] {
-					fmt.Fprintf(os.Stderr, "ERROR: duplicate embedded fieldname %v in type %v\n", fieldname, ccTypeName)
-					os.Exit(1)
+					p.FailAt(message, field, "duplicate embedded fieldname", another)
 				}
 				names[another] = true
 			}
 		} else {
 			if names[fieldname] {
-				fmt.Fprintf(os.Stderr, "ERROR: duplicate embedded fieldname %v in type %v\n", fieldname, ccTypeName)
-				os.Exit(1)
+				p.FailAt(message, field, "duplicate embedded fieldname", fieldname)
 			}
 			names[fieldname] = true
 		}
@@ -165,7 +160,6 @@ func (p *plugin) checkOverwrite(message *generator.Descriptor, enablers map[stri
 }
 
 func (p *plugin) checkRepeated(message *generator.Descriptor) {
-	ccTypeName := generator.CamelCaseSlice(message.TypeName())
 	for _, field := range message.Field {
 		if !gogoproto.IsEmbed(field) {
 			continue
@@ -173,9 +167,7 @@ func (p *plugin) checkRepeated(message *generator.Descriptor) {
 		if !field.IsRepeated() {
 			continue
 		}
-		fieldname := generator.CamelCase(*field.Name)
-		fmt.Fprintf(os.Stderr, "ERROR: found repeated embedded field %s in message %s\n", fieldname, ccTypeName)
-		os.Exit(1)
+		p.FailAt(message, field, "repeated field cannot be embedded")
 	}
 }
 
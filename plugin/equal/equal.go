@@ -25,18 +25,13 @@
 // OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 
 /*
-The equal plugin generates an Equal and a VerboseEqual method for each message.
-These equal methods are quite obvious.
+The equal plugin generates a VerboseEqual method for each message.
+It is the counterpart of the Equal method, which protoc-gen-dgo generates for every message.
 The only difference is that VerboseEqual returns a non nil error if it is not equal.
 This error contains more detail on exactly which part of the message was not equal to the other message.
 The idea is that this is useful for debugging.
 
-Equal is enabled using the following extensions:
-
-  - equal
-  - equal_all
-
-While VerboseEqual is enable dusing the following extensions:
+VerboseEqual is enabled using the following extensions:
 
   - verbose_equal
   - verbose_equal_all
@@ -56,7 +51,6 @@ Btw all the output can be seen at:
 
 The following message:
 
-  option (gogoproto.equal_all) = true;
   option (gogoproto.verbose_equal_all) = true;
 
   message B {
@@ -107,46 +101,6 @@ given to the equal plugin, will generate the following code:
 		return nil
 	}
 
-	func (this *B) Equal(that interface{}) bool {
-		if that == nil {
-			if this == nil {
-				return true
-			}
-			return false
-		}
-
-		that1, ok := that.(*B)
-		if !ok {
-			return false
-		}
-		if that1 == nil {
-			if this == nil {
-				return true
-			}
-			return false
-		} else if this == nil {
-			return false
-		}
-		if this.xxx_IsASet != that1.xxx_IsASet {
-			return false
-		}
-		if this.xxx_IsASet && this.a != that1.a {
-			return false
-		}
-		if this.xxx_LenG != that1.xxx_LenG {
-			return false
-		}
-		for i := 0; i < this.xxx_LenG; i++ {
-			if this.g[i] != that1.g[i] {
-				return false
-			}
-		}
-		if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-			return false
-		}
-		return true
-	}
-
 and the following test code:
 
 	func TestBVerboseEqual(t *testing8.T) {
@@ -198,9 +152,6 @@ func (p *plugin) Generate(file *generator.FileDescriptor) {
 		if gogoproto.HasVerboseEqual(file.FileDescriptorProto, msg.DescriptorProto) {
 			p.generateMessage(msg, true, gogoproto.HasExtensionsMap(file.FileDescriptorProto, msg.DescriptorProto))
 		}
-		if gogoproto.HasEqual(file.FileDescriptorProto, msg.DescriptorProto) {
-			p.generateMessage(msg, false, gogoproto.HasExtensionsMap(file.FileDescriptorProto, msg.DescriptorProto))
-		}
 	}
 }
 
@@ -287,7 +238,7 @@ func (p *plugin) generateMessage(message *generator.Descriptor, verbose bool, ha
 
 		if !repeated {
 			if field.IsMessage() || p.IsGroup(field) {
-				p.P(`if this.`, generator.SetterName(fieldname), ` && !this.`, fieldname, `.Equal(that1.`, fieldname, `) {`)
+				p.P(`if this.`, generator.SetterName(fieldname), ` && !this.`, fieldname, `.Equal(`, generator.MessageRef(field), `that1.`, fieldname, `) {`)
 			} else if field.IsBytes() {
 				p.P(`if this.`, generator.SetterName(fieldname), ` && !`, p.bytesPkg.Use(), `.Equal(this.`, fieldname, `, that1.`, fieldname, `) {`)
 			} else {
@@ -305,7 +256,7 @@ func (p *plugin) generateMessage(message *generator.Descriptor, verbose bool, ha
 			p.P(`for i := 0; i < this.`, generator.SizerName(fieldname), `; i++ {`)
 			p.In()
 			if field.IsMessage() || p.IsGroup(field) {
-				p.P(`if !this.`, fieldname, `[i].Equal(that1.`, fieldname, `[i]) {`)
+				p.P(`if !this.`, fieldname, `[i].Equal(`, generator.MessageRef(field), `that1.`, fieldname, `[i]) {`)
 			} else if field.IsBytes() {
 				p.P(`if !`, p.bytesPkg.Use(), `.Equal(this.`, fieldname, `[i], that1.`, fieldname, `[i]) {`)
 			} else {
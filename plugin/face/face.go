@@ -135,7 +135,7 @@ func (p *plugin) Generate(file *generator.FileDescriptor) {
 			continue
 		}
 		if message.DescriptorProto.HasExtension() {
-			panic("face does not support message with extensions")
+			p.FailAt(message, nil, "face does not support message with extensions")
 		}
 		ccTypeName := generator.CamelCaseSlice(message.TypeName())
 		p.P(`type `, ccTypeName, `Face interface{`)
@@ -190,7 +190,14 @@ func (p *plugin) GenerateField(message *generator.Descriptor, field *descriptor.
 	if gogoproto.IsCustomType(field) {
 		_, typ, err := generator.GetCustomType(field)
 		if err != nil {
-			panic(err)
+			p.FailAt(message, field, err.Error())
+		}
+		ctype = typ
+	}
+	if gogoproto.IsCastType(field) {
+		_, typ, err := generator.GetCastType(field)
+		if err != nil {
+			p.FailAt(message, field, err.Error())
 		}
 		ctype = typ
 	}
@@ -200,9 +207,13 @@ func (p *plugin) GenerateField(message *generator.Descriptor, field *descriptor.
 		if len(goTypNames) == 2 {
 			funcName = goTypNames[0] + ".NewPopulated" + goTypNames[1]
 		} else if len(goTypNames) != 1 {
-			panic(fmt.Errorf("unreachable: too many dots in %v", goTypName))
+			p.FailAt(message, field, "too many dots in", goTypName)
 		}
 		funcCall := funcName + "(r, easy)"
+		deref := ""
+		if generator.MessageRef(field) != "" {
+			deref = "*"
+		}
 		if field.IsRepeated() {
 			p.P(p.varGen.Next(), ` := r.Intn(10)`)
 			p.P(`this.`, fieldname, ` = make(`, goTyp, `, `, p.varGen.Current(), `)`)
@@ -210,13 +221,13 @@ func (p *plugin) GenerateField(message *generator.Descriptor, field *descriptor.
 			p.In()
 			p.P(p.varGen.Next(), `:= `, funcCall)
 			p.P(`this.`, generator.SizerName(fieldname), ` += 1`)
-			p.P(`this.`, fieldname, `[i] = `, p.varGen.Current())
+			p.P(`this.`, fieldname, `[i] = `, deref, p.varGen.Current())
 			p.Out()
 			p.P(`}`)
 		} else {
 			p.P(p.varGen.Next(), `:= `, funcCall)
 			p.P(`this.`, generator.SetterName(fieldname), ` = true`)
-			p.P(`this.`, fieldname, ` = `, p.varGen.Current())
+			p.P(`this.`, fieldname, ` = `, deref, p.varGen.Current())
 		}
 	} else {
 		if field.IsEnum() {
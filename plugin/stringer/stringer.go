@@ -32,6 +32,12 @@ It is enabled by the following extensions:
   - stringer
   - stringer_all
 
+protoc-gen-dgo otherwise generates a String method, which renders the compact
+text format, unless it is disabled using one of the following extensions:
+
+  - goproto_stringer
+  - goproto_stringer_all
+
 The stringer plugin also generates a test given it is enabled using one of the following extensions:
 
   - testgen
@@ -138,7 +144,9 @@ func (p *stringer) Generate(file *generator.FileDescriptor) {
 		p.P("s := ", stringsPkg.Use(), ".Join([]string{`&", ccTypeName, "{`,")
 		for _, field := range message.Field {
 			fieldname := p.GetFieldName(message, field)
-			if field.IsMessage() || p.IsGroup(field) {
+			if p.IsMap(field) {
+				p.P("`", fieldname, ":`", ` + `, p.Pkg["fmt"], `.Sprintf("%v", this.`, fieldname, ") + `,", "`,")
+			} else if field.IsMessage() || p.IsGroup(field) {
 				desc := p.ObjectNamed(field.GetTypeName())
 				msgname := p.TypeName(desc)
 				msgnames := strings.Split(msgname, ".")
@@ -37,6 +37,9 @@ Benchmarks are enabled using the following extensions:
   - benchgen
   - benchgen_all
 
+Messages with a pool, enabled using the pool or pool_all extensions, also get
+a benchmark which unmarshals into messages acquired from the pool.
+
 Let us look at:
 
   code.google.com/p/gogoprotobuf/test/example/example.proto
@@ -418,6 +421,43 @@ func (p *testProto) Generate(imports generator.PluginImports, file *generator.Fi
 			p.Out()
 			p.P(`}`)
 			p.P()
+
+			if gogoproto.HasPool(file.FileDescriptorProto, message.DescriptorProto) {
+				p.P(`func Benchmark`, ccTypeName, `PoolUnmarshal(b *`, testingPkg.Use(), `.B) {`)
+				p.In()
+				p.P(`popr := `, randPkg.Use(), `.New(`, randPkg.Use(), `.NewSource(616))`)
+				p.P(`total := 0`)
+				p.P(`datas := make([][]byte, 10000)`)
+				p.P(`for i := 0; i < 10000; i++ {`)
+				p.In()
+				p.P(`data, err := `, protoPkg.Use(), `.Marshal(NewPopulated`, ccTypeName, `(popr, false))`)
+				p.P(`if err != nil {`)
+				p.In()
+				p.P(`panic(err)`)
+				p.Out()
+				p.P(`}`)
+				p.P(`datas[i] = data`)
+				p.Out()
+				p.P(`}`)
+				p.P(`b.ReportAllocs()`)
+				p.P(`b.ResetTimer()`)
+				p.P(`for i := 0; i < b.N; i++ {`)
+				p.In()
+				p.P(`total += len(datas[i%10000])`)
+				p.P(`msg := Acquire`, ccTypeName, `()`)
+				p.P(`if err := msg.Unmarshal(datas[i%10000]); err != nil {`)
+				p.In()
+				p.P(`panic(err)`)
+				p.Out()
+				p.P(`}`)
+				p.P(`msg.Release()`)
+				p.Out()
+				p.P(`}`)
+				p.P(`b.SetBytes(int64(total / b.N))`)
+				p.Out()
+				p.P(`}`)
+				p.P()
+			}
 		}
 	}
 	return used
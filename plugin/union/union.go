@@ -138,7 +138,7 @@ func (p *union) Generate(file *generator.FileDescriptor) {
 			continue
 		}
 		if message.DescriptorProto.HasExtension() {
-			panic("onlyone does not currently support extensions")
+			p.FailAt(message, nil, "onlyone does not currently support extensions")
 		}
 
 		ccTypeName := generator.CamelCaseSlice(message.TypeName())
@@ -147,7 +147,7 @@ func (p *union) Generate(file *generator.FileDescriptor) {
 		for _, field := range message.Field {
 			fieldname := p.GetFieldName(message, field)
 			if fieldname == "Value" {
-				panic("cannot have a onlyone message " + ccTypeName + " with a field named Value")
+				p.FailAt(message, field, "cannot have a onlyone message with a field named Value")
 			}
 			p.P(`if this.`, generator.SetterName(fieldname), ` == true {`)
 			p.In()
@@ -41,7 +41,11 @@ import (
 )
 
 // Clone returns a deep copy of a protocol buffer.
+// Generated messages are copied using their Clone method.
 func Clone(pb Message) Message {
+	if c, ok := pb.(cloner); ok {
+		return c.Clone()
+	}
 	in := reflect.ValueOf(pb)
 	if in.IsNil() {
 		return pb
@@ -57,6 +61,7 @@ func Clone(pb Message) Message {
 // Required and optional fields that are set in src will be set to that value in dst.
 // Elements of repeated fields will be appended.
 // Merge panics if src and dst are not the same type, or if dst is nil.
+// Generated messages are merged using their MergeFrom method.
 func Merge(dst, src Message) {
 	in := reflect.ValueOf(src)
 	out := reflect.ValueOf(dst)
@@ -71,6 +76,10 @@ func Merge(dst, src Message) {
 		// Merging nil into non-nil is a quiet no-op
 		return
 	}
+	if m, ok := dst.(merger); ok {
+		m.MergeFrom(src)
+		return
+	}
 	mergeStruct(out.Elem(), in.Elem())
 }
 
@@ -125,6 +134,10 @@ func mergeAny(out, in reflect.Value) {
 		if out.IsNil() {
 			out.Set(reflect.New(in.Elem().Type()))
 		}
+		if m, ok := out.Interface().(merger); ok {
+			m.MergeFrom(in.Interface().(Message))
+			return
+		}
 		mergeAny(out.Elem(), in.Elem())
 	case reflect.Slice:
 		if in.IsNil() {
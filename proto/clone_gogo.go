@@ -0,0 +1,53 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+// cloner is implemented by generated messages, whose fields are private and
+// cannot be copied using reflection.
+type cloner interface {
+	Clone() Message
+}
+
+// merger is implemented by generated messages, whose fields are private and
+// cannot be copied using reflection.
+type merger interface {
+	MergeFrom(src Message)
+}
+
+// MergeExtensions merges the extensions of src into dst. It is called from
+// the MergeFrom method of generated messages.
+func MergeExtensions(dst, src Message) {
+	if emSrc, ok := src.(extensionsMap); ok {
+		emDst := dst.(extensionsMap)
+		mergeExtension(emDst.ExtensionMap(), emSrc.ExtensionMap())
+	} else if emSrc, ok := src.(extensionsBytes); ok {
+		emDst := dst.(extensionsBytes)
+		bSrc := emSrc.GetExtensions()
+		bDst := emDst.GetExtensions()
+		*bDst = append(*bDst, *bSrc...)
+	}
+}
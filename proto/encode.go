@@ -236,10 +236,13 @@ func (p *Buffer) Marshal(pb Message) error {
 	if m, ok := pb.(Marshaler); ok {
 		data, err := m.Marshal()
 		if err != nil {
-			return err
+			// The data is still valid if a required field is not set.
+			if _, ok := err.(*RequiredNotSetError); !ok {
+				return err
+			}
 		}
 		p.buf = append(p.buf, data...)
-		return nil
+		return err
 	}
 
 	t, base, err := getbase(pb)
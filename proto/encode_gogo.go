@@ -154,6 +154,109 @@ func size_ref_string(p *Properties, base structPointer) (n int) {
 	return
 }
 
+// Encode a proto3 bool, which is omitted when false.
+func (o *Buffer) enc_proto3_bool(p *Properties, base structPointer) error {
+	v := *structPointer_RefBool(base, p.field)
+	if !v {
+		return ErrNil
+	}
+	o.buf = append(o.buf, p.tagcode...)
+	p.valEnc(o, 1)
+	return nil
+}
+
+func size_proto3_bool(p *Properties, base structPointer) int {
+	v := *structPointer_RefBool(base, p.field)
+	if !v {
+		return 0
+	}
+	return len(p.tagcode) + 1 // each bool takes exactly one byte
+}
+
+// Encode a proto3 int32, which is omitted when zero.
+func (o *Buffer) enc_proto3_int32(p *Properties, base structPointer) error {
+	x := refWord32_Get(structPointer_RefWord32(base, p.field))
+	if x == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, p.tagcode...)
+	p.valEnc(o, uint64(x))
+	return nil
+}
+
+func size_proto3_int32(p *Properties, base structPointer) (n int) {
+	x := refWord32_Get(structPointer_RefWord32(base, p.field))
+	if x == 0 {
+		return 0
+	}
+	n += len(p.tagcode)
+	n += p.valSize(uint64(x))
+	return
+}
+
+// Encode a proto3 int64, which is omitted when zero.
+func (o *Buffer) enc_proto3_int64(p *Properties, base structPointer) error {
+	x := refWord64_Get(structPointer_RefWord64(base, p.field))
+	if x == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, p.tagcode...)
+	p.valEnc(o, x)
+	return nil
+}
+
+func size_proto3_int64(p *Properties, base structPointer) (n int) {
+	x := refWord64_Get(structPointer_RefWord64(base, p.field))
+	if x == 0 {
+		return 0
+	}
+	n += len(p.tagcode)
+	n += p.valSize(x)
+	return
+}
+
+// Encode a proto3 string, which is omitted when empty.
+func (o *Buffer) enc_proto3_string(p *Properties, base structPointer) error {
+	x := *structPointer_RefString(base, p.field)
+	if x == "" {
+		return ErrNil
+	}
+	o.buf = append(o.buf, p.tagcode...)
+	o.EncodeStringBytes(x)
+	return nil
+}
+
+func size_proto3_string(p *Properties, base structPointer) (n int) {
+	x := *structPointer_RefString(base, p.field)
+	if x == "" {
+		return 0
+	}
+	n += len(p.tagcode)
+	n += sizeStringBytes(x)
+	return
+}
+
+// Encode proto3 bytes, which are omitted when empty.
+func (o *Buffer) enc_proto3_slice_byte(p *Properties, base structPointer) error {
+	s := *structPointer_Bytes(base, p.field)
+	if len(s) == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, p.tagcode...)
+	o.EncodeRawBytes(s)
+	return nil
+}
+
+func size_proto3_slice_byte(p *Properties, base structPointer) (n int) {
+	s := *structPointer_Bytes(base, p.field)
+	if len(s) == 0 {
+		return 0
+	}
+	n += len(p.tagcode)
+	n += sizeRawBytes(s)
+	return
+}
+
 // Encode a reference to a message struct.
 func (o *Buffer) enc_ref_struct_message(p *Properties, base structPointer) error {
 	var state errorState
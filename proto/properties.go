@@ -159,6 +159,7 @@ type Properties struct {
 	Optional   bool
 	Repeated   bool
 	Packed     bool   // relevant for repeated primitives only
+	Proto3     bool   // whether this is a proto3 field, where zero values are not encoded
 	Enum       string // set for enum types only
 	Default    string // default value
 	CustomType string
@@ -203,6 +204,9 @@ func (p *Properties) String() string {
 	if p.Packed {
 		s += ",packed"
 	}
+	if p.Proto3 {
+		s += ",proto3"
+	}
 	if p.OrigName != p.Name {
 		s += ",name=" + p.OrigName
 	}
@@ -276,6 +280,8 @@ func (p *Properties) Parse(s string) {
 			p.Repeated = true
 		case f == "packed":
 			p.Packed = true
+		case f == "proto3":
+			p.Proto3 = true
 		case strings.HasPrefix(f, "name="):
 			p.OrigName = f[5:]
 		case strings.HasPrefix(f, "enum="):
@@ -313,6 +319,9 @@ func (p *Properties) setEncAndDec(typ reflect.Type, lockGetProp bool) {
 	}
 	switch t1 := typ; t1.Kind() {
 	default:
+		if p.Proto3 && p.setProto3EncAndDec(t1) {
+			break
+		}
 		if !p.setNonNullableEncAndDec(t1) {
 			fmt.Fprintf(os.Stderr, "proto: no coders for %T\n", t1)
 		}
@@ -402,6 +411,10 @@ func (p *Properties) setEncAndDec(typ reflect.Type, lockGetProp bool) {
 					p.enc = (*Buffer).enc_slice_byte
 					p.dec = (*Buffer).dec_slice_byte
 					p.size = size_slice_byte
+					if p.Proto3 {
+						p.enc = (*Buffer).enc_proto3_slice_byte
+						p.size = size_proto3_slice_byte
+					}
 				}
 			default:
 				logNoSliceEnc(t1, t2)
@@ -668,3 +681,33 @@ func RegisterEnum(typeName string, unusedNameMap map[int32]string, valueMap map[
 	}
 	enumStringMaps[typeName] = unusedNameMap
 }
+
+// A registry of all the message types, by fully qualified proto name.
+// The generated code will register the messages by calling RegisterType.
+
+var protoTypes = make(map[string]reflect.Type)
+var revProtoTypes = make(map[reflect.Type]string)
+
+// RegisterType is called from the generated code to map the fully qualified
+// proto name of a message, such as "pkg.Outer.Inner", to its Go type. It
+// panics if two types are registered with the same name.
+func RegisterType(x Message, name string) {
+	if _, ok := protoTypes[name]; ok {
+		panic("proto: duplicate proto type registered: " + name)
+	}
+	t := reflect.TypeOf(x)
+	protoTypes[name] = t
+	revProtoTypes[t] = name
+}
+
+// MessageName returns the fully qualified proto name of the type of x, or
+// the empty string if it was not registered.
+func MessageName(x Message) string {
+	return revProtoTypes[reflect.TypeOf(x)]
+}
+
+// MessageType returns the Go type, a pointer to a struct, of the message
+// registered with the fully qualified proto name, or nil if there is none.
+func MessageType(name string) reflect.Type {
+	return protoTypes[name]
+}
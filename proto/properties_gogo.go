@@ -92,6 +92,29 @@ func (p *Properties) setNonNullableEncAndDec(typ reflect.Type) bool {
 	return true
 }
 
+// Sets the coders of a proto3 scalar field, whose zero value is not encoded.
+func (p *Properties) setProto3EncAndDec(typ reflect.Type) bool {
+	// The decoders are the same as for non-nullable fields.
+	if !p.setNonNullableEncAndDec(typ) {
+		return false
+	}
+	switch typ.Kind() {
+	case reflect.Bool:
+		p.enc = (*Buffer).enc_proto3_bool
+		p.size = size_proto3_bool
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		p.enc = (*Buffer).enc_proto3_int32
+		p.size = size_proto3_int32
+	case reflect.Int64, reflect.Uint64, reflect.Float64:
+		p.enc = (*Buffer).enc_proto3_int64
+		p.size = size_proto3_int64
+	case reflect.String:
+		p.enc = (*Buffer).enc_proto3_string
+		p.size = size_proto3_string
+	}
+	return true
+}
+
 func (p *Properties) setSliceOfNonPointerStructs(typ reflect.Type) {
 	t2 := typ.Elem()
 	p.sstype = typ
@@ -0,0 +1,212 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Kind is the protocol buffer type of a field. Its values are those of the
+// Type of a FieldDescriptorProto.
+type Kind int32
+
+const (
+	DoubleKind   Kind = 1
+	FloatKind    Kind = 2
+	Int64Kind    Kind = 3
+	Uint64Kind   Kind = 4
+	Int32Kind    Kind = 5
+	Fixed64Kind  Kind = 6
+	Fixed32Kind  Kind = 7
+	BoolKind     Kind = 8
+	StringKind   Kind = 9
+	GroupKind    Kind = 10
+	MessageKind  Kind = 11
+	BytesKind    Kind = 12
+	Uint32Kind   Kind = 13
+	EnumKind     Kind = 14
+	Sfixed32Kind Kind = 15
+	Sfixed64Kind Kind = 16
+	Sint32Kind   Kind = 17
+	Sint64Kind   Kind = 18
+)
+
+var kindNames = map[Kind]string{
+	DoubleKind:   "double",
+	FloatKind:    "float",
+	Int64Kind:    "int64",
+	Uint64Kind:   "uint64",
+	Int32Kind:    "int32",
+	Fixed64Kind:  "fixed64",
+	Fixed32Kind:  "fixed32",
+	BoolKind:     "bool",
+	StringKind:   "string",
+	GroupKind:    "group",
+	MessageKind:  "message",
+	BytesKind:    "bytes",
+	Uint32Kind:   "uint32",
+	EnumKind:     "enum",
+	Sfixed32Kind: "sfixed32",
+	Sfixed64Kind: "sfixed64",
+	Sint32Kind:   "sint32",
+	Sint64Kind:   "sint64",
+}
+
+// String returns the name of the type in the .proto language.
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Label is the cardinality of a field. Its values are those of the Label of
+// a FieldDescriptorProto.
+type Label int32
+
+const (
+	OptionalLabel Label = 1
+	RequiredLabel Label = 2
+	RepeatedLabel Label = 3
+)
+
+func (l Label) String() string {
+	switch l {
+	case OptionalLabel:
+		return "optional"
+	case RequiredLabel:
+		return "required"
+	case RepeatedLabel:
+		return "repeated"
+	}
+	return "Label(" + strconv.Itoa(int(l)) + ")"
+}
+
+// FieldInfo describes a field of a generated message, and accesses it in
+// any message of that type through the generated methods.
+//
+// The values are of the Go type of the field in the generated code: the
+// type returned by its getter for a singular field, a slice of the elements
+// for a repeated field, and a map for a map field.
+type FieldInfo struct {
+	Number int32
+	// The name of the field in the .proto file.
+	Name  string
+	Kind  Kind
+	Label Label
+	// True for a map field, which is a repeated field of map entries.
+	Map bool
+	// The name of the oneof the field is a member of, if any.
+	Oneof string
+	// The value of a singular scalar field that is not set, or nil.
+	Default interface{}
+	// New returns an empty message of the type of a message or group field.
+	// It is nil for the other fields, including maps.
+	New func() Message
+
+	// Get returns the value of the field, or its default value if it is not
+	// set. Repeated and map fields are copied.
+	Get func(m Message) interface{}
+	// Set sets the field to a copy of v, which must be of the Go type of
+	// the field. A nil message clears the field.
+	Set func(m Message, v interface{}) error
+	// Has returns true if the field is set, or, if it is repeated, is not
+	// empty.
+	Has func(m Message) bool
+	// Clear clears the field.
+	Clear func(m Message)
+}
+
+// MessageInfo is the reflection table of a generated message type.
+type MessageInfo struct {
+	// The full name of the message type, such as "my.package.Message".
+	Name string
+	// The fields, in the order they are declared in.
+	Fields []*FieldInfo
+}
+
+// FieldByName returns the field with the given name, or nil if there is none.
+func (mi *MessageInfo) FieldByName(name string) *FieldInfo {
+	for _, f := range mi.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// FieldByNumber returns the field with the given number, or nil if there is
+// none.
+func (mi *MessageInfo) FieldByNumber(number int32) *FieldInfo {
+	for _, f := range mi.Fields {
+		if f.Number == number {
+			return f
+		}
+	}
+	return nil
+}
+
+// Range calls f with each field that is set in m and its value, until f
+// returns false.
+func (mi *MessageInfo) Range(m Message, f func(field *FieldInfo, v interface{}) bool) {
+	for _, field := range mi.Fields {
+		if field.Has(m) && !f(field, field.Get(m)) {
+			return
+		}
+	}
+}
+
+// reflecter is implemented by the messages generated by protoc-gen-dgo,
+// whose fields are private and so cannot be accessed by the reflect package.
+type reflecter interface {
+	ProtoReflect() *MessageInfo
+}
+
+// Reflect returns the reflection table of the type of m, which lets generic
+// code walk and modify messages without knowing their Go types:
+//
+//	info := proto.Reflect(m)
+//	info.Range(m, func(f *proto.FieldInfo, v interface{}) bool {
+//		fmt.Println(f.Name, v)
+//		return true
+//	})
+//
+// It returns nil if m was not generated by protoc-gen-dgo.
+func Reflect(m Message) *MessageInfo {
+	if r, ok := m.(reflecter); ok {
+		return r.ProtoReflect()
+	}
+	return nil
+}
+
+// NewFieldTypeError returns the error of setting the field name of m to v,
+// which is not of the Go type of the field. It is called from the reflection
+// tables of generated messages.
+func NewFieldTypeError(m Message, name string, v interface{}) error {
+	return fmt.Errorf("proto: field %s of %T cannot be set to a %T", name, m, v)
+}
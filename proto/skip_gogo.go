@@ -79,31 +79,11 @@ func Skip(data []byte) (n int, err error) {
 			index += length
 			return index, nil
 		case 3:
-			for {
-				var wire uint64
-				var start int = index
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				wireType := int(wire & 0x7)
-				if wireType == 4 {
-					break
-				}
-				next, err := Skip(data[start:])
-				if err != nil {
-					return 0, err
-				}
-				index = start + next
+			_, n, err := SkipGroup(data[index:], int32(wire>>3))
+			if err != nil {
+				return 0, err
 			}
-			return index, nil
+			return index + n, nil
 		case 4:
 			return index, nil
 		case 5:
@@ -115,3 +95,39 @@ func Skip(data []byte) (n int, err error) {
 	}
 	panic("unreachable")
 }
+
+// SkipGroup is given the data following the start group key of field
+// fieldNum. It returns the length of the group's fields and the length
+// including the matching end group key. Nested groups are skipped as a whole,
+// so only an end group key of the same depth can end the group.
+func SkipGroup(data []byte, fieldNum int32) (body int, n int, err error) {
+	l := len(data)
+	index := 0
+	for index < l {
+		start := index
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return 0, 0, io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if int(wire&0x7) == WireEndGroup {
+			if int32(wire>>3) != fieldNum {
+				return 0, 0, fmt.Errorf("proto: end group of field %d does not match start group of field %d", wire>>3, fieldNum)
+			}
+			return start, index, nil
+		}
+		next, err := Skip(data[start:])
+		if err != nil {
+			return 0, 0, err
+		}
+		index = start + next
+	}
+	return 0, 0, io.ErrUnexpectedEOF
+}
@@ -1238,7 +1238,7 @@ func (m *MyMessage) String() string { return proto.CompactTextString(m) }
 func (*MyMessage) ProtoMessage()    {}
 
 var extRange_MyMessage = []proto.ExtensionRange{
-	{100, 536870911},
+	{Start: 100, End: 536870911},
 }
 
 func (*MyMessage) ExtensionRangeArray() []proto.ExtensionRange {
@@ -1405,7 +1405,7 @@ var _ proto.Marshaler = (*MyMessageSet)(nil)
 var _ proto.Unmarshaler = (*MyMessageSet)(nil)
 
 var extRange_MyMessageSet = []proto.ExtensionRange{
-	{100, 2147483646},
+	{Start: 100, End: 2147483646},
 }
 
 func (*MyMessageSet) ExtensionRangeArray() []proto.ExtensionRange {
@@ -390,6 +390,10 @@ func writeAny(w *textWriter, v reflect.Value, props *Properties) error {
 			if _, err = w.Write(text); err != nil {
 				return err
 			}
+		} else if v.CanAddr() && isTextFieldsMarshaler(v.Addr()) {
+			if err := writeMessageFields(w, v.Addr().Interface().(Message)); err != nil {
+				return err
+			}
 		} else if err := writeStruct(w, v); err != nil {
 			return err
 		}
@@ -702,8 +706,7 @@ func marshalText(w io.Writer, pb Message, compact bool) error {
 		return nil
 	}
 	// Dereference the received pointer so we don't have outer < and >.
-	v := reflect.Indirect(val)
-	if err := writeStruct(aw, v); err != nil {
+	if err := writeMessageFields(aw, pb); err != nil {
 		return err
 	}
 	if bw != nil {
@@ -29,8 +29,182 @@ package proto
 import (
 	"fmt"
 	"reflect"
+	"strings"
 )
 
+// textFieldsMarshaler is implemented by the messages generated by
+// protoc-gen-dgo, whose fields are private and so cannot be walked by
+// writeStruct.
+type textFieldsMarshaler interface {
+	MarshalTextFields(w *TextWriter)
+}
+
+// TextWriter writes the fields of a message in text format. It is used by
+// the generated MarshalTextFields methods. The first error encountered is
+// kept and later writes are ignored.
+type TextWriter struct {
+	w   *textWriter
+	err error
+}
+
+func (w *TextWriter) setErr(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func (w *TextWriter) writeName(name string, colon bool) {
+	if w.err != nil {
+		return
+	}
+	if _, err := w.w.WriteString(name); err != nil {
+		w.setErr(err)
+		return
+	}
+	if colon {
+		if err := w.w.WriteByte(':'); err != nil {
+			w.setErr(err)
+			return
+		}
+	}
+	if !w.w.compact {
+		w.setErr(w.w.WriteByte(' '))
+	}
+}
+
+func (w *TextWriter) endLine() {
+	if w.err == nil {
+		w.setErr(w.w.WriteByte('\n'))
+	}
+}
+
+// Field writes the name of a field, which must be followed by exactly one
+// value.
+func (w *TextWriter) Field(name string) {
+	w.writeName(name, true)
+}
+
+// GroupField writes the name of a group field, which must be followed by
+// Group.
+func (w *TextWriter) GroupField(name string) {
+	w.writeName(name, false)
+}
+
+// Value writes a scalar value: a number, bool, string or []byte.
+func (w *TextWriter) Value(v interface{}) {
+	if w.err == nil {
+		w.setErr(writeAny(w.w, reflect.ValueOf(v), nil))
+	}
+	w.endLine()
+}
+
+// Enum writes the name of an enum value, or its number if it is unknown.
+func (w *TextWriter) Enum(v int32, names map[int32]string) {
+	if w.err == nil {
+		if name, ok := names[v]; ok {
+			_, err := fmt.Fprint(w.w, name)
+			w.setErr(err)
+		} else {
+			_, err := fmt.Fprint(w.w, v)
+			w.setErr(err)
+		}
+	}
+	w.endLine()
+}
+
+func (w *TextWriter) nested(m Message, bra byte, ket byte) {
+	if w.err != nil {
+		return
+	}
+	if reflect.ValueOf(m).IsNil() {
+		_, err := w.w.Write([]byte("<nil>\n"))
+		w.setErr(err)
+		return
+	}
+	if err := w.w.WriteByte(bra); err != nil {
+		w.setErr(err)
+		return
+	}
+	if !w.w.compact {
+		if err := w.w.WriteByte('\n'); err != nil {
+			w.setErr(err)
+			return
+		}
+	}
+	w.w.indent()
+	w.setErr(writeMessageFields(w.w, m))
+	w.w.unindent()
+	if w.err == nil {
+		w.setErr(w.w.WriteByte(ket))
+	}
+	w.endLine()
+}
+
+// Message writes a nested message.
+func (w *TextWriter) Message(m Message) {
+	w.nested(m, '<', '>')
+}
+
+// Group writes the message of a group field.
+func (w *TextWriter) Group(m Message) {
+	w.nested(m, '{', '}')
+}
+
+// Extensions writes the extensions of m, which must be extendable.
+func (w *TextWriter) Extensions(m Message) {
+	if w.err == nil {
+		w.setErr(writeExtensions(w.w, reflect.ValueOf(m)))
+	}
+}
+
+// Unknown writes the fields that were not recognized when unmarshaling.
+func (w *TextWriter) Unknown(data []byte) {
+	if w.err == nil && data != nil {
+		w.setErr(writeUnknownStruct(w.w, data))
+	}
+}
+
+// Writes m as "[type_url] { ... }" if it is an Any holding a message of a
+// registered type, which is expanded. It returns false if m is written as
+// any other message.
+func (w *TextWriter) expandAny(m Message) bool {
+	if MessageName(m) != "google.protobuf.Any" {
+		return false
+	}
+	info := Reflect(m)
+	url, _ := info.FieldByName("type_url").Get(m).(string)
+	value, _ := info.FieldByName("value").Get(m).([]byte)
+	t := MessageType(url[strings.LastIndex(url, "/")+1:])
+	if t == nil {
+		return false
+	}
+	v := reflect.New(t.Elem()).Interface().(Message)
+	if err := Unmarshal(value, v); err != nil {
+		return false
+	}
+	w.GroupField("[" + url + "]")
+	w.Group(v)
+	return true
+}
+
+func isTextFieldsMarshaler(v reflect.Value) bool {
+	_, ok := v.Interface().(textFieldsMarshaler)
+	return ok
+}
+
+// writeMessageFields writes the fields of m, using its generated
+// MarshalTextFields method if it has one.
+func writeMessageFields(w *textWriter, m Message) error {
+	if tm, ok := m.(textFieldsMarshaler); ok {
+		tw := &TextWriter{w: w}
+		if !tw.expandAny(m) {
+			tm.MarshalTextFields(tw)
+		}
+		return tw.err
+	}
+	return writeStruct(w, reflect.ValueOf(m).Elem())
+}
+
 func writeEnum(w *textWriter, v reflect.Value, props *Properties) error {
 	m, ok := enumStringMaps[props.Enum]
 	if !ok {
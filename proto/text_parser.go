@@ -166,7 +166,7 @@ func (p *textParser) advance() {
 	p.cur.offset, p.cur.line = p.offset, p.line
 	p.cur.unquoted = ""
 	switch p.s[0] {
-	case '<', '>', '{', '}', ':', '[', ']', ';', ',':
+	case '<', '>', '{', '}', ':', '[', ']', ';', ',', '/':
 		// Single symbol
 		p.cur.value, p.s = p.s[0:1], p.s[1:len(p.s)]
 	case '"', '\'':
@@ -447,69 +447,9 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) *ParseError
 		}
 		if tok.value == "[" {
 			// Looks like an extension.
-			//
-			// TODO: Check whether we need to handle
-			// namespace rooted names (e.g. ".something.Foo").
-			tok = p.next()
-			if tok.err != nil {
-				return tok.err
-			}
-			var desc *ExtensionDesc
-			// This could be faster, but it's functional.
-			// TODO: Do something smarter than a linear scan.
-			for _, d := range RegisteredExtensions(reflect.New(st).Interface().(Message)) {
-				if d.Name == tok.value {
-					desc = d
-					break
-				}
-			}
-			if desc == nil {
-				return p.errorf("unrecognized extension %q", tok.value)
-			}
-			// Check the extension terminator.
-			tok = p.next()
-			if tok.err != nil {
-				return tok.err
-			}
-			if tok.value != "]" {
-				return p.errorf("unrecognized extension terminator %q", tok.value)
-			}
-
-			props := &Properties{}
-			props.Parse(desc.Tag)
-
-			typ := reflect.TypeOf(desc.ExtensionType)
-			if err := p.checkForColon(props, typ); err != nil {
+			if err := p.readExtension(sv.Addr().Interface().(extendableProto)); err != nil {
 				return err
 			}
-
-			rep := desc.repeated()
-
-			// Read the extension structure, and set it in
-			// the value we're constructing.
-			var ext reflect.Value
-			if !rep {
-				ext = reflect.New(typ).Elem()
-			} else {
-				ext = reflect.New(typ.Elem()).Elem()
-			}
-			if err := p.readAny(ext, props); err != nil {
-				return err
-			}
-			ep := sv.Addr().Interface().(extendableProto)
-			if !rep {
-				SetExtension(ep, desc, ext.Interface())
-			} else {
-				old, err := GetExtension(ep, desc)
-				var sl reflect.Value
-				if err == nil {
-					sl = reflect.ValueOf(old) // existing slice
-				} else {
-					sl = reflect.MakeSlice(typ, 0, 1)
-				}
-				sl = reflect.Append(sl, ext)
-				SetExtension(ep, desc, sl.Interface())
-			}
 		} else {
 			// This is a normal, non-extension field.
 			fi, props, ok := structFieldByName(st, tok.value)
@@ -555,6 +495,72 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) *ParseError
 	return nil
 }
 
+// Reads an extension of ep. The opening '[' has already been consumed.
+func (p *textParser) readExtension(ep extendableProto) *ParseError {
+	// TODO: Check whether we need to handle
+	// namespace rooted names (e.g. ".something.Foo").
+	tok := p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	var desc *ExtensionDesc
+	// This could be faster, but it's functional.
+	// TODO: Do something smarter than a linear scan.
+	for _, d := range RegisteredExtensions(ep) {
+		if d.Name == tok.value {
+			desc = d
+			break
+		}
+	}
+	if desc == nil {
+		return p.errorf("unrecognized extension %q", tok.value)
+	}
+	// Check the extension terminator.
+	tok = p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	if tok.value != "]" {
+		return p.errorf("unrecognized extension terminator %q", tok.value)
+	}
+
+	props := &Properties{}
+	props.Parse(desc.Tag)
+
+	typ := reflect.TypeOf(desc.ExtensionType)
+	if err := p.checkForColon(props, typ); err != nil {
+		return err
+	}
+
+	rep := desc.repeated()
+
+	// Read the extension structure, and set it in
+	// the value we're constructing.
+	var ext reflect.Value
+	if !rep {
+		ext = reflect.New(typ).Elem()
+	} else {
+		ext = reflect.New(typ.Elem()).Elem()
+	}
+	if err := p.readAny(ext, props); err != nil {
+		return err
+	}
+	if !rep {
+		SetExtension(ep, desc, ext.Interface())
+	} else {
+		old, err := GetExtension(ep, desc)
+		var sl reflect.Value
+		if err == nil {
+			sl = reflect.ValueOf(old) // existing slice
+		} else {
+			sl = reflect.MakeSlice(typ, 0, 1)
+		}
+		sl = reflect.Append(sl, ext)
+		SetExtension(ep, desc, sl.Interface())
+	}
+	return nil
+}
+
 func (p *textParser) readAny(v reflect.Value, props *Properties) *ParseError {
 	tok := p.next()
 	if tok.err != nil {
@@ -696,6 +702,9 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) *ParseError {
 			return p.errorf("expected '{' or '<', found %q", tok.value)
 		}
 		// TODO: Handle nested messages which implement textUnmarshaler.
+		if um, ok := fv.Addr().Interface().(textFieldsUnmarshaler); ok {
+			return p.readFields(um, terminator)
+		}
 		return p.readStruct(fv, terminator)
 	case reflect.Uint32:
 		if x, err := strconv.ParseUint(tok.value, 0, 32); err == nil {
@@ -719,6 +728,12 @@ func UnmarshalText(s string, pb Message) error {
 		return err
 	}
 	pb.Reset()
+	if um, ok := pb.(textFieldsUnmarshaler); ok {
+		if pe := newTextParser(s).readFields(um, ""); pe != nil {
+			return pe
+		}
+		return nil
+	}
 	v := reflect.ValueOf(pb)
 	if pe := newTextParser(s).readStruct(v.Elem(), ""); pe != nil {
 		return pe
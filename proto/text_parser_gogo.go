@@ -0,0 +1,260 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// textFieldsUnmarshaler is implemented by the messages generated by
+// protoc-gen-dgo, whose fields are private and so cannot be set by
+// readStruct.
+type textFieldsUnmarshaler interface {
+	UnmarshalTextField(p *TextParser, name string) (found bool, err error)
+}
+
+// TextParser reads the values of fields in text format. It is used by the
+// generated UnmarshalTextField methods, which are called with the name of
+// each field and read its value, including the ':' that precedes it.
+type TextParser struct {
+	p *textParser
+}
+
+// Reads the fields of m up to the terminator.
+func (p *textParser) readFields(m textFieldsUnmarshaler, terminator string) *ParseError {
+	tp := &TextParser{p}
+	for {
+		tok := p.next()
+		if tok.err != nil {
+			return tok.err
+		}
+		if tok.value == terminator {
+			break
+		}
+		name := tok.value
+		ep, extendable := m.(extendableProto)
+		if name == "[" && extendable {
+			if err := p.readExtension(ep); err != nil {
+				return err
+			}
+		} else {
+			if name == "[" {
+				// An extension of a message that does not keep them in
+				// the proto package, such as a dynamic message, is read
+				// as a field named "[name]". The name may also be the
+				// type URL of the message held by an Any.
+				name = ""
+				for {
+					if tok = p.next(); tok.err != nil {
+						return tok.err
+					}
+					if tok.value == "]" {
+						break
+					}
+					name += tok.value
+				}
+				name = "[" + name + "]"
+			}
+			var found bool
+			var err error
+			if strings.HasPrefix(name, "[") && strings.Contains(name, "/") {
+				found, err = true, tp.readAny(m.(Message), name[1:len(name)-1])
+			} else {
+				found, err = m.UnmarshalTextField(tp, name)
+			}
+			if err != nil {
+				if pe, ok := err.(*ParseError); ok {
+					return pe
+				}
+				return p.errorf("field %q: %v", name, err)
+			}
+			if !found {
+				return p.errorf("unknown field name %q in %T", name, m)
+			}
+		}
+
+		// For backward compatibility, permit a semicolon or comma after a field.
+		tok = p.next()
+		if tok.err != nil {
+			return tok.err
+		}
+		if tok.value != ";" && tok.value != "," {
+			p.back()
+		}
+	}
+	return nil
+}
+
+// Reads the message held by an Any, written as "[type_url] { ... }", and
+// stores it in the type_url and value fields of m. The type of the message
+// must be registered with RegisterType.
+func (p *TextParser) readAny(m Message, url string) error {
+	info := Reflect(m)
+	if info == nil || MessageName(m) != "google.protobuf.Any" {
+		return p.p.errorf("type URL %q in %T, which is not an Any", url, m)
+	}
+	t := MessageType(url[strings.LastIndex(url, "/")+1:])
+	if t == nil {
+		return p.p.errorf("unknown message type %q", url)
+	}
+	v := reflect.New(t.Elem()).Interface().(Message)
+	if err := p.ReadMessage(v); err != nil {
+		return err
+	}
+	data, err := Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := info.FieldByName("type_url").Set(m, url); err != nil {
+		return err
+	}
+	return info.FieldByName("value").Set(m, data)
+}
+
+// Consumes the ':' that separates a field name from its value. It is
+// optional before a message.
+func (p *TextParser) colon(required bool) *ParseError {
+	tok := p.p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	if tok.value != ":" {
+		if required {
+			return p.p.errorf("expected ':', found %q", tok.value)
+		}
+		p.p.back()
+	}
+	return nil
+}
+
+// ReadValue reads a scalar value into v, which must be a pointer. It is used
+// for fields with a custom type.
+func (p *TextParser) ReadValue(v interface{}) error {
+	if pe := p.colon(true); pe != nil {
+		return pe
+	}
+	if pe := p.p.readAny(reflect.ValueOf(v).Elem(), &Properties{}); pe != nil {
+		return pe
+	}
+	return nil
+}
+
+func (p *TextParser) ReadBool() (v bool, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadInt32() (v int32, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadInt64() (v int64, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadUint32() (v uint32, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadUint64() (v uint64, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadFloat32() (v float32, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadFloat64() (v float64, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadString() (v string, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+func (p *TextParser) ReadBytes() (v []byte, err error) {
+	err = p.ReadValue(&v)
+	return v, err
+}
+
+// ReadEnum reads an enum value given either by name or by number.
+func (p *TextParser) ReadEnum(values map[string]int32) (int32, error) {
+	if pe := p.colon(true); pe != nil {
+		return 0, pe
+	}
+	tok := p.p.next()
+	if tok.err != nil {
+		return 0, tok.err
+	}
+	if x, err := strconv.ParseInt(tok.value, 0, 32); err == nil {
+		return int32(x), nil
+	}
+	if x, ok := values[tok.value]; ok {
+		return x, nil
+	}
+	return 0, p.p.errorf("invalid enum value %q", tok.value)
+}
+
+// ReadMessage reads a nested message or group, enclosed in '<' and '>' or
+// '{' and '}', and merges it into m.
+func (p *TextParser) ReadMessage(m Message) error {
+	if pe := p.colon(false); pe != nil {
+		return pe
+	}
+	tok := p.p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	var terminator string
+	switch tok.value {
+	case "{":
+		terminator = "}"
+	case "<":
+		terminator = ">"
+	default:
+		return p.p.errorf("expected '{' or '<', found %q", tok.value)
+	}
+	var pe *ParseError
+	if um, ok := m.(textFieldsUnmarshaler); ok {
+		pe = p.p.readFields(um, terminator)
+	} else {
+		pe = p.p.readStruct(reflect.ValueOf(m).Elem(), terminator)
+	}
+	if pe != nil {
+		return pe
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package command runs the generator of protoc-gen-dgo on a
+// CodeGeneratorRequest, for protoc-gen-dgo and dgoc.
+package command
+
+import (
+	"strings"
+
+	"github.com/dropbox/goprotoc/proto"
+	"github.com/dropbox/goprotoc/protoc-gen-dgo/generator"
+	plugin "github.com/dropbox/goprotoc/protoc-gen-dgo/plugin"
+
+	_ "github.com/dropbox/goprotoc/plugin/description"
+	_ "github.com/dropbox/goprotoc/plugin/embedcheck"
+	_ "github.com/dropbox/goprotoc/plugin/enumstringer"
+	_ "github.com/dropbox/goprotoc/plugin/equal"
+	_ "github.com/dropbox/goprotoc/plugin/face"
+	_ "github.com/dropbox/goprotoc/plugin/populate"
+	_ "github.com/dropbox/goprotoc/plugin/stringer"
+	_ "github.com/dropbox/goprotoc/plugin/union"
+
+	"github.com/dropbox/goprotoc/plugin/testgen"
+)
+
+// Generate generates the .pb.go files of the files of req, and the
+// pb_test.go files of the messages which have testgen or benchgen set.
+func Generate(req *plugin.CodeGeneratorRequest) *plugin.CodeGeneratorResponse {
+	// The generators modify the descriptors of their requests, so each one
+	// gets its own copy.
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return &plugin.CodeGeneratorResponse{
+			Error: proto.String("failed to marshal input proto: " + err.Error()),
+		}
+	}
+
+	g := newGenerator(data)
+	g.GenerateAllFiles()
+	if g.Err() != nil {
+		return g.Response
+	}
+
+	gtest := newGenerator(data)
+	gtest.GeneratePlugin(testgen.NewPlugin())
+	if gtest.Err() != nil {
+		return gtest.Response
+	}
+
+	for i := 0; i < len(gtest.Response.File); i++ {
+		if strings.Contains(*gtest.Response.File[i].Content, `//These tests are generated by github.com/dropbox/goprotoc/plugin/testgen`) {
+			gtest.Response.File[i].Name = proto.String(strings.Replace(*gtest.Response.File[i].Name, ".pb.go", "pb_test.go", -1))
+			g.Response.File = append(g.Response.File, gtest.Response.File[i])
+		}
+	}
+	return g.Response
+}
+
+func newGenerator(data []byte) *generator.Generator {
+	g := generator.New()
+
+	// Generate marshaled the request, so it always unmarshals.
+	if err := proto.Unmarshal(data, g.Request); err != nil {
+		panic(err)
+	}
+
+	// The steps stop at the first problem, which Generate returns as the
+	// error of the response.
+	g.CommandLineParameters(g.Request.GetParameter())
+
+	// Create a wrapped version of the Descriptors and EnumDescriptors that
+	// point to the file that defines them.
+	g.WrapTypes()
+
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	return g
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package command
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dropbox/goprotoc/parser"
+	plugin "github.com/dropbox/goprotoc/protoc-gen-dgo/plugin"
+)
+
+// Parses the given files, which may import gogo.proto, and returns a request
+// to generate the named ones.
+func newRequest(t *testing.T, files map[string]string, names []string) *plugin.CodeGeneratorRequest {
+	dir, err := ioutil.TempDir("", "command")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pkg, err := build.Import("github.com/dropbox/goprotoc/gogoproto", "", build.FindOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := parser.ParseFiles(names, dir, pkg.SrcRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &plugin.CodeGeneratorRequest{
+		FileToGenerate: names,
+		ProtoFile:      set.File,
+	}
+}
+
+func TestGenerateDiagnostics(t *testing.T) {
+	files := map[string]string{
+		"a.proto": `package a;
+message A {
+  optional int64 id = 1;
+}
+`,
+		"b.proto": `package a;
+import "github.com/dropbox/goprotoc/gogoproto/gogo.proto";
+message B {
+  optional int64 id = 1;
+}
+message C {
+  option (gogoproto.onlyone) = true;
+  optional int64 id = 1;
+  extensions 100 to 200;
+}
+`,
+		"c.proto": `package a;
+import "github.com/dropbox/goprotoc/gogoproto/gogo.proto";
+
+  message D {
+    option (gogoproto.face) = true;
+    option (gogoproto.goproto_getters) = false;
+    extensions 100 to 200;
+  }
+`,
+	}
+	names := []string{"a.proto", "b.proto", "c.proto"}
+	req := newRequest(t, files, names)
+
+	resp := Generate(req)
+	expected := "b.proto:6:1: message a.C: onlyone does not currently support extensions\n" +
+		"c.proto:4:3: message a.D: face does not support message with extensions"
+	if resp.GetError() != expected {
+		t.Errorf("expected error %q, got %q", expected, resp.GetError())
+	}
+	if len(resp.File) != 0 {
+		t.Errorf("expected no files, got %d", len(resp.File))
+	}
+
+	resp = Generate(&plugin.CodeGeneratorRequest{
+		FileToGenerate: names[:1],
+		ProtoFile:      req.ProtoFile[:1],
+	})
+	if resp.Error != nil {
+		t.Fatalf("unexpected error %q", resp.GetError())
+	}
+	if len(resp.File) != 1 || resp.File[0].GetName() != "a.pb.go" {
+		t.Errorf("expected a.pb.go, got %v", resp.File)
+	}
+}
+
+func TestGenerateFieldOptionDiagnostics(t *testing.T) {
+	files := map[string]string{
+		"a.proto": `package a;
+import "github.com/dropbox/goprotoc/gogoproto/gogo.proto";
+message A {
+  map<string, A> values = 1 [(gogoproto.nullable) = false];
+}
+`,
+		"b.proto": `package a;
+import "github.com/dropbox/goprotoc/gogoproto/gogo.proto";
+message B {
+  optional B child = 1 [(gogoproto.casttype) = "Child"];
+}
+`,
+		"c.proto": `package a;
+import "github.com/dropbox/goprotoc/gogoproto/gogo.proto";
+message C {
+  repeated int64 ids = 1 [(gogoproto.castkey) = "ID"];
+}
+`,
+	}
+	resp := Generate(newRequest(t, files, []string{"a.proto", "b.proto", "c.proto"}))
+	expected := "a.proto:4:3: message a.A: field values: nullable=false is not supported on map fields\n" +
+		"b.proto:4:3: message a.B: field child: casttype is only supported on scalar fields\n" +
+		"c.proto:4:3: message a.C: field ids: castkey is only supported on map fields"
+	if resp.GetError() != expected {
+		t.Errorf("expected error %q, got %q", expected, resp.GetError())
+	}
+}
@@ -46,14 +46,14 @@ func (this *FileDescriptorProto) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&google_protobuf.FileDescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `Package:` + valueToGoStringDescriptor(this.Package, "string"), `Dependency:` + fmt.Sprintf("%#v", this.Dependency), `PublicDependency:` + fmt.Sprintf("%#v", this.PublicDependency), `WeakDependency:` + fmt.Sprintf("%#v", this.WeakDependency), `MessageType:` + fmt.Sprintf("%#v", this.MessageType), `EnumType:` + fmt.Sprintf("%#v", this.EnumType), `Service:` + fmt.Sprintf("%#v", this.Service), `Extension:` + fmt.Sprintf("%#v", this.Extension), `Options:` + fmt.Sprintf("%#v", this.Options), `SourceCodeInfo:` + fmt.Sprintf("%#v", this.SourceCodeInfo), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
+	s := strings.Join([]string{`&google_protobuf.FileDescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `Package:` + valueToGoStringDescriptor(this.Package, "string"), `Dependency:` + fmt.Sprintf("%#v", this.Dependency), `PublicDependency:` + fmt.Sprintf("%#v", this.PublicDependency), `WeakDependency:` + fmt.Sprintf("%#v", this.WeakDependency), `MessageType:` + fmt.Sprintf("%#v", this.MessageType), `EnumType:` + fmt.Sprintf("%#v", this.EnumType), `Service:` + fmt.Sprintf("%#v", this.Service), `Extension:` + fmt.Sprintf("%#v", this.Extension), `Options:` + fmt.Sprintf("%#v", this.Options), `SourceCodeInfo:` + fmt.Sprintf("%#v", this.SourceCodeInfo), `Syntax:` + valueToGoStringDescriptor(this.Syntax, "string"), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
 	return s
 }
 func (this *DescriptorProto) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&google_protobuf.DescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `Field:` + fmt.Sprintf("%#v", this.Field), `Extension:` + fmt.Sprintf("%#v", this.Extension), `NestedType:` + fmt.Sprintf("%#v", this.NestedType), `EnumType:` + fmt.Sprintf("%#v", this.EnumType), `ExtensionRange:` + fmt.Sprintf("%#v", this.ExtensionRange), `Options:` + fmt.Sprintf("%#v", this.Options), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
+	s := strings.Join([]string{`&google_protobuf.DescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `Field:` + fmt.Sprintf("%#v", this.Field), `Extension:` + fmt.Sprintf("%#v", this.Extension), `NestedType:` + fmt.Sprintf("%#v", this.NestedType), `EnumType:` + fmt.Sprintf("%#v", this.EnumType), `ExtensionRange:` + fmt.Sprintf("%#v", this.ExtensionRange), `OneofDecl:` + fmt.Sprintf("%#v", this.OneofDecl), `Options:` + fmt.Sprintf("%#v", this.Options), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
 	return s
 }
 func (this *DescriptorProto_ExtensionRange) GoString() string {
@@ -67,7 +67,14 @@ func (this *FieldDescriptorProto) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&google_protobuf.FieldDescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `Number:` + valueToGoStringDescriptor(this.Number, "int32"), `Label:` + valueToGoStringDescriptor(this.Label, "google_protobuf.FieldDescriptorProto_Label"), `Type:` + valueToGoStringDescriptor(this.Type, "google_protobuf.FieldDescriptorProto_Type"), `TypeName:` + valueToGoStringDescriptor(this.TypeName, "string"), `Extendee:` + valueToGoStringDescriptor(this.Extendee, "string"), `DefaultValue:` + valueToGoStringDescriptor(this.DefaultValue, "string"), `Options:` + fmt.Sprintf("%#v", this.Options), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
+	s := strings.Join([]string{`&google_protobuf.FieldDescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `Number:` + valueToGoStringDescriptor(this.Number, "int32"), `Label:` + valueToGoStringDescriptor(this.Label, "google_protobuf.FieldDescriptorProto_Label"), `Type:` + valueToGoStringDescriptor(this.Type, "google_protobuf.FieldDescriptorProto_Type"), `TypeName:` + valueToGoStringDescriptor(this.TypeName, "string"), `Extendee:` + valueToGoStringDescriptor(this.Extendee, "string"), `DefaultValue:` + valueToGoStringDescriptor(this.DefaultValue, "string"), `OneofIndex:` + valueToGoStringDescriptor(this.OneofIndex, "int32"), `Options:` + fmt.Sprintf("%#v", this.Options), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
+	return s
+}
+func (this *OneofDescriptorProto) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&google_protobuf.OneofDescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
 	return s
 }
 func (this *EnumDescriptorProto) GoString() string {
@@ -95,7 +102,7 @@ func (this *MethodDescriptorProto) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&google_protobuf.MethodDescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `InputType:` + valueToGoStringDescriptor(this.InputType, "string"), `OutputType:` + valueToGoStringDescriptor(this.OutputType, "string"), `Options:` + fmt.Sprintf("%#v", this.Options), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
+	s := strings.Join([]string{`&google_protobuf.MethodDescriptorProto{` + `Name:` + valueToGoStringDescriptor(this.Name, "string"), `InputType:` + valueToGoStringDescriptor(this.InputType, "string"), `OutputType:` + valueToGoStringDescriptor(this.OutputType, "string"), `Options:` + fmt.Sprintf("%#v", this.Options), `ClientStreaming:` + valueToGoStringDescriptor(this.ClientStreaming, "bool"), `ServerStreaming:` + valueToGoStringDescriptor(this.ServerStreaming, "bool"), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
 	return s
 }
 func (this *FileOptions) GoString() string {
@@ -109,7 +116,7 @@ func (this *MessageOptions) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&google_protobuf.MessageOptions{` + `MessageSetWireFormat:` + valueToGoStringDescriptor(this.MessageSetWireFormat, "bool"), `NoStandardDescriptorAccessor:` + valueToGoStringDescriptor(this.NoStandardDescriptorAccessor, "bool"), `UninterpretedOption:` + fmt.Sprintf("%#v", this.UninterpretedOption), `XXX_extensions: ` + extensionToGoStringDescriptor(this.XXX_extensions), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
+	s := strings.Join([]string{`&google_protobuf.MessageOptions{` + `MessageSetWireFormat:` + valueToGoStringDescriptor(this.MessageSetWireFormat, "bool"), `NoStandardDescriptorAccessor:` + valueToGoStringDescriptor(this.NoStandardDescriptorAccessor, "bool"), `MapEntry:` + valueToGoStringDescriptor(this.MapEntry, "bool"), `UninterpretedOption:` + fmt.Sprintf("%#v", this.UninterpretedOption), `XXX_extensions: ` + extensionToGoStringDescriptor(this.XXX_extensions), `XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
 	return s
 }
 func (this *FieldOptions) GoString() string {
@@ -60,7 +60,7 @@ func (field *FieldDescriptorProto) WireType() (wire int) {
 	case FieldDescriptorProto_TYPE_STRING:
 		return 2
 	case FieldDescriptorProto_TYPE_GROUP:
-		return 2
+		return 3
 	case FieldDescriptorProto_TYPE_MESSAGE:
 		return 2
 	case FieldDescriptorProto_TYPE_BYTES:
@@ -270,6 +270,32 @@ func (f *FieldDescriptorProto) IsPacked() bool {
 	return f.Options != nil && f.GetOptions().GetPacked()
 }
 
+// IsPackable returns whether the field is a repeated scalar, which can be
+// encoded both packed and unpacked, whatever its packed option says.
+func (f *FieldDescriptorProto) IsPackable() bool {
+	if !f.IsRepeated() {
+		return false
+	}
+	switch f.WireType() {
+	case 0, 1, 5:
+		return true
+	}
+	return false
+}
+
+// IsPacked3 returns true if the field is packed in a proto3 file, where
+// repeated scalar fields are packed unless the packed option is false.
+func (f *FieldDescriptorProto) IsPacked3() bool {
+	if !f.IsPackable() {
+		return false
+	}
+	return f.Options == nil || f.Options.Packed == nil || f.GetOptions().GetPacked()
+}
+
+func (f *FieldDescriptorProto) IsOneof() bool {
+	return f.OneofIndex != nil
+}
+
 func (m *DescriptorProto) HasExtension() bool {
 	return len(m.ExtensionRange) > 0
 }
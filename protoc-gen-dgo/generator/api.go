@@ -49,7 +49,11 @@ func (m *TestSingular) MutateMsgs() (field *TestMessage, err error) {
     }
     if !m.xxx_IsMsgsSet {
         m.xxx_IsMsgsSet = true
-        m.msgs = new(TestMessage)
+        if m.msgs == nil {
+            m.msgs = new(TestMessage)
+        } else {
+            m.msgs.Clear()
+        }
     }
     return m.msgs, nil
 }
@@ -127,6 +131,7 @@ func (m *TestSingular) Clear() {
 package generator
 
 import (
+	"strconv"
 	"strings"
 
 	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
@@ -154,6 +159,7 @@ func (g *Generator) generateAPI(message *Descriptor) {
 	g.Out()
 	g.P(`}`)
 	g.P(``)
+	g.genOneofs(message)
 	for _, field := range message.Field {
 		isMessageField := IsMessageType(field)
 		c.field = field
@@ -161,6 +167,10 @@ func (g *Generator) generateAPI(message *Descriptor) {
 		c.fieldName = g.GetFieldName(message, field)
 		c.fieldType, _ = g.GoType(message, field)
 
+		if g.IsMap(field) {
+			g.genMap(c)
+			continue
+		}
 		switch field.GetLabel() {
 		case descriptor.FieldDescriptorProto_LABEL_OPTIONAL,
 			descriptor.FieldDescriptorProto_LABEL_REQUIRED:
@@ -170,7 +180,9 @@ func (g *Generator) generateAPI(message *Descriptor) {
 			} else {
 				g.genSetSingular(c)
 			}
-			g.genHas(c)
+			if !HasImplicitPresence(message, field) {
+				g.genHas(c)
+			}
 			g.genClear(c)
 		case descriptor.FieldDescriptorProto_LABEL_REPEATED:
 			c.fieldTypeBase = strings.Replace(strings.Replace(c.fieldType, "*", "", 1),
@@ -186,7 +198,7 @@ func (g *Generator) generateAPI(message *Descriptor) {
 			g.genClear(c)
 			g.genGetByIndex(c)
 		default:
-			panic("not implemented")
+			g.FailAt(message, field, "unsupported field type:", field.GetType().String())
 		}
 	}
 	g.genClearAll(message)
@@ -284,9 +296,26 @@ func (g *Generator) genAddMessage(c *fieldNames) {
 	g.In()
 	g.P(`if m != nil {`)
 	g.In()
-	g.P(`field = new(`, c.fieldTypeBase, `)`)
-	g.genSmartResize(c, "*")
-	g.P(`m.`, c.fieldName, `[m.`, sizerName, `] = `, pointer, `field`)
+	if pointer == "" {
+		g.genSmartResize(c, "*")
+		// Reuse the message left past the end of the field by Clear.
+		g.P(`field = m.`, c.fieldName, `[m.`, sizerName, `]`)
+		g.P(`if field == nil {`)
+		g.In()
+		g.P(`field = new(`, c.fieldTypeBase, `)`)
+		g.P(`m.`, c.fieldName, `[m.`, sizerName, `] = field`)
+		g.Out()
+		g.P(`} else {`)
+		g.In()
+		g.P(`field.Clear()`)
+		g.Out()
+		g.P(`}`)
+	} else {
+		// The messages of a field which is not nullable are held by value.
+		g.genSmartResize(c, "")
+		g.P(`field = &m.`, c.fieldName, `[m.`, sizerName, `]`)
+		g.P(`field.Clear()`)
+	}
 	g.P(`m.`, sizerName, ` += 1`)
 	g.P(`return field, nil`)
 	g.Out()
@@ -343,11 +372,13 @@ func (g *Generator) genMutateMessage(c *fieldNames) {
 	g.P(`return nil, `, g.Pkg[`errors`], `.New("Index is out of bounds")`)
 	g.Out()
 	g.P(`}`)
-	g.P(`if m.`, c.fieldName, `[index] == nil {`)
-	g.In()
-	g.P(`m.`, c.fieldName, `[index] = new(`, c.fieldTypeBase, `)`)
-	g.Out()
-	g.P(`}`)
+	if notref == "" {
+		g.P(`if m.`, c.fieldName, `[index] == nil {`)
+		g.In()
+		g.P(`m.`, c.fieldName, `[index] = new(`, c.fieldTypeBase, `)`)
+		g.Out()
+		g.P(`}`)
+	}
 	g.P(`return `, notref, `m.`, c.fieldName, `[index], nil`)
 	g.Out()
 	g.P(`}`)
@@ -372,7 +403,10 @@ func (g *Generator) genSetSingular(c *fieldNames) {
 		g.Out()
 		g.P(`}`)
 	}
-	g.P(`m.`, SetterName(c.fieldName), ` = true`)
+	g.genOneofSwitch(c.message, c.field)
+	if !HasImplicitPresence(c.message, c.field) {
+		g.P(`m.`, SetterName(c.fieldName), ` = true`)
+	}
 	g.P(`m.`, c.fieldName, ` = `, ref, `value`)
 	g.P(`return nil`)
 	g.Out()
@@ -392,10 +426,24 @@ func (g *Generator) genMutateSingular(c *fieldNames) {
 	g.P(`return nil, `, g.Pkg[`errors`], `.New("Cannot mutate a nil message")`)
 	g.Out()
 	g.P(`}`)
+	g.genOneofSwitch(c.message, c.field)
 	g.P(`if !m.`, setterName, ` {`)
 	g.In()
 	g.P(`m.`, setterName, ` = true`)
-	g.P(`m.`, c.fieldName, ` = new(`, c.fieldTypeBase, `)`)
+	if notref == "" {
+		// Reuse the message left in the field by Clear.
+		g.P(`if m.`, c.fieldName, ` == nil {`)
+		g.In()
+		g.P(`m.`, c.fieldName, ` = new(`, c.fieldTypeBase, `)`)
+		g.Out()
+		g.P(`} else {`)
+		g.In()
+		g.P(`m.`, c.fieldName, `.Clear()`)
+		g.Out()
+		g.P(`}`)
+	} else {
+		g.P(`m.`, c.fieldName, `.Clear()`)
+	}
 	g.Out()
 	g.P(`}`)
 	g.P(`return `, notref, `m.`, c.fieldName, `, nil`)
@@ -431,6 +479,8 @@ func (g *Generator) genClear(c *fieldNames) {
 	g.In()
 	if IsMessageType(c.field) {
 		g.genMsgClear(c.message, c.field)
+	} else if HasImplicitPresence(c.message, c.field) {
+		g.P(`m.`, c.fieldName, ` = `, GetDefaultValue(c.field))
 	} else {
 		if IsRepeated(c.field) {
 			g.P(`m.`, SizerName(c.fieldName), ` = 0`)
@@ -443,6 +493,14 @@ func (g *Generator) genClear(c *fieldNames) {
 			g.P(`m.`, c.fieldName, ` = nil`)
 		}
 	}
+	if c.field.IsOneof() {
+		caseName := OneofCaseName(OneofName(c.message, c.field))
+		g.P(`if m.`, caseName, ` == `, g.OneofCaseValue(c.message, c.field), ` {`)
+		g.In()
+		g.P(`m.`, caseName, ` = `, OneofCaseType(c.message, OneofName(c.message, c.field)), `_NotSet`)
+		g.Out()
+		g.P(`}`)
+	}
 	g.Out()
 	g.P(`}`)
 	g.Out()
@@ -458,25 +516,193 @@ func (g *Generator) genClearAll(message *Descriptor) {
 	g.P(`if m != nil {`)
 	g.In()
 	for _, field := range message.Field {
-		if IsMessageType(field) {
+		if IsMessageType(field) && !g.IsMap(field) {
 			g.genMsgClear(message, field)
 		} else {
 			g.P(`m.Clear`, CamelCase(g.GetFieldName(message, field)), `()`)
 		}
 	}
+	for _, oneof := range message.OneofDecl {
+		oneofName := CamelCase(oneof.GetName())
+		g.P(`m.`, OneofCaseName(oneofName), ` = `, OneofCaseType(message, oneofName), `_NotSet`)
+	}
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
+
+// Generates the accessors of a map field.
+func (g *Generator) genMap(c *fieldNames) {
+	keyType, valueType := g.GoMapType(c.field)
+	name := CamelCase(c.fieldName)
+	g.P(`func (m *`, c.typeName, `) Get`, name, `(key `, keyType, `) (value `, valueType, `, ok bool) {`)
+	g.In()
+	g.P(`if m != nil {`)
+	g.In()
+	g.P(`value, ok = m.`, c.fieldName, `[key]`)
+	g.Out()
+	g.P(`}`)
+	g.P(`return value, ok`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+	g.P(`func (m *`, c.typeName, `) Put`, name, `(key `, keyType, `, value `, valueType, `) (err error) {`)
+	g.In()
+	g.P(`if m == nil {`)
+	g.In()
+	g.P(`return `, g.Pkg[`errors`], `.New("Cannot assign to nil message")`)
+	g.Out()
+	g.P(`}`)
+	if valueType == "[]byte" || strings.HasPrefix(valueType, "*") {
+		g.P(`if value == nil {`)
+		g.In()
+		g.P(`return `, g.Pkg[`errors`], `.New("Cannot set with a nil value.")`)
+		g.Out()
+		g.P(`}`)
+	}
+	g.P(`if m.`, c.fieldName, ` == nil {`)
+	g.In()
+	g.P(`m.`, c.fieldName, ` = make(`, c.fieldType, `)`)
 	g.Out()
 	g.P(`}`)
+	g.P(`m.`, c.fieldName, `[key] = value`)
+	g.P(`return nil`)
 	g.Out()
 	g.P(`}`)
 	g.P()
+	g.P(`func (m *`, c.typeName, `) Delete`, name, `(key `, keyType, `) {`)
+	g.In()
+	g.P(`if m != nil {`)
+	g.In()
+	g.P(`delete(m.`, c.fieldName, `, key)`)
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+	g.P(`func (m *`, c.typeName, `) `, name, `Len() (size int) {`)
+	g.In()
+	g.P(`if m != nil {`)
+	g.In()
+	g.P(`return len(m.`, c.fieldName, `)`)
+	g.Out()
+	g.P(`}`)
+	g.P(`return 0`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+	g.P(`func (m *`, c.typeName, `) Range`, name, `(f func(key `, keyType, `, value `, valueType, `) bool) {`)
+	g.In()
+	g.P(`if m != nil {`)
+	g.In()
+	g.P(`for k, v := range m.`, c.fieldName, ` {`)
+	g.In()
+	g.P(`if !f(k, v) {`)
+	g.In()
+	g.P(`return`)
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+	g.P(`func (m *`, c.typeName, `) Clear`, name, `() {`)
+	g.In()
+	g.P(`if m != nil {`)
+	g.In()
+	g.P(`m.`, c.fieldName, ` = nil`)
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
+
+// Generates the case enum of each oneof, along with a method returning the
+// member which is set and a method clearing it.
+func (g *Generator) genOneofs(message *Descriptor) {
+	typeName := CamelCaseSlice(message.TypeName())
+	for i, oneof := range message.OneofDecl {
+		oneofName := CamelCase(oneof.GetName())
+		caseType := OneofCaseType(message, oneofName)
+		caseName := OneofCaseName(oneofName)
+		var members []*descriptor.FieldDescriptorProto
+		for _, field := range message.Field {
+			if field.IsOneof() && field.GetOneofIndex() == int32(i) {
+				members = append(members, field)
+			}
+		}
+		g.P(`type `, caseType, ` int32`)
+		g.P()
+		g.P(`const (`)
+		g.In()
+		g.P(caseType, `_NotSet `, caseType, ` = 0`)
+		for _, field := range members {
+			g.P(g.OneofCaseValue(message, field), ` `, caseType, ` = `, strconv.Itoa(int(field.GetNumber())))
+		}
+		g.Out()
+		g.P(`)`)
+		g.P()
+		g.P(`func (m *`, typeName, `) Which`, oneofName, `() `, caseType, ` {`)
+		g.In()
+		g.P(`if m != nil {`)
+		g.In()
+		g.P(`return m.`, caseName)
+		g.Out()
+		g.P(`}`)
+		g.P(`return `, caseType, `_NotSet`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+		g.P(`func (m *`, typeName, `) Clear`, oneofName, `() {`)
+		g.In()
+		g.P(`if m != nil {`)
+		g.In()
+		g.P(`switch m.`, caseName, ` {`)
+		for _, field := range members {
+			g.P(`case `, g.OneofCaseValue(message, field), `:`)
+			g.In()
+			g.P(`m.Clear`, CamelCase(g.GetFieldName(message, field)), `()`)
+			g.Out()
+		}
+		g.P(`}`)
+		g.P(`m.`, caseName, ` = `, caseType, `_NotSet`)
+		g.Out()
+		g.P(`}`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// Clears the other members of the oneof before the field is set.
+func (g *Generator) genOneofSwitch(message *Descriptor, field *descriptor.FieldDescriptorProto) {
+	if !field.IsOneof() {
+		return
+	}
+	caseName := OneofCaseName(OneofName(message, field))
+	caseValue := g.OneofCaseValue(message, field)
+	g.P(`if m.`, caseName, ` != `, caseValue, ` {`)
+	g.In()
+	g.P(`m.Clear`, OneofName(message, field), `()`)
+	g.P(`m.`, caseName, ` = `, caseValue)
+	g.Out()
+	g.P(`}`)
 }
 
 // Returns the element at the given zero-based index.
 func (g *Generator) genGetByIndex(c *fieldNames) {
 	defaultValue := GetDefaultValue(c.field)
 	pointer := ""
+	ref := ""
 	if IsMessageType(c.field) {
 		pointer = "*"
+		ref = getAssignmentRefrence(c.fieldType)
 	}
 	g.P(`func (m *`, c.typeName, `) Get`, CamelCase(c.fieldName),
 		`(index int) (field `, pointer, c.fieldTypeBase, `, err error) {`)
@@ -491,7 +717,7 @@ func (g *Generator) genGetByIndex(c *fieldNames) {
 	g.P(`return `, defaultValue, `, `, g.Pkg[`errors`], `.New("Index is out of bounds")`)
 	g.Out()
 	g.P(`}`)
-	g.P(`return m.`, c.fieldName, `[index], nil`)
+	g.P(`return `, ref, `m.`, c.fieldName, `[index], nil`)
 	g.Out()
 	g.P(`}`)
 	g.P()
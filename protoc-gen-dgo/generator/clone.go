@@ -0,0 +1,309 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+The clone code generates the Clone, MergeFrom and Equal methods used by the
+proto package to copy and compare messages, since the fields of the
+generated messages are private and cannot be found using reflection.
+
+Given the following message:
+
+  message A {
+	optional string description = 1;
+	repeated B items = 2;
+  }
+
+the clone code will generate the following code:
+
+  func (m *A) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &A{}
+	c.MergeFrom(m)
+	return c
+  }
+
+  func (m *A) MergeFrom(src proto.Message) {
+	s, ok := src.(*A)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsDescriptionSet {
+		m.SetDescription(s.description)
+	}
+	for i := 0; i < s.xxx_LenItems; i++ {
+		v, _ := m.AddItems()
+		v.MergeFrom(s.items[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+  }
+
+  func (m *A) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*A)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsDescriptionSet != o.xxx_IsDescriptionSet {
+		return false
+	}
+	if m.xxx_IsDescriptionSet && m.description != o.description {
+		return false
+	}
+	if m.xxx_LenItems != o.xxx_LenItems {
+		return false
+	}
+	for i := 0; i < m.xxx_LenItems; i++ {
+		if !m.items[i].Equal(o.items[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+  }
+*/
+package generator
+
+import (
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+func (g *Generator) generateClone(file *FileDescriptor) {
+	for _, message := range file.Messages() {
+		ccTypeName := CamelCaseSlice(message.TypeName())
+		g.P(`func (m *`, ccTypeName, `) Clone() `, g.Pkg["proto"], `.Message {`)
+		g.In()
+		g.P(`if m == nil {`)
+		g.In()
+		g.P(`return m`)
+		g.Out()
+		g.P(`}`)
+		g.P(`c := &`, ccTypeName, `{}`)
+		g.P(`c.MergeFrom(m)`)
+		g.P(`return c`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+		g.generateMergeFrom(message, ccTypeName)
+		g.generateEqual(message, ccTypeName)
+	}
+}
+
+// Returns an expression for a copy of the value varName, which does not
+// share any memory with it.
+func (g *Generator) cloneValue(field *descriptor.FieldDescriptorProto, goType string, varName string) string {
+	switch {
+	case IsMessageType(field):
+		return varName + `.Clone().(` + goType + `)`
+	case *field.Type == descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return `append([]byte{}, ` + varName + `...)`
+	}
+	return varName
+}
+
+func (g *Generator) generateMergeFrom(message *Descriptor, ccTypeName string) {
+	g.P(`func (m *`, ccTypeName, `) MergeFrom(src `, g.Pkg["proto"], `.Message) {`)
+	g.In()
+	g.P(`s, ok := src.(*`, ccTypeName, `)`)
+	g.P(`if !ok {`)
+	g.In()
+	g.P(`panic("proto: type mismatch")`)
+	g.Out()
+	g.P(`}`)
+	g.P(`if s == nil {`)
+	g.In()
+	g.P(`return`)
+	g.Out()
+	g.P(`}`)
+	for _, field := range message.Field {
+		fieldname := g.GetFieldName(message, field)
+		name := CamelCase(fieldname)
+		if g.IsMap(field) {
+			keyType, valueType := g.GoMapType(field)
+			_, _, value := g.MapEntry(field)
+			g.P(`if len(s.`, fieldname, `) > 0 {`)
+			g.In()
+			g.P(`if m.`, fieldname, ` == nil {`)
+			g.In()
+			g.P(`m.`, fieldname, ` = make(map[`, keyType, `]`, valueType, `, len(s.`, fieldname, `))`)
+			g.Out()
+			g.P(`}`)
+			g.P(`for k, v := range s.`, fieldname, ` {`)
+			g.In()
+			g.P(`m.`, fieldname, `[k] = `, g.cloneValue(value, valueType, "v"))
+			g.Out()
+			g.P(`}`)
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		if field.IsRepeated() {
+			g.P(`for i := 0; i < s.`, SizerName(fieldname), `; i++ {`)
+			g.In()
+			if IsMessageType(field) {
+				g.P(`v, _ := m.Add`, name, `()`)
+				g.P(`v.MergeFrom(`, MessageRef(field), `s.`, fieldname, `[i])`)
+			} else {
+				g.P(`m.Add`, name, `(`, g.cloneValue(field, "", "s."+fieldname+"[i]"), `)`)
+			}
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		g.P(`if `, g.presenceCheckOf(message, field, "s", fieldname), ` {`)
+		g.In()
+		if IsMessageType(field) {
+			g.P(`v, _ := m.Mutate`, name, `()`)
+			g.P(`v.MergeFrom(`, MessageRef(field), `s.`, fieldname, `)`)
+		} else {
+			g.P(`m.Set`, name, `(`, g.cloneValue(field, "", "s."+fieldname), `)`)
+		}
+		g.Out()
+		g.P(`}`)
+	}
+	if len(message.ExtensionRange) > 0 {
+		g.P(g.Pkg["proto"], `.MergeExtensions(m, s)`)
+	}
+	g.P(`m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
+
+// Returns a condition which is true if the values v1 and v2 of a field are
+// not equal.
+func (g *Generator) notEqual(field *descriptor.FieldDescriptorProto, v1 string, v2 string) string {
+	switch {
+	case IsMessageType(field):
+		return `!` + v1 + `.Equal(` + MessageRef(field) + v2 + `)`
+	case *field.Type == descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return `!` + g.Pkg["bytes"] + `.Equal(` + v1 + `, ` + v2 + `)`
+	}
+	return v1 + ` != ` + v2
+}
+
+// Returns a condition which is true if the bits of the values v1 and v2 of a
+// float or double field differ, so that -0.0 is not equal to an unset field,
+// like presenceCheckOf considers it.
+func (g *Generator) floatBitsNotEqual(field *descriptor.FieldDescriptorProto, v1 string, v2 string) string {
+	bits := g.Pkg["math"] + `.Float64bits`
+	if *field.Type == descriptor.FieldDescriptorProto_TYPE_FLOAT {
+		bits = g.Pkg["math"] + `.Float32bits`
+	}
+	return bits + `(` + g.castToBase(field, v1) + `) != ` + bits + `(` + g.castToBase(field, v2) + `)`
+}
+
+func (g *Generator) equalReturnFalse() {
+	g.In()
+	g.P(`return false`)
+	g.Out()
+	g.P(`}`)
+}
+
+func (g *Generator) generateEqual(message *Descriptor, ccTypeName string) {
+	g.P(`func (m *`, ccTypeName, `) Equal(that interface{}) bool {`)
+	g.In()
+	g.P(`if that == nil {`)
+	g.In()
+	g.P(`return m == nil`)
+	g.Out()
+	g.P(`}`)
+	g.P(`o, ok := that.(*`, ccTypeName, `)`)
+	g.P(`if !ok {`)
+	g.equalReturnFalse()
+	g.P(`if m == nil || o == nil {`)
+	g.In()
+	g.P(`return m == o`)
+	g.Out()
+	g.P(`}`)
+	for _, field := range message.Field {
+		fieldname := g.GetFieldName(message, field)
+		if g.IsMap(field) {
+			_, _, value := g.MapEntry(field)
+			g.P(`if len(m.`, fieldname, `) != len(o.`, fieldname, `) {`)
+			g.equalReturnFalse()
+			g.P(`for k, v := range m.`, fieldname, ` {`)
+			g.In()
+			g.P(`if v2, ok := o.`, fieldname, `[k]; !ok || `, g.notEqual(value, "v", "v2"), ` {`)
+			g.equalReturnFalse()
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		if field.IsRepeated() {
+			sizerName := SizerName(fieldname)
+			g.P(`if m.`, sizerName, ` != o.`, sizerName, ` {`)
+			g.equalReturnFalse()
+			g.P(`for i := 0; i < m.`, sizerName, `; i++ {`)
+			g.In()
+			g.P(`if `, g.notEqual(field, "m."+fieldname+"[i]", "o."+fieldname+"[i]"), ` {`)
+			g.equalReturnFalse()
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		notEqual := g.notEqual(field, "m."+fieldname, "o."+fieldname)
+		if HasImplicitPresence(message, field) {
+			if *field.Type == descriptor.FieldDescriptorProto_TYPE_DOUBLE ||
+				*field.Type == descriptor.FieldDescriptorProto_TYPE_FLOAT {
+				notEqual = g.floatBitsNotEqual(field, "m."+fieldname, "o."+fieldname)
+			}
+			g.P(`if `, notEqual, ` {`)
+			g.equalReturnFalse()
+			continue
+		}
+		isSet := g.presenceCheckOf(message, field, "m", fieldname)
+		otherIsSet := g.presenceCheckOf(message, field, "o", fieldname)
+		if field.IsOneof() {
+			isSet, otherIsSet = `(`+isSet+`)`, `(`+otherIsSet+`)`
+		}
+		g.P(`if `, isSet, ` != `, otherIsSet, ` {`)
+		g.equalReturnFalse()
+		g.P(`if `, isSet, ` && `, notEqual, ` {`)
+		g.equalReturnFalse()
+	}
+	if len(message.ExtensionRange) > 0 {
+		g.P(`if !`, g.Pkg["proto"], `.EqualExtensions(m, o) {`)
+		g.equalReturnFalse()
+	}
+	g.P(`if !`, g.Pkg["bytes"], `.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {`)
+	g.equalReturnFalse()
+	g.P(`return true`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// Diagnostic is a problem found by the generator, which stops the generation
+// of the file it is about.
+type Diagnostic struct {
+	File    string // The name of the .proto file, if the problem is in a file.
+	Message string // The full name of the message, if the problem is in a message.
+	Field   string // The name of the field, if the problem is in a field.
+	// The position of the message or field in the .proto file, counting from
+	// 1, if the file has source code info.
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (d *Diagnostic) Error() string {
+	var parts []string
+	switch {
+	case d.File != "" && d.Line > 0:
+		parts = append(parts, fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column))
+	case d.File != "":
+		parts = append(parts, d.File)
+	}
+	if d.Message != "" {
+		parts = append(parts, "message "+d.Message)
+	}
+	if d.Field != "" {
+		parts = append(parts, "field "+d.Field)
+	}
+	return strings.Join(append(parts, d.Msg), ": ")
+}
+
+// Diagnostics is the error of a generator which found problems, in the order
+// they were found.
+type Diagnostics []*Diagnostic
+
+func (ds Diagnostics) Error() string {
+	msgs := make([]string, len(ds))
+	for i, d := range ds {
+		msgs[i] = d.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Err returns the problems found by the steps of the generator run so far as
+// Diagnostics, or nil.
+func (g *Generator) Err() error {
+	if len(g.diagnostics) == 0 {
+		return nil
+	}
+	return g.diagnostics
+}
+
+// Returns a diagnostic about the file being generated, if any.
+func (g *Generator) diagnostic(msg string) *Diagnostic {
+	d := &Diagnostic{Msg: msg}
+	if g.file != nil {
+		d.File = g.file.GetName()
+	}
+	return d
+}
+
+// FailAt reports a problem with the field of the message, or with the
+// message if field is nil, and stops generating the file.
+func (g *Generator) FailAt(message *Descriptor, field *descriptor.FieldDescriptorProto, msgs ...string) {
+	d := &Diagnostic{
+		File:    message.file.GetName(),
+		Message: message.FullName(),
+		Msg:     strings.Join(msgs, " "),
+	}
+	path := message.path
+	if field != nil {
+		d.Field = field.GetName()
+		for i, f := range message.Field {
+			if f == field {
+				path += "," + strconv.Itoa(messageFieldPath) + "," + strconv.Itoa(i)
+			}
+		}
+		for i, f := range message.Extension {
+			if f == field {
+				path += "," + strconv.Itoa(messageExtensionPath) + "," + strconv.Itoa(i)
+			}
+		}
+	}
+	for _, file := range g.allFiles {
+		if file.FileDescriptorProto != message.file {
+			continue
+		}
+		if loc := file.locations[path]; loc != nil && len(loc.Span) >= 2 {
+			d.Line = int(loc.Span[0]) + 1
+			d.Column = int(loc.Span[1]) + 1
+		}
+	}
+	panic(d)
+}
+
+// Runs fn, which generates a file, and returns whether it succeeded. The
+// failure of fn is recorded.
+func (g *Generator) try(fn func()) (ok bool) {
+	defer func() {
+		if !ok {
+			g.indent = ""
+		}
+	}()
+	defer g.recoverFailure()
+	fn()
+	return true
+}
+
+// Recovers the failure of a step of the generator, which is recorded. Other
+// panics are recorded as internal errors.
+func (g *Generator) recoverFailure() {
+	r := recover()
+	if r == nil {
+		return
+	}
+	d, ok := r.(*Diagnostic)
+	if !ok {
+		d = g.diagnostic(fmt.Sprint("internal error: ", r))
+	}
+	g.diagnostics = append(g.diagnostics, d)
+	g.Response.Error = proto.String(g.diagnostics.Error())
+	g.Response.File = nil
+}
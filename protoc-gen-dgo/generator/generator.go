@@ -48,7 +48,6 @@ import (
 	"go/printer"
 	"go/token"
 	"log"
-	"os"
 	"path"
 	"strconv"
 	"strings"
@@ -133,6 +132,17 @@ func (d *Descriptor) TypeName() []string {
 	return s
 }
 
+// FullName returns the dotted type name including the package name, which is
+// how the message is named in type URLs and in the registry of the proto
+// package.
+func (d *Descriptor) FullName() string {
+	name := strings.Join(d.TypeName(), ".")
+	if pkg := d.file.GetPackage(); pkg != "" {
+		name = pkg + "." + name
+	}
+	return name
+}
+
 // EnumDescriptor describes an enum. If it's at top level, its parent will be nil.
 // Otherwise it will be the descriptor of the message in which it is defined.
 type EnumDescriptor struct {
@@ -181,8 +191,7 @@ func (e *EnumDescriptor) integerValueAsString(name string) string {
 			return fmt.Sprint(c.GetNumber())
 		}
 	}
-	log.Fatal("cannot find value for enum constant")
-	return ""
+	panic(&Diagnostic{File: e.file.GetName(), Msg: "cannot find value for enum constant " + name})
 }
 
 // ExtensionDescriptor describes an extension. If it's at top level, its parent will be nil.
@@ -240,6 +249,8 @@ type FileDescriptor struct {
 
 	// Comments, stored as a map of path (comma-separated integers) to the comment.
 	comments map[string]*descriptor.SourceCodeInfo_Location
+	// All the locations, for the diagnostics, stored the same way.
+	locations map[string]*descriptor.SourceCodeInfo_Location
 
 	// The full list of symbols that are exported,
 	// as a map from the exported object to its symbols.
@@ -416,7 +427,7 @@ type Object interface {
 func uniquePackageOf(fd *descriptor.FileDescriptorProto) string {
 	s, ok := uniquePackageName[fd]
 	if !ok {
-		log.Fatal("internal error: no package name defined for " + fd.GetName())
+		panic(&Diagnostic{File: fd.GetName(), Msg: "internal error: no package name defined"})
 	}
 	return s
 }
@@ -444,6 +455,8 @@ type Generator struct {
 	typeNameToObject map[string]Object // Key is a fully-qualified name in input syntax.
 	customImports    []string
 	indent           string
+	requiredCache    map[*Descriptor]bool // Messages holding required fields, see hasRequired.
+	diagnostics      Diagnostics          // The problems found so far.
 }
 
 // New creates a new generator and allocates the request and response protobufs.
@@ -457,24 +470,28 @@ func New() *Generator {
 	return g
 }
 
-// Error reports a problem, including an error, and exits the program.
+// Error reports a problem, including an error, in the file being generated,
+// and stops generating it. The steps of the generator record the problems they
+// find, and stop at the first problem found by an earlier step; Err returns
+// them, and they are set as the error of the response.
 func (g *Generator) Error(err error, msgs ...string) {
-	s := strings.Join(msgs, " ") + ":" + err.Error()
-	log.Print("protoc-gen-dgo: error:", s)
-	os.Exit(1)
+	panic(g.diagnostic(strings.Join(msgs, " ") + ": " + err.Error()))
 }
 
-// Fail reports a problem and exits the program.
+// Fail reports a problem in the file being generated and stops generating it,
+// like Error.
 func (g *Generator) Fail(msgs ...string) {
-	s := strings.Join(msgs, " ")
-	log.Print("protoc-gen-dgo: error:", s)
-	os.Exit(1)
+	panic(g.diagnostic(strings.Join(msgs, " ")))
 }
 
 // CommandLineParameters breaks the comma-separated list of key=value pairs
 // in the parameter (a member of the request protobuf) into a key/value map.
 // It then sets file name mappings defined by those entries.
 func (g *Generator) CommandLineParameters(parameter string) {
+	if g.Err() != nil {
+		return
+	}
+	defer g.recoverFailure()
 	g.Param = make(map[string]string)
 	for _, p := range strings.Split(parameter, ",") {
 		if i := strings.Index(p, "="); i < 0 {
@@ -564,6 +581,23 @@ var isGoKeyword = map[string]bool{
 	"var":         true,
 }
 
+// The generated files of the well known types, without their .go extension.
+// They are all generated into the types package.
+var wellKnownTypes = map[string]bool{
+	"any.pb":       true,
+	"duration.pb":  true,
+	"empty.pb":     true,
+	"struct.pb":    true,
+	"timestamp.pb": true,
+	"wrappers.pb":  true,
+}
+
+// Returns true if dir is where the well known types are imported from,
+// either directly or through the copy in this repository.
+func isWellKnownDir(dir string) bool {
+	return dir == "google/protobuf" || dir == "github.com/dropbox/goprotoc/protobuf/google/protobuf"
+}
+
 // defaultGoPackage returns the package name to use,
 // derived from the import path of the package we're building code for.
 func (g *Generator) defaultGoPackage() string {
@@ -591,6 +625,10 @@ func (g *Generator) defaultGoPackage() string {
 // The package name must agree across all files being generated.
 // It also defines unique package names for all imported files.
 func (g *Generator) SetPackageNames() {
+	if g.Err() != nil {
+		return
+	}
+	defer g.recoverFailure()
 	// Register the name for this package.  It will be the first name
 	// registered so is guaranteed to be unmodified.
 	pkg, explicit := g.genFiles[0].goPackageName()
@@ -634,12 +672,18 @@ func (g *Generator) SetPackageNames() {
 	// Register the support package names. They might collide with the
 	// name of a package we import.
 	g.Pkg = map[string]string{
+		"bytes":   RegisterUniquePackageName("bytes", nil),
 		"errors":  RegisterUniquePackageName("errors", nil),
 		"fmt":     RegisterUniquePackageName("fmt", nil),
 		"io":      RegisterUniquePackageName("io", nil),
+		"jsonpb":  RegisterUniquePackageName("jsonpb", nil),
 		"math":    RegisterUniquePackageName("math", nil),
 		"proto":   RegisterUniquePackageName("proto", nil),
 		"reflect": RegisterUniquePackageName("reflect", nil),
+		"rpc":     RegisterUniquePackageName("rpc", nil),
+		"sort":    RegisterUniquePackageName("sort", nil),
+		"sync":    RegisterUniquePackageName("sync", nil),
+		"unsafe":  RegisterUniquePackageName("unsafe", nil),
 	}
 
 AllFiles:
@@ -665,6 +709,13 @@ AllFiles:
 // and FileDescriptorProtos into file-referenced objects within the Generator.
 // It also creates the list of files to generate and so should be called before GenerateAllFiles.
 func (g *Generator) WrapTypes() {
+	if g.Err() != nil {
+		return
+	}
+	defer g.recoverFailure()
+	if len(g.Request.FileToGenerate) == 0 {
+		g.Fail("no files to generate")
+	}
 	g.allFiles = make([]*FileDescriptor, len(g.Request.ProtoFile))
 	for i, f := range g.Request.ProtoFile {
 		// We must wrap the descriptors before we wrap the enums
@@ -836,14 +887,18 @@ func wrapImported(file *descriptor.FileDescriptorProto, g *Generator) (sl []*Imp
 
 func extractComments(file *FileDescriptor) {
 	file.comments = make(map[string]*descriptor.SourceCodeInfo_Location)
+	file.locations = make(map[string]*descriptor.SourceCodeInfo_Location)
 	for _, loc := range file.GetSourceCodeInfo().GetLocation() {
-		if loc.LeadingComments == nil {
-			continue
-		}
 		var p []string
 		for _, n := range loc.Path {
 			p = append(p, strconv.Itoa(int(n)))
 		}
+		if _, ok := file.locations[strings.Join(p, ",")]; !ok {
+			file.locations[strings.Join(p, ",")] = loc
+		}
+		if loc.LeadingComments == nil {
+			continue
+		}
 		file.comments[strings.Join(p, ",")] = loc
 	}
 }
@@ -852,6 +907,10 @@ func extractComments(file *FileDescriptor) {
 // The key names for the map come from the input data, which puts a period at the beginning.
 // It should be called after SetPackageNames and before GenerateAllFiles.
 func (g *Generator) BuildTypeNameMap() {
+	if g.Err() != nil {
+		return
+	}
+	defer g.recoverFailure()
 	g.typeNameToObject = make(map[string]Object)
 	for _, f := range g.allFiles {
 		// The names in this loop are defined by the proto world, not us, so the
@@ -957,7 +1016,13 @@ func (g *Generator) Out() {
 }
 
 // GenerateAllFiles generates the output for all the files we're outputting.
+// A problem in a file does not stop the generation of the other files, so
+// that every file is checked.
 func (g *Generator) GenerateAllFiles() {
+	if g.Err() != nil {
+		return
+	}
+	defer g.recoverFailure()
 	// Initialize the plugins
 	for _, p := range plugins {
 		p.Init(g)
@@ -972,8 +1037,11 @@ func (g *Generator) GenerateAllFiles() {
 	i := 0
 	for _, file := range g.allFiles {
 		g.Reset()
-		g.generate(file)
-		if _, ok := genFileMap[file]; !ok {
+		if !g.try(func() { g.generate(file) }) {
+			continue
+		}
+		// The response has no files once a problem is found.
+		if _, ok := genFileMap[file]; !ok || g.Err() != nil {
 			continue
 		}
 		g.Response.File[i] = new(plugin.CodeGeneratorResponse_File)
@@ -1009,7 +1077,9 @@ func (g *Generator) generate(file *FileDescriptor) {
 	g.localName = FileName(file)
 	g.usedPackages = make(map[string]bool)
 
+	g.checkFieldOptions(file)
 	g.transformCustomByteToString(file)
+	g.transformCastKey(file)
 	for _, td := range g.file.imp {
 		g.generateImported(td)
 	}
@@ -1023,9 +1093,16 @@ func (g *Generator) generate(file *FileDescriptor) {
 	g.generateSize(file)
 	g.generateMarshalto(file)
 	g.generateUnmarshal(file)
+	g.generateInitialized(file)
+	g.generateJSON(file)
+	g.generateText(file)
+	g.generateClone(file)
+	g.generatePool(file)
+	g.generateReflect(file)
 	for _, ext := range g.file.ext {
 		g.generateExtension(ext)
 	}
+	g.generateServices(file)
 	g.generateInitFunction()
 
 	// Run the plugins before the imports so we know which imports are necessary.
@@ -1137,11 +1214,23 @@ func (g *Generator) generateImports() {
 	// for handling bit patterns for floating-point numbers.
 	c := make(map[string]bool)
 	g.P("import " + g.Pkg["proto"] + " " + strconv.Quote(g.ImportPrefix+"github.com/dropbox/goprotoc/proto"))
+	g.P("import " + g.Pkg["bytes"] + ` "bytes"`)
 	g.P("import " + g.Pkg["fmt"] + ` "fmt"`)
 	g.P("import " + g.Pkg["io"] + ` "io"`)
 	g.P("import " + g.Pkg["math"] + ` "math"`)
 	g.P("import " + g.Pkg["errors"] + ` "github.com/dropbox/godropbox/errors"`)
 	g.P("import " + g.Pkg["reflect"] + ` "reflect"`)
+	g.P("import " + g.Pkg["sort"] + ` "sort"`)
+	g.P("import " + g.Pkg["jsonpb"] + " " + strconv.Quote(g.ImportPrefix+"github.com/dropbox/goprotoc/jsonpb"))
+	if g.hasPool(g.file) {
+		g.P("import " + g.Pkg["sync"] + ` "sync"`)
+	}
+	if g.hasZeroCopyString(g.file) {
+		g.P("import " + g.Pkg["unsafe"] + ` "unsafe"`)
+	}
+	if len(g.file.Service) > 0 {
+		g.P("import " + g.Pkg["rpc"] + " " + strconv.Quote(g.ImportPrefix+"github.com/dropbox/goprotoc/rpc"))
+	}
 	for i, s := range g.file.Dependency {
 		fd := g.fileByName(s)
 		// Do not import our own package.
@@ -1170,6 +1259,10 @@ func (g *Generator) generateImports() {
 					// Also this allows the go generated descriptor to live inside the gogoprotobuf package
 					dir = "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
 					g.P("// renamed import google/protobuf/descriptor to github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor")
+				} else if isWellKnownDir(dir) && wellKnownTypes[path.Base(filename)] {
+					// The well known types all live in the types package.
+					dir = "github.com/dropbox/goprotoc/types"
+					g.P("// renamed import ", filename, " to github.com/dropbox/goprotoc/types")
 				}
 				g.P("import ", fd.PackageName(), " ", strconv.Quote(dir))
 				c[dir] = true
@@ -1200,11 +1293,14 @@ func (g *Generator) generateImports() {
 	}
 	g.P("// Reference imports to suppress errors if they are not otherwise used.")
 	g.P("var _ = ", g.Pkg["proto"], ".Marshal")
+	g.P("var _ = ", g.Pkg["bytes"], ".Equal")
 	g.P("var _ = ", g.Pkg["fmt"], ".Print")
 	g.P("var _ = ", g.Pkg["io"], ".Copy")
 	g.P("var _ = ", g.Pkg["math"], ".Inf")
 	g.P("var _ = ", g.Pkg["errors"], ".New")
 	g.P("var _ = ", g.Pkg["reflect"], ".Copy")
+	g.P("var _ = ", g.Pkg["sort"], ".Sort")
+	g.P("var _ = ", g.Pkg["jsonpb"], ".Marshal")
 	g.P()
 }
 
@@ -1299,10 +1395,13 @@ func (g *Generator) generateEnum(enum *EnumDescriptor) {
 	g.P()
 }
 
+// NeedsStar returns whether the Go type of the field is a pointer, which is
+// the case for message fields, unless they are not nullable. Embedded fields
+// are always pointers.
 func NeedsStar(field *descriptor.FieldDescriptorProto) bool {
 	if *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE ||
 		*field.Type == descriptor.FieldDescriptorProto_TYPE_GROUP {
-		return true
+		return gogoproto.IsNullable(field) || gogoproto.IsEmbed(field)
 	}
 	return false
 }
@@ -1374,11 +1473,19 @@ func (g *Generator) GoBaseType(field *descriptor.FieldDescriptorProto) (typ stri
 // GoType returns a string representing the type name, and the wire type
 func (g *Generator) GoType(message *Descriptor, field *descriptor.FieldDescriptorProto) (typ string, wire string) {
 	// TODO: Options.
+	if g.IsMap(field) {
+		keyType, valueType := g.GoMapType(field)
+		return "map[" + keyType + "]" + valueType, "bytes"
+	}
 	typ, wire = g.GoBaseType(field)
-	if gogoproto.IsCustomType(field) {
+	if gogoproto.IsCustomType(field) || gogoproto.IsCastType(field) {
 		var packageName string
 		var err error
-		packageName, typ, err = getCustomType(field)
+		if gogoproto.IsCustomType(field) {
+			packageName, typ, err = getCustomType(field)
+		} else {
+			packageName, typ, err = GetCastType(field)
+		}
 		if err != nil {
 			g.Fail(err.Error())
 		}
@@ -1421,14 +1528,25 @@ var methodNames = [...]string{
 func (g *Generator) addFieldSetters(message *Descriptor) {
 	for _, field := range message.Field {
 		fieldName := g.GetFieldName(message, field)
+		if g.IsMap(field) {
+			continue
+		}
 		if IsRepeated(field) {
 			g.P(SizerName(fieldName), "\t", "int")
-		} else {
+		} else if !HasImplicitPresence(message, field) {
 			g.P(SetterName(fieldName), "\t", "bool")
 		}
 	}
 }
 
+// Add for each oneof a discriminator holding the member that is set
+func (g *Generator) addOneofCases(message *Descriptor) {
+	for _, oneof := range message.OneofDecl {
+		oneofName := CamelCase(oneof.GetName())
+		g.P(OneofCaseName(oneofName), "\t", OneofCaseType(message, oneofName))
+	}
+}
+
 // Generate the type and default constant definitions for this Descriptor.
 func (g *Generator) generateMessage(message *Descriptor) {
 	// The full type name
@@ -1482,11 +1600,17 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	}
 	g.P("XXX_unrecognized\t[]byte")
 	g.addFieldSetters(message)
+	g.addOneofCases(message)
 	g.Out()
 	g.P("}")
 
 	// Reset, String and ProtoMessage methods.
 	g.P("func (m *", ccTypeName, ") Reset() { *m = ", ccTypeName, "{} }")
+	// The stringer plugin generates its own String method instead.
+	if gogoproto.HasGoStringer(g.file.FileDescriptorProto, message.DescriptorProto) &&
+		!gogoproto.IsStringer(g.file.FileDescriptorProto, message.DescriptorProto) {
+		g.P("func (m *", ccTypeName, ") String() string { return ", g.Pkg["proto"], ".CompactTextString(m) }")
+	}
 	g.P("func (*", ccTypeName, ") ProtoMessage() {}")
 
 	// Extension support methods
@@ -1517,7 +1641,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		g.In()
 		for _, r := range message.ExtensionRange {
 			end := fmt.Sprint(*r.End - 1) // make range inclusive on both ends
-			g.P("{", r.Start, ", ", end, "},")
+			g.P("{Start: ", r.Start, ", End: ", end, "},")
 		}
 		g.Out()
 		g.P("}")
@@ -1627,6 +1751,12 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			typename = typename[1:]
 			star = "*"
 		}
+		// The getter of a message field which is not nullable returns a
+		// pointer to the message held by value, like for a nullable field.
+		ref := MessageRef(field)
+		if ref != "" {
+			typename = "*" + typename
+		}
 
 		// Only export getter symbols for basic types,
 		// and for messages and enums in the same package.
@@ -1668,9 +1798,13 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		case descriptor.FieldDescriptorProto_TYPE_GROUP, descriptor.FieldDescriptorProto_TYPE_MESSAGE:
 			typeDefaultIsNil = true
 		}
-		g.P("if m != nil && m." + SetterName(fname) + " {")
+		if HasImplicitPresence(message, field) {
+			g.P("if m != nil {")
+		} else {
+			g.P("if m != nil && m." + SetterName(fname) + " {")
+		}
 		g.In()
-		g.P("return " + star + "m." + fname)
+		g.P("return " + star + ref + "m." + fname)
 		g.Out()
 		g.P("}")
 
@@ -1796,6 +1930,12 @@ func (g *Generator) generateInitFunction() {
 	for _, enum := range g.file.enum {
 		g.generateEnumRegistration(enum)
 	}
+	for _, message := range g.file.desc {
+		if message.GetOptions().GetMapEntry() {
+			continue
+		}
+		g.generateMessageRegistration(message)
+	}
 	for _, d := range g.file.desc {
 		for _, ext := range d.ext {
 			g.generateExtensionRegistration(ext)
@@ -1844,6 +1984,11 @@ func (g *Generator) generateEnumRegistration(enum *EnumDescriptor) {
 	g.P(g.Pkg["proto"]+".RegisterEnum(", strconv.Quote(pkg+ccTypeName), ", ", ccTypeName+"_name, ", ccTypeName+"_value)")
 }
 
+func (g *Generator) generateMessageRegistration(message *Descriptor) {
+	ccTypeName := CamelCaseSlice(message.TypeName())
+	g.P(g.Pkg["proto"]+".RegisterType((*", ccTypeName, ")(nil), ", strconv.Quote(message.FullName()), ")")
+}
+
 func (g *Generator) generateExtensionRegistration(ext *ExtensionDescriptor) {
 	g.P(g.Pkg["proto"]+".RegisterExtension(", ext.DescName(), ")")
 }
@@ -1995,10 +2140,14 @@ const (
 	packagePath = 2 // package
 	messagePath = 4 // message_type
 	enumPath    = 5 // enum_type
+	servicePath = 6 // service
 	// tag numbers in DescriptorProto
-	messageFieldPath   = 2 // field
-	messageMessagePath = 3 // nested_type
-	messageEnumPath    = 4 // enum_type
+	messageFieldPath     = 2 // field
+	messageMessagePath   = 3 // nested_type
+	messageEnumPath      = 4 // enum_type
+	messageExtensionPath = 6 // extension
 	// tag numbers in EnumDescriptorProto
 	enumValuePath = 2 // value
+	// tag numbers in ServiceDescriptorProto
+	serviceMethodPath = 2 // method
 )
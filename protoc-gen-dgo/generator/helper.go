@@ -28,6 +28,7 @@ package generator
 
 import (
 	"bytes"
+	"fmt"
 	"go/parser"
 	"go/printer"
 	"go/token"
@@ -140,7 +141,10 @@ func (g *Generator) GetFieldName(message *Descriptor, field *descriptor.FieldDes
 	}
 	fieldname = MakePrivate(fieldname)
 	if gogoproto.IsEmbed(field) {
-		fieldname = EmbedFieldName(goTyp)
+		var err error
+		if fieldname, err = EmbedFieldName(goTyp); err != nil {
+			g.FailAt(message, field, err.Error())
+		}
 	}
 	return fieldname
 }
@@ -149,19 +153,23 @@ func GoTypeToName(goTyp string) string {
 	return strings.Replace(strings.Replace(goTyp, "*", "", -1), "[]", "", -1)
 }
 
-func EmbedFieldName(goTyp string) string {
+func EmbedFieldName(goTyp string) (string, error) {
 	goTyp = GoTypeToName(goTyp)
 	goTyps := strings.Split(goTyp, ".")
 	if len(goTyps) == 1 {
-		return goTyp
+		return goTyp, nil
 	}
 	if len(goTyps) == 2 {
-		return goTyps[1]
+		return goTyps[1], nil
 	}
-	panic("unreachable")
+	return "", fmt.Errorf("cannot embed type %s", goTyp)
 }
 
 func (g *Generator) GeneratePlugin(p Plugin) {
+	if g.Err() != nil {
+		return
+	}
+	defer g.recoverFailure()
 	p.Init(g)
 	// Generate the output. The generator runs for every file, even the files
 	// that we don't generate output for, so that we can collate the full list
@@ -173,8 +181,11 @@ func (g *Generator) GeneratePlugin(p Plugin) {
 	i := 0
 	for _, file := range g.allFiles {
 		g.Reset()
-		g.generatePlugin(file, p)
-		if _, ok := genFileMap[file]; !ok {
+		if !g.try(func() { g.generatePlugin(file, p) }) {
+			continue
+		}
+		// The response has no files once a problem is found.
+		if _, ok := genFileMap[file]; !ok || g.Err() != nil {
 			continue
 		}
 		g.Response.File[i] = new(plugin.CodeGeneratorResponse_File)
@@ -201,9 +212,66 @@ func (g *Generator) transformCustomByteToString(file *FileDescriptor) {
 	}
 }
 
+// Returns true if the repeated field is packed in the file being generated.
+// Repeated scalar fields of proto3 files are packed unless the packed option
+// is given explicitly.
+func (g *Generator) IsPacked(field *descriptor.FieldDescriptorProto) bool {
+	if IsProto3(g.file.FileDescriptorProto) {
+		return field.IsPacked3()
+	}
+	return field.IsPacked()
+}
+
+// Checks the nullable, casttype and castkey options of the fields of the
+// file, which only apply to some kinds of fields.
+func (g *Generator) checkFieldOptions(file *FileDescriptor) {
+	for _, message := range g.file.desc {
+		for _, field := range message.Field {
+			if !gogoproto.IsNullable(field) && g.IsMap(field) {
+				g.FailAt(message, field, "nullable=false is not supported on map fields")
+			}
+			if gogoproto.IsCastType(field) {
+				switch {
+				case IsMessageType(field), field.IsEnum():
+					g.FailAt(message, field, "casttype is only supported on scalar fields")
+				case gogoproto.IsCustomType(field):
+					g.FailAt(message, field, "casttype can not be used with customtype")
+				}
+			}
+			if gogoproto.IsCastKey(field) {
+				if !g.IsMap(field) {
+					g.FailAt(message, field, "castkey is only supported on map fields")
+				} else if _, key, _ := g.MapEntry(field); key.IsEnum() {
+					g.FailAt(message, field, "castkey is not supported on enum keys")
+				}
+			}
+		}
+	}
+}
+
+// The castkey option of a map field is the casttype of the key field of its
+// entry message, so that the entry and the map have the same key type.
+func (g *Generator) transformCastKey(file *FileDescriptor) {
+	for _, message := range g.file.desc {
+		for _, field := range message.Field {
+			if !gogoproto.IsCastKey(field) || !g.IsMap(field) {
+				continue
+			}
+			_, key, _ := g.MapEntry(field)
+			if key.Options == nil {
+				key.Options = &descriptor.FieldOptions{}
+			}
+			if err := proto.SetExtension(key.Options, gogoproto.E_Casttype, proto.String(gogoproto.GetCastKey(field))); err != nil {
+				g.Error(err, "setting the casttype of", key.GetName())
+			}
+		}
+	}
+}
+
 func (g *Generator) generatePlugin(file *FileDescriptor, p Plugin) {
 	g.file = g.FileOf(file.FileDescriptorProto)
 	g.usedPackages = make(map[string]bool)
+	g.transformCastKey(file)
 
 	// Run the plugins before the imports so we know which imports are necessary.
 	p.Generate(file)
@@ -234,8 +302,18 @@ func GetCustomType(field *descriptor.FieldDescriptorProto) (packageName string,
 }
 
 func getCustomType(field *descriptor.FieldDescriptorProto) (packageName string, typ string, err error) {
+	return getTypeExtension(field, gogoproto.E_Customtype)
+}
+
+func GetCastType(field *descriptor.FieldDescriptorProto) (packageName string, typ string, err error) {
+	return getTypeExtension(field, gogoproto.E_Casttype)
+}
+
+// Returns the Go type named by a string extension of the field, such as
+// customtype or casttype, along with the package to import for it.
+func getTypeExtension(field *descriptor.FieldDescriptorProto, ext *proto.ExtensionDesc) (packageName string, typ string, err error) {
 	if field.Options != nil {
-		v, err := proto.GetExtension(field.Options, gogoproto.E_Customtype)
+		v, err := proto.GetExtension(field.Options, ext)
 		if err == nil && v.(*string) != nil {
 			ctype := *(v.(*string))
 			ss := strings.Split(ctype, ".")
@@ -261,6 +339,100 @@ func SetterName(fieldName string) string {
 	return "xxx_Is" + CamelCase(fieldName) + "Set"
 }
 
+// Returns "&" if the field holds its messages by value, which turns an
+// expression for one of them into a pointer, and "" otherwise.
+func MessageRef(field *descriptor.FieldDescriptorProto) string {
+	if IsMessageType(field) && !NeedsStar(field) {
+		return "&"
+	}
+	return ""
+}
+
+// Returns the expression converting the value of a field with a casttype to
+// the base Go type of the field, which is what the codecs work with.
+func (g *Generator) castToBase(field *descriptor.FieldDescriptorProto, varName string) string {
+	if !gogoproto.IsCastType(field) {
+		return varName
+	}
+	typ, _ := g.GoBaseType(field)
+	return typ + "(" + varName + ")"
+}
+
+// Returns the expression converting a value of the base Go type of a field
+// with a casttype to the casttype.
+func (g *Generator) castFromBase(field *descriptor.FieldDescriptorProto, varName string) string {
+	if !gogoproto.IsCastType(field) {
+		return varName
+	}
+	_, typ, err := GetCastType(field)
+	if err != nil {
+		g.Fail(err.Error())
+	}
+	return typ + "(" + varName + ")"
+}
+
+// Returns the CamelCased name of the oneof the field is a member of.
+func OneofName(message *Descriptor, field *descriptor.FieldDescriptorProto) string {
+	return CamelCase(message.OneofDecl[field.GetOneofIndex()].GetName())
+}
+
+// Returns the name of the struct field holding the case of the oneof.
+func OneofCaseName(oneofName string) string {
+	return "xxx_" + oneofName + "Case"
+}
+
+// Returns the name of the enum type listing the cases of the oneof.
+func OneofCaseType(message *Descriptor, oneofName string) string {
+	return CamelCaseSlice(message.TypeName()) + "_" + oneofName + "Case"
+}
+
+// Returns the name of the enum constant for the case where the field is set.
+func (g *Generator) OneofCaseValue(message *Descriptor, field *descriptor.FieldDescriptorProto) string {
+	return OneofCaseType(message, OneofName(message, field)) + "_" + CamelCase(g.GetFieldName(message, field))
+}
+
+// Returns true if the file uses proto3 syntax.
+func IsProto3(file *descriptor.FileDescriptorProto) bool {
+	return file.GetSyntax() == "proto3"
+}
+
+// Returns true if the field has no presence bit: a proto3 singular scalar
+// outside of a oneof, which is considered unset when it holds its zero value.
+func HasImplicitPresence(message *Descriptor, field *descriptor.FieldDescriptorProto) bool {
+	return IsProto3(message.File()) && !field.IsRepeated() && !IsMessageType(field) &&
+		!field.IsOneof()
+}
+
+// Returns the condition under which a singular field is written to the wire.
+func (g *Generator) presenceCheck(message *Descriptor, field *descriptor.FieldDescriptorProto, fieldName string) string {
+	return g.presenceCheckOf(message, field, "m", fieldName)
+}
+
+// Returns the condition under which a singular field of the message varName
+// is set.
+func (g *Generator) presenceCheckOf(message *Descriptor, field *descriptor.FieldDescriptorProto, varName string, fieldName string) string {
+	if field.IsOneof() {
+		return varName + `.` + OneofCaseName(OneofName(message, field)) + ` == ` + g.OneofCaseValue(message, field)
+	}
+	if !HasImplicitPresence(message, field) {
+		return varName + `.` + SetterName(fieldName)
+	}
+	switch *field.Type {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return varName + `.` + fieldName
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return `len(` + varName + `.` + fieldName + `) > 0`
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		// Compare the bits, so that -0.0 is set like the reflection based
+		// encoder considers it.
+		return g.Pkg["math"] + `.Float64bits(` + g.castToBase(field, varName+`.`+fieldName) + `) != 0`
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return g.Pkg["math"] + `.Float32bits(` + g.castToBase(field, varName+`.`+fieldName) + `) != 0`
+	}
+	return varName + `.` + fieldName + ` != 0`
+}
+
 func GetDefaultValue(field *descriptor.FieldDescriptorProto) (value string) {
 	switch *field.Type {
 	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
@@ -0,0 +1,205 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+/*
+The initialized code generates an IsInitialized and a CheckInitialized method
+for each message, which report whether the required fields of the message and
+of all the messages it holds are set.
+
+Given the following messages:
+
+  message A {
+	required string description = 1;
+	optional B b = 2;
+	repeated B items = 3;
+  }
+
+  message B {
+	required int64 number = 1;
+  }
+
+the initialized code will generate the following code:
+
+  // IsInitialized returns true if all the required fields of m, and of the
+  // messages held by m, are set.
+  func (m *A) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+  }
+
+  // CheckInitialized returns a RequiredNotSetError naming the path of the
+  // first required field of m, or of the messages held by m, that is not set.
+  func (m *A) CheckInitialized() error {
+	if !m.xxx_IsDescriptionSet {
+		return proto.NewRequiredNotSetError("Description")
+	}
+	if m.xxx_IsBSet {
+		if err := m.b.CheckInitialized(); err != nil {
+			return proto.NewRequiredNotSetError("B." + err.(*proto.RequiredNotSetError).Field())
+		}
+	}
+	for i := 0; i < m.xxx_LenItems; i++ {
+		if err := m.items[i].CheckInitialized(); err != nil {
+			return proto.NewRequiredNotSetError("Items." + err.(*proto.RequiredNotSetError).Field())
+		}
+	}
+	return nil
+  }
+
+Only fields whose messages can contain required fields are checked, so
+CheckInitialized returns nil straight away for messages without any.
+
+The generated Marshal and MarshalTo methods of messages that can contain
+required fields still encode the whole message, but return the error of
+CheckInitialized along with the result. The generated Unmarshal methods decode
+the whole input and then do the same.
+*/
+package generator
+
+import (
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// Returns true if the message, or any message it holds, has required fields.
+func (g *Generator) hasRequired(message *Descriptor) bool {
+	if g.requiredCache == nil {
+		g.requiredCache = make(map[*Descriptor]bool)
+	}
+	if has, ok := g.requiredCache[message]; ok {
+		return has
+	}
+	// Assume false while the fields are visited, to end recursive types.
+	g.requiredCache[message] = false
+	has := false
+	for _, field := range message.Field {
+		if field.IsRequired() {
+			has = true
+			break
+		}
+		if desc := g.requiredType(field); desc != nil && g.hasRequired(desc) {
+			has = true
+			break
+		}
+	}
+	g.requiredCache[message] = has
+	return has
+}
+
+// Returns the message held by the field, or by the values of the field if it
+// is a map, or nil if the field does not hold messages.
+func (g *Generator) requiredType(field *descriptor.FieldDescriptorProto) *Descriptor {
+	if g.IsMap(field) {
+		_, _, value := g.MapEntry(field)
+		field = value
+	}
+	if !IsMessageType(field) {
+		return nil
+	}
+	desc, _ := g.ObjectNamed(field.GetTypeName()).(*Descriptor)
+	return desc
+}
+
+// Returns the statement returning the error err of the message in the field
+// named path, prefixed with the path.
+func (g *Generator) nestedRequiredError(path string) string {
+	return `return ` + g.Pkg["proto"] + `.NewRequiredNotSetError("` + path + `." + err.(*` + g.Pkg["proto"] + `.RequiredNotSetError).Field())`
+}
+
+// Generates the handling of the error err returned by the Unmarshal method of
+// a message of type desc. Unset required fields are not returned right away,
+// since the containing message reports them with their full path once it is
+// decoded.
+func (g *Generator) nestedUnmarshalError(desc *Descriptor) {
+	if desc == nil || !g.hasRequired(desc) {
+		g.P(`return err`)
+		return
+	}
+	g.P(`if _, ok := err.(*`, g.Pkg["proto"], `.RequiredNotSetError); !ok {`)
+	g.In()
+	g.P(`return err`)
+	g.Out()
+	g.P(`}`)
+}
+
+func (g *Generator) generateInitialized(file *FileDescriptor) {
+	for _, message := range file.Messages() {
+		ccTypeName := CamelCaseSlice(message.TypeName())
+		g.P(`// IsInitialized returns true if all the required fields of m, and of the`)
+		g.P(`// messages held by m, are set.`)
+		g.P(`func (m *`, ccTypeName, `) IsInitialized() bool {`)
+		g.In()
+		g.P(`return m.CheckInitialized() == nil`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+		g.P(`// CheckInitialized returns a RequiredNotSetError naming the path of the`)
+		g.P(`// first required field of m, or of the messages held by m, that is not set.`)
+		g.P(`func (m *`, ccTypeName, `) CheckInitialized() error {`)
+		g.In()
+		for _, field := range message.Field {
+			fieldname := g.GetFieldName(message, field)
+			path := CamelCase(fieldname)
+			if field.IsRequired() {
+				g.P(`if !(`, g.presenceCheck(message, field, fieldname), `) {`)
+				g.In()
+				g.P(`return `, g.Pkg["proto"], `.NewRequiredNotSetError("`, path, `")`)
+				g.Out()
+				g.P(`}`)
+			}
+			desc := g.requiredType(field)
+			if desc == nil || !g.hasRequired(desc) {
+				continue
+			}
+			switch {
+			case g.IsMap(field):
+				g.P(`for _, v := range m.`, fieldname, ` {`)
+				g.In()
+				g.P(`if v == nil {`)
+				g.In()
+				g.P(`continue`)
+				g.Out()
+				g.P(`}`)
+				g.P(`if err := v.CheckInitialized(); err != nil {`)
+			case field.IsRepeated():
+				g.P(`for i := 0; i < m.`, SizerName(fieldname), `; i++ {`)
+				g.In()
+				g.P(`if err := m.`, fieldname, `[i].CheckInitialized(); err != nil {`)
+			default:
+				g.P(`if `, g.presenceCheck(message, field, fieldname), ` {`)
+				g.In()
+				g.P(`if err := m.`, fieldname, `.CheckInitialized(); err != nil {`)
+			}
+			g.In()
+			g.P(g.nestedRequiredError(path))
+			g.Out()
+			g.P(`}`)
+			g.Out()
+			g.P(`}`)
+		}
+		g.P(`return nil`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+	}
+}
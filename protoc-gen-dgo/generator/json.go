@@ -0,0 +1,463 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+The json code generates the methods used by the jsonpb package to marshal
+and unmarshal messages using the canonical protobuf JSON mapping.
+
+Given the following message:
+
+  message A {
+	optional int64 first_value = 1;
+	repeated B items = 2;
+  }
+
+the json code will generate the following code:
+
+  func (m *A) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsFirstValueSet || w.EmitDefaults() {
+		w.Field("firstValue", "first_value")
+		w.Int64(m.GetFirstValue())
+	}
+	if m.xxx_LenItems > 0 || w.EmitDefaults() {
+		w.Field("items", "items")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenItems; i++ {
+			w.Message(m.items[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+  }
+
+  func (m *A) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("firstValue", "first_value"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFirstValue(v); err != nil {
+			return err
+		}
+	}
+	...
+	return fields.Done()
+  }
+
+  func (m *A) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+  }
+
+  func (m *A) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+  }
+
+The JSON name of a field is its lowerCamelCase name, unless the
+gogoproto.jsontag option is set.
+
+If the gogoproto.goproto_jsonpb option is false, MarshalJSONPB and
+UnmarshalJSONPB are not generated, so that a message with a special JSON
+representation can implement them by hand.
+*/
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/goprotoc/gogoproto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+// Returns the name of the field in the JSON representation of its message.
+func JSONName(field *descriptor.FieldDescriptorProto) string {
+	if tag := gogoproto.GetJsonTag(field); tag != nil {
+		if name := strings.Split(*tag, ",")[0]; name != "" {
+			return name
+		}
+	}
+	return lowerCamelCase(field.GetName())
+}
+
+// Converts a field name to lowerCamelCase the way protoc does: underscores
+// are removed and the letter following each one is upper cased.
+func lowerCamelCase(name string) string {
+	var b []byte
+	upper := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper && 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+func (g *Generator) generateJSON(file *FileDescriptor) {
+	for _, message := range file.Messages() {
+		ccTypeName := CamelCaseSlice(message.TypeName())
+		if gogoproto.HasGoJsonpb(file.FileDescriptorProto, message.DescriptorProto) {
+			g.generateMarshalJSON(message, ccTypeName)
+			g.generateUnmarshalJSON(message, ccTypeName)
+		}
+		g.P(`func (m *`, ccTypeName, `) MarshalJSON() ([]byte, error) {`)
+		g.In()
+		g.P(`return `, g.Pkg["jsonpb"], `.Marshal(m)`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+		g.P(`func (m *`, ccTypeName, `) UnmarshalJSON(data []byte) error {`)
+		g.In()
+		g.P(`return `, g.Pkg["jsonpb"], `.Unmarshal(data, m)`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// Fields with a custom type or embedded fields have no accessors, so they
+// are written directly using encoding/json.
+func isJSONCustom(field *descriptor.FieldDescriptorProto) bool {
+	return gogoproto.IsCustomType(field) || gogoproto.IsEmbed(field)
+}
+
+// Returns the name of the map from enum values to names, or from names to
+// values if byName is set, for an enum field.
+func (g *Generator) jsonEnumMap(field *descriptor.FieldDescriptorProto, byName bool) string {
+	g.RecordTypeUse(field.GetTypeName())
+	typeName := g.TypeName(g.ObjectNamed(field.GetTypeName()))
+	if byName {
+		return typeName + "_value"
+	}
+	return typeName + "_name"
+}
+
+// Writes the value of varName to the Writer w.
+func (g *Generator) jsonWriteValue(field *descriptor.FieldDescriptorProto, varName string) {
+	if isJSONCustom(field) {
+		g.P(`w.JSON(`, varName, `)`)
+		return
+	}
+	varName = g.castToBase(field, varName)
+	switch *field.Type {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		g.P(`w.Float64(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		g.P(`w.Float32(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		g.P(`w.Int64(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		g.P(`w.Uint64(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		g.P(`w.Int32(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		g.P(`w.Uint32(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		g.P(`w.Bool(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		g.P(`w.String(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		g.P(`w.Base64(`, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		g.P(`w.Enum(int32(`, varName, `), `, g.jsonEnumMap(field, false), `)`)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		g.P(`w.Message(`, varName, `)`)
+	default:
+		g.Fail("unsupported field type for json:", field.GetName())
+	}
+}
+
+// Writes the key of a map entry to the Writer w.
+func (g *Generator) jsonWriteKey(key *descriptor.FieldDescriptorProto, varName string) {
+	switch *key.Type {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		g.P(`w.BoolKey(`, g.castToBase(key, varName), `)`)
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		g.P(`w.Key(`, g.castToBase(key, varName), `)`)
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		g.P(`w.UintKey(uint64(`, varName, `))`)
+	default:
+		g.P(`w.IntKey(int64(`, varName, `))`)
+	}
+}
+
+func (g *Generator) generateMarshalJSON(message *Descriptor, ccTypeName string) {
+	g.P(`func (m *`, ccTypeName, `) MarshalJSONPB(w *`, g.Pkg["jsonpb"], `.Writer) error {`)
+	g.In()
+	g.P(`if m == nil {`)
+	g.In()
+	g.P(`w.Null()`)
+	g.P(`return nil`)
+	g.Out()
+	g.P(`}`)
+	g.P(`w.BeginObject()`)
+	for _, field := range message.Field {
+		fieldname := g.GetFieldName(message, field)
+		writeField := `w.Field(` + strconv.Quote(JSONName(field)) + `, ` + strconv.Quote(field.GetName()) + `)`
+		if g.IsMap(field) {
+			_, key, value := g.MapEntry(field)
+			g.P(`if len(m.`, fieldname, `) > 0 || w.EmitDefaults() {`)
+			g.In()
+			g.P(writeField)
+			g.P(`w.BeginObject()`)
+			g.sortedMapKeys(field, fieldname)
+			g.P(`for _, k := range keys {`)
+			g.In()
+			g.jsonWriteKey(key, `k`)
+			g.jsonWriteValue(value, `m.`+fieldname+`[k]`)
+			g.Out()
+			g.P(`}`)
+			g.P(`w.EndObject()`)
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		if field.IsRepeated() {
+			sizerName := SizerName(fieldname)
+			g.P(`if m.`, sizerName, ` > 0 || w.EmitDefaults() {`)
+			g.In()
+			g.P(writeField)
+			if isJSONCustom(field) {
+				g.P(`w.JSON(m.`, fieldname, `[:m.`, sizerName, `])`)
+			} else {
+				g.P(`w.BeginArray()`)
+				g.P(`for i := 0; i < m.`, sizerName, `; i++ {`)
+				g.In()
+				g.jsonWriteValue(field, MessageRef(field)+`m.`+fieldname+`[i]`)
+				g.Out()
+				g.P(`}`)
+				g.P(`w.EndArray()`)
+			}
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		cond := g.presenceCheck(message, field, fieldname)
+		if !field.IsOneof() && !IsMessageType(field) {
+			cond += ` || w.EmitDefaults()`
+		}
+		g.P(`if `, cond, ` {`)
+		g.In()
+		g.P(writeField)
+		if isJSONCustom(field) {
+			g.jsonWriteValue(field, `m.`+fieldname)
+		} else {
+			g.jsonWriteValue(field, `m.Get`+CamelCase(fieldname)+`()`)
+		}
+		g.Out()
+		if IsMessageType(field) && !field.IsOneof() {
+			g.P(`} else if w.EmitDefaults() {`)
+			g.In()
+			g.P(writeField)
+			g.P(`w.Null()`)
+			g.Out()
+		}
+		g.P(`}`)
+	}
+	g.P(`w.EndObject()`)
+	g.P(`return nil`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
+
+// Parses the JSON value in varName into a new variable named target, and
+// returns the expression of the value in the Go type of the field.
+func (g *Generator) jsonReadValue(field *descriptor.FieldDescriptorProto, varName string, target string) string {
+	parser := ""
+	switch *field.Type {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		parser = "Float64"
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		parser = "Float32"
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		parser = "Int64"
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		parser = "Uint64"
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		parser = "Int32"
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		parser = "Uint32"
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		parser = "Bool"
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		parser = "String"
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		parser = "Base64"
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if IsProto3(g.ObjectNamed(field.GetTypeName()).File()) {
+			g.P(target, `, err := `, g.Pkg["jsonpb"], `.Enum(`, varName, `, `, g.jsonEnumMap(field, true), `)`)
+		} else {
+			// Numbers which are not values of a proto2 enum are rejected,
+			// since the enum is closed.
+			g.P(target, `, err := `, g.Pkg["jsonpb"], `.ClosedEnum(`, varName, `, `,
+				g.jsonEnumMap(field, true), `, `, g.jsonEnumMap(field, false), `)`)
+		}
+		g.jsonReturnErr()
+		return g.TypeName(g.ObjectNamed(field.GetTypeName())) + `(` + target + `)`
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		g.RecordTypeUse(field.GetTypeName())
+		g.P(target, ` := new(`, g.TypeName(g.ObjectNamed(field.GetTypeName())), `)`)
+		g.P(`if err := u.Message(`, varName, `, `, target, `); err != nil {`)
+		g.In()
+		g.P(`return err`)
+		g.Out()
+		g.P(`}`)
+		return target
+	default:
+		g.Fail("unsupported field type for json:", field.GetName())
+	}
+	g.P(target, `, err := `, g.Pkg["jsonpb"], `.`, parser, `(`, varName, `)`)
+	g.jsonReturnErr()
+	return g.castFromBase(field, target)
+}
+
+func (g *Generator) jsonReturnErr() {
+	g.P(`if err != nil {`)
+	g.In()
+	g.P(`return err`)
+	g.Out()
+	g.P(`}`)
+}
+
+// Calls the accessor, which returns an error, with the given arguments.
+func (g *Generator) jsonCall(call string, args ...string) {
+	g.P(`if err := m.`, call, `(`, strings.Join(args, ", "), `); err != nil {`)
+	g.In()
+	g.P(`return err`)
+	g.Out()
+	g.P(`}`)
+}
+
+func (g *Generator) generateUnmarshalJSON(message *Descriptor, ccTypeName string) {
+	g.P(`func (m *`, ccTypeName, `) UnmarshalJSONPB(u *`, g.Pkg["jsonpb"], `.Unmarshaler, data []byte) error {`)
+	g.In()
+	g.P(`fields, err := u.Fields(data)`)
+	g.jsonReturnErr()
+	for _, field := range message.Field {
+		fieldname := g.GetFieldName(message, field)
+		name := CamelCase(fieldname)
+		g.P(`if raw, ok := fields.Get(`, strconv.Quote(JSONName(field)), `, `, strconv.Quote(field.GetName()), `); ok {`)
+		g.In()
+		switch {
+		case g.IsMap(field):
+			_, key, value := g.MapEntry(field)
+			g.P(`entries, err := `, g.Pkg["jsonpb"], `.Object(raw)`)
+			g.jsonReturnErr()
+			g.P(`for key, elem := range entries {`)
+			g.In()
+			k := g.castFromBase(key, "key")
+			if *key.Type != descriptor.FieldDescriptorProto_TYPE_STRING {
+				k = g.jsonReadValue(key, g.Pkg["jsonpb"]+".Key(key)", "k")
+			}
+			v := g.jsonReadValue(value, "elem", "v")
+			g.jsonCall(`Put`+name, k, v)
+			g.Out()
+			g.P(`}`)
+		case isJSONCustom(field):
+			g.P(`if err := `, g.Pkg["jsonpb"], `.Decode(raw, &m.`, fieldname, `); err != nil {`)
+			g.In()
+			g.P(`return err`)
+			g.Out()
+			g.P(`}`)
+			if field.IsRepeated() {
+				g.P(`m.`, SizerName(fieldname), ` = len(m.`, fieldname, `)`)
+			} else if !HasImplicitPresence(message, field) {
+				g.genOneofSwitch(message, field)
+				g.P(`m.`, SetterName(fieldname), ` = true`)
+			}
+		case field.IsRepeated():
+			g.P(`elems, err := `, g.Pkg["jsonpb"], `.Array(raw)`)
+			g.jsonReturnErr()
+			g.P(`for _, elem := range elems {`)
+			g.In()
+			if IsMessageType(field) {
+				g.P(`v, err := m.Add`, name, `()`)
+				g.jsonReturnErr()
+				g.P(`if err := u.Message(elem, v); err != nil {`)
+				g.In()
+				g.P(`return err`)
+				g.Out()
+				g.P(`}`)
+			} else {
+				v := g.jsonReadValue(field, "elem", "v")
+				g.jsonCall(`Add`+name, v)
+			}
+			g.Out()
+			g.P(`}`)
+		case IsMessageType(field):
+			g.P(`v, err := m.Mutate`, name, `()`)
+			g.jsonReturnErr()
+			g.P(`if err := u.Message(raw, v); err != nil {`)
+			g.In()
+			g.P(`return err`)
+			g.Out()
+			g.P(`}`)
+		default:
+			v := g.jsonReadValue(field, "raw", "v")
+			g.jsonCall(`Set`+name, v)
+		}
+		g.Out()
+		g.P(`}`)
+	}
+	g.P(`return fields.Done()`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+The map code generates the size, marshal and unmarshal code of map fields.
+
+On the wire a map<K, V> field is a repeated message with the key as field 1
+and the value as field 2. In the generated struct it is a Go map, so that:
+
+  message A {
+	map<string, int64> counts = 1;
+  }
+
+is generated as:
+
+  type A struct {
+	counts map[string]int64
+	...
+  }
+
+The marshal code writes the entries sorted by key, so that equal maps
+always have the same encoding:
+
+  if len(m.counts) > 0 {
+	keys := make([]string, 0, len(m.counts))
+	for k := range m.counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+	for _, k := range keys {
+		v := m.counts[k]
+		data[i] = 0xa
+		i++
+		mapEntrySize := 1 + len(k) + sovA(uint64(len(k))) + 1 + sovA(uint64(v))
+		i = encodeVarintA(data, i, uint64(mapEntrySize))
+		...
+	}
+  }
+
+The unmarshal code decodes each entry using the generated entry message and
+merges it into the map, replacing the value of a key that is already present.
+*/
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+// Returns true if the field is a map<K, V> field.
+func (g *Generator) IsMap(field *descriptor.FieldDescriptorProto) bool {
+	if !field.IsRepeated() || !field.IsMessage() {
+		return false
+	}
+	desc, ok := g.ObjectNamed(field.GetTypeName()).(*Descriptor)
+	return ok && desc.GetOptions().GetMapEntry()
+}
+
+// Returns the entry message, key and value fields of a map field.
+func (g *Generator) MapEntry(field *descriptor.FieldDescriptorProto) (entry *Descriptor, key *descriptor.FieldDescriptorProto, value *descriptor.FieldDescriptorProto) {
+	entry = g.ObjectNamed(field.GetTypeName()).(*Descriptor)
+	for _, f := range entry.Field {
+		switch f.GetNumber() {
+		case 1:
+			key = f
+		case 2:
+			value = f
+		}
+	}
+	if key == nil || value == nil {
+		g.Fail("map entry", entry.GetName(), "must have a key and a value field")
+	}
+	return entry, key, value
+}
+
+// Returns the Go types of the key and the value of a map field.
+func (g *Generator) GoMapType(field *descriptor.FieldDescriptorProto) (keyType string, valueType string) {
+	entry, key, value := g.MapEntry(field)
+	keyType, _ = g.GoType(entry, key)
+	valueType, _ = g.GoType(entry, value)
+	g.RecordTypeUse(value.GetTypeName())
+	return keyType, valueType
+}
+
+// Returns an expression for the encoded size of a map key or value, without
+// its tag. The encoded size of a message value is given by msgSize.
+func (g *Generator) mapFieldSize(field *descriptor.FieldDescriptorProto, varName string, msgSize string) string {
+	switch *field.Type {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return "8"
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return "4"
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "1"
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return `sov` + g.localName + `(uint64(` + varName + `))`
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		return `sov` + g.localName + `(uint64(uint32(` + varName + `)))`
+	case descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return `soz` + g.localName + `(uint64(` + varName + `))`
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return `len(` + varName + `)+sov` + g.localName + `(uint64(len(` + varName + `)))`
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return msgSize + `+sov` + g.localName + `(uint64(` + msgSize + `))`
+	}
+	g.Fail("map does not support", field.GetType().String())
+	return ""
+}
+
+// Returns an expression for the encoded size of a map entry.
+func (g *Generator) mapEntrySize(key *descriptor.FieldDescriptorProto, value *descriptor.FieldDescriptorProto, msgSize string) string {
+	keyTag := strconv.Itoa(keySize(1, key.WireType()))
+	valueTag := strconv.Itoa(keySize(2, value.WireType()))
+	return keyTag + `+` + g.mapFieldSize(key, "k", "") + `+` + valueTag + `+` + g.mapFieldSize(value, "v", msgSize)
+}
+
+func (g *Generator) generateMapSize(field *descriptor.FieldDescriptorProto, fieldname string) {
+	_, key, value := g.MapEntry(field)
+	g.P(`for k, v := range m.`, fieldname, ` {`)
+	g.In()
+	g.P(`_ = k`)
+	g.P(`_ = v`)
+	if value.IsMessage() {
+		g.P(`l = v.Size()`)
+	}
+	g.P(`mapEntrySize := `, g.mapEntrySize(key, value, "l"))
+	g.P(`n+=`, strconv.Itoa(keySize(field.GetNumber(), proto.WireBytes)), `+mapEntrySize+sov`, g.localName, `(uint64(mapEntrySize))`)
+	g.Out()
+	g.P(`}`)
+}
+
+// Encodes a map key or value, without its tag.
+func (g *Generator) encodeMapField(field *descriptor.FieldDescriptorProto, varName string) {
+	switch *field.Type {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		g.callFixed64(g.Pkg["math"], `.Float64bits(float64(`, varName, `))`)
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		g.callFixed32(g.Pkg["math"], `.Float32bits(float32(`, varName, `))`)
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_ENUM:
+		g.callVarint(varName)
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		g.callInt32Varint(varName)
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		g.callFixed64(varName)
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		g.callFixed32(varName)
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		g.callVarint(`(uint32(`, varName, `) << 1) ^ uint32((`, varName, ` >> 31))`)
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		g.callVarint(`(uint64(`, varName, `) << 1) ^ uint64((`, varName, ` >> 63))`)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		g.P(`if `, varName, ` {`)
+		g.In()
+		g.P(`data[i] = 1`)
+		g.Out()
+		g.P(`} else {`)
+		g.In()
+		g.P(`data[i] = 0`)
+		g.Out()
+		g.P(`}`)
+		g.P(`i++`)
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES:
+		g.callVarint(`len(`, varName, `)`)
+		g.P(`i+=copy(data[i:], `, varName, `)`)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		g.callVarint(varName, `.SizeCached()`)
+		g.P(`nn, err := `, varName, `.MarshalToUsingCachedSize(data[i:])`)
+		g.P(`if err != nil {`)
+		g.In()
+		g.P(`return 0, err`)
+		g.Out()
+		g.P(`}`)
+		g.P(`i+=nn`)
+	default:
+		g.Fail("map does not support", field.GetType().String())
+	}
+}
+
+// Declares keys, holding the keys of the map field in ascending order.
+func (g *Generator) sortedMapKeys(field *descriptor.FieldDescriptorProto, fieldname string) {
+	_, key, _ := g.MapEntry(field)
+	keyType, _ := g.GoMapType(field)
+	g.P(`keys := make([]`, keyType, `, 0, len(m.`, fieldname, `))`)
+	g.P(`for k := range m.`, fieldname, ` {`)
+	g.In()
+	g.P(`keys = append(keys, k)`)
+	g.Out()
+	g.P(`}`)
+	if key.GetType() == descriptor.FieldDescriptorProto_TYPE_BOOL {
+		g.P(g.Pkg["sort"], `.Slice(keys, func(a, b int) bool { return !keys[a] && keys[b] })`)
+	} else {
+		g.P(g.Pkg["sort"], `.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })`)
+	}
+}
+
+func (g *Generator) generateMapMarshalto(field *descriptor.FieldDescriptorProto, fieldname string) {
+	_, key, value := g.MapEntry(field)
+	g.sortedMapKeys(field, fieldname)
+	g.P(`for _, k := range keys {`)
+	g.In()
+	g.P(`v := m.`, fieldname, `[k]`)
+	g.encodeKey(field.GetNumber(), proto.WireBytes)
+	g.P(`mapEntrySize := `, g.mapEntrySize(key, value, "v.SizeCached()"))
+	g.callVarint(`mapEntrySize`)
+	g.encodeKey(1, key.WireType())
+	g.encodeMapField(key, "k")
+	g.encodeKey(2, value.WireType())
+	g.encodeMapField(value, "v")
+	g.Out()
+	g.P(`}`)
+}
+
+func (g *Generator) generateMapUnmarshal(field *descriptor.FieldDescriptorProto, fieldname string) {
+	entry, _, _ := g.MapEntry(field)
+	g.P(`var msglen int`)
+	g.decodeVarint("msglen", "int")
+	g.P(`postIndex := index + msglen`)
+	g.P(`if postIndex > l {`)
+	g.In()
+	g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
+	g.Out()
+	g.P(`}`)
+	g.P(`entry := &`, CamelCaseSlice(entry.TypeName()), `{}`)
+	g.P(`if err := entry.Unmarshal(data[index:postIndex]); err != nil {`)
+	g.In()
+	g.nestedUnmarshalError(entry)
+	g.Out()
+	g.P(`}`)
+	g.mergeMapEntry(field, fieldname)
+	g.P(`index = postIndex`)
+}
+
+// Stores the key and value of the decoded map entry named entry into the
+// map field.
+func (g *Generator) mergeMapEntry(field *descriptor.FieldDescriptorProto, fieldname string) {
+	entry, key, value := g.MapEntry(field)
+	keyType, valueType := g.GoMapType(field)
+	g.P(`if m.`, fieldname, ` == nil {`)
+	g.In()
+	g.P(`m.`, fieldname, ` = make(map[`, keyType, `]`, valueType, `)`)
+	g.Out()
+	g.P(`}`)
+	valueName := g.GetFieldName(entry, value)
+	if value.IsMessage() {
+		g.P(`if entry.`, valueName, ` == nil {`)
+		g.In()
+		g.P(`entry.`, valueName, ` = new(`, GoTypeToName(valueType), `)`)
+		g.Out()
+		g.P(`}`)
+	}
+	g.P(`m.`, fieldname, `[entry.`, g.GetFieldName(entry, key), `] = entry.`, valueName)
+}
+
+// Returns a composite literal of the entry message holding the key k and
+// the value v of a map field.
+func (g *Generator) mapEntryLiteral(field *descriptor.FieldDescriptorProto) string {
+	entry, key, value := g.MapEntry(field)
+	fields := []string{}
+	for _, f := range []*descriptor.FieldDescriptorProto{key, value} {
+		name := g.GetFieldName(entry, f)
+		v := "k"
+		if f == value {
+			v = "v"
+		}
+		fields = append(fields, name+": "+v)
+		if !HasImplicitPresence(entry, f) {
+			fields = append(fields, SetterName(name)+": true")
+		}
+	}
+	return `&` + CamelCaseSlice(entry.TypeName()) + `{` + strings.Join(fields, ", ") + `}`
+}
@@ -231,14 +231,28 @@ func (g *Generator) generateMarshalto(file *FileDescriptor) {
 		g.P(`return nil, err`)
 		g.Out()
 		g.P(`}`)
-		g.P(`return data[:n], nil`)
+		if g.hasRequired(message) {
+			g.P(`return data[:n], m.CheckInitialized()`)
+		} else {
+			g.P(`return data[:n], nil`)
+		}
 		g.Out()
 		g.P(`}`)
 		g.P(``)
 		g.P(`func (m *`, ccTypeName, `) MarshalTo(data []byte) (n int, err error) {`)
 		g.In()
 		g.P(`m.Size()`)
-		g.P(`return m.MarshalToUsingCachedSize(data)`)
+		if g.hasRequired(message) {
+			g.P(`n, err = m.MarshalToUsingCachedSize(data)`)
+			g.P(`if err != nil {`)
+			g.In()
+			g.P(`return n, err`)
+			g.Out()
+			g.P(`}`)
+			g.P(`return n, m.CheckInitialized()`)
+		} else {
+			g.P(`return m.MarshalToUsingCachedSize(data)`)
+		}
 		g.Out()
 		g.P(`}`)
 		g.P(``)
@@ -250,6 +264,14 @@ func (g *Generator) generateMarshalto(file *FileDescriptor) {
 		g.P(`_ = l`)
 		for _, field := range message.Field {
 			fieldname := g.GetFieldName(message, field)
+			if g.IsMap(field) {
+				g.P(`if len(m.`, fieldname, `) > 0 {`)
+				g.In()
+				g.generateMapMarshalto(field, fieldname)
+				g.Out()
+				g.P(`}`)
+				continue
+			}
 			repeated := field.IsRepeated()
 			sizerName := ""
 			if repeated {
@@ -257,10 +279,10 @@ func (g *Generator) generateMarshalto(file *FileDescriptor) {
 				g.P(`if m.`, sizerName, ` > 0 {`)
 				g.In()
 			} else {
-				g.P(`if m.`, SetterName(fieldname), ` {`)
+				g.P(`if `, g.presenceCheck(message, field, fieldname), ` {`)
 				g.In()
 			}
-			packed := field.IsPacked()
+			packed := g.IsPacked(field)
 			wireType := field.WireType()
 			fieldNumber := field.GetNumber()
 			if packed {
@@ -483,12 +505,37 @@ func (g *Generator) generateMarshalto(file *FileDescriptor) {
 					g.P(`i+=copy(data[i:], m.`, fieldname, `)`)
 				}
 			case descriptor.FieldDescriptorProto_TYPE_GROUP:
-				panic(fmt.Errorf("marshaler does not support group %v", fieldname))
+				if repeated {
+					g.P(`for idx := 0; idx < m.`, sizerName, `; idx++ {`)
+					g.In()
+					g.P(`msg := `, MessageRef(field), `m.`, fieldname, `[idx]`)
+					g.encodeKey(fieldNumber, proto.WireStartGroup)
+					g.P(`n, err := msg.MarshalToUsingCachedSize(data[i:])`)
+					g.P(`if err != nil {`)
+					g.In()
+					g.P(`return 0, err`)
+					g.Out()
+					g.P(`}`)
+					g.P(`i+=n`)
+					g.encodeKey(fieldNumber, proto.WireEndGroup)
+					g.Out()
+					g.P(`}`)
+				} else {
+					g.encodeKey(fieldNumber, proto.WireStartGroup)
+					g.P(`n`, numGen.Next(), `, err := m.`, fieldname, `.MarshalToUsingCachedSize(data[i:])`)
+					g.P(`if err != nil {`)
+					g.In()
+					g.P(`return 0, err`)
+					g.Out()
+					g.P(`}`)
+					g.P(`i+=n`, numGen.Current())
+					g.encodeKey(fieldNumber, proto.WireEndGroup)
+				}
 			case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
 				if repeated {
 					g.P(`for idx := 0; idx < m.`, sizerName, `; idx++ {`)
 					g.In()
-					g.P(`msg := m.`, fieldname, `[idx]`)
+					g.P(`msg := `, MessageRef(field), `m.`, fieldname, `[idx]`)
 					g.encodeKey(fieldNumber, wireType)
 					g.callVarint("msg.SizeCached()")
 					g.P(`n, err := msg.MarshalToUsingCachedSize(data[i:])`)
@@ -603,7 +650,7 @@ func (g *Generator) generateMarshalto(file *FileDescriptor) {
 					g.callVarint(`(uint64(m.`, fieldname, `) << 1) ^ uint64((m.`, fieldname, ` >> 63))`)
 				}
 			default:
-				panic("not implemented")
+				g.FailAt(message, field, "unsupported field type:", field.GetType().String())
 			}
 			g.Out()
 			g.P(`}`)
@@ -0,0 +1,118 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+The pool code generates a pool of messages for each message with the pool
+or pool_all extension, so that messages can be recycled on the hot path
+instead of being left to the garbage collector.
+
+Given the following message:
+
+  option (gogoproto.pool_all) = true;
+
+  message A {
+	optional string description = 1;
+	repeated B items = 2;
+  }
+
+the pool code will generate the following code:
+
+  var poolA = sync.Pool{New: func() interface{} { return new(A) }}
+
+  // AcquireA returns an empty A from the pool. Call Release once the
+  // message is no longer used.
+  func AcquireA() *A {
+	return poolA.Get().(*A)
+  }
+
+  // Release clears m and returns it to the pool. Neither m nor any of the
+  // messages it holds may be used after the call.
+  func (m *A) Release() {
+	if m == nil {
+		return
+	}
+	m.Clear()
+	m.XXX_unrecognized = m.XXX_unrecognized[:0]
+	poolA.Put(m)
+  }
+
+Since Clear keeps the memory held by the fields of a message, including the
+messages past the end of repeated fields, a released message is unmarshaled
+into without allocating the nested messages again.
+*/
+package generator
+
+import (
+	"github.com/dropbox/goprotoc/gogoproto"
+)
+
+// Returns true if any message of the file has a pool.
+func (g *Generator) hasPool(file *FileDescriptor) bool {
+	for _, message := range file.Messages() {
+		if gogoproto.HasPool(file.FileDescriptorProto, message.DescriptorProto) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generator) generatePool(file *FileDescriptor) {
+	for _, message := range file.Messages() {
+		if !gogoproto.HasPool(file.FileDescriptorProto, message.DescriptorProto) {
+			continue
+		}
+		ccTypeName := CamelCaseSlice(message.TypeName())
+		poolName := "pool" + ccTypeName
+		g.P(`var `, poolName, ` = `, g.Pkg["sync"], `.Pool{New: func() interface{} { return new(`, ccTypeName, `) }}`)
+		g.P()
+		g.P(`// Acquire`, ccTypeName, ` returns an empty `, ccTypeName, ` from the pool. Call Release once the`)
+		g.P(`// message is no longer used.`)
+		g.P(`func Acquire`, ccTypeName, `() *`, ccTypeName, ` {`)
+		g.In()
+		g.P(`return `, poolName, `.Get().(*`, ccTypeName, `)`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+		g.P(`// Release clears m and returns it to the pool. Neither m nor any of the`)
+		g.P(`// messages it holds may be used after the call.`)
+		g.P(`func (m *`, ccTypeName, `) Release() {`)
+		g.In()
+		g.P(`if m == nil {`)
+		g.In()
+		g.P(`return`)
+		g.Out()
+		g.P(`}`)
+		g.P(`m.Clear()`)
+		if len(message.ExtensionRange) > 0 {
+			g.P(`m.XXX_extensions = nil`)
+		}
+		g.P(`m.XXX_unrecognized = m.XXX_unrecognized[:0]`)
+		g.P(poolName, `.Put(m)`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+	}
+}
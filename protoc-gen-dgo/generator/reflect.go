@@ -0,0 +1,342 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+The reflect code generates a reflection table for each message, returned by
+its ProtoReflect method, so that generic code such as validators and
+redactors can walk messages through proto.Reflect, since the fields of the
+generated messages are private and cannot be found using reflection. The
+accessors of the table call the generated methods.
+
+Given the following message:
+
+  message A {
+	optional string description = 1;
+	repeated B items = 2;
+  }
+
+the reflect code will generate the following code:
+
+  var reflectionA = &proto.MessageInfo{
+	Name: "pkg.A",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "description",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*A)(nil).GetDescription(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*A).GetDescription()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "description", v)
+				}
+				x := m.(*A)
+				return x.SetDescription(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*A).HasDescription()
+			},
+			Clear: func(m proto.Message) {
+				m.(*A).ClearDescription()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "items",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(B)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*A)
+				v := make([]*B, x.xxx_LenItems)
+				copy(v, x.items)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*B)
+				if !ok {
+					return proto.NewFieldTypeError(m, "items", v)
+				}
+				x := m.(*A)
+				x.ClearItems()
+				for _, e := range value {
+					field, err := x.AddItems()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*A).ItemsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*A).ClearItems()
+			},
+		},
+	},
+  }
+
+  func (m *A) ProtoReflect() *proto.MessageInfo {
+	return reflectionA
+  }
+*/
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/goprotoc/gogoproto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+func (g *Generator) generateReflect(file *FileDescriptor) {
+	for _, message := range file.Messages() {
+		ccTypeName := CamelCaseSlice(message.TypeName())
+		tableName := "reflection" + ccTypeName
+		g.P(`var `, tableName, ` = &`, g.Pkg["proto"], `.MessageInfo{`)
+		g.In()
+		g.P(`Name: `, strconv.Quote(message.FullName()), `,`)
+		g.P(`Fields: []*`, g.Pkg["proto"], `.FieldInfo{`)
+		g.In()
+		for _, field := range message.Field {
+			g.generateFieldInfo(message, ccTypeName, field)
+		}
+		g.Out()
+		g.P(`},`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+		g.P(`func (m *`, ccTypeName, `) ProtoReflect() *`, g.Pkg["proto"], `.MessageInfo {`)
+		g.In()
+		g.P(`return `, tableName)
+		g.Out()
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// Returns the name of the proto.Kind constant of the type of the field, such
+// as "Sfixed32Kind" for TYPE_SFIXED32.
+func kindName(field *descriptor.FieldDescriptorProto) string {
+	name := strings.TrimPrefix(field.GetType().String(), "TYPE_")
+	return CamelCase(strings.ToLower(name)) + "Kind"
+}
+
+// Returns the name of the proto.Label constant of the label of the field.
+func labelName(field *descriptor.FieldDescriptorProto) string {
+	name := strings.TrimPrefix(field.GetLabel().String(), "LABEL_")
+	return CamelCase(strings.ToLower(name)) + "Label"
+}
+
+// Returns true if a getter returning the value of the singular field is
+// generated.
+func hasGetter(field *descriptor.FieldDescriptorProto) bool {
+	return !gogoproto.IsEmbed(field) && !field.IsRepeated() && !gogoproto.IsCustomType(field)
+}
+
+func (g *Generator) generateFieldInfo(message *Descriptor, ccTypeName string, field *descriptor.FieldDescriptorProto) {
+	fieldname := g.GetFieldName(message, field)
+	name := CamelCase(fieldname)
+	goType, _ := g.GoType(message, field)
+	isMap := g.IsMap(field)
+	ref := MessageRef(field)
+	if ref != "" {
+		// Messages held by value are exposed by pointer like any others.
+		if field.IsRepeated() {
+			goType = "[]*" + strings.TrimPrefix(goType, "[]")
+		} else {
+			goType = "*" + goType
+		}
+	}
+	g.P(`{`)
+	g.In()
+	g.P(`Number: `, strconv.Itoa(int(field.GetNumber())), `,`)
+	g.P(`Name: `, strconv.Quote(field.GetName()), `,`)
+	g.P(`Kind: `, g.Pkg["proto"], `.`, kindName(field), `,`)
+	g.P(`Label: `, g.Pkg["proto"], `.`, labelName(field), `,`)
+	if isMap {
+		g.P(`Map: true,`)
+	}
+	if field.IsOneof() {
+		g.P(`Oneof: `, strconv.Quote(message.OneofDecl[field.GetOneofIndex()].GetName()), `,`)
+	}
+	if hasGetter(field) && !IsMessageType(field) {
+		g.P(`Default: (*`, ccTypeName, `)(nil).Get`, name, `(),`)
+	}
+	if IsMessageType(field) && !isMap {
+		g.P(`New: func() `, g.Pkg["proto"], `.Message {`)
+		g.In()
+		g.P(`return new(`, GoTypeToName(goType), `)`)
+		g.Out()
+		g.P(`},`)
+	}
+
+	// Get
+	g.P(`Get: func(m `, g.Pkg["proto"], `.Message) interface{} {`)
+	g.In()
+	switch {
+	case isMap:
+		keyType, valueType := g.GoMapType(field)
+		g.P(`x := m.(*`, ccTypeName, `)`)
+		g.P(`v := make(`, goType, `, x.`, name, `Len())`)
+		g.P(`x.Range`, name, `(func(key `, keyType, `, value `, valueType, `) bool {`)
+		g.In()
+		g.P(`v[key] = value`)
+		g.P(`return true`)
+		g.Out()
+		g.P(`})`)
+		g.P(`return v`)
+	case field.IsRepeated():
+		g.P(`x := m.(*`, ccTypeName, `)`)
+		g.P(`v := make(`, goType, `, x.`, SizerName(fieldname), `)`)
+		if ref != "" {
+			g.P(`for i := range v {`)
+			g.In()
+			g.P(`v[i] = &x.`, fieldname, `[i]`)
+			g.Out()
+			g.P(`}`)
+		} else {
+			g.P(`copy(v, x.`, fieldname, `)`)
+		}
+		g.P(`return v`)
+	case hasGetter(field):
+		g.P(`return m.(*`, ccTypeName, `).Get`, name, `()`)
+	default:
+		g.P(`x := m.(*`, ccTypeName, `)`)
+		g.P(`if `, g.presenceCheckOf(message, field, "x", fieldname), ` {`)
+		g.In()
+		g.P(`return x.`, fieldname)
+		g.Out()
+		g.P(`}`)
+		g.P(`var zero `, goType)
+		g.P(`return zero`)
+	}
+	g.Out()
+	g.P(`},`)
+
+	// Set
+	g.P(`Set: func(m `, g.Pkg["proto"], `.Message, v interface{}) error {`)
+	g.In()
+	g.P(`value, ok := v.(`, goType, `)`)
+	g.P(`if !ok {`)
+	g.In()
+	g.P(`return `, g.Pkg["proto"], `.NewFieldTypeError(m, `, strconv.Quote(field.GetName()), `, v)`)
+	g.Out()
+	g.P(`}`)
+	g.P(`x := m.(*`, ccTypeName, `)`)
+	switch {
+	case isMap:
+		_, _, value := g.MapEntry(field)
+		_, valueType := g.GoMapType(field)
+		g.P(`x.Clear`, name, `()`)
+		g.P(`for k, e := range value {`)
+		g.In()
+		g.P(`if err := x.Put`, name, `(k, `, g.cloneValue(value, valueType, "e"), `); err != nil {`)
+		g.In()
+		g.P(`return err`)
+		g.Out()
+		g.P(`}`)
+		g.Out()
+		g.P(`}`)
+		g.P(`return nil`)
+	case field.IsRepeated():
+		g.P(`x.Clear`, name, `()`)
+		g.P(`for _, e := range value {`)
+		g.In()
+		if IsMessageType(field) {
+			g.P(`field, err := x.Add`, name, `()`)
+			g.P(`if err != nil {`)
+			g.In()
+			g.P(`return err`)
+			g.Out()
+			g.P(`}`)
+			g.P(`field.MergeFrom(e)`)
+		} else {
+			g.P(`if err := x.Add`, name, `(`, g.cloneValue(field, "", "e"), `); err != nil {`)
+			g.In()
+			g.P(`return err`)
+			g.Out()
+			g.P(`}`)
+		}
+		g.Out()
+		g.P(`}`)
+		g.P(`return nil`)
+	case IsMessageType(field):
+		g.P(`x.Clear`, name, `()`)
+		g.P(`if value == nil {`)
+		g.In()
+		g.P(`return nil`)
+		g.Out()
+		g.P(`}`)
+		g.P(`field, err := x.Mutate`, name, `()`)
+		g.P(`if err != nil {`)
+		g.In()
+		g.P(`return err`)
+		g.Out()
+		g.P(`}`)
+		g.P(`field.MergeFrom(value)`)
+		g.P(`return nil`)
+	default:
+		g.P(`return x.Set`, name, `(`, g.cloneValue(field, "", "value"), `)`)
+	}
+	g.Out()
+	g.P(`},`)
+
+	// Has
+	g.P(`Has: func(m `, g.Pkg["proto"], `.Message) bool {`)
+	g.In()
+	switch {
+	case isMap:
+		g.P(`return m.(*`, ccTypeName, `).`, name, `Len() > 0`)
+	case field.IsRepeated():
+		g.P(`return m.(*`, ccTypeName, `).`, name, `Size() > 0`)
+	case HasImplicitPresence(message, field):
+		g.P(`x := m.(*`, ccTypeName, `)`)
+		g.P(`return `, g.presenceCheckOf(message, field, "x", fieldname))
+	default:
+		g.P(`return m.(*`, ccTypeName, `).Has`, name, `()`)
+	}
+	g.Out()
+	g.P(`},`)
+
+	// Clear
+	g.P(`Clear: func(m `, g.Pkg["proto"], `.Message) {`)
+	g.In()
+	g.P(`m.(*`, ccTypeName, `).Clear`, name, `()`)
+	g.Out()
+	g.P(`},`)
+	g.Out()
+	g.P(`},`)
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+The service code generates client and server stubs for each service
+in the file. The stubs are transport agnostic; see the rpc package.
+
+Given the following service:
+
+  service Search {
+	rpc Query (Request) returns (Response);
+  }
+
+the service code will generate the following code:
+
+  type SearchClient struct {
+	transport rpc.Transport
+  }
+
+  func NewSearchClient(transport rpc.Transport) *SearchClient {
+	return &SearchClient{transport}
+  }
+
+  func (c *SearchClient) Query(in *Request) (*Response, error) {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	data, err = c.transport.Call("/pkg.Search/Query", data)
+	if err != nil {
+		return nil, err
+	}
+	out := new(Response)
+	if err := proto.Unmarshal(data, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+  }
+
+  type SearchServer interface {
+	Query(in *Request) (*Response, error)
+  }
+
+  func RegisterSearchServer(s *rpc.Server, srv SearchServer) {
+	s.RegisterService(&_Search_serviceDesc, srv)
+  }
+
+  func _Search_Query_Handler(srv interface{}, data []byte) ([]byte, error) {
+	in := new(Request)
+	if err := proto.Unmarshal(data, in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(SearchServer).Query(in)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, errors.New("rpc: Search.Query returned a nil response")
+	}
+	return proto.Marshal(out)
+  }
+
+  var _Search_serviceDesc = rpc.ServiceDesc{
+	ServiceName: "pkg.Search",
+	HandlerType: (*SearchServer)(nil),
+	Methods: []rpc.MethodDesc{
+		{
+			MethodName: "Query",
+			Handler:    _Search_Query_Handler,
+		},
+	},
+  }
+
+*/
+package generator
+
+import (
+	"fmt"
+	"strconv"
+
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+func (g *Generator) generateServices(file *FileDescriptor) {
+	for i, service := range file.Service {
+		g.generateService(service, i)
+	}
+}
+
+// Returns the Go type names of the request and response of the method.
+func (g *Generator) methodTypes(method *descriptor.MethodDescriptorProto) (in string, out string) {
+	g.RecordTypeUse(method.GetInputType())
+	g.RecordTypeUse(method.GetOutputType())
+	in = g.TypeName(g.ObjectNamed(method.GetInputType()))
+	out = g.TypeName(g.ObjectNamed(method.GetOutputType()))
+	return in, out
+}
+
+func (g *Generator) generateService(service *descriptor.ServiceDescriptorProto, index int) {
+	path := fmt.Sprintf("%d,%d", servicePath, index)
+	servName := CamelCase(service.GetName())
+	fullServName := service.GetName()
+	if pkg := g.file.GetPackage(); pkg != "" {
+		fullServName = pkg + "." + fullServName
+	}
+	clientName := servName + "Client"
+	serverName := servName + "Server"
+	descName := "_" + servName + "_serviceDesc"
+	for _, method := range service.Method {
+		// A Transport carries a single request and response per call.
+		if method.GetClientStreaming() || method.GetServerStreaming() {
+			g.Fail("streaming method", fullServName+"."+method.GetName(), "is not supported")
+		}
+	}
+
+	g.P("// Client API for ", servName, " service")
+	g.P()
+	g.PrintComments(path)
+	g.P(`type `, clientName, ` struct {`)
+	g.In()
+	g.P(`transport `, g.Pkg["rpc"], `.Transport`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+	g.P(`func New`, clientName, `(transport `, g.Pkg["rpc"], `.Transport) *`, clientName, ` {`)
+	g.In()
+	g.P(`return &`, clientName, `{transport}`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+	for i, method := range service.Method {
+		in, out := g.methodTypes(method)
+		methName := CamelCase(method.GetName())
+		g.PrintComments(fmt.Sprintf("%s,%d,%d", path, serviceMethodPath, i))
+		g.P(`func (c *`, clientName, `) `, methName, `(in *`, in, `) (*`, out, `, error) {`)
+		g.In()
+		g.P(`data, err := `, g.Pkg["proto"], `.Marshal(in)`)
+		g.P(`if err != nil {`)
+		g.In()
+		g.P(`return nil, err`)
+		g.Out()
+		g.P(`}`)
+		g.P(`data, err = c.transport.Call(`, strconv.Quote("/"+fullServName+"/"+method.GetName()), `, data)`)
+		g.P(`if err != nil {`)
+		g.In()
+		g.P(`return nil, err`)
+		g.Out()
+		g.P(`}`)
+		g.P(`out := new(`, out, `)`)
+		g.P(`if err := `, g.Pkg["proto"], `.Unmarshal(data, out); err != nil {`)
+		g.In()
+		g.P(`return nil, err`)
+		g.Out()
+		g.P(`}`)
+		g.P(`return out, nil`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+	}
+
+	g.P("// Server API for ", servName, " service")
+	g.P()
+	g.PrintComments(path)
+	g.P(`type `, serverName, ` interface {`)
+	g.In()
+	for i, method := range service.Method {
+		in, out := g.methodTypes(method)
+		g.PrintComments(fmt.Sprintf("%s,%d,%d", path, serviceMethodPath, i))
+		g.P(CamelCase(method.GetName()), `(in *`, in, `) (*`, out, `, error)`)
+	}
+	g.Out()
+	g.P(`}`)
+	g.P()
+	g.P(`func Register`, serverName, `(s *`, g.Pkg["rpc"], `.Server, srv `, serverName, `) {`)
+	g.In()
+	g.P(`s.RegisterService(&`, descName, `, srv)`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+	for _, method := range service.Method {
+		in, _ := g.methodTypes(method)
+		methName := CamelCase(method.GetName())
+		g.P(`func `, handlerName(servName, methName), `(srv interface{}, data []byte) ([]byte, error) {`)
+		g.In()
+		g.P(`in := new(`, in, `)`)
+		g.P(`if err := `, g.Pkg["proto"], `.Unmarshal(data, in); err != nil {`)
+		g.In()
+		g.P(`return nil, err`)
+		g.Out()
+		g.P(`}`)
+		g.P(`out, err := srv.(`, serverName, `).`, methName, `(in)`)
+		g.P(`if err != nil {`)
+		g.In()
+		g.P(`return nil, err`)
+		g.Out()
+		g.P(`}`)
+		g.P(`if out == nil {`)
+		g.In()
+		g.P(`return nil, `, g.Pkg["errors"], `.New(`,
+			strconv.Quote("rpc: "+service.GetName()+"."+method.GetName()+" returned a nil response"), `)`)
+		g.Out()
+		g.P(`}`)
+		g.P(`return `, g.Pkg["proto"], `.Marshal(out)`)
+		g.Out()
+		g.P(`}`)
+		g.P()
+	}
+
+	g.P(`var `, descName, ` = `, g.Pkg["rpc"], `.ServiceDesc{`)
+	g.In()
+	g.P(`ServiceName: `, strconv.Quote(fullServName), `,`)
+	g.P(`HandlerType: (*`, serverName, `)(nil),`)
+	g.P(`Methods: []`, g.Pkg["rpc"], `.MethodDesc{`)
+	g.In()
+	for _, method := range service.Method {
+		g.P(`{`)
+		g.In()
+		g.P(`MethodName: `, strconv.Quote(method.GetName()), `,`)
+		g.P(`Handler: `, handlerName(servName, CamelCase(method.GetName())), `,`)
+		g.Out()
+		g.P(`},`)
+	}
+	g.Out()
+	g.P(`},`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
+
+func handlerName(servName string, methName string) string {
+	return "_" + servName + "_" + methName + "_Handler"
+}
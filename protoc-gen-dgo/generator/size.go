@@ -111,7 +111,6 @@ The sovExample function is a size of varint function for the example.pb.go file.
 package generator
 
 import (
-	"fmt"
 	"github.com/dropbox/goprotoc/gogoproto"
 	"github.com/dropbox/goprotoc/proto"
 	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
@@ -129,7 +128,7 @@ func wireToType(wire string) int {
 	case "bytes":
 		return proto.WireBytes
 	case "group":
-		return proto.WireBytes
+		return proto.WireStartGroup
 	case "zigzag32":
 		return proto.WireVarint
 	case "zigzag64":
@@ -177,6 +176,14 @@ func (g *Generator) generateSize(file *FileDescriptor) {
 		g.P(`_ = l`)
 		for _, field := range message.Field {
 			fieldname := g.GetFieldName(message, field)
+			if g.IsMap(field) {
+				g.P(`if len(m.`, fieldname, `) > 0 {`)
+				g.In()
+				g.generateMapSize(field, fieldname)
+				g.Out()
+				g.P(`}`)
+				continue
+			}
 			repeated := field.IsRepeated()
 			sizerName := ""
 			if repeated {
@@ -184,10 +191,10 @@ func (g *Generator) generateSize(file *FileDescriptor) {
 				g.P(`if m.`, sizerName, ` > 0 {`)
 				g.In()
 			} else {
-				g.P(`if m.`, SetterName(fieldname), ` {`)
+				g.P(`if `, g.presenceCheck(message, field, fieldname), ` {`)
 				g.In()
 			}
-			packed := field.IsPacked()
+			packed := g.IsPacked(field)
 			_, wire := g.GoType(message, field)
 			wireType := wireToType(wire)
 			fieldNumber := field.GetNumber()
@@ -284,12 +291,22 @@ func (g *Generator) generateSize(file *FileDescriptor) {
 					g.P(`n+=`, strconv.Itoa(key), `+l+sov`, g.localName, `(uint64(l))`)
 				}
 			case descriptor.FieldDescriptorProto_TYPE_GROUP:
-				panic(fmt.Errorf("size does not support group %v", fieldname))
+				// The start and end group keys have the same size.
+				if repeated {
+					g.P(`for i := 0; i < m.`, sizerName, `; i++ {`)
+					g.In()
+					g.P(`e := `, MessageRef(field), `m.`, fieldname, `[i]`)
+					g.P(`n+=`, strconv.Itoa(2*key), `+e.Size()`)
+					g.Out()
+					g.P(`}`)
+				} else {
+					g.P(`n+=`, strconv.Itoa(2*key), `+m.`, fieldname, `.Size()`)
+				}
 			case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
 				if repeated {
 					g.P(`for i := 0; i < m.`, sizerName, `; i++ {`)
 					g.In()
-					g.P(`e := m.`, fieldname, `[i]`)
+					g.P(`e := `, MessageRef(field), `m.`, fieldname, `[i]`)
 					g.P(`l=e.Size()`)
 					g.P(`n+=`, strconv.Itoa(key), `+l+sov`, g.localName, `(uint64(l))`)
 					g.Out()
@@ -333,7 +350,7 @@ func (g *Generator) generateSize(file *FileDescriptor) {
 					g.P(`n+=`, strconv.Itoa(key), `+soz`, g.localName, `(uint64(m.`, fieldname, `))`)
 				}
 			default:
-				panic("not implemented")
+				g.FailAt(message, field, "unsupported field type:", field.GetType().String())
 			}
 			g.Out()
 			g.P(`}`)
@@ -0,0 +1,282 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+The text code generates the methods used by the proto package to marshal
+and unmarshal messages in text format, since the fields of the generated
+messages are private and cannot be found using reflection.
+
+Given the following message:
+
+  message A {
+	optional string description = 1;
+	repeated B items = 2;
+  }
+
+the text code will generate the following code:
+
+  func (m *A) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsDescriptionSet {
+		w.Field("description")
+		w.Value(m.description)
+	}
+	for i := 0; i < m.xxx_LenItems; i++ {
+		w.Field("items")
+		w.Message(m.items[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+  }
+
+  func (m *A) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "description":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetDescription(v)
+	case "items":
+		v, err := m.AddItems()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+  }
+*/
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dropbox/goprotoc/gogoproto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+func (g *Generator) generateText(file *FileDescriptor) {
+	for _, message := range file.Messages() {
+		ccTypeName := CamelCaseSlice(message.TypeName())
+		g.generateMarshalText(message, ccTypeName)
+		g.generateUnmarshalText(message, ccTypeName)
+	}
+}
+
+// Returns the name of the field in text format. Groups are written using
+// the name of their message type.
+func (g *Generator) textNames(field *descriptor.FieldDescriptorProto) []string {
+	names := []string{field.GetName()}
+	if g.IsGroup(field) {
+		names = append([]string{g.ObjectNamed(field.GetTypeName()).(*Descriptor).GetName()}, names...)
+	}
+	return names
+}
+
+// Writes the field name and the value of varName to the TextWriter w.
+func (g *Generator) textWriteValue(field *descriptor.FieldDescriptorProto, varName string) {
+	name := strconv.Quote(g.textNames(field)[0])
+	switch {
+	case g.IsGroup(field):
+		g.P(`w.GroupField(`, name, `)`)
+		g.P(`w.Group(`, varName, `)`)
+	case IsMessageType(field):
+		g.P(`w.Field(`, name, `)`)
+		g.P(`w.Message(`, varName, `)`)
+	case *field.Type == descriptor.FieldDescriptorProto_TYPE_ENUM && !gogoproto.IsCustomType(field):
+		g.P(`w.Field(`, name, `)`)
+		g.P(`w.Enum(int32(`, varName, `), `, g.jsonEnumMap(field, false), `)`)
+	default:
+		g.P(`w.Field(`, name, `)`)
+		g.P(`w.Value(`, g.castToBase(field, varName), `)`)
+	}
+}
+
+func (g *Generator) generateMarshalText(message *Descriptor, ccTypeName string) {
+	g.P(`func (m *`, ccTypeName, `) MarshalTextFields(w *`, g.Pkg["proto"], `.TextWriter) {`)
+	g.In()
+	for _, field := range message.Field {
+		fieldname := g.GetFieldName(message, field)
+		if g.IsMap(field) {
+			g.P(`if len(m.`, fieldname, `) > 0 {`)
+			g.In()
+			g.sortedMapKeys(field, fieldname)
+			g.P(`for _, k := range keys {`)
+			g.In()
+			g.P(`v := m.`, fieldname, `[k]`)
+			g.P(`w.Field(`, strconv.Quote(field.GetName()), `)`)
+			g.P(`w.Message(`, g.mapEntryLiteral(field), `)`)
+			g.Out()
+			g.P(`}`)
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		if field.IsRepeated() {
+			g.P(`for i := 0; i < m.`, SizerName(fieldname), `; i++ {`)
+			g.In()
+			g.textWriteValue(field, MessageRef(field)+`m.`+fieldname+`[i]`)
+			g.Out()
+			g.P(`}`)
+			continue
+		}
+		g.P(`if `, g.presenceCheck(message, field, fieldname), ` {`)
+		g.In()
+		g.textWriteValue(field, MessageRef(field)+`m.`+fieldname)
+		g.Out()
+		g.P(`}`)
+	}
+	if len(message.ExtensionRange) > 0 {
+		g.P(`w.Extensions(m)`)
+	}
+	g.P(`w.Unknown(m.XXX_unrecognized)`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
+
+// Reads the value of a scalar field into a new variable v, and returns the
+// expression of the value in the Go type of the field.
+func (g *Generator) textReadValue(field *descriptor.FieldDescriptorProto) string {
+	reader := ""
+	switch *field.Type {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		reader = "Float64"
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		reader = "Float32"
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		reader = "Int64"
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		reader = "Uint64"
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		reader = "Int32"
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		reader = "Uint32"
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		reader = "Bool"
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		reader = "String"
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		reader = "Bytes"
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		g.P(`v, err := p.ReadEnum(`, g.jsonEnumMap(field, true), `)`)
+		g.textReturnErr()
+		return g.TypeName(g.ObjectNamed(field.GetTypeName())) + `(v)`
+	default:
+		g.Fail("unsupported field type for text:", field.GetName())
+	}
+	g.P(`v, err := p.Read`, reader, `()`)
+	g.textReturnErr()
+	return g.castFromBase(field, `v`)
+}
+
+func (g *Generator) textReturnErr() {
+	g.P(`if err != nil {`)
+	g.In()
+	g.P(`return true, err`)
+	g.Out()
+	g.P(`}`)
+}
+
+func (g *Generator) generateUnmarshalText(message *Descriptor, ccTypeName string) {
+	g.P(`func (m *`, ccTypeName, `) UnmarshalTextField(p *`, g.Pkg["proto"], `.TextParser, name string) (bool, error) {`)
+	g.In()
+	if len(message.Field) > 0 {
+		g.P(`switch name {`)
+	}
+	for _, field := range message.Field {
+		fieldname := g.GetFieldName(message, field)
+		name := CamelCase(fieldname)
+		cases := []string{}
+		for _, n := range g.textNames(field) {
+			cases = append(cases, strconv.Quote(n))
+		}
+		g.P(`case `, strings.Join(cases, ", "), `:`)
+		g.In()
+		switch {
+		case g.IsMap(field):
+			entry, _, _ := g.MapEntry(field)
+			g.P(`entry := &`, CamelCaseSlice(entry.TypeName()), `{}`)
+			g.P(`if err := p.ReadMessage(entry); err != nil {`)
+			g.In()
+			g.P(`return true, err`)
+			g.Out()
+			g.P(`}`)
+			g.mergeMapEntry(field, fieldname)
+			g.P(`return true, nil`)
+		case gogoproto.IsCustomType(field):
+			if field.IsRepeated() {
+				goType, _ := g.GoType(message, field)
+				g.genSmartResize(&fieldNames{fieldName: fieldname, fieldTypeBase: strings.TrimPrefix(goType, "[]")}, "")
+				g.P(`if err := p.ReadValue(&m.`, fieldname, `[m.`, SizerName(fieldname), `]); err != nil {`)
+				g.In()
+				g.P(`return true, err`)
+				g.Out()
+				g.P(`}`)
+				g.P(`m.`, SizerName(fieldname), ` += 1`)
+			} else {
+				g.P(`if err := p.ReadValue(&m.`, fieldname, `); err != nil {`)
+				g.In()
+				g.P(`return true, err`)
+				g.Out()
+				g.P(`}`)
+				if !HasImplicitPresence(message, field) {
+					g.genOneofSwitch(message, field)
+					g.P(`m.`, SetterName(fieldname), ` = true`)
+				}
+			}
+			g.P(`return true, nil`)
+		case IsMessageType(field):
+			if field.IsRepeated() {
+				g.P(`v, err := m.Add`, name, `()`)
+			} else {
+				g.P(`v, err := m.Mutate`, name, `()`)
+			}
+			g.textReturnErr()
+			g.P(`return true, p.ReadMessage(v)`)
+		case field.IsRepeated():
+			v := g.textReadValue(field)
+			g.P(`return true, m.Add`, name, `(`, v, `)`)
+		default:
+			v := g.textReadValue(field)
+			g.P(`return true, m.Set`, name, `(`, v, `)`)
+		}
+		g.Out()
+	}
+	if len(message.Field) > 0 {
+		g.P(`}`)
+	}
+	g.P(`return false, nil`)
+	g.Out()
+	g.P(`}`)
+	g.P()
+}
@@ -106,7 +106,6 @@ the unmarshal will generate the following code:
 				if wireType != 0 {
 					return fmt.Errorf("proto: wrong wireType = %d for field g", wireType)
 				}
-				m.xxx_LenG += 1
 				var v int64
 				for shift := uint(0); ; shift += 7 {
 					if index >= l {
@@ -119,7 +118,21 @@ the unmarshal will generate the following code:
 						break
 					}
 				}
-				m.g = append(m.g, github_com_dropbox_goprotoc_test.Id(v))
+				if len(m.g) <= m.xxx_LenG {
+					newCapacity := 0
+					if len(m.g) == 0 {
+						newCapacity = 8
+					} else if len(m.g) < 1000000 {
+						newCapacity = m.xxx_LenG * 2
+					} else {
+						newCapacity = m.xxx_LenG + 1000000
+					}
+					t := make([]github_com_dropbox_goprotoc_test.Id, newCapacity, newCapacity)
+					copy(t, m.g)
+					m.g = t
+				}
+				m.g[m.xxx_LenG] = github_com_dropbox_goprotoc_test.Id(v)
+				m.xxx_LenG += 1
 			default:
 				var sizeOfWire int
 				for {
@@ -147,12 +160,30 @@ the unmarshal will generate the following code:
 Remember when using this code to call proto.Unmarshal.
 This will call m.Reset and invoke the generated Unmarshal method for you.
 If you call m.Unmarshal without m.Reset you could be merging protocol buffers.
+Calling m.Clear instead of m.Reset before m.Unmarshal reuses the memory
+already held by the message.
+
+Messages with one of the following extensions:
+
+  - zero_copy
+  - zero_copy_all
+
+also get an UnmarshalZeroCopy method. It decodes like Unmarshal, except that
+bytes fields are subslices of the input and string fields share its memory
+through an unsafe conversion:
+
+	b := data[index:postIndex]
+	m.a = *(*string)(unsafe.Pointer(&b))
+
+Nothing is copied, so the input buffer must outlive the message and must not
+be modified or reused while the message, or any string or byte slice taken
+from it, is still in use; doing so silently changes values that are supposed
+to be immutable. Map entries and unrecognized fields are still copied.
 
 */
 package generator
 
 import (
-	"fmt"
 	"github.com/dropbox/goprotoc/gogoproto"
 	"github.com/dropbox/goprotoc/proto"
 	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
@@ -212,19 +243,30 @@ func (g *Generator) decodeFixed64(varName string, typeName string) {
 	g.P(varName, ` |= `, typeName, `(data[i-1]) << 56`)
 }
 
-func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname string) {
+// Appends value to the repeated field, reusing the capacity left past the
+// end of the field by Clear.
+func (g *Generator) appendValue(gotype string, fieldname string, value string) {
+	g.genSmartResize(&fieldNames{fieldName: fieldname, fieldTypeBase: strings.TrimPrefix(gotype, "[]")}, "")
+	g.P(`m.`, fieldname, `[m.`, SizerName(fieldname), `] = `, value)
+	g.P(`m.`, SizerName(fieldname), ` += 1`)
+}
+
+// Generates the decoding of a single value of the field. In zero copy mode
+// bytes and string values alias data instead of being copied out of it.
+func (g *Generator) field(message *Descriptor, field *descriptor.FieldDescriptorProto, fieldname string, zeroCopy bool) {
 	repeated := field.IsRepeated()
 	gotype, _ := g.GoType(nil, field)
 	fieldtype := GoTypeToName(gotype)
-	if repeated {
-		g.P(`m.`, SizerName(fieldname), ` += 1`)
-	} else {
-		g.P(`m.`, SetterName(fieldname), ` = true`)
+	if !repeated && !IsMessageType(field) {
+		g.genOneofSwitch(message, field)
+		if !HasImplicitPresence(message, field) {
+			g.P(`m.`, SetterName(fieldname), ` = true`)
+		}
 	}
 	if gogoproto.IsCustomType(field) {
 		_, typ, err := GetCustomType(field)
 		if err != nil {
-			panic(err)
+			g.FailAt(message, field, err.Error())
 		}
 		fieldtype = typ
 	}
@@ -234,7 +276,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 			g.P(`var v uint64`)
 			g.decodeFixed64("v", "uint64")
 			g.P(`v2 := `, g.Pkg["math"], `.Float64frombits(v)`)
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v2))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v2)`)
 		} else {
 			g.P(`var v uint64`)
 			g.decodeFixed64("v", "uint64")
@@ -245,7 +287,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 			g.P(`var v uint32`)
 			g.decodeFixed32("v", "uint32")
 			g.P(`v2 := `, g.Pkg["math"], `.Float32frombits(v)`)
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v2))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v2)`)
 		} else {
 			g.P(`var v uint32`)
 			g.decodeFixed32("v", "uint32")
@@ -255,7 +297,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		if repeated {
 			g.P(`var v int64`)
 			g.decodeVarint("v", "int64")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeVarint("m."+fieldname, fieldtype)
 		}
@@ -263,7 +305,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		if repeated {
 			g.P(`var v uint64`)
 			g.decodeVarint("v", "uint64")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeVarint("m."+fieldname, fieldtype)
 		}
@@ -271,7 +313,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		if repeated {
 			g.P(`var v int32`)
 			g.decodeVarint("v", "int32")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeVarint("m."+fieldname, fieldtype)
 		}
@@ -279,7 +321,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		if repeated {
 			g.P(`var v uint64`)
 			g.decodeFixed64("v", "uint64")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeFixed64("m."+fieldname, fieldtype)
 		}
@@ -287,7 +329,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		if repeated {
 			g.P(`var v uint32`)
 			g.decodeFixed32("v", "uint32")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeFixed32("m."+fieldname, fieldtype)
 		}
@@ -295,7 +337,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		if repeated {
 			g.P(`var v int`)
 			g.decodeVarint("v", "int")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(bool(v != 0)))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(bool(v != 0))`)
 		} else {
 			g.P(`var v int`)
 			g.decodeVarint("v", "int")
@@ -310,37 +352,62 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
 		g.Out()
 		g.P(`}`)
+		value := fieldtype + `(data[index:postIndex])`
+		if zeroCopy {
+			g.P(`b := data[index:postIndex]`)
+			value = `*(*string)(` + g.Pkg["unsafe"] + `.Pointer(&b))`
+			if fieldtype != "string" {
+				value = fieldtype + `(` + value + `)`
+			}
+		}
 		if repeated {
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(data[index:postIndex]))`)
+			g.appendValue(gotype, fieldname, value)
 		} else {
-			g.P(`m.`, fieldname, ` = `, fieldtype, `(data[index:postIndex])`)
+			g.P(`m.`, fieldname, ` = `, value)
 		}
 		g.P(`index = postIndex`)
-	case descriptor.FieldDescriptorProto_TYPE_GROUP:
-		panic(fmt.Errorf("unmarshaler does not support group %v", fieldname))
-	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
-		desc := g.ObjectNamed(field.GetTypeName())
-		msgname := g.TypeName(desc)
-		g.P(`var msglen int`)
-		g.decodeVarint("msglen", "int")
-		g.P(`postIndex := index + msglen`)
-		g.P(`if postIndex > l {`)
-		g.In()
-		g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
-		g.Out()
-		g.P(`}`)
-		if repeated {
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, &`, msgname, `{})`)
-			g.P(`m.`, fieldname, `[len(m.`, fieldname, `)-1].Unmarshal(data[index:postIndex])`)
-		} else {
-			g.P(`m.`, fieldname, ` = &`, msgname, `{}`)
-			g.P(`if err := m.`, fieldname, `.Unmarshal(data[index:postIndex]); err != nil {`)
+	case descriptor.FieldDescriptorProto_TYPE_GROUP, descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		if *field.Type == descriptor.FieldDescriptorProto_TYPE_GROUP {
+			// The fields of the group run up to its matching end group key.
+			g.P(`groupLen, groupEnd, err := `, g.Pkg["proto"], `.SkipGroup(data[index:], `, strconv.Itoa(int(field.GetNumber())), `)`)
+			g.P(`if err != nil {`)
 			g.In()
 			g.P(`return err`)
 			g.Out()
 			g.P(`}`)
+			g.P(`postIndex := index + groupLen`)
+		} else {
+			g.P(`var msglen int`)
+			g.decodeVarint("msglen", "int")
+			g.P(`postIndex := index + msglen`)
+			g.P(`if postIndex > l {`)
+			g.In()
+			g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
+			g.Out()
+			g.P(`}`)
+		}
+		if repeated {
+			g.P(`v, _ := m.Add`, CamelCase(fieldname), `()`)
+		} else {
+			g.P(`v, _ := m.Mutate`, CamelCase(fieldname), `()`)
+		}
+		unmarshal := "Unmarshal"
+		if zeroCopy {
+			if desc, ok := g.ObjectNamed(field.GetTypeName()).(*Descriptor); ok &&
+				gogoproto.IsZeroCopy(desc.file, desc.DescriptorProto) {
+				unmarshal = "UnmarshalZeroCopy"
+			}
+		}
+		g.P(`if err := v.`, unmarshal, `(data[index:postIndex]); err != nil {`)
+		g.In()
+		g.nestedUnmarshalError(g.requiredType(field))
+		g.Out()
+		g.P(`}`)
+		if *field.Type == descriptor.FieldDescriptorProto_TYPE_GROUP {
+			g.P(`index += groupEnd`)
+		} else {
+			g.P(`index = postIndex`)
 		}
-		g.P(`index = postIndex`)
 	case descriptor.FieldDescriptorProto_TYPE_BYTES:
 		g.P(`var byteLen int`)
 		g.decodeVarint("byteLen", "int")
@@ -350,27 +417,23 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
 		g.Out()
 		g.P(`}`)
-		if !gogoproto.IsCustomType(field) {
-			if repeated {
-				g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, make([]byte, postIndex-index))`)
-				g.P(`copy(m.`, fieldname, `[len(m.`, fieldname, `)-1], data[index:postIndex])`)
-			} else {
-				g.P(`m.`, fieldname, ` = append([]byte{}, data[index:postIndex]...)`)
-			}
+		value := `append([]byte{}, data[index:postIndex]...)`
+		if zeroCopy {
+			// The capacity is capped so that appending to the field
+			// reallocates instead of overwriting the rest of data.
+			value = `data[index:postIndex:postIndex]`
+		}
+		if repeated {
+			g.appendValue(gotype, fieldname, value)
 		} else {
-			if repeated {
-				g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, make(([]byte), postIndex-index))`)
-				g.P(`copy(m.`, fieldname, `[len(m.`, fieldname, `)-1], data[index:postIndex])`)
-			} else {
-				g.P(`m.`, fieldname, ` = append([]byte{}, data[index:postIndex]...)`)
-			}
+			g.P(`m.`, fieldname, ` = `, value)
 		}
 		g.P(`index = postIndex`)
 	case descriptor.FieldDescriptorProto_TYPE_UINT32:
 		if repeated {
 			g.P(`var v uint32`)
 			g.decodeVarint("v", "uint32")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeVarint("m."+fieldname, fieldtype)
 		}
@@ -380,18 +443,18 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 			if repeated {
 				g.P(`var v `, typName)
 				g.decodeVarint("v", typName)
-				g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
+				g.appendValue(gotype, fieldname, `v`)
 			} else {
 				g.decodeVarint("m."+fieldname, typName)
 			}
 		} else {
-			panic("Enum custom types is not supported!")
+			g.FailAt(message, field, "enum fields can not have a customtype")
 		}
 	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
 		if repeated {
 			g.P(`var v int32`)
 			g.decodeFixed32("v", "int32")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeFixed32("m."+fieldname, fieldtype)
 		}
@@ -399,7 +462,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		if repeated {
 			g.P(`var v int64`)
 			g.decodeFixed64("v", "int64")
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.decodeFixed64("m."+fieldname, fieldtype)
 		}
@@ -408,7 +471,7 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		g.decodeVarint("v", "int32")
 		g.P(`v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))`)
 		if repeated {
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(v))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(v)`)
 		} else {
 			g.P(`m.`, fieldname, ` = `, fieldtype, `(v)`)
 		}
@@ -417,124 +480,122 @@ func (g *Generator) field(field *descriptor.FieldDescriptorProto, fieldname stri
 		g.decodeVarint("v", "uint64")
 		g.P(`v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)`)
 		if repeated {
-			g.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, fieldtype, `(int64(v)))`)
+			g.appendValue(gotype, fieldname, fieldtype+`(int64(v))`)
 		} else {
 			g.P(`m.`, fieldname, ` = `, fieldtype, `(int64(v))`)
 		}
 	default:
-		panic("not implemented")
+		g.FailAt(message, field, "unsupported field type:", field.GetType().String())
 	}
 }
 
-func (g *Generator) generateUnmarshal(file *FileDescriptor) {
+// Returns true if any message of the file has a zero copy unmarshaler which
+// aliases a string field, as only those use the unsafe package.
+func (g *Generator) hasZeroCopyString(file *FileDescriptor) bool {
 	for _, message := range file.Messages() {
-		ccTypeName := CamelCaseSlice(message.TypeName())
-
-		g.P(`func (m *`, ccTypeName, `) Unmarshal(data []byte) error {`)
-		g.In()
-		g.P(`l := len(data)`)
-		g.P(`index := 0`)
-		g.P(`for index < l {`)
-		g.In()
-		g.P(`var wire uint64`)
-		g.decodeVarint("wire", "uint64")
-		g.P(`fieldNum := int32(wire >> 3)`)
-		if len(message.Field) > 0 {
-			g.P(`wireType := int(wire & 0x7)`)
+		if !gogoproto.IsZeroCopy(file.FileDescriptorProto, message.DescriptorProto) {
+			continue
 		}
-		g.P(`switch fieldNum {`)
-		g.In()
 		for _, field := range message.Field {
-			fieldname := g.GetFieldName(message, field)
-
-			packed := field.IsPacked()
-			g.P(`case `, strconv.Itoa(int(field.GetNumber())), `:`)
-			g.In()
-			wireType := field.WireType()
-			if packed {
-				g.P(`if wireType == `, strconv.Itoa(proto.WireBytes), `{`)
-				g.In()
-				g.P(`var packedLen int`)
-				g.decodeVarint("packedLen", "int")
-				g.P(`postIndex := index + packedLen`)
-				g.P(`if postIndex > l {`)
-				g.In()
-				g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
-				g.Out()
-				g.P(`}`)
-				g.P(`for index < postIndex {`)
-				g.In()
-				g.field(field, fieldname)
-				g.Out()
-				g.P(`}`)
-				g.Out()
-				g.P(`} else if wireType == `, strconv.Itoa(wireType), `{`)
-				g.In()
-				g.field(field, fieldname)
-				g.Out()
-				g.P(`} else {`)
-				g.In()
-				g.P(`return ` + g.Pkg["fmt"] + `.Errorf("proto: wrong wireType = %d for field ` + fieldname + `", wireType)`)
-				g.Out()
-				g.P(`}`)
-			} else {
-				g.P(`if wireType != `, strconv.Itoa(wireType), `{`)
-				g.In()
-				g.P(`return ` + g.Pkg["fmt"] + `.Errorf("proto: wrong wireType = %d for field ` + fieldname + `", wireType)`)
-				g.Out()
-				g.P(`}`)
-				g.field(field, fieldname)
+			if *field.Type == descriptor.FieldDescriptorProto_TYPE_STRING {
+				return true
 			}
 		}
-		g.Out()
-		g.P(`default:`)
+	}
+	return false
+}
+
+func (g *Generator) generateUnmarshal(file *FileDescriptor) {
+	for _, message := range file.Messages() {
+		g.generateUnmarshalMethod(file, message, false)
+		if gogoproto.IsZeroCopy(file.FileDescriptorProto, message.DescriptorProto) {
+			g.generateUnmarshalMethod(file, message, true)
+		}
+	}
+}
+
+func (g *Generator) generateUnmarshalMethod(file *FileDescriptor, message *Descriptor, zeroCopy bool) {
+	ccTypeName := CamelCaseSlice(message.TypeName())
+
+	if zeroCopy {
+		g.P(`// UnmarshalZeroCopy is like Unmarshal, except that bytes and string fields`)
+		g.P(`// alias data instead of copying it, recursively for nested messages that`)
+		g.P(`// are zero copy as well. data must not be modified while m, or any value`)
+		g.P(`// read from it, is in use.`)
+		g.P(`func (m *`, ccTypeName, `) UnmarshalZeroCopy(data []byte) error {`)
+	} else {
+		g.P(`func (m *`, ccTypeName, `) Unmarshal(data []byte) error {`)
+	}
+	g.In()
+	g.P(`l := len(data)`)
+	g.P(`index := 0`)
+	g.P(`for index < l {`)
+	g.In()
+	g.P(`var wire uint64`)
+	g.decodeVarint("wire", "uint64")
+	g.P(`fieldNum := int32(wire >> 3)`)
+	if len(message.Field) > 0 {
+		g.P(`wireType := int(wire & 0x7)`)
+	}
+	g.P(`switch fieldNum {`)
+	g.In()
+	for _, field := range message.Field {
+		fieldname := g.GetFieldName(message, field)
+
+		g.P(`case `, strconv.Itoa(int(field.GetNumber())), `:`)
 		g.In()
-		if message.DescriptorProto.HasExtension() {
-			c := []string{}
-			for _, erange := range message.GetExtensionRange() {
-				c = append(c, `((fieldNum >= `+strconv.Itoa(int(erange.GetStart()))+") && (fieldNum<"+strconv.Itoa(int(erange.GetEnd()))+`))`)
-			}
-			g.P(`if `, strings.Join(c, "||"), `{`)
-			g.In()
-			g.P(`var sizeOfWire int`)
-			g.P(`for {`)
+		wireType := field.WireType()
+		// Parsers must accept both encodings of repeated scalars, whether
+		// they are declared packed or not.
+		if field.IsPackable() {
+			g.P(`if wireType == `, strconv.Itoa(proto.WireBytes), `{`)
 			g.In()
-			g.P(`sizeOfWire++`)
-			g.P(`wire >>= 7`)
-			g.P(`if wire == 0 {`)
+			g.P(`var packedLen int`)
+			g.decodeVarint("packedLen", "int")
+			g.P(`postIndex := index + packedLen`)
+			g.P(`if postIndex > l {`)
 			g.In()
-			g.P(`break`)
+			g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
 			g.Out()
 			g.P(`}`)
+			g.P(`for index < postIndex {`)
+			g.In()
+			g.field(message, field, fieldname, zeroCopy)
 			g.Out()
 			g.P(`}`)
-			g.P(`index-=sizeOfWire`)
-			g.P(`skippy, err := `, g.Pkg["proto"], `.Skip(data[index:])`)
-			g.P(`if err != nil {`)
+			g.Out()
+			g.P(`} else if wireType == `, strconv.Itoa(wireType), `{`)
 			g.In()
-			g.P(`return err`)
+			g.field(message, field, fieldname, zeroCopy)
+			g.Out()
+			g.P(`} else {`)
+			g.In()
+			g.P(`return ` + g.Pkg["fmt"] + `.Errorf("proto: wrong wireType = %d for field ` + fieldname + `", wireType)`)
 			g.Out()
 			g.P(`}`)
-			g.P(`if (index + skippy) > l {`)
+		} else {
+			g.P(`if wireType != `, strconv.Itoa(wireType), `{`)
 			g.In()
-			g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
+			g.P(`return ` + g.Pkg["fmt"] + `.Errorf("proto: wrong wireType = %d for field ` + fieldname + `", wireType)`)
 			g.Out()
 			g.P(`}`)
-			if gogoproto.HasExtensionsMap(file.FileDescriptorProto, message.DescriptorProto) {
-				g.P(`if m.XXX_extensions == nil {`)
-				g.In()
-				g.P(`m.XXX_extensions = make(map[int32]`, g.Pkg["proto"], `.Extension)`)
-				g.Out()
-				g.P(`}`)
-				g.P(`m.XXX_extensions[int32(fieldNum)] = `, g.Pkg["proto"], `.NewExtension(data[index:index+skippy])`)
+			if g.IsMap(field) {
+				g.generateMapUnmarshal(field, fieldname)
 			} else {
-				g.P(`m.XXX_extensions = append(m.XXX_extensions, data[index:index+skippy]...)`)
+				g.field(message, field, fieldname, zeroCopy)
 			}
-			g.P(`index += skippy`)
-			g.Out()
-			g.P(`} else {`)
-			g.In()
 		}
+	}
+	g.Out()
+	g.P(`default:`)
+	g.In()
+	if message.DescriptorProto.HasExtension() {
+		c := []string{}
+		for _, erange := range message.GetExtensionRange() {
+			c = append(c, `((fieldNum >= `+strconv.Itoa(int(erange.GetStart()))+") && (fieldNum<"+strconv.Itoa(int(erange.GetEnd()))+`))`)
+		}
+		g.P(`if `, strings.Join(c, "||"), `{`)
+		g.In()
 		g.P(`var sizeOfWire int`)
 		g.P(`for {`)
 		g.In()
@@ -559,21 +620,63 @@ func (g *Generator) generateUnmarshal(file *FileDescriptor) {
 		g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
 		g.Out()
 		g.P(`}`)
-		g.P(`m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)`)
-		g.P(`index += skippy`)
-		g.Out()
-		if message.DescriptorProto.HasExtension() {
+		if gogoproto.HasExtensionsMap(file.FileDescriptorProto, message.DescriptorProto) {
+			g.P(`if m.XXX_extensions == nil {`)
+			g.In()
+			g.P(`m.XXX_extensions = make(map[int32]`, g.Pkg["proto"], `.Extension)`)
 			g.Out()
 			g.P(`}`)
+			g.P(`m.XXX_extensions[int32(fieldNum)] = `, g.Pkg["proto"], `.NewExtension(data[index:index+skippy])`)
+		} else {
+			g.P(`m.XXX_extensions = append(m.XXX_extensions, data[index:index+skippy]...)`)
 		}
+		g.P(`index += skippy`)
 		g.Out()
-		g.P(`}`)
+		g.P(`} else {`)
+		g.In()
+	}
+	g.P(`var sizeOfWire int`)
+	g.P(`for {`)
+	g.In()
+	g.P(`sizeOfWire++`)
+	g.P(`wire >>= 7`)
+	g.P(`if wire == 0 {`)
+	g.In()
+	g.P(`break`)
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	g.P(`index-=sizeOfWire`)
+	g.P(`skippy, err := `, g.Pkg["proto"], `.Skip(data[index:])`)
+	g.P(`if err != nil {`)
+	g.In()
+	g.P(`return err`)
+	g.Out()
+	g.P(`}`)
+	g.P(`if (index + skippy) > l {`)
+	g.In()
+	g.P(`return `, g.Pkg["io"], `.ErrUnexpectedEOF`)
+	g.Out()
+	g.P(`}`)
+	g.P(`m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)`)
+	g.P(`index += skippy`)
+	g.Out()
+	if message.DescriptorProto.HasExtension() {
 		g.Out()
 		g.P(`}`)
+	}
+	g.Out()
+	g.P(`}`)
+	g.Out()
+	g.P(`}`)
+	if g.hasRequired(message) {
+		g.P(`return m.CheckInitialized()`)
+	} else {
 		g.P(`return nil`)
-		g.Out()
-		g.P(`}`)
 	}
+	g.Out()
+	g.P(`}`)
 }
 
 func (g *Generator) skipFixed32() {
@@ -41,90 +41,34 @@ package main
 
 import (
 	"io/ioutil"
+	"log"
 	"os"
 
 	"github.com/dropbox/goprotoc/proto"
-	"github.com/dropbox/goprotoc/protoc-gen-dgo/generator"
-
-	_ "github.com/dropbox/goprotoc/plugin/description"
-	_ "github.com/dropbox/goprotoc/plugin/embedcheck"
-	_ "github.com/dropbox/goprotoc/plugin/enumstringer"
-	_ "github.com/dropbox/goprotoc/plugin/equal"
-	_ "github.com/dropbox/goprotoc/plugin/face"
-	_ "github.com/dropbox/goprotoc/plugin/populate"
-	_ "github.com/dropbox/goprotoc/plugin/stringer"
-	_ "github.com/dropbox/goprotoc/plugin/union"
-
-	"github.com/dropbox/goprotoc/plugin/testgen"
-
-	"strings"
+	"github.com/dropbox/goprotoc/protoc-gen-dgo/command"
+	plugin "github.com/dropbox/goprotoc/protoc-gen-dgo/plugin"
 )
 
 func main() {
-	// Begin by allocating a generator. The request and response structures are stored there
-	// so we can do error handling easily - the response structure contains the field to
-	// report failure.
-	g := generator.New()
-
 	data, err := ioutil.ReadAll(os.Stdin)
 	if err != nil {
-		g.Error(err, "reading input")
-	}
-
-	if err := proto.Unmarshal(data, g.Request); err != nil {
-		g.Error(err, "parsing input proto")
-	}
-
-	if len(g.Request.FileToGenerate) == 0 {
-		g.Fail("no files to generate")
-	}
-
-	g.CommandLineParameters(g.Request.GetParameter())
-
-	// Create a wrapped version of the Descriptors and EnumDescriptors that
-	// point to the file that defines them.
-	g.WrapTypes()
-
-	g.SetPackageNames()
-	g.BuildTypeNameMap()
-
-	g.GenerateAllFiles()
-
-	gtest := generator.New()
-
-	if err := proto.Unmarshal(data, gtest.Request); err != nil {
-		gtest.Error(err, "parsing input proto")
+		log.Fatal("protoc-gen-dgo: error: reading input: ", err)
 	}
 
-	if len(gtest.Request.FileToGenerate) == 0 {
-		gtest.Fail("no files to generate")
+	req := new(plugin.CodeGeneratorRequest)
+	if err := proto.Unmarshal(data, req); err != nil {
+		log.Fatal("protoc-gen-dgo: error: parsing input proto: ", err)
 	}
 
-	gtest.CommandLineParameters(gtest.Request.GetParameter())
-
-	// Create a wrapped version of the Descriptors and EnumDescriptors that
-	// point to the file that defines them.
-	gtest.WrapTypes()
-
-	gtest.SetPackageNames()
-	gtest.BuildTypeNameMap()
-
-	gtest.GeneratePlugin(testgen.NewPlugin())
-
-	for i := 0; i < len(gtest.Response.File); i++ {
-		if strings.Contains(*gtest.Response.File[i].Content, `//These tests are generated by github.com/dropbox/goprotoc/plugin/testgen`) {
-			gtest.Response.File[i].Name = proto.String(strings.Replace(*gtest.Response.File[i].Name, ".pb.go", "pb_test.go", -1))
-			g.Response.File = append(g.Response.File, gtest.Response.File[i])
-		}
-	}
+	resp := command.Generate(req)
 
 	// Send back the results.
-	data, err = proto.Marshal(g.Response)
+	data, err = proto.Marshal(resp)
 	if err != nil {
-		g.Error(err, "failed to marshal output proto")
+		log.Fatal("protoc-gen-dgo: error: failed to marshal output proto: ", err)
 	}
 	_, err = os.Stdout.Write(data)
 	if err != nil {
-		g.Error(err, "failed to write output proto")
+		log.Fatal("protoc-gen-dgo: error: failed to write output proto: ", err)
 	}
 }
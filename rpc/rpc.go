@@ -0,0 +1,142 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package rpc contains the runtime support for the service stubs generated by
+protoc-gen-dgo.
+
+For every service in a .proto file the generator emits a server interface,
+a client type that calls through a Transport, and a ServiceDesc that maps
+each method name to a handler. Methods are addressed using their full name,
+"/package.Service/Method".
+
+A Server is itself a Transport, which dispatches calls in-memory to the
+registered implementations. This makes it possible to exercise the generated
+client and server code without any network:
+
+	srv := rpc.NewServer()
+	RegisterSearchServer(srv, &searchImpl{})
+	client := NewSearchClient(srv)
+	resp, err := client.Query(req)
+*/
+package rpc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/dropbox/godropbox/errors"
+)
+
+// Transport carries an encoded request for the named method and returns the
+// encoded response. Implementations must be safe for concurrent use.
+type Transport interface {
+	Call(method string, request []byte) (response []byte, err error)
+}
+
+// Handler decodes the request, invokes the method on srv and returns the
+// encoded response.
+type Handler func(srv interface{}, request []byte) (response []byte, err error)
+
+// MethodDesc describes a single method of a service.
+type MethodDesc struct {
+	MethodName string
+	Handler    Handler
+}
+
+// ServiceDesc describes a service, as generated by protoc-gen-dgo.
+type ServiceDesc struct {
+	// The fully qualified service name, e.g. "package.Service".
+	ServiceName string
+	// A nil pointer to the server interface, used to check implementations.
+	HandlerType interface{}
+	Methods     []MethodDesc
+}
+
+type service struct {
+	impl     interface{}
+	handlers map[string]Handler
+}
+
+// Server dispatches calls to registered service implementations.
+type Server struct {
+	mu       sync.RWMutex
+	services map[string]*service
+}
+
+// NewServer returns a server with no registered services.
+func NewServer() *Server {
+	return &Server{services: make(map[string]*service)}
+}
+
+// RegisterService registers impl as the implementation of the service
+// described by desc. It panics if impl does not implement the server
+// interface of the service or if the service is already registered, as
+// these are programming errors.
+func (s *Server) RegisterService(desc *ServiceDesc, impl interface{}) {
+	ht := reflect.TypeOf(desc.HandlerType).Elem()
+	if st := reflect.TypeOf(impl); st == nil || !st.Implements(ht) {
+		panic(fmt.Sprintf("rpc: RegisterService found the handler of type %T "+
+			"that does not satisfy %v", impl, ht))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.services[desc.ServiceName]; ok {
+		panic("rpc: duplicate service registration for " + desc.ServiceName)
+	}
+	srv := &service{
+		impl:     impl,
+		handlers: make(map[string]Handler, len(desc.Methods)),
+	}
+	for _, m := range desc.Methods {
+		srv.handlers[m.MethodName] = m.Handler
+	}
+	s.services[desc.ServiceName] = srv
+}
+
+// Call implements Transport by dispatching the request to the handler
+// registered for method.
+func (s *Server) Call(method string, request []byte) ([]byte, error) {
+	// Method names have the form "/package.Service/Method".
+	i := strings.LastIndex(method, "/")
+	if i < 1 || method[0] != '/' {
+		return nil, errors.New("rpc: unknown method " + method)
+	}
+	serviceName, methodName := method[1:i], method[i+1:]
+	s.mu.RLock()
+	srv, ok := s.services[serviceName]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, errors.New("rpc: unknown service " + serviceName)
+	}
+	handler, ok := srv.handlers[methodName]
+	if !ok {
+		return nil, errors.New("rpc: unknown method " + methodName + " for service " + serviceName)
+	}
+	return handler(srv.impl, request)
+}
@@ -0,0 +1,4740 @@
+// Code generated by protoc-gen-dgo.
+// source: casttype.proto
+// DO NOT EDIT!
+
+/*
+Package casttype is a generated protocol buffer package.
+
+It is generated from these files:
+
+	casttype.proto
+
+It has these top-level messages:
+
+	Castaway
+	Castchoice
+	Castmap
+*/
+package casttype
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+import github_com_dropbox_goprotoc_test "github.com/dropbox/goprotoc/test"
+
+import bytes1 "bytes"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Castaway struct {
+	xxx_sizeCached     int
+	user               UserID
+	other              github_com_dropbox_goprotoc_test.Id
+	weight             github_com_dropbox_goprotoc_test.Weight
+	truth              github_com_dropbox_goprotoc_test.Truth
+	name               Name
+	data               Bytes
+	flags              Flags
+	delta              Delta
+	stamp              Stamp
+	ratio              Ratio
+	users              []UserID
+	packedUsers        []UserID
+	names              []Name
+	deltas             []Delta64
+	XXX_unrecognized   []byte
+	xxx_IsUserSet      bool
+	xxx_IsOtherSet     bool
+	xxx_IsWeightSet    bool
+	xxx_IsTruthSet     bool
+	xxx_IsNameSet      bool
+	xxx_IsDataSet      bool
+	xxx_IsFlagsSet     bool
+	xxx_IsDeltaSet     bool
+	xxx_IsStampSet     bool
+	xxx_IsRatioSet     bool
+	xxx_LenUsers       int
+	xxx_LenPackedUsers int
+	xxx_LenNames       int
+	xxx_LenDeltas      int
+}
+
+func (m *Castaway) Reset()         { *m = Castaway{} }
+func (m *Castaway) String() string { return proto.CompactTextString(m) }
+func (*Castaway) ProtoMessage()    {}
+
+const Default_Castaway_Other github_com_dropbox_goprotoc_test.Id = 7
+
+func (m *Castaway) GetUser() UserID {
+	if m != nil && m.xxx_IsUserSet {
+		return m.user
+	}
+	return 0
+}
+
+func (m *Castaway) GetOther() github_com_dropbox_goprotoc_test.Id {
+	if m != nil && m.xxx_IsOtherSet {
+		return m.other
+	}
+	return Default_Castaway_Other
+}
+
+func (m *Castaway) GetWeight() github_com_dropbox_goprotoc_test.Weight {
+	if m != nil && m.xxx_IsWeightSet {
+		return m.weight
+	}
+	return 0
+}
+
+func (m *Castaway) GetTruth() github_com_dropbox_goprotoc_test.Truth {
+	if m != nil && m.xxx_IsTruthSet {
+		return m.truth
+	}
+	return false
+}
+
+func (m *Castaway) GetName() Name {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Castaway) GetData() Bytes {
+	if m != nil && m.xxx_IsDataSet {
+		return m.data
+	}
+	return nil
+}
+func (m *Castaway) GetFlags() Flags {
+	if m != nil && m.xxx_IsFlagsSet {
+		return m.flags
+	}
+	return 0
+}
+
+func (m *Castaway) GetDelta() Delta {
+	if m != nil && m.xxx_IsDeltaSet {
+		return m.delta
+	}
+	return 0
+}
+
+func (m *Castaway) GetStamp() Stamp {
+	if m != nil && m.xxx_IsStampSet {
+		return m.stamp
+	}
+	return 0
+}
+
+func (m *Castaway) GetRatio() Ratio {
+	if m != nil && m.xxx_IsRatioSet {
+		return m.ratio
+	}
+	return 0
+}
+
+func (m *Castaway) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Castaway) SetUser(value UserID) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsUserSet = true
+	m.user = value
+	return nil
+}
+
+func (m *Castaway) HasUser() (isSet bool) {
+	if m != nil && m.xxx_IsUserSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearUser() {
+	if m != nil {
+		m.xxx_IsUserSet = false
+	}
+}
+
+func (m *Castaway) SetOther(value github_com_dropbox_goprotoc_test.Id) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsOtherSet = true
+	m.other = value
+	return nil
+}
+
+func (m *Castaway) HasOther() (isSet bool) {
+	if m != nil && m.xxx_IsOtherSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearOther() {
+	if m != nil {
+		m.xxx_IsOtherSet = false
+	}
+}
+
+func (m *Castaway) SetWeight(value github_com_dropbox_goprotoc_test.Weight) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsWeightSet = true
+	m.weight = value
+	return nil
+}
+
+func (m *Castaway) HasWeight() (isSet bool) {
+	if m != nil && m.xxx_IsWeightSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearWeight() {
+	if m != nil {
+		m.xxx_IsWeightSet = false
+	}
+}
+
+func (m *Castaway) SetTruth(value github_com_dropbox_goprotoc_test.Truth) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsTruthSet = true
+	m.truth = value
+	return nil
+}
+
+func (m *Castaway) HasTruth() (isSet bool) {
+	if m != nil && m.xxx_IsTruthSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearTruth() {
+	if m != nil {
+		m.xxx_IsTruthSet = false
+	}
+}
+
+func (m *Castaway) SetName(value Name) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Castaway) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+	}
+}
+
+func (m *Castaway) SetData(value Bytes) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsDataSet = true
+	m.data = value
+	return nil
+}
+
+func (m *Castaway) HasData() (isSet bool) {
+	if m != nil && m.xxx_IsDataSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearData() {
+	if m != nil {
+		m.xxx_IsDataSet = false
+	}
+}
+
+func (m *Castaway) SetFlags(value Flags) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFlagsSet = true
+	m.flags = value
+	return nil
+}
+
+func (m *Castaway) HasFlags() (isSet bool) {
+	if m != nil && m.xxx_IsFlagsSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearFlags() {
+	if m != nil {
+		m.xxx_IsFlagsSet = false
+	}
+}
+
+func (m *Castaway) SetDelta(value Delta) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsDeltaSet = true
+	m.delta = value
+	return nil
+}
+
+func (m *Castaway) HasDelta() (isSet bool) {
+	if m != nil && m.xxx_IsDeltaSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearDelta() {
+	if m != nil {
+		m.xxx_IsDeltaSet = false
+	}
+}
+
+func (m *Castaway) SetStamp(value Stamp) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsStampSet = true
+	m.stamp = value
+	return nil
+}
+
+func (m *Castaway) HasStamp() (isSet bool) {
+	if m != nil && m.xxx_IsStampSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearStamp() {
+	if m != nil {
+		m.xxx_IsStampSet = false
+	}
+}
+
+func (m *Castaway) SetRatio(value Ratio) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsRatioSet = true
+	m.ratio = value
+	return nil
+}
+
+func (m *Castaway) HasRatio() (isSet bool) {
+	if m != nil && m.xxx_IsRatioSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castaway) ClearRatio() {
+	if m != nil {
+		m.xxx_IsRatioSet = false
+	}
+}
+
+func (m *Castaway) AddUsers(value UserID) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.users) <= m.xxx_LenUsers {
+		newCapacity := 0
+		if len(m.users) == 0 {
+			newCapacity = 8
+		} else if len(m.users) < 1000000 {
+			newCapacity = m.xxx_LenUsers * 2
+		} else {
+			newCapacity = m.xxx_LenUsers + 1000000
+		}
+		t := make([]UserID, newCapacity, newCapacity)
+		copy(t, m.users)
+		m.users = t
+	}
+	m.users[m.xxx_LenUsers] = value
+	m.xxx_LenUsers += 1
+	return nil
+}
+
+func (m *Castaway) SetUsers(value UserID, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenUsers {
+		return errors.New("Index is out of bounds")
+	}
+	m.users[index] = value
+	return nil
+}
+
+func (m *Castaway) UsersSize() (size int) {
+	if m != nil {
+		return m.xxx_LenUsers
+	}
+	return 0
+}
+
+func (m *Castaway) ClearUsers() {
+	if m != nil {
+		m.xxx_LenUsers = 0
+	}
+}
+
+func (m *Castaway) GetUsers(index int) (field UserID, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenUsers {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.users[index], nil
+}
+
+func (m *Castaway) AddPackedUsers(value UserID) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.packedUsers) <= m.xxx_LenPackedUsers {
+		newCapacity := 0
+		if len(m.packedUsers) == 0 {
+			newCapacity = 8
+		} else if len(m.packedUsers) < 1000000 {
+			newCapacity = m.xxx_LenPackedUsers * 2
+		} else {
+			newCapacity = m.xxx_LenPackedUsers + 1000000
+		}
+		t := make([]UserID, newCapacity, newCapacity)
+		copy(t, m.packedUsers)
+		m.packedUsers = t
+	}
+	m.packedUsers[m.xxx_LenPackedUsers] = value
+	m.xxx_LenPackedUsers += 1
+	return nil
+}
+
+func (m *Castaway) SetPackedUsers(value UserID, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPackedUsers {
+		return errors.New("Index is out of bounds")
+	}
+	m.packedUsers[index] = value
+	return nil
+}
+
+func (m *Castaway) PackedUsersSize() (size int) {
+	if m != nil {
+		return m.xxx_LenPackedUsers
+	}
+	return 0
+}
+
+func (m *Castaway) ClearPackedUsers() {
+	if m != nil {
+		m.xxx_LenPackedUsers = 0
+	}
+}
+
+func (m *Castaway) GetPackedUsers(index int) (field UserID, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPackedUsers {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.packedUsers[index], nil
+}
+
+func (m *Castaway) AddNames(value Name) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.names) <= m.xxx_LenNames {
+		newCapacity := 0
+		if len(m.names) == 0 {
+			newCapacity = 8
+		} else if len(m.names) < 1000000 {
+			newCapacity = m.xxx_LenNames * 2
+		} else {
+			newCapacity = m.xxx_LenNames + 1000000
+		}
+		t := make([]Name, newCapacity, newCapacity)
+		copy(t, m.names)
+		m.names = t
+	}
+	m.names[m.xxx_LenNames] = value
+	m.xxx_LenNames += 1
+	return nil
+}
+
+func (m *Castaway) SetNames(value Name, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenNames {
+		return errors.New("Index is out of bounds")
+	}
+	m.names[index] = value
+	return nil
+}
+
+func (m *Castaway) NamesSize() (size int) {
+	if m != nil {
+		return m.xxx_LenNames
+	}
+	return 0
+}
+
+func (m *Castaway) ClearNames() {
+	if m != nil {
+		m.xxx_LenNames = 0
+	}
+}
+
+func (m *Castaway) GetNames(index int) (field Name, err error) {
+	if m == nil {
+		return "", errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenNames {
+		return "", errors.New("Index is out of bounds")
+	}
+	return m.names[index], nil
+}
+
+func (m *Castaway) AddDeltas(value Delta64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.deltas) <= m.xxx_LenDeltas {
+		newCapacity := 0
+		if len(m.deltas) == 0 {
+			newCapacity = 8
+		} else if len(m.deltas) < 1000000 {
+			newCapacity = m.xxx_LenDeltas * 2
+		} else {
+			newCapacity = m.xxx_LenDeltas + 1000000
+		}
+		t := make([]Delta64, newCapacity, newCapacity)
+		copy(t, m.deltas)
+		m.deltas = t
+	}
+	m.deltas[m.xxx_LenDeltas] = value
+	m.xxx_LenDeltas += 1
+	return nil
+}
+
+func (m *Castaway) SetDeltas(value Delta64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenDeltas {
+		return errors.New("Index is out of bounds")
+	}
+	m.deltas[index] = value
+	return nil
+}
+
+func (m *Castaway) DeltasSize() (size int) {
+	if m != nil {
+		return m.xxx_LenDeltas
+	}
+	return 0
+}
+
+func (m *Castaway) ClearDeltas() {
+	if m != nil {
+		m.xxx_LenDeltas = 0
+	}
+}
+
+func (m *Castaway) GetDeltas(index int) (field Delta64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenDeltas {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.deltas[index], nil
+}
+
+func (m *Castaway) Clear() {
+	if m != nil {
+		m.ClearUser()
+		m.ClearOther()
+		m.ClearWeight()
+		m.ClearTruth()
+		m.ClearName()
+		m.ClearData()
+		m.ClearFlags()
+		m.ClearDelta()
+		m.ClearStamp()
+		m.ClearRatio()
+		m.ClearUsers()
+		m.ClearPackedUsers()
+		m.ClearNames()
+		m.ClearDeltas()
+	}
+}
+
+type Castchoice struct {
+	xxx_sizeCached      int
+	chosenUser          UserID
+	chosenName          Name
+	XXX_unrecognized    []byte
+	xxx_IsChosenUserSet bool
+	xxx_IsChosenNameSet bool
+	xxx_ChoiceCase      Castchoice_ChoiceCase
+}
+
+func (m *Castchoice) Reset()         { *m = Castchoice{} }
+func (m *Castchoice) String() string { return proto.CompactTextString(m) }
+func (*Castchoice) ProtoMessage()    {}
+
+func (m *Castchoice) GetChosenUser() UserID {
+	if m != nil && m.xxx_IsChosenUserSet {
+		return m.chosenUser
+	}
+	return 0
+}
+
+func (m *Castchoice) GetChosenName() Name {
+	if m != nil && m.xxx_IsChosenNameSet {
+		return m.chosenName
+	}
+	return ""
+}
+
+func (m *Castchoice) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Castchoice_ChoiceCase int32
+
+const (
+	Castchoice_ChoiceCase_NotSet     Castchoice_ChoiceCase = 0
+	Castchoice_ChoiceCase_ChosenUser Castchoice_ChoiceCase = 1
+	Castchoice_ChoiceCase_ChosenName Castchoice_ChoiceCase = 2
+)
+
+func (m *Castchoice) WhichChoice() Castchoice_ChoiceCase {
+	if m != nil {
+		return m.xxx_ChoiceCase
+	}
+	return Castchoice_ChoiceCase_NotSet
+}
+
+func (m *Castchoice) ClearChoice() {
+	if m != nil {
+		switch m.xxx_ChoiceCase {
+		case Castchoice_ChoiceCase_ChosenUser:
+			m.ClearChosenUser()
+		case Castchoice_ChoiceCase_ChosenName:
+			m.ClearChosenName()
+		}
+		m.xxx_ChoiceCase = Castchoice_ChoiceCase_NotSet
+	}
+}
+
+func (m *Castchoice) SetChosenUser(value UserID) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ChoiceCase != Castchoice_ChoiceCase_ChosenUser {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Castchoice_ChoiceCase_ChosenUser
+	}
+	m.xxx_IsChosenUserSet = true
+	m.chosenUser = value
+	return nil
+}
+
+func (m *Castchoice) HasChosenUser() (isSet bool) {
+	if m != nil && m.xxx_IsChosenUserSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castchoice) ClearChosenUser() {
+	if m != nil {
+		m.xxx_IsChosenUserSet = false
+		if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser {
+			m.xxx_ChoiceCase = Castchoice_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Castchoice) SetChosenName(value Name) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ChoiceCase != Castchoice_ChoiceCase_ChosenName {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Castchoice_ChoiceCase_ChosenName
+	}
+	m.xxx_IsChosenNameSet = true
+	m.chosenName = value
+	return nil
+}
+
+func (m *Castchoice) HasChosenName() (isSet bool) {
+	if m != nil && m.xxx_IsChosenNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castchoice) ClearChosenName() {
+	if m != nil {
+		m.xxx_IsChosenNameSet = false
+		if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName {
+			m.xxx_ChoiceCase = Castchoice_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Castchoice) Clear() {
+	if m != nil {
+		m.ClearChosenUser()
+		m.ClearChosenName()
+		m.xxx_ChoiceCase = Castchoice_ChoiceCase_NotSet
+	}
+}
+
+type Castmap struct {
+	xxx_sizeCached   int
+	userNames        map[UserID]string
+	named            map[Name]*Castaway
+	XXX_unrecognized []byte
+}
+
+func (m *Castmap) Reset()         { *m = Castmap{} }
+func (m *Castmap) String() string { return proto.CompactTextString(m) }
+func (*Castmap) ProtoMessage()    {}
+
+func (m *Castmap) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Castmap) GetUserNames(key UserID) (value string, ok bool) {
+	if m != nil {
+		value, ok = m.userNames[key]
+	}
+	return value, ok
+}
+
+func (m *Castmap) PutUserNames(key UserID, value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.userNames == nil {
+		m.userNames = make(map[UserID]string)
+	}
+	m.userNames[key] = value
+	return nil
+}
+
+func (m *Castmap) DeleteUserNames(key UserID) {
+	if m != nil {
+		delete(m.userNames, key)
+	}
+}
+
+func (m *Castmap) UserNamesLen() (size int) {
+	if m != nil {
+		return len(m.userNames)
+	}
+	return 0
+}
+
+func (m *Castmap) RangeUserNames(f func(key UserID, value string) bool) {
+	if m != nil {
+		for k, v := range m.userNames {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Castmap) ClearUserNames() {
+	if m != nil {
+		m.userNames = nil
+	}
+}
+
+func (m *Castmap) GetNamed(key Name) (value *Castaway, ok bool) {
+	if m != nil {
+		value, ok = m.named[key]
+	}
+	return value, ok
+}
+
+func (m *Castmap) PutNamed(key Name, value *Castaway) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.named == nil {
+		m.named = make(map[Name]*Castaway)
+	}
+	m.named[key] = value
+	return nil
+}
+
+func (m *Castmap) DeleteNamed(key Name) {
+	if m != nil {
+		delete(m.named, key)
+	}
+}
+
+func (m *Castmap) NamedLen() (size int) {
+	if m != nil {
+		return len(m.named)
+	}
+	return 0
+}
+
+func (m *Castmap) RangeNamed(f func(key Name, value *Castaway) bool) {
+	if m != nil {
+		for k, v := range m.named {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Castmap) ClearNamed() {
+	if m != nil {
+		m.named = nil
+	}
+}
+
+func (m *Castmap) Clear() {
+	if m != nil {
+		m.ClearUserNames()
+		m.ClearNamed()
+	}
+}
+
+type Castmap_UserNamesEntry struct {
+	xxx_sizeCached   int
+	key              UserID
+	value            string
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Castmap_UserNamesEntry) Reset()         { *m = Castmap_UserNamesEntry{} }
+func (m *Castmap_UserNamesEntry) String() string { return proto.CompactTextString(m) }
+func (*Castmap_UserNamesEntry) ProtoMessage()    {}
+
+func (m *Castmap_UserNamesEntry) GetKey() UserID {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return 0
+}
+
+func (m *Castmap_UserNamesEntry) GetValue() string {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return ""
+}
+
+func (m *Castmap_UserNamesEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Castmap_UserNamesEntry) SetKey(value UserID) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Castmap_UserNamesEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Castmap_UserNamesEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Castmap_UserNamesEntry) SetValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Castmap_UserNamesEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castmap_UserNamesEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+		m.value = ""
+	}
+}
+
+func (m *Castmap_UserNamesEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+type Castmap_NamedEntry struct {
+	xxx_sizeCached   int
+	key              Name
+	value            *Castaway
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Castmap_NamedEntry) Reset()         { *m = Castmap_NamedEntry{} }
+func (m *Castmap_NamedEntry) String() string { return proto.CompactTextString(m) }
+func (*Castmap_NamedEntry) ProtoMessage()    {}
+
+func (m *Castmap_NamedEntry) GetKey() Name {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Castmap_NamedEntry) GetValue() *Castaway {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Castmap_NamedEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Castmap_NamedEntry) SetKey(value Name) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Castmap_NamedEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Castmap_NamedEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Castmap_NamedEntry) MutateValue() (field *Castaway, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Castaway)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Castmap_NamedEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Castmap_NamedEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Castmap_NamedEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Castaway) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsUserSet {
+		n += 1 + sovCasttype(uint64(m.user))
+	}
+	if m.xxx_IsOtherSet {
+		n += 1 + sovCasttype(uint64(m.other))
+	}
+	if m.xxx_IsWeightSet {
+		n += 9
+	}
+	if m.xxx_IsTruthSet {
+		n += 2
+	}
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovCasttype(uint64(l))
+	}
+	if m.xxx_IsDataSet {
+		l = len(m.data)
+		n += 1 + l + sovCasttype(uint64(l))
+	}
+	if m.xxx_IsFlagsSet {
+		n += 1 + sovCasttype(uint64(m.flags))
+	}
+	if m.xxx_IsDeltaSet {
+		n += 1 + sozCasttype(uint64(m.delta))
+	}
+	if m.xxx_IsStampSet {
+		n += 9
+	}
+	if m.xxx_IsRatioSet {
+		n += 5
+	}
+	if m.xxx_LenUsers > 0 {
+		for i := 0; i < m.xxx_LenUsers; i++ {
+			e := m.users[i]
+			n += 1 + sovCasttype(uint64(e))
+		}
+	}
+	if m.xxx_LenPackedUsers > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenPackedUsers; i++ {
+			e := m.packedUsers[i]
+			l += sovCasttype(uint64(e))
+		}
+		n += 1 + sovCasttype(uint64(l)) + l
+	}
+	if m.xxx_LenNames > 0 {
+		for i := 0; i < m.xxx_LenNames; i++ {
+			s := m.names[i]
+			l = len(s)
+			n += 1 + l + sovCasttype(uint64(l))
+		}
+	}
+	if m.xxx_LenDeltas > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenDeltas; i++ {
+			e := m.deltas[i]
+			l += sozCasttype(uint64(e))
+		}
+		n += 1 + sovCasttype(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Castchoice) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser {
+		n += 1 + sovCasttype(uint64(m.chosenUser))
+	}
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName {
+		l = len(m.chosenName)
+		n += 1 + l + sovCasttype(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Castmap) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.userNames) > 0 {
+		for k, v := range m.userNames {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovCasttype(uint64(k)) + 1 + len(v) + sovCasttype(uint64(len(v)))
+			n += 1 + mapEntrySize + sovCasttype(uint64(mapEntrySize))
+		}
+	}
+	if len(m.named) > 0 {
+		for k, v := range m.named {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovCasttype(uint64(len(k))) + 1 + l + sovCasttype(uint64(l))
+			n += 1 + mapEntrySize + sovCasttype(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Castmap_UserNamesEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		n += 1 + sovCasttype(uint64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		l = len(m.value)
+		n += 1 + l + sovCasttype(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Castmap_NamedEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovCasttype(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovCasttype(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovCasttype(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCasttype(x uint64) (n int) {
+	return sovCasttype(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Castaway) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Castaway) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Castaway) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsUserSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintCasttype(data, i, uint64(m.user))
+	}
+	if m.xxx_IsOtherSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintCasttype(data, i, uint64(m.other))
+	}
+	if m.xxx_IsWeightSet {
+		data[i] = 0x19
+		i++
+		i = encodeFixed64Casttype(data, i, uint64(math.Float64bits(float64(m.weight))))
+	}
+	if m.xxx_IsTruthSet {
+		data[i] = 0x20
+		i++
+		if m.truth {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.xxx_IsNameSet {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintCasttype(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsDataSet {
+		data[i] = 0x32
+		i++
+		i = encodeVarintCasttype(data, i, uint64(len(m.data)))
+		i += copy(data[i:], m.data)
+	}
+	if m.xxx_IsFlagsSet {
+		data[i] = 0x38
+		i++
+		i = encodeVarintCasttype(data, i, uint64(m.flags))
+	}
+	if m.xxx_IsDeltaSet {
+		data[i] = 0x40
+		i++
+		i = encodeVarintCasttype(data, i, uint64((uint32(m.delta)<<1)^uint32((m.delta>>31))))
+	}
+	if m.xxx_IsStampSet {
+		data[i] = 0x49
+		i++
+		i = encodeFixed64Casttype(data, i, uint64(m.stamp))
+	}
+	if m.xxx_IsRatioSet {
+		data[i] = 0x55
+		i++
+		i = encodeFixed32Casttype(data, i, uint32(math.Float32bits(float32(m.ratio))))
+	}
+	if m.xxx_LenUsers > 0 {
+		for idx := 0; idx < m.xxx_LenUsers; idx++ {
+			num := m.users[idx]
+			data[i] = 0x58
+			i++
+			i = encodeVarintCasttype(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenPackedUsers > 0 {
+		data2 := make([]byte, m.xxx_LenPackedUsers*10)
+		var j1 int
+		for idx := 0; idx < m.xxx_LenPackedUsers; idx++ {
+			num := uint64(m.packedUsers[idx])
+			for num >= 1<<7 {
+				data2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			data2[j1] = uint8(num)
+			j1++
+		}
+		data[i] = 0x62
+		i++
+		i = encodeVarintCasttype(data, i, uint64(j1))
+		i += copy(data[i:], data2[:j1])
+	}
+	if m.xxx_LenNames > 0 {
+		for idx := 0; idx < m.xxx_LenNames; idx++ {
+			s := m.names[idx]
+			data[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.xxx_LenDeltas > 0 {
+		var j3 int
+		data5 := make([]byte, m.xxx_LenDeltas*10)
+		for idx := 0; idx < m.xxx_LenDeltas; idx++ {
+			num := m.deltas[idx]
+			x4 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x4 >= 1<<7 {
+				data5[j3] = uint8(uint64(x4)&0x7f | 0x80)
+				j3++
+				x4 >>= 7
+			}
+			data5[j3] = uint8(x4)
+			j3++
+		}
+		data[i] = 0x72
+		i++
+		i = encodeVarintCasttype(data, i, uint64(j3))
+		i += copy(data[i:], data5[:j3])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Castchoice) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Castchoice) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Castchoice) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser {
+		data[i] = 0x8
+		i++
+		i = encodeVarintCasttype(data, i, uint64(m.chosenUser))
+	}
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName {
+		data[i] = 0x12
+		i++
+		i = encodeVarintCasttype(data, i, uint64(len(m.chosenName)))
+		i += copy(data[i:], m.chosenName)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Castmap) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Castmap) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Castmap) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.userNames) > 0 {
+		keys := make([]UserID, 0, len(m.userNames))
+		for k := range m.userNames {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.userNames[k]
+			data[i] = 0xa
+			i++
+			mapEntrySize := 1 + sovCasttype(uint64(k)) + 1 + len(v) + sovCasttype(uint64(len(v)))
+			i = encodeVarintCasttype(data, i, uint64(mapEntrySize))
+			data[i] = 0x8
+			i++
+			i = encodeVarintCasttype(data, i, uint64(k))
+			data[i] = 0x12
+			i++
+			i = encodeVarintCasttype(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if len(m.named) > 0 {
+		keys := make([]Name, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			data[i] = 0x12
+			i++
+			mapEntrySize := 1 + len(k) + sovCasttype(uint64(len(k))) + 1 + v.SizeCached() + sovCasttype(uint64(v.SizeCached()))
+			i = encodeVarintCasttype(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintCasttype(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintCasttype(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Castmap_UserNamesEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Castmap_UserNamesEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Castmap_UserNamesEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintCasttype(data, i, uint64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintCasttype(data, i, uint64(len(m.value)))
+		i += copy(data[i:], m.value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Castmap_NamedEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Castmap_NamedEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Castmap_NamedEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintCasttype(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintCasttype(data, i, uint64(m.value.SizeCached()))
+		n6, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Casttype(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Casttype(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintCasttype(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Castaway) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field user", wireType)
+			}
+			m.xxx_IsUserSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.user |= (UserID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field other", wireType)
+			}
+			m.xxx_IsOtherSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.other |= (github_com_dropbox_goprotoc_test.Id(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field weight", wireType)
+			}
+			m.xxx_IsWeightSet = true
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			m.weight = github_com_dropbox_goprotoc_test.Weight(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field truth", wireType)
+			}
+			m.xxx_IsTruthSet = true
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.truth = github_com_dropbox_goprotoc_test.Truth(bool(v != 0))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = Name(data[index:postIndex])
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field data", wireType)
+			}
+			m.xxx_IsDataSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.data = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field flags", wireType)
+			}
+			m.xxx_IsFlagsSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.flags |= (Flags(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field delta", wireType)
+			}
+			m.xxx_IsDeltaSet = true
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.delta = Delta(v)
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field stamp", wireType)
+			}
+			m.xxx_IsStampSet = true
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			m.stamp = Stamp(data[i-8])
+			m.stamp |= Stamp(data[i-7]) << 8
+			m.stamp |= Stamp(data[i-6]) << 16
+			m.stamp |= Stamp(data[i-5]) << 24
+			m.stamp |= Stamp(data[i-4]) << 32
+			m.stamp |= Stamp(data[i-3]) << 40
+			m.stamp |= Stamp(data[i-2]) << 48
+			m.stamp |= Stamp(data[i-1]) << 56
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ratio", wireType)
+			}
+			m.xxx_IsRatioSet = true
+			var v uint32
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint32(data[i-4])
+			v |= uint32(data[i-3]) << 8
+			v |= uint32(data[i-2]) << 16
+			v |= uint32(data[i-1]) << 24
+			m.ratio = Ratio(math.Float32frombits(v))
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.users) <= m.xxx_LenUsers {
+						newCapacity := 0
+						if len(m.users) == 0 {
+							newCapacity = 8
+						} else if len(m.users) < 1000000 {
+							newCapacity = m.xxx_LenUsers * 2
+						} else {
+							newCapacity = m.xxx_LenUsers + 1000000
+						}
+						t := make([]UserID, newCapacity, newCapacity)
+						copy(t, m.users)
+						m.users = t
+					}
+					m.users[m.xxx_LenUsers] = UserID(v)
+					m.xxx_LenUsers += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.users) <= m.xxx_LenUsers {
+					newCapacity := 0
+					if len(m.users) == 0 {
+						newCapacity = 8
+					} else if len(m.users) < 1000000 {
+						newCapacity = m.xxx_LenUsers * 2
+					} else {
+						newCapacity = m.xxx_LenUsers + 1000000
+					}
+					t := make([]UserID, newCapacity, newCapacity)
+					copy(t, m.users)
+					m.users = t
+				}
+				m.users[m.xxx_LenUsers] = UserID(v)
+				m.xxx_LenUsers += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field users", wireType)
+			}
+		case 12:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.packedUsers) <= m.xxx_LenPackedUsers {
+						newCapacity := 0
+						if len(m.packedUsers) == 0 {
+							newCapacity = 8
+						} else if len(m.packedUsers) < 1000000 {
+							newCapacity = m.xxx_LenPackedUsers * 2
+						} else {
+							newCapacity = m.xxx_LenPackedUsers + 1000000
+						}
+						t := make([]UserID, newCapacity, newCapacity)
+						copy(t, m.packedUsers)
+						m.packedUsers = t
+					}
+					m.packedUsers[m.xxx_LenPackedUsers] = UserID(v)
+					m.xxx_LenPackedUsers += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.packedUsers) <= m.xxx_LenPackedUsers {
+					newCapacity := 0
+					if len(m.packedUsers) == 0 {
+						newCapacity = 8
+					} else if len(m.packedUsers) < 1000000 {
+						newCapacity = m.xxx_LenPackedUsers * 2
+					} else {
+						newCapacity = m.xxx_LenPackedUsers + 1000000
+					}
+					t := make([]UserID, newCapacity, newCapacity)
+					copy(t, m.packedUsers)
+					m.packedUsers = t
+				}
+				m.packedUsers[m.xxx_LenPackedUsers] = UserID(v)
+				m.xxx_LenPackedUsers += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field packedUsers", wireType)
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.names) <= m.xxx_LenNames {
+				newCapacity := 0
+				if len(m.names) == 0 {
+					newCapacity = 8
+				} else if len(m.names) < 1000000 {
+					newCapacity = m.xxx_LenNames * 2
+				} else {
+					newCapacity = m.xxx_LenNames + 1000000
+				}
+				t := make([]Name, newCapacity, newCapacity)
+				copy(t, m.names)
+				m.names = t
+			}
+			m.names[m.xxx_LenNames] = Name(data[index:postIndex])
+			m.xxx_LenNames += 1
+			index = postIndex
+		case 14:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					if len(m.deltas) <= m.xxx_LenDeltas {
+						newCapacity := 0
+						if len(m.deltas) == 0 {
+							newCapacity = 8
+						} else if len(m.deltas) < 1000000 {
+							newCapacity = m.xxx_LenDeltas * 2
+						} else {
+							newCapacity = m.xxx_LenDeltas + 1000000
+						}
+						t := make([]Delta64, newCapacity, newCapacity)
+						copy(t, m.deltas)
+						m.deltas = t
+					}
+					m.deltas[m.xxx_LenDeltas] = Delta64(int64(v))
+					m.xxx_LenDeltas += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				if len(m.deltas) <= m.xxx_LenDeltas {
+					newCapacity := 0
+					if len(m.deltas) == 0 {
+						newCapacity = 8
+					} else if len(m.deltas) < 1000000 {
+						newCapacity = m.xxx_LenDeltas * 2
+					} else {
+						newCapacity = m.xxx_LenDeltas + 1000000
+					}
+					t := make([]Delta64, newCapacity, newCapacity)
+					copy(t, m.deltas)
+					m.deltas = t
+				}
+				m.deltas[m.xxx_LenDeltas] = Delta64(int64(v))
+				m.xxx_LenDeltas += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field deltas", wireType)
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Castchoice) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field chosenUser", wireType)
+			}
+			if m.xxx_ChoiceCase != Castchoice_ChoiceCase_ChosenUser {
+				m.ClearChoice()
+				m.xxx_ChoiceCase = Castchoice_ChoiceCase_ChosenUser
+			}
+			m.xxx_IsChosenUserSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.chosenUser |= (UserID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field chosenName", wireType)
+			}
+			if m.xxx_ChoiceCase != Castchoice_ChoiceCase_ChosenName {
+				m.ClearChoice()
+				m.xxx_ChoiceCase = Castchoice_ChoiceCase_ChosenName
+			}
+			m.xxx_IsChosenNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.chosenName = Name(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Castmap) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field userNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Castmap_UserNamesEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.userNames == nil {
+				m.userNames = make(map[UserID]string)
+			}
+			m.userNames[entry.key] = entry.value
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field named", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Castmap_NamedEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.named == nil {
+				m.named = make(map[Name]*Castaway)
+			}
+			if entry.value == nil {
+				entry.value = new(Castaway)
+			}
+			m.named[entry.key] = entry.value
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Castmap_UserNamesEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.key |= (UserID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.value = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Castmap_NamedEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = Name(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Castaway) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Castaway) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Castchoice) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Castchoice) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Castmap) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Castmap) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Castmap_UserNamesEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Castmap_UserNamesEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Castmap_NamedEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Castmap_NamedEntry) CheckInitialized() error {
+	return nil
+}
+
+func (m *Castaway) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsUserSet || w.EmitDefaults() {
+		w.Field("user", "user")
+		w.Int64(int64(m.GetUser()))
+	}
+	if m.xxx_IsOtherSet || w.EmitDefaults() {
+		w.Field("other", "other")
+		w.Int64(int64(m.GetOther()))
+	}
+	if m.xxx_IsWeightSet || w.EmitDefaults() {
+		w.Field("weight", "weight")
+		w.Float64(float64(m.GetWeight()))
+	}
+	if m.xxx_IsTruthSet || w.EmitDefaults() {
+		w.Field("truth", "truth")
+		w.Bool(bool(m.GetTruth()))
+	}
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(string(m.GetName()))
+	}
+	if m.xxx_IsDataSet || w.EmitDefaults() {
+		w.Field("data", "data")
+		w.Base64([]byte(m.GetData()))
+	}
+	if m.xxx_IsFlagsSet || w.EmitDefaults() {
+		w.Field("flags", "flags")
+		w.Uint32(uint32(m.GetFlags()))
+	}
+	if m.xxx_IsDeltaSet || w.EmitDefaults() {
+		w.Field("delta", "delta")
+		w.Int32(int32(m.GetDelta()))
+	}
+	if m.xxx_IsStampSet || w.EmitDefaults() {
+		w.Field("stamp", "stamp")
+		w.Uint64(uint64(m.GetStamp()))
+	}
+	if m.xxx_IsRatioSet || w.EmitDefaults() {
+		w.Field("ratio", "ratio")
+		w.Float32(float32(m.GetRatio()))
+	}
+	if m.xxx_LenUsers > 0 || w.EmitDefaults() {
+		w.Field("users", "users")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenUsers; i++ {
+			w.Int64(int64(m.users[i]))
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenPackedUsers > 0 || w.EmitDefaults() {
+		w.Field("packedUsers", "packed_users")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenPackedUsers; i++ {
+			w.Int64(int64(m.packedUsers[i]))
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenNames > 0 || w.EmitDefaults() {
+		w.Field("names", "names")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenNames; i++ {
+			w.String(string(m.names[i]))
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenDeltas > 0 || w.EmitDefaults() {
+		w.Field("deltas", "deltas")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenDeltas; i++ {
+			w.Int64(int64(m.deltas[i]))
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Castaway) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("user", "user"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetUser(UserID(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("other", "other"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetOther(github_com_dropbox_goprotoc_test.Id(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("weight", "weight"); ok {
+		v, err := jsonpb.Float64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetWeight(github_com_dropbox_goprotoc_test.Weight(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("truth", "truth"); ok {
+		v, err := jsonpb.Bool(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetTruth(github_com_dropbox_goprotoc_test.Truth(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(Name(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("data", "data"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetData(Bytes(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("flags", "flags"); ok {
+		v, err := jsonpb.Uint32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFlags(Flags(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("delta", "delta"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetDelta(Delta(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("stamp", "stamp"); ok {
+		v, err := jsonpb.Uint64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetStamp(Stamp(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("ratio", "ratio"); ok {
+		v, err := jsonpb.Float32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetRatio(Ratio(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("users", "users"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddUsers(UserID(v)); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("packedUsers", "packed_users"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddPackedUsers(UserID(v)); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("names", "names"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddNames(Name(v)); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("deltas", "deltas"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddDeltas(Delta64(v)); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Castaway) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Castaway) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Castchoice) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser {
+		w.Field("chosenUser", "chosen_user")
+		w.Int64(int64(m.GetChosenUser()))
+	}
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName {
+		w.Field("chosenName", "chosen_name")
+		w.String(string(m.GetChosenName()))
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Castchoice) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("chosenUser", "chosen_user"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetChosenUser(UserID(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("chosenName", "chosen_name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetChosenName(Name(v)); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Castchoice) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Castchoice) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Castmap) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if len(m.userNames) > 0 || w.EmitDefaults() {
+		w.Field("userNames", "user_names")
+		w.BeginObject()
+		keys := make([]UserID, 0, len(m.userNames))
+		for k := range m.userNames {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.IntKey(int64(k))
+			w.String(m.userNames[k])
+		}
+		w.EndObject()
+	}
+	if len(m.named) > 0 || w.EmitDefaults() {
+		w.Field("named", "named")
+		w.BeginObject()
+		keys := make([]Name, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(string(k))
+			w.Message(m.named[k])
+		}
+		w.EndObject()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Castmap) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("userNames", "user_names"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			k, err := jsonpb.Int64(jsonpb.Key(key))
+			if err != nil {
+				return err
+			}
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.PutUserNames(UserID(k), v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("named", "named"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v := new(Castaway)
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+			if err := m.PutNamed(Name(key), v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Castmap) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Castmap) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Castmap_UserNamesEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.Int64(int64(m.GetKey()))
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.String(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Castmap_UserNamesEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(UserID(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Castmap_UserNamesEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Castmap_UserNamesEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Castmap_NamedEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(string(m.GetKey()))
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Castmap_NamedEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(Name(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Castmap_NamedEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Castmap_NamedEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Castaway) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsUserSet {
+		w.Field("user")
+		w.Value(int64(m.user))
+	}
+	if m.xxx_IsOtherSet {
+		w.Field("other")
+		w.Value(int64(m.other))
+	}
+	if m.xxx_IsWeightSet {
+		w.Field("weight")
+		w.Value(float64(m.weight))
+	}
+	if m.xxx_IsTruthSet {
+		w.Field("truth")
+		w.Value(bool(m.truth))
+	}
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(string(m.name))
+	}
+	if m.xxx_IsDataSet {
+		w.Field("data")
+		w.Value([]byte(m.data))
+	}
+	if m.xxx_IsFlagsSet {
+		w.Field("flags")
+		w.Value(uint32(m.flags))
+	}
+	if m.xxx_IsDeltaSet {
+		w.Field("delta")
+		w.Value(int32(m.delta))
+	}
+	if m.xxx_IsStampSet {
+		w.Field("stamp")
+		w.Value(uint64(m.stamp))
+	}
+	if m.xxx_IsRatioSet {
+		w.Field("ratio")
+		w.Value(float32(m.ratio))
+	}
+	for i := 0; i < m.xxx_LenUsers; i++ {
+		w.Field("users")
+		w.Value(int64(m.users[i]))
+	}
+	for i := 0; i < m.xxx_LenPackedUsers; i++ {
+		w.Field("packed_users")
+		w.Value(int64(m.packedUsers[i]))
+	}
+	for i := 0; i < m.xxx_LenNames; i++ {
+		w.Field("names")
+		w.Value(string(m.names[i]))
+	}
+	for i := 0; i < m.xxx_LenDeltas; i++ {
+		w.Field("deltas")
+		w.Value(int64(m.deltas[i]))
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Castaway) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "user":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetUser(UserID(v))
+	case "other":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetOther(github_com_dropbox_goprotoc_test.Id(v))
+	case "weight":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetWeight(github_com_dropbox_goprotoc_test.Weight(v))
+	case "truth":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetTruth(github_com_dropbox_goprotoc_test.Truth(v))
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(Name(v))
+	case "data":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetData(Bytes(v))
+	case "flags":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFlags(Flags(v))
+	case "delta":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetDelta(Delta(v))
+	case "stamp":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetStamp(Stamp(v))
+	case "ratio":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetRatio(Ratio(v))
+	case "users":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddUsers(UserID(v))
+	case "packed_users":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddPackedUsers(UserID(v))
+	case "names":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddNames(Name(v))
+	case "deltas":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddDeltas(Delta64(v))
+	}
+	return false, nil
+}
+
+func (m *Castchoice) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser {
+		w.Field("chosen_user")
+		w.Value(int64(m.chosenUser))
+	}
+	if m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName {
+		w.Field("chosen_name")
+		w.Value(string(m.chosenName))
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Castchoice) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "chosen_user":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetChosenUser(UserID(v))
+	case "chosen_name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetChosenName(Name(v))
+	}
+	return false, nil
+}
+
+func (m *Castmap) MarshalTextFields(w *proto.TextWriter) {
+	if len(m.userNames) > 0 {
+		keys := make([]UserID, 0, len(m.userNames))
+		for k := range m.userNames {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.userNames[k]
+			w.Field("user_names")
+			w.Message(&Castmap_UserNamesEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if len(m.named) > 0 {
+		keys := make([]Name, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			w.Field("named")
+			w.Message(&Castmap_NamedEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Castmap) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "user_names":
+		entry := &Castmap_UserNamesEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.userNames == nil {
+			m.userNames = make(map[UserID]string)
+		}
+		m.userNames[entry.key] = entry.value
+		return true, nil
+	case "named":
+		entry := &Castmap_NamedEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.named == nil {
+			m.named = make(map[Name]*Castaway)
+		}
+		if entry.value == nil {
+			entry.value = new(Castaway)
+		}
+		m.named[entry.key] = entry.value
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *Castmap_UserNamesEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(int64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Castmap_UserNamesEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(UserID(v))
+	case "value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Castmap_NamedEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(string(m.key))
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Castmap_NamedEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(Name(v))
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Castaway) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Castaway{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Castaway) MergeFrom(src proto.Message) {
+	s, ok := src.(*Castaway)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsUserSet {
+		m.SetUser(s.user)
+	}
+	if s.xxx_IsOtherSet {
+		m.SetOther(s.other)
+	}
+	if s.xxx_IsWeightSet {
+		m.SetWeight(s.weight)
+	}
+	if s.xxx_IsTruthSet {
+		m.SetTruth(s.truth)
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsDataSet {
+		m.SetData(append([]byte{}, s.data...))
+	}
+	if s.xxx_IsFlagsSet {
+		m.SetFlags(s.flags)
+	}
+	if s.xxx_IsDeltaSet {
+		m.SetDelta(s.delta)
+	}
+	if s.xxx_IsStampSet {
+		m.SetStamp(s.stamp)
+	}
+	if s.xxx_IsRatioSet {
+		m.SetRatio(s.ratio)
+	}
+	for i := 0; i < s.xxx_LenUsers; i++ {
+		m.AddUsers(s.users[i])
+	}
+	for i := 0; i < s.xxx_LenPackedUsers; i++ {
+		m.AddPackedUsers(s.packedUsers[i])
+	}
+	for i := 0; i < s.xxx_LenNames; i++ {
+		m.AddNames(s.names[i])
+	}
+	for i := 0; i < s.xxx_LenDeltas; i++ {
+		m.AddDeltas(s.deltas[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Castaway) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Castaway)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsUserSet != o.xxx_IsUserSet {
+		return false
+	}
+	if m.xxx_IsUserSet && m.user != o.user {
+		return false
+	}
+	if m.xxx_IsOtherSet != o.xxx_IsOtherSet {
+		return false
+	}
+	if m.xxx_IsOtherSet && m.other != o.other {
+		return false
+	}
+	if m.xxx_IsWeightSet != o.xxx_IsWeightSet {
+		return false
+	}
+	if m.xxx_IsWeightSet && m.weight != o.weight {
+		return false
+	}
+	if m.xxx_IsTruthSet != o.xxx_IsTruthSet {
+		return false
+	}
+	if m.xxx_IsTruthSet && m.truth != o.truth {
+		return false
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsDataSet != o.xxx_IsDataSet {
+		return false
+	}
+	if m.xxx_IsDataSet && !bytes.Equal(m.data, o.data) {
+		return false
+	}
+	if m.xxx_IsFlagsSet != o.xxx_IsFlagsSet {
+		return false
+	}
+	if m.xxx_IsFlagsSet && m.flags != o.flags {
+		return false
+	}
+	if m.xxx_IsDeltaSet != o.xxx_IsDeltaSet {
+		return false
+	}
+	if m.xxx_IsDeltaSet && m.delta != o.delta {
+		return false
+	}
+	if m.xxx_IsStampSet != o.xxx_IsStampSet {
+		return false
+	}
+	if m.xxx_IsStampSet && m.stamp != o.stamp {
+		return false
+	}
+	if m.xxx_IsRatioSet != o.xxx_IsRatioSet {
+		return false
+	}
+	if m.xxx_IsRatioSet && m.ratio != o.ratio {
+		return false
+	}
+	if m.xxx_LenUsers != o.xxx_LenUsers {
+		return false
+	}
+	for i := 0; i < m.xxx_LenUsers; i++ {
+		if m.users[i] != o.users[i] {
+			return false
+		}
+	}
+	if m.xxx_LenPackedUsers != o.xxx_LenPackedUsers {
+		return false
+	}
+	for i := 0; i < m.xxx_LenPackedUsers; i++ {
+		if m.packedUsers[i] != o.packedUsers[i] {
+			return false
+		}
+	}
+	if m.xxx_LenNames != o.xxx_LenNames {
+		return false
+	}
+	for i := 0; i < m.xxx_LenNames; i++ {
+		if m.names[i] != o.names[i] {
+			return false
+		}
+	}
+	if m.xxx_LenDeltas != o.xxx_LenDeltas {
+		return false
+	}
+	for i := 0; i < m.xxx_LenDeltas; i++ {
+		if m.deltas[i] != o.deltas[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Castchoice) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Castchoice{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Castchoice) MergeFrom(src proto.Message) {
+	s, ok := src.(*Castchoice)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser {
+		m.SetChosenUser(s.chosenUser)
+	}
+	if s.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName {
+		m.SetChosenName(s.chosenName)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Castchoice) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Castchoice)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser) != (o.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenUser) && m.chosenUser != o.chosenUser {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName) != (o.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Castchoice_ChoiceCase_ChosenName) && m.chosenName != o.chosenName {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Castmap) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Castmap{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Castmap) MergeFrom(src proto.Message) {
+	s, ok := src.(*Castmap)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if len(s.userNames) > 0 {
+		if m.userNames == nil {
+			m.userNames = make(map[UserID]string, len(s.userNames))
+		}
+		for k, v := range s.userNames {
+			m.userNames[k] = v
+		}
+	}
+	if len(s.named) > 0 {
+		if m.named == nil {
+			m.named = make(map[Name]*Castaway, len(s.named))
+		}
+		for k, v := range s.named {
+			m.named[k] = v.Clone().(*Castaway)
+		}
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Castmap) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Castmap)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if len(m.userNames) != len(o.userNames) {
+		return false
+	}
+	for k, v := range m.userNames {
+		if v2, ok := o.userNames[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	if len(m.named) != len(o.named) {
+		return false
+	}
+	for k, v := range m.named {
+		if v2, ok := o.named[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Castmap_UserNamesEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Castmap_UserNamesEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Castmap_UserNamesEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Castmap_UserNamesEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Castmap_UserNamesEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Castmap_UserNamesEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Castmap_NamedEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Castmap_NamedEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Castmap_NamedEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Castmap_NamedEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Castmap_NamedEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Castmap_NamedEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionCastaway = &proto.MessageInfo{
+	Name: "casttype.Castaway",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "user",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetUser(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetUser()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(UserID)
+				if !ok {
+					return proto.NewFieldTypeError(m, "user", v)
+				}
+				x := m.(*Castaway)
+				return x.SetUser(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasUser()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearUser()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "other",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetOther(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetOther()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(github_com_dropbox_goprotoc_test.Id)
+				if !ok {
+					return proto.NewFieldTypeError(m, "other", v)
+				}
+				x := m.(*Castaway)
+				return x.SetOther(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasOther()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearOther()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "weight",
+			Kind:    proto.DoubleKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetWeight(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetWeight()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(github_com_dropbox_goprotoc_test.Weight)
+				if !ok {
+					return proto.NewFieldTypeError(m, "weight", v)
+				}
+				x := m.(*Castaway)
+				return x.SetWeight(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasWeight()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearWeight()
+			},
+		},
+		{
+			Number:  4,
+			Name:    "truth",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetTruth(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetTruth()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(github_com_dropbox_goprotoc_test.Truth)
+				if !ok {
+					return proto.NewFieldTypeError(m, "truth", v)
+				}
+				x := m.(*Castaway)
+				return x.SetTruth(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasTruth()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearTruth()
+			},
+		},
+		{
+			Number:  5,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Name)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Castaway)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearName()
+			},
+		},
+		{
+			Number:  6,
+			Name:    "data",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetData(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetData()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Bytes)
+				if !ok {
+					return proto.NewFieldTypeError(m, "data", v)
+				}
+				x := m.(*Castaway)
+				return x.SetData(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasData()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearData()
+			},
+		},
+		{
+			Number:  7,
+			Name:    "flags",
+			Kind:    proto.Uint32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetFlags(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetFlags()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Flags)
+				if !ok {
+					return proto.NewFieldTypeError(m, "flags", v)
+				}
+				x := m.(*Castaway)
+				return x.SetFlags(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasFlags()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearFlags()
+			},
+		},
+		{
+			Number:  8,
+			Name:    "delta",
+			Kind:    proto.Sint32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetDelta(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetDelta()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Delta)
+				if !ok {
+					return proto.NewFieldTypeError(m, "delta", v)
+				}
+				x := m.(*Castaway)
+				return x.SetDelta(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasDelta()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearDelta()
+			},
+		},
+		{
+			Number:  9,
+			Name:    "stamp",
+			Kind:    proto.Fixed64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetStamp(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetStamp()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Stamp)
+				if !ok {
+					return proto.NewFieldTypeError(m, "stamp", v)
+				}
+				x := m.(*Castaway)
+				return x.SetStamp(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasStamp()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearStamp()
+			},
+		},
+		{
+			Number:  10,
+			Name:    "ratio",
+			Kind:    proto.FloatKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castaway)(nil).GetRatio(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castaway).GetRatio()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Ratio)
+				if !ok {
+					return proto.NewFieldTypeError(m, "ratio", v)
+				}
+				x := m.(*Castaway)
+				return x.SetRatio(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).HasRatio()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearRatio()
+			},
+		},
+		{
+			Number: 11,
+			Name:   "users",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Castaway)
+				v := make([]UserID, x.xxx_LenUsers)
+				copy(v, x.users)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]UserID)
+				if !ok {
+					return proto.NewFieldTypeError(m, "users", v)
+				}
+				x := m.(*Castaway)
+				x.ClearUsers()
+				for _, e := range value {
+					if err := x.AddUsers(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).UsersSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearUsers()
+			},
+		},
+		{
+			Number: 12,
+			Name:   "packed_users",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Castaway)
+				v := make([]UserID, x.xxx_LenPackedUsers)
+				copy(v, x.packedUsers)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]UserID)
+				if !ok {
+					return proto.NewFieldTypeError(m, "packed_users", v)
+				}
+				x := m.(*Castaway)
+				x.ClearPackedUsers()
+				for _, e := range value {
+					if err := x.AddPackedUsers(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).PackedUsersSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearPackedUsers()
+			},
+		},
+		{
+			Number: 13,
+			Name:   "names",
+			Kind:   proto.StringKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Castaway)
+				v := make([]Name, x.xxx_LenNames)
+				copy(v, x.names)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]Name)
+				if !ok {
+					return proto.NewFieldTypeError(m, "names", v)
+				}
+				x := m.(*Castaway)
+				x.ClearNames()
+				for _, e := range value {
+					if err := x.AddNames(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).NamesSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearNames()
+			},
+		},
+		{
+			Number: 14,
+			Name:   "deltas",
+			Kind:   proto.Sint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Castaway)
+				v := make([]Delta64, x.xxx_LenDeltas)
+				copy(v, x.deltas)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]Delta64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "deltas", v)
+				}
+				x := m.(*Castaway)
+				x.ClearDeltas()
+				for _, e := range value {
+					if err := x.AddDeltas(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castaway).DeltasSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castaway).ClearDeltas()
+			},
+		},
+	},
+}
+
+func (m *Castaway) ProtoReflect() *proto.MessageInfo {
+	return reflectionCastaway
+}
+
+var reflectionCastchoice = &proto.MessageInfo{
+	Name: "casttype.Castchoice",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "chosen_user",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "choice",
+			Default: (*Castchoice)(nil).GetChosenUser(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castchoice).GetChosenUser()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(UserID)
+				if !ok {
+					return proto.NewFieldTypeError(m, "chosen_user", v)
+				}
+				x := m.(*Castchoice)
+				return x.SetChosenUser(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castchoice).HasChosenUser()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castchoice).ClearChosenUser()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "chosen_name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "choice",
+			Default: (*Castchoice)(nil).GetChosenName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castchoice).GetChosenName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Name)
+				if !ok {
+					return proto.NewFieldTypeError(m, "chosen_name", v)
+				}
+				x := m.(*Castchoice)
+				return x.SetChosenName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castchoice).HasChosenName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castchoice).ClearChosenName()
+			},
+		},
+	},
+}
+
+func (m *Castchoice) ProtoReflect() *proto.MessageInfo {
+	return reflectionCastchoice
+}
+
+var reflectionCastmap = &proto.MessageInfo{
+	Name: "casttype.Castmap",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "user_names",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Castmap)
+				v := make(map[UserID]string, x.UserNamesLen())
+				x.RangeUserNames(func(key UserID, value string) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[UserID]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "user_names", v)
+				}
+				x := m.(*Castmap)
+				x.ClearUserNames()
+				for k, e := range value {
+					if err := x.PutUserNames(k, e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castmap).UserNamesLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castmap).ClearUserNames()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "named",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Castmap)
+				v := make(map[Name]*Castaway, x.NamedLen())
+				x.RangeNamed(func(key Name, value *Castaway) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[Name]*Castaway)
+				if !ok {
+					return proto.NewFieldTypeError(m, "named", v)
+				}
+				x := m.(*Castmap)
+				x.ClearNamed()
+				for k, e := range value {
+					if err := x.PutNamed(k, e.Clone().(*Castaway)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castmap).NamedLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castmap).ClearNamed()
+			},
+		},
+	},
+}
+
+func (m *Castmap) ProtoReflect() *proto.MessageInfo {
+	return reflectionCastmap
+}
+
+var reflectionCastmap_UserNamesEntry = &proto.MessageInfo{
+	Name: "casttype.Castmap.UserNamesEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castmap_UserNamesEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castmap_UserNamesEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(UserID)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Castmap_UserNamesEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castmap_UserNamesEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castmap_UserNamesEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castmap_UserNamesEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castmap_UserNamesEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Castmap_UserNamesEntry)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castmap_UserNamesEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castmap_UserNamesEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Castmap_UserNamesEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionCastmap_UserNamesEntry
+}
+
+var reflectionCastmap_NamedEntry = &proto.MessageInfo{
+	Name: "casttype.Castmap.NamedEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Castmap_NamedEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castmap_NamedEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Name)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Castmap_NamedEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castmap_NamedEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castmap_NamedEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Castaway)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Castmap_NamedEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Castaway)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Castmap_NamedEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Castmap_NamedEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Castmap_NamedEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Castmap_NamedEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionCastmap_NamedEntry
+}
+
+func init() {
+	proto.RegisterType((*Castaway)(nil), "casttype.Castaway")
+	proto.RegisterType((*Castchoice)(nil), "casttype.Castchoice")
+	proto.RegisterType((*Castmap)(nil), "casttype.Castmap")
+}
+func NewPopulatedCastaway(r randyCasttype, easy bool) *Castaway {
+	this := &Castaway{}
+	this.xxx_IsUserSet = true
+	this.user = UserID(r.Int63())
+	if r.Intn(2) == 0 {
+		this.user *= UserID(-1)
+	}
+	this.xxx_IsOtherSet = true
+	this.other = github_com_dropbox_goprotoc_test.Id(r.Int63())
+	if r.Intn(2) == 0 {
+		this.other *= github_com_dropbox_goprotoc_test.Id(-1)
+	}
+	this.xxx_IsWeightSet = true
+	this.weight = github_com_dropbox_goprotoc_test.Weight(r.Float64())
+	if r.Intn(2) == 0 {
+		this.weight *= github_com_dropbox_goprotoc_test.Weight(-1)
+	}
+	this.xxx_IsTruthSet = true
+	this.truth = github_com_dropbox_goprotoc_test.Truth(bool(r.Intn(2) == 0))
+	this.xxx_IsNameSet = true
+	this.name = Name(randStringCasttype(r))
+	v1 := r.Intn(100)
+	this.data = make(Bytes, v1)
+	for i := 0; i < v1; i++ {
+		this.xxx_IsDataSet = true
+		this.data[i] = byte(r.Intn(256))
+	}
+	this.xxx_IsFlagsSet = true
+	this.flags = Flags(r.Uint32())
+	this.xxx_IsDeltaSet = true
+	this.delta = Delta(r.Int31())
+	if r.Intn(2) == 0 {
+		this.delta *= Delta(-1)
+	}
+	this.xxx_IsStampSet = true
+	this.stamp = Stamp(uint64(r.Uint32()))
+	this.xxx_IsRatioSet = true
+	this.ratio = Ratio(r.Float32())
+	if r.Intn(2) == 0 {
+		this.ratio *= Ratio(-1)
+	}
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(100)
+		this.users = make([]UserID, v2)
+		for i := 0; i < v2; i++ {
+			this.xxx_LenUsers += 1
+			this.users[i] = UserID(r.Int63())
+			if r.Intn(2) == 0 {
+				this.users[i] *= UserID(-1)
+			}
+		}
+	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(100)
+		this.packedUsers = make([]UserID, v3)
+		for i := 0; i < v3; i++ {
+			this.xxx_LenPackedUsers += 1
+			this.packedUsers[i] = UserID(r.Int63())
+			if r.Intn(2) == 0 {
+				this.packedUsers[i] *= UserID(-1)
+			}
+		}
+	}
+	if r.Intn(10) != 0 {
+		v4 := r.Intn(10)
+		this.names = make([]Name, v4)
+		for i := 0; i < v4; i++ {
+			this.xxx_LenNames += 1
+			this.names[i] = Name(randStringCasttype(r))
+		}
+	}
+	if r.Intn(10) != 0 {
+		v5 := r.Intn(100)
+		this.deltas = make([]Delta64, v5)
+		for i := 0; i < v5; i++ {
+			this.xxx_LenDeltas += 1
+			this.deltas[i] = Delta64(r.Int63())
+			if r.Intn(2) == 0 {
+				this.deltas[i] *= Delta64(-1)
+			}
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCasttype(r, 15)
+	}
+	return this
+}
+
+func NewPopulatedCastmap_UserNamesEntry(r randyCasttype, easy bool) *Castmap_UserNamesEntry {
+	this := &Castmap_UserNamesEntry{}
+	this.xxx_IsKeySet = true
+	this.key = UserID(r.Int63())
+	if r.Intn(2) == 0 {
+		this.key *= UserID(-1)
+	}
+	this.xxx_IsValueSet = true
+	this.value = (randStringCasttype(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCasttype(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedCastmap_NamedEntry(r randyCasttype, easy bool) *Castmap_NamedEntry {
+	this := &Castmap_NamedEntry{}
+	this.xxx_IsKeySet = true
+	this.key = Name(randStringCasttype(r))
+	v6 := NewPopulatedCastaway(r, easy)
+	this.xxx_IsValueSet = true
+	this.value = v6
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCasttype(r, 3)
+	}
+	return this
+}
+
+type randyCasttype interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneCasttype(r randyCasttype) rune {
+	res := rune(r.Uint32() % 1112064)
+	if 55296 <= res {
+		res += 2047
+	}
+	return res
+}
+func randStringCasttype(r randyCasttype) string {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
+		tmps[i] = randUTF8RuneCasttype(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedCasttype(r randyCasttype, maxFieldNumber int) (data []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		data = randFieldCasttype(data, r, fieldNumber, wire)
+	}
+	return data
+}
+func randFieldCasttype(data []byte, r randyCasttype, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		data = encodeVarintPopulateCasttype(data, uint64(key))
+		v8 := r.Int63()
+		if r.Intn(2) == 0 {
+			v8 *= -1
+		}
+		data = encodeVarintPopulateCasttype(data, uint64(v8))
+	case 1:
+		data = encodeVarintPopulateCasttype(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		data = encodeVarintPopulateCasttype(data, uint64(key))
+		ll := r.Intn(100)
+		data = encodeVarintPopulateCasttype(data, uint64(ll))
+		for j := 0; j < ll; j++ {
+			data = append(data, byte(r.Intn(256)))
+		}
+	default:
+		data = encodeVarintPopulateCasttype(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return data
+}
+func encodeVarintPopulateCasttype(data []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		data = append(data, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	data = append(data, uint8(v))
+	return data
+}
+func (this *Castaway) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Castaway)
+	if !ok {
+		return fmt.Errorf("that is not of type *Castaway")
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Castaway but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Castawaybut is not nil && this == nil")
+	}
+	if this.xxx_IsUserSet != that1.xxx_IsUserSet {
+		return fmt.Errorf("that.user is not equal to this.user")
+	}
+	if this.xxx_IsUserSet && this.user != that1.user {
+		return fmt.Errorf("user this(%v) Not Equal that(%v)", this.user, that1.user)
+	}
+	if this.xxx_IsOtherSet != that1.xxx_IsOtherSet {
+		return fmt.Errorf("that.other is not equal to this.other")
+	}
+	if this.xxx_IsOtherSet && this.other != that1.other {
+		return fmt.Errorf("other this(%v) Not Equal that(%v)", this.other, that1.other)
+	}
+	if this.xxx_IsWeightSet != that1.xxx_IsWeightSet {
+		return fmt.Errorf("that.weight is not equal to this.weight")
+	}
+	if this.xxx_IsWeightSet && this.weight != that1.weight {
+		return fmt.Errorf("weight this(%v) Not Equal that(%v)", this.weight, that1.weight)
+	}
+	if this.xxx_IsTruthSet != that1.xxx_IsTruthSet {
+		return fmt.Errorf("that.truth is not equal to this.truth")
+	}
+	if this.xxx_IsTruthSet && this.truth != that1.truth {
+		return fmt.Errorf("truth this(%v) Not Equal that(%v)", this.truth, that1.truth)
+	}
+	if this.xxx_IsNameSet != that1.xxx_IsNameSet {
+		return fmt.Errorf("that.name is not equal to this.name")
+	}
+	if this.xxx_IsNameSet && this.name != that1.name {
+		return fmt.Errorf("name this(%v) Not Equal that(%v)", this.name, that1.name)
+	}
+	if this.xxx_IsDataSet != that1.xxx_IsDataSet {
+		return fmt.Errorf("that.data is not equal to this.data")
+	}
+	if this.xxx_IsDataSet && !bytes1.Equal(this.data, that1.data) {
+		return fmt.Errorf("data this(%v) Not Equal that(%v)", this.data, that1.data)
+	}
+	if this.xxx_IsFlagsSet != that1.xxx_IsFlagsSet {
+		return fmt.Errorf("that.flags is not equal to this.flags")
+	}
+	if this.xxx_IsFlagsSet && this.flags != that1.flags {
+		return fmt.Errorf("flags this(%v) Not Equal that(%v)", this.flags, that1.flags)
+	}
+	if this.xxx_IsDeltaSet != that1.xxx_IsDeltaSet {
+		return fmt.Errorf("that.delta is not equal to this.delta")
+	}
+	if this.xxx_IsDeltaSet && this.delta != that1.delta {
+		return fmt.Errorf("delta this(%v) Not Equal that(%v)", this.delta, that1.delta)
+	}
+	if this.xxx_IsStampSet != that1.xxx_IsStampSet {
+		return fmt.Errorf("that.stamp is not equal to this.stamp")
+	}
+	if this.xxx_IsStampSet && this.stamp != that1.stamp {
+		return fmt.Errorf("stamp this(%v) Not Equal that(%v)", this.stamp, that1.stamp)
+	}
+	if this.xxx_IsRatioSet != that1.xxx_IsRatioSet {
+		return fmt.Errorf("that.ratio is not equal to this.ratio")
+	}
+	if this.xxx_IsRatioSet && this.ratio != that1.ratio {
+		return fmt.Errorf("ratio this(%v) Not Equal that(%v)", this.ratio, that1.ratio)
+	}
+	if this.xxx_LenUsers != that1.xxx_LenUsers {
+		return fmt.Errorf("that.users is not equal to this.users")
+	}
+	for i := 0; i < this.xxx_LenUsers; i++ {
+		if this.users[i] != that1.users[i] {
+			return fmt.Errorf("users this[%v](%v) Not Equal that[%v](%v)", i, this.users[i], i, that1.users[i])
+		}
+	}
+	if this.xxx_LenPackedUsers != that1.xxx_LenPackedUsers {
+		return fmt.Errorf("that.packedUsers is not equal to this.packedUsers")
+	}
+	for i := 0; i < this.xxx_LenPackedUsers; i++ {
+		if this.packedUsers[i] != that1.packedUsers[i] {
+			return fmt.Errorf("packedUsers this[%v](%v) Not Equal that[%v](%v)", i, this.packedUsers[i], i, that1.packedUsers[i])
+		}
+	}
+	if this.xxx_LenNames != that1.xxx_LenNames {
+		return fmt.Errorf("that.names is not equal to this.names")
+	}
+	for i := 0; i < this.xxx_LenNames; i++ {
+		if this.names[i] != that1.names[i] {
+			return fmt.Errorf("names this[%v](%v) Not Equal that[%v](%v)", i, this.names[i], i, that1.names[i])
+		}
+	}
+	if this.xxx_LenDeltas != that1.xxx_LenDeltas {
+		return fmt.Errorf("that.deltas is not equal to this.deltas")
+	}
+	for i := 0; i < this.xxx_LenDeltas; i++ {
+		if this.deltas[i] != that1.deltas[i] {
+			return fmt.Errorf("deltas this[%v](%v) Not Equal that[%v](%v)", i, this.deltas[i], i, that1.deltas[i])
+		}
+	}
+	if !bytes1.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Castchoice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Castchoice)
+	if !ok {
+		return fmt.Errorf("that is not of type *Castchoice")
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Castchoice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Castchoicebut is not nil && this == nil")
+	}
+	if this.xxx_IsChosenUserSet != that1.xxx_IsChosenUserSet {
+		return fmt.Errorf("that.chosenUser is not equal to this.chosenUser")
+	}
+	if this.xxx_IsChosenUserSet && this.chosenUser != that1.chosenUser {
+		return fmt.Errorf("chosenUser this(%v) Not Equal that(%v)", this.chosenUser, that1.chosenUser)
+	}
+	if this.xxx_IsChosenNameSet != that1.xxx_IsChosenNameSet {
+		return fmt.Errorf("that.chosenName is not equal to this.chosenName")
+	}
+	if this.xxx_IsChosenNameSet && this.chosenName != that1.chosenName {
+		return fmt.Errorf("chosenName this(%v) Not Equal that(%v)", this.chosenName, that1.chosenName)
+	}
+	if !bytes1.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Castmap_UserNamesEntry) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Castmap_UserNamesEntry)
+	if !ok {
+		return fmt.Errorf("that is not of type *Castmap_UserNamesEntry")
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Castmap_UserNamesEntry but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Castmap_UserNamesEntrybut is not nil && this == nil")
+	}
+	if this.xxx_IsKeySet != that1.xxx_IsKeySet {
+		return fmt.Errorf("that.key is not equal to this.key")
+	}
+	if this.xxx_IsKeySet && this.key != that1.key {
+		return fmt.Errorf("key this(%v) Not Equal that(%v)", this.key, that1.key)
+	}
+	if this.xxx_IsValueSet != that1.xxx_IsValueSet {
+		return fmt.Errorf("that.value is not equal to this.value")
+	}
+	if this.xxx_IsValueSet && this.value != that1.value {
+		return fmt.Errorf("value this(%v) Not Equal that(%v)", this.value, that1.value)
+	}
+	if !bytes1.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Castmap_NamedEntry) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Castmap_NamedEntry)
+	if !ok {
+		return fmt.Errorf("that is not of type *Castmap_NamedEntry")
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Castmap_NamedEntry but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Castmap_NamedEntrybut is not nil && this == nil")
+	}
+	if this.xxx_IsKeySet != that1.xxx_IsKeySet {
+		return fmt.Errorf("that.key is not equal to this.key")
+	}
+	if this.xxx_IsKeySet && this.key != that1.key {
+		return fmt.Errorf("key this(%v) Not Equal that(%v)", this.key, that1.key)
+	}
+	if this.xxx_IsValueSet != that1.xxx_IsValueSet {
+		return fmt.Errorf("that.value is not equal to this.value")
+	}
+	if this.xxx_IsValueSet && !this.value.Equal(that1.value) {
+		return fmt.Errorf("value this(%v) Not Equal that(%v)", this.value, that1.value)
+	}
+	if !bytes1.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
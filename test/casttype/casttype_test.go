@@ -0,0 +1,141 @@
+package casttype
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+	"github.com/dropbox/goprotoc/proto"
+	"github.com/dropbox/goprotoc/test"
+)
+
+func newCastaway() *Castaway {
+	m := &Castaway{}
+	m.SetUser(UserID(-5))
+	m.SetWeight(test.Weight(1.5))
+	m.SetTruth(test.Truth(true))
+	m.SetName(Name("name"))
+	m.SetData(Bytes("data"))
+	m.SetFlags(Flags(6))
+	m.SetDelta(Delta(-7))
+	m.SetStamp(Stamp(8))
+	m.SetRatio(Ratio(0.25))
+	for i := 0; i < 3; i++ {
+		m.AddUsers(UserID(i))
+		m.AddPackedUsers(UserID(-i))
+		m.AddNames(Name("names"))
+		m.AddDeltas(Delta64(-i))
+	}
+	return m
+}
+
+func newCastmap() *Castmap {
+	m := &Castmap{}
+	m.PutUserNames(UserID(1), "one")
+	m.PutUserNames(UserID(-2), "minus two")
+	m.PutNamed(Name("first"), newCastaway())
+	return m
+}
+
+func TestDefault(t *testing.T) {
+	m := &Castaway{}
+	if got := m.GetOther(); got != test.Id(7) {
+		t.Fatalf("other is %d, want the default 7", got)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	m := newCastaway()
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &Castaway{}
+	if err := proto.Unmarshal(data, m2); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.VerboseEqual(m2); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := m2.GetDeltas(2); got != Delta64(-2) {
+		t.Fatalf("delta 2 is %d, want -2", got)
+	}
+
+	text := proto.MarshalTextString(m)
+	if !strings.Contains(text, "user: -5\n") || !strings.Contains(text, `name: "name"`) {
+		t.Fatalf("text does not have the plain values of the fields:\n%s", text)
+	}
+	m3 := &Castaway{}
+	if err := proto.UnmarshalText(text, m3); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.VerboseEqual(m3); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := new(jsonpb.Marshaler).MarshalToString(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m4 := &Castaway{}
+	if err := new(jsonpb.Unmarshaler).UnmarshalString(s, m4); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.VerboseEqual(m4); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCastKey(t *testing.T) {
+	m := newCastmap()
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &Castmap{}
+	if err := proto.Unmarshal(data, m2); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := m2.GetUserNames(UserID(-2)); !ok || got != "minus two" {
+		t.Fatalf("user name of -2 is %q", got)
+	}
+	if got, ok := m2.GetNamed(Name("first")); !ok || !got.Equal(newCastaway()) {
+		t.Fatalf("first is %v", got)
+	}
+
+	s, err := new(jsonpb.Marshaler).MarshalToString(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m3 := &Castmap{}
+	if err := new(jsonpb.Unmarshaler).UnmarshalString(s, m3); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Equal(m3) {
+		t.Fatalf("%v != %v", m, m3)
+	}
+
+	m4 := &Castmap{}
+	if err := proto.UnmarshalText(proto.MarshalTextString(m), m4); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Equal(m4) {
+		t.Fatalf("%v != %v", m, m4)
+	}
+}
+
+func TestOneofCastType(t *testing.T) {
+	m := &Castchoice{}
+	m.SetChosenName(Name("chosen"))
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &Castchoice{}
+	if err := proto.Unmarshal(data, m2); err != nil {
+		t.Fatal(err)
+	}
+	if got := m2.GetChosenName(); got != Name("chosen") {
+		t.Fatalf("chosen name is %q", got)
+	}
+}
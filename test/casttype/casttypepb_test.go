@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-dgo.
+// source: casttype.proto
+// DO NOT EDIT!
+
+/*
+Package casttype is a generated protocol buffer package.
+
+It is generated from these files:
+
+	casttype.proto
+
+It has these top-level messages:
+
+	Castaway
+	Castchoice
+	Castmap
+*/
+package casttype
+
+import testing "testing"
+import math_rand "math/rand"
+import time "time"
+import github_com_dropbox_goprotoc_proto "github.com/dropbox/goprotoc/proto"
+import testing1 "testing"
+import math_rand1 "math/rand"
+import time1 "time"
+import math_rand2 "math/rand"
+import time2 "time"
+import testing2 "testing"
+import github_com_dropbox_goprotoc_proto1 "github.com/dropbox/goprotoc/proto"
+
+func TestCastawayProto(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedCastaway(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castaway{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestCastawayMarshalTo(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedCastaway(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	_, err := p.MarshalTo(data)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castaway{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestCastmap_UserNamesEntryProto(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedCastmap_UserNamesEntry(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castmap_UserNamesEntry{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestCastmap_UserNamesEntryMarshalTo(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedCastmap_UserNamesEntry(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	_, err := p.MarshalTo(data)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castmap_UserNamesEntry{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestCastmap_NamedEntryProto(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedCastmap_NamedEntry(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castmap_NamedEntry{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestCastmap_NamedEntryMarshalTo(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedCastmap_NamedEntry(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	_, err := p.MarshalTo(data)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castmap_NamedEntry{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestCastawayAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedCastaway(popr, false)
+	msg := &Castaway{}
+	if !apiEmptyCastaway(msg, t) {
+		t.Fatalf("Castaway should be empty")
+	}
+	apiCopyCastaway(msg, p, t)
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyCastaway(p, t) != apiEmptyCastaway(msg, t) {
+		t.Fatalf("Castaway should not be empty")
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+	msg.Clear()
+	if !apiEmptyCastaway(msg, t) {
+		t.Fatalf("Castaway should be empty")
+	}
+}
+
+func apiCopyCastaway(dst *Castaway, src *Castaway, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
+	}
+	if src.HasUser() {
+		dst.SetUser(src.GetUser())
+	}
+	if src.HasOther() {
+		dst.SetOther(src.GetOther())
+	}
+	if src.HasWeight() {
+		dst.SetWeight(src.GetWeight())
+	}
+	if src.HasTruth() {
+		dst.SetTruth(src.GetTruth())
+	}
+	if src.HasName() {
+		dst.SetName(src.GetName())
+	}
+	if src.HasData() {
+		dst.SetData(src.GetData())
+	}
+	if src.HasFlags() {
+		dst.SetFlags(src.GetFlags())
+	}
+	if src.HasDelta() {
+		dst.SetDelta(src.GetDelta())
+	}
+	if src.HasStamp() {
+		dst.SetStamp(src.GetStamp())
+	}
+	if src.HasRatio() {
+		dst.SetRatio(src.GetRatio())
+	}
+	for i := 0; i < src.UsersSize(); i++ {
+		value, _ := src.GetUsers(i)
+		dst.AddUsers(value)
+	}
+	for i := 0; i < src.PackedUsersSize(); i++ {
+		value, _ := src.GetPackedUsers(i)
+		dst.AddPackedUsers(value)
+	}
+	for i := 0; i < src.NamesSize(); i++ {
+		value, _ := src.GetNames(i)
+		dst.AddNames(value)
+	}
+	for i := 0; i < src.DeltasSize(); i++ {
+		value, _ := src.GetDeltas(i)
+		dst.AddDeltas(value)
+	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
+}
+
+func apiEmptyCastaway(msg *Castaway, t *testing1.T) bool {
+	if msg == nil {
+		return true
+	}
+	if msg.HasUser() {
+		return false
+	}
+	if msg.HasOther() {
+		return false
+	}
+	if msg.HasWeight() {
+		return false
+	}
+	if msg.HasTruth() {
+		return false
+	}
+	if msg.HasName() {
+		return false
+	}
+	if msg.HasData() {
+		return false
+	}
+	if msg.HasFlags() {
+		return false
+	}
+	if msg.HasDelta() {
+		return false
+	}
+	if msg.HasStamp() {
+		return false
+	}
+	if msg.HasRatio() {
+		return false
+	}
+	if msg.UsersSize() != 0 {
+		return false
+	}
+	if msg.PackedUsersSize() != 0 {
+		return false
+	}
+	if msg.NamesSize() != 0 {
+		return false
+	}
+	if msg.DeltasSize() != 0 {
+		return false
+	}
+	return true
+}
+
+func TestCastmap_UserNamesEntryAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedCastmap_UserNamesEntry(popr, false)
+	msg := &Castmap_UserNamesEntry{}
+	if !apiEmptyCastmap_UserNamesEntry(msg, t) {
+		t.Fatalf("Castmap_UserNamesEntry should be empty")
+	}
+	apiCopyCastmap_UserNamesEntry(msg, p, t)
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyCastmap_UserNamesEntry(p, t) != apiEmptyCastmap_UserNamesEntry(msg, t) {
+		t.Fatalf("Castmap_UserNamesEntry should not be empty")
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+	msg.Clear()
+	if !apiEmptyCastmap_UserNamesEntry(msg, t) {
+		t.Fatalf("Castmap_UserNamesEntry should be empty")
+	}
+}
+
+func apiCopyCastmap_UserNamesEntry(dst *Castmap_UserNamesEntry, src *Castmap_UserNamesEntry, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
+	}
+	if src.HasKey() {
+		dst.SetKey(src.GetKey())
+	}
+	if src.HasValue() {
+		dst.SetValue(src.GetValue())
+	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
+}
+
+func apiEmptyCastmap_UserNamesEntry(msg *Castmap_UserNamesEntry, t *testing1.T) bool {
+	if msg == nil {
+		return true
+	}
+	if msg.HasKey() {
+		return false
+	}
+	if msg.HasValue() {
+		return false
+	}
+	return true
+}
+
+func TestCastmap_NamedEntryAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedCastmap_NamedEntry(popr, false)
+	msg := &Castmap_NamedEntry{}
+	if !apiEmptyCastmap_NamedEntry(msg, t) {
+		t.Fatalf("Castmap_NamedEntry should be empty")
+	}
+	apiCopyCastmap_NamedEntry(msg, p, t)
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyCastmap_NamedEntry(p, t) != apiEmptyCastmap_NamedEntry(msg, t) {
+		t.Fatalf("Castmap_NamedEntry should not be empty")
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+	msg.Clear()
+	if !apiEmptyCastmap_NamedEntry(msg, t) {
+		t.Fatalf("Castmap_NamedEntry should be empty")
+	}
+}
+
+func apiCopyCastmap_NamedEntry(dst *Castmap_NamedEntry, src *Castmap_NamedEntry, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
+	}
+	if src.HasKey() {
+		dst.SetKey(src.GetKey())
+	}
+	if src.HasValue() {
+		srcValue := src.GetValue()
+		dstValue, _ := dst.MutateValue()
+		apiCopyCastaway(dstValue, srcValue, t)
+	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
+}
+
+func apiEmptyCastmap_NamedEntry(msg *Castmap_NamedEntry, t *testing1.T) bool {
+	if msg == nil {
+		return true
+	}
+	if msg.HasKey() {
+		return false
+	}
+	if msg.HasValue() {
+		return false
+	}
+	return true
+}
+
+func TestCastawayVerboseEqual(t *testing2.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
+	p := NewPopulatedCastaway(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castaway{}
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+}
+func TestCastmap_UserNamesEntryVerboseEqual(t *testing2.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
+	p := NewPopulatedCastmap_UserNamesEntry(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castmap_UserNamesEntry{}
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+}
+func TestCastmap_NamedEntryVerboseEqual(t *testing2.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
+	p := NewPopulatedCastmap_NamedEntry(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Castmap_NamedEntry{}
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+}
+
+//These tests are generated by github.com/dropbox/goprotoc/plugin/testgen
@@ -0,0 +1,2774 @@
+// Code generated by protoc-gen-dgo.
+// source: clone.proto
+// DO NOT EDIT!
+
+/*
+Package clone is a generated protocol buffer package.
+
+It is generated from these files:
+
+	clone.proto
+
+It has these top-level messages:
+
+	Inner
+	Outer
+*/
+package clone
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Inner struct {
+	xxx_sizeCached   int
+	name             string
+	number           int32
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+	xxx_IsNumberSet  bool
+}
+
+func (m *Inner) Reset()         { *m = Inner{} }
+func (m *Inner) String() string { return proto.CompactTextString(m) }
+func (*Inner) ProtoMessage()    {}
+
+func (m *Inner) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Inner) GetNumber() int32 {
+	if m != nil && m.xxx_IsNumberSet {
+		return m.number
+	}
+	return 0
+}
+
+func (m *Inner) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Inner) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Inner) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Inner) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Inner) SetNumber(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNumberSet = true
+	m.number = value
+	return nil
+}
+
+func (m *Inner) HasNumber() (isSet bool) {
+	if m != nil && m.xxx_IsNumberSet {
+		return true
+	}
+	return false
+}
+
+func (m *Inner) ClearNumber() {
+	if m != nil {
+		m.xxx_IsNumberSet = false
+	}
+}
+
+func (m *Inner) Clear() {
+	if m != nil {
+		m.ClearName()
+		m.ClearNumber()
+	}
+}
+
+type Outer struct {
+	xxx_sizeCached      int
+	intValue            int32
+	bytesValue          []byte
+	inner               *Inner
+	longs               []int64
+	blobs               [][]byte
+	inners              []*Inner
+	named               map[string]*Inner
+	text                string
+	nested              *Inner
+	XXX_extensions      map[int32]proto.Extension
+	XXX_unrecognized    []byte
+	xxx_IsIntValueSet   bool
+	xxx_IsBytesValueSet bool
+	xxx_IsInnerSet      bool
+	xxx_LenLongs        int
+	xxx_LenBlobs        int
+	xxx_LenInners       int
+	xxx_IsTextSet       bool
+	xxx_IsNestedSet     bool
+	xxx_ChoiceCase      Outer_ChoiceCase
+}
+
+func (m *Outer) Reset()         { *m = Outer{} }
+func (m *Outer) String() string { return proto.CompactTextString(m) }
+func (*Outer) ProtoMessage()    {}
+
+var extRange_Outer = []proto.ExtensionRange{
+	{Start: 100, End: 199},
+}
+
+func (m *Outer) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_Outer
+}
+func (m *Outer) ExtensionMap() map[int32]proto.Extension {
+	if m.XXX_extensions == nil {
+		m.XXX_extensions = make(map[int32]proto.Extension)
+	}
+	return m.XXX_extensions
+}
+
+func (m *Outer) GetIntValue() int32 {
+	if m != nil && m.xxx_IsIntValueSet {
+		return m.intValue
+	}
+	return 0
+}
+
+func (m *Outer) GetBytesValue() []byte {
+	if m != nil && m.xxx_IsBytesValueSet {
+		return m.bytesValue
+	}
+	return nil
+}
+func (m *Outer) GetInner() *Inner {
+	if m != nil && m.xxx_IsInnerSet {
+		return m.inner
+	}
+	return nil
+}
+func (m *Outer) GetText() string {
+	if m != nil && m.xxx_IsTextSet {
+		return m.text
+	}
+	return ""
+}
+
+func (m *Outer) GetNested() *Inner {
+	if m != nil && m.xxx_IsNestedSet {
+		return m.nested
+	}
+	return nil
+}
+func (m *Outer) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Outer_ChoiceCase int32
+
+const (
+	Outer_ChoiceCase_NotSet Outer_ChoiceCase = 0
+	Outer_ChoiceCase_Text   Outer_ChoiceCase = 8
+	Outer_ChoiceCase_Nested Outer_ChoiceCase = 9
+)
+
+func (m *Outer) WhichChoice() Outer_ChoiceCase {
+	if m != nil {
+		return m.xxx_ChoiceCase
+	}
+	return Outer_ChoiceCase_NotSet
+}
+
+func (m *Outer) ClearChoice() {
+	if m != nil {
+		switch m.xxx_ChoiceCase {
+		case Outer_ChoiceCase_Text:
+			m.ClearText()
+		case Outer_ChoiceCase_Nested:
+			m.ClearNested()
+		}
+		m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+	}
+}
+
+func (m *Outer) SetIntValue(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIntValueSet = true
+	m.intValue = value
+	return nil
+}
+
+func (m *Outer) HasIntValue() (isSet bool) {
+	if m != nil && m.xxx_IsIntValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearIntValue() {
+	if m != nil {
+		m.xxx_IsIntValueSet = false
+	}
+}
+
+func (m *Outer) SetBytesValue(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.xxx_IsBytesValueSet = true
+	m.bytesValue = value
+	return nil
+}
+
+func (m *Outer) HasBytesValue() (isSet bool) {
+	if m != nil && m.xxx_IsBytesValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearBytesValue() {
+	if m != nil {
+		m.xxx_IsBytesValueSet = false
+		m.bytesValue = nil
+	}
+}
+
+func (m *Outer) MutateInner() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsInnerSet {
+		m.xxx_IsInnerSet = true
+		if m.inner == nil {
+			m.inner = new(Inner)
+		} else {
+			m.inner.Clear()
+		}
+	}
+	return m.inner, nil
+}
+
+func (m *Outer) HasInner() (isSet bool) {
+	if m != nil && m.xxx_IsInnerSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearInner() {
+	if m != nil {
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+	}
+}
+
+func (m *Outer) AddLongs(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.longs) <= m.xxx_LenLongs {
+		newCapacity := 0
+		if len(m.longs) == 0 {
+			newCapacity = 8
+		} else if len(m.longs) < 1000000 {
+			newCapacity = m.xxx_LenLongs * 2
+		} else {
+			newCapacity = m.xxx_LenLongs + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.longs)
+		m.longs = t
+	}
+	m.longs[m.xxx_LenLongs] = value
+	m.xxx_LenLongs += 1
+	return nil
+}
+
+func (m *Outer) SetLongs(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenLongs {
+		return errors.New("Index is out of bounds")
+	}
+	m.longs[index] = value
+	return nil
+}
+
+func (m *Outer) LongsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenLongs
+	}
+	return 0
+}
+
+func (m *Outer) ClearLongs() {
+	if m != nil {
+		m.xxx_LenLongs = 0
+	}
+}
+
+func (m *Outer) GetLongs(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenLongs {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.longs[index], nil
+}
+
+func (m *Outer) AddBlobs(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if len(m.blobs) <= m.xxx_LenBlobs {
+		newCapacity := 0
+		if len(m.blobs) == 0 {
+			newCapacity = 8
+		} else if len(m.blobs) < 1000000 {
+			newCapacity = m.xxx_LenBlobs * 2
+		} else {
+			newCapacity = m.xxx_LenBlobs + 1000000
+		}
+		t := make([][]byte, newCapacity, newCapacity)
+		copy(t, m.blobs)
+		m.blobs = t
+	}
+	m.blobs[m.xxx_LenBlobs] = value
+	m.xxx_LenBlobs += 1
+	return nil
+}
+
+func (m *Outer) SetBlobs(value []byte, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenBlobs {
+		return errors.New("Index is out of bounds")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.blobs[index] = value
+	return nil
+}
+
+func (m *Outer) BlobsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenBlobs
+	}
+	return 0
+}
+
+func (m *Outer) ClearBlobs() {
+	if m != nil {
+		m.xxx_LenBlobs = 0
+	}
+}
+
+func (m *Outer) GetBlobs(index int) (field []byte, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenBlobs {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.blobs[index], nil
+}
+
+func (m *Outer) AddInners() (field *Inner, err error) {
+	if m != nil {
+		if len(m.inners) <= m.xxx_LenInners {
+			newCapacity := 0
+			if len(m.inners) == 0 {
+				newCapacity = 8
+			} else if len(m.inners) < 1000000 {
+				newCapacity = m.xxx_LenInners * 2
+			} else {
+				newCapacity = m.xxx_LenInners + 1000000
+			}
+			t := make([]*Inner, newCapacity, newCapacity)
+			copy(t, m.inners)
+			m.inners = t
+		}
+		field = m.inners[m.xxx_LenInners]
+		if field == nil {
+			field = new(Inner)
+			m.inners[m.xxx_LenInners] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenInners += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Outer) MutateInners(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenInners {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.inners[index] == nil {
+		m.inners[index] = new(Inner)
+	}
+	return m.inners[index], nil
+}
+
+func (m *Outer) InnersSize() (size int) {
+	if m != nil {
+		return m.xxx_LenInners
+	}
+	return 0
+}
+
+func (m *Outer) ClearInners() {
+	if m != nil {
+		for i := 0; i < m.InnersSize(); i++ {
+			m.inners[i].Clear()
+		}
+		m.xxx_LenInners = 0
+
+	}
+}
+
+func (m *Outer) GetInners(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenInners {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.inners[index], nil
+}
+
+func (m *Outer) GetNamed(key string) (value *Inner, ok bool) {
+	if m != nil {
+		value, ok = m.named[key]
+	}
+	return value, ok
+}
+
+func (m *Outer) PutNamed(key string, value *Inner) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.named == nil {
+		m.named = make(map[string]*Inner)
+	}
+	m.named[key] = value
+	return nil
+}
+
+func (m *Outer) DeleteNamed(key string) {
+	if m != nil {
+		delete(m.named, key)
+	}
+}
+
+func (m *Outer) NamedLen() (size int) {
+	if m != nil {
+		return len(m.named)
+	}
+	return 0
+}
+
+func (m *Outer) RangeNamed(f func(key string, value *Inner) bool) {
+	if m != nil {
+		for k, v := range m.named {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Outer) ClearNamed() {
+	if m != nil {
+		m.named = nil
+	}
+}
+
+func (m *Outer) SetText(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ChoiceCase != Outer_ChoiceCase_Text {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Outer_ChoiceCase_Text
+	}
+	m.xxx_IsTextSet = true
+	m.text = value
+	return nil
+}
+
+func (m *Outer) HasText() (isSet bool) {
+	if m != nil && m.xxx_IsTextSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearText() {
+	if m != nil {
+		m.xxx_IsTextSet = false
+		m.text = ""
+		if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+			m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Outer) MutateNested() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if m.xxx_ChoiceCase != Outer_ChoiceCase_Nested {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Outer_ChoiceCase_Nested
+	}
+	if !m.xxx_IsNestedSet {
+		m.xxx_IsNestedSet = true
+		if m.nested == nil {
+			m.nested = new(Inner)
+		} else {
+			m.nested.Clear()
+		}
+	}
+	return m.nested, nil
+}
+
+func (m *Outer) HasNested() (isSet bool) {
+	if m != nil && m.xxx_IsNestedSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearNested() {
+	if m != nil {
+		m.nested.Clear()
+		m.xxx_IsNestedSet = false
+
+		if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+			m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Outer) Clear() {
+	if m != nil {
+		m.ClearIntValue()
+		m.ClearBytesValue()
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+		m.ClearLongs()
+		m.ClearBlobs()
+		for i := 0; i < m.InnersSize(); i++ {
+			m.inners[i].Clear()
+		}
+		m.xxx_LenInners = 0
+
+		m.ClearNamed()
+		m.ClearText()
+		m.nested.Clear()
+		m.xxx_IsNestedSet = false
+
+		m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+	}
+}
+
+type Outer_NamedEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            *Inner
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Outer_NamedEntry) Reset()         { *m = Outer_NamedEntry{} }
+func (m *Outer_NamedEntry) String() string { return proto.CompactTextString(m) }
+func (*Outer_NamedEntry) ProtoMessage()    {}
+
+func (m *Outer_NamedEntry) GetKey() string {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Outer_NamedEntry) GetValue() *Inner {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Outer_NamedEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Outer_NamedEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Outer_NamedEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer_NamedEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+		m.key = ""
+	}
+}
+
+func (m *Outer_NamedEntry) MutateValue() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Inner)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Outer_NamedEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer_NamedEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Outer_NamedEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Inner) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovClone(uint64(l))
+	}
+	if m.xxx_IsNumberSet {
+		n += 1 + sovClone(uint64(uint32(m.number)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Outer) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsIntValueSet {
+		n += 1 + sovClone(uint64(uint32(m.intValue)))
+	}
+	if m.xxx_IsBytesValueSet {
+		l = len(m.bytesValue)
+		n += 1 + l + sovClone(uint64(l))
+	}
+	if m.xxx_IsInnerSet {
+		l = m.inner.Size()
+		n += 1 + l + sovClone(uint64(l))
+	}
+	if m.xxx_LenLongs > 0 {
+		for i := 0; i < m.xxx_LenLongs; i++ {
+			e := m.longs[i]
+			n += 1 + sovClone(uint64(e))
+		}
+	}
+	if m.xxx_LenBlobs > 0 {
+		for i := 0; i < m.xxx_LenBlobs; i++ {
+			b := m.blobs[i]
+			l = len(b)
+			n += 1 + l + sovClone(uint64(l))
+		}
+	}
+	if m.xxx_LenInners > 0 {
+		for i := 0; i < m.xxx_LenInners; i++ {
+			e := m.inners[i]
+			l = e.Size()
+			n += 1 + l + sovClone(uint64(l))
+		}
+	}
+	if len(m.named) > 0 {
+		for k, v := range m.named {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovClone(uint64(len(k))) + 1 + l + sovClone(uint64(l))
+			n += 1 + mapEntrySize + sovClone(uint64(mapEntrySize))
+		}
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		l = len(m.text)
+		n += 1 + l + sovClone(uint64(l))
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		l = m.nested.Size()
+		n += 1 + l + sovClone(uint64(l))
+	}
+	if m.XXX_extensions != nil {
+		n += proto.SizeOfExtensionMap(m.XXX_extensions)
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Outer_NamedEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovClone(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovClone(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovClone(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozClone(x uint64) (n int) {
+	return sovClone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Inner) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Inner) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Inner) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClone(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsNumberSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintClone(data, i, uint64(uint32(m.number)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Outer) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Outer) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Outer) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsIntValueSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintClone(data, i, uint64(uint32(m.intValue)))
+	}
+	if m.xxx_IsBytesValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClone(data, i, uint64(len(m.bytesValue)))
+		i += copy(data[i:], m.bytesValue)
+	}
+	if m.xxx_IsInnerSet {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintClone(data, i, uint64(m.inner.SizeCached()))
+		n1, err := m.inner.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_LenLongs > 0 {
+		for idx := 0; idx < m.xxx_LenLongs; idx++ {
+			num := m.longs[idx]
+			data[i] = 0x20
+			i++
+			i = encodeVarintClone(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenBlobs > 0 {
+		for idx := 0; idx < m.xxx_LenBlobs; idx++ {
+			b := m.blobs[idx]
+			data[i] = 0x2a
+			i++
+			i = encodeVarintClone(data, i, uint64(len(b)))
+			i += copy(data[i:], b)
+		}
+	}
+	if m.xxx_LenInners > 0 {
+		for idx := 0; idx < m.xxx_LenInners; idx++ {
+			msg := m.inners[idx]
+			data[i] = 0x32
+			i++
+			i = encodeVarintClone(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.named) > 0 {
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			data[i] = 0x3a
+			i++
+			mapEntrySize := 1 + len(k) + sovClone(uint64(len(k))) + 1 + v.SizeCached() + sovClone(uint64(v.SizeCached()))
+			i = encodeVarintClone(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintClone(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintClone(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		data[i] = 0x42
+		i++
+		i = encodeVarintClone(data, i, uint64(len(m.text)))
+		i += copy(data[i:], m.text)
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintClone(data, i, uint64(m.nested.SizeCached()))
+		n2, err := m.nested.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.XXX_extensions) > 0 {
+		n, err := proto.EncodeExtensionMap(m.XXX_extensions, data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Outer_NamedEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Outer_NamedEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Outer_NamedEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintClone(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintClone(data, i, uint64(m.value.SizeCached()))
+		n3, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Clone(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Clone(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintClone(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Inner) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field number", wireType)
+			}
+			m.xxx_IsNumberSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.number |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Outer) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field intValue", wireType)
+			}
+			m.xxx_IsIntValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.intValue |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field bytesValue", wireType)
+			}
+			m.xxx_IsBytesValueSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.bytesValue = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field inner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateInner()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.longs) <= m.xxx_LenLongs {
+						newCapacity := 0
+						if len(m.longs) == 0 {
+							newCapacity = 8
+						} else if len(m.longs) < 1000000 {
+							newCapacity = m.xxx_LenLongs * 2
+						} else {
+							newCapacity = m.xxx_LenLongs + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.longs)
+						m.longs = t
+					}
+					m.longs[m.xxx_LenLongs] = int64(v)
+					m.xxx_LenLongs += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.longs) <= m.xxx_LenLongs {
+					newCapacity := 0
+					if len(m.longs) == 0 {
+						newCapacity = 8
+					} else if len(m.longs) < 1000000 {
+						newCapacity = m.xxx_LenLongs * 2
+					} else {
+						newCapacity = m.xxx_LenLongs + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.longs)
+					m.longs = t
+				}
+				m.longs[m.xxx_LenLongs] = int64(v)
+				m.xxx_LenLongs += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field longs", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field blobs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.blobs) <= m.xxx_LenBlobs {
+				newCapacity := 0
+				if len(m.blobs) == 0 {
+					newCapacity = 8
+				} else if len(m.blobs) < 1000000 {
+					newCapacity = m.xxx_LenBlobs * 2
+				} else {
+					newCapacity = m.xxx_LenBlobs + 1000000
+				}
+				t := make([][]byte, newCapacity, newCapacity)
+				copy(t, m.blobs)
+				m.blobs = t
+			}
+			m.blobs[m.xxx_LenBlobs] = append([]byte{}, data[index:postIndex]...)
+			m.xxx_LenBlobs += 1
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field inners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddInners()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field named", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Outer_NamedEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.named == nil {
+				m.named = make(map[string]*Inner)
+			}
+			if entry.value == nil {
+				entry.value = new(Inner)
+			}
+			m.named[entry.key] = entry.value
+			index = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field text", wireType)
+			}
+			if m.xxx_ChoiceCase != Outer_ChoiceCase_Text {
+				m.ClearChoice()
+				m.xxx_ChoiceCase = Outer_ChoiceCase_Text
+			}
+			m.xxx_IsTextSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.text = string(data[index:postIndex])
+			index = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateNested()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			if (fieldNum >= 100) && (fieldNum < 200) {
+				var sizeOfWire int
+				for {
+					sizeOfWire++
+					wire >>= 7
+					if wire == 0 {
+						break
+					}
+				}
+				index -= sizeOfWire
+				skippy, err := proto.Skip(data[index:])
+				if err != nil {
+					return err
+				}
+				if (index + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				if m.XXX_extensions == nil {
+					m.XXX_extensions = make(map[int32]proto.Extension)
+				}
+				m.XXX_extensions[int32(fieldNum)] = proto.NewExtension(data[index : index+skippy])
+				index += skippy
+			} else {
+				var sizeOfWire int
+				for {
+					sizeOfWire++
+					wire >>= 7
+					if wire == 0 {
+						break
+					}
+				}
+				index -= sizeOfWire
+				skippy, err := proto.Skip(data[index:])
+				if err != nil {
+					return err
+				}
+				if (index + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+				index += skippy
+			}
+		}
+	}
+	return nil
+}
+func (m *Outer_NamedEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Inner) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Inner) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Outer) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Outer) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Outer_NamedEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Outer_NamedEntry) CheckInitialized() error {
+	return nil
+}
+
+func (m *Inner) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_IsNumberSet || w.EmitDefaults() {
+		w.Field("number", "number")
+		w.Int32(m.GetNumber())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Inner) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("number", "number"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetNumber(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Inner) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Inner) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Outer) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsIntValueSet || w.EmitDefaults() {
+		w.Field("intValue", "int_value")
+		w.Int32(m.GetIntValue())
+	}
+	if m.xxx_IsBytesValueSet || w.EmitDefaults() {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.GetBytesValue())
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner", "inner")
+		w.Message(m.GetInner())
+	} else if w.EmitDefaults() {
+		w.Field("inner", "inner")
+		w.Null()
+	}
+	if m.xxx_LenLongs > 0 || w.EmitDefaults() {
+		w.Field("longs", "longs")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenLongs; i++ {
+			w.Int64(m.longs[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenBlobs > 0 || w.EmitDefaults() {
+		w.Field("blobs", "blobs")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenBlobs; i++ {
+			w.Base64(m.blobs[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenInners > 0 || w.EmitDefaults() {
+		w.Field("inners", "inners")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenInners; i++ {
+			w.Message(m.inners[i])
+		}
+		w.EndArray()
+	}
+	if len(m.named) > 0 || w.EmitDefaults() {
+		w.Field("named", "named")
+		w.BeginObject()
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(k)
+			w.Message(m.named[k])
+		}
+		w.EndObject()
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		w.Field("text", "text")
+		w.String(m.GetText())
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		w.Field("nested", "nested")
+		w.Message(m.GetNested())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Outer) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("intValue", "int_value"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetIntValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("bytesValue", "bytes_value"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetBytesValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("inner", "inner"); ok {
+		v, err := m.MutateInner()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("longs", "longs"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddLongs(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("blobs", "blobs"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Base64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddBlobs(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("inners", "inners"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddInners()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("named", "named"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v := new(Inner)
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+			if err := m.PutNamed(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("text", "text"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetText(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("nested", "nested"); ok {
+		v, err := m.MutateNested()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Outer) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Outer) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Outer_NamedEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Outer_NamedEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Outer_NamedEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Outer_NamedEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Inner) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_IsNumberSet {
+		w.Field("number")
+		w.Value(m.number)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Inner) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "number":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNumber(v)
+	}
+	return false, nil
+}
+
+func (m *Outer) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsIntValueSet {
+		w.Field("int_value")
+		w.Value(m.intValue)
+	}
+	if m.xxx_IsBytesValueSet {
+		w.Field("bytes_value")
+		w.Value(m.bytesValue)
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner")
+		w.Message(m.inner)
+	}
+	for i := 0; i < m.xxx_LenLongs; i++ {
+		w.Field("longs")
+		w.Value(m.longs[i])
+	}
+	for i := 0; i < m.xxx_LenBlobs; i++ {
+		w.Field("blobs")
+		w.Value(m.blobs[i])
+	}
+	for i := 0; i < m.xxx_LenInners; i++ {
+		w.Field("inners")
+		w.Message(m.inners[i])
+	}
+	if len(m.named) > 0 {
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			w.Field("named")
+			w.Message(&Outer_NamedEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		w.Field("text")
+		w.Value(m.text)
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		w.Field("nested")
+		w.Message(m.nested)
+	}
+	w.Extensions(m)
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Outer) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "int_value":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetIntValue(v)
+	case "bytes_value":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetBytesValue(v)
+	case "inner":
+		v, err := m.MutateInner()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "longs":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddLongs(v)
+	case "blobs":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddBlobs(v)
+	case "inners":
+		v, err := m.AddInners()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "named":
+		entry := &Outer_NamedEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.named == nil {
+			m.named = make(map[string]*Inner)
+		}
+		if entry.value == nil {
+			entry.value = new(Inner)
+		}
+		m.named[entry.key] = entry.value
+		return true, nil
+	case "text":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetText(v)
+	case "nested":
+		v, err := m.MutateNested()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Outer_NamedEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Outer_NamedEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Inner) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Inner{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Inner) MergeFrom(src proto.Message) {
+	s, ok := src.(*Inner)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsNumberSet {
+		m.SetNumber(s.number)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Inner) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Inner)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsNumberSet != o.xxx_IsNumberSet {
+		return false
+	}
+	if m.xxx_IsNumberSet && m.number != o.number {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Outer) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Outer{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Outer) MergeFrom(src proto.Message) {
+	s, ok := src.(*Outer)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsIntValueSet {
+		m.SetIntValue(s.intValue)
+	}
+	if s.xxx_IsBytesValueSet {
+		m.SetBytesValue(append([]byte{}, s.bytesValue...))
+	}
+	if s.xxx_IsInnerSet {
+		v, _ := m.MutateInner()
+		v.MergeFrom(s.inner)
+	}
+	for i := 0; i < s.xxx_LenLongs; i++ {
+		m.AddLongs(s.longs[i])
+	}
+	for i := 0; i < s.xxx_LenBlobs; i++ {
+		m.AddBlobs(append([]byte{}, s.blobs[i]...))
+	}
+	for i := 0; i < s.xxx_LenInners; i++ {
+		v, _ := m.AddInners()
+		v.MergeFrom(s.inners[i])
+	}
+	if len(s.named) > 0 {
+		if m.named == nil {
+			m.named = make(map[string]*Inner, len(s.named))
+		}
+		for k, v := range s.named {
+			m.named[k] = v.Clone().(*Inner)
+		}
+	}
+	if s.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		m.SetText(s.text)
+	}
+	if s.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		v, _ := m.MutateNested()
+		v.MergeFrom(s.nested)
+	}
+	proto.MergeExtensions(m, s)
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Outer) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Outer)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsIntValueSet != o.xxx_IsIntValueSet {
+		return false
+	}
+	if m.xxx_IsIntValueSet && m.intValue != o.intValue {
+		return false
+	}
+	if m.xxx_IsBytesValueSet != o.xxx_IsBytesValueSet {
+		return false
+	}
+	if m.xxx_IsBytesValueSet && !bytes.Equal(m.bytesValue, o.bytesValue) {
+		return false
+	}
+	if m.xxx_IsInnerSet != o.xxx_IsInnerSet {
+		return false
+	}
+	if m.xxx_IsInnerSet && !m.inner.Equal(o.inner) {
+		return false
+	}
+	if m.xxx_LenLongs != o.xxx_LenLongs {
+		return false
+	}
+	for i := 0; i < m.xxx_LenLongs; i++ {
+		if m.longs[i] != o.longs[i] {
+			return false
+		}
+	}
+	if m.xxx_LenBlobs != o.xxx_LenBlobs {
+		return false
+	}
+	for i := 0; i < m.xxx_LenBlobs; i++ {
+		if !bytes.Equal(m.blobs[i], o.blobs[i]) {
+			return false
+		}
+	}
+	if m.xxx_LenInners != o.xxx_LenInners {
+		return false
+	}
+	for i := 0; i < m.xxx_LenInners; i++ {
+		if !m.inners[i].Equal(o.inners[i]) {
+			return false
+		}
+	}
+	if len(m.named) != len(o.named) {
+		return false
+	}
+	for k, v := range m.named {
+		if v2, ok := o.named[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Text) != (o.xxx_ChoiceCase == Outer_ChoiceCase_Text) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Text) && m.text != o.text {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Nested) != (o.xxx_ChoiceCase == Outer_ChoiceCase_Nested) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Nested) && !m.nested.Equal(o.nested) {
+		return false
+	}
+	if !proto.EqualExtensions(m, o) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Outer_NamedEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Outer_NamedEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Outer_NamedEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Outer_NamedEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Outer_NamedEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Outer_NamedEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionInner = &proto.MessageInfo{
+	Name: "clone.Inner",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Inner)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Inner).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearName()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "number",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetNumber(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetNumber()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "number", v)
+				}
+				x := m.(*Inner)
+				return x.SetNumber(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Inner).HasNumber()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearNumber()
+			},
+		},
+	},
+}
+
+func (m *Inner) ProtoReflect() *proto.MessageInfo {
+	return reflectionInner
+}
+
+var reflectionOuter = &proto.MessageInfo{
+	Name: "clone.Outer",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "int_value",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetIntValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetIntValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "int_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetIntValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasIntValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearIntValue()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "bytes_value",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetBytesValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetBytesValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "bytes_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetBytesValue(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasBytesValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearBytesValue()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "inner",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetInner()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "inner", v)
+				}
+				x := m.(*Outer)
+				x.ClearInner()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateInner()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasInner()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearInner()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "longs",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([]int64, x.xxx_LenLongs)
+				copy(v, x.longs)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "longs", v)
+				}
+				x := m.(*Outer)
+				x.ClearLongs()
+				for _, e := range value {
+					if err := x.AddLongs(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).LongsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearLongs()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "blobs",
+			Kind:   proto.BytesKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([][]byte, x.xxx_LenBlobs)
+				copy(v, x.blobs)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([][]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "blobs", v)
+				}
+				x := m.(*Outer)
+				x.ClearBlobs()
+				for _, e := range value {
+					if err := x.AddBlobs(append([]byte{}, e...)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).BlobsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearBlobs()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "inners",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([]*Inner, x.xxx_LenInners)
+				copy(v, x.inners)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "inners", v)
+				}
+				x := m.(*Outer)
+				x.ClearInners()
+				for _, e := range value {
+					field, err := x.AddInners()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).InnersSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearInners()
+			},
+		},
+		{
+			Number: 7,
+			Name:   "named",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make(map[string]*Inner, x.NamedLen())
+				x.RangeNamed(func(key string, value *Inner) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "named", v)
+				}
+				x := m.(*Outer)
+				x.ClearNamed()
+				for k, e := range value {
+					if err := x.PutNamed(k, e.Clone().(*Inner)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).NamedLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearNamed()
+			},
+		},
+		{
+			Number:  8,
+			Name:    "text",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "choice",
+			Default: (*Outer)(nil).GetText(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetText()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "text", v)
+				}
+				x := m.(*Outer)
+				return x.SetText(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasText()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearText()
+			},
+		},
+		{
+			Number: 9,
+			Name:   "nested",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			Oneof:  "choice",
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetNested()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "nested", v)
+				}
+				x := m.(*Outer)
+				x.ClearNested()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateNested()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasNested()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearNested()
+			},
+		},
+	},
+}
+
+func (m *Outer) ProtoReflect() *proto.MessageInfo {
+	return reflectionOuter
+}
+
+var reflectionOuter_NamedEntry = &proto.MessageInfo{
+	Name: "clone.Outer.NamedEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer_NamedEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer_NamedEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Outer_NamedEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer_NamedEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer_NamedEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer_NamedEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Outer_NamedEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer_NamedEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer_NamedEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Outer_NamedEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionOuter_NamedEntry
+}
+
+var E_Extra = &proto.ExtensionDesc{
+	ExtendedType:  (*Outer)(nil),
+	ExtensionType: (*int32)(nil),
+	Field:         100,
+	Name:          "clone.extra",
+}
+
+func init() {
+	proto.RegisterType((*Inner)(nil), "clone.Inner")
+	proto.RegisterType((*Outer)(nil), "clone.Outer")
+	proto.RegisterExtension(E_Extra)
+}
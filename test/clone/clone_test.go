@@ -0,0 +1,192 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package clone
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newOuter() *Outer {
+	m := &Outer{}
+	m.SetIntValue(1)
+	m.SetBytesValue([]byte("abc"))
+	inner, _ := m.MutateInner()
+	inner.SetName("in")
+	m.AddLongs(2)
+	m.AddLongs(3)
+	m.AddBlobs([]byte("blob"))
+	inner, _ = m.AddInners()
+	inner.SetNumber(4)
+	named := &Inner{}
+	named.SetName("n")
+	m.PutNamed("a", named)
+	m.SetText("text")
+	if err := proto.SetExtension(m, E_Extra, proto.Int32(5)); err != nil {
+		panic(err)
+	}
+	m.XXX_unrecognized = []byte{0xf8, 0x01, 0x06}
+	return m
+}
+
+func TestClone(t *testing.T) {
+	m := newOuter()
+	c := proto.Clone(m).(*Outer)
+	if !proto.Equal(m, c) {
+		t.Fatalf("clone %v is not equal to %v", c, m)
+	}
+	if got, want := proto.CompactTextString(c), proto.CompactTextString(m); got != want {
+		t.Fatalf("clone is %q, want %q", got, want)
+	}
+
+	// The clone must not share memory with the original.
+	c.GetBytesValue()[0] = 'x'
+	inner, _ := c.MutateInner()
+	inner.SetName("changed")
+	named, _ := c.GetNamed("a")
+	named.SetName("changed")
+	blob, _ := c.GetBlobs(0)
+	blob[0] = 'x'
+	if !bytes.Equal(m.GetBytesValue(), []byte("abc")) {
+		t.Fatalf("bytes shared with the clone: %q", m.GetBytesValue())
+	}
+	if m.GetInner().GetName() != "in" {
+		t.Fatalf("message shared with the clone: %v", m.GetInner())
+	}
+	if named, _ := m.GetNamed("a"); named.GetName() != "n" {
+		t.Fatalf("map value shared with the clone: %v", named)
+	}
+	if blob, _ := m.GetBlobs(0); string(blob) != "blob" {
+		t.Fatalf("repeated bytes shared with the clone: %q", blob)
+	}
+	if proto.Equal(m, c) {
+		t.Fatalf("modified clone %v is equal to %v", c, m)
+	}
+
+	var nilOuter *Outer
+	if c := proto.Clone(nilOuter).(*Outer); c != nil {
+		t.Fatalf("clone of nil is %v", c)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	dst := &Outer{}
+	dst.SetIntValue(7)
+	inner, _ := dst.MutateInner()
+	inner.SetNumber(8)
+	dst.AddLongs(1)
+	named := &Inner{}
+	named.SetNumber(9)
+	dst.PutNamed("b", named)
+	nested, _ := dst.MutateNested()
+	nested.SetNumber(10)
+
+	proto.Merge(dst, newOuter())
+
+	want := &Outer{}
+	want.SetIntValue(1)
+	want.SetBytesValue([]byte("abc"))
+	inner, _ = want.MutateInner()
+	inner.SetName("in")
+	inner.SetNumber(8)
+	want.AddLongs(1)
+	want.AddLongs(2)
+	want.AddLongs(3)
+	want.AddBlobs([]byte("blob"))
+	inner, _ = want.AddInners()
+	inner.SetNumber(4)
+	named = &Inner{}
+	named.SetNumber(9)
+	want.PutNamed("b", named)
+	named = &Inner{}
+	named.SetName("n")
+	want.PutNamed("a", named)
+	want.SetText("text")
+	if err := proto.SetExtension(want, E_Extra, proto.Int32(5)); err != nil {
+		t.Fatal(err)
+	}
+	want.XXX_unrecognized = []byte{0xf8, 0x01, 0x06}
+	if !proto.Equal(dst, want) {
+		t.Fatalf("merged %v, want %v", dst, want)
+	}
+	if dst.WhichChoice() != Outer_ChoiceCase_Text {
+		t.Fatalf("merged oneof case %v, want text", dst.WhichChoice())
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !proto.Equal(&Outer{}, &Outer{}) {
+		t.Fatalf("empty messages are not equal")
+	}
+	if !proto.Equal(newOuter(), newOuter()) {
+		t.Fatalf("identical messages are not equal")
+	}
+
+	// A field set to its default value is not equal to an unset field.
+	m := &Outer{}
+	m.SetIntValue(0)
+	if proto.Equal(m, &Outer{}) {
+		t.Fatalf("set field is equal to unset field")
+	}
+
+	// Elements past the length of a cleared repeated field are ignored.
+	m1 := &Outer{}
+	m1.AddLongs(1)
+	m1.ClearLongs()
+	if !proto.Equal(m1, &Outer{}) {
+		t.Fatalf("cleared repeated field is not equal to an empty one")
+	}
+
+	modify := []func(m *Outer){
+		func(m *Outer) { m.SetBytesValue([]byte("abd")) },
+		func(m *Outer) { m.AddLongs(4) },
+		func(m *Outer) { m.PutNamed("a", &Inner{}) },
+		func(m *Outer) { m.MutateNested() },
+		func(m *Outer) { proto.SetExtension(m, E_Extra, proto.Int32(6)) },
+		func(m *Outer) { m.XXX_unrecognized = nil },
+	}
+	for i, f := range modify {
+		m := newOuter()
+		f(m)
+		if proto.Equal(m, newOuter()) {
+			t.Fatalf("modification %d: %v is equal to %v", i, m, newOuter())
+		}
+	}
+
+	var nilOuter *Outer
+	if proto.Equal(nilOuter, &Outer{}) {
+		t.Fatalf("nil message is equal to an empty message")
+	}
+	if !proto.Equal(nilOuter, nilOuter) {
+		t.Fatalf("nil messages are not equal")
+	}
+	if proto.Equal(&Outer{}, &Inner{}) {
+		t.Fatalf("messages of different types are equal")
+	}
+}
@@ -0,0 +1,6090 @@
+// Code generated by protoc-gen-dgo.
+// source: dynamic.proto
+// DO NOT EDIT!
+
+/*
+Package dynamic is a generated protocol buffer package.
+
+It is generated from these files:
+
+	dynamic.proto
+
+It has these top-level messages:
+
+	Inner
+	Everything
+*/
+package dynamic
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+import google_protobuf "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Color int32
+
+const (
+	Color_RED   Color = 1
+	Color_GREEN Color = 2
+	Color_BLUE  Color = 3
+)
+
+var Color_name = map[int32]string{
+	1: "RED",
+	2: "GREEN",
+	3: "BLUE",
+}
+var Color_value = map[string]int32{
+	"RED":   1,
+	"GREEN": 2,
+	"BLUE":  3,
+}
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+
+type Inner struct {
+	xxx_sizeCached   int
+	name             string
+	id               int64
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+	xxx_IsIdSet      bool
+}
+
+func (m *Inner) Reset()         { *m = Inner{} }
+func (m *Inner) String() string { return proto.CompactTextString(m) }
+func (*Inner) ProtoMessage()    {}
+
+func (m *Inner) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Inner) GetId() int64 {
+	if m != nil && m.xxx_IsIdSet {
+		return m.id
+	}
+	return 0
+}
+
+func (m *Inner) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Inner) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Inner) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Inner) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Inner) SetId(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIdSet = true
+	m.id = value
+	return nil
+}
+
+func (m *Inner) HasId() (isSet bool) {
+	if m != nil && m.xxx_IsIdSet {
+		return true
+	}
+	return false
+}
+
+func (m *Inner) ClearId() {
+	if m != nil {
+		m.xxx_IsIdSet = false
+	}
+}
+
+func (m *Inner) Clear() {
+	if m != nil {
+		m.ClearName()
+		m.ClearId()
+	}
+}
+
+type Everything struct {
+	xxx_sizeCached     int
+	fDouble            float64
+	fFloat             float32
+	fInt32             int32
+	fInt64             int64
+	fUint32            uint32
+	fUint64            uint64
+	fSint32            int32
+	fSint64            int64
+	fFixed32           uint32
+	fFixed64           uint64
+	fSfixed32          int32
+	fSfixed64          int64
+	fBool              bool
+	fString            string
+	fBytes             []byte
+	color              Color
+	inner              *Inner
+	inners             []*Inner
+	unpacked           []int32
+	packed             []int32
+	names              []string
+	point              *Everything_Point
+	named              map[string]*Inner
+	text               string
+	nested             *Inner
+	answer             int32
+	XXX_extensions     map[int32]proto.Extension
+	XXX_unrecognized   []byte
+	xxx_IsFDoubleSet   bool
+	xxx_IsFFloatSet    bool
+	xxx_IsFInt32Set    bool
+	xxx_IsFInt64Set    bool
+	xxx_IsFUint32Set   bool
+	xxx_IsFUint64Set   bool
+	xxx_IsFSint32Set   bool
+	xxx_IsFSint64Set   bool
+	xxx_IsFFixed32Set  bool
+	xxx_IsFFixed64Set  bool
+	xxx_IsFSfixed32Set bool
+	xxx_IsFSfixed64Set bool
+	xxx_IsFBoolSet     bool
+	xxx_IsFStringSet   bool
+	xxx_IsFBytesSet    bool
+	xxx_IsColorSet     bool
+	xxx_IsInnerSet     bool
+	xxx_LenInners      int
+	xxx_LenUnpacked    int
+	xxx_LenPacked      int
+	xxx_LenNames       int
+	xxx_IsPointSet     bool
+	xxx_IsTextSet      bool
+	xxx_IsNestedSet    bool
+	xxx_IsAnswerSet    bool
+	xxx_ChoiceCase     Everything_ChoiceCase
+}
+
+func (m *Everything) Reset()         { *m = Everything{} }
+func (m *Everything) String() string { return proto.CompactTextString(m) }
+func (*Everything) ProtoMessage()    {}
+
+var extRange_Everything = []proto.ExtensionRange{
+	{Start: 100, End: 199},
+}
+
+func (m *Everything) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_Everything
+}
+func (m *Everything) ExtensionMap() map[int32]proto.Extension {
+	if m.XXX_extensions == nil {
+		m.XXX_extensions = make(map[int32]proto.Extension)
+	}
+	return m.XXX_extensions
+}
+
+const Default_Everything_Color Color = Color_GREEN
+const Default_Everything_Answer int32 = 42
+
+func (m *Everything) GetFDouble() float64 {
+	if m != nil && m.xxx_IsFDoubleSet {
+		return m.fDouble
+	}
+	return 0
+}
+
+func (m *Everything) GetFFloat() float32 {
+	if m != nil && m.xxx_IsFFloatSet {
+		return m.fFloat
+	}
+	return 0
+}
+
+func (m *Everything) GetFInt32() int32 {
+	if m != nil && m.xxx_IsFInt32Set {
+		return m.fInt32
+	}
+	return 0
+}
+
+func (m *Everything) GetFInt64() int64 {
+	if m != nil && m.xxx_IsFInt64Set {
+		return m.fInt64
+	}
+	return 0
+}
+
+func (m *Everything) GetFUint32() uint32 {
+	if m != nil && m.xxx_IsFUint32Set {
+		return m.fUint32
+	}
+	return 0
+}
+
+func (m *Everything) GetFUint64() uint64 {
+	if m != nil && m.xxx_IsFUint64Set {
+		return m.fUint64
+	}
+	return 0
+}
+
+func (m *Everything) GetFSint32() int32 {
+	if m != nil && m.xxx_IsFSint32Set {
+		return m.fSint32
+	}
+	return 0
+}
+
+func (m *Everything) GetFSint64() int64 {
+	if m != nil && m.xxx_IsFSint64Set {
+		return m.fSint64
+	}
+	return 0
+}
+
+func (m *Everything) GetFFixed32() uint32 {
+	if m != nil && m.xxx_IsFFixed32Set {
+		return m.fFixed32
+	}
+	return 0
+}
+
+func (m *Everything) GetFFixed64() uint64 {
+	if m != nil && m.xxx_IsFFixed64Set {
+		return m.fFixed64
+	}
+	return 0
+}
+
+func (m *Everything) GetFSfixed32() int32 {
+	if m != nil && m.xxx_IsFSfixed32Set {
+		return m.fSfixed32
+	}
+	return 0
+}
+
+func (m *Everything) GetFSfixed64() int64 {
+	if m != nil && m.xxx_IsFSfixed64Set {
+		return m.fSfixed64
+	}
+	return 0
+}
+
+func (m *Everything) GetFBool() bool {
+	if m != nil && m.xxx_IsFBoolSet {
+		return m.fBool
+	}
+	return false
+}
+
+func (m *Everything) GetFString() string {
+	if m != nil && m.xxx_IsFStringSet {
+		return m.fString
+	}
+	return ""
+}
+
+func (m *Everything) GetFBytes() []byte {
+	if m != nil && m.xxx_IsFBytesSet {
+		return m.fBytes
+	}
+	return nil
+}
+func (m *Everything) GetColor() Color {
+	if m != nil && m.xxx_IsColorSet {
+		return m.color
+	}
+	return Default_Everything_Color
+}
+
+func (m *Everything) GetInner() *Inner {
+	if m != nil && m.xxx_IsInnerSet {
+		return m.inner
+	}
+	return nil
+}
+func (m *Everything) GetPoint() *Everything_Point {
+	if m != nil && m.xxx_IsPointSet {
+		return m.point
+	}
+	return nil
+}
+func (m *Everything) GetText() string {
+	if m != nil && m.xxx_IsTextSet {
+		return m.text
+	}
+	return ""
+}
+
+func (m *Everything) GetNested() *Inner {
+	if m != nil && m.xxx_IsNestedSet {
+		return m.nested
+	}
+	return nil
+}
+func (m *Everything) GetAnswer() int32 {
+	if m != nil && m.xxx_IsAnswerSet {
+		return m.answer
+	}
+	return Default_Everything_Answer
+}
+
+func (m *Everything) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Everything_ChoiceCase int32
+
+const (
+	Everything_ChoiceCase_NotSet Everything_ChoiceCase = 0
+	Everything_ChoiceCase_Text   Everything_ChoiceCase = 26
+	Everything_ChoiceCase_Nested Everything_ChoiceCase = 27
+)
+
+func (m *Everything) WhichChoice() Everything_ChoiceCase {
+	if m != nil {
+		return m.xxx_ChoiceCase
+	}
+	return Everything_ChoiceCase_NotSet
+}
+
+func (m *Everything) ClearChoice() {
+	if m != nil {
+		switch m.xxx_ChoiceCase {
+		case Everything_ChoiceCase_Text:
+			m.ClearText()
+		case Everything_ChoiceCase_Nested:
+			m.ClearNested()
+		}
+		m.xxx_ChoiceCase = Everything_ChoiceCase_NotSet
+	}
+}
+
+func (m *Everything) SetFDouble(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFDoubleSet = true
+	m.fDouble = value
+	return nil
+}
+
+func (m *Everything) HasFDouble() (isSet bool) {
+	if m != nil && m.xxx_IsFDoubleSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFDouble() {
+	if m != nil {
+		m.xxx_IsFDoubleSet = false
+	}
+}
+
+func (m *Everything) SetFFloat(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFFloatSet = true
+	m.fFloat = value
+	return nil
+}
+
+func (m *Everything) HasFFloat() (isSet bool) {
+	if m != nil && m.xxx_IsFFloatSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFFloat() {
+	if m != nil {
+		m.xxx_IsFFloatSet = false
+	}
+}
+
+func (m *Everything) SetFInt32(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFInt32Set = true
+	m.fInt32 = value
+	return nil
+}
+
+func (m *Everything) HasFInt32() (isSet bool) {
+	if m != nil && m.xxx_IsFInt32Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFInt32() {
+	if m != nil {
+		m.xxx_IsFInt32Set = false
+	}
+}
+
+func (m *Everything) SetFInt64(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFInt64Set = true
+	m.fInt64 = value
+	return nil
+}
+
+func (m *Everything) HasFInt64() (isSet bool) {
+	if m != nil && m.xxx_IsFInt64Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFInt64() {
+	if m != nil {
+		m.xxx_IsFInt64Set = false
+	}
+}
+
+func (m *Everything) SetFUint32(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFUint32Set = true
+	m.fUint32 = value
+	return nil
+}
+
+func (m *Everything) HasFUint32() (isSet bool) {
+	if m != nil && m.xxx_IsFUint32Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFUint32() {
+	if m != nil {
+		m.xxx_IsFUint32Set = false
+	}
+}
+
+func (m *Everything) SetFUint64(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFUint64Set = true
+	m.fUint64 = value
+	return nil
+}
+
+func (m *Everything) HasFUint64() (isSet bool) {
+	if m != nil && m.xxx_IsFUint64Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFUint64() {
+	if m != nil {
+		m.xxx_IsFUint64Set = false
+	}
+}
+
+func (m *Everything) SetFSint32(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFSint32Set = true
+	m.fSint32 = value
+	return nil
+}
+
+func (m *Everything) HasFSint32() (isSet bool) {
+	if m != nil && m.xxx_IsFSint32Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFSint32() {
+	if m != nil {
+		m.xxx_IsFSint32Set = false
+	}
+}
+
+func (m *Everything) SetFSint64(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFSint64Set = true
+	m.fSint64 = value
+	return nil
+}
+
+func (m *Everything) HasFSint64() (isSet bool) {
+	if m != nil && m.xxx_IsFSint64Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFSint64() {
+	if m != nil {
+		m.xxx_IsFSint64Set = false
+	}
+}
+
+func (m *Everything) SetFFixed32(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFFixed32Set = true
+	m.fFixed32 = value
+	return nil
+}
+
+func (m *Everything) HasFFixed32() (isSet bool) {
+	if m != nil && m.xxx_IsFFixed32Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFFixed32() {
+	if m != nil {
+		m.xxx_IsFFixed32Set = false
+	}
+}
+
+func (m *Everything) SetFFixed64(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFFixed64Set = true
+	m.fFixed64 = value
+	return nil
+}
+
+func (m *Everything) HasFFixed64() (isSet bool) {
+	if m != nil && m.xxx_IsFFixed64Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFFixed64() {
+	if m != nil {
+		m.xxx_IsFFixed64Set = false
+	}
+}
+
+func (m *Everything) SetFSfixed32(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFSfixed32Set = true
+	m.fSfixed32 = value
+	return nil
+}
+
+func (m *Everything) HasFSfixed32() (isSet bool) {
+	if m != nil && m.xxx_IsFSfixed32Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFSfixed32() {
+	if m != nil {
+		m.xxx_IsFSfixed32Set = false
+	}
+}
+
+func (m *Everything) SetFSfixed64(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFSfixed64Set = true
+	m.fSfixed64 = value
+	return nil
+}
+
+func (m *Everything) HasFSfixed64() (isSet bool) {
+	if m != nil && m.xxx_IsFSfixed64Set {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFSfixed64() {
+	if m != nil {
+		m.xxx_IsFSfixed64Set = false
+	}
+}
+
+func (m *Everything) SetFBool(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFBoolSet = true
+	m.fBool = value
+	return nil
+}
+
+func (m *Everything) HasFBool() (isSet bool) {
+	if m != nil && m.xxx_IsFBoolSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFBool() {
+	if m != nil {
+		m.xxx_IsFBoolSet = false
+	}
+}
+
+func (m *Everything) SetFString(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFStringSet = true
+	m.fString = value
+	return nil
+}
+
+func (m *Everything) HasFString() (isSet bool) {
+	if m != nil && m.xxx_IsFStringSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFString() {
+	if m != nil {
+		m.xxx_IsFStringSet = false
+		m.fString = ""
+	}
+}
+
+func (m *Everything) SetFBytes(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.xxx_IsFBytesSet = true
+	m.fBytes = value
+	return nil
+}
+
+func (m *Everything) HasFBytes() (isSet bool) {
+	if m != nil && m.xxx_IsFBytesSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearFBytes() {
+	if m != nil {
+		m.xxx_IsFBytesSet = false
+		m.fBytes = nil
+	}
+}
+
+func (m *Everything) SetColor(value Color) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsColorSet = true
+	m.color = value
+	return nil
+}
+
+func (m *Everything) HasColor() (isSet bool) {
+	if m != nil && m.xxx_IsColorSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearColor() {
+	if m != nil {
+		m.xxx_IsColorSet = false
+	}
+}
+
+func (m *Everything) MutateInner() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsInnerSet {
+		m.xxx_IsInnerSet = true
+		if m.inner == nil {
+			m.inner = new(Inner)
+		} else {
+			m.inner.Clear()
+		}
+	}
+	return m.inner, nil
+}
+
+func (m *Everything) HasInner() (isSet bool) {
+	if m != nil && m.xxx_IsInnerSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearInner() {
+	if m != nil {
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+	}
+}
+
+func (m *Everything) AddInners() (field *Inner, err error) {
+	if m != nil {
+		if len(m.inners) <= m.xxx_LenInners {
+			newCapacity := 0
+			if len(m.inners) == 0 {
+				newCapacity = 8
+			} else if len(m.inners) < 1000000 {
+				newCapacity = m.xxx_LenInners * 2
+			} else {
+				newCapacity = m.xxx_LenInners + 1000000
+			}
+			t := make([]*Inner, newCapacity, newCapacity)
+			copy(t, m.inners)
+			m.inners = t
+		}
+		field = m.inners[m.xxx_LenInners]
+		if field == nil {
+			field = new(Inner)
+			m.inners[m.xxx_LenInners] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenInners += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Everything) MutateInners(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenInners {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.inners[index] == nil {
+		m.inners[index] = new(Inner)
+	}
+	return m.inners[index], nil
+}
+
+func (m *Everything) InnersSize() (size int) {
+	if m != nil {
+		return m.xxx_LenInners
+	}
+	return 0
+}
+
+func (m *Everything) ClearInners() {
+	if m != nil {
+		for i := 0; i < m.InnersSize(); i++ {
+			m.inners[i].Clear()
+		}
+		m.xxx_LenInners = 0
+
+	}
+}
+
+func (m *Everything) GetInners(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenInners {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.inners[index], nil
+}
+
+func (m *Everything) AddUnpacked(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.unpacked) <= m.xxx_LenUnpacked {
+		newCapacity := 0
+		if len(m.unpacked) == 0 {
+			newCapacity = 8
+		} else if len(m.unpacked) < 1000000 {
+			newCapacity = m.xxx_LenUnpacked * 2
+		} else {
+			newCapacity = m.xxx_LenUnpacked + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.unpacked)
+		m.unpacked = t
+	}
+	m.unpacked[m.xxx_LenUnpacked] = value
+	m.xxx_LenUnpacked += 1
+	return nil
+}
+
+func (m *Everything) SetUnpacked(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenUnpacked {
+		return errors.New("Index is out of bounds")
+	}
+	m.unpacked[index] = value
+	return nil
+}
+
+func (m *Everything) UnpackedSize() (size int) {
+	if m != nil {
+		return m.xxx_LenUnpacked
+	}
+	return 0
+}
+
+func (m *Everything) ClearUnpacked() {
+	if m != nil {
+		m.xxx_LenUnpacked = 0
+	}
+}
+
+func (m *Everything) GetUnpacked(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenUnpacked {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.unpacked[index], nil
+}
+
+func (m *Everything) AddPacked(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.packed) <= m.xxx_LenPacked {
+		newCapacity := 0
+		if len(m.packed) == 0 {
+			newCapacity = 8
+		} else if len(m.packed) < 1000000 {
+			newCapacity = m.xxx_LenPacked * 2
+		} else {
+			newCapacity = m.xxx_LenPacked + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.packed)
+		m.packed = t
+	}
+	m.packed[m.xxx_LenPacked] = value
+	m.xxx_LenPacked += 1
+	return nil
+}
+
+func (m *Everything) SetPacked(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPacked {
+		return errors.New("Index is out of bounds")
+	}
+	m.packed[index] = value
+	return nil
+}
+
+func (m *Everything) PackedSize() (size int) {
+	if m != nil {
+		return m.xxx_LenPacked
+	}
+	return 0
+}
+
+func (m *Everything) ClearPacked() {
+	if m != nil {
+		m.xxx_LenPacked = 0
+	}
+}
+
+func (m *Everything) GetPacked(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPacked {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.packed[index], nil
+}
+
+func (m *Everything) AddNames(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.names) <= m.xxx_LenNames {
+		newCapacity := 0
+		if len(m.names) == 0 {
+			newCapacity = 8
+		} else if len(m.names) < 1000000 {
+			newCapacity = m.xxx_LenNames * 2
+		} else {
+			newCapacity = m.xxx_LenNames + 1000000
+		}
+		t := make([]string, newCapacity, newCapacity)
+		copy(t, m.names)
+		m.names = t
+	}
+	m.names[m.xxx_LenNames] = value
+	m.xxx_LenNames += 1
+	return nil
+}
+
+func (m *Everything) SetNames(value string, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenNames {
+		return errors.New("Index is out of bounds")
+	}
+	m.names[index] = value
+	return nil
+}
+
+func (m *Everything) NamesSize() (size int) {
+	if m != nil {
+		return m.xxx_LenNames
+	}
+	return 0
+}
+
+func (m *Everything) ClearNames() {
+	if m != nil {
+		m.xxx_LenNames = 0
+	}
+}
+
+func (m *Everything) GetNames(index int) (field string, err error) {
+	if m == nil {
+		return "", errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenNames {
+		return "", errors.New("Index is out of bounds")
+	}
+	return m.names[index], nil
+}
+
+func (m *Everything) MutatePoint() (field *Everything_Point, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsPointSet {
+		m.xxx_IsPointSet = true
+		if m.point == nil {
+			m.point = new(Everything_Point)
+		} else {
+			m.point.Clear()
+		}
+	}
+	return m.point, nil
+}
+
+func (m *Everything) HasPoint() (isSet bool) {
+	if m != nil && m.xxx_IsPointSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearPoint() {
+	if m != nil {
+		m.point.Clear()
+		m.xxx_IsPointSet = false
+
+	}
+}
+
+func (m *Everything) GetNamed(key string) (value *Inner, ok bool) {
+	if m != nil {
+		value, ok = m.named[key]
+	}
+	return value, ok
+}
+
+func (m *Everything) PutNamed(key string, value *Inner) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.named == nil {
+		m.named = make(map[string]*Inner)
+	}
+	m.named[key] = value
+	return nil
+}
+
+func (m *Everything) DeleteNamed(key string) {
+	if m != nil {
+		delete(m.named, key)
+	}
+}
+
+func (m *Everything) NamedLen() (size int) {
+	if m != nil {
+		return len(m.named)
+	}
+	return 0
+}
+
+func (m *Everything) RangeNamed(f func(key string, value *Inner) bool) {
+	if m != nil {
+		for k, v := range m.named {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Everything) ClearNamed() {
+	if m != nil {
+		m.named = nil
+	}
+}
+
+func (m *Everything) SetText(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ChoiceCase != Everything_ChoiceCase_Text {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Everything_ChoiceCase_Text
+	}
+	m.xxx_IsTextSet = true
+	m.text = value
+	return nil
+}
+
+func (m *Everything) HasText() (isSet bool) {
+	if m != nil && m.xxx_IsTextSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearText() {
+	if m != nil {
+		m.xxx_IsTextSet = false
+		m.text = ""
+		if m.xxx_ChoiceCase == Everything_ChoiceCase_Text {
+			m.xxx_ChoiceCase = Everything_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Everything) MutateNested() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if m.xxx_ChoiceCase != Everything_ChoiceCase_Nested {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Everything_ChoiceCase_Nested
+	}
+	if !m.xxx_IsNestedSet {
+		m.xxx_IsNestedSet = true
+		if m.nested == nil {
+			m.nested = new(Inner)
+		} else {
+			m.nested.Clear()
+		}
+	}
+	return m.nested, nil
+}
+
+func (m *Everything) HasNested() (isSet bool) {
+	if m != nil && m.xxx_IsNestedSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearNested() {
+	if m != nil {
+		m.nested.Clear()
+		m.xxx_IsNestedSet = false
+
+		if m.xxx_ChoiceCase == Everything_ChoiceCase_Nested {
+			m.xxx_ChoiceCase = Everything_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Everything) SetAnswer(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsAnswerSet = true
+	m.answer = value
+	return nil
+}
+
+func (m *Everything) HasAnswer() (isSet bool) {
+	if m != nil && m.xxx_IsAnswerSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything) ClearAnswer() {
+	if m != nil {
+		m.xxx_IsAnswerSet = false
+	}
+}
+
+func (m *Everything) Clear() {
+	if m != nil {
+		m.ClearFDouble()
+		m.ClearFFloat()
+		m.ClearFInt32()
+		m.ClearFInt64()
+		m.ClearFUint32()
+		m.ClearFUint64()
+		m.ClearFSint32()
+		m.ClearFSint64()
+		m.ClearFFixed32()
+		m.ClearFFixed64()
+		m.ClearFSfixed32()
+		m.ClearFSfixed64()
+		m.ClearFBool()
+		m.ClearFString()
+		m.ClearFBytes()
+		m.ClearColor()
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+		for i := 0; i < m.InnersSize(); i++ {
+			m.inners[i].Clear()
+		}
+		m.xxx_LenInners = 0
+
+		m.ClearUnpacked()
+		m.ClearPacked()
+		m.ClearNames()
+		m.point.Clear()
+		m.xxx_IsPointSet = false
+
+		m.ClearNamed()
+		m.ClearText()
+		m.nested.Clear()
+		m.xxx_IsNestedSet = false
+
+		m.ClearAnswer()
+		m.xxx_ChoiceCase = Everything_ChoiceCase_NotSet
+	}
+}
+
+type Everything_Point struct {
+	xxx_sizeCached   int
+	x                int32
+	y                int32
+	XXX_unrecognized []byte
+	xxx_IsXSet       bool
+	xxx_IsYSet       bool
+}
+
+func (m *Everything_Point) Reset()         { *m = Everything_Point{} }
+func (m *Everything_Point) String() string { return proto.CompactTextString(m) }
+func (*Everything_Point) ProtoMessage()    {}
+
+func (m *Everything_Point) GetX() int32 {
+	if m != nil && m.xxx_IsXSet {
+		return m.x
+	}
+	return 0
+}
+
+func (m *Everything_Point) GetY() int32 {
+	if m != nil && m.xxx_IsYSet {
+		return m.y
+	}
+	return 0
+}
+
+func (m *Everything_Point) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Everything_Point) SetX(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsXSet = true
+	m.x = value
+	return nil
+}
+
+func (m *Everything_Point) HasX() (isSet bool) {
+	if m != nil && m.xxx_IsXSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything_Point) ClearX() {
+	if m != nil {
+		m.xxx_IsXSet = false
+	}
+}
+
+func (m *Everything_Point) SetY(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsYSet = true
+	m.y = value
+	return nil
+}
+
+func (m *Everything_Point) HasY() (isSet bool) {
+	if m != nil && m.xxx_IsYSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything_Point) ClearY() {
+	if m != nil {
+		m.xxx_IsYSet = false
+	}
+}
+
+func (m *Everything_Point) Clear() {
+	if m != nil {
+		m.ClearX()
+		m.ClearY()
+	}
+}
+
+type Everything_NamedEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            *Inner
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Everything_NamedEntry) Reset()         { *m = Everything_NamedEntry{} }
+func (m *Everything_NamedEntry) String() string { return proto.CompactTextString(m) }
+func (*Everything_NamedEntry) ProtoMessage()    {}
+
+func (m *Everything_NamedEntry) GetKey() string {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Everything_NamedEntry) GetValue() *Inner {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Everything_NamedEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Everything_NamedEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Everything_NamedEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything_NamedEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+		m.key = ""
+	}
+}
+
+func (m *Everything_NamedEntry) MutateValue() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Inner)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Everything_NamedEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Everything_NamedEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Everything_NamedEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Inner) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovDynamic(uint64(l))
+	}
+	if m.xxx_IsIdSet {
+		n += 1 + sovDynamic(uint64(m.id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Everything) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsFDoubleSet {
+		n += 9
+	}
+	if m.xxx_IsFFloatSet {
+		n += 5
+	}
+	if m.xxx_IsFInt32Set {
+		n += 1 + sovDynamic(uint64(uint32(m.fInt32)))
+	}
+	if m.xxx_IsFInt64Set {
+		n += 1 + sovDynamic(uint64(m.fInt64))
+	}
+	if m.xxx_IsFUint32Set {
+		n += 1 + sovDynamic(uint64(m.fUint32))
+	}
+	if m.xxx_IsFUint64Set {
+		n += 1 + sovDynamic(uint64(m.fUint64))
+	}
+	if m.xxx_IsFSint32Set {
+		n += 1 + sozDynamic(uint64(m.fSint32))
+	}
+	if m.xxx_IsFSint64Set {
+		n += 1 + sozDynamic(uint64(m.fSint64))
+	}
+	if m.xxx_IsFFixed32Set {
+		n += 5
+	}
+	if m.xxx_IsFFixed64Set {
+		n += 9
+	}
+	if m.xxx_IsFSfixed32Set {
+		n += 5
+	}
+	if m.xxx_IsFSfixed64Set {
+		n += 9
+	}
+	if m.xxx_IsFBoolSet {
+		n += 2
+	}
+	if m.xxx_IsFStringSet {
+		l = len(m.fString)
+		n += 1 + l + sovDynamic(uint64(l))
+	}
+	if m.xxx_IsFBytesSet {
+		l = len(m.fBytes)
+		n += 1 + l + sovDynamic(uint64(l))
+	}
+	if m.xxx_IsColorSet {
+		n += 2 + sovDynamic(uint64(m.color))
+	}
+	if m.xxx_IsInnerSet {
+		l = m.inner.Size()
+		n += 2 + l + sovDynamic(uint64(l))
+	}
+	if m.xxx_LenInners > 0 {
+		for i := 0; i < m.xxx_LenInners; i++ {
+			e := m.inners[i]
+			l = e.Size()
+			n += 2 + l + sovDynamic(uint64(l))
+		}
+	}
+	if m.xxx_LenUnpacked > 0 {
+		for i := 0; i < m.xxx_LenUnpacked; i++ {
+			e := m.unpacked[i]
+			n += 2 + sozDynamic(uint64(e))
+		}
+	}
+	if m.xxx_LenPacked > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenPacked; i++ {
+			e := m.packed[i]
+			l += sozDynamic(uint64(e))
+		}
+		n += 2 + sovDynamic(uint64(l)) + l
+	}
+	if m.xxx_LenNames > 0 {
+		for i := 0; i < m.xxx_LenNames; i++ {
+			s := m.names[i]
+			l = len(s)
+			n += 2 + l + sovDynamic(uint64(l))
+		}
+	}
+	if m.xxx_IsPointSet {
+		n += 4 + m.point.Size()
+	}
+	if len(m.named) > 0 {
+		for k, v := range m.named {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovDynamic(uint64(len(k))) + 1 + l + sovDynamic(uint64(l))
+			n += 2 + mapEntrySize + sovDynamic(uint64(mapEntrySize))
+		}
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Text {
+		l = len(m.text)
+		n += 2 + l + sovDynamic(uint64(l))
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Nested {
+		l = m.nested.Size()
+		n += 2 + l + sovDynamic(uint64(l))
+	}
+	if m.xxx_IsAnswerSet {
+		n += 2 + sovDynamic(uint64(uint32(m.answer)))
+	}
+	if m.XXX_extensions != nil {
+		n += proto.SizeOfExtensionMap(m.XXX_extensions)
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Everything_Point) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsXSet {
+		n += 2 + sovDynamic(uint64(uint32(m.x)))
+	}
+	if m.xxx_IsYSet {
+		n += 2 + sovDynamic(uint64(uint32(m.y)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Everything_NamedEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovDynamic(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovDynamic(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovDynamic(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDynamic(x uint64) (n int) {
+	return sovDynamic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Inner) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Inner) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Inner) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDynamic(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsIdSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.id))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Everything) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Everything) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Everything) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsFDoubleSet {
+		data[i] = 0x9
+		i++
+		i = encodeFixed64Dynamic(data, i, uint64(math.Float64bits(float64(m.fDouble))))
+	}
+	if m.xxx_IsFFloatSet {
+		data[i] = 0x15
+		i++
+		i = encodeFixed32Dynamic(data, i, uint32(math.Float32bits(float32(m.fFloat))))
+	}
+	if m.xxx_IsFInt32Set {
+		data[i] = 0x18
+		i++
+		i = encodeVarintDynamic(data, i, uint64(uint32(m.fInt32)))
+	}
+	if m.xxx_IsFInt64Set {
+		data[i] = 0x20
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.fInt64))
+	}
+	if m.xxx_IsFUint32Set {
+		data[i] = 0x28
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.fUint32))
+	}
+	if m.xxx_IsFUint64Set {
+		data[i] = 0x30
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.fUint64))
+	}
+	if m.xxx_IsFSint32Set {
+		data[i] = 0x38
+		i++
+		i = encodeVarintDynamic(data, i, uint64((uint32(m.fSint32)<<1)^uint32((m.fSint32>>31))))
+	}
+	if m.xxx_IsFSint64Set {
+		data[i] = 0x40
+		i++
+		i = encodeVarintDynamic(data, i, uint64((uint64(m.fSint64)<<1)^uint64((m.fSint64>>63))))
+	}
+	if m.xxx_IsFFixed32Set {
+		data[i] = 0x4d
+		i++
+		i = encodeFixed32Dynamic(data, i, uint32(m.fFixed32))
+	}
+	if m.xxx_IsFFixed64Set {
+		data[i] = 0x51
+		i++
+		i = encodeFixed64Dynamic(data, i, uint64(m.fFixed64))
+	}
+	if m.xxx_IsFSfixed32Set {
+		data[i] = 0x5d
+		i++
+		i = encodeFixed32Dynamic(data, i, uint32(m.fSfixed32))
+	}
+	if m.xxx_IsFSfixed64Set {
+		data[i] = 0x61
+		i++
+		i = encodeFixed64Dynamic(data, i, uint64(m.fSfixed64))
+	}
+	if m.xxx_IsFBoolSet {
+		data[i] = 0x68
+		i++
+		if m.fBool {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.xxx_IsFStringSet {
+		data[i] = 0x72
+		i++
+		i = encodeVarintDynamic(data, i, uint64(len(m.fString)))
+		i += copy(data[i:], m.fString)
+	}
+	if m.xxx_IsFBytesSet {
+		data[i] = 0x7a
+		i++
+		i = encodeVarintDynamic(data, i, uint64(len(m.fBytes)))
+		i += copy(data[i:], m.fBytes)
+	}
+	if m.xxx_IsColorSet {
+		data[i] = 0x80
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.color))
+	}
+	if m.xxx_IsInnerSet {
+		data[i] = 0x8a
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.inner.SizeCached()))
+		n1, err := m.inner.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_LenInners > 0 {
+		for idx := 0; idx < m.xxx_LenInners; idx++ {
+			msg := m.inners[idx]
+			data[i] = 0x92
+			i++
+			data[i] = 0x1
+			i++
+			i = encodeVarintDynamic(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.xxx_LenUnpacked > 0 {
+		for idx := 0; idx < m.xxx_LenUnpacked; idx++ {
+			num := m.unpacked[idx]
+			data[i] = 0x98
+			i++
+			data[i] = 0x1
+			i++
+			x2 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x2 >= 1<<7 {
+				data[i] = uint8(uint64(x2)&0x7f | 0x80)
+				x2 >>= 7
+				i++
+			}
+			data[i] = uint8(x2)
+			i++
+		}
+	}
+	if m.xxx_LenPacked > 0 {
+		data3 := make([]byte, m.xxx_LenPacked*5)
+		var j4 int
+		for idx := 0; idx < m.xxx_LenPacked; idx++ {
+			num := m.packed[idx]
+			x5 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x5 >= 1<<7 {
+				data3[j4] = uint8(uint64(x5)&0x7f | 0x80)
+				j4++
+				x5 >>= 7
+			}
+			data3[j4] = uint8(x5)
+			j4++
+		}
+		data[i] = 0xa2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(j4))
+		i += copy(data[i:], data3[:j4])
+	}
+	if m.xxx_LenNames > 0 {
+		for idx := 0; idx < m.xxx_LenNames; idx++ {
+			s := m.names[idx]
+			data[i] = 0xaa
+			i++
+			data[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.xxx_IsPointSet {
+		data[i] = 0xb3
+		i++
+		data[i] = 0x1
+		i++
+		n6, err := m.point.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+		data[i] = 0xb4
+		i++
+		data[i] = 0x1
+		i++
+	}
+	if len(m.named) > 0 {
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			data[i] = 0xca
+			i++
+			data[i] = 0x1
+			i++
+			mapEntrySize := 1 + len(k) + sovDynamic(uint64(len(k))) + 1 + v.SizeCached() + sovDynamic(uint64(v.SizeCached()))
+			i = encodeVarintDynamic(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintDynamic(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintDynamic(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Text {
+		data[i] = 0xd2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(len(m.text)))
+		i += copy(data[i:], m.text)
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Nested {
+		data[i] = 0xda
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.nested.SizeCached()))
+		n7, err := m.nested.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.xxx_IsAnswerSet {
+		data[i] = 0xe0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(uint32(m.answer)))
+	}
+	if len(m.XXX_extensions) > 0 {
+		n, err := proto.EncodeExtensionMap(m.XXX_extensions, data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Everything_Point) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Everything_Point) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Everything_Point) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsXSet {
+		data[i] = 0xb8
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(uint32(m.x)))
+	}
+	if m.xxx_IsYSet {
+		data[i] = 0xc0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintDynamic(data, i, uint64(uint32(m.y)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Everything_NamedEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Everything_NamedEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Everything_NamedEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintDynamic(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintDynamic(data, i, uint64(m.value.SizeCached()))
+		n8, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Dynamic(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Dynamic(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDynamic(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Inner) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field id", wireType)
+			}
+			m.xxx_IsIdSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Everything) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fDouble", wireType)
+			}
+			m.xxx_IsFDoubleSet = true
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			m.fDouble = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fFloat", wireType)
+			}
+			m.xxx_IsFFloatSet = true
+			var v uint32
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint32(data[i-4])
+			v |= uint32(data[i-3]) << 8
+			v |= uint32(data[i-2]) << 16
+			v |= uint32(data[i-1]) << 24
+			m.fFloat = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fInt32", wireType)
+			}
+			m.xxx_IsFInt32Set = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.fInt32 |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fInt64", wireType)
+			}
+			m.xxx_IsFInt64Set = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.fInt64 |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fUint32", wireType)
+			}
+			m.xxx_IsFUint32Set = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.fUint32 |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fUint64", wireType)
+			}
+			m.xxx_IsFUint64Set = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.fUint64 |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fSint32", wireType)
+			}
+			m.xxx_IsFSint32Set = true
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.fSint32 = int32(v)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fSint64", wireType)
+			}
+			m.xxx_IsFSint64Set = true
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.fSint64 = int64(int64(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fFixed32", wireType)
+			}
+			m.xxx_IsFFixed32Set = true
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			m.fFixed32 = uint32(data[i-4])
+			m.fFixed32 |= uint32(data[i-3]) << 8
+			m.fFixed32 |= uint32(data[i-2]) << 16
+			m.fFixed32 |= uint32(data[i-1]) << 24
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fFixed64", wireType)
+			}
+			m.xxx_IsFFixed64Set = true
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			m.fFixed64 = uint64(data[i-8])
+			m.fFixed64 |= uint64(data[i-7]) << 8
+			m.fFixed64 |= uint64(data[i-6]) << 16
+			m.fFixed64 |= uint64(data[i-5]) << 24
+			m.fFixed64 |= uint64(data[i-4]) << 32
+			m.fFixed64 |= uint64(data[i-3]) << 40
+			m.fFixed64 |= uint64(data[i-2]) << 48
+			m.fFixed64 |= uint64(data[i-1]) << 56
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fSfixed32", wireType)
+			}
+			m.xxx_IsFSfixed32Set = true
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			m.fSfixed32 = int32(data[i-4])
+			m.fSfixed32 |= int32(data[i-3]) << 8
+			m.fSfixed32 |= int32(data[i-2]) << 16
+			m.fSfixed32 |= int32(data[i-1]) << 24
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fSfixed64", wireType)
+			}
+			m.xxx_IsFSfixed64Set = true
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			m.fSfixed64 = int64(data[i-8])
+			m.fSfixed64 |= int64(data[i-7]) << 8
+			m.fSfixed64 |= int64(data[i-6]) << 16
+			m.fSfixed64 |= int64(data[i-5]) << 24
+			m.fSfixed64 |= int64(data[i-4]) << 32
+			m.fSfixed64 |= int64(data[i-3]) << 40
+			m.fSfixed64 |= int64(data[i-2]) << 48
+			m.fSfixed64 |= int64(data[i-1]) << 56
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fBool", wireType)
+			}
+			m.xxx_IsFBoolSet = true
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.fBool = bool(bool(v != 0))
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fString", wireType)
+			}
+			m.xxx_IsFStringSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.fString = string(data[index:postIndex])
+			index = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fBytes", wireType)
+			}
+			m.xxx_IsFBytesSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.fBytes = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field color", wireType)
+			}
+			m.xxx_IsColorSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.color |= (Color(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field inner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateInner()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field inners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddInners()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 19:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.unpacked) <= m.xxx_LenUnpacked {
+						newCapacity := 0
+						if len(m.unpacked) == 0 {
+							newCapacity = 8
+						} else if len(m.unpacked) < 1000000 {
+							newCapacity = m.xxx_LenUnpacked * 2
+						} else {
+							newCapacity = m.xxx_LenUnpacked + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.unpacked)
+						m.unpacked = t
+					}
+					m.unpacked[m.xxx_LenUnpacked] = int32(v)
+					m.xxx_LenUnpacked += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.unpacked) <= m.xxx_LenUnpacked {
+					newCapacity := 0
+					if len(m.unpacked) == 0 {
+						newCapacity = 8
+					} else if len(m.unpacked) < 1000000 {
+						newCapacity = m.xxx_LenUnpacked * 2
+					} else {
+						newCapacity = m.xxx_LenUnpacked + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.unpacked)
+					m.unpacked = t
+				}
+				m.unpacked[m.xxx_LenUnpacked] = int32(v)
+				m.xxx_LenUnpacked += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field unpacked", wireType)
+			}
+		case 20:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.packed) <= m.xxx_LenPacked {
+						newCapacity := 0
+						if len(m.packed) == 0 {
+							newCapacity = 8
+						} else if len(m.packed) < 1000000 {
+							newCapacity = m.xxx_LenPacked * 2
+						} else {
+							newCapacity = m.xxx_LenPacked + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.packed)
+						m.packed = t
+					}
+					m.packed[m.xxx_LenPacked] = int32(v)
+					m.xxx_LenPacked += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.packed) <= m.xxx_LenPacked {
+					newCapacity := 0
+					if len(m.packed) == 0 {
+						newCapacity = 8
+					} else if len(m.packed) < 1000000 {
+						newCapacity = m.xxx_LenPacked * 2
+					} else {
+						newCapacity = m.xxx_LenPacked + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.packed)
+					m.packed = t
+				}
+				m.packed[m.xxx_LenPacked] = int32(v)
+				m.xxx_LenPacked += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field packed", wireType)
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.names) <= m.xxx_LenNames {
+				newCapacity := 0
+				if len(m.names) == 0 {
+					newCapacity = 8
+				} else if len(m.names) < 1000000 {
+					newCapacity = m.xxx_LenNames * 2
+				} else {
+					newCapacity = m.xxx_LenNames + 1000000
+				}
+				t := make([]string, newCapacity, newCapacity)
+				copy(t, m.names)
+				m.names = t
+			}
+			m.names[m.xxx_LenNames] = string(data[index:postIndex])
+			m.xxx_LenNames += 1
+			index = postIndex
+		case 22:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field point", wireType)
+			}
+			groupLen, groupEnd, err := proto.SkipGroup(data[index:], 22)
+			if err != nil {
+				return err
+			}
+			postIndex := index + groupLen
+			v, _ := m.MutatePoint()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index += groupEnd
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field named", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Everything_NamedEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.named == nil {
+				m.named = make(map[string]*Inner)
+			}
+			if entry.value == nil {
+				entry.value = new(Inner)
+			}
+			m.named[entry.key] = entry.value
+			index = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field text", wireType)
+			}
+			if m.xxx_ChoiceCase != Everything_ChoiceCase_Text {
+				m.ClearChoice()
+				m.xxx_ChoiceCase = Everything_ChoiceCase_Text
+			}
+			m.xxx_IsTextSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.text = string(data[index:postIndex])
+			index = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateNested()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field answer", wireType)
+			}
+			m.xxx_IsAnswerSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.answer |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			if (fieldNum >= 100) && (fieldNum < 200) {
+				var sizeOfWire int
+				for {
+					sizeOfWire++
+					wire >>= 7
+					if wire == 0 {
+						break
+					}
+				}
+				index -= sizeOfWire
+				skippy, err := proto.Skip(data[index:])
+				if err != nil {
+					return err
+				}
+				if (index + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				if m.XXX_extensions == nil {
+					m.XXX_extensions = make(map[int32]proto.Extension)
+				}
+				m.XXX_extensions[int32(fieldNum)] = proto.NewExtension(data[index : index+skippy])
+				index += skippy
+			} else {
+				var sizeOfWire int
+				for {
+					sizeOfWire++
+					wire >>= 7
+					if wire == 0 {
+						break
+					}
+				}
+				index -= sizeOfWire
+				skippy, err := proto.Skip(data[index:])
+				if err != nil {
+					return err
+				}
+				if (index + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+				index += skippy
+			}
+		}
+	}
+	return nil
+}
+func (m *Everything_Point) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field x", wireType)
+			}
+			m.xxx_IsXSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.x |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field y", wireType)
+			}
+			m.xxx_IsYSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.y |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Everything_NamedEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Inner) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Inner) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Everything) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Everything) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Everything_Point) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Everything_Point) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Everything_NamedEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Everything_NamedEntry) CheckInitialized() error {
+	return nil
+}
+
+func (m *Inner) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_IsIdSet || w.EmitDefaults() {
+		w.Field("id", "id")
+		w.Int64(m.GetId())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Inner) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("id", "id"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetId(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Inner) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Inner) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Everything) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsFDoubleSet || w.EmitDefaults() {
+		w.Field("fDouble", "f_double")
+		w.Float64(m.GetFDouble())
+	}
+	if m.xxx_IsFFloatSet || w.EmitDefaults() {
+		w.Field("fFloat", "f_float")
+		w.Float32(m.GetFFloat())
+	}
+	if m.xxx_IsFInt32Set || w.EmitDefaults() {
+		w.Field("fInt32", "f_int32")
+		w.Int32(m.GetFInt32())
+	}
+	if m.xxx_IsFInt64Set || w.EmitDefaults() {
+		w.Field("fInt64", "f_int64")
+		w.Int64(m.GetFInt64())
+	}
+	if m.xxx_IsFUint32Set || w.EmitDefaults() {
+		w.Field("fUint32", "f_uint32")
+		w.Uint32(m.GetFUint32())
+	}
+	if m.xxx_IsFUint64Set || w.EmitDefaults() {
+		w.Field("fUint64", "f_uint64")
+		w.Uint64(m.GetFUint64())
+	}
+	if m.xxx_IsFSint32Set || w.EmitDefaults() {
+		w.Field("fSint32", "f_sint32")
+		w.Int32(m.GetFSint32())
+	}
+	if m.xxx_IsFSint64Set || w.EmitDefaults() {
+		w.Field("fSint64", "f_sint64")
+		w.Int64(m.GetFSint64())
+	}
+	if m.xxx_IsFFixed32Set || w.EmitDefaults() {
+		w.Field("fFixed32", "f_fixed32")
+		w.Uint32(m.GetFFixed32())
+	}
+	if m.xxx_IsFFixed64Set || w.EmitDefaults() {
+		w.Field("fFixed64", "f_fixed64")
+		w.Uint64(m.GetFFixed64())
+	}
+	if m.xxx_IsFSfixed32Set || w.EmitDefaults() {
+		w.Field("fSfixed32", "f_sfixed32")
+		w.Int32(m.GetFSfixed32())
+	}
+	if m.xxx_IsFSfixed64Set || w.EmitDefaults() {
+		w.Field("fSfixed64", "f_sfixed64")
+		w.Int64(m.GetFSfixed64())
+	}
+	if m.xxx_IsFBoolSet || w.EmitDefaults() {
+		w.Field("fBool", "f_bool")
+		w.Bool(m.GetFBool())
+	}
+	if m.xxx_IsFStringSet || w.EmitDefaults() {
+		w.Field("fString", "f_string")
+		w.String(m.GetFString())
+	}
+	if m.xxx_IsFBytesSet || w.EmitDefaults() {
+		w.Field("fBytes", "f_bytes")
+		w.Base64(m.GetFBytes())
+	}
+	if m.xxx_IsColorSet || w.EmitDefaults() {
+		w.Field("color", "color")
+		w.Enum(int32(m.GetColor()), Color_name)
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner", "inner")
+		w.Message(m.GetInner())
+	} else if w.EmitDefaults() {
+		w.Field("inner", "inner")
+		w.Null()
+	}
+	if m.xxx_LenInners > 0 || w.EmitDefaults() {
+		w.Field("inners", "inners")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenInners; i++ {
+			w.Message(m.inners[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenUnpacked > 0 || w.EmitDefaults() {
+		w.Field("unpacked", "unpacked")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenUnpacked; i++ {
+			w.Int32(m.unpacked[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenPacked > 0 || w.EmitDefaults() {
+		w.Field("packed", "packed")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenPacked; i++ {
+			w.Int32(m.packed[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenNames > 0 || w.EmitDefaults() {
+		w.Field("names", "names")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenNames; i++ {
+			w.String(m.names[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_IsPointSet {
+		w.Field("point", "point")
+		w.Message(m.GetPoint())
+	} else if w.EmitDefaults() {
+		w.Field("point", "point")
+		w.Null()
+	}
+	if len(m.named) > 0 || w.EmitDefaults() {
+		w.Field("named", "named")
+		w.BeginObject()
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(k)
+			w.Message(m.named[k])
+		}
+		w.EndObject()
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Text {
+		w.Field("text", "text")
+		w.String(m.GetText())
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Nested {
+		w.Field("nested", "nested")
+		w.Message(m.GetNested())
+	}
+	if m.xxx_IsAnswerSet || w.EmitDefaults() {
+		w.Field("answer", "answer")
+		w.Int32(m.GetAnswer())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Everything) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("fDouble", "f_double"); ok {
+		v, err := jsonpb.Float64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFDouble(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fFloat", "f_float"); ok {
+		v, err := jsonpb.Float32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFFloat(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fInt32", "f_int32"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFInt32(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fInt64", "f_int64"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFInt64(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fUint32", "f_uint32"); ok {
+		v, err := jsonpb.Uint32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFUint32(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fUint64", "f_uint64"); ok {
+		v, err := jsonpb.Uint64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFUint64(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fSint32", "f_sint32"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFSint32(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fSint64", "f_sint64"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFSint64(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fFixed32", "f_fixed32"); ok {
+		v, err := jsonpb.Uint32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFFixed32(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fFixed64", "f_fixed64"); ok {
+		v, err := jsonpb.Uint64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFFixed64(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fSfixed32", "f_sfixed32"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFSfixed32(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fSfixed64", "f_sfixed64"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFSfixed64(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fBool", "f_bool"); ok {
+		v, err := jsonpb.Bool(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFBool(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fString", "f_string"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFString(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fBytes", "f_bytes"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFBytes(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("color", "color"); ok {
+		v, err := jsonpb.ClosedEnum(raw, Color_value, Color_name)
+		if err != nil {
+			return err
+		}
+		if err := m.SetColor(Color(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("inner", "inner"); ok {
+		v, err := m.MutateInner()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("inners", "inners"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddInners()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("unpacked", "unpacked"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddUnpacked(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("packed", "packed"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddPacked(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("names", "names"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddNames(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("point", "point"); ok {
+		v, err := m.MutatePoint()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("named", "named"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v := new(Inner)
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+			if err := m.PutNamed(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("text", "text"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetText(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("nested", "nested"); ok {
+		v, err := m.MutateNested()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("answer", "answer"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetAnswer(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Everything) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Everything) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Everything_Point) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsXSet || w.EmitDefaults() {
+		w.Field("x", "x")
+		w.Int32(m.GetX())
+	}
+	if m.xxx_IsYSet || w.EmitDefaults() {
+		w.Field("y", "y")
+		w.Int32(m.GetY())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Everything_Point) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("x", "x"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetX(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("y", "y"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetY(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Everything_Point) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Everything_Point) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Everything_NamedEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Everything_NamedEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Everything_NamedEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Everything_NamedEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Inner) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_IsIdSet {
+		w.Field("id")
+		w.Value(m.id)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Inner) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "id":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetId(v)
+	}
+	return false, nil
+}
+
+func (m *Everything) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsFDoubleSet {
+		w.Field("f_double")
+		w.Value(m.fDouble)
+	}
+	if m.xxx_IsFFloatSet {
+		w.Field("f_float")
+		w.Value(m.fFloat)
+	}
+	if m.xxx_IsFInt32Set {
+		w.Field("f_int32")
+		w.Value(m.fInt32)
+	}
+	if m.xxx_IsFInt64Set {
+		w.Field("f_int64")
+		w.Value(m.fInt64)
+	}
+	if m.xxx_IsFUint32Set {
+		w.Field("f_uint32")
+		w.Value(m.fUint32)
+	}
+	if m.xxx_IsFUint64Set {
+		w.Field("f_uint64")
+		w.Value(m.fUint64)
+	}
+	if m.xxx_IsFSint32Set {
+		w.Field("f_sint32")
+		w.Value(m.fSint32)
+	}
+	if m.xxx_IsFSint64Set {
+		w.Field("f_sint64")
+		w.Value(m.fSint64)
+	}
+	if m.xxx_IsFFixed32Set {
+		w.Field("f_fixed32")
+		w.Value(m.fFixed32)
+	}
+	if m.xxx_IsFFixed64Set {
+		w.Field("f_fixed64")
+		w.Value(m.fFixed64)
+	}
+	if m.xxx_IsFSfixed32Set {
+		w.Field("f_sfixed32")
+		w.Value(m.fSfixed32)
+	}
+	if m.xxx_IsFSfixed64Set {
+		w.Field("f_sfixed64")
+		w.Value(m.fSfixed64)
+	}
+	if m.xxx_IsFBoolSet {
+		w.Field("f_bool")
+		w.Value(m.fBool)
+	}
+	if m.xxx_IsFStringSet {
+		w.Field("f_string")
+		w.Value(m.fString)
+	}
+	if m.xxx_IsFBytesSet {
+		w.Field("f_bytes")
+		w.Value(m.fBytes)
+	}
+	if m.xxx_IsColorSet {
+		w.Field("color")
+		w.Enum(int32(m.color), Color_name)
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner")
+		w.Message(m.inner)
+	}
+	for i := 0; i < m.xxx_LenInners; i++ {
+		w.Field("inners")
+		w.Message(m.inners[i])
+	}
+	for i := 0; i < m.xxx_LenUnpacked; i++ {
+		w.Field("unpacked")
+		w.Value(m.unpacked[i])
+	}
+	for i := 0; i < m.xxx_LenPacked; i++ {
+		w.Field("packed")
+		w.Value(m.packed[i])
+	}
+	for i := 0; i < m.xxx_LenNames; i++ {
+		w.Field("names")
+		w.Value(m.names[i])
+	}
+	if m.xxx_IsPointSet {
+		w.GroupField("Point")
+		w.Group(m.point)
+	}
+	if len(m.named) > 0 {
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			w.Field("named")
+			w.Message(&Everything_NamedEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Text {
+		w.Field("text")
+		w.Value(m.text)
+	}
+	if m.xxx_ChoiceCase == Everything_ChoiceCase_Nested {
+		w.Field("nested")
+		w.Message(m.nested)
+	}
+	if m.xxx_IsAnswerSet {
+		w.Field("answer")
+		w.Value(m.answer)
+	}
+	w.Extensions(m)
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Everything) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "f_double":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFDouble(v)
+	case "f_float":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFFloat(v)
+	case "f_int32":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFInt32(v)
+	case "f_int64":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFInt64(v)
+	case "f_uint32":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFUint32(v)
+	case "f_uint64":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFUint64(v)
+	case "f_sint32":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFSint32(v)
+	case "f_sint64":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFSint64(v)
+	case "f_fixed32":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFFixed32(v)
+	case "f_fixed64":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFFixed64(v)
+	case "f_sfixed32":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFSfixed32(v)
+	case "f_sfixed64":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFSfixed64(v)
+	case "f_bool":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFBool(v)
+	case "f_string":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFString(v)
+	case "f_bytes":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFBytes(v)
+	case "color":
+		v, err := p.ReadEnum(Color_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetColor(Color(v))
+	case "inner":
+		v, err := m.MutateInner()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "inners":
+		v, err := m.AddInners()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "unpacked":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddUnpacked(v)
+	case "packed":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddPacked(v)
+	case "names":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddNames(v)
+	case "Point", "point":
+		v, err := m.MutatePoint()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "named":
+		entry := &Everything_NamedEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.named == nil {
+			m.named = make(map[string]*Inner)
+		}
+		if entry.value == nil {
+			entry.value = new(Inner)
+		}
+		m.named[entry.key] = entry.value
+		return true, nil
+	case "text":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetText(v)
+	case "nested":
+		v, err := m.MutateNested()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "answer":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetAnswer(v)
+	}
+	return false, nil
+}
+
+func (m *Everything_Point) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsXSet {
+		w.Field("x")
+		w.Value(m.x)
+	}
+	if m.xxx_IsYSet {
+		w.Field("y")
+		w.Value(m.y)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Everything_Point) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "x":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetX(v)
+	case "y":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetY(v)
+	}
+	return false, nil
+}
+
+func (m *Everything_NamedEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Everything_NamedEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Inner) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Inner{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Inner) MergeFrom(src proto.Message) {
+	s, ok := src.(*Inner)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsIdSet {
+		m.SetId(s.id)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Inner) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Inner)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsIdSet != o.xxx_IsIdSet {
+		return false
+	}
+	if m.xxx_IsIdSet && m.id != o.id {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Everything) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Everything{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Everything) MergeFrom(src proto.Message) {
+	s, ok := src.(*Everything)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsFDoubleSet {
+		m.SetFDouble(s.fDouble)
+	}
+	if s.xxx_IsFFloatSet {
+		m.SetFFloat(s.fFloat)
+	}
+	if s.xxx_IsFInt32Set {
+		m.SetFInt32(s.fInt32)
+	}
+	if s.xxx_IsFInt64Set {
+		m.SetFInt64(s.fInt64)
+	}
+	if s.xxx_IsFUint32Set {
+		m.SetFUint32(s.fUint32)
+	}
+	if s.xxx_IsFUint64Set {
+		m.SetFUint64(s.fUint64)
+	}
+	if s.xxx_IsFSint32Set {
+		m.SetFSint32(s.fSint32)
+	}
+	if s.xxx_IsFSint64Set {
+		m.SetFSint64(s.fSint64)
+	}
+	if s.xxx_IsFFixed32Set {
+		m.SetFFixed32(s.fFixed32)
+	}
+	if s.xxx_IsFFixed64Set {
+		m.SetFFixed64(s.fFixed64)
+	}
+	if s.xxx_IsFSfixed32Set {
+		m.SetFSfixed32(s.fSfixed32)
+	}
+	if s.xxx_IsFSfixed64Set {
+		m.SetFSfixed64(s.fSfixed64)
+	}
+	if s.xxx_IsFBoolSet {
+		m.SetFBool(s.fBool)
+	}
+	if s.xxx_IsFStringSet {
+		m.SetFString(s.fString)
+	}
+	if s.xxx_IsFBytesSet {
+		m.SetFBytes(append([]byte{}, s.fBytes...))
+	}
+	if s.xxx_IsColorSet {
+		m.SetColor(s.color)
+	}
+	if s.xxx_IsInnerSet {
+		v, _ := m.MutateInner()
+		v.MergeFrom(s.inner)
+	}
+	for i := 0; i < s.xxx_LenInners; i++ {
+		v, _ := m.AddInners()
+		v.MergeFrom(s.inners[i])
+	}
+	for i := 0; i < s.xxx_LenUnpacked; i++ {
+		m.AddUnpacked(s.unpacked[i])
+	}
+	for i := 0; i < s.xxx_LenPacked; i++ {
+		m.AddPacked(s.packed[i])
+	}
+	for i := 0; i < s.xxx_LenNames; i++ {
+		m.AddNames(s.names[i])
+	}
+	if s.xxx_IsPointSet {
+		v, _ := m.MutatePoint()
+		v.MergeFrom(s.point)
+	}
+	if len(s.named) > 0 {
+		if m.named == nil {
+			m.named = make(map[string]*Inner, len(s.named))
+		}
+		for k, v := range s.named {
+			m.named[k] = v.Clone().(*Inner)
+		}
+	}
+	if s.xxx_ChoiceCase == Everything_ChoiceCase_Text {
+		m.SetText(s.text)
+	}
+	if s.xxx_ChoiceCase == Everything_ChoiceCase_Nested {
+		v, _ := m.MutateNested()
+		v.MergeFrom(s.nested)
+	}
+	if s.xxx_IsAnswerSet {
+		m.SetAnswer(s.answer)
+	}
+	proto.MergeExtensions(m, s)
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Everything) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Everything)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsFDoubleSet != o.xxx_IsFDoubleSet {
+		return false
+	}
+	if m.xxx_IsFDoubleSet && m.fDouble != o.fDouble {
+		return false
+	}
+	if m.xxx_IsFFloatSet != o.xxx_IsFFloatSet {
+		return false
+	}
+	if m.xxx_IsFFloatSet && m.fFloat != o.fFloat {
+		return false
+	}
+	if m.xxx_IsFInt32Set != o.xxx_IsFInt32Set {
+		return false
+	}
+	if m.xxx_IsFInt32Set && m.fInt32 != o.fInt32 {
+		return false
+	}
+	if m.xxx_IsFInt64Set != o.xxx_IsFInt64Set {
+		return false
+	}
+	if m.xxx_IsFInt64Set && m.fInt64 != o.fInt64 {
+		return false
+	}
+	if m.xxx_IsFUint32Set != o.xxx_IsFUint32Set {
+		return false
+	}
+	if m.xxx_IsFUint32Set && m.fUint32 != o.fUint32 {
+		return false
+	}
+	if m.xxx_IsFUint64Set != o.xxx_IsFUint64Set {
+		return false
+	}
+	if m.xxx_IsFUint64Set && m.fUint64 != o.fUint64 {
+		return false
+	}
+	if m.xxx_IsFSint32Set != o.xxx_IsFSint32Set {
+		return false
+	}
+	if m.xxx_IsFSint32Set && m.fSint32 != o.fSint32 {
+		return false
+	}
+	if m.xxx_IsFSint64Set != o.xxx_IsFSint64Set {
+		return false
+	}
+	if m.xxx_IsFSint64Set && m.fSint64 != o.fSint64 {
+		return false
+	}
+	if m.xxx_IsFFixed32Set != o.xxx_IsFFixed32Set {
+		return false
+	}
+	if m.xxx_IsFFixed32Set && m.fFixed32 != o.fFixed32 {
+		return false
+	}
+	if m.xxx_IsFFixed64Set != o.xxx_IsFFixed64Set {
+		return false
+	}
+	if m.xxx_IsFFixed64Set && m.fFixed64 != o.fFixed64 {
+		return false
+	}
+	if m.xxx_IsFSfixed32Set != o.xxx_IsFSfixed32Set {
+		return false
+	}
+	if m.xxx_IsFSfixed32Set && m.fSfixed32 != o.fSfixed32 {
+		return false
+	}
+	if m.xxx_IsFSfixed64Set != o.xxx_IsFSfixed64Set {
+		return false
+	}
+	if m.xxx_IsFSfixed64Set && m.fSfixed64 != o.fSfixed64 {
+		return false
+	}
+	if m.xxx_IsFBoolSet != o.xxx_IsFBoolSet {
+		return false
+	}
+	if m.xxx_IsFBoolSet && m.fBool != o.fBool {
+		return false
+	}
+	if m.xxx_IsFStringSet != o.xxx_IsFStringSet {
+		return false
+	}
+	if m.xxx_IsFStringSet && m.fString != o.fString {
+		return false
+	}
+	if m.xxx_IsFBytesSet != o.xxx_IsFBytesSet {
+		return false
+	}
+	if m.xxx_IsFBytesSet && !bytes.Equal(m.fBytes, o.fBytes) {
+		return false
+	}
+	if m.xxx_IsColorSet != o.xxx_IsColorSet {
+		return false
+	}
+	if m.xxx_IsColorSet && m.color != o.color {
+		return false
+	}
+	if m.xxx_IsInnerSet != o.xxx_IsInnerSet {
+		return false
+	}
+	if m.xxx_IsInnerSet && !m.inner.Equal(o.inner) {
+		return false
+	}
+	if m.xxx_LenInners != o.xxx_LenInners {
+		return false
+	}
+	for i := 0; i < m.xxx_LenInners; i++ {
+		if !m.inners[i].Equal(o.inners[i]) {
+			return false
+		}
+	}
+	if m.xxx_LenUnpacked != o.xxx_LenUnpacked {
+		return false
+	}
+	for i := 0; i < m.xxx_LenUnpacked; i++ {
+		if m.unpacked[i] != o.unpacked[i] {
+			return false
+		}
+	}
+	if m.xxx_LenPacked != o.xxx_LenPacked {
+		return false
+	}
+	for i := 0; i < m.xxx_LenPacked; i++ {
+		if m.packed[i] != o.packed[i] {
+			return false
+		}
+	}
+	if m.xxx_LenNames != o.xxx_LenNames {
+		return false
+	}
+	for i := 0; i < m.xxx_LenNames; i++ {
+		if m.names[i] != o.names[i] {
+			return false
+		}
+	}
+	if m.xxx_IsPointSet != o.xxx_IsPointSet {
+		return false
+	}
+	if m.xxx_IsPointSet && !m.point.Equal(o.point) {
+		return false
+	}
+	if len(m.named) != len(o.named) {
+		return false
+	}
+	for k, v := range m.named {
+		if v2, ok := o.named[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if (m.xxx_ChoiceCase == Everything_ChoiceCase_Text) != (o.xxx_ChoiceCase == Everything_ChoiceCase_Text) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Everything_ChoiceCase_Text) && m.text != o.text {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Everything_ChoiceCase_Nested) != (o.xxx_ChoiceCase == Everything_ChoiceCase_Nested) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Everything_ChoiceCase_Nested) && !m.nested.Equal(o.nested) {
+		return false
+	}
+	if m.xxx_IsAnswerSet != o.xxx_IsAnswerSet {
+		return false
+	}
+	if m.xxx_IsAnswerSet && m.answer != o.answer {
+		return false
+	}
+	if !proto.EqualExtensions(m, o) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Everything_Point) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Everything_Point{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Everything_Point) MergeFrom(src proto.Message) {
+	s, ok := src.(*Everything_Point)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsXSet {
+		m.SetX(s.x)
+	}
+	if s.xxx_IsYSet {
+		m.SetY(s.y)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Everything_Point) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Everything_Point)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsXSet != o.xxx_IsXSet {
+		return false
+	}
+	if m.xxx_IsXSet && m.x != o.x {
+		return false
+	}
+	if m.xxx_IsYSet != o.xxx_IsYSet {
+		return false
+	}
+	if m.xxx_IsYSet && m.y != o.y {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Everything_NamedEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Everything_NamedEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Everything_NamedEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Everything_NamedEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Everything_NamedEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Everything_NamedEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionInner = &proto.MessageInfo{
+	Name: "dynamic.Inner",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Inner)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Inner).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearName()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "id",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetId(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetId()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "id", v)
+				}
+				x := m.(*Inner)
+				return x.SetId(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Inner).HasId()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearId()
+			},
+		},
+	},
+}
+
+func (m *Inner) ProtoReflect() *proto.MessageInfo {
+	return reflectionInner
+}
+
+var reflectionEverything = &proto.MessageInfo{
+	Name: "dynamic.Everything",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "f_double",
+			Kind:    proto.DoubleKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFDouble(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFDouble()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_double", v)
+				}
+				x := m.(*Everything)
+				return x.SetFDouble(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFDouble()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFDouble()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "f_float",
+			Kind:    proto.FloatKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFFloat(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFFloat()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_float", v)
+				}
+				x := m.(*Everything)
+				return x.SetFFloat(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFFloat()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFFloat()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "f_int32",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFInt32(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFInt32()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_int32", v)
+				}
+				x := m.(*Everything)
+				return x.SetFInt32(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFInt32()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFInt32()
+			},
+		},
+		{
+			Number:  4,
+			Name:    "f_int64",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFInt64(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFInt64()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_int64", v)
+				}
+				x := m.(*Everything)
+				return x.SetFInt64(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFInt64()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFInt64()
+			},
+		},
+		{
+			Number:  5,
+			Name:    "f_uint32",
+			Kind:    proto.Uint32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFUint32(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFUint32()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_uint32", v)
+				}
+				x := m.(*Everything)
+				return x.SetFUint32(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFUint32()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFUint32()
+			},
+		},
+		{
+			Number:  6,
+			Name:    "f_uint64",
+			Kind:    proto.Uint64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFUint64(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFUint64()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_uint64", v)
+				}
+				x := m.(*Everything)
+				return x.SetFUint64(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFUint64()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFUint64()
+			},
+		},
+		{
+			Number:  7,
+			Name:    "f_sint32",
+			Kind:    proto.Sint32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFSint32(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFSint32()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_sint32", v)
+				}
+				x := m.(*Everything)
+				return x.SetFSint32(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFSint32()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFSint32()
+			},
+		},
+		{
+			Number:  8,
+			Name:    "f_sint64",
+			Kind:    proto.Sint64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFSint64(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFSint64()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_sint64", v)
+				}
+				x := m.(*Everything)
+				return x.SetFSint64(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFSint64()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFSint64()
+			},
+		},
+		{
+			Number:  9,
+			Name:    "f_fixed32",
+			Kind:    proto.Fixed32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFFixed32(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFFixed32()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_fixed32", v)
+				}
+				x := m.(*Everything)
+				return x.SetFFixed32(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFFixed32()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFFixed32()
+			},
+		},
+		{
+			Number:  10,
+			Name:    "f_fixed64",
+			Kind:    proto.Fixed64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFFixed64(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFFixed64()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_fixed64", v)
+				}
+				x := m.(*Everything)
+				return x.SetFFixed64(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFFixed64()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFFixed64()
+			},
+		},
+		{
+			Number:  11,
+			Name:    "f_sfixed32",
+			Kind:    proto.Sfixed32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFSfixed32(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFSfixed32()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_sfixed32", v)
+				}
+				x := m.(*Everything)
+				return x.SetFSfixed32(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFSfixed32()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFSfixed32()
+			},
+		},
+		{
+			Number:  12,
+			Name:    "f_sfixed64",
+			Kind:    proto.Sfixed64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFSfixed64(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFSfixed64()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_sfixed64", v)
+				}
+				x := m.(*Everything)
+				return x.SetFSfixed64(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFSfixed64()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFSfixed64()
+			},
+		},
+		{
+			Number:  13,
+			Name:    "f_bool",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFBool(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFBool()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_bool", v)
+				}
+				x := m.(*Everything)
+				return x.SetFBool(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFBool()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFBool()
+			},
+		},
+		{
+			Number:  14,
+			Name:    "f_string",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFString(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFString()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_string", v)
+				}
+				x := m.(*Everything)
+				return x.SetFString(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFString()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFString()
+			},
+		},
+		{
+			Number:  15,
+			Name:    "f_bytes",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetFBytes(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetFBytes()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "f_bytes", v)
+				}
+				x := m.(*Everything)
+				return x.SetFBytes(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasFBytes()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearFBytes()
+			},
+		},
+		{
+			Number:  16,
+			Name:    "color",
+			Kind:    proto.EnumKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetColor(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetColor()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Color)
+				if !ok {
+					return proto.NewFieldTypeError(m, "color", v)
+				}
+				x := m.(*Everything)
+				return x.SetColor(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasColor()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearColor()
+			},
+		},
+		{
+			Number: 17,
+			Name:   "inner",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetInner()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "inner", v)
+				}
+				x := m.(*Everything)
+				x.ClearInner()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateInner()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasInner()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearInner()
+			},
+		},
+		{
+			Number: 18,
+			Name:   "inners",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Everything)
+				v := make([]*Inner, x.xxx_LenInners)
+				copy(v, x.inners)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "inners", v)
+				}
+				x := m.(*Everything)
+				x.ClearInners()
+				for _, e := range value {
+					field, err := x.AddInners()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).InnersSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearInners()
+			},
+		},
+		{
+			Number: 19,
+			Name:   "unpacked",
+			Kind:   proto.Sint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Everything)
+				v := make([]int32, x.xxx_LenUnpacked)
+				copy(v, x.unpacked)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "unpacked", v)
+				}
+				x := m.(*Everything)
+				x.ClearUnpacked()
+				for _, e := range value {
+					if err := x.AddUnpacked(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).UnpackedSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearUnpacked()
+			},
+		},
+		{
+			Number: 20,
+			Name:   "packed",
+			Kind:   proto.Sint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Everything)
+				v := make([]int32, x.xxx_LenPacked)
+				copy(v, x.packed)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "packed", v)
+				}
+				x := m.(*Everything)
+				x.ClearPacked()
+				for _, e := range value {
+					if err := x.AddPacked(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).PackedSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearPacked()
+			},
+		},
+		{
+			Number: 21,
+			Name:   "names",
+			Kind:   proto.StringKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Everything)
+				v := make([]string, x.xxx_LenNames)
+				copy(v, x.names)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "names", v)
+				}
+				x := m.(*Everything)
+				x.ClearNames()
+				for _, e := range value {
+					if err := x.AddNames(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).NamesSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearNames()
+			},
+		},
+		{
+			Number: 22,
+			Name:   "point",
+			Kind:   proto.GroupKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Everything_Point)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetPoint()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Everything_Point)
+				if !ok {
+					return proto.NewFieldTypeError(m, "point", v)
+				}
+				x := m.(*Everything)
+				x.ClearPoint()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutatePoint()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasPoint()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearPoint()
+			},
+		},
+		{
+			Number: 25,
+			Name:   "named",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Everything)
+				v := make(map[string]*Inner, x.NamedLen())
+				x.RangeNamed(func(key string, value *Inner) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "named", v)
+				}
+				x := m.(*Everything)
+				x.ClearNamed()
+				for k, e := range value {
+					if err := x.PutNamed(k, e.Clone().(*Inner)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).NamedLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearNamed()
+			},
+		},
+		{
+			Number:  26,
+			Name:    "text",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "choice",
+			Default: (*Everything)(nil).GetText(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetText()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "text", v)
+				}
+				x := m.(*Everything)
+				return x.SetText(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasText()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearText()
+			},
+		},
+		{
+			Number: 27,
+			Name:   "nested",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			Oneof:  "choice",
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetNested()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "nested", v)
+				}
+				x := m.(*Everything)
+				x.ClearNested()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateNested()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasNested()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearNested()
+			},
+		},
+		{
+			Number:  28,
+			Name:    "answer",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything)(nil).GetAnswer(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything).GetAnswer()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "answer", v)
+				}
+				x := m.(*Everything)
+				return x.SetAnswer(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything).HasAnswer()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything).ClearAnswer()
+			},
+		},
+	},
+}
+
+func (m *Everything) ProtoReflect() *proto.MessageInfo {
+	return reflectionEverything
+}
+
+var reflectionEverything_Point = &proto.MessageInfo{
+	Name: "dynamic.Everything.Point",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  23,
+			Name:    "x",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything_Point)(nil).GetX(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything_Point).GetX()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "x", v)
+				}
+				x := m.(*Everything_Point)
+				return x.SetX(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything_Point).HasX()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything_Point).ClearX()
+			},
+		},
+		{
+			Number:  24,
+			Name:    "y",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything_Point)(nil).GetY(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything_Point).GetY()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "y", v)
+				}
+				x := m.(*Everything_Point)
+				return x.SetY(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything_Point).HasY()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything_Point).ClearY()
+			},
+		},
+	},
+}
+
+func (m *Everything_Point) ProtoReflect() *proto.MessageInfo {
+	return reflectionEverything_Point
+}
+
+var reflectionEverything_NamedEntry = &proto.MessageInfo{
+	Name: "dynamic.Everything.NamedEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Everything_NamedEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything_NamedEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Everything_NamedEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything_NamedEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything_NamedEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Everything_NamedEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Everything_NamedEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Everything_NamedEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Everything_NamedEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Everything_NamedEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionEverything_NamedEntry
+}
+
+var E_Note = &proto.ExtensionDesc{
+	ExtendedType:  (*Everything)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         100,
+	Name:          "dynamic.note",
+}
+
+var E_Marks = &proto.ExtensionDesc{
+	ExtendedType:  (*Everything)(nil),
+	ExtensionType: (*[]int64)(nil),
+	Field:         101,
+	Name:          "dynamic.marks",
+}
+
+func init() {
+	proto.RegisterEnum("dynamic.Color", Color_name, Color_value)
+	proto.RegisterType((*Inner)(nil), "dynamic.Inner")
+	proto.RegisterType((*Everything)(nil), "dynamic.Everything")
+	proto.RegisterType((*Everything_Point)(nil), "dynamic.Everything.Point")
+	proto.RegisterExtension(E_Note)
+	proto.RegisterExtension(E_Marks)
+}
+func (this *Inner) Description() (desc *google_protobuf.FileDescriptorSet) {
+	return DynamicDescription()
+}
+func (this *Everything) Description() (desc *google_protobuf.FileDescriptorSet) {
+	return DynamicDescription()
+}
+func (this *Everything_Point) Description() (desc *google_protobuf.FileDescriptorSet) {
+	return DynamicDescription()
+}
+func (this *Everything_NamedEntry) Description() (desc *google_protobuf.FileDescriptorSet) {
+	return DynamicDescription()
+}
+func DynamicDescription() (desc *google_protobuf.FileDescriptorSet) {
+	return &google_protobuf.FileDescriptorSet{File: []*google_protobuf.FileDescriptorProto{{Name: func(v string) *string { return &v }("github.com/dropbox/goprotoc/protobuf/google/protobuf/descriptor.proto"), Package: func(v string) *string { return &v }("google.protobuf"), Dependency: []string(nil), PublicDependency: []int32(nil), WeakDependency: []int32(nil), MessageType: []*google_protobuf.DescriptorProto{{Name: func(v string) *string { return &v }("FileDescriptorSet"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("file"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.FileDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x66, 0x69, 0x6c, 0x65}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("FileDescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("package"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65}}, {Name: func(v string) *string { return &v }("dependency"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79}}, {Name: func(v string) *string { return &v }("public_dependency"), Number: func(v int32) *int32 { return &v }(10), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79}}, {Name: func(v string) *string { return &v }("weak_dependency"), Number: func(v int32) *int32 { return &v }(11), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xe, 0x77, 0x65, 0x61, 0x6b, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79}}, {Name: func(v string) *string { return &v }("message_type"), Number: func(v int32) *int32 { return &v }(4), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.DescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("enum_type"), Number: func(v int32) *int32 { return &v }(5), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.EnumDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x65, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("service"), Number: func(v int32) *int32 { return &v }(6), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.ServiceDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65}}, {Name: func(v string) *string { return &v }("extension"), Number: func(v int32) *int32 { return &v }(7), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.FieldDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e}}, {Name: func(v string) *string { return &v }("options"), Number: func(v int32) *int32 { return &v }(8), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.FileOptions"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73}}, {Name: func(v string) *string { return &v }("source_code_info"), Number: func(v int32) *int32 { return &v }(9), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.SourceCodeInfo"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xe, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f}}, {Name: func(v string) *string { return &v }("syntax"), Number: func(v int32) *int32 { return &v }(12), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x73, 0x79, 0x6e, 0x74, 0x61, 0x78}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("DescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("field"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.FieldDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x66, 0x69, 0x65, 0x6c, 0x64}}, {Name: func(v string) *string { return &v }("extension"), Number: func(v int32) *int32 { return &v }(6), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.FieldDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e}}, {Name: func(v string) *string { return &v }("nested_type"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.DescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("enum_type"), Number: func(v int32) *int32 { return &v }(4), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.EnumDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x65, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("extension_range"), Number: func(v int32) *int32 { return &v }(5), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.DescriptorProto.ExtensionRange"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xe, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65}}, {Name: func(v string) *string { return &v }("oneof_decl"), Number: func(v int32) *int32 { return &v }(8), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.OneofDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x44, 0x65, 0x63, 0x6c}}, {Name: func(v string) *string { return &v }("options"), Number: func(v int32) *int32 { return &v }(7), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto{{Name: func(v string) *string { return &v }("ExtensionRange"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("start"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x73, 0x74, 0x61, 0x72, 0x74}}, {Name: func(v string) *string { return &v }("end"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x3, 0x65, 0x6e, 0x64}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}}, EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("FieldDescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("number"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("label"), Number: func(v int32) *int32 { return &v }(4), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(14), TypeName: func(v string) *string { return &v }(".google.protobuf.FieldDescriptorProto.Label"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x6c, 0x61, 0x62, 0x65, 0x6c}}, {Name: func(v string) *string { return &v }("type"), Number: func(v int32) *int32 { return &v }(5), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(14), TypeName: func(v string) *string { return &v }(".google.protobuf.FieldDescriptorProto.Type"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x74, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("type_name"), Number: func(v int32) *int32 { return &v }(6), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("extendee"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65}}, {Name: func(v string) *string { return &v }("default_value"), Number: func(v int32) *int32 { return &v }(7), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xc, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65}}, {Name: func(v string) *string { return &v }("oneof_index"), Number: func(v int32) *int32 { return &v }(9), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78}}, {Name: func(v string) *string { return &v }("options"), Number: func(v int32) *int32 { return &v }(8), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto{{Name: func(v string) *string { return &v }("Type"), Value: []*google_protobuf.EnumValueDescriptorProto{{Name: func(v string) *string { return &v }("TYPE_DOUBLE"), Number: func(v int32) *int32 { return &v }(1), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_FLOAT"), Number: func(v int32) *int32 { return &v }(2), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_INT64"), Number: func(v int32) *int32 { return &v }(3), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_UINT64"), Number: func(v int32) *int32 { return &v }(4), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_INT32"), Number: func(v int32) *int32 { return &v }(5), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_FIXED64"), Number: func(v int32) *int32 { return &v }(6), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_FIXED32"), Number: func(v int32) *int32 { return &v }(7), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_BOOL"), Number: func(v int32) *int32 { return &v }(8), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_STRING"), Number: func(v int32) *int32 { return &v }(9), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_GROUP"), Number: func(v int32) *int32 { return &v }(10), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_MESSAGE"), Number: func(v int32) *int32 { return &v }(11), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_BYTES"), Number: func(v int32) *int32 { return &v }(12), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_UINT32"), Number: func(v int32) *int32 { return &v }(13), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_ENUM"), Number: func(v int32) *int32 { return &v }(14), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_SFIXED32"), Number: func(v int32) *int32 { return &v }(15), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_SFIXED64"), Number: func(v int32) *int32 { return &v }(16), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_SINT32"), Number: func(v int32) *int32 { return &v }(17), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("TYPE_SINT64"), Number: func(v int32) *int32 { return &v }(18), Options: nil, XXX_unrecognized: []byte(nil)}}, Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("Label"), Value: []*google_protobuf.EnumValueDescriptorProto{{Name: func(v string) *string { return &v }("LABEL_OPTIONAL"), Number: func(v int32) *int32 { return &v }(1), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("LABEL_REQUIRED"), Number: func(v int32) *int32 { return &v }(2), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("LABEL_REPEATED"), Number: func(v int32) *int32 { return &v }(3), Options: nil, XXX_unrecognized: []byte(nil)}}, Options: nil, XXX_unrecognized: []byte(nil)}}, ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("OneofDescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("EnumDescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("value"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.EnumValueDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x76, 0x61, 0x6c, 0x75, 0x65}}, {Name: func(v string) *string { return &v }("options"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.EnumOptions"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("EnumValueDescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("number"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("options"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.EnumValueOptions"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("ServiceDescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("method"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.MethodDescriptorProto"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64}}, {Name: func(v string) *string { return &v }("options"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.ServiceOptions"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("MethodDescriptorProto"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("input_type"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("output_type"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("options"), Number: func(v int32) *int32 { return &v }(4), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.MethodOptions"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73}}, {Name: func(v string) *string { return &v }("client_streaming"), Number: func(v int32) *int32 { return &v }(5), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xf, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67}}, {Name: func(v string) *string { return &v }("server_streaming"), Number: func(v int32) *int32 { return &v }(6), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xf, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("FileOptions"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("java_package"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x6a, 0x61, 0x76, 0x61, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65}}, {Name: func(v string) *string { return &v }("java_outer_classname"), Number: func(v int32) *int32 { return &v }(8), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x12, 0x6a, 0x61, 0x76, 0x61, 0x4f, 0x75, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("java_multiple_files"), Number: func(v int32) *int32 { return &v }(10), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x11, 0x6a, 0x61, 0x76, 0x61, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73}}, {Name: func(v string) *string { return &v }("java_generate_equals_and_hash"), Number: func(v int32) *int32 { return &v }(20), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x19, 0x6a, 0x61, 0x76, 0x61, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x41, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68}}, {Name: func(v string) *string { return &v }("optimize_for"), Number: func(v int32) *int32 { return &v }(9), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(14), TypeName: func(v string) *string { return &v }(".google.protobuf.FileOptions.OptimizeMode"), Extendee: nil, DefaultValue: func(v string) *string { return &v }("SPEED"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x46, 0x6f, 0x72}}, {Name: func(v string) *string { return &v }("go_package"), Number: func(v int32) *int32 { return &v }(11), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x67, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65}}, {Name: func(v string) *string { return &v }("cc_generic_services"), Number: func(v int32) *int32 { return &v }(16), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x11, 0x63, 0x63, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73}}, {Name: func(v string) *string { return &v }("java_generic_services"), Number: func(v int32) *int32 { return &v }(17), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x6a, 0x61, 0x76, 0x61, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73}}, {Name: func(v string) *string { return &v }("py_generic_services"), Number: func(v int32) *int32 { return &v }(18), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x11, 0x70, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73}}, {Name: func(v string) *string { return &v }("uninterpreted_option"), Number: func(v int32) *int32 { return &v }(999), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x75, 0x6e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto{{Name: func(v string) *string { return &v }("OptimizeMode"), Value: []*google_protobuf.EnumValueDescriptorProto{{Name: func(v string) *string { return &v }("SPEED"), Number: func(v int32) *int32 { return &v }(1), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("CODE_SIZE"), Number: func(v int32) *int32 { return &v }(2), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("LITE_RUNTIME"), Number: func(v int32) *int32 { return &v }(3), Options: nil, XXX_unrecognized: []byte(nil)}}, Options: nil, XXX_unrecognized: []byte(nil)}}, ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(1000), End: func(v int32) *int32 { return &v }(536870912), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("MessageOptions"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("message_set_wire_format"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x14, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x74, 0x57, 0x69, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74}}, {Name: func(v string) *string { return &v }("no_standard_descriptor_accessor"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x1c, 0x6e, 0x6f, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72}}, {Name: func(v string) *string { return &v }("map_entry"), Number: func(v int32) *int32 { return &v }(7), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x6d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79}}, {Name: func(v string) *string { return &v }("uninterpreted_option"), Number: func(v int32) *int32 { return &v }(999), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x75, 0x6e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(1000), End: func(v int32) *int32 { return &v }(536870912), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("FieldOptions"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("ctype"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(14), TypeName: func(v string) *string { return &v }(".google.protobuf.FieldOptions.CType"), Extendee: nil, DefaultValue: func(v string) *string { return &v }("STRING"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x63, 0x74, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("packed"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64}}, {Name: func(v string) *string { return &v }("lazy"), Number: func(v int32) *int32 { return &v }(5), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6c, 0x61, 0x7a, 0x79}}, {Name: func(v string) *string { return &v }("deprecated"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64}}, {Name: func(v string) *string { return &v }("experimental_map_key"), Number: func(v int32) *int32 { return &v }(9), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79}}, {Name: func(v string) *string { return &v }("weak"), Number: func(v int32) *int32 { return &v }(10), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("false"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x77, 0x65, 0x61, 0x6b}}, {Name: func(v string) *string { return &v }("interpreted_customtype"), Number: func(v int32) *int32 { return &v }(616), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x15, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x74, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("uninterpreted_option"), Number: func(v int32) *int32 { return &v }(999), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x75, 0x6e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto{{Name: func(v string) *string { return &v }("CType"), Value: []*google_protobuf.EnumValueDescriptorProto{{Name: func(v string) *string { return &v }("STRING"), Number: func(v int32) *int32 { return &v }(0), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("CORD"), Number: func(v int32) *int32 { return &v }(1), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("STRING_PIECE"), Number: func(v int32) *int32 { return &v }(2), Options: nil, XXX_unrecognized: []byte(nil)}}, Options: nil, XXX_unrecognized: []byte(nil)}}, ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(1000), End: func(v int32) *int32 { return &v }(536870912), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("EnumOptions"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("allow_alias"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("true"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x6c, 0x69, 0x61, 0x73}}, {Name: func(v string) *string { return &v }("uninterpreted_option"), Number: func(v int32) *int32 { return &v }(999), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x75, 0x6e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(1000), End: func(v int32) *int32 { return &v }(536870912), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("EnumValueOptions"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("uninterpreted_option"), Number: func(v int32) *int32 { return &v }(999), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x75, 0x6e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(1000), End: func(v int32) *int32 { return &v }(536870912), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("ServiceOptions"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("uninterpreted_option"), Number: func(v int32) *int32 { return &v }(999), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x75, 0x6e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(1000), End: func(v int32) *int32 { return &v }(536870912), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("MethodOptions"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("uninterpreted_option"), Number: func(v int32) *int32 { return &v }(999), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x75, 0x6e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(1000), End: func(v int32) *int32 { return &v }(536870912), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("UninterpretedOption"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.UninterpretedOption.NamePart"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("identifier_value"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xf, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65}}, {Name: func(v string) *string { return &v }("positive_int_value"), Number: func(v int32) *int32 { return &v }(4), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(4), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65}}, {Name: func(v string) *string { return &v }("negative_int_value"), Number: func(v int32) *int32 { return &v }(5), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(3), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x10, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65}}, {Name: func(v string) *string { return &v }("double_value"), Number: func(v int32) *int32 { return &v }(6), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(1), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65}}, {Name: func(v string) *string { return &v }("string_value"), Number: func(v int32) *int32 { return &v }(7), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(12), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65}}, {Name: func(v string) *string { return &v }("aggregate_value"), Number: func(v int32) *int32 { return &v }(8), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xe, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto{{Name: func(v string) *string { return &v }("NamePart"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name_part"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(2), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x74}}, {Name: func(v string) *string { return &v }("is_extension"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(2), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x69, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}}, EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("SourceCodeInfo"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("location"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".google.protobuf.SourceCodeInfo.Location"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto{{Name: func(v string) *string { return &v }("Location"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("path"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: &google_protobuf.FieldOptions{Ctype: nil, Packed: func(v bool) *bool { return &v }(true), Lazy: nil, Deprecated: nil, ExperimentalMapKey: nil, Weak: nil, UninterpretedOption: []*google_protobuf.UninterpretedOption(nil), XXX_extensions: map[int32]proto.Extension{}, XXX_unrecognized: []byte(nil)}, XXX_unrecognized: []byte{0x52, 0x4, 0x70, 0x61, 0x74, 0x68}}, {Name: func(v string) *string { return &v }("span"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: &google_protobuf.FieldOptions{Ctype: nil, Packed: func(v bool) *bool { return &v }(true), Lazy: nil, Deprecated: nil, ExperimentalMapKey: nil, Weak: nil, UninterpretedOption: []*google_protobuf.UninterpretedOption(nil), XXX_extensions: map[int32]proto.Extension{}, XXX_unrecognized: []byte(nil)}, XXX_unrecognized: []byte{0x52, 0x4, 0x73, 0x70, 0x61, 0x6e}}, {Name: func(v string) *string { return &v }("leading_comments"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xf, 0x6c, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73}}, {Name: func(v string) *string { return &v }("trailing_comments"), Number: func(v int32) *int32 { return &v }(4), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x10, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}}, EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}}, EnumType: []*google_protobuf.EnumDescriptorProto(nil), Service: []*google_protobuf.ServiceDescriptorProto(nil), Extension: []*google_protobuf.FieldDescriptorProto(nil), Options: &google_protobuf.FileOptions{JavaPackage: func(v string) *string { return &v }("com.google.protobuf"), JavaOuterClassname: func(v string) *string { return &v }("DescriptorProtos"), JavaMultipleFiles: nil, JavaGenerateEqualsAndHash: nil, OptimizeFor: func(v google_protobuf.FileOptions_OptimizeMode) *google_protobuf.FileOptions_OptimizeMode { return &v }(1), GoPackage: nil, CcGenericServices: nil, JavaGenericServices: nil, PyGenericServices: nil, UninterpretedOption: []*google_protobuf.UninterpretedOption(nil), XXX_extensions: map[int32]proto.Extension{}, XXX_unrecognized: []byte(nil)}, SourceCodeInfo: nil, Syntax: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("github.com/dropbox/goprotoc/gogoproto/gogo.proto"), Package: func(v string) *string { return &v }("gogoproto"), Dependency: []string{"github.com/dropbox/goprotoc/protobuf/google/protobuf/descriptor.proto"}, PublicDependency: []int32(nil), WeakDependency: []int32(nil), MessageType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), Service: []*google_protobuf.ServiceDescriptorProto(nil), Extension: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("goproto_enum_prefix"), Number: func(v int32) *int32 { return &v }(62001), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.EnumOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x11, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78}}, {Name: func(v string) *string { return &v }("goproto_enum_stringer"), Number: func(v int32) *int32 { return &v }(62021), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.EnumOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x13, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("enum_stringer"), Number: func(v int32) *int32 { return &v }(62022), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.EnumOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xc, 0x65, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("goproto_getters_all"), Number: func(v int32) *int32 { return &v }(63001), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x11, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("goproto_enum_prefix_all"), Number: func(v int32) *int32 { return &v }(63002), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x14, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("goproto_stringer_all"), Number: func(v int32) *int32 { return &v }(63003), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x12, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("verbose_equal_all"), Number: func(v int32) *int32 { return &v }(63004), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xf, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("face_all"), Number: func(v int32) *int32 { return &v }(63005), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x66, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("gostring_all"), Number: func(v int32) *int32 { return &v }(63006), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x67, 0x6f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("populate_all"), Number: func(v int32) *int32 { return &v }(63007), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("stringer_all"), Number: func(v int32) *int32 { return &v }(63008), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("onlyone_all"), Number: func(v int32) *int32 { return &v }(63009), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x6f, 0x6e, 0x6c, 0x79, 0x6f, 0x6e, 0x65, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("equal_all"), Number: func(v int32) *int32 { return &v }(63013), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("description_all"), Number: func(v int32) *int32 { return &v }(63014), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xe, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("testgen_all"), Number: func(v int32) *int32 { return &v }(63015), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x74, 0x65, 0x73, 0x74, 0x67, 0x65, 0x6e, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("benchgen_all"), Number: func(v int32) *int32 { return &v }(63016), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x67, 0x65, 0x6e, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("marshaler_all"), Number: func(v int32) *int32 { return &v }(63017), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xc, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("unmarshaler_all"), Number: func(v int32) *int32 { return &v }(63018), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xe, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("bufferto_all"), Number: func(v int32) *int32 { return &v }(63019), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x74, 0x6f, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("sizer_all"), Number: func(v int32) *int32 { return &v }(63020), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x73, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("goproto_enum_stringer_all"), Number: func(v int32) *int32 { return &v }(63021), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x16, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("enum_stringer_all"), Number: func(v int32) *int32 { return &v }(63022), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xf, 0x65, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("goproto_extensions_map_all"), Number: func(v int32) *int32 { return &v }(63025), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x17, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("setter_all"), Number: func(v int32) *int32 { return &v }(63026), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("pool_all"), Number: func(v int32) *int32 { return &v }(63027), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x70, 0x6f, 0x6f, 0x6c, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("zero_copy_all"), Number: func(v int32) *int32 { return &v }(63028), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x7a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x70, 0x79, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("goproto_jsonpb_all"), Number: func(v int32) *int32 { return &v }(63029), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FileOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x10, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x4a, 0x73, 0x6f, 0x6e, 0x70, 0x62, 0x41, 0x6c, 0x6c}}, {Name: func(v string) *string { return &v }("goproto_getters"), Number: func(v int32) *int32 { return &v }(64001), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xe, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73}}, {Name: func(v string) *string { return &v }("goproto_stringer"), Number: func(v int32) *int32 { return &v }(64003), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xf, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("verbose_equal"), Number: func(v int32) *int32 { return &v }(64004), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xc, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c}}, {Name: func(v string) *string { return &v }("face"), Number: func(v int32) *int32 { return &v }(64005), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x66, 0x61, 0x63, 0x65}}, {Name: func(v string) *string { return &v }("gostring"), Number: func(v int32) *int32 { return &v }(64006), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x67, 0x6f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67}}, {Name: func(v string) *string { return &v }("populate"), Number: func(v int32) *int32 { return &v }(64007), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65}}, {Name: func(v string) *string { return &v }("stringer"), Number: func(v int32) *int32 { return &v }(67008), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("onlyone"), Number: func(v int32) *int32 { return &v }(64009), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6f, 0x6e, 0x6c, 0x79, 0x6f, 0x6e, 0x65}}, {Name: func(v string) *string { return &v }("equal"), Number: func(v int32) *int32 { return &v }(64013), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x65, 0x71, 0x75, 0x61, 0x6c}}, {Name: func(v string) *string { return &v }("description"), Number: func(v int32) *int32 { return &v }(64014), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e}}, {Name: func(v string) *string { return &v }("testgen"), Number: func(v int32) *int32 { return &v }(64015), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x74, 0x65, 0x73, 0x74, 0x67, 0x65, 0x6e}}, {Name: func(v string) *string { return &v }("benchgen"), Number: func(v int32) *int32 { return &v }(64016), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x67, 0x65, 0x6e}}, {Name: func(v string) *string { return &v }("marshaler"), Number: func(v int32) *int32 { return &v }(64017), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("unmarshaler"), Number: func(v int32) *int32 { return &v }(64018), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xb, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("bufferto"), Number: func(v int32) *int32 { return &v }(64019), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x74, 0x6f}}, {Name: func(v string) *string { return &v }("sizer"), Number: func(v int32) *int32 { return &v }(64020), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x73, 0x69, 0x7a, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("goproto_extensions_map"), Number: func(v int32) *int32 { return &v }(64025), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x14, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x61, 0x70}}, {Name: func(v string) *string { return &v }("setter"), Number: func(v int32) *int32 { return &v }(64026), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("pool"), Number: func(v int32) *int32 { return &v }(64027), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x70, 0x6f, 0x6f, 0x6c}}, {Name: func(v string) *string { return &v }("zero_copy"), Number: func(v int32) *int32 { return &v }(64028), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x7a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x70, 0x79}}, {Name: func(v string) *string { return &v }("goproto_jsonpb"), Number: func(v int32) *int32 { return &v }(64029), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.MessageOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xd, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x4a, 0x73, 0x6f, 0x6e, 0x70, 0x62}}, {Name: func(v string) *string { return &v }("nullable"), Number: func(v int32) *int32 { return &v }(65001), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65}}, {Name: func(v string) *string { return &v }("embed"), Number: func(v int32) *int32 { return &v }(65002), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x65, 0x6d, 0x62, 0x65, 0x64}}, {Name: func(v string) *string { return &v }("customtype"), Number: func(v int32) *int32 { return &v }(65003), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x74, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("customname"), Number: func(v int32) *int32 { return &v }(65004), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0xa, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("jsontag"), Number: func(v int32) *int32 { return &v }(65005), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x6a, 0x73, 0x6f, 0x6e, 0x74, 0x61, 0x67}}, {Name: func(v string) *string { return &v }("moretags"), Number: func(v int32) *int32 { return &v }(65006), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x6d, 0x6f, 0x72, 0x65, 0x74, 0x61, 0x67, 0x73}}, {Name: func(v string) *string { return &v }("casttype"), Number: func(v int32) *int32 { return &v }(65007), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x63, 0x61, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65}}, {Name: func(v string) *string { return &v }("castkey"), Number: func(v int32) *int32 { return &v }(65008), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: func(v string) *string { return &v }(".google.protobuf.FieldOptions"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x63, 0x61, 0x73, 0x74, 0x6b, 0x65, 0x79}}}, Options: nil, SourceCodeInfo: nil, Syntax: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("dynamic.proto"), Package: func(v string) *string { return &v }("dynamic"), Dependency: []string{"github.com/dropbox/goprotoc/gogoproto/gogo.proto"}, PublicDependency: []int32(nil), WeakDependency: []int32(nil), MessageType: []*google_protobuf.DescriptorProto{{Name: func(v string) *string { return &v }("Inner"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("name"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x61, 0x6d, 0x65}}, {Name: func(v string) *string { return &v }("id"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(3), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x2, 0x69, 0x64}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("Everything"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("f_double"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(1), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x66, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65}}, {Name: func(v string) *string { return &v }("f_float"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(2), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x66, 0x46, 0x6c, 0x6f, 0x61, 0x74}}, {Name: func(v string) *string { return &v }("f_int32"), Number: func(v int32) *int32 { return &v }(3), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x66, 0x49, 0x6e, 0x74, 0x33, 0x32}}, {Name: func(v string) *string { return &v }("f_int64"), Number: func(v int32) *int32 { return &v }(4), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(3), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x66, 0x49, 0x6e, 0x74, 0x36, 0x34}}, {Name: func(v string) *string { return &v }("f_uint32"), Number: func(v int32) *int32 { return &v }(5), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(13), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32}}, {Name: func(v string) *string { return &v }("f_uint64"), Number: func(v int32) *int32 { return &v }(6), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(4), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34}}, {Name: func(v string) *string { return &v }("f_sint32"), Number: func(v int32) *int32 { return &v }(7), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(17), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x66, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32}}, {Name: func(v string) *string { return &v }("f_sint64"), Number: func(v int32) *int32 { return &v }(8), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(18), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x66, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34}}, {Name: func(v string) *string { return &v }("f_fixed32"), Number: func(v int32) *int32 { return &v }(9), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(7), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x66, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32}}, {Name: func(v string) *string { return &v }("f_fixed64"), Number: func(v int32) *int32 { return &v }(10), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(6), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x66, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34}}, {Name: func(v string) *string { return &v }("f_sfixed32"), Number: func(v int32) *int32 { return &v }(11), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(15), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x66, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32}}, {Name: func(v string) *string { return &v }("f_sfixed64"), Number: func(v int32) *int32 { return &v }(12), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(16), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x9, 0x66, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34}}, {Name: func(v string) *string { return &v }("f_bool"), Number: func(v int32) *int32 { return &v }(13), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(8), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x66, 0x42, 0x6f, 0x6f, 0x6c}}, {Name: func(v string) *string { return &v }("f_string"), Number: func(v int32) *int32 { return &v }(14), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x7, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67}}, {Name: func(v string) *string { return &v }("f_bytes"), Number: func(v int32) *int32 { return &v }(15), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(12), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73}}, {Name: func(v string) *string { return &v }("color"), Number: func(v int32) *int32 { return &v }(16), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(14), TypeName: func(v string) *string { return &v }(".dynamic.Color"), Extendee: nil, DefaultValue: func(v string) *string { return &v }("GREEN"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x63, 0x6f, 0x6c, 0x6f, 0x72}}, {Name: func(v string) *string { return &v }("inner"), Number: func(v int32) *int32 { return &v }(17), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".dynamic.Inner"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x69, 0x6e, 0x6e, 0x65, 0x72}}, {Name: func(v string) *string { return &v }("inners"), Number: func(v int32) *int32 { return &v }(18), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".dynamic.Inner"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73}}, {Name: func(v string) *string { return &v }("unpacked"), Number: func(v int32) *int32 { return &v }(19), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(17), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x8, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64}}, {Name: func(v string) *string { return &v }("packed"), Number: func(v int32) *int32 { return &v }(20), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(17), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: &google_protobuf.FieldOptions{Ctype: nil, Packed: func(v bool) *bool { return &v }(true), Lazy: nil, Deprecated: nil, ExperimentalMapKey: nil, Weak: nil, UninterpretedOption: []*google_protobuf.UninterpretedOption(nil), XXX_extensions: map[int32]proto.Extension{}, XXX_unrecognized: []byte(nil)}, XXX_unrecognized: []byte{0x52, 0x6, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64}}, {Name: func(v string) *string { return &v }("names"), Number: func(v int32) *int32 { return &v }(21), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x6e, 0x61, 0x6d, 0x65, 0x73}}, {Name: func(v string) *string { return &v }("point"), Number: func(v int32) *int32 { return &v }(22), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(10), TypeName: func(v string) *string { return &v }(".dynamic.Everything.Point"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x70, 0x6f, 0x69, 0x6e, 0x74}}, {Name: func(v string) *string { return &v }("named"), Number: func(v int32) *int32 { return &v }(25), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".dynamic.Everything.NamedEntry"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x6e, 0x61, 0x6d, 0x65, 0x64}}, {Name: func(v string) *string { return &v }("text"), Number: func(v int32) *int32 { return &v }(26), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: func(v int32) *int32 { return &v }(0), Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x74, 0x65, 0x78, 0x74}}, {Name: func(v string) *string { return &v }("nested"), Number: func(v int32) *int32 { return &v }(27), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".dynamic.Inner"), Extendee: nil, DefaultValue: nil, OneofIndex: func(v int32) *int32 { return &v }(0), Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64}}, {Name: func(v string) *string { return &v }("answer"), Number: func(v int32) *int32 { return &v }(28), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: func(v string) *string { return &v }("42"), OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x6, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto{{Name: func(v string) *string { return &v }("Point"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("x"), Number: func(v int32) *int32 { return &v }(23), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x1, 0x78}}, {Name: func(v string) *string { return &v }("y"), Number: func(v int32) *int32 { return &v }(24), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(5), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x1, 0x79}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("NamedEntry"), Field: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("key"), Number: func(v int32) *int32 { return &v }(1), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x3, 0x6b, 0x65, 0x79}}, {Name: func(v string) *string { return &v }("value"), Number: func(v int32) *int32 { return &v }(2), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(11), TypeName: func(v string) *string { return &v }(".dynamic.Inner"), Extendee: nil, DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x76, 0x61, 0x6c, 0x75, 0x65}}}, Extension: []*google_protobuf.FieldDescriptorProto(nil), NestedType: []*google_protobuf.DescriptorProto(nil), EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange(nil), OneofDecl: []*google_protobuf.OneofDescriptorProto(nil), Options: &google_protobuf.MessageOptions{MessageSetWireFormat: nil, NoStandardDescriptorAccessor: nil, MapEntry: func(v bool) *bool { return &v }(true), UninterpretedOption: []*google_protobuf.UninterpretedOption(nil), XXX_extensions: map[int32]proto.Extension{}, XXX_unrecognized: []byte(nil)}, XXX_unrecognized: []byte(nil)}}, EnumType: []*google_protobuf.EnumDescriptorProto(nil), ExtensionRange: []*google_protobuf.DescriptorProto_ExtensionRange{{Start: func(v int32) *int32 { return &v }(100), End: func(v int32) *int32 { return &v }(200), XXX_unrecognized: []byte(nil)}}, OneofDecl: []*google_protobuf.OneofDescriptorProto{{Name: func(v string) *string { return &v }("choice"), XXX_unrecognized: []byte(nil)}}, Options: nil, XXX_unrecognized: []byte(nil)}}, EnumType: []*google_protobuf.EnumDescriptorProto{{Name: func(v string) *string { return &v }("Color"), Value: []*google_protobuf.EnumValueDescriptorProto{{Name: func(v string) *string { return &v }("RED"), Number: func(v int32) *int32 { return &v }(1), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("GREEN"), Number: func(v int32) *int32 { return &v }(2), Options: nil, XXX_unrecognized: []byte(nil)}, {Name: func(v string) *string { return &v }("BLUE"), Number: func(v int32) *int32 { return &v }(3), Options: nil, XXX_unrecognized: []byte(nil)}}, Options: nil, XXX_unrecognized: []byte(nil)}}, Service: []*google_protobuf.ServiceDescriptorProto(nil), Extension: []*google_protobuf.FieldDescriptorProto{{Name: func(v string) *string { return &v }("note"), Number: func(v int32) *int32 { return &v }(100), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(1), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(9), TypeName: nil, Extendee: func(v string) *string { return &v }(".dynamic.Everything"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x4, 0x6e, 0x6f, 0x74, 0x65}}, {Name: func(v string) *string { return &v }("marks"), Number: func(v int32) *int32 { return &v }(101), Label: func(v google_protobuf.FieldDescriptorProto_Label) *google_protobuf.FieldDescriptorProto_Label {
+		return &v
+	}(3), Type: func(v google_protobuf.FieldDescriptorProto_Type) *google_protobuf.FieldDescriptorProto_Type {
+		return &v
+	}(3), TypeName: nil, Extendee: func(v string) *string { return &v }(".dynamic.Everything"), DefaultValue: nil, OneofIndex: nil, Options: nil, XXX_unrecognized: []byte{0x52, 0x5, 0x6d, 0x61, 0x72, 0x6b, 0x73}}}, Options: &google_protobuf.FileOptions{JavaPackage: nil, JavaOuterClassname: nil, JavaMultipleFiles: nil, JavaGenerateEqualsAndHash: nil, OptimizeFor: nil, GoPackage: nil, CcGenericServices: nil, JavaGenericServices: nil, PyGenericServices: nil, UninterpretedOption: []*google_protobuf.UninterpretedOption(nil), XXX_extensions: map[int32]proto.Extension{63014: proto.NewExtension([]byte{0xb0, 0xe2, 0x1e, 0x1})}, XXX_unrecognized: []byte(nil)}, SourceCodeInfo: nil, Syntax: nil, XXX_unrecognized: []byte(nil)}}, XXX_unrecognized: []byte(nil)}
+}
@@ -0,0 +1,328 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	dyn "github.com/dropbox/goprotoc/dynamic"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newTypes(t *testing.T) *dyn.Types {
+	types, err := dyn.NewTypes(DynamicDescription())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types
+}
+
+func newEverything() *Everything {
+	m := &Everything{}
+	m.SetFDouble(1.5)
+	m.SetFFloat(2.5)
+	m.SetFInt32(-3)
+	m.SetFInt64(-4)
+	m.SetFUint32(5)
+	m.SetFUint64(6)
+	m.SetFSint32(-7)
+	m.SetFSint64(-8)
+	m.SetFFixed32(9)
+	m.SetFFixed64(10)
+	m.SetFSfixed32(-11)
+	m.SetFSfixed64(-12)
+	m.SetFBool(true)
+	m.SetFString("string")
+	m.SetFBytes([]byte("bytes"))
+	m.SetColor(Color_BLUE)
+	inner, _ := m.MutateInner()
+	inner.SetName("inner")
+	inner, _ = m.AddInners()
+	inner.SetId(1)
+	inner, _ = m.AddInners()
+	inner.SetId(2)
+	m.AddUnpacked(-1)
+	m.AddUnpacked(2)
+	m.AddPacked(-3)
+	m.AddPacked(4)
+	m.AddNames("a")
+	m.AddNames("b")
+	point, _ := m.MutatePoint()
+	point.SetX(1)
+	point.SetY(-1)
+	named := &Inner{}
+	named.SetName("named")
+	m.PutNamed("key", named)
+	m.SetText("text")
+	return m
+}
+
+func TestUnmarshalGenerated(t *testing.T) {
+	types := newTypes(t)
+	data, err := proto.Marshal(newEverything())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := types.New("dynamic.Everything")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]interface{}{
+		"f_double":   float64(1.5),
+		"f_float":    float32(2.5),
+		"f_int32":    int32(-3),
+		"f_int64":    int64(-4),
+		"f_uint32":   uint32(5),
+		"f_uint64":   uint64(6),
+		"f_sint32":   int32(-7),
+		"f_sint64":   int64(-8),
+		"f_fixed32":  uint32(9),
+		"f_fixed64":  uint64(10),
+		"f_sfixed32": int32(-11),
+		"f_sfixed64": int64(-12),
+		"f_bool":     true,
+		"f_string":   "string",
+		"f_bytes":    []byte("bytes"),
+		"color":      int32(Color_BLUE),
+		"unpacked":   []interface{}{int32(-1), int32(2)},
+		"packed":     []interface{}{int32(-3), int32(4)},
+		"names":      []interface{}{"a", "b"},
+		"text":       "text",
+		"answer":     int32(42),
+	} {
+		if got := m.Get(name); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s is %#v, want %#v", name, got, want)
+		}
+	}
+	if got := m.Get("inner").(*dyn.Message).Get("name"); got != "inner" {
+		t.Errorf("inner.name is %v, want inner", got)
+	}
+	if got := m.Get("inners").([]interface{}); len(got) != 2 || got[1].(*dyn.Message).Get("id") != int64(2) {
+		t.Errorf("inners is %v", got)
+	}
+	if got := m.GetByNumber(22).(*dyn.Message).Get("y"); got != int32(-1) {
+		t.Errorf("Point.y is %v, want -1", got)
+	}
+	entry := m.Get("named").([]interface{})[0].(*dyn.Message)
+	if entry.Get("key") != "key" || entry.Get("value").(*dyn.Message).Get("name") != "named" {
+		t.Errorf("named entry is %v", entry)
+	}
+	if m.Has("nested") || !m.Has("text") {
+		t.Errorf("oneof is %v, want text", m)
+	}
+
+	// The fields are written in the same order as by the generated code.
+	got, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Marshal is %x, want %x", got, data)
+	}
+}
+
+func TestMarshalToGenerated(t *testing.T) {
+	types := newTypes(t)
+	m, _ := types.New("dynamic.Everything")
+	if err := m.Set("f_sint64", int64(-8)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("color", int32(Color_RED)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add("packed", int32(-3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("names", []interface{}{"a", "b"}); err != nil {
+		t.Fatal(err)
+	}
+	inner, err := m.AddMessage("inners")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner.Set("name", "first")
+	point, err := m.Mutate("point")
+	if err != nil {
+		t.Fatal(err)
+	}
+	point.Set("x", int32(7))
+	m.Set("text", "text")
+	nested, _ := m.Mutate("nested")
+	nested.Set("id", int64(3))
+	if m.Has("text") {
+		t.Errorf("setting nested did not clear text")
+	}
+
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &Everything{}
+	if err := proto.Unmarshal(data, g); err != nil {
+		t.Fatal(err)
+	}
+	if g.GetFSint64() != -8 || g.GetColor() != Color_RED {
+		t.Errorf("scalars are %d, %v", g.GetFSint64(), g.GetColor())
+	}
+	if v, _ := g.GetPacked(0); g.PackedSize() != 1 || v != -3 {
+		t.Errorf("packed is %v", g)
+	}
+	if v, _ := g.GetNames(1); g.NamesSize() != 2 || v != "b" {
+		t.Errorf("names is %v", g)
+	}
+	if v, _ := g.GetInners(0); g.InnersSize() != 1 || v.GetName() != "first" {
+		t.Errorf("inners is %v", g)
+	}
+	if g.GetPoint().GetX() != 7 {
+		t.Errorf("Point is %v", g.GetPoint())
+	}
+	if g.WhichChoice() != Everything_ChoiceCase_Nested || g.GetNested().GetId() != 3 {
+		t.Errorf("choice is %v", g)
+	}
+}
+
+func TestSetChecksType(t *testing.T) {
+	types := newTypes(t)
+	m, _ := types.New("dynamic.Everything")
+	if err := m.Set("f_int32", int64(1)); err == nil {
+		t.Errorf("set int32 field to int64")
+	}
+	if err := m.Set("names", "a"); err == nil {
+		t.Errorf("set repeated field to a string")
+	}
+	if err := m.Set("inner", types.Type("dynamic.Everything").New()); err == nil {
+		t.Errorf("set inner to an Everything")
+	}
+	if err := m.Add("f_string", "a"); err == nil {
+		t.Errorf("added to singular field")
+	}
+	if err := m.Set("missing", 1); err == nil {
+		t.Errorf("set missing field")
+	}
+	if m.Has("f_int32") || m.Has("names") || m.Has("inner") {
+		t.Errorf("failed sets changed %v", m)
+	}
+	if m.Get("color") != int32(Color_GREEN) {
+		t.Errorf("default color is %v, want GREEN", m.Get("color"))
+	}
+}
+
+func TestText(t *testing.T) {
+	types := newTypes(t)
+	want := proto.MarshalTextString(newEverything())
+	m, _ := types.New("dynamic.Everything")
+	if err := proto.UnmarshalText(want, m); err != nil {
+		t.Fatal(err)
+	}
+	if got := proto.MarshalTextString(m); got != want {
+		t.Errorf("MarshalTextString is\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExtensions(t *testing.T) {
+	types := newTypes(t)
+	m, _ := types.New("dynamic.Everything")
+	if err := m.Set("dynamic.note", "note"); err != nil {
+		t.Fatal(err)
+	}
+	m.Add("dynamic.marks", int64(1))
+	m.Add("dynamic.marks", int64(2))
+
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := types.New("dynamic.Everything")
+	if err := proto.Unmarshal(data, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("binary round trip is %v, want %v", got, m)
+	}
+
+	text := proto.MarshalTextString(m)
+	got.Reset()
+	if err := proto.UnmarshalText(text, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("text round trip of %q is %v, want %v", text, got, m)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	types := newTypes(t)
+	data, err := proto.Marshal(newEverything())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the fields of Inner are known; the others are kept as they are.
+	m, err := dyn.NewMessage(types.Type("dynamic.Inner").Descriptor())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Unknown()) == 0 {
+		t.Fatalf("no unknown fields in %v", m)
+	}
+	got, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &Everything{}
+	if err := proto.Unmarshal(got, g); err != nil {
+		t.Fatal(err)
+	}
+	if !newEverything().Equal(g) {
+		t.Errorf("unknown fields were not kept: %v", g)
+	}
+}
+
+func TestClone(t *testing.T) {
+	types := newTypes(t)
+	data, _ := proto.Marshal(newEverything())
+	m, _ := types.New("dynamic.Everything")
+	if err := proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	c := proto.Clone(m).(*dyn.Message)
+	if !proto.Equal(c, m) {
+		t.Fatalf("clone is %v, want %v", c, m)
+	}
+	inner, _ := c.Mutate("inner")
+	inner.Set("name", "changed")
+	if proto.Equal(c, m) {
+		t.Errorf("changing the clone changed the original")
+	}
+}
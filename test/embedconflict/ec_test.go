@@ -27,76 +27,76 @@
 package embedconflict
 
 import (
+	"go/build"
+	"io/ioutil"
 	"os"
-	"os/exec"
 	"strings"
 	"testing"
 
-	"github.com/dropbox/goprotoc/test_config"
+	"github.com/dropbox/goprotoc/parser"
+	"github.com/dropbox/goprotoc/protoc-gen-dgo/command"
+	plugin "github.com/dropbox/goprotoc/protoc-gen-dgo/plugin"
 )
 
-func TestEmbedConflict(t *testing.T) {
-	cmd := exec.Command("protoc", "--dgo_out=.", "-I="+config.ProtoPath, "ec.proto")
-	data, err := cmd.CombinedOutput()
-	if err == nil {
-		t.Errorf("Expected error")
-		if err := os.Remove("ec.pb.go"); err != nil {
-			panic(err)
-		}
+// Generates the code of the .proto file in this directory, and returns the
+// error of the response.
+func generate(t *testing.T, name string) string {
+	pkg, err := build.Import("github.com/dropbox/goprotoc/gogoproto", "", build.FindOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := parser.ParseFiles([]string{name}, ".", pkg.SrcRoot)
+	if err != nil {
+		t.Fatal(err)
 	}
-	t.Logf("received expected error = %v and output = %v", err, string(data))
+	resp := command.Generate(&plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{name},
+		ProtoFile:      set.File,
+	})
+	return resp.GetError()
+}
+
+func expectError(t *testing.T, name string, expected string) {
+	if err := generate(t, name); err != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+}
+
+func TestEmbedConflict(t *testing.T) {
+	expectError(t, "ec.proto", "ec.proto:33:9: message embedconflict.A: field B: duplicate embedded fieldname Field1")
 }
 
 func TestEmbedMarshaler(t *testing.T) {
-	cmd := exec.Command("protoc", "--dgo_out=.", "-I="+config.ProtoPath, "em.proto")
-	data, err := cmd.CombinedOutput()
-	dataStr := string(data)
-	t.Logf("received error = %v and output = %v", err, dataStr)
-	if !strings.Contains(dataStr, "WARNING: found non-") || !strings.Contains(dataStr, "marshaler") {
-		t.Errorf("Expected WARNING: found non-[marshaler] C with embedded marshaler D")
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = w
+	resp := generate(t, "em.proto")
+	os.Stderr = stderr
+	w.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
 	}
-	if err := os.Remove("em.pb.go"); err != nil {
-		panic(err)
+	if resp != "" {
+		t.Errorf("unexpected error %q", resp)
+	}
+	warning := "WARNING: found non-[marshaler] C with embedded marshaler D"
+	if !strings.Contains(string(data), warning) {
+		t.Errorf("expected %q, got %q", warning, data)
 	}
 }
 
 func TestEmbedExtend(t *testing.T) {
-	cmd := exec.Command("protoc", "--dgo_out=.", "-I="+config.ProtoPath, "ee.proto")
-	data, err := cmd.CombinedOutput()
-	if err == nil {
-		t.Errorf("Expected error")
-		if err := os.Remove("ee.pb.go"); err != nil {
-			panic(err)
-		}
-	}
-	t.Logf("received expected error = %v and output = %v", err, string(data))
+	expectError(t, "ee.proto", "ee.proto: extended field Field1 cannot be embedded")
 }
 
 func TestCustomName(t *testing.T) {
-	cmd := exec.Command("protoc", "--dgo_out=.", "-I="+config.ProtoPath, "en.proto")
-	data, err := cmd.CombinedOutput()
-	if err == nil {
-		t.Errorf("Expected error")
-		if err := os.Remove("en.pb.go"); err != nil {
-			panic(err)
-		}
-	}
-	t.Logf("received expected error = %v and output = %v", err, string(data))
+	expectError(t, "en.proto", "en.proto:32:9: message embedconflict.F: field G: field with custom name G cannot be embedded")
 }
 
 func TestRepeatedEmbed(t *testing.T) {
-	cmd := exec.Command("protoc", "--dgo_out=.", "-I="+config.ProtoPath, "er.proto")
-	data, err := cmd.CombinedOutput()
-	if err == nil {
-		t.Errorf("Expected error")
-		if err := os.Remove("er.pb.go"); err != nil {
-			panic(err)
-		}
-	}
-	dataStr := string(data)
-	t.Logf("received error = %v and output = %v", err, dataStr)
-	warning := "ERROR: found repeated embedded field B in message A"
-	if !strings.Contains(dataStr, warning) {
-		t.Errorf("Expected " + warning)
-	}
+	expectError(t, "er.proto", "er.proto:33:9: message embedconflict.A: field B: repeated field cannot be embedded")
 }
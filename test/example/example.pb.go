@@ -408,7 +408,7 @@ func (m *E) Reset()      { *m = E{} }
 func (*E) ProtoMessage() {}
 
 var extRange_E = []proto.ExtensionRange{
-	{1, 536870911},
+	{Start: 1, End: 536870911},
 }
 
 func (m *E) ExtensionRangeArray() []proto.ExtensionRange {
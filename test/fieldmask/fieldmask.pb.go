@@ -0,0 +1,2596 @@
+// Code generated by protoc-gen-dgo.
+// source: fieldmask.proto
+// DO NOT EDIT!
+
+/*
+Package fieldmask is a generated protocol buffer package.
+
+It is generated from these files:
+
+	fieldmask.proto
+
+It has these top-level messages:
+
+	Address
+	Profile
+	User
+*/
+package fieldmask
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Address struct {
+	xxx_sizeCached   int
+	street           string
+	city             string
+	XXX_unrecognized []byte
+	xxx_IsStreetSet  bool
+	xxx_IsCitySet    bool
+}
+
+func (m *Address) Reset()         { *m = Address{} }
+func (m *Address) String() string { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()    {}
+
+func (m *Address) GetStreet() string {
+	if m != nil && m.xxx_IsStreetSet {
+		return m.street
+	}
+	return ""
+}
+
+func (m *Address) GetCity() string {
+	if m != nil && m.xxx_IsCitySet {
+		return m.city
+	}
+	return ""
+}
+
+func (m *Address) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Address) SetStreet(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsStreetSet = true
+	m.street = value
+	return nil
+}
+
+func (m *Address) HasStreet() (isSet bool) {
+	if m != nil && m.xxx_IsStreetSet {
+		return true
+	}
+	return false
+}
+
+func (m *Address) ClearStreet() {
+	if m != nil {
+		m.xxx_IsStreetSet = false
+		m.street = ""
+	}
+}
+
+func (m *Address) SetCity(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsCitySet = true
+	m.city = value
+	return nil
+}
+
+func (m *Address) HasCity() (isSet bool) {
+	if m != nil && m.xxx_IsCitySet {
+		return true
+	}
+	return false
+}
+
+func (m *Address) ClearCity() {
+	if m != nil {
+		m.xxx_IsCitySet = false
+		m.city = ""
+	}
+}
+
+func (m *Address) Clear() {
+	if m != nil {
+		m.ClearStreet()
+		m.ClearCity()
+	}
+}
+
+type Profile struct {
+	xxx_sizeCached   int
+	name             string
+	address          *Address
+	tags             []string
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+	xxx_IsAddressSet bool
+	xxx_LenTags      int
+}
+
+func (m *Profile) Reset()         { *m = Profile{} }
+func (m *Profile) String() string { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()    {}
+
+func (m *Profile) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Profile) GetAddress() *Address {
+	if m != nil && m.xxx_IsAddressSet {
+		return m.address
+	}
+	return nil
+}
+func (m *Profile) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Profile) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Profile) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Profile) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Profile) MutateAddress() (field *Address, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsAddressSet {
+		m.xxx_IsAddressSet = true
+		if m.address == nil {
+			m.address = new(Address)
+		} else {
+			m.address.Clear()
+		}
+	}
+	return m.address, nil
+}
+
+func (m *Profile) HasAddress() (isSet bool) {
+	if m != nil && m.xxx_IsAddressSet {
+		return true
+	}
+	return false
+}
+
+func (m *Profile) ClearAddress() {
+	if m != nil {
+		m.address.Clear()
+		m.xxx_IsAddressSet = false
+
+	}
+}
+
+func (m *Profile) AddTags(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.tags) <= m.xxx_LenTags {
+		newCapacity := 0
+		if len(m.tags) == 0 {
+			newCapacity = 8
+		} else if len(m.tags) < 1000000 {
+			newCapacity = m.xxx_LenTags * 2
+		} else {
+			newCapacity = m.xxx_LenTags + 1000000
+		}
+		t := make([]string, newCapacity, newCapacity)
+		copy(t, m.tags)
+		m.tags = t
+	}
+	m.tags[m.xxx_LenTags] = value
+	m.xxx_LenTags += 1
+	return nil
+}
+
+func (m *Profile) SetTags(value string, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return errors.New("Index is out of bounds")
+	}
+	m.tags[index] = value
+	return nil
+}
+
+func (m *Profile) TagsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenTags
+	}
+	return 0
+}
+
+func (m *Profile) ClearTags() {
+	if m != nil {
+		m.xxx_LenTags = 0
+	}
+}
+
+func (m *Profile) GetTags(index int) (field string, err error) {
+	if m == nil {
+		return "", errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return "", errors.New("Index is out of bounds")
+	}
+	return m.tags[index], nil
+}
+
+func (m *Profile) Clear() {
+	if m != nil {
+		m.ClearName()
+		m.address.Clear()
+		m.xxx_IsAddressSet = false
+
+		m.ClearTags()
+	}
+}
+
+type User struct {
+	xxx_sizeCached   int
+	id               int64
+	email            string
+	profile          *Profile
+	friends          []*Profile
+	labels           map[string]string
+	XXX_unrecognized []byte
+	xxx_IsIdSet      bool
+	xxx_IsEmailSet   bool
+	xxx_IsProfileSet bool
+	xxx_LenFriends   int
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+
+func (m *User) GetId() int64 {
+	if m != nil && m.xxx_IsIdSet {
+		return m.id
+	}
+	return 0
+}
+
+func (m *User) GetEmail() string {
+	if m != nil && m.xxx_IsEmailSet {
+		return m.email
+	}
+	return ""
+}
+
+func (m *User) GetProfile() *Profile {
+	if m != nil && m.xxx_IsProfileSet {
+		return m.profile
+	}
+	return nil
+}
+func (m *User) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *User) SetId(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIdSet = true
+	m.id = value
+	return nil
+}
+
+func (m *User) HasId() (isSet bool) {
+	if m != nil && m.xxx_IsIdSet {
+		return true
+	}
+	return false
+}
+
+func (m *User) ClearId() {
+	if m != nil {
+		m.xxx_IsIdSet = false
+	}
+}
+
+func (m *User) SetEmail(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsEmailSet = true
+	m.email = value
+	return nil
+}
+
+func (m *User) HasEmail() (isSet bool) {
+	if m != nil && m.xxx_IsEmailSet {
+		return true
+	}
+	return false
+}
+
+func (m *User) ClearEmail() {
+	if m != nil {
+		m.xxx_IsEmailSet = false
+		m.email = ""
+	}
+}
+
+func (m *User) MutateProfile() (field *Profile, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsProfileSet {
+		m.xxx_IsProfileSet = true
+		if m.profile == nil {
+			m.profile = new(Profile)
+		} else {
+			m.profile.Clear()
+		}
+	}
+	return m.profile, nil
+}
+
+func (m *User) HasProfile() (isSet bool) {
+	if m != nil && m.xxx_IsProfileSet {
+		return true
+	}
+	return false
+}
+
+func (m *User) ClearProfile() {
+	if m != nil {
+		m.profile.Clear()
+		m.xxx_IsProfileSet = false
+
+	}
+}
+
+func (m *User) AddFriends() (field *Profile, err error) {
+	if m != nil {
+		if len(m.friends) <= m.xxx_LenFriends {
+			newCapacity := 0
+			if len(m.friends) == 0 {
+				newCapacity = 8
+			} else if len(m.friends) < 1000000 {
+				newCapacity = m.xxx_LenFriends * 2
+			} else {
+				newCapacity = m.xxx_LenFriends + 1000000
+			}
+			t := make([]*Profile, newCapacity, newCapacity)
+			copy(t, m.friends)
+			m.friends = t
+		}
+		field = m.friends[m.xxx_LenFriends]
+		if field == nil {
+			field = new(Profile)
+			m.friends[m.xxx_LenFriends] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenFriends += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *User) MutateFriends(index int) (field *Profile, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenFriends {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.friends[index] == nil {
+		m.friends[index] = new(Profile)
+	}
+	return m.friends[index], nil
+}
+
+func (m *User) FriendsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenFriends
+	}
+	return 0
+}
+
+func (m *User) ClearFriends() {
+	if m != nil {
+		for i := 0; i < m.FriendsSize(); i++ {
+			m.friends[i].Clear()
+		}
+		m.xxx_LenFriends = 0
+
+	}
+}
+
+func (m *User) GetFriends(index int) (field *Profile, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenFriends {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.friends[index], nil
+}
+
+func (m *User) GetLabels(key string) (value string, ok bool) {
+	if m != nil {
+		value, ok = m.labels[key]
+	}
+	return value, ok
+}
+
+func (m *User) PutLabels(key string, value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.labels == nil {
+		m.labels = make(map[string]string)
+	}
+	m.labels[key] = value
+	return nil
+}
+
+func (m *User) DeleteLabels(key string) {
+	if m != nil {
+		delete(m.labels, key)
+	}
+}
+
+func (m *User) LabelsLen() (size int) {
+	if m != nil {
+		return len(m.labels)
+	}
+	return 0
+}
+
+func (m *User) RangeLabels(f func(key string, value string) bool) {
+	if m != nil {
+		for k, v := range m.labels {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *User) ClearLabels() {
+	if m != nil {
+		m.labels = nil
+	}
+}
+
+func (m *User) Clear() {
+	if m != nil {
+		m.ClearId()
+		m.ClearEmail()
+		m.profile.Clear()
+		m.xxx_IsProfileSet = false
+
+		for i := 0; i < m.FriendsSize(); i++ {
+			m.friends[i].Clear()
+		}
+		m.xxx_LenFriends = 0
+
+		m.ClearLabels()
+	}
+}
+
+type User_LabelsEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            string
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *User_LabelsEntry) Reset()         { *m = User_LabelsEntry{} }
+func (m *User_LabelsEntry) String() string { return proto.CompactTextString(m) }
+func (*User_LabelsEntry) ProtoMessage()    {}
+
+func (m *User_LabelsEntry) GetKey() string {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *User_LabelsEntry) GetValue() string {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return ""
+}
+
+func (m *User_LabelsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *User_LabelsEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *User_LabelsEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *User_LabelsEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+		m.key = ""
+	}
+}
+
+func (m *User_LabelsEntry) SetValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *User_LabelsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *User_LabelsEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+		m.value = ""
+	}
+}
+
+func (m *User_LabelsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+func (m *Address) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsStreetSet {
+		l = len(m.street)
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.xxx_IsCitySet {
+		l = len(m.city)
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Profile) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.xxx_IsAddressSet {
+		l = m.address.Size()
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.xxx_LenTags > 0 {
+		for i := 0; i < m.xxx_LenTags; i++ {
+			s := m.tags[i]
+			l = len(s)
+			n += 1 + l + sovFieldmask(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *User) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		n += 1 + sovFieldmask(uint64(m.id))
+	}
+	if m.xxx_IsEmailSet {
+		l = len(m.email)
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.xxx_IsProfileSet {
+		l = m.profile.Size()
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.xxx_LenFriends > 0 {
+		for i := 0; i < m.xxx_LenFriends; i++ {
+			e := m.friends[i]
+			l = e.Size()
+			n += 1 + l + sovFieldmask(uint64(l))
+		}
+	}
+	if len(m.labels) > 0 {
+		for k, v := range m.labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFieldmask(uint64(len(k))) + 1 + len(v) + sovFieldmask(uint64(len(v)))
+			n += 1 + mapEntrySize + sovFieldmask(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *User_LabelsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		l = len(m.value)
+		n += 1 + l + sovFieldmask(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovFieldmask(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozFieldmask(x uint64) (n int) {
+	return sovFieldmask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Address) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Address) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Address) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsStreetSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(len(m.street)))
+		i += copy(data[i:], m.street)
+	}
+	if m.xxx_IsCitySet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(len(m.city)))
+		i += copy(data[i:], m.city)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Profile) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Profile) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Profile) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsAddressSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(m.address.SizeCached()))
+		n1, err := m.address.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_LenTags > 0 {
+		for idx := 0; idx < m.xxx_LenTags; idx++ {
+			s := m.tags[idx]
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *User) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *User) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *User) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(m.id))
+	}
+	if m.xxx_IsEmailSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(len(m.email)))
+		i += copy(data[i:], m.email)
+	}
+	if m.xxx_IsProfileSet {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(m.profile.SizeCached()))
+		n2, err := m.profile.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.xxx_LenFriends > 0 {
+		for idx := 0; idx < m.xxx_LenFriends; idx++ {
+			msg := m.friends[idx]
+			data[i] = 0x22
+			i++
+			i = encodeVarintFieldmask(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.labels) > 0 {
+		keys := make([]string, 0, len(m.labels))
+		for k := range m.labels {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.labels[k]
+			data[i] = 0x2a
+			i++
+			mapEntrySize := 1 + len(k) + sovFieldmask(uint64(len(k))) + 1 + len(v) + sovFieldmask(uint64(len(v)))
+			i = encodeVarintFieldmask(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintFieldmask(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintFieldmask(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *User_LabelsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *User_LabelsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *User_LabelsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintFieldmask(data, i, uint64(len(m.value)))
+		i += copy(data[i:], m.value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Fieldmask(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Fieldmask(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintFieldmask(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Address) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field street", wireType)
+			}
+			m.xxx_IsStreetSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.street = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field city", wireType)
+			}
+			m.xxx_IsCitySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.city = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Profile) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field address", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateAddress()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.tags) <= m.xxx_LenTags {
+				newCapacity := 0
+				if len(m.tags) == 0 {
+					newCapacity = 8
+				} else if len(m.tags) < 1000000 {
+					newCapacity = m.xxx_LenTags * 2
+				} else {
+					newCapacity = m.xxx_LenTags + 1000000
+				}
+				t := make([]string, newCapacity, newCapacity)
+				copy(t, m.tags)
+				m.tags = t
+			}
+			m.tags[m.xxx_LenTags] = string(data[index:postIndex])
+			m.xxx_LenTags += 1
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *User) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field id", wireType)
+			}
+			m.xxx_IsIdSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field email", wireType)
+			}
+			m.xxx_IsEmailSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.email = string(data[index:postIndex])
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateProfile()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field friends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddFriends()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &User_LabelsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.labels == nil {
+				m.labels = make(map[string]string)
+			}
+			m.labels[entry.key] = entry.value
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *User_LabelsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.value = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Address) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Address) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Profile) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Profile) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *User) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *User) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *User_LabelsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *User_LabelsEntry) CheckInitialized() error {
+	return nil
+}
+
+func (m *Address) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsStreetSet || w.EmitDefaults() {
+		w.Field("street", "street")
+		w.String(m.GetStreet())
+	}
+	if m.xxx_IsCitySet || w.EmitDefaults() {
+		w.Field("city", "city")
+		w.String(m.GetCity())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Address) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("street", "street"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetStreet(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("city", "city"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetCity(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Address) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Address) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Profile) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_IsAddressSet {
+		w.Field("address", "address")
+		w.Message(m.GetAddress())
+	} else if w.EmitDefaults() {
+		w.Field("address", "address")
+		w.Null()
+	}
+	if m.xxx_LenTags > 0 || w.EmitDefaults() {
+		w.Field("tags", "tags")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenTags; i++ {
+			w.String(m.tags[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Profile) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("address", "address"); ok {
+		v, err := m.MutateAddress()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("tags", "tags"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddTags(v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Profile) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Profile) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *User) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsIdSet || w.EmitDefaults() {
+		w.Field("id", "id")
+		w.Int64(m.GetId())
+	}
+	if m.xxx_IsEmailSet || w.EmitDefaults() {
+		w.Field("email", "email")
+		w.String(m.GetEmail())
+	}
+	if m.xxx_IsProfileSet {
+		w.Field("profile", "profile")
+		w.Message(m.GetProfile())
+	} else if w.EmitDefaults() {
+		w.Field("profile", "profile")
+		w.Null()
+	}
+	if m.xxx_LenFriends > 0 || w.EmitDefaults() {
+		w.Field("friends", "friends")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenFriends; i++ {
+			w.Message(m.friends[i])
+		}
+		w.EndArray()
+	}
+	if len(m.labels) > 0 || w.EmitDefaults() {
+		w.Field("labels", "labels")
+		w.BeginObject()
+		keys := make([]string, 0, len(m.labels))
+		for k := range m.labels {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(k)
+			w.String(m.labels[k])
+		}
+		w.EndObject()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *User) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("id", "id"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetId(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("email", "email"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetEmail(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("profile", "profile"); ok {
+		v, err := m.MutateProfile()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("friends", "friends"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddFriends()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("labels", "labels"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.PutLabels(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *User) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *User) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *User_LabelsEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.String(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *User_LabelsEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *User_LabelsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *User_LabelsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Address) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsStreetSet {
+		w.Field("street")
+		w.Value(m.street)
+	}
+	if m.xxx_IsCitySet {
+		w.Field("city")
+		w.Value(m.city)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Address) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "street":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetStreet(v)
+	case "city":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetCity(v)
+	}
+	return false, nil
+}
+
+func (m *Profile) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_IsAddressSet {
+		w.Field("address")
+		w.Message(m.address)
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		w.Field("tags")
+		w.Value(m.tags[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Profile) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "address":
+		v, err := m.MutateAddress()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "tags":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddTags(v)
+	}
+	return false, nil
+}
+
+func (m *User) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsIdSet {
+		w.Field("id")
+		w.Value(m.id)
+	}
+	if m.xxx_IsEmailSet {
+		w.Field("email")
+		w.Value(m.email)
+	}
+	if m.xxx_IsProfileSet {
+		w.Field("profile")
+		w.Message(m.profile)
+	}
+	for i := 0; i < m.xxx_LenFriends; i++ {
+		w.Field("friends")
+		w.Message(m.friends[i])
+	}
+	if len(m.labels) > 0 {
+		keys := make([]string, 0, len(m.labels))
+		for k := range m.labels {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.labels[k]
+			w.Field("labels")
+			w.Message(&User_LabelsEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *User) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "id":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetId(v)
+	case "email":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetEmail(v)
+	case "profile":
+		v, err := m.MutateProfile()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "friends":
+		v, err := m.AddFriends()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "labels":
+		entry := &User_LabelsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.labels == nil {
+			m.labels = make(map[string]string)
+		}
+		m.labels[entry.key] = entry.value
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *User_LabelsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *User_LabelsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Address) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Address{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Address) MergeFrom(src proto.Message) {
+	s, ok := src.(*Address)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsStreetSet {
+		m.SetStreet(s.street)
+	}
+	if s.xxx_IsCitySet {
+		m.SetCity(s.city)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Address) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Address)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsStreetSet != o.xxx_IsStreetSet {
+		return false
+	}
+	if m.xxx_IsStreetSet && m.street != o.street {
+		return false
+	}
+	if m.xxx_IsCitySet != o.xxx_IsCitySet {
+		return false
+	}
+	if m.xxx_IsCitySet && m.city != o.city {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Profile) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Profile{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Profile) MergeFrom(src proto.Message) {
+	s, ok := src.(*Profile)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsAddressSet {
+		v, _ := m.MutateAddress()
+		v.MergeFrom(s.address)
+	}
+	for i := 0; i < s.xxx_LenTags; i++ {
+		m.AddTags(s.tags[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Profile) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Profile)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsAddressSet != o.xxx_IsAddressSet {
+		return false
+	}
+	if m.xxx_IsAddressSet && !m.address.Equal(o.address) {
+		return false
+	}
+	if m.xxx_LenTags != o.xxx_LenTags {
+		return false
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		if m.tags[i] != o.tags[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *User) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &User{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *User) MergeFrom(src proto.Message) {
+	s, ok := src.(*User)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsIdSet {
+		m.SetId(s.id)
+	}
+	if s.xxx_IsEmailSet {
+		m.SetEmail(s.email)
+	}
+	if s.xxx_IsProfileSet {
+		v, _ := m.MutateProfile()
+		v.MergeFrom(s.profile)
+	}
+	for i := 0; i < s.xxx_LenFriends; i++ {
+		v, _ := m.AddFriends()
+		v.MergeFrom(s.friends[i])
+	}
+	if len(s.labels) > 0 {
+		if m.labels == nil {
+			m.labels = make(map[string]string, len(s.labels))
+		}
+		for k, v := range s.labels {
+			m.labels[k] = v
+		}
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *User) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*User)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsIdSet != o.xxx_IsIdSet {
+		return false
+	}
+	if m.xxx_IsIdSet && m.id != o.id {
+		return false
+	}
+	if m.xxx_IsEmailSet != o.xxx_IsEmailSet {
+		return false
+	}
+	if m.xxx_IsEmailSet && m.email != o.email {
+		return false
+	}
+	if m.xxx_IsProfileSet != o.xxx_IsProfileSet {
+		return false
+	}
+	if m.xxx_IsProfileSet && !m.profile.Equal(o.profile) {
+		return false
+	}
+	if m.xxx_LenFriends != o.xxx_LenFriends {
+		return false
+	}
+	for i := 0; i < m.xxx_LenFriends; i++ {
+		if !m.friends[i].Equal(o.friends[i]) {
+			return false
+		}
+	}
+	if len(m.labels) != len(o.labels) {
+		return false
+	}
+	for k, v := range m.labels {
+		if v2, ok := o.labels[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *User_LabelsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &User_LabelsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *User_LabelsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*User_LabelsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *User_LabelsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*User_LabelsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionAddress = &proto.MessageInfo{
+	Name: "fieldmask.Address",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "street",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Address)(nil).GetStreet(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Address).GetStreet()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "street", v)
+				}
+				x := m.(*Address)
+				return x.SetStreet(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Address).HasStreet()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Address).ClearStreet()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "city",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Address)(nil).GetCity(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Address).GetCity()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "city", v)
+				}
+				x := m.(*Address)
+				return x.SetCity(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Address).HasCity()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Address).ClearCity()
+			},
+		},
+	},
+}
+
+func (m *Address) ProtoReflect() *proto.MessageInfo {
+	return reflectionAddress
+}
+
+var reflectionProfile = &proto.MessageInfo{
+	Name: "fieldmask.Profile",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Profile)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Profile).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Profile)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Profile).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Profile).ClearName()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "address",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Address)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Profile).GetAddress()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Address)
+				if !ok {
+					return proto.NewFieldTypeError(m, "address", v)
+				}
+				x := m.(*Profile)
+				x.ClearAddress()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateAddress()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Profile).HasAddress()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Profile).ClearAddress()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "tags",
+			Kind:   proto.StringKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Profile)
+				v := make([]string, x.xxx_LenTags)
+				copy(v, x.tags)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "tags", v)
+				}
+				x := m.(*Profile)
+				x.ClearTags()
+				for _, e := range value {
+					if err := x.AddTags(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Profile).TagsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Profile).ClearTags()
+			},
+		},
+	},
+}
+
+func (m *Profile) ProtoReflect() *proto.MessageInfo {
+	return reflectionProfile
+}
+
+var reflectionUser = &proto.MessageInfo{
+	Name: "fieldmask.User",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "id",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*User)(nil).GetId(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*User).GetId()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "id", v)
+				}
+				x := m.(*User)
+				return x.SetId(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*User).HasId()
+			},
+			Clear: func(m proto.Message) {
+				m.(*User).ClearId()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "email",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*User)(nil).GetEmail(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*User).GetEmail()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "email", v)
+				}
+				x := m.(*User)
+				return x.SetEmail(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*User).HasEmail()
+			},
+			Clear: func(m proto.Message) {
+				m.(*User).ClearEmail()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "profile",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Profile)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*User).GetProfile()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Profile)
+				if !ok {
+					return proto.NewFieldTypeError(m, "profile", v)
+				}
+				x := m.(*User)
+				x.ClearProfile()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateProfile()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*User).HasProfile()
+			},
+			Clear: func(m proto.Message) {
+				m.(*User).ClearProfile()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "friends",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Profile)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*User)
+				v := make([]*Profile, x.xxx_LenFriends)
+				copy(v, x.friends)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Profile)
+				if !ok {
+					return proto.NewFieldTypeError(m, "friends", v)
+				}
+				x := m.(*User)
+				x.ClearFriends()
+				for _, e := range value {
+					field, err := x.AddFriends()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*User).FriendsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*User).ClearFriends()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "labels",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*User)
+				v := make(map[string]string, x.LabelsLen())
+				x.RangeLabels(func(key string, value string) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "labels", v)
+				}
+				x := m.(*User)
+				x.ClearLabels()
+				for k, e := range value {
+					if err := x.PutLabels(k, e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*User).LabelsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*User).ClearLabels()
+			},
+		},
+	},
+}
+
+func (m *User) ProtoReflect() *proto.MessageInfo {
+	return reflectionUser
+}
+
+var reflectionUser_LabelsEntry = &proto.MessageInfo{
+	Name: "fieldmask.User.LabelsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*User_LabelsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*User_LabelsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*User_LabelsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*User_LabelsEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*User_LabelsEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*User_LabelsEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*User_LabelsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*User_LabelsEntry)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*User_LabelsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*User_LabelsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *User_LabelsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionUser_LabelsEntry
+}
+
+func init() {
+	proto.RegisterType((*Address)(nil), "fieldmask.Address")
+	proto.RegisterType((*Profile)(nil), "fieldmask.Profile")
+	proto.RegisterType((*User)(nil), "fieldmask.User")
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package fieldmask
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	fm "github.com/dropbox/goprotoc/fieldmask"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newUser(id int64, name string, city string) *User {
+	m := &User{}
+	m.SetId(id)
+	m.SetEmail(name + "@example.com")
+	p, _ := m.MutateProfile()
+	p.SetName(name)
+	p.AddTags(name)
+	a, _ := p.MutateAddress()
+	a.SetStreet(name + " street")
+	a.SetCity(city)
+	f, _ := m.AddFriends()
+	f.SetName(name + "'s friend")
+	m.PutLabels("name", name)
+	return m
+}
+
+func newMask(t *testing.T, paths ...string) *fm.Mask {
+	mask, err := fm.New(&User{}, paths...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mask
+}
+
+func TestNew(t *testing.T) {
+	mask := newMask(t, "profile.address.city", "email", "profile", "labels")
+	if got, want := mask.Paths(), []string{"email", "labels", "profile"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paths are %v, want %v", got, want)
+	}
+	for _, c := range []struct {
+		path string
+		err  string
+	}{
+		{"nickname", `unknown field "nickname" in path "nickname" of fieldmask.User`},
+		{"profile.age", `unknown field "age" in path "profile.age" of fieldmask.Profile`},
+		{"email.domain", `field "email" in path "email.domain" of fieldmask.User is not a singular message`},
+		{"friends.name", `field "friends" in path "friends.name" of fieldmask.User is not a singular message`},
+	} {
+		_, err := fm.New(&User{}, c.path)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("error of %q is %v, want %s", c.path, err, c.err)
+		}
+	}
+}
+
+func TestApplyMask(t *testing.T) {
+	dst := newUser(1, "alice", "Paris")
+	src := newUser(2, "bob", "London")
+	src.GetProfile().ClearName()
+	mask := newMask(t, "email", "profile.name", "profile.address.city", "friends")
+	if err := fm.ApplyMask(dst, src, mask); err != nil {
+		t.Fatal(err)
+	}
+	if dst.GetId() != 1 || dst.GetEmail() != "bob@example.com" {
+		t.Errorf("scalars are %v", dst)
+	}
+	p := dst.GetProfile()
+	if p.HasName() {
+		t.Errorf("name was not cleared: %v", p)
+	}
+	if p.GetAddress().GetCity() != "London" || p.GetAddress().GetStreet() != "alice street" {
+		t.Errorf("address is %v", p.GetAddress())
+	}
+	if f, _ := dst.GetFriends(0); dst.FriendsSize() != 1 || f.GetName() != "bob's friend" {
+		t.Errorf("friends are %v", dst)
+	}
+	if v, _ := dst.GetLabels("name"); v != "alice" {
+		t.Errorf("labels were changed: %v", dst)
+	}
+}
+
+func TestApplyMaskMergesMessages(t *testing.T) {
+	dst := newUser(1, "alice", "Paris")
+	src := &User{}
+	p, _ := src.MutateProfile()
+	p.SetName("bob")
+	if err := fm.ApplyMask(dst, src, newMask(t, "profile")); err != nil {
+		t.Fatal(err)
+	}
+	if dst.GetProfile().GetName() != "bob" || dst.GetProfile().GetAddress().GetCity() != "Paris" {
+		t.Errorf("profile was not merged: %v", dst.GetProfile())
+	}
+
+	// A message in the middle of a path which is not set in src is empty.
+	dst = newUser(1, "alice", "Paris")
+	if err := fm.ApplyMask(dst, &User{}, newMask(t, "profile.address.city")); err != nil {
+		t.Fatal(err)
+	}
+	if a := dst.GetProfile().GetAddress(); a.HasCity() || !a.HasStreet() {
+		t.Errorf("address is %v", a)
+	}
+}
+
+func TestClearExcept(t *testing.T) {
+	m := newUser(1, "alice", "Paris")
+	if err := fm.ClearExcept(m, newMask(t, "id", "profile.address.city")); err != nil {
+		t.Fatal(err)
+	}
+	want := &User{}
+	want.SetId(1)
+	p, _ := want.MutateProfile()
+	a, _ := p.MutateAddress()
+	a.SetCity("Paris")
+	if !proto.Equal(m, want) {
+		t.Errorf("ClearExcept left %v, want %v", m, want)
+	}
+}
+
+func TestPruneToMask(t *testing.T) {
+	m := newUser(1, "alice", "Paris")
+	pruned, err := fm.PruneToMask(m, newMask(t, "email", "profile.tags"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &User{}
+	want.SetEmail("alice@example.com")
+	p, _ := want.MutateProfile()
+	p.AddTags("alice")
+	if !proto.Equal(pruned, want) {
+		t.Errorf("PruneToMask is %v, want %v", pruned, want)
+	}
+	if !proto.Equal(m, newUser(1, "alice", "Paris")) {
+		t.Errorf("PruneToMask changed its argument")
+	}
+}
+
+func TestWrongType(t *testing.T) {
+	mask := newMask(t, "email")
+	if err := fm.ClearExcept(&Profile{}, mask); err == nil {
+		t.Errorf("mask of User cleared a Profile")
+	}
+}
@@ -0,0 +1,67 @@
+package group
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func TestGroupWireFormat(t *testing.T) {
+	m := &Groups1{}
+	g, _ := m.AddG()
+	g.SetField1(1)
+	g, _ = m.AddG()
+	g.SetField1(2)
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x0b, 0x08, 0x01, 0x0c, 0x0b, 0x08, 0x02, 0x0c}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("got %x, want %x", data, want)
+	}
+	if m.Size() != len(want) {
+		t.Fatalf("size is %d, want %d", m.Size(), len(want))
+	}
+
+	u := &Groups1{}
+	if err := proto.Unmarshal(data, u); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Equal(u) {
+		t.Fatalf("got %v, want %v", u, m)
+	}
+}
+
+func TestGroupUnknownNestedGroup(t *testing.T) {
+	// Field 1 of Groups2_G followed by an unknown group 3 holding a group 4.
+	data := []byte{0x0b, 0x08, 0x05, 0x1b, 0x23, 0x08, 0x01, 0x24, 0x1c, 0x0c}
+	m := &Groups2{}
+	if err := proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.GetG().GetField1(); got != 5 {
+		t.Fatalf("field1 is %d, want 5", got)
+	}
+	out, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatalf("got %x, want %x", out, data)
+	}
+}
+
+func TestGroupMismatchedEnd(t *testing.T) {
+	for _, data := range [][]byte{
+		// The group of field 1 is ended by an end group key of field 2.
+		{0x0b, 0x08, 0x01, 0x14},
+		// The group of field 1 is never ended.
+		{0x0b, 0x08, 0x01},
+	} {
+		if err := proto.Unmarshal(data, &Groups1{}); err == nil {
+			t.Errorf("unmarshaled %x without error", data)
+		}
+	}
+}
@@ -6,9 +6,11 @@
 Package group is a generated protocol buffer package.
 
 It is generated from these files:
+
 	group.proto
 
 It has these top-level messages:
+
 	Groups1
 	Groups2
 */
@@ -17,39 +19,29 @@ package group
 import testing "testing"
 import math_rand "math/rand"
 import time "time"
-import dropbox_gogoprotobuf_proto "github.com/dropbox/goprotoc/proto"
+import github_com_dropbox_goprotoc_proto "github.com/dropbox/goprotoc/proto"
 import testing1 "testing"
 import math_rand1 "math/rand"
 import time1 "time"
-import encoding_json "encoding/json"
 import testing2 "testing"
 import math_rand2 "math/rand"
 import time2 "time"
-import dropbox_gogoprotobuf_proto1 "github.com/dropbox/goprotoc/proto"
+import testing3 "testing"
+import github_com_dropbox_goprotoc_proto1 "github.com/dropbox/goprotoc/proto"
 import math_rand3 "math/rand"
 import time3 "time"
-import testing3 "testing"
-import fmt "fmt"
-import math_rand4 "math/rand"
-import time4 "time"
 import testing4 "testing"
 import fmt1 "fmt"
-import go_parser "go/parser"
-import math_rand5 "math/rand"
-import time5 "time"
-import testing5 "testing"
-import dropbox_gogoprotobuf_proto2 "github.com/dropbox/goprotoc/proto"
-import testing6 "testing"
 
 func TestGroups1Proto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
 	p := NewPopulatedGroups1(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups1{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -66,12 +58,12 @@ func TestGroups1Proto(t *testing.T) {
 func TestGroups1_GProto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
 	p := NewPopulatedGroups1_G(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups1_G{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -88,12 +80,12 @@ func TestGroups1_GProto(t *testing.T) {
 func TestGroups2Proto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
 	p := NewPopulatedGroups2(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups2{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -110,12 +102,12 @@ func TestGroups2Proto(t *testing.T) {
 func TestGroups2_GProto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
 	p := NewPopulatedGroups2_G(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups2_G{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -129,360 +121,293 @@ func TestGroups2_GProto(t *testing.T) {
 	}
 }
 
-func TestGroups1JSON(t *testing1.T) {
+func TestGroups1API(t *testing1.T) {
 	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedGroups1(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
-		panic(err)
-	}
+	p := NewPopulatedGroups1(popr, false)
 	msg := &Groups1{}
-	err = encoding_json.Unmarshal(jsondata, msg)
-	if err != nil {
-		panic(err)
+	if !apiEmptyGroups1(msg, t) {
+		t.Fatalf("Groups1 should be empty")
 	}
+	apiCopyGroups1(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
-	}
-}
-func TestGroups1_GJSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedGroups1_G(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
-		panic(err)
-	}
-	msg := &Groups1_G{}
-	err = encoding_json.Unmarshal(jsondata, msg)
-	if err != nil {
-		panic(err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	if apiEmptyGroups1(p, t) != apiEmptyGroups1(msg, t) {
+		t.Fatalf("Groups1 should not be empty")
 	}
 	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
-	}
-}
-func TestGroups2JSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedGroups2(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
-		panic(err)
-	}
-	msg := &Groups2{}
-	err = encoding_json.Unmarshal(jsondata, msg)
-	if err != nil {
-		panic(err)
+		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
+	msg.Clear()
+	if !apiEmptyGroups1(msg, t) {
+		t.Fatalf("Groups1 should be empty")
 	}
 }
-func TestGroups2_GJSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedGroups2_G(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
-		panic(err)
-	}
-	msg := &Groups2_G{}
-	err = encoding_json.Unmarshal(jsondata, msg)
-	if err != nil {
-		panic(err)
+
+func apiCopyGroups1(dst *Groups1, src *Groups1, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
+	for i := 0; i < src.GSize(); i++ {
+		srcG, _ := src.GetG(i)
+		dstG, _ := dst.AddG()
+		apiCopyGroups1_G(dstG, srcG, t)
 	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
-func TestGroups1ProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups1(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
-	msg := &Groups1{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
-	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+
+func apiEmptyGroups1(msg *Groups1, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if msg.GSize() != 0 {
+		return false
 	}
+	return true
 }
 
-func TestGroups1ProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups1(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
-	msg := &Groups1{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func TestGroups1_GAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedGroups1_G(popr, false)
+	msg := &Groups1_G{}
+	if !apiEmptyGroups1_G(msg, t) {
+		t.Fatalf("Groups1_G should be empty")
 	}
+	apiCopyGroups1_G(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyGroups1_G(p, t) != apiEmptyGroups1_G(msg, t) {
+		t.Fatalf("Groups1_G should not be empty")
 	}
 	if !p.Equal(msg) {
 		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
+	msg.Clear()
+	if !apiEmptyGroups1_G(msg, t) {
+		t.Fatalf("Groups1_G should be empty")
+	}
 }
 
-func TestGroups1_GProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups1_G(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
-	msg := &Groups1_G{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiCopyGroups1_G(dst *Groups1_G, src *Groups1_G, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	if src.HasField1() {
+		dst.SetField1(src.GetField1())
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if src.HasField2() {
+		dst.SetField2(src.GetField2())
 	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
 
-func TestGroups1_GProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups1_G(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
-	msg := &Groups1_G{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiEmptyGroups1_G(msg *Groups1_G, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	if msg.HasField1() {
+		return false
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if msg.HasField2() {
+		return false
 	}
+	return true
 }
 
-func TestGroups2ProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups2(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
+func TestGroups2API(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedGroups2(popr, false)
 	msg := &Groups2{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+	if !apiEmptyGroups2(msg, t) {
+		t.Fatalf("Groups2 should be empty")
 	}
+	apiCopyGroups2(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyGroups2(p, t) != apiEmptyGroups2(msg, t) {
+		t.Fatalf("Groups2 should not be empty")
 	}
 	if !p.Equal(msg) {
 		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
+	msg.Clear()
+	if !apiEmptyGroups2(msg, t) {
+		t.Fatalf("Groups2 should be empty")
+	}
 }
 
-func TestGroups2ProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups2(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
-	msg := &Groups2{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
-	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+func apiCopyGroups2(dst *Groups2, src *Groups2, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if src.HasG() {
+		srcG := src.GetG()
+		dstG, _ := dst.MutateG()
+		apiCopyGroups2_G(dstG, srcG, t)
 	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
 
-func TestGroups2_GProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups2_G(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
-	msg := &Groups2_G{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiEmptyGroups2(msg *Groups2, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if msg.HasG() {
+		return false
 	}
+	return true
 }
 
-func TestGroups2_GProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedGroups2_G(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
+func TestGroups2_GAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedGroups2_G(popr, false)
 	msg := &Groups2_G{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+	if !apiEmptyGroups2_G(msg, t) {
+		t.Fatalf("Groups2_G should be empty")
 	}
+	apiCopyGroups2_G(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyGroups2_G(p, t) != apiEmptyGroups2_G(msg, t) {
+		t.Fatalf("Groups2_G should not be empty")
 	}
 	if !p.Equal(msg) {
 		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
-}
-
-func TestGroups1Stringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedGroups1(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
-	}
-}
-func TestGroups1_GStringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedGroups1_G(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
-	}
-}
-func TestGroups2Stringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedGroups2(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
+	msg.Clear()
+	if !apiEmptyGroups2_G(msg, t) {
+		t.Fatalf("Groups2_G should be empty")
 	}
 }
-func TestGroups2_GStringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedGroups2_G(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
+
+func apiCopyGroups2_G(dst *Groups2_G, src *Groups2_G, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-}
-func TestGroups1GoString(t *testing4.T) {
-	popr := math_rand4.New(math_rand4.NewSource(time4.Now().UnixNano()))
-	p := NewPopulatedGroups1(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
+	if src.HasField1() {
+		dst.SetField1(src.GetField1())
 	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
+	for i := 0; i < src.Field2Size(); i++ {
+		value, _ := src.GetField2(i)
+		dst.AddField2(value)
 	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
-func TestGroups1_GGoString(t *testing4.T) {
-	popr := math_rand4.New(math_rand4.NewSource(time4.Now().UnixNano()))
-	p := NewPopulatedGroups1_G(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
+
+func apiEmptyGroups2_G(msg *Groups2_G, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
+	if msg.HasField1() {
+		return false
 	}
-}
-func TestGroups2GoString(t *testing4.T) {
-	popr := math_rand4.New(math_rand4.NewSource(time4.Now().UnixNano()))
-	p := NewPopulatedGroups2(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
-	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
+	if msg.Field2Size() != 0 {
+		return false
 	}
+	return true
 }
-func TestGroups2_GGoString(t *testing4.T) {
-	popr := math_rand4.New(math_rand4.NewSource(time4.Now().UnixNano()))
-	p := NewPopulatedGroups2_G(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
-	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
-	}
+
+func TestGroupDescription(t *testing2.T) {
+	GroupDescription()
 }
-func TestGroups1VerboseEqual(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
+func TestGroups1VerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedGroups1(popr, false)
-	data, err := dropbox_gogoprotobuf_proto2.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups1{}
-	if err := dropbox_gogoprotobuf_proto2.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestGroups1_GVerboseEqual(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
+func TestGroups1_GVerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedGroups1_G(popr, false)
-	data, err := dropbox_gogoprotobuf_proto2.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups1_G{}
-	if err := dropbox_gogoprotobuf_proto2.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestGroups2VerboseEqual(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
+func TestGroups2VerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedGroups2(popr, false)
-	data, err := dropbox_gogoprotobuf_proto2.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups2{}
-	if err := dropbox_gogoprotobuf_proto2.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestGroups2_GVerboseEqual(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
+func TestGroups2_GVerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedGroups2_G(popr, false)
-	data, err := dropbox_gogoprotobuf_proto2.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &Groups2_G{}
-	if err := dropbox_gogoprotobuf_proto2.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestGroupDescription(t *testing6.T) {
-	GroupDescription()
+func TestGroups1Stringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedGroups1(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
+}
+func TestGroups1_GStringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedGroups1_G(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
+}
+func TestGroups2Stringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedGroups2(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
+}
+func TestGroups2_GStringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedGroups2_G(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
 }
 
-//These tests are generated by code.google.com/p/gogoprotobuf/plugin/testgen
+//These tests are generated by github.com/dropbox/goprotoc/plugin/testgen
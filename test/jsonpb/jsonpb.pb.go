@@ -0,0 +1,4226 @@
+// Code generated by protoc-gen-dgo.
+// source: jsonpb.proto
+// DO NOT EDIT!
+
+/*
+Package jsonpb is a generated protocol buffer package.
+
+It is generated from these files:
+
+	jsonpb.proto
+
+It has these top-level messages:
+
+	Inner
+	Outer
+*/
+package jsonpb
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb1 "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb1.Marshal
+
+type Color int32
+
+const (
+	Color_RED   Color = 0
+	Color_GREEN Color = 1
+	Color_BLUE  Color = 2
+)
+
+var Color_name = map[int32]string{
+	0: "RED",
+	1: "GREEN",
+	2: "BLUE",
+}
+var Color_value = map[string]int32{
+	"RED":   0,
+	"GREEN": 1,
+	"BLUE":  2,
+}
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+
+type Inner struct {
+	xxx_sizeCached   int
+	name             string
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+}
+
+func (m *Inner) Reset()         { *m = Inner{} }
+func (m *Inner) String() string { return proto.CompactTextString(m) }
+func (*Inner) ProtoMessage()    {}
+
+func (m *Inner) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Inner) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Inner) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Inner) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Inner) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Inner) Clear() {
+	if m != nil {
+		m.ClearName()
+	}
+}
+
+type Outer struct {
+	xxx_sizeCached       int
+	intValue             int32
+	longValue            int64
+	ulongValue           uint64
+	doubleValue          float64
+	floatValue           float32
+	boolValue            bool
+	stringValue          string
+	bytesValue           []byte
+	color                Color
+	inner                *Inner
+	longs                []int64
+	inners               []*Inner
+	colors               []Color
+	counts               map[string]int32
+	named                map[int64]*Inner
+	taggedValue          string
+	text                 string
+	nested               *Inner
+	XXX_unrecognized     []byte
+	xxx_IsIntValueSet    bool
+	xxx_IsLongValueSet   bool
+	xxx_IsUlongValueSet  bool
+	xxx_IsDoubleValueSet bool
+	xxx_IsFloatValueSet  bool
+	xxx_IsBoolValueSet   bool
+	xxx_IsStringValueSet bool
+	xxx_IsBytesValueSet  bool
+	xxx_IsColorSet       bool
+	xxx_IsInnerSet       bool
+	xxx_LenLongs         int
+	xxx_LenInners        int
+	xxx_LenColors        int
+	xxx_IsTaggedValueSet bool
+	xxx_IsTextSet        bool
+	xxx_IsNestedSet      bool
+	xxx_ChoiceCase       Outer_ChoiceCase
+}
+
+func (m *Outer) Reset()         { *m = Outer{} }
+func (m *Outer) String() string { return proto.CompactTextString(m) }
+func (*Outer) ProtoMessage()    {}
+
+const Default_Outer_StringValue string = "hello"
+
+func (m *Outer) GetIntValue() int32 {
+	if m != nil && m.xxx_IsIntValueSet {
+		return m.intValue
+	}
+	return 0
+}
+
+func (m *Outer) GetLongValue() int64 {
+	if m != nil && m.xxx_IsLongValueSet {
+		return m.longValue
+	}
+	return 0
+}
+
+func (m *Outer) GetUlongValue() uint64 {
+	if m != nil && m.xxx_IsUlongValueSet {
+		return m.ulongValue
+	}
+	return 0
+}
+
+func (m *Outer) GetDoubleValue() float64 {
+	if m != nil && m.xxx_IsDoubleValueSet {
+		return m.doubleValue
+	}
+	return 0
+}
+
+func (m *Outer) GetFloatValue() float32 {
+	if m != nil && m.xxx_IsFloatValueSet {
+		return m.floatValue
+	}
+	return 0
+}
+
+func (m *Outer) GetBoolValue() bool {
+	if m != nil && m.xxx_IsBoolValueSet {
+		return m.boolValue
+	}
+	return false
+}
+
+func (m *Outer) GetStringValue() string {
+	if m != nil && m.xxx_IsStringValueSet {
+		return m.stringValue
+	}
+	return Default_Outer_StringValue
+}
+
+func (m *Outer) GetBytesValue() []byte {
+	if m != nil && m.xxx_IsBytesValueSet {
+		return m.bytesValue
+	}
+	return nil
+}
+func (m *Outer) GetColor() Color {
+	if m != nil && m.xxx_IsColorSet {
+		return m.color
+	}
+	return Color_RED
+}
+
+func (m *Outer) GetInner() *Inner {
+	if m != nil && m.xxx_IsInnerSet {
+		return m.inner
+	}
+	return nil
+}
+func (m *Outer) GetTaggedValue() string {
+	if m != nil && m.xxx_IsTaggedValueSet {
+		return m.taggedValue
+	}
+	return ""
+}
+
+func (m *Outer) GetText() string {
+	if m != nil && m.xxx_IsTextSet {
+		return m.text
+	}
+	return ""
+}
+
+func (m *Outer) GetNested() *Inner {
+	if m != nil && m.xxx_IsNestedSet {
+		return m.nested
+	}
+	return nil
+}
+func (m *Outer) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Outer_ChoiceCase int32
+
+const (
+	Outer_ChoiceCase_NotSet Outer_ChoiceCase = 0
+	Outer_ChoiceCase_Text   Outer_ChoiceCase = 17
+	Outer_ChoiceCase_Nested Outer_ChoiceCase = 18
+)
+
+func (m *Outer) WhichChoice() Outer_ChoiceCase {
+	if m != nil {
+		return m.xxx_ChoiceCase
+	}
+	return Outer_ChoiceCase_NotSet
+}
+
+func (m *Outer) ClearChoice() {
+	if m != nil {
+		switch m.xxx_ChoiceCase {
+		case Outer_ChoiceCase_Text:
+			m.ClearText()
+		case Outer_ChoiceCase_Nested:
+			m.ClearNested()
+		}
+		m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+	}
+}
+
+func (m *Outer) SetIntValue(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIntValueSet = true
+	m.intValue = value
+	return nil
+}
+
+func (m *Outer) HasIntValue() (isSet bool) {
+	if m != nil && m.xxx_IsIntValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearIntValue() {
+	if m != nil {
+		m.xxx_IsIntValueSet = false
+	}
+}
+
+func (m *Outer) SetLongValue(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsLongValueSet = true
+	m.longValue = value
+	return nil
+}
+
+func (m *Outer) HasLongValue() (isSet bool) {
+	if m != nil && m.xxx_IsLongValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearLongValue() {
+	if m != nil {
+		m.xxx_IsLongValueSet = false
+	}
+}
+
+func (m *Outer) SetUlongValue(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsUlongValueSet = true
+	m.ulongValue = value
+	return nil
+}
+
+func (m *Outer) HasUlongValue() (isSet bool) {
+	if m != nil && m.xxx_IsUlongValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearUlongValue() {
+	if m != nil {
+		m.xxx_IsUlongValueSet = false
+	}
+}
+
+func (m *Outer) SetDoubleValue(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsDoubleValueSet = true
+	m.doubleValue = value
+	return nil
+}
+
+func (m *Outer) HasDoubleValue() (isSet bool) {
+	if m != nil && m.xxx_IsDoubleValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearDoubleValue() {
+	if m != nil {
+		m.xxx_IsDoubleValueSet = false
+	}
+}
+
+func (m *Outer) SetFloatValue(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsFloatValueSet = true
+	m.floatValue = value
+	return nil
+}
+
+func (m *Outer) HasFloatValue() (isSet bool) {
+	if m != nil && m.xxx_IsFloatValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearFloatValue() {
+	if m != nil {
+		m.xxx_IsFloatValueSet = false
+	}
+}
+
+func (m *Outer) SetBoolValue(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsBoolValueSet = true
+	m.boolValue = value
+	return nil
+}
+
+func (m *Outer) HasBoolValue() (isSet bool) {
+	if m != nil && m.xxx_IsBoolValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearBoolValue() {
+	if m != nil {
+		m.xxx_IsBoolValueSet = false
+	}
+}
+
+func (m *Outer) SetStringValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsStringValueSet = true
+	m.stringValue = value
+	return nil
+}
+
+func (m *Outer) HasStringValue() (isSet bool) {
+	if m != nil && m.xxx_IsStringValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearStringValue() {
+	if m != nil {
+		m.xxx_IsStringValueSet = false
+		m.stringValue = ""
+	}
+}
+
+func (m *Outer) SetBytesValue(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.xxx_IsBytesValueSet = true
+	m.bytesValue = value
+	return nil
+}
+
+func (m *Outer) HasBytesValue() (isSet bool) {
+	if m != nil && m.xxx_IsBytesValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearBytesValue() {
+	if m != nil {
+		m.xxx_IsBytesValueSet = false
+		m.bytesValue = nil
+	}
+}
+
+func (m *Outer) SetColor(value Color) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsColorSet = true
+	m.color = value
+	return nil
+}
+
+func (m *Outer) HasColor() (isSet bool) {
+	if m != nil && m.xxx_IsColorSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearColor() {
+	if m != nil {
+		m.xxx_IsColorSet = false
+	}
+}
+
+func (m *Outer) MutateInner() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsInnerSet {
+		m.xxx_IsInnerSet = true
+		if m.inner == nil {
+			m.inner = new(Inner)
+		} else {
+			m.inner.Clear()
+		}
+	}
+	return m.inner, nil
+}
+
+func (m *Outer) HasInner() (isSet bool) {
+	if m != nil && m.xxx_IsInnerSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearInner() {
+	if m != nil {
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+	}
+}
+
+func (m *Outer) AddLongs(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.longs) <= m.xxx_LenLongs {
+		newCapacity := 0
+		if len(m.longs) == 0 {
+			newCapacity = 8
+		} else if len(m.longs) < 1000000 {
+			newCapacity = m.xxx_LenLongs * 2
+		} else {
+			newCapacity = m.xxx_LenLongs + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.longs)
+		m.longs = t
+	}
+	m.longs[m.xxx_LenLongs] = value
+	m.xxx_LenLongs += 1
+	return nil
+}
+
+func (m *Outer) SetLongs(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenLongs {
+		return errors.New("Index is out of bounds")
+	}
+	m.longs[index] = value
+	return nil
+}
+
+func (m *Outer) LongsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenLongs
+	}
+	return 0
+}
+
+func (m *Outer) ClearLongs() {
+	if m != nil {
+		m.xxx_LenLongs = 0
+	}
+}
+
+func (m *Outer) GetLongs(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenLongs {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.longs[index], nil
+}
+
+func (m *Outer) AddInners() (field *Inner, err error) {
+	if m != nil {
+		if len(m.inners) <= m.xxx_LenInners {
+			newCapacity := 0
+			if len(m.inners) == 0 {
+				newCapacity = 8
+			} else if len(m.inners) < 1000000 {
+				newCapacity = m.xxx_LenInners * 2
+			} else {
+				newCapacity = m.xxx_LenInners + 1000000
+			}
+			t := make([]*Inner, newCapacity, newCapacity)
+			copy(t, m.inners)
+			m.inners = t
+		}
+		field = m.inners[m.xxx_LenInners]
+		if field == nil {
+			field = new(Inner)
+			m.inners[m.xxx_LenInners] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenInners += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Outer) MutateInners(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenInners {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.inners[index] == nil {
+		m.inners[index] = new(Inner)
+	}
+	return m.inners[index], nil
+}
+
+func (m *Outer) InnersSize() (size int) {
+	if m != nil {
+		return m.xxx_LenInners
+	}
+	return 0
+}
+
+func (m *Outer) ClearInners() {
+	if m != nil {
+		for i := 0; i < m.InnersSize(); i++ {
+			m.inners[i].Clear()
+		}
+		m.xxx_LenInners = 0
+
+	}
+}
+
+func (m *Outer) GetInners(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenInners {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.inners[index], nil
+}
+
+func (m *Outer) AddColors(value Color) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.colors) <= m.xxx_LenColors {
+		newCapacity := 0
+		if len(m.colors) == 0 {
+			newCapacity = 8
+		} else if len(m.colors) < 1000000 {
+			newCapacity = m.xxx_LenColors * 2
+		} else {
+			newCapacity = m.xxx_LenColors + 1000000
+		}
+		t := make([]Color, newCapacity, newCapacity)
+		copy(t, m.colors)
+		m.colors = t
+	}
+	m.colors[m.xxx_LenColors] = value
+	m.xxx_LenColors += 1
+	return nil
+}
+
+func (m *Outer) SetColors(value Color, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenColors {
+		return errors.New("Index is out of bounds")
+	}
+	m.colors[index] = value
+	return nil
+}
+
+func (m *Outer) ColorsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenColors
+	}
+	return 0
+}
+
+func (m *Outer) ClearColors() {
+	if m != nil {
+		m.xxx_LenColors = 0
+	}
+}
+
+func (m *Outer) GetColors(index int) (field Color, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenColors {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.colors[index], nil
+}
+
+func (m *Outer) GetCounts(key string) (value int32, ok bool) {
+	if m != nil {
+		value, ok = m.counts[key]
+	}
+	return value, ok
+}
+
+func (m *Outer) PutCounts(key string, value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.counts == nil {
+		m.counts = make(map[string]int32)
+	}
+	m.counts[key] = value
+	return nil
+}
+
+func (m *Outer) DeleteCounts(key string) {
+	if m != nil {
+		delete(m.counts, key)
+	}
+}
+
+func (m *Outer) CountsLen() (size int) {
+	if m != nil {
+		return len(m.counts)
+	}
+	return 0
+}
+
+func (m *Outer) RangeCounts(f func(key string, value int32) bool) {
+	if m != nil {
+		for k, v := range m.counts {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Outer) ClearCounts() {
+	if m != nil {
+		m.counts = nil
+	}
+}
+
+func (m *Outer) GetNamed(key int64) (value *Inner, ok bool) {
+	if m != nil {
+		value, ok = m.named[key]
+	}
+	return value, ok
+}
+
+func (m *Outer) PutNamed(key int64, value *Inner) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.named == nil {
+		m.named = make(map[int64]*Inner)
+	}
+	m.named[key] = value
+	return nil
+}
+
+func (m *Outer) DeleteNamed(key int64) {
+	if m != nil {
+		delete(m.named, key)
+	}
+}
+
+func (m *Outer) NamedLen() (size int) {
+	if m != nil {
+		return len(m.named)
+	}
+	return 0
+}
+
+func (m *Outer) RangeNamed(f func(key int64, value *Inner) bool) {
+	if m != nil {
+		for k, v := range m.named {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Outer) ClearNamed() {
+	if m != nil {
+		m.named = nil
+	}
+}
+
+func (m *Outer) SetTaggedValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsTaggedValueSet = true
+	m.taggedValue = value
+	return nil
+}
+
+func (m *Outer) HasTaggedValue() (isSet bool) {
+	if m != nil && m.xxx_IsTaggedValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearTaggedValue() {
+	if m != nil {
+		m.xxx_IsTaggedValueSet = false
+		m.taggedValue = ""
+	}
+}
+
+func (m *Outer) SetText(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ChoiceCase != Outer_ChoiceCase_Text {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Outer_ChoiceCase_Text
+	}
+	m.xxx_IsTextSet = true
+	m.text = value
+	return nil
+}
+
+func (m *Outer) HasText() (isSet bool) {
+	if m != nil && m.xxx_IsTextSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearText() {
+	if m != nil {
+		m.xxx_IsTextSet = false
+		m.text = ""
+		if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+			m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Outer) MutateNested() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if m.xxx_ChoiceCase != Outer_ChoiceCase_Nested {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Outer_ChoiceCase_Nested
+	}
+	if !m.xxx_IsNestedSet {
+		m.xxx_IsNestedSet = true
+		if m.nested == nil {
+			m.nested = new(Inner)
+		} else {
+			m.nested.Clear()
+		}
+	}
+	return m.nested, nil
+}
+
+func (m *Outer) HasNested() (isSet bool) {
+	if m != nil && m.xxx_IsNestedSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearNested() {
+	if m != nil {
+		m.nested.Clear()
+		m.xxx_IsNestedSet = false
+
+		if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+			m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Outer) Clear() {
+	if m != nil {
+		m.ClearIntValue()
+		m.ClearLongValue()
+		m.ClearUlongValue()
+		m.ClearDoubleValue()
+		m.ClearFloatValue()
+		m.ClearBoolValue()
+		m.ClearStringValue()
+		m.ClearBytesValue()
+		m.ClearColor()
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+		m.ClearLongs()
+		for i := 0; i < m.InnersSize(); i++ {
+			m.inners[i].Clear()
+		}
+		m.xxx_LenInners = 0
+
+		m.ClearColors()
+		m.ClearCounts()
+		m.ClearNamed()
+		m.ClearTaggedValue()
+		m.ClearText()
+		m.nested.Clear()
+		m.xxx_IsNestedSet = false
+
+		m.xxx_ChoiceCase = Outer_ChoiceCase_NotSet
+	}
+}
+
+type Outer_CountsEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            int32
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Outer_CountsEntry) Reset()         { *m = Outer_CountsEntry{} }
+func (m *Outer_CountsEntry) String() string { return proto.CompactTextString(m) }
+func (*Outer_CountsEntry) ProtoMessage()    {}
+
+func (m *Outer_CountsEntry) GetKey() string {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Outer_CountsEntry) GetValue() int32 {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return 0
+}
+
+func (m *Outer_CountsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Outer_CountsEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Outer_CountsEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer_CountsEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+		m.key = ""
+	}
+}
+
+func (m *Outer_CountsEntry) SetValue(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Outer_CountsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer_CountsEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+	}
+}
+
+func (m *Outer_CountsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+type Outer_NamedEntry struct {
+	xxx_sizeCached   int
+	key              int64
+	value            *Inner
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Outer_NamedEntry) Reset()         { *m = Outer_NamedEntry{} }
+func (m *Outer_NamedEntry) String() string { return proto.CompactTextString(m) }
+func (*Outer_NamedEntry) ProtoMessage()    {}
+
+func (m *Outer_NamedEntry) GetKey() int64 {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return 0
+}
+
+func (m *Outer_NamedEntry) GetValue() *Inner {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Outer_NamedEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Outer_NamedEntry) SetKey(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Outer_NamedEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer_NamedEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Outer_NamedEntry) MutateValue() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Inner)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Outer_NamedEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer_NamedEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Outer_NamedEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Inner) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovJsonpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Outer) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsIntValueSet {
+		n += 1 + sovJsonpb(uint64(uint32(m.intValue)))
+	}
+	if m.xxx_IsLongValueSet {
+		n += 1 + sovJsonpb(uint64(m.longValue))
+	}
+	if m.xxx_IsUlongValueSet {
+		n += 1 + sovJsonpb(uint64(m.ulongValue))
+	}
+	if m.xxx_IsDoubleValueSet {
+		n += 9
+	}
+	if m.xxx_IsFloatValueSet {
+		n += 5
+	}
+	if m.xxx_IsBoolValueSet {
+		n += 2
+	}
+	if m.xxx_IsStringValueSet {
+		l = len(m.stringValue)
+		n += 1 + l + sovJsonpb(uint64(l))
+	}
+	if m.xxx_IsBytesValueSet {
+		l = len(m.bytesValue)
+		n += 1 + l + sovJsonpb(uint64(l))
+	}
+	if m.xxx_IsColorSet {
+		n += 1 + sovJsonpb(uint64(m.color))
+	}
+	if m.xxx_IsInnerSet {
+		l = m.inner.Size()
+		n += 1 + l + sovJsonpb(uint64(l))
+	}
+	if m.xxx_LenLongs > 0 {
+		for i := 0; i < m.xxx_LenLongs; i++ {
+			e := m.longs[i]
+			n += 1 + sovJsonpb(uint64(e))
+		}
+	}
+	if m.xxx_LenInners > 0 {
+		for i := 0; i < m.xxx_LenInners; i++ {
+			e := m.inners[i]
+			l = e.Size()
+			n += 1 + l + sovJsonpb(uint64(l))
+		}
+	}
+	if m.xxx_LenColors > 0 {
+		for i := 0; i < m.xxx_LenColors; i++ {
+			e := m.colors[i]
+			n += 1 + sovJsonpb(uint64(e))
+		}
+	}
+	if len(m.counts) > 0 {
+		for k, v := range m.counts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovJsonpb(uint64(len(k))) + 1 + sovJsonpb(uint64(uint32(v)))
+			n += 1 + mapEntrySize + sovJsonpb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.named) > 0 {
+		for k, v := range m.named {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovJsonpb(uint64(k)) + 1 + l + sovJsonpb(uint64(l))
+			n += 1 + mapEntrySize + sovJsonpb(uint64(mapEntrySize))
+		}
+	}
+	if m.xxx_IsTaggedValueSet {
+		l = len(m.taggedValue)
+		n += 2 + l + sovJsonpb(uint64(l))
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		l = len(m.text)
+		n += 2 + l + sovJsonpb(uint64(l))
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		l = m.nested.Size()
+		n += 2 + l + sovJsonpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Outer_CountsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovJsonpb(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		n += 1 + sovJsonpb(uint64(uint32(m.value)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Outer_NamedEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		n += 1 + sovJsonpb(uint64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovJsonpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovJsonpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozJsonpb(x uint64) (n int) {
+	return sovJsonpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Inner) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Inner) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Inner) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Outer) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Outer) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Outer) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsIntValueSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(uint32(m.intValue)))
+	}
+	if m.xxx_IsLongValueSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(m.longValue))
+	}
+	if m.xxx_IsUlongValueSet {
+		data[i] = 0x18
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(m.ulongValue))
+	}
+	if m.xxx_IsDoubleValueSet {
+		data[i] = 0x21
+		i++
+		i = encodeFixed64Jsonpb(data, i, uint64(math.Float64bits(float64(m.doubleValue))))
+	}
+	if m.xxx_IsFloatValueSet {
+		data[i] = 0x2d
+		i++
+		i = encodeFixed32Jsonpb(data, i, uint32(math.Float32bits(float32(m.floatValue))))
+	}
+	if m.xxx_IsBoolValueSet {
+		data[i] = 0x30
+		i++
+		if m.boolValue {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.xxx_IsStringValueSet {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(len(m.stringValue)))
+		i += copy(data[i:], m.stringValue)
+	}
+	if m.xxx_IsBytesValueSet {
+		data[i] = 0x42
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(len(m.bytesValue)))
+		i += copy(data[i:], m.bytesValue)
+	}
+	if m.xxx_IsColorSet {
+		data[i] = 0x48
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(m.color))
+	}
+	if m.xxx_IsInnerSet {
+		data[i] = 0x52
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(m.inner.SizeCached()))
+		n1, err := m.inner.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_LenLongs > 0 {
+		for idx := 0; idx < m.xxx_LenLongs; idx++ {
+			num := m.longs[idx]
+			data[i] = 0x58
+			i++
+			i = encodeVarintJsonpb(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenInners > 0 {
+		for idx := 0; idx < m.xxx_LenInners; idx++ {
+			msg := m.inners[idx]
+			data[i] = 0x62
+			i++
+			i = encodeVarintJsonpb(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.xxx_LenColors > 0 {
+		for idx := 0; idx < m.xxx_LenColors; idx++ {
+			num := m.colors[idx]
+			data[i] = 0x68
+			i++
+			i = encodeVarintJsonpb(data, i, uint64(num))
+		}
+	}
+	if len(m.counts) > 0 {
+		keys := make([]string, 0, len(m.counts))
+		for k := range m.counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.counts[k]
+			data[i] = 0x72
+			i++
+			mapEntrySize := 1 + len(k) + sovJsonpb(uint64(len(k))) + 1 + sovJsonpb(uint64(uint32(v)))
+			i = encodeVarintJsonpb(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintJsonpb(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			i = encodeVarintJsonpb(data, i, uint64(uint32(v)))
+		}
+	}
+	if len(m.named) > 0 {
+		keys := make([]int64, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			data[i] = 0x7a
+			i++
+			mapEntrySize := 1 + sovJsonpb(uint64(k)) + 1 + v.SizeCached() + sovJsonpb(uint64(v.SizeCached()))
+			i = encodeVarintJsonpb(data, i, uint64(mapEntrySize))
+			data[i] = 0x8
+			i++
+			i = encodeVarintJsonpb(data, i, uint64(k))
+			data[i] = 0x12
+			i++
+			i = encodeVarintJsonpb(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if m.xxx_IsTaggedValueSet {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(len(m.taggedValue)))
+		i += copy(data[i:], m.taggedValue)
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		data[i] = 0x8a
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(len(m.text)))
+		i += copy(data[i:], m.text)
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		data[i] = 0x92
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(m.nested.SizeCached()))
+		n2, err := m.nested.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Outer_CountsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Outer_CountsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Outer_CountsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(uint32(m.value)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Outer_NamedEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Outer_NamedEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Outer_NamedEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintJsonpb(data, i, uint64(m.value.SizeCached()))
+		n3, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Jsonpb(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Jsonpb(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintJsonpb(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Inner) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Outer) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field intValue", wireType)
+			}
+			m.xxx_IsIntValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.intValue |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field longValue", wireType)
+			}
+			m.xxx_IsLongValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.longValue |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ulongValue", wireType)
+			}
+			m.xxx_IsUlongValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.ulongValue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field doubleValue", wireType)
+			}
+			m.xxx_IsDoubleValueSet = true
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			m.doubleValue = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field floatValue", wireType)
+			}
+			m.xxx_IsFloatValueSet = true
+			var v uint32
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint32(data[i-4])
+			v |= uint32(data[i-3]) << 8
+			v |= uint32(data[i-2]) << 16
+			v |= uint32(data[i-1]) << 24
+			m.floatValue = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field boolValue", wireType)
+			}
+			m.xxx_IsBoolValueSet = true
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.boolValue = bool(bool(v != 0))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field stringValue", wireType)
+			}
+			m.xxx_IsStringValueSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.stringValue = string(data[index:postIndex])
+			index = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field bytesValue", wireType)
+			}
+			m.xxx_IsBytesValueSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.bytesValue = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field color", wireType)
+			}
+			m.xxx_IsColorSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.color |= (Color(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field inner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateInner()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.longs) <= m.xxx_LenLongs {
+						newCapacity := 0
+						if len(m.longs) == 0 {
+							newCapacity = 8
+						} else if len(m.longs) < 1000000 {
+							newCapacity = m.xxx_LenLongs * 2
+						} else {
+							newCapacity = m.xxx_LenLongs + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.longs)
+						m.longs = t
+					}
+					m.longs[m.xxx_LenLongs] = int64(v)
+					m.xxx_LenLongs += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.longs) <= m.xxx_LenLongs {
+					newCapacity := 0
+					if len(m.longs) == 0 {
+						newCapacity = 8
+					} else if len(m.longs) < 1000000 {
+						newCapacity = m.xxx_LenLongs * 2
+					} else {
+						newCapacity = m.xxx_LenLongs + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.longs)
+					m.longs = t
+				}
+				m.longs[m.xxx_LenLongs] = int64(v)
+				m.xxx_LenLongs += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field longs", wireType)
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field inners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddInners()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v Color
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (Color(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.colors) <= m.xxx_LenColors {
+						newCapacity := 0
+						if len(m.colors) == 0 {
+							newCapacity = 8
+						} else if len(m.colors) < 1000000 {
+							newCapacity = m.xxx_LenColors * 2
+						} else {
+							newCapacity = m.xxx_LenColors + 1000000
+						}
+						t := make([]Color, newCapacity, newCapacity)
+						copy(t, m.colors)
+						m.colors = t
+					}
+					m.colors[m.xxx_LenColors] = v
+					m.xxx_LenColors += 1
+				}
+			} else if wireType == 0 {
+				var v Color
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (Color(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.colors) <= m.xxx_LenColors {
+					newCapacity := 0
+					if len(m.colors) == 0 {
+						newCapacity = 8
+					} else if len(m.colors) < 1000000 {
+						newCapacity = m.xxx_LenColors * 2
+					} else {
+						newCapacity = m.xxx_LenColors + 1000000
+					}
+					t := make([]Color, newCapacity, newCapacity)
+					copy(t, m.colors)
+					m.colors = t
+				}
+				m.colors[m.xxx_LenColors] = v
+				m.xxx_LenColors += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field colors", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Outer_CountsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.counts == nil {
+				m.counts = make(map[string]int32)
+			}
+			m.counts[entry.key] = entry.value
+			index = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field named", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Outer_NamedEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.named == nil {
+				m.named = make(map[int64]*Inner)
+			}
+			if entry.value == nil {
+				entry.value = new(Inner)
+			}
+			m.named[entry.key] = entry.value
+			index = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field taggedValue", wireType)
+			}
+			m.xxx_IsTaggedValueSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.taggedValue = string(data[index:postIndex])
+			index = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field text", wireType)
+			}
+			if m.xxx_ChoiceCase != Outer_ChoiceCase_Text {
+				m.ClearChoice()
+				m.xxx_ChoiceCase = Outer_ChoiceCase_Text
+			}
+			m.xxx_IsTextSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.text = string(data[index:postIndex])
+			index = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateNested()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Outer_CountsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Outer_NamedEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.key |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Inner) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Inner) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Outer) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Outer) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Outer_CountsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Outer_CountsEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Outer_NamedEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Outer_NamedEntry) CheckInitialized() error {
+	return nil
+}
+
+func (m *Inner) MarshalJSONPB(w *jsonpb1.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Inner) UnmarshalJSONPB(u *jsonpb1.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb1.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Inner) MarshalJSON() ([]byte, error) {
+	return jsonpb1.Marshal(m)
+}
+
+func (m *Inner) UnmarshalJSON(data []byte) error {
+	return jsonpb1.Unmarshal(data, m)
+}
+
+func (m *Outer) MarshalJSONPB(w *jsonpb1.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsIntValueSet || w.EmitDefaults() {
+		w.Field("intValue", "int_value")
+		w.Int32(m.GetIntValue())
+	}
+	if m.xxx_IsLongValueSet || w.EmitDefaults() {
+		w.Field("longValue", "long_value")
+		w.Int64(m.GetLongValue())
+	}
+	if m.xxx_IsUlongValueSet || w.EmitDefaults() {
+		w.Field("ulongValue", "ulong_value")
+		w.Uint64(m.GetUlongValue())
+	}
+	if m.xxx_IsDoubleValueSet || w.EmitDefaults() {
+		w.Field("doubleValue", "double_value")
+		w.Float64(m.GetDoubleValue())
+	}
+	if m.xxx_IsFloatValueSet || w.EmitDefaults() {
+		w.Field("floatValue", "float_value")
+		w.Float32(m.GetFloatValue())
+	}
+	if m.xxx_IsBoolValueSet || w.EmitDefaults() {
+		w.Field("boolValue", "bool_value")
+		w.Bool(m.GetBoolValue())
+	}
+	if m.xxx_IsStringValueSet || w.EmitDefaults() {
+		w.Field("stringValue", "string_value")
+		w.String(m.GetStringValue())
+	}
+	if m.xxx_IsBytesValueSet || w.EmitDefaults() {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.GetBytesValue())
+	}
+	if m.xxx_IsColorSet || w.EmitDefaults() {
+		w.Field("color", "color")
+		w.Enum(int32(m.GetColor()), Color_name)
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner", "inner")
+		w.Message(m.GetInner())
+	} else if w.EmitDefaults() {
+		w.Field("inner", "inner")
+		w.Null()
+	}
+	if m.xxx_LenLongs > 0 || w.EmitDefaults() {
+		w.Field("longs", "longs")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenLongs; i++ {
+			w.Int64(m.longs[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenInners > 0 || w.EmitDefaults() {
+		w.Field("inners", "inners")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenInners; i++ {
+			w.Message(m.inners[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenColors > 0 || w.EmitDefaults() {
+		w.Field("colors", "colors")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenColors; i++ {
+			w.Enum(int32(m.colors[i]), Color_name)
+		}
+		w.EndArray()
+	}
+	if len(m.counts) > 0 || w.EmitDefaults() {
+		w.Field("counts", "counts")
+		w.BeginObject()
+		keys := make([]string, 0, len(m.counts))
+		for k := range m.counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(k)
+			w.Int32(m.counts[k])
+		}
+		w.EndObject()
+	}
+	if len(m.named) > 0 || w.EmitDefaults() {
+		w.Field("named", "named")
+		w.BeginObject()
+		keys := make([]int64, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.IntKey(int64(k))
+			w.Message(m.named[k])
+		}
+		w.EndObject()
+	}
+	if m.xxx_IsTaggedValueSet || w.EmitDefaults() {
+		w.Field("custom", "tagged_value")
+		w.String(m.GetTaggedValue())
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		w.Field("text", "text")
+		w.String(m.GetText())
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		w.Field("nested", "nested")
+		w.Message(m.GetNested())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Outer) UnmarshalJSONPB(u *jsonpb1.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("intValue", "int_value"); ok {
+		v, err := jsonpb1.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetIntValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("longValue", "long_value"); ok {
+		v, err := jsonpb1.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetLongValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("ulongValue", "ulong_value"); ok {
+		v, err := jsonpb1.Uint64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetUlongValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("doubleValue", "double_value"); ok {
+		v, err := jsonpb1.Float64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetDoubleValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("floatValue", "float_value"); ok {
+		v, err := jsonpb1.Float32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFloatValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("boolValue", "bool_value"); ok {
+		v, err := jsonpb1.Bool(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetBoolValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("stringValue", "string_value"); ok {
+		v, err := jsonpb1.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetStringValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("bytesValue", "bytes_value"); ok {
+		v, err := jsonpb1.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetBytesValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("color", "color"); ok {
+		v, err := jsonpb1.ClosedEnum(raw, Color_value, Color_name)
+		if err != nil {
+			return err
+		}
+		if err := m.SetColor(Color(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("inner", "inner"); ok {
+		v, err := m.MutateInner()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("longs", "longs"); ok {
+		elems, err := jsonpb1.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb1.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddLongs(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("inners", "inners"); ok {
+		elems, err := jsonpb1.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddInners()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("colors", "colors"); ok {
+		elems, err := jsonpb1.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb1.ClosedEnum(elem, Color_value, Color_name)
+			if err != nil {
+				return err
+			}
+			if err := m.AddColors(Color(v)); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("counts", "counts"); ok {
+		entries, err := jsonpb1.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v, err := jsonpb1.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.PutCounts(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("named", "named"); ok {
+		entries, err := jsonpb1.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			k, err := jsonpb1.Int64(jsonpb1.Key(key))
+			if err != nil {
+				return err
+			}
+			v := new(Inner)
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+			if err := m.PutNamed(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("custom", "tagged_value"); ok {
+		v, err := jsonpb1.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetTaggedValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("text", "text"); ok {
+		v, err := jsonpb1.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetText(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("nested", "nested"); ok {
+		v, err := m.MutateNested()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Outer) MarshalJSON() ([]byte, error) {
+	return jsonpb1.Marshal(m)
+}
+
+func (m *Outer) UnmarshalJSON(data []byte) error {
+	return jsonpb1.Unmarshal(data, m)
+}
+
+func (m *Outer_CountsEntry) MarshalJSONPB(w *jsonpb1.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Int32(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Outer_CountsEntry) UnmarshalJSONPB(u *jsonpb1.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb1.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb1.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Outer_CountsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb1.Marshal(m)
+}
+
+func (m *Outer_CountsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb1.Unmarshal(data, m)
+}
+
+func (m *Outer_NamedEntry) MarshalJSONPB(w *jsonpb1.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.Int64(m.GetKey())
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Outer_NamedEntry) UnmarshalJSONPB(u *jsonpb1.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb1.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Outer_NamedEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb1.Marshal(m)
+}
+
+func (m *Outer_NamedEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb1.Unmarshal(data, m)
+}
+
+func (m *Inner) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Inner) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	}
+	return false, nil
+}
+
+func (m *Outer) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsIntValueSet {
+		w.Field("int_value")
+		w.Value(m.intValue)
+	}
+	if m.xxx_IsLongValueSet {
+		w.Field("long_value")
+		w.Value(m.longValue)
+	}
+	if m.xxx_IsUlongValueSet {
+		w.Field("ulong_value")
+		w.Value(m.ulongValue)
+	}
+	if m.xxx_IsDoubleValueSet {
+		w.Field("double_value")
+		w.Value(m.doubleValue)
+	}
+	if m.xxx_IsFloatValueSet {
+		w.Field("float_value")
+		w.Value(m.floatValue)
+	}
+	if m.xxx_IsBoolValueSet {
+		w.Field("bool_value")
+		w.Value(m.boolValue)
+	}
+	if m.xxx_IsStringValueSet {
+		w.Field("string_value")
+		w.Value(m.stringValue)
+	}
+	if m.xxx_IsBytesValueSet {
+		w.Field("bytes_value")
+		w.Value(m.bytesValue)
+	}
+	if m.xxx_IsColorSet {
+		w.Field("color")
+		w.Enum(int32(m.color), Color_name)
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner")
+		w.Message(m.inner)
+	}
+	for i := 0; i < m.xxx_LenLongs; i++ {
+		w.Field("longs")
+		w.Value(m.longs[i])
+	}
+	for i := 0; i < m.xxx_LenInners; i++ {
+		w.Field("inners")
+		w.Message(m.inners[i])
+	}
+	for i := 0; i < m.xxx_LenColors; i++ {
+		w.Field("colors")
+		w.Enum(int32(m.colors[i]), Color_name)
+	}
+	if len(m.counts) > 0 {
+		keys := make([]string, 0, len(m.counts))
+		for k := range m.counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.counts[k]
+			w.Field("counts")
+			w.Message(&Outer_CountsEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if len(m.named) > 0 {
+		keys := make([]int64, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			w.Field("named")
+			w.Message(&Outer_NamedEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if m.xxx_IsTaggedValueSet {
+		w.Field("tagged_value")
+		w.Value(m.taggedValue)
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		w.Field("text")
+		w.Value(m.text)
+	}
+	if m.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		w.Field("nested")
+		w.Message(m.nested)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Outer) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "int_value":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetIntValue(v)
+	case "long_value":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetLongValue(v)
+	case "ulong_value":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetUlongValue(v)
+	case "double_value":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetDoubleValue(v)
+	case "float_value":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFloatValue(v)
+	case "bool_value":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetBoolValue(v)
+	case "string_value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetStringValue(v)
+	case "bytes_value":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetBytesValue(v)
+	case "color":
+		v, err := p.ReadEnum(Color_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetColor(Color(v))
+	case "inner":
+		v, err := m.MutateInner()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "longs":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddLongs(v)
+	case "inners":
+		v, err := m.AddInners()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "colors":
+		v, err := p.ReadEnum(Color_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddColors(Color(v))
+	case "counts":
+		entry := &Outer_CountsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.counts == nil {
+			m.counts = make(map[string]int32)
+		}
+		m.counts[entry.key] = entry.value
+		return true, nil
+	case "named":
+		entry := &Outer_NamedEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.named == nil {
+			m.named = make(map[int64]*Inner)
+		}
+		if entry.value == nil {
+			entry.value = new(Inner)
+		}
+		m.named[entry.key] = entry.value
+		return true, nil
+	case "tagged_value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetTaggedValue(v)
+	case "text":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetText(v)
+	case "nested":
+		v, err := m.MutateNested()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Outer_CountsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Outer_CountsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Outer_NamedEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Outer_NamedEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Inner) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Inner{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Inner) MergeFrom(src proto.Message) {
+	s, ok := src.(*Inner)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Inner) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Inner)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Outer) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Outer{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Outer) MergeFrom(src proto.Message) {
+	s, ok := src.(*Outer)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsIntValueSet {
+		m.SetIntValue(s.intValue)
+	}
+	if s.xxx_IsLongValueSet {
+		m.SetLongValue(s.longValue)
+	}
+	if s.xxx_IsUlongValueSet {
+		m.SetUlongValue(s.ulongValue)
+	}
+	if s.xxx_IsDoubleValueSet {
+		m.SetDoubleValue(s.doubleValue)
+	}
+	if s.xxx_IsFloatValueSet {
+		m.SetFloatValue(s.floatValue)
+	}
+	if s.xxx_IsBoolValueSet {
+		m.SetBoolValue(s.boolValue)
+	}
+	if s.xxx_IsStringValueSet {
+		m.SetStringValue(s.stringValue)
+	}
+	if s.xxx_IsBytesValueSet {
+		m.SetBytesValue(append([]byte{}, s.bytesValue...))
+	}
+	if s.xxx_IsColorSet {
+		m.SetColor(s.color)
+	}
+	if s.xxx_IsInnerSet {
+		v, _ := m.MutateInner()
+		v.MergeFrom(s.inner)
+	}
+	for i := 0; i < s.xxx_LenLongs; i++ {
+		m.AddLongs(s.longs[i])
+	}
+	for i := 0; i < s.xxx_LenInners; i++ {
+		v, _ := m.AddInners()
+		v.MergeFrom(s.inners[i])
+	}
+	for i := 0; i < s.xxx_LenColors; i++ {
+		m.AddColors(s.colors[i])
+	}
+	if len(s.counts) > 0 {
+		if m.counts == nil {
+			m.counts = make(map[string]int32, len(s.counts))
+		}
+		for k, v := range s.counts {
+			m.counts[k] = v
+		}
+	}
+	if len(s.named) > 0 {
+		if m.named == nil {
+			m.named = make(map[int64]*Inner, len(s.named))
+		}
+		for k, v := range s.named {
+			m.named[k] = v.Clone().(*Inner)
+		}
+	}
+	if s.xxx_IsTaggedValueSet {
+		m.SetTaggedValue(s.taggedValue)
+	}
+	if s.xxx_ChoiceCase == Outer_ChoiceCase_Text {
+		m.SetText(s.text)
+	}
+	if s.xxx_ChoiceCase == Outer_ChoiceCase_Nested {
+		v, _ := m.MutateNested()
+		v.MergeFrom(s.nested)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Outer) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Outer)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsIntValueSet != o.xxx_IsIntValueSet {
+		return false
+	}
+	if m.xxx_IsIntValueSet && m.intValue != o.intValue {
+		return false
+	}
+	if m.xxx_IsLongValueSet != o.xxx_IsLongValueSet {
+		return false
+	}
+	if m.xxx_IsLongValueSet && m.longValue != o.longValue {
+		return false
+	}
+	if m.xxx_IsUlongValueSet != o.xxx_IsUlongValueSet {
+		return false
+	}
+	if m.xxx_IsUlongValueSet && m.ulongValue != o.ulongValue {
+		return false
+	}
+	if m.xxx_IsDoubleValueSet != o.xxx_IsDoubleValueSet {
+		return false
+	}
+	if m.xxx_IsDoubleValueSet && m.doubleValue != o.doubleValue {
+		return false
+	}
+	if m.xxx_IsFloatValueSet != o.xxx_IsFloatValueSet {
+		return false
+	}
+	if m.xxx_IsFloatValueSet && m.floatValue != o.floatValue {
+		return false
+	}
+	if m.xxx_IsBoolValueSet != o.xxx_IsBoolValueSet {
+		return false
+	}
+	if m.xxx_IsBoolValueSet && m.boolValue != o.boolValue {
+		return false
+	}
+	if m.xxx_IsStringValueSet != o.xxx_IsStringValueSet {
+		return false
+	}
+	if m.xxx_IsStringValueSet && m.stringValue != o.stringValue {
+		return false
+	}
+	if m.xxx_IsBytesValueSet != o.xxx_IsBytesValueSet {
+		return false
+	}
+	if m.xxx_IsBytesValueSet && !bytes.Equal(m.bytesValue, o.bytesValue) {
+		return false
+	}
+	if m.xxx_IsColorSet != o.xxx_IsColorSet {
+		return false
+	}
+	if m.xxx_IsColorSet && m.color != o.color {
+		return false
+	}
+	if m.xxx_IsInnerSet != o.xxx_IsInnerSet {
+		return false
+	}
+	if m.xxx_IsInnerSet && !m.inner.Equal(o.inner) {
+		return false
+	}
+	if m.xxx_LenLongs != o.xxx_LenLongs {
+		return false
+	}
+	for i := 0; i < m.xxx_LenLongs; i++ {
+		if m.longs[i] != o.longs[i] {
+			return false
+		}
+	}
+	if m.xxx_LenInners != o.xxx_LenInners {
+		return false
+	}
+	for i := 0; i < m.xxx_LenInners; i++ {
+		if !m.inners[i].Equal(o.inners[i]) {
+			return false
+		}
+	}
+	if m.xxx_LenColors != o.xxx_LenColors {
+		return false
+	}
+	for i := 0; i < m.xxx_LenColors; i++ {
+		if m.colors[i] != o.colors[i] {
+			return false
+		}
+	}
+	if len(m.counts) != len(o.counts) {
+		return false
+	}
+	for k, v := range m.counts {
+		if v2, ok := o.counts[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	if len(m.named) != len(o.named) {
+		return false
+	}
+	for k, v := range m.named {
+		if v2, ok := o.named[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if m.xxx_IsTaggedValueSet != o.xxx_IsTaggedValueSet {
+		return false
+	}
+	if m.xxx_IsTaggedValueSet && m.taggedValue != o.taggedValue {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Text) != (o.xxx_ChoiceCase == Outer_ChoiceCase_Text) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Text) && m.text != o.text {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Nested) != (o.xxx_ChoiceCase == Outer_ChoiceCase_Nested) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Outer_ChoiceCase_Nested) && !m.nested.Equal(o.nested) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Outer_CountsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Outer_CountsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Outer_CountsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Outer_CountsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Outer_CountsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Outer_CountsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Outer_NamedEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Outer_NamedEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Outer_NamedEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Outer_NamedEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Outer_NamedEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Outer_NamedEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionInner = &proto.MessageInfo{
+	Name: "jsonpb.Inner",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Inner)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Inner).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearName()
+			},
+		},
+	},
+}
+
+func (m *Inner) ProtoReflect() *proto.MessageInfo {
+	return reflectionInner
+}
+
+var reflectionOuter = &proto.MessageInfo{
+	Name: "jsonpb.Outer",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "int_value",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetIntValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetIntValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "int_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetIntValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasIntValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearIntValue()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "long_value",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetLongValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetLongValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "long_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetLongValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasLongValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearLongValue()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "ulong_value",
+			Kind:    proto.Uint64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetUlongValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetUlongValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "ulong_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetUlongValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasUlongValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearUlongValue()
+			},
+		},
+		{
+			Number:  4,
+			Name:    "double_value",
+			Kind:    proto.DoubleKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetDoubleValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetDoubleValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "double_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetDoubleValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasDoubleValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearDoubleValue()
+			},
+		},
+		{
+			Number:  5,
+			Name:    "float_value",
+			Kind:    proto.FloatKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetFloatValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetFloatValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "float_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetFloatValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasFloatValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearFloatValue()
+			},
+		},
+		{
+			Number:  6,
+			Name:    "bool_value",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetBoolValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetBoolValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "bool_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetBoolValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasBoolValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearBoolValue()
+			},
+		},
+		{
+			Number:  7,
+			Name:    "string_value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetStringValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetStringValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "string_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetStringValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasStringValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearStringValue()
+			},
+		},
+		{
+			Number:  8,
+			Name:    "bytes_value",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetBytesValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetBytesValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "bytes_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetBytesValue(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasBytesValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearBytesValue()
+			},
+		},
+		{
+			Number:  9,
+			Name:    "color",
+			Kind:    proto.EnumKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetColor(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetColor()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Color)
+				if !ok {
+					return proto.NewFieldTypeError(m, "color", v)
+				}
+				x := m.(*Outer)
+				return x.SetColor(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasColor()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearColor()
+			},
+		},
+		{
+			Number: 10,
+			Name:   "inner",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetInner()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "inner", v)
+				}
+				x := m.(*Outer)
+				x.ClearInner()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateInner()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasInner()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearInner()
+			},
+		},
+		{
+			Number: 11,
+			Name:   "longs",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([]int64, x.xxx_LenLongs)
+				copy(v, x.longs)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "longs", v)
+				}
+				x := m.(*Outer)
+				x.ClearLongs()
+				for _, e := range value {
+					if err := x.AddLongs(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).LongsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearLongs()
+			},
+		},
+		{
+			Number: 12,
+			Name:   "inners",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([]*Inner, x.xxx_LenInners)
+				copy(v, x.inners)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "inners", v)
+				}
+				x := m.(*Outer)
+				x.ClearInners()
+				for _, e := range value {
+					field, err := x.AddInners()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).InnersSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearInners()
+			},
+		},
+		{
+			Number: 13,
+			Name:   "colors",
+			Kind:   proto.EnumKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([]Color, x.xxx_LenColors)
+				copy(v, x.colors)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]Color)
+				if !ok {
+					return proto.NewFieldTypeError(m, "colors", v)
+				}
+				x := m.(*Outer)
+				x.ClearColors()
+				for _, e := range value {
+					if err := x.AddColors(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).ColorsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearColors()
+			},
+		},
+		{
+			Number: 14,
+			Name:   "counts",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make(map[string]int32, x.CountsLen())
+				x.RangeCounts(func(key string, value int32) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "counts", v)
+				}
+				x := m.(*Outer)
+				x.ClearCounts()
+				for k, e := range value {
+					if err := x.PutCounts(k, e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).CountsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearCounts()
+			},
+		},
+		{
+			Number: 15,
+			Name:   "named",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make(map[int64]*Inner, x.NamedLen())
+				x.RangeNamed(func(key int64, value *Inner) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[int64]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "named", v)
+				}
+				x := m.(*Outer)
+				x.ClearNamed()
+				for k, e := range value {
+					if err := x.PutNamed(k, e.Clone().(*Inner)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).NamedLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearNamed()
+			},
+		},
+		{
+			Number:  16,
+			Name:    "tagged_value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetTaggedValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetTaggedValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "tagged_value", v)
+				}
+				x := m.(*Outer)
+				return x.SetTaggedValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasTaggedValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearTaggedValue()
+			},
+		},
+		{
+			Number:  17,
+			Name:    "text",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "choice",
+			Default: (*Outer)(nil).GetText(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetText()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "text", v)
+				}
+				x := m.(*Outer)
+				return x.SetText(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasText()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearText()
+			},
+		},
+		{
+			Number: 18,
+			Name:   "nested",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			Oneof:  "choice",
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetNested()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "nested", v)
+				}
+				x := m.(*Outer)
+				x.ClearNested()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateNested()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasNested()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearNested()
+			},
+		},
+	},
+}
+
+func (m *Outer) ProtoReflect() *proto.MessageInfo {
+	return reflectionOuter
+}
+
+var reflectionOuter_CountsEntry = &proto.MessageInfo{
+	Name: "jsonpb.Outer.CountsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer_CountsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer_CountsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Outer_CountsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer_CountsEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer_CountsEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer_CountsEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer_CountsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Outer_CountsEntry)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer_CountsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer_CountsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Outer_CountsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionOuter_CountsEntry
+}
+
+var reflectionOuter_NamedEntry = &proto.MessageInfo{
+	Name: "jsonpb.Outer.NamedEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer_NamedEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer_NamedEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Outer_NamedEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer_NamedEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer_NamedEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer_NamedEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Outer_NamedEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer_NamedEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer_NamedEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Outer_NamedEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionOuter_NamedEntry
+}
+
+func init() {
+	proto.RegisterEnum("jsonpb.Color", Color_name, Color_value)
+	proto.RegisterType((*Inner)(nil), "jsonpb.Inner")
+	proto.RegisterType((*Outer)(nil), "jsonpb.Outer")
+}
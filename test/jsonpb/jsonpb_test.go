@@ -0,0 +1,205 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+)
+
+func newOuter() *Outer {
+	m := &Outer{}
+	m.SetIntValue(-1)
+	m.SetLongValue(1 << 60)
+	m.SetUlongValue(math.MaxUint64)
+	m.SetDoubleValue(math.Inf(-1))
+	m.SetFloatValue(0.5)
+	m.SetBoolValue(true)
+	m.SetBytesValue([]byte("\xff\x00bytes"))
+	m.SetColor(Color_BLUE)
+	inner, _ := m.MutateInner()
+	inner.SetName("in")
+	m.AddLongs(2)
+	m.AddLongs(-3)
+	inner, _ = m.AddInners()
+	inner.SetName("a")
+	m.AddInners()
+	m.AddColors(Color_GREEN)
+	m.AddColors(Color_RED)
+	m.PutCounts("b", 2)
+	m.PutCounts("a", 1)
+	named := &Inner{}
+	named.SetName("n")
+	m.PutNamed(-5, named)
+	m.SetTaggedValue("tag")
+	m.SetText("text")
+	return m
+}
+
+const outerJSON = `{"intValue":-1,"longValue":"1152921504606846976",` +
+	`"ulongValue":"18446744073709551615","doubleValue":"-Infinity","floatValue":0.5,` +
+	`"boolValue":true,"bytesValue":"/wBieXRlcw==","color":"BLUE","inner":{"name":"in"},` +
+	`"longs":["2","-3"],"inners":[{"name":"a"},{}],"colors":["GREEN","RED"],` +
+	`"counts":{"a":1,"b":2},"named":{"-5":{"name":"n"}},"custom":"tag","text":"text"}`
+
+func equal(t *testing.T, m1 *Outer, m2 *Outer) bool {
+	data1, err := m1.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data2, err := m2.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Equal(data1, data2)
+}
+
+func TestMarshal(t *testing.T) {
+	s, err := new(jsonpb.Marshaler).MarshalToString(newOuter())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != outerJSON {
+		t.Fatalf("expected\n%s\ngot\n%s", outerJSON, s)
+	}
+}
+
+func TestOrigName(t *testing.T) {
+	m := &Outer{}
+	m.SetIntValue(1)
+	m.SetTaggedValue("x")
+	s, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != `{"int_value":1,"tagged_value":"x"}` {
+		t.Fatalf("unexpected output %s", s)
+	}
+}
+
+func TestEmitDefaults(t *testing.T) {
+	s, err := (&jsonpb.Marshaler{EmitDefaults: true}).MarshalToString(&Inner{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != `{"name":""}` {
+		t.Fatalf("unexpected output %s", s)
+	}
+	s, err = (&jsonpb.Marshaler{EmitDefaults: true}).MarshalToString(&Outer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{`"stringValue":"hello"`, `"longValue":"0"`,
+		`"color":"RED"`, `"inner":null`, `"longs":[]`, `"counts":{}`} {
+		if !strings.Contains(s, expected) {
+			t.Fatalf("expected %s in %s", expected, s)
+		}
+	}
+	if strings.Contains(s, `"text"`) {
+		t.Fatalf("unset oneof members should not be written: %s", s)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	m := &Outer{}
+	if err := new(jsonpb.Unmarshaler).UnmarshalString(outerJSON, m); err != nil {
+		t.Fatal(err)
+	}
+	if !equal(t, m, newOuter()) {
+		t.Fatalf("round trip mismatch: %v", m)
+	}
+	s, err := new(jsonpb.Marshaler).MarshalToString(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != outerJSON {
+		t.Fatalf("expected\n%s\ngot\n%s", outerJSON, s)
+	}
+}
+
+func TestUnmarshalLenient(t *testing.T) {
+	m := &Outer{}
+	input := `{"int_value":"7","longValue":8,"color":1,"bytesValue":"_w","inner":null,` +
+		`"floatValue":"NaN","custom":"t","nested":{"name":"x"}}`
+	if err := new(jsonpb.Unmarshaler).UnmarshalString(input, m); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetIntValue() != 7 || m.GetLongValue() != 8 || m.GetColor() != Color_GREEN ||
+		string(m.GetBytesValue()) != "\xff" || m.HasInner() || !math.IsNaN(float64(m.GetFloatValue())) ||
+		m.GetTaggedValue() != "t" || m.GetNested().GetName() != "x" {
+		t.Fatalf("unexpected message %v", m)
+	}
+	if m.WhichChoice() != Outer_ChoiceCase_Nested {
+		t.Fatalf("expected the nested case to be set")
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, input := range []string{
+		`{"unknown":1}`,
+		`{"intValue":"x"}`,
+		`{"intValue":4294967296}`,
+		`{"color":"PURPLE"}`,
+		`{"color":7}`,
+		`{"colors":[1,7]}`,
+		`{"longs":1}`,
+		`[]`,
+	} {
+		if err := new(jsonpb.Unmarshaler).UnmarshalString(input, &Outer{}); err == nil {
+			t.Fatalf("expected an error for %s", input)
+		}
+	}
+	u := &jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := u.UnmarshalString(`{"unknown":1,"intValue":2}`, &Outer{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEncodingJSON(t *testing.T) {
+	type wrapper struct {
+		Msg *Outer `json:"msg"`
+	}
+	data, err := json.Marshal(wrapper{newOuter()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"msg":`+outerJSON+`}` {
+		t.Fatalf("unexpected output %s", data)
+	}
+	var w wrapper
+	if err := json.Unmarshal(data, &w); err != nil {
+		t.Fatal(err)
+	}
+	if !equal(t, w.Msg, newOuter()) {
+		t.Fatalf("round trip mismatch: %v", w.Msg)
+	}
+}
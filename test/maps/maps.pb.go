@@ -0,0 +1,4587 @@
+// Code generated by protoc-gen-dgo.
+// source: maps.proto
+// DO NOT EDIT!
+
+/*
+Package maps is a generated protocol buffer package.
+
+It is generated from these files:
+
+	maps.proto
+
+It has these top-level messages:
+
+	Sub
+	Maps
+*/
+package maps
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Color int32
+
+const (
+	Color_RED   Color = 0
+	Color_GREEN Color = 1
+	Color_BLUE  Color = 2
+)
+
+var Color_name = map[int32]string{
+	0: "RED",
+	1: "GREEN",
+	2: "BLUE",
+}
+var Color_value = map[string]int32{
+	"RED":   0,
+	"GREEN": 1,
+	"BLUE":  2,
+}
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+
+type Sub struct {
+	xxx_sizeCached   int
+	number           int64
+	XXX_unrecognized []byte
+	xxx_IsNumberSet  bool
+}
+
+func (m *Sub) Reset()         { *m = Sub{} }
+func (m *Sub) String() string { return proto.CompactTextString(m) }
+func (*Sub) ProtoMessage()    {}
+
+func (m *Sub) GetNumber() int64 {
+	if m != nil && m.xxx_IsNumberSet {
+		return m.number
+	}
+	return 0
+}
+
+func (m *Sub) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Sub) SetNumber(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNumberSet = true
+	m.number = value
+	return nil
+}
+
+func (m *Sub) HasNumber() (isSet bool) {
+	if m != nil && m.xxx_IsNumberSet {
+		return true
+	}
+	return false
+}
+
+func (m *Sub) ClearNumber() {
+	if m != nil {
+		m.xxx_IsNumberSet = false
+	}
+}
+
+func (m *Sub) Clear() {
+	if m != nil {
+		m.ClearNumber()
+	}
+}
+
+type Maps struct {
+	xxx_sizeCached   int
+	counts           map[string]int64
+	subs             map[int32]*Sub
+	flags            map[bool][]byte
+	names            map[int64]string
+	weights          map[uint32]float64
+	colors           map[uint32]Color
+	after            string
+	XXX_unrecognized []byte
+	xxx_IsAfterSet   bool
+}
+
+func (m *Maps) Reset()         { *m = Maps{} }
+func (m *Maps) String() string { return proto.CompactTextString(m) }
+func (*Maps) ProtoMessage()    {}
+
+func (m *Maps) GetAfter() string {
+	if m != nil && m.xxx_IsAfterSet {
+		return m.after
+	}
+	return ""
+}
+
+func (m *Maps) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Maps) GetCounts(key string) (value int64, ok bool) {
+	if m != nil {
+		value, ok = m.counts[key]
+	}
+	return value, ok
+}
+
+func (m *Maps) PutCounts(key string, value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.counts == nil {
+		m.counts = make(map[string]int64)
+	}
+	m.counts[key] = value
+	return nil
+}
+
+func (m *Maps) DeleteCounts(key string) {
+	if m != nil {
+		delete(m.counts, key)
+	}
+}
+
+func (m *Maps) CountsLen() (size int) {
+	if m != nil {
+		return len(m.counts)
+	}
+	return 0
+}
+
+func (m *Maps) RangeCounts(f func(key string, value int64) bool) {
+	if m != nil {
+		for k, v := range m.counts {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Maps) ClearCounts() {
+	if m != nil {
+		m.counts = nil
+	}
+}
+
+func (m *Maps) GetSubs(key int32) (value *Sub, ok bool) {
+	if m != nil {
+		value, ok = m.subs[key]
+	}
+	return value, ok
+}
+
+func (m *Maps) PutSubs(key int32, value *Sub) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.subs == nil {
+		m.subs = make(map[int32]*Sub)
+	}
+	m.subs[key] = value
+	return nil
+}
+
+func (m *Maps) DeleteSubs(key int32) {
+	if m != nil {
+		delete(m.subs, key)
+	}
+}
+
+func (m *Maps) SubsLen() (size int) {
+	if m != nil {
+		return len(m.subs)
+	}
+	return 0
+}
+
+func (m *Maps) RangeSubs(f func(key int32, value *Sub) bool) {
+	if m != nil {
+		for k, v := range m.subs {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Maps) ClearSubs() {
+	if m != nil {
+		m.subs = nil
+	}
+}
+
+func (m *Maps) GetFlags(key bool) (value []byte, ok bool) {
+	if m != nil {
+		value, ok = m.flags[key]
+	}
+	return value, ok
+}
+
+func (m *Maps) PutFlags(key bool, value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.flags == nil {
+		m.flags = make(map[bool][]byte)
+	}
+	m.flags[key] = value
+	return nil
+}
+
+func (m *Maps) DeleteFlags(key bool) {
+	if m != nil {
+		delete(m.flags, key)
+	}
+}
+
+func (m *Maps) FlagsLen() (size int) {
+	if m != nil {
+		return len(m.flags)
+	}
+	return 0
+}
+
+func (m *Maps) RangeFlags(f func(key bool, value []byte) bool) {
+	if m != nil {
+		for k, v := range m.flags {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Maps) ClearFlags() {
+	if m != nil {
+		m.flags = nil
+	}
+}
+
+func (m *Maps) GetNames(key int64) (value string, ok bool) {
+	if m != nil {
+		value, ok = m.names[key]
+	}
+	return value, ok
+}
+
+func (m *Maps) PutNames(key int64, value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.names == nil {
+		m.names = make(map[int64]string)
+	}
+	m.names[key] = value
+	return nil
+}
+
+func (m *Maps) DeleteNames(key int64) {
+	if m != nil {
+		delete(m.names, key)
+	}
+}
+
+func (m *Maps) NamesLen() (size int) {
+	if m != nil {
+		return len(m.names)
+	}
+	return 0
+}
+
+func (m *Maps) RangeNames(f func(key int64, value string) bool) {
+	if m != nil {
+		for k, v := range m.names {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Maps) ClearNames() {
+	if m != nil {
+		m.names = nil
+	}
+}
+
+func (m *Maps) GetWeights(key uint32) (value float64, ok bool) {
+	if m != nil {
+		value, ok = m.weights[key]
+	}
+	return value, ok
+}
+
+func (m *Maps) PutWeights(key uint32, value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.weights == nil {
+		m.weights = make(map[uint32]float64)
+	}
+	m.weights[key] = value
+	return nil
+}
+
+func (m *Maps) DeleteWeights(key uint32) {
+	if m != nil {
+		delete(m.weights, key)
+	}
+}
+
+func (m *Maps) WeightsLen() (size int) {
+	if m != nil {
+		return len(m.weights)
+	}
+	return 0
+}
+
+func (m *Maps) RangeWeights(f func(key uint32, value float64) bool) {
+	if m != nil {
+		for k, v := range m.weights {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Maps) ClearWeights() {
+	if m != nil {
+		m.weights = nil
+	}
+}
+
+func (m *Maps) GetColors(key uint32) (value Color, ok bool) {
+	if m != nil {
+		value, ok = m.colors[key]
+	}
+	return value, ok
+}
+
+func (m *Maps) PutColors(key uint32, value Color) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.colors == nil {
+		m.colors = make(map[uint32]Color)
+	}
+	m.colors[key] = value
+	return nil
+}
+
+func (m *Maps) DeleteColors(key uint32) {
+	if m != nil {
+		delete(m.colors, key)
+	}
+}
+
+func (m *Maps) ColorsLen() (size int) {
+	if m != nil {
+		return len(m.colors)
+	}
+	return 0
+}
+
+func (m *Maps) RangeColors(f func(key uint32, value Color) bool) {
+	if m != nil {
+		for k, v := range m.colors {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Maps) ClearColors() {
+	if m != nil {
+		m.colors = nil
+	}
+}
+
+func (m *Maps) SetAfter(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsAfterSet = true
+	m.after = value
+	return nil
+}
+
+func (m *Maps) HasAfter() (isSet bool) {
+	if m != nil && m.xxx_IsAfterSet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps) ClearAfter() {
+	if m != nil {
+		m.xxx_IsAfterSet = false
+		m.after = ""
+	}
+}
+
+func (m *Maps) Clear() {
+	if m != nil {
+		m.ClearCounts()
+		m.ClearSubs()
+		m.ClearFlags()
+		m.ClearNames()
+		m.ClearWeights()
+		m.ClearColors()
+		m.ClearAfter()
+	}
+}
+
+type Maps_CountsEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            int64
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Maps_CountsEntry) Reset()         { *m = Maps_CountsEntry{} }
+func (m *Maps_CountsEntry) String() string { return proto.CompactTextString(m) }
+func (*Maps_CountsEntry) ProtoMessage()    {}
+
+func (m *Maps_CountsEntry) GetKey() string {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Maps_CountsEntry) GetValue() int64 {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return 0
+}
+
+func (m *Maps_CountsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Maps_CountsEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Maps_CountsEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_CountsEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+		m.key = ""
+	}
+}
+
+func (m *Maps_CountsEntry) SetValue(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Maps_CountsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_CountsEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+	}
+}
+
+func (m *Maps_CountsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+type Maps_SubsEntry struct {
+	xxx_sizeCached   int
+	key              int32
+	value            *Sub
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Maps_SubsEntry) Reset()         { *m = Maps_SubsEntry{} }
+func (m *Maps_SubsEntry) String() string { return proto.CompactTextString(m) }
+func (*Maps_SubsEntry) ProtoMessage()    {}
+
+func (m *Maps_SubsEntry) GetKey() int32 {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return 0
+}
+
+func (m *Maps_SubsEntry) GetValue() *Sub {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Maps_SubsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Maps_SubsEntry) SetKey(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Maps_SubsEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_SubsEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Maps_SubsEntry) MutateValue() (field *Sub, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Sub)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Maps_SubsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_SubsEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Maps_SubsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+type Maps_FlagsEntry struct {
+	xxx_sizeCached   int
+	key              bool
+	value            []byte
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Maps_FlagsEntry) Reset()         { *m = Maps_FlagsEntry{} }
+func (m *Maps_FlagsEntry) String() string { return proto.CompactTextString(m) }
+func (*Maps_FlagsEntry) ProtoMessage()    {}
+
+func (m *Maps_FlagsEntry) GetKey() bool {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return false
+}
+
+func (m *Maps_FlagsEntry) GetValue() []byte {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Maps_FlagsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Maps_FlagsEntry) SetKey(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Maps_FlagsEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_FlagsEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Maps_FlagsEntry) SetValue(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Maps_FlagsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_FlagsEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+		m.value = nil
+	}
+}
+
+func (m *Maps_FlagsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+type Maps_NamesEntry struct {
+	xxx_sizeCached   int
+	key              int64
+	value            string
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Maps_NamesEntry) Reset()         { *m = Maps_NamesEntry{} }
+func (m *Maps_NamesEntry) String() string { return proto.CompactTextString(m) }
+func (*Maps_NamesEntry) ProtoMessage()    {}
+
+func (m *Maps_NamesEntry) GetKey() int64 {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return 0
+}
+
+func (m *Maps_NamesEntry) GetValue() string {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return ""
+}
+
+func (m *Maps_NamesEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Maps_NamesEntry) SetKey(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Maps_NamesEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_NamesEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Maps_NamesEntry) SetValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Maps_NamesEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_NamesEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+		m.value = ""
+	}
+}
+
+func (m *Maps_NamesEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+type Maps_WeightsEntry struct {
+	xxx_sizeCached   int
+	key              uint32
+	value            float64
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Maps_WeightsEntry) Reset()         { *m = Maps_WeightsEntry{} }
+func (m *Maps_WeightsEntry) String() string { return proto.CompactTextString(m) }
+func (*Maps_WeightsEntry) ProtoMessage()    {}
+
+func (m *Maps_WeightsEntry) GetKey() uint32 {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return 0
+}
+
+func (m *Maps_WeightsEntry) GetValue() float64 {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return 0
+}
+
+func (m *Maps_WeightsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Maps_WeightsEntry) SetKey(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Maps_WeightsEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_WeightsEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Maps_WeightsEntry) SetValue(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Maps_WeightsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_WeightsEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+	}
+}
+
+func (m *Maps_WeightsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+type Maps_ColorsEntry struct {
+	xxx_sizeCached   int
+	key              uint32
+	value            Color
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Maps_ColorsEntry) Reset()         { *m = Maps_ColorsEntry{} }
+func (m *Maps_ColorsEntry) String() string { return proto.CompactTextString(m) }
+func (*Maps_ColorsEntry) ProtoMessage()    {}
+
+func (m *Maps_ColorsEntry) GetKey() uint32 {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return 0
+}
+
+func (m *Maps_ColorsEntry) GetValue() Color {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return Color_RED
+}
+
+func (m *Maps_ColorsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Maps_ColorsEntry) SetKey(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Maps_ColorsEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_ColorsEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+	}
+}
+
+func (m *Maps_ColorsEntry) SetValue(value Color) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Maps_ColorsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Maps_ColorsEntry) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+	}
+}
+
+func (m *Maps_ColorsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.ClearValue()
+	}
+}
+
+func (m *Sub) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNumberSet {
+		n += 1 + sovMaps(uint64(m.number))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Maps) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.counts) > 0 {
+		for k, v := range m.counts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMaps(uint64(len(k))) + 1 + sovMaps(uint64(v))
+			n += 1 + mapEntrySize + sovMaps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.subs) > 0 {
+		for k, v := range m.subs {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovMaps(uint64(uint32(k))) + 1 + l + sovMaps(uint64(l))
+			n += 1 + mapEntrySize + sovMaps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.flags) > 0 {
+		for k, v := range m.flags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + len(v) + sovMaps(uint64(len(v)))
+			n += 1 + mapEntrySize + sovMaps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.names) > 0 {
+		for k, v := range m.names {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozMaps(uint64(k)) + 1 + len(v) + sovMaps(uint64(len(v)))
+			n += 1 + mapEntrySize + sovMaps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.weights) > 0 {
+		for k, v := range m.weights {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovMaps(uint64(k)) + 1 + 8
+			n += 1 + mapEntrySize + sovMaps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.colors) > 0 {
+		for k, v := range m.colors {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovMaps(uint64(v))
+			n += 1 + mapEntrySize + sovMaps(uint64(mapEntrySize))
+		}
+	}
+	if m.xxx_IsAfterSet {
+		l = len(m.after)
+		n += 1 + l + sovMaps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Maps_CountsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovMaps(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		n += 1 + sovMaps(uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Maps_SubsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		n += 1 + sovMaps(uint64(uint32(m.key)))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovMaps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Maps_FlagsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		n += 2
+	}
+	if m.xxx_IsValueSet {
+		l = len(m.value)
+		n += 1 + l + sovMaps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Maps_NamesEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		n += 1 + sozMaps(uint64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		l = len(m.value)
+		n += 1 + l + sovMaps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Maps_WeightsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		n += 1 + sovMaps(uint64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Maps_ColorsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		n += 5
+	}
+	if m.xxx_IsValueSet {
+		n += 1 + sovMaps(uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovMaps(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMaps(x uint64) (n int) {
+	return sovMaps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sub) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Sub) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Sub) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNumberSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintMaps(data, i, uint64(m.number))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Maps) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Maps) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Maps) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.counts) > 0 {
+		keys := make([]string, 0, len(m.counts))
+		for k := range m.counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.counts[k]
+			data[i] = 0xa
+			i++
+			mapEntrySize := 1 + len(k) + sovMaps(uint64(len(k))) + 1 + sovMaps(uint64(v))
+			i = encodeVarintMaps(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintMaps(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			i = encodeVarintMaps(data, i, uint64(v))
+		}
+	}
+	if len(m.subs) > 0 {
+		keys := make([]int32, 0, len(m.subs))
+		for k := range m.subs {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.subs[k]
+			data[i] = 0x12
+			i++
+			mapEntrySize := 1 + sovMaps(uint64(uint32(k))) + 1 + v.SizeCached() + sovMaps(uint64(v.SizeCached()))
+			i = encodeVarintMaps(data, i, uint64(mapEntrySize))
+			data[i] = 0x8
+			i++
+			i = encodeVarintMaps(data, i, uint64(uint32(k)))
+			data[i] = 0x12
+			i++
+			i = encodeVarintMaps(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if len(m.flags) > 0 {
+		keys := make([]bool, 0, len(m.flags))
+		for k := range m.flags {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return !keys[a] && keys[b] })
+		for _, k := range keys {
+			v := m.flags[k]
+			data[i] = 0x1a
+			i++
+			mapEntrySize := 1 + 1 + 1 + len(v) + sovMaps(uint64(len(v)))
+			i = encodeVarintMaps(data, i, uint64(mapEntrySize))
+			data[i] = 0x8
+			i++
+			if k {
+				data[i] = 1
+			} else {
+				data[i] = 0
+			}
+			i++
+			data[i] = 0x12
+			i++
+			i = encodeVarintMaps(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if len(m.names) > 0 {
+		keys := make([]int64, 0, len(m.names))
+		for k := range m.names {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.names[k]
+			data[i] = 0x22
+			i++
+			mapEntrySize := 1 + sozMaps(uint64(k)) + 1 + len(v) + sovMaps(uint64(len(v)))
+			i = encodeVarintMaps(data, i, uint64(mapEntrySize))
+			data[i] = 0x8
+			i++
+			i = encodeVarintMaps(data, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			data[i] = 0x12
+			i++
+			i = encodeVarintMaps(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if len(m.weights) > 0 {
+		keys := make([]uint32, 0, len(m.weights))
+		for k := range m.weights {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.weights[k]
+			data[i] = 0x2a
+			i++
+			mapEntrySize := 1 + sovMaps(uint64(k)) + 1 + 8
+			i = encodeVarintMaps(data, i, uint64(mapEntrySize))
+			data[i] = 0x8
+			i++
+			i = encodeVarintMaps(data, i, uint64(k))
+			data[i] = 0x11
+			i++
+			i = encodeFixed64Maps(data, i, uint64(math.Float64bits(float64(v))))
+		}
+	}
+	if len(m.colors) > 0 {
+		keys := make([]uint32, 0, len(m.colors))
+		for k := range m.colors {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.colors[k]
+			data[i] = 0x32
+			i++
+			mapEntrySize := 1 + 4 + 1 + sovMaps(uint64(v))
+			i = encodeVarintMaps(data, i, uint64(mapEntrySize))
+			data[i] = 0xd
+			i++
+			i = encodeFixed32Maps(data, i, uint32(k))
+			data[i] = 0x10
+			i++
+			i = encodeVarintMaps(data, i, uint64(v))
+		}
+	}
+	if m.xxx_IsAfterSet {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintMaps(data, i, uint64(len(m.after)))
+		i += copy(data[i:], m.after)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Maps_CountsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Maps_CountsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Maps_CountsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintMaps(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintMaps(data, i, uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Maps_SubsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Maps_SubsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Maps_SubsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintMaps(data, i, uint64(uint32(m.key)))
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintMaps(data, i, uint64(m.value.SizeCached()))
+		n1, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Maps_FlagsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Maps_FlagsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Maps_FlagsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0x8
+		i++
+		if m.key {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintMaps(data, i, uint64(len(m.value)))
+		i += copy(data[i:], m.value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Maps_NamesEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Maps_NamesEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Maps_NamesEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintMaps(data, i, uint64((uint64(m.key)<<1)^uint64((m.key>>63))))
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintMaps(data, i, uint64(len(m.value)))
+		i += copy(data[i:], m.value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Maps_WeightsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Maps_WeightsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Maps_WeightsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintMaps(data, i, uint64(m.key))
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x11
+		i++
+		i = encodeFixed64Maps(data, i, uint64(math.Float64bits(float64(m.value))))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Maps_ColorsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Maps_ColorsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Maps_ColorsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xd
+		i++
+		i = encodeFixed32Maps(data, i, uint32(m.key))
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintMaps(data, i, uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Maps(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Maps(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintMaps(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Sub) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field number", wireType)
+			}
+			m.xxx_IsNumberSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.number |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Maps) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Maps_CountsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.counts == nil {
+				m.counts = make(map[string]int64)
+			}
+			m.counts[entry.key] = entry.value
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field subs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Maps_SubsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.subs == nil {
+				m.subs = make(map[int32]*Sub)
+			}
+			if entry.value == nil {
+				entry.value = new(Sub)
+			}
+			m.subs[entry.key] = entry.value
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Maps_FlagsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.flags == nil {
+				m.flags = make(map[bool][]byte)
+			}
+			m.flags[entry.key] = entry.value
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field names", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Maps_NamesEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.names == nil {
+				m.names = make(map[int64]string)
+			}
+			m.names[entry.key] = entry.value
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Maps_WeightsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.weights == nil {
+				m.weights = make(map[uint32]float64)
+			}
+			m.weights[entry.key] = entry.value
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field colors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Maps_ColorsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.colors == nil {
+				m.colors = make(map[uint32]Color)
+			}
+			m.colors[entry.key] = entry.value
+			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field after", wireType)
+			}
+			m.xxx_IsAfterSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.after = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Maps_CountsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Maps_SubsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.key |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Maps_FlagsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.key = bool(bool(v != 0))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.value = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Maps_NamesEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.key = int64(int64(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.value = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Maps_WeightsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.key |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			m.value = float64(math.Float64frombits(v))
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Maps_ColorsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			m.key = uint32(data[i-4])
+			m.key |= uint32(data[i-3]) << 8
+			m.key |= uint32(data[i-2]) << 16
+			m.key |= uint32(data[i-1]) << 24
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (Color(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Sub) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Sub) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Maps) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Maps) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Maps_CountsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Maps_CountsEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Maps_SubsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Maps_SubsEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Maps_FlagsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Maps_FlagsEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Maps_NamesEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Maps_NamesEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Maps_WeightsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Maps_WeightsEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Maps_ColorsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Maps_ColorsEntry) CheckInitialized() error {
+	return nil
+}
+
+func (m *Sub) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNumberSet || w.EmitDefaults() {
+		w.Field("number", "number")
+		w.Int64(m.GetNumber())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Sub) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("number", "number"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetNumber(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Sub) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Sub) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Maps) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if len(m.counts) > 0 || w.EmitDefaults() {
+		w.Field("counts", "counts")
+		w.BeginObject()
+		keys := make([]string, 0, len(m.counts))
+		for k := range m.counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(k)
+			w.Int64(m.counts[k])
+		}
+		w.EndObject()
+	}
+	if len(m.subs) > 0 || w.EmitDefaults() {
+		w.Field("subs", "subs")
+		w.BeginObject()
+		keys := make([]int32, 0, len(m.subs))
+		for k := range m.subs {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.IntKey(int64(k))
+			w.Message(m.subs[k])
+		}
+		w.EndObject()
+	}
+	if len(m.flags) > 0 || w.EmitDefaults() {
+		w.Field("flags", "flags")
+		w.BeginObject()
+		keys := make([]bool, 0, len(m.flags))
+		for k := range m.flags {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return !keys[a] && keys[b] })
+		for _, k := range keys {
+			w.BoolKey(k)
+			w.Base64(m.flags[k])
+		}
+		w.EndObject()
+	}
+	if len(m.names) > 0 || w.EmitDefaults() {
+		w.Field("names", "names")
+		w.BeginObject()
+		keys := make([]int64, 0, len(m.names))
+		for k := range m.names {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.IntKey(int64(k))
+			w.String(m.names[k])
+		}
+		w.EndObject()
+	}
+	if len(m.weights) > 0 || w.EmitDefaults() {
+		w.Field("weights", "weights")
+		w.BeginObject()
+		keys := make([]uint32, 0, len(m.weights))
+		for k := range m.weights {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.UintKey(uint64(k))
+			w.Float64(m.weights[k])
+		}
+		w.EndObject()
+	}
+	if len(m.colors) > 0 || w.EmitDefaults() {
+		w.Field("colors", "colors")
+		w.BeginObject()
+		keys := make([]uint32, 0, len(m.colors))
+		for k := range m.colors {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.UintKey(uint64(k))
+			w.Enum(int32(m.colors[k]), Color_name)
+		}
+		w.EndObject()
+	}
+	if m.xxx_IsAfterSet || w.EmitDefaults() {
+		w.Field("after", "after")
+		w.String(m.GetAfter())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Maps) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("counts", "counts"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.PutCounts(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("subs", "subs"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			k, err := jsonpb.Int32(jsonpb.Key(key))
+			if err != nil {
+				return err
+			}
+			v := new(Sub)
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+			if err := m.PutSubs(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("flags", "flags"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			k, err := jsonpb.Bool(jsonpb.Key(key))
+			if err != nil {
+				return err
+			}
+			v, err := jsonpb.Base64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.PutFlags(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("names", "names"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			k, err := jsonpb.Int64(jsonpb.Key(key))
+			if err != nil {
+				return err
+			}
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.PutNames(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("weights", "weights"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			k, err := jsonpb.Uint32(jsonpb.Key(key))
+			if err != nil {
+				return err
+			}
+			v, err := jsonpb.Float64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.PutWeights(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("colors", "colors"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			k, err := jsonpb.Uint32(jsonpb.Key(key))
+			if err != nil {
+				return err
+			}
+			v, err := jsonpb.ClosedEnum(elem, Color_value, Color_name)
+			if err != nil {
+				return err
+			}
+			if err := m.PutColors(k, Color(v)); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("after", "after"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetAfter(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Maps) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Maps) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Maps_CountsEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Int64(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Maps_CountsEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Maps_CountsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Maps_CountsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Maps_SubsEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.Int32(m.GetKey())
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Maps_SubsEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Maps_SubsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Maps_SubsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Maps_FlagsEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.Bool(m.GetKey())
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Base64(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Maps_FlagsEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.Bool(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Maps_FlagsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Maps_FlagsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Maps_NamesEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.Int64(m.GetKey())
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.String(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Maps_NamesEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Maps_NamesEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Maps_NamesEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Maps_WeightsEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.Uint32(m.GetKey())
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Float64(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Maps_WeightsEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.Uint32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.Float64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Maps_WeightsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Maps_WeightsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Maps_ColorsEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.Uint32(m.GetKey())
+	}
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Enum(int32(m.GetValue()), Color_name)
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Maps_ColorsEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.Uint32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.ClosedEnum(raw, Color_value, Color_name)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(Color(v)); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Maps_ColorsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Maps_ColorsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Sub) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNumberSet {
+		w.Field("number")
+		w.Value(m.number)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Sub) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "number":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNumber(v)
+	}
+	return false, nil
+}
+
+func (m *Maps) MarshalTextFields(w *proto.TextWriter) {
+	if len(m.counts) > 0 {
+		keys := make([]string, 0, len(m.counts))
+		for k := range m.counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.counts[k]
+			w.Field("counts")
+			w.Message(&Maps_CountsEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if len(m.subs) > 0 {
+		keys := make([]int32, 0, len(m.subs))
+		for k := range m.subs {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.subs[k]
+			w.Field("subs")
+			w.Message(&Maps_SubsEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if len(m.flags) > 0 {
+		keys := make([]bool, 0, len(m.flags))
+		for k := range m.flags {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return !keys[a] && keys[b] })
+		for _, k := range keys {
+			v := m.flags[k]
+			w.Field("flags")
+			w.Message(&Maps_FlagsEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if len(m.names) > 0 {
+		keys := make([]int64, 0, len(m.names))
+		for k := range m.names {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.names[k]
+			w.Field("names")
+			w.Message(&Maps_NamesEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if len(m.weights) > 0 {
+		keys := make([]uint32, 0, len(m.weights))
+		for k := range m.weights {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.weights[k]
+			w.Field("weights")
+			w.Message(&Maps_WeightsEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if len(m.colors) > 0 {
+		keys := make([]uint32, 0, len(m.colors))
+		for k := range m.colors {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.colors[k]
+			w.Field("colors")
+			w.Message(&Maps_ColorsEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if m.xxx_IsAfterSet {
+		w.Field("after")
+		w.Value(m.after)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Maps) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "counts":
+		entry := &Maps_CountsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.counts == nil {
+			m.counts = make(map[string]int64)
+		}
+		m.counts[entry.key] = entry.value
+		return true, nil
+	case "subs":
+		entry := &Maps_SubsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.subs == nil {
+			m.subs = make(map[int32]*Sub)
+		}
+		if entry.value == nil {
+			entry.value = new(Sub)
+		}
+		m.subs[entry.key] = entry.value
+		return true, nil
+	case "flags":
+		entry := &Maps_FlagsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.flags == nil {
+			m.flags = make(map[bool][]byte)
+		}
+		m.flags[entry.key] = entry.value
+		return true, nil
+	case "names":
+		entry := &Maps_NamesEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.names == nil {
+			m.names = make(map[int64]string)
+		}
+		m.names[entry.key] = entry.value
+		return true, nil
+	case "weights":
+		entry := &Maps_WeightsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.weights == nil {
+			m.weights = make(map[uint32]float64)
+		}
+		m.weights[entry.key] = entry.value
+		return true, nil
+	case "colors":
+		entry := &Maps_ColorsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.colors == nil {
+			m.colors = make(map[uint32]Color)
+		}
+		m.colors[entry.key] = entry.value
+		return true, nil
+	case "after":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetAfter(v)
+	}
+	return false, nil
+}
+
+func (m *Maps_CountsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Maps_CountsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Maps_SubsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Maps_SubsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Maps_FlagsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Maps_FlagsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Maps_NamesEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Maps_NamesEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Maps_WeightsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Maps_WeightsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Maps_ColorsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Enum(int32(m.value), Color_name)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Maps_ColorsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := p.ReadEnum(Color_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(Color(v))
+	}
+	return false, nil
+}
+
+func (m *Sub) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Sub{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Sub) MergeFrom(src proto.Message) {
+	s, ok := src.(*Sub)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNumberSet {
+		m.SetNumber(s.number)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Sub) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Sub)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNumberSet != o.xxx_IsNumberSet {
+		return false
+	}
+	if m.xxx_IsNumberSet && m.number != o.number {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Maps) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Maps{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Maps) MergeFrom(src proto.Message) {
+	s, ok := src.(*Maps)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if len(s.counts) > 0 {
+		if m.counts == nil {
+			m.counts = make(map[string]int64, len(s.counts))
+		}
+		for k, v := range s.counts {
+			m.counts[k] = v
+		}
+	}
+	if len(s.subs) > 0 {
+		if m.subs == nil {
+			m.subs = make(map[int32]*Sub, len(s.subs))
+		}
+		for k, v := range s.subs {
+			m.subs[k] = v.Clone().(*Sub)
+		}
+	}
+	if len(s.flags) > 0 {
+		if m.flags == nil {
+			m.flags = make(map[bool][]byte, len(s.flags))
+		}
+		for k, v := range s.flags {
+			m.flags[k] = append([]byte{}, v...)
+		}
+	}
+	if len(s.names) > 0 {
+		if m.names == nil {
+			m.names = make(map[int64]string, len(s.names))
+		}
+		for k, v := range s.names {
+			m.names[k] = v
+		}
+	}
+	if len(s.weights) > 0 {
+		if m.weights == nil {
+			m.weights = make(map[uint32]float64, len(s.weights))
+		}
+		for k, v := range s.weights {
+			m.weights[k] = v
+		}
+	}
+	if len(s.colors) > 0 {
+		if m.colors == nil {
+			m.colors = make(map[uint32]Color, len(s.colors))
+		}
+		for k, v := range s.colors {
+			m.colors[k] = v
+		}
+	}
+	if s.xxx_IsAfterSet {
+		m.SetAfter(s.after)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Maps) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Maps)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if len(m.counts) != len(o.counts) {
+		return false
+	}
+	for k, v := range m.counts {
+		if v2, ok := o.counts[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	if len(m.subs) != len(o.subs) {
+		return false
+	}
+	for k, v := range m.subs {
+		if v2, ok := o.subs[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if len(m.flags) != len(o.flags) {
+		return false
+	}
+	for k, v := range m.flags {
+		if v2, ok := o.flags[k]; !ok || !bytes.Equal(v, v2) {
+			return false
+		}
+	}
+	if len(m.names) != len(o.names) {
+		return false
+	}
+	for k, v := range m.names {
+		if v2, ok := o.names[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	if len(m.weights) != len(o.weights) {
+		return false
+	}
+	for k, v := range m.weights {
+		if v2, ok := o.weights[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	if len(m.colors) != len(o.colors) {
+		return false
+	}
+	for k, v := range m.colors {
+		if v2, ok := o.colors[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	if m.xxx_IsAfterSet != o.xxx_IsAfterSet {
+		return false
+	}
+	if m.xxx_IsAfterSet && m.after != o.after {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Maps_CountsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Maps_CountsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Maps_CountsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Maps_CountsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Maps_CountsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Maps_CountsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Maps_SubsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Maps_SubsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Maps_SubsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Maps_SubsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Maps_SubsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Maps_SubsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Maps_FlagsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Maps_FlagsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Maps_FlagsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Maps_FlagsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(append([]byte{}, s.value...))
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Maps_FlagsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Maps_FlagsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !bytes.Equal(m.value, o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Maps_NamesEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Maps_NamesEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Maps_NamesEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Maps_NamesEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Maps_NamesEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Maps_NamesEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Maps_WeightsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Maps_WeightsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Maps_WeightsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Maps_WeightsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Maps_WeightsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Maps_WeightsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Maps_ColorsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Maps_ColorsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Maps_ColorsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Maps_ColorsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Maps_ColorsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Maps_ColorsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionSub = &proto.MessageInfo{
+	Name: "maps.Sub",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "number",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Sub)(nil).GetNumber(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Sub).GetNumber()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "number", v)
+				}
+				x := m.(*Sub)
+				return x.SetNumber(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Sub).HasNumber()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Sub).ClearNumber()
+			},
+		},
+	},
+}
+
+func (m *Sub) ProtoReflect() *proto.MessageInfo {
+	return reflectionSub
+}
+
+var reflectionMaps = &proto.MessageInfo{
+	Name: "maps.Maps",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "counts",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Maps)
+				v := make(map[string]int64, x.CountsLen())
+				x.RangeCounts(func(key string, value int64) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "counts", v)
+				}
+				x := m.(*Maps)
+				x.ClearCounts()
+				for k, e := range value {
+					if err := x.PutCounts(k, e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps).CountsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps).ClearCounts()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "subs",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Maps)
+				v := make(map[int32]*Sub, x.SubsLen())
+				x.RangeSubs(func(key int32, value *Sub) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[int32]*Sub)
+				if !ok {
+					return proto.NewFieldTypeError(m, "subs", v)
+				}
+				x := m.(*Maps)
+				x.ClearSubs()
+				for k, e := range value {
+					if err := x.PutSubs(k, e.Clone().(*Sub)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps).SubsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps).ClearSubs()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "flags",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Maps)
+				v := make(map[bool][]byte, x.FlagsLen())
+				x.RangeFlags(func(key bool, value []byte) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[bool][]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "flags", v)
+				}
+				x := m.(*Maps)
+				x.ClearFlags()
+				for k, e := range value {
+					if err := x.PutFlags(k, append([]byte{}, e...)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps).FlagsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps).ClearFlags()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "names",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Maps)
+				v := make(map[int64]string, x.NamesLen())
+				x.RangeNames(func(key int64, value string) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[int64]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "names", v)
+				}
+				x := m.(*Maps)
+				x.ClearNames()
+				for k, e := range value {
+					if err := x.PutNames(k, e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps).NamesLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps).ClearNames()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "weights",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Maps)
+				v := make(map[uint32]float64, x.WeightsLen())
+				x.RangeWeights(func(key uint32, value float64) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[uint32]float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "weights", v)
+				}
+				x := m.(*Maps)
+				x.ClearWeights()
+				for k, e := range value {
+					if err := x.PutWeights(k, e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps).WeightsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps).ClearWeights()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "colors",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Maps)
+				v := make(map[uint32]Color, x.ColorsLen())
+				x.RangeColors(func(key uint32, value Color) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[uint32]Color)
+				if !ok {
+					return proto.NewFieldTypeError(m, "colors", v)
+				}
+				x := m.(*Maps)
+				x.ClearColors()
+				for k, e := range value {
+					if err := x.PutColors(k, e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps).ColorsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps).ClearColors()
+			},
+		},
+		{
+			Number:  7,
+			Name:    "after",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps)(nil).GetAfter(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps).GetAfter()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "after", v)
+				}
+				x := m.(*Maps)
+				return x.SetAfter(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps).HasAfter()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps).ClearAfter()
+			},
+		},
+	},
+}
+
+func (m *Maps) ProtoReflect() *proto.MessageInfo {
+	return reflectionMaps
+}
+
+var reflectionMaps_CountsEntry = &proto.MessageInfo{
+	Name: "maps.Maps.CountsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_CountsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_CountsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Maps_CountsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_CountsEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_CountsEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_CountsEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_CountsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Maps_CountsEntry)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_CountsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_CountsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Maps_CountsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionMaps_CountsEntry
+}
+
+var reflectionMaps_SubsEntry = &proto.MessageInfo{
+	Name: "maps.Maps.SubsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_SubsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_SubsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Maps_SubsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_SubsEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_SubsEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Sub)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_SubsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Sub)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Maps_SubsEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_SubsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_SubsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Maps_SubsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionMaps_SubsEntry
+}
+
+var reflectionMaps_FlagsEntry = &proto.MessageInfo{
+	Name: "maps.Maps.FlagsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_FlagsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_FlagsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Maps_FlagsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_FlagsEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_FlagsEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_FlagsEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_FlagsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Maps_FlagsEntry)
+				return x.SetValue(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_FlagsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_FlagsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Maps_FlagsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionMaps_FlagsEntry
+}
+
+var reflectionMaps_NamesEntry = &proto.MessageInfo{
+	Name: "maps.Maps.NamesEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.Sint64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_NamesEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_NamesEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Maps_NamesEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_NamesEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_NamesEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_NamesEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_NamesEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Maps_NamesEntry)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_NamesEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_NamesEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Maps_NamesEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionMaps_NamesEntry
+}
+
+var reflectionMaps_WeightsEntry = &proto.MessageInfo{
+	Name: "maps.Maps.WeightsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.Uint32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_WeightsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_WeightsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Maps_WeightsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_WeightsEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_WeightsEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.DoubleKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_WeightsEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_WeightsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Maps_WeightsEntry)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_WeightsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_WeightsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Maps_WeightsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionMaps_WeightsEntry
+}
+
+var reflectionMaps_ColorsEntry = &proto.MessageInfo{
+	Name: "maps.Maps.ColorsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.Fixed32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_ColorsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_ColorsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Maps_ColorsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_ColorsEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_ColorsEntry).ClearKey()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.EnumKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Maps_ColorsEntry)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Maps_ColorsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Color)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Maps_ColorsEntry)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Maps_ColorsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Maps_ColorsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Maps_ColorsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionMaps_ColorsEntry
+}
+
+func init() {
+	proto.RegisterEnum("maps.Color", Color_name, Color_value)
+	proto.RegisterType((*Sub)(nil), "maps.Sub")
+	proto.RegisterType((*Maps)(nil), "maps.Maps")
+}
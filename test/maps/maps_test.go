@@ -0,0 +1,182 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package maps
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newMaps() *Maps {
+	m := &Maps{}
+	m.PutCounts("a", 1)
+	m.PutCounts("b", -2)
+	sub := &Sub{}
+	sub.SetNumber(3)
+	m.PutSubs(-4, sub)
+	m.PutSubs(5, &Sub{})
+	m.PutFlags(true, []byte("yes"))
+	m.PutFlags(false, []byte{})
+	m.PutNames(-6, "minus six")
+	m.PutWeights(7, 0.5)
+	m.PutColors(8, Color_BLUE)
+	m.SetAfter("after")
+	return m
+}
+
+func TestMapAccessors(t *testing.T) {
+	m := newMaps()
+	if v, ok := m.GetCounts("b"); !ok || v != -2 {
+		t.Fatalf("expected -2, got %v %v", v, ok)
+	}
+	if _, ok := m.GetCounts("c"); ok {
+		t.Fatalf("unexpected key c")
+	}
+	if m.CountsLen() != 2 {
+		t.Fatalf("expected 2 counts, got %d", m.CountsLen())
+	}
+	m.DeleteCounts("a")
+	if _, ok := m.GetCounts("a"); ok || m.CountsLen() != 1 {
+		t.Fatalf("a should have been deleted")
+	}
+	total := 0
+	m.RangeSubs(func(key int32, value *Sub) bool {
+		total++
+		return false
+	})
+	if total != 1 {
+		t.Fatalf("range should stop when f returns false")
+	}
+	if err := m.PutSubs(1, nil); err == nil {
+		t.Fatalf("expected an error when putting a nil message")
+	}
+	m.ClearCounts()
+	if m.CountsLen() != 0 {
+		t.Fatalf("expected no counts after clearing")
+	}
+	m.Clear()
+	if m.SubsLen() != 0 || m.FlagsLen() != 0 || m.HasAfter() {
+		t.Fatalf("Clear should clear all the maps")
+	}
+	var nilMaps *Maps
+	if _, ok := nilMaps.GetCounts("a"); ok || nilMaps.CountsLen() != 0 {
+		t.Fatalf("a nil message should have empty maps")
+	}
+}
+
+func TestMapRoundTrip(t *testing.T) {
+	m := newMaps()
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != m.Size() {
+		t.Fatalf("size %d does not match marshaled length %d", m.Size(), len(data))
+	}
+	out := &Maps{}
+	if err := proto.Unmarshal(data, out); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := out.GetCounts("b"); v != -2 {
+		t.Fatalf("expected -2, got %v", v)
+	}
+	if v, _ := out.GetSubs(-4); v.GetNumber() != 3 {
+		t.Fatalf("expected 3, got %v", v)
+	}
+	if v, ok := out.GetSubs(5); !ok || v == nil {
+		t.Fatalf("an empty message value should be decoded as an empty message")
+	}
+	if v, _ := out.GetFlags(true); !bytes.Equal(v, []byte("yes")) {
+		t.Fatalf("expected yes, got %q", v)
+	}
+	if v, _ := out.GetNames(-6); v != "minus six" {
+		t.Fatalf("expected minus six, got %q", v)
+	}
+	if v, _ := out.GetWeights(7); v != 0.5 {
+		t.Fatalf("expected 0.5, got %v", v)
+	}
+	if v, _ := out.GetColors(8); v != Color_BLUE {
+		t.Fatalf("expected BLUE, got %v", v)
+	}
+	if out.GetAfter() != "after" {
+		t.Fatalf("expected after, got %q", out.GetAfter())
+	}
+}
+
+func TestMapDeterministic(t *testing.T) {
+	m1 := &Maps{}
+	m2 := &Maps{}
+	for i := 0; i < 100; i++ {
+		m1.PutNames(int64(i), "x")
+		m2.PutNames(int64(99-i), "x")
+	}
+	data1, err := proto.Marshal(m1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		data2, err := proto.Marshal(m2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data1, data2) {
+			t.Fatalf("equal maps should have the same encoding")
+		}
+	}
+}
+
+func TestMapUnmarshalMerge(t *testing.T) {
+	m1 := &Maps{}
+	m1.PutCounts("a", 1)
+	m1.PutCounts("b", 2)
+	m2 := &Maps{}
+	m2.PutCounts("b", 3)
+	m2.PutCounts("c", 4)
+	data1, err := proto.Marshal(m1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data2, err := proto.Marshal(m2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := &Maps{}
+	if err := proto.Unmarshal(append(data1, data2...), out); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int64{"a": 1, "b": 3, "c": 4}
+	if out.CountsLen() != len(expected) {
+		t.Fatalf("expected %d counts, got %d", len(expected), out.CountsLen())
+	}
+	for k, v := range expected {
+		if got, _ := out.GetCounts(k); got != v {
+			t.Fatalf("expected %v for %v, got %v", v, k, got)
+		}
+	}
+}
@@ -75,7 +75,7 @@ func (this *MixMatch) Bench(rgx string, outFileName string) {
 		panic(err)
 	}
 	this.Regenerate()
-	var test = exec.Command("go", "test", "-test.timeout=20m", "-test.v", "-test.run=XXX", "-test.bench="+rgx, "./testdata/")
+	var test = exec.Command("go", "test", "-test.timeout=20m", "-test.v", "-test.run=XXX", "-test.bench="+rgx, "-test.benchmem", "./testdata/")
 	fmt.Printf("benching\n")
 	out, err := test.CombinedOutput()
 	fmt.Printf("bench output: %v\n", string(out))
@@ -90,7 +90,7 @@ func (this *MixMatch) Bench(rgx string, outFileName string) {
 	}
 }
 
-func NewMixMatch(marshaler, unmarshaler, unsafe_marshaler, unsafe_unmarshaler bool) *MixMatch {
+func NewMixMatch(marshaler, unmarshaler, unsafe_marshaler, unsafe_unmarshaler, pool bool) *MixMatch {
 	mm := &MixMatch{}
 	if marshaler {
 		mm.Old = append(mm.Old, "option (gogoproto.marshaler_all) = false;")
@@ -120,19 +120,25 @@ func NewMixMatch(marshaler, unmarshaler, unsafe_marshaler, unsafe_unmarshaler bo
 		mm.Old = append(mm.Old, "option (gogoproto.unsafe_unmarshaler_all) = true;")
 		mm.New = append(mm.New, "option (gogoproto.unsafe_unmarshaler_all) = false;")
 	}
+	if pool {
+		mm.Old = append(mm.Old, "option (gogoproto.benchgen_all) = true;")
+		mm.New = append(mm.New, "option (gogoproto.benchgen_all) = true;\noption (gogoproto.pool_all) = true;")
+	}
 	return mm
 }
 
 func main() {
-	NewMixMatch(true, true, false, false).Bench("ProtoMarshal", "marshaler.txt")
-	NewMixMatch(false, false, false, false).Bench("ProtoMarshal", "marshal.txt")
-	NewMixMatch(false, false, true, true).Bench("ProtoMarshal", "unsafe_marshaler.txt")
-	NewMixMatch(true, true, false, false).Bench("ProtoUnmarshal", "unmarshaler.txt")
-	NewMixMatch(false, false, false, false).Bench("ProtoUnmarshal", "unmarshal.txt")
-	NewMixMatch(false, false, true, true).Bench("ProtoUnmarshal", "unsafe_unmarshaler.txt")
+	NewMixMatch(true, true, false, false, false).Bench("ProtoMarshal", "marshaler.txt")
+	NewMixMatch(false, false, false, false, false).Bench("ProtoMarshal", "marshal.txt")
+	NewMixMatch(false, false, true, true, false).Bench("ProtoMarshal", "unsafe_marshaler.txt")
+	NewMixMatch(true, true, false, false, false).Bench("ProtoUnmarshal", "unmarshaler.txt")
+	NewMixMatch(false, false, false, false, false).Bench("ProtoUnmarshal", "unmarshal.txt")
+	NewMixMatch(false, false, true, true, false).Bench("ProtoUnmarshal", "unsafe_unmarshaler.txt")
+	NewMixMatch(true, true, false, false, true).Bench("ProtoUnmarshal|PoolUnmarshal", "pool_unmarshaler.txt")
 	fmt.Println("Running benchcmp will show the performance difference between using reflect and generated code for marshalling and unmarshalling of protocol buffers")
 	fmt.Println("$GOROOT/misc/benchcmp marshal.txt marshaler.txt")
 	fmt.Println("$GOROOT/misc/benchcmp unmarshal.txt unmarshaler.txt")
 	fmt.Println("$GOROOT/misc/benchcmp marshal.txt unsafe_marshaler.txt")
 	fmt.Println("$GOROOT/misc/benchcmp unmarshal.txt unsafe_unmarshaler.txt")
+	fmt.Println("The allocs/op of the ProtoUnmarshal and PoolUnmarshal benchmarks in pool_unmarshaler.txt show the allocations saved by reusing pooled messages")
 }
@@ -0,0 +1,2270 @@
+// Code generated by protoc-gen-dgo.
+// source: nullable.proto
+// DO NOT EDIT!
+
+/*
+Package nullable is a generated protocol buffer package.
+
+It is generated from these files:
+
+	nullable.proto
+
+It has these top-level messages:
+
+	Inner
+	Outer
+	Choice
+*/
+package nullable
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+import bytes1 "bytes"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Inner struct {
+	xxx_sizeCached   int
+	id               int64
+	name             string
+	XXX_unrecognized []byte
+	xxx_IsIdSet      bool
+	xxx_IsNameSet    bool
+}
+
+func (m *Inner) Reset()         { *m = Inner{} }
+func (m *Inner) String() string { return proto.CompactTextString(m) }
+func (*Inner) ProtoMessage()    {}
+
+func (m *Inner) GetId() int64 {
+	if m != nil && m.xxx_IsIdSet {
+		return m.id
+	}
+	return 0
+}
+
+func (m *Inner) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Inner) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Inner) SetId(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIdSet = true
+	m.id = value
+	return nil
+}
+
+func (m *Inner) HasId() (isSet bool) {
+	if m != nil && m.xxx_IsIdSet {
+		return true
+	}
+	return false
+}
+
+func (m *Inner) ClearId() {
+	if m != nil {
+		m.xxx_IsIdSet = false
+	}
+}
+
+func (m *Inner) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Inner) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Inner) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Inner) Clear() {
+	if m != nil {
+		m.ClearId()
+		m.ClearName()
+	}
+}
+
+type Outer struct {
+	xxx_sizeCached   int
+	value            Inner
+	pointer          *Inner
+	values           []Inner
+	pointers         []*Inner
+	number           int64
+	XXX_unrecognized []byte
+	xxx_IsValueSet   bool
+	xxx_IsPointerSet bool
+	xxx_LenValues    int
+	xxx_LenPointers  int
+	xxx_IsNumberSet  bool
+}
+
+func (m *Outer) Reset()         { *m = Outer{} }
+func (m *Outer) String() string { return proto.CompactTextString(m) }
+func (*Outer) ProtoMessage()    {}
+
+func (m *Outer) GetValue() *Inner {
+	if m != nil && m.xxx_IsValueSet {
+		return &m.value
+	}
+	return nil
+}
+func (m *Outer) GetPointer() *Inner {
+	if m != nil && m.xxx_IsPointerSet {
+		return m.pointer
+	}
+	return nil
+}
+func (m *Outer) GetNumber() int64 {
+	if m != nil && m.xxx_IsNumberSet {
+		return m.number
+	}
+	return 0
+}
+
+func (m *Outer) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Outer) MutateValue() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		m.value.Clear()
+	}
+	return &m.value, nil
+}
+
+func (m *Outer) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Outer) MutatePointer() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsPointerSet {
+		m.xxx_IsPointerSet = true
+		if m.pointer == nil {
+			m.pointer = new(Inner)
+		} else {
+			m.pointer.Clear()
+		}
+	}
+	return m.pointer, nil
+}
+
+func (m *Outer) HasPointer() (isSet bool) {
+	if m != nil && m.xxx_IsPointerSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearPointer() {
+	if m != nil {
+		m.pointer.Clear()
+		m.xxx_IsPointerSet = false
+
+	}
+}
+
+func (m *Outer) AddValues() (field *Inner, err error) {
+	if m != nil {
+		if len(m.values) <= m.xxx_LenValues {
+			newCapacity := 0
+			if len(m.values) == 0 {
+				newCapacity = 8
+			} else if len(m.values) < 1000000 {
+				newCapacity = m.xxx_LenValues * 2
+			} else {
+				newCapacity = m.xxx_LenValues + 1000000
+			}
+			t := make([]Inner, newCapacity, newCapacity)
+			copy(t, m.values)
+			m.values = t
+		}
+		field = &m.values[m.xxx_LenValues]
+		field.Clear()
+		m.xxx_LenValues += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Outer) MutateValues(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenValues {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return &m.values[index], nil
+}
+
+func (m *Outer) ValuesSize() (size int) {
+	if m != nil {
+		return m.xxx_LenValues
+	}
+	return 0
+}
+
+func (m *Outer) ClearValues() {
+	if m != nil {
+		for i := 0; i < m.ValuesSize(); i++ {
+			m.values[i].Clear()
+		}
+		m.xxx_LenValues = 0
+
+	}
+}
+
+func (m *Outer) GetValues(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenValues {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return &m.values[index], nil
+}
+
+func (m *Outer) AddPointers() (field *Inner, err error) {
+	if m != nil {
+		if len(m.pointers) <= m.xxx_LenPointers {
+			newCapacity := 0
+			if len(m.pointers) == 0 {
+				newCapacity = 8
+			} else if len(m.pointers) < 1000000 {
+				newCapacity = m.xxx_LenPointers * 2
+			} else {
+				newCapacity = m.xxx_LenPointers + 1000000
+			}
+			t := make([]*Inner, newCapacity, newCapacity)
+			copy(t, m.pointers)
+			m.pointers = t
+		}
+		field = m.pointers[m.xxx_LenPointers]
+		if field == nil {
+			field = new(Inner)
+			m.pointers[m.xxx_LenPointers] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenPointers += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Outer) MutatePointers(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPointers {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.pointers[index] == nil {
+		m.pointers[index] = new(Inner)
+	}
+	return m.pointers[index], nil
+}
+
+func (m *Outer) PointersSize() (size int) {
+	if m != nil {
+		return m.xxx_LenPointers
+	}
+	return 0
+}
+
+func (m *Outer) ClearPointers() {
+	if m != nil {
+		for i := 0; i < m.PointersSize(); i++ {
+			m.pointers[i].Clear()
+		}
+		m.xxx_LenPointers = 0
+
+	}
+}
+
+func (m *Outer) GetPointers(index int) (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPointers {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.pointers[index], nil
+}
+
+func (m *Outer) SetNumber(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNumberSet = true
+	m.number = value
+	return nil
+}
+
+func (m *Outer) HasNumber() (isSet bool) {
+	if m != nil && m.xxx_IsNumberSet {
+		return true
+	}
+	return false
+}
+
+func (m *Outer) ClearNumber() {
+	if m != nil {
+		m.xxx_IsNumberSet = false
+	}
+}
+
+func (m *Outer) Clear() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+		m.pointer.Clear()
+		m.xxx_IsPointerSet = false
+
+		for i := 0; i < m.ValuesSize(); i++ {
+			m.values[i].Clear()
+		}
+		m.xxx_LenValues = 0
+
+		for i := 0; i < m.PointersSize(); i++ {
+			m.pointers[i].Clear()
+		}
+		m.xxx_LenPointers = 0
+
+		m.ClearNumber()
+	}
+}
+
+type Choice struct {
+	xxx_sizeCached   int
+	chosen           Inner
+	other            string
+	XXX_unrecognized []byte
+	xxx_IsChosenSet  bool
+	xxx_IsOtherSet   bool
+	xxx_ChoiceCase   Choice_ChoiceCase
+}
+
+func (m *Choice) Reset()         { *m = Choice{} }
+func (m *Choice) String() string { return proto.CompactTextString(m) }
+func (*Choice) ProtoMessage()    {}
+
+func (m *Choice) GetChosen() *Inner {
+	if m != nil && m.xxx_IsChosenSet {
+		return &m.chosen
+	}
+	return nil
+}
+func (m *Choice) GetOther() string {
+	if m != nil && m.xxx_IsOtherSet {
+		return m.other
+	}
+	return ""
+}
+
+func (m *Choice) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Choice_ChoiceCase int32
+
+const (
+	Choice_ChoiceCase_NotSet Choice_ChoiceCase = 0
+	Choice_ChoiceCase_Chosen Choice_ChoiceCase = 1
+	Choice_ChoiceCase_Other  Choice_ChoiceCase = 2
+)
+
+func (m *Choice) WhichChoice() Choice_ChoiceCase {
+	if m != nil {
+		return m.xxx_ChoiceCase
+	}
+	return Choice_ChoiceCase_NotSet
+}
+
+func (m *Choice) ClearChoice() {
+	if m != nil {
+		switch m.xxx_ChoiceCase {
+		case Choice_ChoiceCase_Chosen:
+			m.ClearChosen()
+		case Choice_ChoiceCase_Other:
+			m.ClearOther()
+		}
+		m.xxx_ChoiceCase = Choice_ChoiceCase_NotSet
+	}
+}
+
+func (m *Choice) MutateChosen() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if m.xxx_ChoiceCase != Choice_ChoiceCase_Chosen {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Choice_ChoiceCase_Chosen
+	}
+	if !m.xxx_IsChosenSet {
+		m.xxx_IsChosenSet = true
+		m.chosen.Clear()
+	}
+	return &m.chosen, nil
+}
+
+func (m *Choice) HasChosen() (isSet bool) {
+	if m != nil && m.xxx_IsChosenSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearChosen() {
+	if m != nil {
+		m.chosen.Clear()
+		m.xxx_IsChosenSet = false
+
+		if m.xxx_ChoiceCase == Choice_ChoiceCase_Chosen {
+			m.xxx_ChoiceCase = Choice_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Choice) SetOther(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ChoiceCase != Choice_ChoiceCase_Other {
+		m.ClearChoice()
+		m.xxx_ChoiceCase = Choice_ChoiceCase_Other
+	}
+	m.xxx_IsOtherSet = true
+	m.other = value
+	return nil
+}
+
+func (m *Choice) HasOther() (isSet bool) {
+	if m != nil && m.xxx_IsOtherSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearOther() {
+	if m != nil {
+		m.xxx_IsOtherSet = false
+		m.other = ""
+		if m.xxx_ChoiceCase == Choice_ChoiceCase_Other {
+			m.xxx_ChoiceCase = Choice_ChoiceCase_NotSet
+		}
+	}
+}
+
+func (m *Choice) Clear() {
+	if m != nil {
+		m.chosen.Clear()
+		m.xxx_IsChosenSet = false
+
+		m.ClearOther()
+		m.xxx_ChoiceCase = Choice_ChoiceCase_NotSet
+	}
+}
+
+func (m *Inner) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		n += 1 + sovNullable(uint64(m.id))
+	}
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovNullable(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Outer) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovNullable(uint64(l))
+	}
+	if m.xxx_IsPointerSet {
+		l = m.pointer.Size()
+		n += 1 + l + sovNullable(uint64(l))
+	}
+	if m.xxx_LenValues > 0 {
+		for i := 0; i < m.xxx_LenValues; i++ {
+			e := &m.values[i]
+			l = e.Size()
+			n += 1 + l + sovNullable(uint64(l))
+		}
+	}
+	if m.xxx_LenPointers > 0 {
+		for i := 0; i < m.xxx_LenPointers; i++ {
+			e := m.pointers[i]
+			l = e.Size()
+			n += 1 + l + sovNullable(uint64(l))
+		}
+	}
+	if m.xxx_IsNumberSet {
+		n += 1 + sovNullable(uint64(m.number))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Choice) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Chosen {
+		l = m.chosen.Size()
+		n += 1 + l + sovNullable(uint64(l))
+	}
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Other {
+		l = len(m.other)
+		n += 1 + l + sovNullable(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovNullable(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozNullable(x uint64) (n int) {
+	return sovNullable(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Inner) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Inner) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Inner) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintNullable(data, i, uint64(m.id))
+	}
+	if m.xxx_IsNameSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintNullable(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Outer) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Outer) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Outer) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsValueSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintNullable(data, i, uint64(m.value.SizeCached()))
+		n1, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_IsPointerSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintNullable(data, i, uint64(m.pointer.SizeCached()))
+		n2, err := m.pointer.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.xxx_LenValues > 0 {
+		for idx := 0; idx < m.xxx_LenValues; idx++ {
+			msg := &m.values[idx]
+			data[i] = 0x1a
+			i++
+			i = encodeVarintNullable(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.xxx_LenPointers > 0 {
+		for idx := 0; idx < m.xxx_LenPointers; idx++ {
+			msg := m.pointers[idx]
+			data[i] = 0x22
+			i++
+			i = encodeVarintNullable(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.xxx_IsNumberSet {
+		data[i] = 0x28
+		i++
+		i = encodeVarintNullable(data, i, uint64(m.number))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Choice) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Choice) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Choice) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Chosen {
+		data[i] = 0xa
+		i++
+		i = encodeVarintNullable(data, i, uint64(m.chosen.SizeCached()))
+		n3, err := m.chosen.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Other {
+		data[i] = 0x12
+		i++
+		i = encodeVarintNullable(data, i, uint64(len(m.other)))
+		i += copy(data[i:], m.other)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Nullable(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Nullable(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintNullable(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Inner) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field id", wireType)
+			}
+			m.xxx_IsIdSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Outer) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field pointer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutatePointer()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddValues()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field pointers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddPointers()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field number", wireType)
+			}
+			m.xxx_IsNumberSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.number |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Choice) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field chosen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateChosen()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field other", wireType)
+			}
+			if m.xxx_ChoiceCase != Choice_ChoiceCase_Other {
+				m.ClearChoice()
+				m.xxx_ChoiceCase = Choice_ChoiceCase_Other
+			}
+			m.xxx_IsOtherSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.other = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Inner) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Inner) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Outer) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Outer) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Choice) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Choice) CheckInitialized() error {
+	return nil
+}
+
+func (m *Inner) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsIdSet || w.EmitDefaults() {
+		w.Field("id", "id")
+		w.Int64(m.GetId())
+	}
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Inner) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("id", "id"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetId(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Inner) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Inner) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Outer) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	if m.xxx_IsPointerSet {
+		w.Field("pointer", "pointer")
+		w.Message(m.GetPointer())
+	} else if w.EmitDefaults() {
+		w.Field("pointer", "pointer")
+		w.Null()
+	}
+	if m.xxx_LenValues > 0 || w.EmitDefaults() {
+		w.Field("values", "values")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenValues; i++ {
+			w.Message(&m.values[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenPointers > 0 || w.EmitDefaults() {
+		w.Field("pointers", "pointers")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenPointers; i++ {
+			w.Message(m.pointers[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_IsNumberSet || w.EmitDefaults() {
+		w.Field("number", "number")
+		w.Int64(m.GetNumber())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Outer) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("pointer", "pointer"); ok {
+		v, err := m.MutatePointer()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("values", "values"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddValues()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("pointers", "pointers"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddPointers()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("number", "number"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetNumber(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Outer) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Outer) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Choice) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Chosen {
+		w.Field("chosen", "chosen")
+		w.Message(m.GetChosen())
+	}
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Other {
+		w.Field("other", "other")
+		w.String(m.GetOther())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Choice) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("chosen", "chosen"); ok {
+		v, err := m.MutateChosen()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("other", "other"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetOther(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Choice) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Choice) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Inner) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsIdSet {
+		w.Field("id")
+		w.Value(m.id)
+	}
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Inner) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "id":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetId(v)
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	}
+	return false, nil
+}
+
+func (m *Outer) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(&m.value)
+	}
+	if m.xxx_IsPointerSet {
+		w.Field("pointer")
+		w.Message(m.pointer)
+	}
+	for i := 0; i < m.xxx_LenValues; i++ {
+		w.Field("values")
+		w.Message(&m.values[i])
+	}
+	for i := 0; i < m.xxx_LenPointers; i++ {
+		w.Field("pointers")
+		w.Message(m.pointers[i])
+	}
+	if m.xxx_IsNumberSet {
+		w.Field("number")
+		w.Value(m.number)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Outer) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "pointer":
+		v, err := m.MutatePointer()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "values":
+		v, err := m.AddValues()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "pointers":
+		v, err := m.AddPointers()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "number":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNumber(v)
+	}
+	return false, nil
+}
+
+func (m *Choice) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Chosen {
+		w.Field("chosen")
+		w.Message(&m.chosen)
+	}
+	if m.xxx_ChoiceCase == Choice_ChoiceCase_Other {
+		w.Field("other")
+		w.Value(m.other)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Choice) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "chosen":
+		v, err := m.MutateChosen()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "other":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetOther(v)
+	}
+	return false, nil
+}
+
+func (m *Inner) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Inner{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Inner) MergeFrom(src proto.Message) {
+	s, ok := src.(*Inner)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsIdSet {
+		m.SetId(s.id)
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Inner) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Inner)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsIdSet != o.xxx_IsIdSet {
+		return false
+	}
+	if m.xxx_IsIdSet && m.id != o.id {
+		return false
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Outer) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Outer{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Outer) MergeFrom(src proto.Message) {
+	s, ok := src.(*Outer)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(&s.value)
+	}
+	if s.xxx_IsPointerSet {
+		v, _ := m.MutatePointer()
+		v.MergeFrom(s.pointer)
+	}
+	for i := 0; i < s.xxx_LenValues; i++ {
+		v, _ := m.AddValues()
+		v.MergeFrom(&s.values[i])
+	}
+	for i := 0; i < s.xxx_LenPointers; i++ {
+		v, _ := m.AddPointers()
+		v.MergeFrom(s.pointers[i])
+	}
+	if s.xxx_IsNumberSet {
+		m.SetNumber(s.number)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Outer) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Outer)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(&o.value) {
+		return false
+	}
+	if m.xxx_IsPointerSet != o.xxx_IsPointerSet {
+		return false
+	}
+	if m.xxx_IsPointerSet && !m.pointer.Equal(o.pointer) {
+		return false
+	}
+	if m.xxx_LenValues != o.xxx_LenValues {
+		return false
+	}
+	for i := 0; i < m.xxx_LenValues; i++ {
+		if !m.values[i].Equal(&o.values[i]) {
+			return false
+		}
+	}
+	if m.xxx_LenPointers != o.xxx_LenPointers {
+		return false
+	}
+	for i := 0; i < m.xxx_LenPointers; i++ {
+		if !m.pointers[i].Equal(o.pointers[i]) {
+			return false
+		}
+	}
+	if m.xxx_IsNumberSet != o.xxx_IsNumberSet {
+		return false
+	}
+	if m.xxx_IsNumberSet && m.number != o.number {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Choice) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Choice{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Choice) MergeFrom(src proto.Message) {
+	s, ok := src.(*Choice)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_ChoiceCase == Choice_ChoiceCase_Chosen {
+		v, _ := m.MutateChosen()
+		v.MergeFrom(&s.chosen)
+	}
+	if s.xxx_ChoiceCase == Choice_ChoiceCase_Other {
+		m.SetOther(s.other)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Choice) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Choice)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.xxx_ChoiceCase == Choice_ChoiceCase_Chosen) != (o.xxx_ChoiceCase == Choice_ChoiceCase_Chosen) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Choice_ChoiceCase_Chosen) && !m.chosen.Equal(&o.chosen) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Choice_ChoiceCase_Other) != (o.xxx_ChoiceCase == Choice_ChoiceCase_Other) {
+		return false
+	}
+	if (m.xxx_ChoiceCase == Choice_ChoiceCase_Other) && m.other != o.other {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionInner = &proto.MessageInfo{
+	Name: "nullable.Inner",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "id",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetId(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetId()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "id", v)
+				}
+				x := m.(*Inner)
+				return x.SetId(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Inner).HasId()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearId()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Inner)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Inner).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearName()
+			},
+		},
+	},
+}
+
+func (m *Inner) ProtoReflect() *proto.MessageInfo {
+	return reflectionInner
+}
+
+var reflectionOuter = &proto.MessageInfo{
+	Name: "nullable.Outer",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Outer)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearValue()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "pointer",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetPointer()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "pointer", v)
+				}
+				x := m.(*Outer)
+				x.ClearPointer()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutatePointer()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasPointer()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearPointer()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "values",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([]*Inner, x.xxx_LenValues)
+				for i := range v {
+					v[i] = &x.values[i]
+				}
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "values", v)
+				}
+				x := m.(*Outer)
+				x.ClearValues()
+				for _, e := range value {
+					field, err := x.AddValues()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).ValuesSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearValues()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "pointers",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Outer)
+				v := make([]*Inner, x.xxx_LenPointers)
+				copy(v, x.pointers)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "pointers", v)
+				}
+				x := m.(*Outer)
+				x.ClearPointers()
+				for _, e := range value {
+					field, err := x.AddPointers()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).PointersSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearPointers()
+			},
+		},
+		{
+			Number:  5,
+			Name:    "number",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Outer)(nil).GetNumber(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Outer).GetNumber()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "number", v)
+				}
+				x := m.(*Outer)
+				return x.SetNumber(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Outer).HasNumber()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Outer).ClearNumber()
+			},
+		},
+	},
+}
+
+func (m *Outer) ProtoReflect() *proto.MessageInfo {
+	return reflectionOuter
+}
+
+var reflectionChoice = &proto.MessageInfo{
+	Name: "nullable.Choice",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "chosen",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			Oneof:  "choice",
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetChosen()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "chosen", v)
+				}
+				x := m.(*Choice)
+				x.ClearChosen()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateChosen()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasChosen()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearChosen()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "other",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "choice",
+			Default: (*Choice)(nil).GetOther(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetOther()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "other", v)
+				}
+				x := m.(*Choice)
+				return x.SetOther(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasOther()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearOther()
+			},
+		},
+	},
+}
+
+func (m *Choice) ProtoReflect() *proto.MessageInfo {
+	return reflectionChoice
+}
+
+func init() {
+	proto.RegisterType((*Inner)(nil), "nullable.Inner")
+	proto.RegisterType((*Outer)(nil), "nullable.Outer")
+	proto.RegisterType((*Choice)(nil), "nullable.Choice")
+}
+func NewPopulatedInner(r randyNullable, easy bool) *Inner {
+	this := &Inner{}
+	this.xxx_IsIdSet = true
+	this.id = (r.Int63())
+	if r.Intn(2) == 0 {
+		this.id *= (-1)
+	}
+	this.xxx_IsNameSet = true
+	this.name = (randStringNullable(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedNullable(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedOuter(r randyNullable, easy bool) *Outer {
+	this := &Outer{}
+	v1 := NewPopulatedInner(r, easy)
+	this.xxx_IsValueSet = true
+	this.value = *v1
+	v2 := NewPopulatedInner(r, easy)
+	this.xxx_IsPointerSet = true
+	this.pointer = v2
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(10)
+		this.values = make([]Inner, v3)
+		for i := 0; i < v3; i++ {
+			v4 := NewPopulatedInner(r, easy)
+			this.xxx_LenValues += 1
+			this.values[i] = *v4
+		}
+	}
+	if r.Intn(10) != 0 {
+		v5 := r.Intn(10)
+		this.pointers = make([]*Inner, v5)
+		for i := 0; i < v5; i++ {
+			v6 := NewPopulatedInner(r, easy)
+			this.xxx_LenPointers += 1
+			this.pointers[i] = v6
+		}
+	}
+	this.xxx_IsNumberSet = true
+	this.number = (r.Int63())
+	if r.Intn(2) == 0 {
+		this.number *= (-1)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedNullable(r, 6)
+	}
+	return this
+}
+
+type randyNullable interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneNullable(r randyNullable) rune {
+	res := rune(r.Uint32() % 1112064)
+	if 55296 <= res {
+		res += 2047
+	}
+	return res
+}
+func randStringNullable(r randyNullable) string {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
+		tmps[i] = randUTF8RuneNullable(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedNullable(r randyNullable, maxFieldNumber int) (data []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		data = randFieldNullable(data, r, fieldNumber, wire)
+	}
+	return data
+}
+func randFieldNullable(data []byte, r randyNullable, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		data = encodeVarintPopulateNullable(data, uint64(key))
+		v8 := r.Int63()
+		if r.Intn(2) == 0 {
+			v8 *= -1
+		}
+		data = encodeVarintPopulateNullable(data, uint64(v8))
+	case 1:
+		data = encodeVarintPopulateNullable(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		data = encodeVarintPopulateNullable(data, uint64(key))
+		ll := r.Intn(100)
+		data = encodeVarintPopulateNullable(data, uint64(ll))
+		for j := 0; j < ll; j++ {
+			data = append(data, byte(r.Intn(256)))
+		}
+	default:
+		data = encodeVarintPopulateNullable(data, uint64(key))
+		data = append(data, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return data
+}
+func encodeVarintPopulateNullable(data []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		data = append(data, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	data = append(data, uint8(v))
+	return data
+}
+func (this *Inner) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Inner)
+	if !ok {
+		return fmt.Errorf("that is not of type *Inner")
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Inner but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Innerbut is not nil && this == nil")
+	}
+	if this.xxx_IsIdSet != that1.xxx_IsIdSet {
+		return fmt.Errorf("that.id is not equal to this.id")
+	}
+	if this.xxx_IsIdSet && this.id != that1.id {
+		return fmt.Errorf("id this(%v) Not Equal that(%v)", this.id, that1.id)
+	}
+	if this.xxx_IsNameSet != that1.xxx_IsNameSet {
+		return fmt.Errorf("that.name is not equal to this.name")
+	}
+	if this.xxx_IsNameSet && this.name != that1.name {
+		return fmt.Errorf("name this(%v) Not Equal that(%v)", this.name, that1.name)
+	}
+	if !bytes1.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Outer) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Outer)
+	if !ok {
+		return fmt.Errorf("that is not of type *Outer")
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Outer but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Outerbut is not nil && this == nil")
+	}
+	if this.xxx_IsValueSet != that1.xxx_IsValueSet {
+		return fmt.Errorf("that.value is not equal to this.value")
+	}
+	if this.xxx_IsValueSet && !this.value.Equal(&that1.value) {
+		return fmt.Errorf("value this(%v) Not Equal that(%v)", this.value, that1.value)
+	}
+	if this.xxx_IsPointerSet != that1.xxx_IsPointerSet {
+		return fmt.Errorf("that.pointer is not equal to this.pointer")
+	}
+	if this.xxx_IsPointerSet && !this.pointer.Equal(that1.pointer) {
+		return fmt.Errorf("pointer this(%v) Not Equal that(%v)", this.pointer, that1.pointer)
+	}
+	if this.xxx_LenValues != that1.xxx_LenValues {
+		return fmt.Errorf("that.values is not equal to this.values")
+	}
+	for i := 0; i < this.xxx_LenValues; i++ {
+		if !this.values[i].Equal(&that1.values[i]) {
+			return fmt.Errorf("values this[%v](%v) Not Equal that[%v](%v)", i, this.values[i], i, that1.values[i])
+		}
+	}
+	if this.xxx_LenPointers != that1.xxx_LenPointers {
+		return fmt.Errorf("that.pointers is not equal to this.pointers")
+	}
+	for i := 0; i < this.xxx_LenPointers; i++ {
+		if !this.pointers[i].Equal(that1.pointers[i]) {
+			return fmt.Errorf("pointers this[%v](%v) Not Equal that[%v](%v)", i, this.pointers[i], i, that1.pointers[i])
+		}
+	}
+	if this.xxx_IsNumberSet != that1.xxx_IsNumberSet {
+		return fmt.Errorf("that.number is not equal to this.number")
+	}
+	if this.xxx_IsNumberSet && this.number != that1.number {
+		return fmt.Errorf("number this(%v) Not Equal that(%v)", this.number, that1.number)
+	}
+	if !bytes1.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Choice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Choice)
+	if !ok {
+		return fmt.Errorf("that is not of type *Choice")
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Choice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Choicebut is not nil && this == nil")
+	}
+	if this.xxx_IsChosenSet != that1.xxx_IsChosenSet {
+		return fmt.Errorf("that.chosen is not equal to this.chosen")
+	}
+	if this.xxx_IsChosenSet && !this.chosen.Equal(&that1.chosen) {
+		return fmt.Errorf("chosen this(%v) Not Equal that(%v)", this.chosen, that1.chosen)
+	}
+	if this.xxx_IsOtherSet != that1.xxx_IsOtherSet {
+		return fmt.Errorf("that.other is not equal to this.other")
+	}
+	if this.xxx_IsOtherSet && this.other != that1.other {
+		return fmt.Errorf("other this(%v) Not Equal that(%v)", this.other, that1.other)
+	}
+	if !bytes1.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package nullable
+
+import (
+	"testing"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newOuter() *Outer {
+	m := &Outer{}
+	value, _ := m.MutateValue()
+	value.SetId(1)
+	pointer, _ := m.MutatePointer()
+	pointer.SetId(2)
+	for i := int64(0); i < 10; i++ {
+		v, _ := m.AddValues()
+		v.SetId(10 + i)
+		v.SetName("values")
+		p, _ := m.AddPointers()
+		p.SetId(20 + i)
+	}
+	m.SetNumber(3)
+	return m
+}
+
+func TestValueHeldInPlace(t *testing.T) {
+	m := &Outer{}
+	if m.HasValue() {
+		t.Fatal("value is set on an empty message")
+	}
+	if m.GetValue() != nil {
+		t.Fatal("getter of an unset value is not nil")
+	}
+	value, err := m.MutateValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != &m.value {
+		t.Fatal("mutator does not return the field held in the message")
+	}
+	value.SetId(1)
+	if got := m.GetValue(); got != value || got.GetId() != 1 {
+		t.Fatalf("getter returns %v, want the mutated value", got)
+	}
+
+	// Clearing keeps the storage, which is reset on the next mutation.
+	m.ClearValue()
+	if m.HasValue() || m.GetValue() != nil {
+		t.Fatal("value is still set after clearing it")
+	}
+	value, _ = m.MutateValue()
+	if value.HasId() {
+		t.Fatal("mutator returns a value which was not cleared")
+	}
+}
+
+func TestRepeatedValues(t *testing.T) {
+	m := newOuter()
+	if got := m.ValuesSize(); got != 10 {
+		t.Fatalf("%d values, want 10", got)
+	}
+	for i := 0; i < m.ValuesSize(); i++ {
+		v, err := m.GetValues(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != &m.values[i] || v.GetId() != int64(10+i) {
+			t.Fatalf("value %d is %v", i, v)
+		}
+	}
+	mutated, _ := m.MutateValues(3)
+	mutated.SetName("mutated")
+	if v, _ := m.GetValues(3); v.GetName() != "mutated" {
+		t.Fatalf("value 3 is %v, want it mutated in place", v)
+	}
+
+	m.ClearValues()
+	v, _ := m.AddValues()
+	if v.HasId() || v.HasName() {
+		t.Fatalf("added value %v was not cleared", v)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	m := newOuter()
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &Outer{}
+	if err := proto.Unmarshal(data, m2); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.VerboseEqual(m2); err != nil {
+		t.Fatal(err)
+	}
+
+	text := proto.MarshalTextString(m)
+	m3 := &Outer{}
+	if err := proto.UnmarshalText(text, m3); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.VerboseEqual(m3); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := new(jsonpb.Marshaler).MarshalToString(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m4 := &Outer{}
+	if err := new(jsonpb.Unmarshaler).UnmarshalString(s, m4); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.VerboseEqual(m4); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOneofValue(t *testing.T) {
+	m := &Choice{}
+	chosen, err := m.MutateChosen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chosen.SetId(1)
+	if m.WhichChoice() != Choice_ChoiceCase_Chosen {
+		t.Fatalf("case is %v", m.WhichChoice())
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &Choice{}
+	if err := proto.Unmarshal(data, m2); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Equal(m2) {
+		t.Fatalf("%v != %v", m, m2)
+	}
+
+	m.SetOther("other")
+	if m.HasChosen() || m.GetChosen() != nil {
+		t.Fatal("chosen is still set after setting other")
+	}
+}
+
+func TestReflect(t *testing.T) {
+	m := newOuter()
+	info := proto.Reflect(m)
+	m2 := &Outer{}
+	info.Range(m, func(f *proto.FieldInfo, v interface{}) bool {
+		if err := f.Set(m2, v); err != nil {
+			t.Fatal(err)
+		}
+		return true
+	})
+	if err := m.VerboseEqual(m2); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := info.FieldByName("value").Get(m).(*Inner); !ok {
+		t.Fatal("value is not reflected as a *Inner")
+	}
+	if _, ok := info.FieldByName("values").Get(m).([]*Inner); !ok {
+		t.Fatal("values are not reflected as a []*Inner")
+	}
+}
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-dgo.
+// source: nullable.proto
+// DO NOT EDIT!
+
+/*
+Package nullable is a generated protocol buffer package.
+
+It is generated from these files:
+
+	nullable.proto
+
+It has these top-level messages:
+
+	Inner
+	Outer
+	Choice
+*/
+package nullable
+
+import testing "testing"
+import math_rand "math/rand"
+import time "time"
+import github_com_dropbox_goprotoc_proto "github.com/dropbox/goprotoc/proto"
+import testing1 "testing"
+import math_rand1 "math/rand"
+import time1 "time"
+import math_rand2 "math/rand"
+import time2 "time"
+import testing2 "testing"
+import github_com_dropbox_goprotoc_proto1 "github.com/dropbox/goprotoc/proto"
+
+func TestInnerProto(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedInner(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Inner{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestInnerMarshalTo(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedInner(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	_, err := p.MarshalTo(data)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Inner{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestOuterProto(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedOuter(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Outer{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestOuterMarshalTo(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedOuter(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	_, err := p.MarshalTo(data)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Outer{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+}
+
+func TestInnerAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedInner(popr, false)
+	msg := &Inner{}
+	if !apiEmptyInner(msg, t) {
+		t.Fatalf("Inner should be empty")
+	}
+	apiCopyInner(msg, p, t)
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyInner(p, t) != apiEmptyInner(msg, t) {
+		t.Fatalf("Inner should not be empty")
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+	msg.Clear()
+	if !apiEmptyInner(msg, t) {
+		t.Fatalf("Inner should be empty")
+	}
+}
+
+func apiCopyInner(dst *Inner, src *Inner, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
+	}
+	if src.HasId() {
+		dst.SetId(src.GetId())
+	}
+	if src.HasName() {
+		dst.SetName(src.GetName())
+	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
+}
+
+func apiEmptyInner(msg *Inner, t *testing1.T) bool {
+	if msg == nil {
+		return true
+	}
+	if msg.HasId() {
+		return false
+	}
+	if msg.HasName() {
+		return false
+	}
+	return true
+}
+
+func TestOuterAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedOuter(popr, false)
+	msg := &Outer{}
+	if !apiEmptyOuter(msg, t) {
+		t.Fatalf("Outer should be empty")
+	}
+	apiCopyOuter(msg, p, t)
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyOuter(p, t) != apiEmptyOuter(msg, t) {
+		t.Fatalf("Outer should not be empty")
+	}
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+	msg.Clear()
+	if !apiEmptyOuter(msg, t) {
+		t.Fatalf("Outer should be empty")
+	}
+}
+
+func apiCopyOuter(dst *Outer, src *Outer, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
+	}
+	if src.HasValue() {
+		srcValue := src.GetValue()
+		dstValue, _ := dst.MutateValue()
+		apiCopyInner(dstValue, srcValue, t)
+	}
+	if src.HasPointer() {
+		srcPointer := src.GetPointer()
+		dstPointer, _ := dst.MutatePointer()
+		apiCopyInner(dstPointer, srcPointer, t)
+	}
+	for i := 0; i < src.ValuesSize(); i++ {
+		srcValues, _ := src.GetValues(i)
+		dstValues, _ := dst.AddValues()
+		apiCopyInner(dstValues, srcValues, t)
+	}
+	for i := 0; i < src.PointersSize(); i++ {
+		srcPointers, _ := src.GetPointers(i)
+		dstPointers, _ := dst.AddPointers()
+		apiCopyInner(dstPointers, srcPointers, t)
+	}
+	if src.HasNumber() {
+		dst.SetNumber(src.GetNumber())
+	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
+}
+
+func apiEmptyOuter(msg *Outer, t *testing1.T) bool {
+	if msg == nil {
+		return true
+	}
+	if msg.HasValue() {
+		return false
+	}
+	if msg.HasPointer() {
+		return false
+	}
+	if msg.ValuesSize() != 0 {
+		return false
+	}
+	if msg.PointersSize() != 0 {
+		return false
+	}
+	if msg.HasNumber() {
+		return false
+	}
+	return true
+}
+
+func TestInnerVerboseEqual(t *testing2.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
+	p := NewPopulatedInner(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Inner{}
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+}
+func TestOuterVerboseEqual(t *testing2.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
+	p := NewPopulatedOuter(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	msg := &Outer{}
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
+		panic(err)
+	}
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+}
+
+//These tests are generated by github.com/dropbox/goprotoc/plugin/testgen
@@ -0,0 +1,1394 @@
+// Code generated by protoc-gen-dgo.
+// source: oneof.proto
+// DO NOT EDIT!
+
+/*
+Package oneof is a generated protocol buffer package.
+
+It is generated from these files:
+
+	oneof.proto
+
+It has these top-level messages:
+
+	Sub
+	Choice
+*/
+package oneof
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Sub struct {
+	xxx_sizeCached   int
+	number           int64
+	XXX_unrecognized []byte
+	xxx_IsNumberSet  bool
+}
+
+func (m *Sub) Reset()         { *m = Sub{} }
+func (m *Sub) String() string { return proto.CompactTextString(m) }
+func (*Sub) ProtoMessage()    {}
+
+func (m *Sub) GetNumber() int64 {
+	if m != nil && m.xxx_IsNumberSet {
+		return m.number
+	}
+	return 0
+}
+
+func (m *Sub) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Sub) SetNumber(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNumberSet = true
+	m.number = value
+	return nil
+}
+
+func (m *Sub) HasNumber() (isSet bool) {
+	if m != nil && m.xxx_IsNumberSet {
+		return true
+	}
+	return false
+}
+
+func (m *Sub) ClearNumber() {
+	if m != nil {
+		m.xxx_IsNumberSet = false
+	}
+}
+
+func (m *Sub) Clear() {
+	if m != nil {
+		m.ClearNumber()
+	}
+}
+
+type Choice struct {
+	xxx_sizeCached       int
+	name                 string
+	intValue             int64
+	stringValue          string
+	subValue             *Sub
+	bytesValue           []byte
+	after                int32
+	XXX_unrecognized     []byte
+	xxx_IsNameSet        bool
+	xxx_IsIntValueSet    bool
+	xxx_IsStringValueSet bool
+	xxx_IsSubValueSet    bool
+	xxx_IsBytesValueSet  bool
+	xxx_IsAfterSet       bool
+	xxx_ValueCase        Choice_ValueCase
+}
+
+func (m *Choice) Reset()         { *m = Choice{} }
+func (m *Choice) String() string { return proto.CompactTextString(m) }
+func (*Choice) ProtoMessage()    {}
+
+func (m *Choice) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Choice) GetIntValue() int64 {
+	if m != nil && m.xxx_IsIntValueSet {
+		return m.intValue
+	}
+	return 0
+}
+
+func (m *Choice) GetStringValue() string {
+	if m != nil && m.xxx_IsStringValueSet {
+		return m.stringValue
+	}
+	return ""
+}
+
+func (m *Choice) GetSubValue() *Sub {
+	if m != nil && m.xxx_IsSubValueSet {
+		return m.subValue
+	}
+	return nil
+}
+func (m *Choice) GetBytesValue() []byte {
+	if m != nil && m.xxx_IsBytesValueSet {
+		return m.bytesValue
+	}
+	return nil
+}
+func (m *Choice) GetAfter() int32 {
+	if m != nil && m.xxx_IsAfterSet {
+		return m.after
+	}
+	return 0
+}
+
+func (m *Choice) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Choice_ValueCase int32
+
+const (
+	Choice_ValueCase_NotSet      Choice_ValueCase = 0
+	Choice_ValueCase_IntValue    Choice_ValueCase = 2
+	Choice_ValueCase_StringValue Choice_ValueCase = 3
+	Choice_ValueCase_SubValue    Choice_ValueCase = 4
+	Choice_ValueCase_BytesValue  Choice_ValueCase = 5
+)
+
+func (m *Choice) WhichValue() Choice_ValueCase {
+	if m != nil {
+		return m.xxx_ValueCase
+	}
+	return Choice_ValueCase_NotSet
+}
+
+func (m *Choice) ClearValue() {
+	if m != nil {
+		switch m.xxx_ValueCase {
+		case Choice_ValueCase_IntValue:
+			m.ClearIntValue()
+		case Choice_ValueCase_StringValue:
+			m.ClearStringValue()
+		case Choice_ValueCase_SubValue:
+			m.ClearSubValue()
+		case Choice_ValueCase_BytesValue:
+			m.ClearBytesValue()
+		}
+		m.xxx_ValueCase = Choice_ValueCase_NotSet
+	}
+}
+
+func (m *Choice) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Choice) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Choice) SetIntValue(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ValueCase != Choice_ValueCase_IntValue {
+		m.ClearValue()
+		m.xxx_ValueCase = Choice_ValueCase_IntValue
+	}
+	m.xxx_IsIntValueSet = true
+	m.intValue = value
+	return nil
+}
+
+func (m *Choice) HasIntValue() (isSet bool) {
+	if m != nil && m.xxx_IsIntValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearIntValue() {
+	if m != nil {
+		m.xxx_IsIntValueSet = false
+		if m.xxx_ValueCase == Choice_ValueCase_IntValue {
+			m.xxx_ValueCase = Choice_ValueCase_NotSet
+		}
+	}
+}
+
+func (m *Choice) SetStringValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ValueCase != Choice_ValueCase_StringValue {
+		m.ClearValue()
+		m.xxx_ValueCase = Choice_ValueCase_StringValue
+	}
+	m.xxx_IsStringValueSet = true
+	m.stringValue = value
+	return nil
+}
+
+func (m *Choice) HasStringValue() (isSet bool) {
+	if m != nil && m.xxx_IsStringValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearStringValue() {
+	if m != nil {
+		m.xxx_IsStringValueSet = false
+		m.stringValue = ""
+		if m.xxx_ValueCase == Choice_ValueCase_StringValue {
+			m.xxx_ValueCase = Choice_ValueCase_NotSet
+		}
+	}
+}
+
+func (m *Choice) MutateSubValue() (field *Sub, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if m.xxx_ValueCase != Choice_ValueCase_SubValue {
+		m.ClearValue()
+		m.xxx_ValueCase = Choice_ValueCase_SubValue
+	}
+	if !m.xxx_IsSubValueSet {
+		m.xxx_IsSubValueSet = true
+		if m.subValue == nil {
+			m.subValue = new(Sub)
+		} else {
+			m.subValue.Clear()
+		}
+	}
+	return m.subValue, nil
+}
+
+func (m *Choice) HasSubValue() (isSet bool) {
+	if m != nil && m.xxx_IsSubValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearSubValue() {
+	if m != nil {
+		m.subValue.Clear()
+		m.xxx_IsSubValueSet = false
+
+		if m.xxx_ValueCase == Choice_ValueCase_SubValue {
+			m.xxx_ValueCase = Choice_ValueCase_NotSet
+		}
+	}
+}
+
+func (m *Choice) SetBytesValue(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.xxx_ValueCase != Choice_ValueCase_BytesValue {
+		m.ClearValue()
+		m.xxx_ValueCase = Choice_ValueCase_BytesValue
+	}
+	m.xxx_IsBytesValueSet = true
+	m.bytesValue = value
+	return nil
+}
+
+func (m *Choice) HasBytesValue() (isSet bool) {
+	if m != nil && m.xxx_IsBytesValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearBytesValue() {
+	if m != nil {
+		m.xxx_IsBytesValueSet = false
+		m.bytesValue = nil
+		if m.xxx_ValueCase == Choice_ValueCase_BytesValue {
+			m.xxx_ValueCase = Choice_ValueCase_NotSet
+		}
+	}
+}
+
+func (m *Choice) SetAfter(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsAfterSet = true
+	m.after = value
+	return nil
+}
+
+func (m *Choice) HasAfter() (isSet bool) {
+	if m != nil && m.xxx_IsAfterSet {
+		return true
+	}
+	return false
+}
+
+func (m *Choice) ClearAfter() {
+	if m != nil {
+		m.xxx_IsAfterSet = false
+	}
+}
+
+func (m *Choice) Clear() {
+	if m != nil {
+		m.ClearName()
+		m.ClearIntValue()
+		m.ClearStringValue()
+		m.subValue.Clear()
+		m.xxx_IsSubValueSet = false
+
+		m.ClearBytesValue()
+		m.ClearAfter()
+		m.xxx_ValueCase = Choice_ValueCase_NotSet
+	}
+}
+
+func (m *Sub) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNumberSet {
+		n += 1 + sovOneof(uint64(m.number))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Choice) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovOneof(uint64(l))
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_IntValue {
+		n += 1 + sovOneof(uint64(m.intValue))
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_StringValue {
+		l = len(m.stringValue)
+		n += 1 + l + sovOneof(uint64(l))
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_SubValue {
+		l = m.subValue.Size()
+		n += 1 + l + sovOneof(uint64(l))
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_BytesValue {
+		l = len(m.bytesValue)
+		n += 1 + l + sovOneof(uint64(l))
+	}
+	if m.xxx_IsAfterSet {
+		n += 1 + sovOneof(uint64(uint32(m.after)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovOneof(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozOneof(x uint64) (n int) {
+	return sovOneof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sub) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Sub) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Sub) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNumberSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintOneof(data, i, uint64(m.number))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Choice) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Choice) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Choice) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintOneof(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_IntValue {
+		data[i] = 0x10
+		i++
+		i = encodeVarintOneof(data, i, uint64(m.intValue))
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_StringValue {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintOneof(data, i, uint64(len(m.stringValue)))
+		i += copy(data[i:], m.stringValue)
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_SubValue {
+		data[i] = 0x22
+		i++
+		i = encodeVarintOneof(data, i, uint64(m.subValue.SizeCached()))
+		n1, err := m.subValue.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_BytesValue {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintOneof(data, i, uint64(len(m.bytesValue)))
+		i += copy(data[i:], m.bytesValue)
+	}
+	if m.xxx_IsAfterSet {
+		data[i] = 0x30
+		i++
+		i = encodeVarintOneof(data, i, uint64(uint32(m.after)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Oneof(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Oneof(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintOneof(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Sub) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field number", wireType)
+			}
+			m.xxx_IsNumberSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.number |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Choice) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field intValue", wireType)
+			}
+			if m.xxx_ValueCase != Choice_ValueCase_IntValue {
+				m.ClearValue()
+				m.xxx_ValueCase = Choice_ValueCase_IntValue
+			}
+			m.xxx_IsIntValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.intValue |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field stringValue", wireType)
+			}
+			if m.xxx_ValueCase != Choice_ValueCase_StringValue {
+				m.ClearValue()
+				m.xxx_ValueCase = Choice_ValueCase_StringValue
+			}
+			m.xxx_IsStringValueSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.stringValue = string(data[index:postIndex])
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field subValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateSubValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field bytesValue", wireType)
+			}
+			if m.xxx_ValueCase != Choice_ValueCase_BytesValue {
+				m.ClearValue()
+				m.xxx_ValueCase = Choice_ValueCase_BytesValue
+			}
+			m.xxx_IsBytesValueSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.bytesValue = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field after", wireType)
+			}
+			m.xxx_IsAfterSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.after |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Sub) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Sub) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Choice) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Choice) CheckInitialized() error {
+	return nil
+}
+
+func (m *Sub) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNumberSet || w.EmitDefaults() {
+		w.Field("number", "number")
+		w.Int64(m.GetNumber())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Sub) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("number", "number"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetNumber(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Sub) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Sub) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Choice) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_IntValue {
+		w.Field("intValue", "int_value")
+		w.Int64(m.GetIntValue())
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_StringValue {
+		w.Field("stringValue", "string_value")
+		w.String(m.GetStringValue())
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_SubValue {
+		w.Field("subValue", "sub_value")
+		w.Message(m.GetSubValue())
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_BytesValue {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.GetBytesValue())
+	}
+	if m.xxx_IsAfterSet || w.EmitDefaults() {
+		w.Field("after", "after")
+		w.Int32(m.GetAfter())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Choice) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("intValue", "int_value"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetIntValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("stringValue", "string_value"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetStringValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("subValue", "sub_value"); ok {
+		v, err := m.MutateSubValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("bytesValue", "bytes_value"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetBytesValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("after", "after"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetAfter(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Choice) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Choice) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Sub) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNumberSet {
+		w.Field("number")
+		w.Value(m.number)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Sub) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "number":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNumber(v)
+	}
+	return false, nil
+}
+
+func (m *Choice) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_IntValue {
+		w.Field("int_value")
+		w.Value(m.intValue)
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_StringValue {
+		w.Field("string_value")
+		w.Value(m.stringValue)
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_SubValue {
+		w.Field("sub_value")
+		w.Message(m.subValue)
+	}
+	if m.xxx_ValueCase == Choice_ValueCase_BytesValue {
+		w.Field("bytes_value")
+		w.Value(m.bytesValue)
+	}
+	if m.xxx_IsAfterSet {
+		w.Field("after")
+		w.Value(m.after)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Choice) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "int_value":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetIntValue(v)
+	case "string_value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetStringValue(v)
+	case "sub_value":
+		v, err := m.MutateSubValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "bytes_value":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetBytesValue(v)
+	case "after":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetAfter(v)
+	}
+	return false, nil
+}
+
+func (m *Sub) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Sub{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Sub) MergeFrom(src proto.Message) {
+	s, ok := src.(*Sub)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNumberSet {
+		m.SetNumber(s.number)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Sub) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Sub)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNumberSet != o.xxx_IsNumberSet {
+		return false
+	}
+	if m.xxx_IsNumberSet && m.number != o.number {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Choice) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Choice{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Choice) MergeFrom(src proto.Message) {
+	s, ok := src.(*Choice)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_ValueCase == Choice_ValueCase_IntValue {
+		m.SetIntValue(s.intValue)
+	}
+	if s.xxx_ValueCase == Choice_ValueCase_StringValue {
+		m.SetStringValue(s.stringValue)
+	}
+	if s.xxx_ValueCase == Choice_ValueCase_SubValue {
+		v, _ := m.MutateSubValue()
+		v.MergeFrom(s.subValue)
+	}
+	if s.xxx_ValueCase == Choice_ValueCase_BytesValue {
+		m.SetBytesValue(append([]byte{}, s.bytesValue...))
+	}
+	if s.xxx_IsAfterSet {
+		m.SetAfter(s.after)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Choice) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Choice)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_IntValue) != (o.xxx_ValueCase == Choice_ValueCase_IntValue) {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_IntValue) && m.intValue != o.intValue {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_StringValue) != (o.xxx_ValueCase == Choice_ValueCase_StringValue) {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_StringValue) && m.stringValue != o.stringValue {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_SubValue) != (o.xxx_ValueCase == Choice_ValueCase_SubValue) {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_SubValue) && !m.subValue.Equal(o.subValue) {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_BytesValue) != (o.xxx_ValueCase == Choice_ValueCase_BytesValue) {
+		return false
+	}
+	if (m.xxx_ValueCase == Choice_ValueCase_BytesValue) && !bytes.Equal(m.bytesValue, o.bytesValue) {
+		return false
+	}
+	if m.xxx_IsAfterSet != o.xxx_IsAfterSet {
+		return false
+	}
+	if m.xxx_IsAfterSet && m.after != o.after {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionSub = &proto.MessageInfo{
+	Name: "oneof.Sub",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "number",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Sub)(nil).GetNumber(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Sub).GetNumber()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "number", v)
+				}
+				x := m.(*Sub)
+				return x.SetNumber(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Sub).HasNumber()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Sub).ClearNumber()
+			},
+		},
+	},
+}
+
+func (m *Sub) ProtoReflect() *proto.MessageInfo {
+	return reflectionSub
+}
+
+var reflectionChoice = &proto.MessageInfo{
+	Name: "oneof.Choice",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Choice)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Choice)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearName()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "int_value",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "value",
+			Default: (*Choice)(nil).GetIntValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetIntValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "int_value", v)
+				}
+				x := m.(*Choice)
+				return x.SetIntValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasIntValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearIntValue()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "string_value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "value",
+			Default: (*Choice)(nil).GetStringValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetStringValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "string_value", v)
+				}
+				x := m.(*Choice)
+				return x.SetStringValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasStringValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearStringValue()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "sub_value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			Oneof:  "value",
+			New: func() proto.Message {
+				return new(Sub)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetSubValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Sub)
+				if !ok {
+					return proto.NewFieldTypeError(m, "sub_value", v)
+				}
+				x := m.(*Choice)
+				x.ClearSubValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateSubValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasSubValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearSubValue()
+			},
+		},
+		{
+			Number:  5,
+			Name:    "bytes_value",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "value",
+			Default: (*Choice)(nil).GetBytesValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetBytesValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "bytes_value", v)
+				}
+				x := m.(*Choice)
+				return x.SetBytesValue(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasBytesValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearBytesValue()
+			},
+		},
+		{
+			Number:  6,
+			Name:    "after",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Choice)(nil).GetAfter(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Choice).GetAfter()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "after", v)
+				}
+				x := m.(*Choice)
+				return x.SetAfter(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Choice).HasAfter()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Choice).ClearAfter()
+			},
+		},
+	},
+}
+
+func (m *Choice) ProtoReflect() *proto.MessageInfo {
+	return reflectionChoice
+}
+
+func init() {
+	proto.RegisterType((*Sub)(nil), "oneof.Sub")
+	proto.RegisterType((*Choice)(nil), "oneof.Choice")
+}
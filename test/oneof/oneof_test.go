@@ -0,0 +1,145 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package oneof
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func TestOneofSetClearsSiblings(t *testing.T) {
+	m := &Choice{}
+	if m.WhichValue() != Choice_ValueCase_NotSet {
+		t.Fatalf("expected no case, got %v", m.WhichValue())
+	}
+	m.SetIntValue(5)
+	if m.WhichValue() != Choice_ValueCase_IntValue {
+		t.Fatalf("expected IntValue, got %v", m.WhichValue())
+	}
+	m.SetStringValue("hello")
+	if m.WhichValue() != Choice_ValueCase_StringValue {
+		t.Fatalf("expected StringValue, got %v", m.WhichValue())
+	}
+	if m.HasIntValue() {
+		t.Fatalf("setting StringValue should clear IntValue")
+	}
+	sub, err := m.MutateSubValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub.SetNumber(7)
+	if m.WhichValue() != Choice_ValueCase_SubValue {
+		t.Fatalf("expected SubValue, got %v", m.WhichValue())
+	}
+	if m.HasStringValue() || m.GetStringValue() != "" {
+		t.Fatalf("mutating SubValue should clear StringValue")
+	}
+	m.ClearSubValue()
+	if m.WhichValue() != Choice_ValueCase_NotSet {
+		t.Fatalf("expected no case after clearing the member, got %v", m.WhichValue())
+	}
+}
+
+func TestOneofClear(t *testing.T) {
+	m := &Choice{}
+	m.SetName("name")
+	m.SetBytesValue([]byte("data"))
+	m.ClearValue()
+	if m.WhichValue() != Choice_ValueCase_NotSet || m.HasBytesValue() {
+		t.Fatalf("ClearValue should clear the member which is set")
+	}
+	if !m.HasName() {
+		t.Fatalf("ClearValue should not clear fields outside of the oneof")
+	}
+	m.SetIntValue(1)
+	m.Clear()
+	if m.WhichValue() != Choice_ValueCase_NotSet || m.HasIntValue() {
+		t.Fatalf("Clear should clear the oneof")
+	}
+}
+
+func TestOneofMarshalLastSet(t *testing.T) {
+	m := &Choice{}
+	m.SetName("name")
+	m.SetIntValue(5)
+	m.SetBytesValue([]byte("data"))
+	m.SetAfter(3)
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != m.Size() {
+		t.Fatalf("size %d does not match marshaled length %d", m.Size(), len(data))
+	}
+	out := &Choice{}
+	if err := proto.Unmarshal(data, out); err != nil {
+		t.Fatal(err)
+	}
+	if out.WhichValue() != Choice_ValueCase_BytesValue {
+		t.Fatalf("expected BytesValue, got %v", out.WhichValue())
+	}
+	if out.HasIntValue() {
+		t.Fatalf("only the last set member should be marshaled")
+	}
+	if !bytes.Equal(out.GetBytesValue(), []byte("data")) {
+		t.Fatalf("expected data, got %q", out.GetBytesValue())
+	}
+	if out.GetName() != "name" || out.GetAfter() != 3 {
+		t.Fatalf("fields outside of the oneof were not preserved")
+	}
+}
+
+func TestOneofUnmarshalLastWins(t *testing.T) {
+	first := &Choice{}
+	first.SetStringValue("first")
+	second := &Choice{}
+	sub, _ := second.MutateSubValue()
+	sub.SetNumber(42)
+	data1, err := proto.Marshal(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data2, err := proto.Marshal(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := &Choice{}
+	if err := proto.Unmarshal(append(data1, data2...), out); err != nil {
+		t.Fatal(err)
+	}
+	if out.WhichValue() != Choice_ValueCase_SubValue {
+		t.Fatalf("expected SubValue, got %v", out.WhichValue())
+	}
+	if out.HasStringValue() {
+		t.Fatalf("the earlier member should have been cleared")
+	}
+	if out.GetSubValue().GetNumber() != 42 {
+		t.Fatalf("expected 42, got %d", out.GetSubValue().GetNumber())
+	}
+}
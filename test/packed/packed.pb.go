@@ -3,2423 +3,15348 @@
 // DO NOT EDIT!
 
 /*
-	Package packed is a generated protocol buffer package.
+Package packed is a generated protocol buffer package.
 
-	It is generated from these files:
-		packed.proto
+It is generated from these files:
 
-	It has these top-level messages:
-		NinRepNative
-		NinRepPackedNative
-		NinRepNativeUnsafe
-		NinRepPackedNativeUnsafe
+	packed.proto
+
+It has these top-level messages:
+
+	NinRepNative
+	NinRepPackedNative
+	NinRepNativeUnsafe
+	NinRepPackedNativeUnsafe
 */
 package packed
 
 import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
 import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
 
 // discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
 
-import io "io"
-import math1 "math"
-import fmt "fmt"
-import dropbox_gogoprotobuf_proto "github.com/dropbox/goprotoc/proto"
-
-import io1 "io"
-import unsafe "unsafe"
-import fmt1 "fmt"
-import dropbox_gogoprotobuf_proto1 "github.com/dropbox/goprotoc/proto"
-
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
 var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
 
 type NinRepNative struct {
-	Field1           []float64 `protobuf:"fixed64,1,rep" json:"Field1,omitempty"`
-	Field2           []float32 `protobuf:"fixed32,2,rep" json:"Field2,omitempty"`
-	Field3           []int32   `protobuf:"varint,3,rep" json:"Field3,omitempty"`
-	Field4           []int64   `protobuf:"varint,4,rep" json:"Field4,omitempty"`
-	Field5           []uint32  `protobuf:"varint,5,rep" json:"Field5,omitempty"`
-	Field6           []uint64  `protobuf:"varint,6,rep" json:"Field6,omitempty"`
-	Field7           []int32   `protobuf:"zigzag32,7,rep" json:"Field7,omitempty"`
-	Field8           []int64   `protobuf:"zigzag64,8,rep" json:"Field8,omitempty"`
-	Field9           []uint32  `protobuf:"fixed32,9,rep" json:"Field9,omitempty"`
-	Field10          []int32   `protobuf:"fixed32,10,rep" json:"Field10,omitempty"`
-	Field11          []uint64  `protobuf:"fixed64,11,rep" json:"Field11,omitempty"`
-	Field12          []int64   `protobuf:"fixed64,12,rep" json:"Field12,omitempty"`
-	Field13          []bool    `protobuf:"varint,13,rep" json:"Field13,omitempty"`
-	XXX_unrecognized []byte    `json:"-"`
+	xxx_sizeCached   int
+	field1           []float64
+	field2           []float32
+	field3           []int32
+	field4           []int64
+	field5           []uint32
+	field6           []uint64
+	field7           []int32
+	field8           []int64
+	field9           []uint32
+	field10          []int32
+	field11          []uint64
+	field12          []int64
+	field13          []bool
+	XXX_unrecognized []byte
+	xxx_LenField1    int
+	xxx_LenField2    int
+	xxx_LenField3    int
+	xxx_LenField4    int
+	xxx_LenField5    int
+	xxx_LenField6    int
+	xxx_LenField7    int
+	xxx_LenField8    int
+	xxx_LenField9    int
+	xxx_LenField10   int
+	xxx_LenField11   int
+	xxx_LenField12   int
+	xxx_LenField13   int
 }
 
 func (m *NinRepNative) Reset()         { *m = NinRepNative{} }
 func (m *NinRepNative) String() string { return proto.CompactTextString(m) }
 func (*NinRepNative) ProtoMessage()    {}
 
-func (m *NinRepNative) GetField1() []float64 {
-	if m != nil {
-		return m.Field1
+func (m *NinRepNative) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *NinRepNative) AddField1(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field1) <= m.xxx_LenField1 {
+		newCapacity := 0
+		if len(m.field1) == 0 {
+			newCapacity = 8
+		} else if len(m.field1) < 1000000 {
+			newCapacity = m.xxx_LenField1 * 2
+		} else {
+			newCapacity = m.xxx_LenField1 + 1000000
+		}
+		t := make([]float64, newCapacity, newCapacity)
+		copy(t, m.field1)
+		m.field1 = t
 	}
+	m.field1[m.xxx_LenField1] = value
+	m.xxx_LenField1 += 1
 	return nil
 }
 
-func (m *NinRepNative) GetField2() []float32 {
-	if m != nil {
-		return m.Field2
+func (m *NinRepNative) SetField1(value float64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field1[index] = value
 	return nil
 }
 
-func (m *NinRepNative) GetField3() []int32 {
+func (m *NinRepNative) Field1Size() (size int) {
 	if m != nil {
-		return m.Field3
+		return m.xxx_LenField1
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNative) GetField4() []int64 {
+func (m *NinRepNative) ClearField1() {
 	if m != nil {
-		return m.Field4
+		m.xxx_LenField1 = 0
 	}
+}
+
+func (m *NinRepNative) GetField1(index int) (field float64, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return 0.0, errors.New("Index is out of bounds")
+	}
+	return m.field1[index], nil
+}
+
+func (m *NinRepNative) AddField2(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field2) <= m.xxx_LenField2 {
+		newCapacity := 0
+		if len(m.field2) == 0 {
+			newCapacity = 8
+		} else if len(m.field2) < 1000000 {
+			newCapacity = m.xxx_LenField2 * 2
+		} else {
+			newCapacity = m.xxx_LenField2 + 1000000
+		}
+		t := make([]float32, newCapacity, newCapacity)
+		copy(t, m.field2)
+		m.field2 = t
+	}
+	m.field2[m.xxx_LenField2] = value
+	m.xxx_LenField2 += 1
 	return nil
 }
 
-func (m *NinRepNative) GetField5() []uint32 {
-	if m != nil {
-		return m.Field5
+func (m *NinRepNative) SetField2(value float32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field2[index] = value
 	return nil
 }
 
-func (m *NinRepNative) GetField6() []uint64 {
+func (m *NinRepNative) Field2Size() (size int) {
 	if m != nil {
-		return m.Field6
+		return m.xxx_LenField2
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNative) GetField7() []int32 {
+func (m *NinRepNative) ClearField2() {
 	if m != nil {
-		return m.Field7
+		m.xxx_LenField2 = 0
+	}
+}
+
+func (m *NinRepNative) GetField2(index int) (field float32, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return 0.0, errors.New("Index is out of bounds")
 	}
+	return m.field2[index], nil
+}
+
+func (m *NinRepNative) AddField3(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field3) <= m.xxx_LenField3 {
+		newCapacity := 0
+		if len(m.field3) == 0 {
+			newCapacity = 8
+		} else if len(m.field3) < 1000000 {
+			newCapacity = m.xxx_LenField3 * 2
+		} else {
+			newCapacity = m.xxx_LenField3 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field3)
+		m.field3 = t
+	}
+	m.field3[m.xxx_LenField3] = value
+	m.xxx_LenField3 += 1
 	return nil
 }
 
-func (m *NinRepNative) GetField8() []int64 {
-	if m != nil {
-		return m.Field8
+func (m *NinRepNative) SetField3(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field3[index] = value
 	return nil
 }
 
-func (m *NinRepNative) GetField9() []uint32 {
+func (m *NinRepNative) Field3Size() (size int) {
 	if m != nil {
-		return m.Field9
+		return m.xxx_LenField3
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNative) GetField10() []int32 {
+func (m *NinRepNative) ClearField3() {
 	if m != nil {
-		return m.Field10
+		m.xxx_LenField3 = 0
+	}
+}
+
+func (m *NinRepNative) GetField3(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field3[index], nil
+}
+
+func (m *NinRepNative) AddField4(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field4) <= m.xxx_LenField4 {
+		newCapacity := 0
+		if len(m.field4) == 0 {
+			newCapacity = 8
+		} else if len(m.field4) < 1000000 {
+			newCapacity = m.xxx_LenField4 * 2
+		} else {
+			newCapacity = m.xxx_LenField4 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field4)
+		m.field4 = t
 	}
+	m.field4[m.xxx_LenField4] = value
+	m.xxx_LenField4 += 1
 	return nil
 }
 
-func (m *NinRepNative) GetField11() []uint64 {
-	if m != nil {
-		return m.Field11
+func (m *NinRepNative) SetField4(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field4[index] = value
 	return nil
 }
 
-func (m *NinRepNative) GetField12() []int64 {
+func (m *NinRepNative) Field4Size() (size int) {
 	if m != nil {
-		return m.Field12
+		return m.xxx_LenField4
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNative) GetField13() []bool {
+func (m *NinRepNative) ClearField4() {
 	if m != nil {
-		return m.Field13
+		m.xxx_LenField4 = 0
+	}
+}
+
+func (m *NinRepNative) GetField4(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return 0, errors.New("Index is out of bounds")
 	}
+	return m.field4[index], nil
+}
+
+func (m *NinRepNative) AddField5(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field5) <= m.xxx_LenField5 {
+		newCapacity := 0
+		if len(m.field5) == 0 {
+			newCapacity = 8
+		} else if len(m.field5) < 1000000 {
+			newCapacity = m.xxx_LenField5 * 2
+		} else {
+			newCapacity = m.xxx_LenField5 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field5)
+		m.field5 = t
+	}
+	m.field5[m.xxx_LenField5] = value
+	m.xxx_LenField5 += 1
 	return nil
 }
 
-type NinRepPackedNative struct {
-	Field1           []float64 `protobuf:"fixed64,1,rep,packed" json:"Field1,omitempty"`
-	Field2           []float32 `protobuf:"fixed32,2,rep,packed" json:"Field2,omitempty"`
-	Field3           []int32   `protobuf:"varint,3,rep,packed" json:"Field3,omitempty"`
-	Field4           []int64   `protobuf:"varint,4,rep,packed" json:"Field4,omitempty"`
-	Field5           []uint32  `protobuf:"varint,5,rep,packed" json:"Field5,omitempty"`
-	Field6           []uint64  `protobuf:"varint,6,rep,packed" json:"Field6,omitempty"`
-	Field7           []int32   `protobuf:"zigzag32,7,rep,packed" json:"Field7,omitempty"`
-	Field8           []int64   `protobuf:"zigzag64,8,rep,packed" json:"Field8,omitempty"`
-	Field9           []uint32  `protobuf:"fixed32,9,rep,packed" json:"Field9,omitempty"`
-	Field10          []int32   `protobuf:"fixed32,10,rep,packed" json:"Field10,omitempty"`
-	Field11          []uint64  `protobuf:"fixed64,11,rep,packed" json:"Field11,omitempty"`
-	Field12          []int64   `protobuf:"fixed64,12,rep,packed" json:"Field12,omitempty"`
-	Field13          []bool    `protobuf:"varint,13,rep,packed" json:"Field13,omitempty"`
-	XXX_unrecognized []byte    `json:"-"`
+func (m *NinRepNative) SetField5(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field5[index] = value
+	return nil
 }
 
-func (m *NinRepPackedNative) Reset()         { *m = NinRepPackedNative{} }
-func (m *NinRepPackedNative) String() string { return proto.CompactTextString(m) }
-func (*NinRepPackedNative) ProtoMessage()    {}
+func (m *NinRepNative) Field5Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField5
+	}
+	return 0
+}
 
-func (m *NinRepPackedNative) GetField1() []float64 {
+func (m *NinRepNative) ClearField5() {
 	if m != nil {
-		return m.Field1
+		m.xxx_LenField5 = 0
+	}
+}
+
+func (m *NinRepNative) GetField5(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field5[index], nil
+}
+
+func (m *NinRepNative) AddField6(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field6) <= m.xxx_LenField6 {
+		newCapacity := 0
+		if len(m.field6) == 0 {
+			newCapacity = 8
+		} else if len(m.field6) < 1000000 {
+			newCapacity = m.xxx_LenField6 * 2
+		} else {
+			newCapacity = m.xxx_LenField6 + 1000000
+		}
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field6)
+		m.field6 = t
 	}
+	m.field6[m.xxx_LenField6] = value
+	m.xxx_LenField6 += 1
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField2() []float32 {
-	if m != nil {
-		return m.Field2
+func (m *NinRepNative) SetField6(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field6[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField3() []int32 {
+func (m *NinRepNative) Field6Size() (size int) {
 	if m != nil {
-		return m.Field3
+		return m.xxx_LenField6
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNative) GetField4() []int64 {
+func (m *NinRepNative) ClearField6() {
 	if m != nil {
-		return m.Field4
+		m.xxx_LenField6 = 0
+	}
+}
+
+func (m *NinRepNative) GetField6(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field6[index], nil
+}
+
+func (m *NinRepNative) AddField7(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field7) <= m.xxx_LenField7 {
+		newCapacity := 0
+		if len(m.field7) == 0 {
+			newCapacity = 8
+		} else if len(m.field7) < 1000000 {
+			newCapacity = m.xxx_LenField7 * 2
+		} else {
+			newCapacity = m.xxx_LenField7 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field7)
+		m.field7 = t
 	}
+	m.field7[m.xxx_LenField7] = value
+	m.xxx_LenField7 += 1
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField5() []uint32 {
-	if m != nil {
-		return m.Field5
+func (m *NinRepNative) SetField7(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field7[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField6() []uint64 {
+func (m *NinRepNative) Field7Size() (size int) {
 	if m != nil {
-		return m.Field6
+		return m.xxx_LenField7
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNative) GetField7() []int32 {
+func (m *NinRepNative) ClearField7() {
 	if m != nil {
-		return m.Field7
+		m.xxx_LenField7 = 0
+	}
+}
+
+func (m *NinRepNative) GetField7(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field7[index], nil
+}
+
+func (m *NinRepNative) AddField8(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field8) <= m.xxx_LenField8 {
+		newCapacity := 0
+		if len(m.field8) == 0 {
+			newCapacity = 8
+		} else if len(m.field8) < 1000000 {
+			newCapacity = m.xxx_LenField8 * 2
+		} else {
+			newCapacity = m.xxx_LenField8 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field8)
+		m.field8 = t
 	}
+	m.field8[m.xxx_LenField8] = value
+	m.xxx_LenField8 += 1
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField8() []int64 {
-	if m != nil {
-		return m.Field8
+func (m *NinRepNative) SetField8(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field8[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField9() []uint32 {
+func (m *NinRepNative) Field8Size() (size int) {
 	if m != nil {
-		return m.Field9
+		return m.xxx_LenField8
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNative) GetField10() []int32 {
+func (m *NinRepNative) ClearField8() {
 	if m != nil {
-		return m.Field10
+		m.xxx_LenField8 = 0
+	}
+}
+
+func (m *NinRepNative) GetField8(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field8[index], nil
+}
+
+func (m *NinRepNative) AddField9(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field9) <= m.xxx_LenField9 {
+		newCapacity := 0
+		if len(m.field9) == 0 {
+			newCapacity = 8
+		} else if len(m.field9) < 1000000 {
+			newCapacity = m.xxx_LenField9 * 2
+		} else {
+			newCapacity = m.xxx_LenField9 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field9)
+		m.field9 = t
 	}
+	m.field9[m.xxx_LenField9] = value
+	m.xxx_LenField9 += 1
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField11() []uint64 {
-	if m != nil {
-		return m.Field11
+func (m *NinRepNative) SetField9(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field9[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNative) GetField12() []int64 {
+func (m *NinRepNative) Field9Size() (size int) {
 	if m != nil {
-		return m.Field12
+		return m.xxx_LenField9
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNative) GetField13() []bool {
+func (m *NinRepNative) ClearField9() {
 	if m != nil {
-		return m.Field13
+		m.xxx_LenField9 = 0
 	}
-	return nil
 }
 
-type NinRepNativeUnsafe struct {
-	Field1           []float64 `protobuf:"fixed64,1,rep" json:"Field1,omitempty"`
-	Field2           []float32 `protobuf:"fixed32,2,rep" json:"Field2,omitempty"`
-	Field3           []int32   `protobuf:"varint,3,rep" json:"Field3,omitempty"`
-	Field4           []int64   `protobuf:"varint,4,rep" json:"Field4,omitempty"`
-	Field5           []uint32  `protobuf:"varint,5,rep" json:"Field5,omitempty"`
-	Field6           []uint64  `protobuf:"varint,6,rep" json:"Field6,omitempty"`
-	Field7           []int32   `protobuf:"zigzag32,7,rep" json:"Field7,omitempty"`
-	Field8           []int64   `protobuf:"zigzag64,8,rep" json:"Field8,omitempty"`
-	Field9           []uint32  `protobuf:"fixed32,9,rep" json:"Field9,omitempty"`
-	Field10          []int32   `protobuf:"fixed32,10,rep" json:"Field10,omitempty"`
-	Field11          []uint64  `protobuf:"fixed64,11,rep" json:"Field11,omitempty"`
-	Field12          []int64   `protobuf:"fixed64,12,rep" json:"Field12,omitempty"`
-	Field13          []bool    `protobuf:"varint,13,rep" json:"Field13,omitempty"`
-	XXX_unrecognized []byte    `json:"-"`
+func (m *NinRepNative) GetField9(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field9[index], nil
 }
 
-func (m *NinRepNativeUnsafe) Reset()         { *m = NinRepNativeUnsafe{} }
-func (m *NinRepNativeUnsafe) String() string { return proto.CompactTextString(m) }
-func (*NinRepNativeUnsafe) ProtoMessage()    {}
+func (m *NinRepNative) AddField10(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field10) <= m.xxx_LenField10 {
+		newCapacity := 0
+		if len(m.field10) == 0 {
+			newCapacity = 8
+		} else if len(m.field10) < 1000000 {
+			newCapacity = m.xxx_LenField10 * 2
+		} else {
+			newCapacity = m.xxx_LenField10 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field10)
+		m.field10 = t
+	}
+	m.field10[m.xxx_LenField10] = value
+	m.xxx_LenField10 += 1
+	return nil
+}
 
-func (m *NinRepNativeUnsafe) GetField1() []float64 {
-	if m != nil {
-		return m.Field1
+func (m *NinRepNative) SetField10(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field10[index] = value
 	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField2() []float32 {
+func (m *NinRepNative) Field10Size() (size int) {
 	if m != nil {
-		return m.Field2
+		return m.xxx_LenField10
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNativeUnsafe) GetField3() []int32 {
+func (m *NinRepNative) ClearField10() {
 	if m != nil {
-		return m.Field3
+		m.xxx_LenField10 = 0
+	}
+}
+
+func (m *NinRepNative) GetField10(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field10[index], nil
+}
+
+func (m *NinRepNative) AddField11(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field11) <= m.xxx_LenField11 {
+		newCapacity := 0
+		if len(m.field11) == 0 {
+			newCapacity = 8
+		} else if len(m.field11) < 1000000 {
+			newCapacity = m.xxx_LenField11 * 2
+		} else {
+			newCapacity = m.xxx_LenField11 + 1000000
+		}
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field11)
+		m.field11 = t
 	}
+	m.field11[m.xxx_LenField11] = value
+	m.xxx_LenField11 += 1
 	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField4() []int64 {
-	if m != nil {
-		return m.Field4
+func (m *NinRepNative) SetField11(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field11[index] = value
 	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField5() []uint32 {
+func (m *NinRepNative) Field11Size() (size int) {
 	if m != nil {
-		return m.Field5
+		return m.xxx_LenField11
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNativeUnsafe) GetField6() []uint64 {
+func (m *NinRepNative) ClearField11() {
 	if m != nil {
-		return m.Field6
+		m.xxx_LenField11 = 0
+	}
+}
+
+func (m *NinRepNative) GetField11(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field11[index], nil
+}
+
+func (m *NinRepNative) AddField12(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field12) <= m.xxx_LenField12 {
+		newCapacity := 0
+		if len(m.field12) == 0 {
+			newCapacity = 8
+		} else if len(m.field12) < 1000000 {
+			newCapacity = m.xxx_LenField12 * 2
+		} else {
+			newCapacity = m.xxx_LenField12 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field12)
+		m.field12 = t
 	}
+	m.field12[m.xxx_LenField12] = value
+	m.xxx_LenField12 += 1
 	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField7() []int32 {
-	if m != nil {
-		return m.Field7
+func (m *NinRepNative) SetField12(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field12[index] = value
 	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField8() []int64 {
+func (m *NinRepNative) Field12Size() (size int) {
 	if m != nil {
-		return m.Field8
+		return m.xxx_LenField12
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNativeUnsafe) GetField9() []uint32 {
+func (m *NinRepNative) ClearField12() {
 	if m != nil {
-		return m.Field9
+		m.xxx_LenField12 = 0
+	}
+}
+
+func (m *NinRepNative) GetField12(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field12[index], nil
+}
+
+func (m *NinRepNative) AddField13(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field13) <= m.xxx_LenField13 {
+		newCapacity := 0
+		if len(m.field13) == 0 {
+			newCapacity = 8
+		} else if len(m.field13) < 1000000 {
+			newCapacity = m.xxx_LenField13 * 2
+		} else {
+			newCapacity = m.xxx_LenField13 + 1000000
+		}
+		t := make([]bool, newCapacity, newCapacity)
+		copy(t, m.field13)
+		m.field13 = t
 	}
+	m.field13[m.xxx_LenField13] = value
+	m.xxx_LenField13 += 1
 	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField10() []int32 {
-	if m != nil {
-		return m.Field10
+func (m *NinRepNative) SetField13(value bool, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
 	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field13[index] = value
 	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField11() []uint64 {
+func (m *NinRepNative) Field13Size() (size int) {
 	if m != nil {
-		return m.Field11
+		return m.xxx_LenField13
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepNativeUnsafe) GetField12() []int64 {
+func (m *NinRepNative) ClearField13() {
 	if m != nil {
-		return m.Field12
+		m.xxx_LenField13 = 0
 	}
-	return nil
 }
 
-func (m *NinRepNativeUnsafe) GetField13() []bool {
+func (m *NinRepNative) GetField13(index int) (field bool, err error) {
+	if m == nil {
+		return false, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return false, errors.New("Index is out of bounds")
+	}
+	return m.field13[index], nil
+}
+
+func (m *NinRepNative) Clear() {
 	if m != nil {
-		return m.Field13
+		m.ClearField1()
+		m.ClearField2()
+		m.ClearField3()
+		m.ClearField4()
+		m.ClearField5()
+		m.ClearField6()
+		m.ClearField7()
+		m.ClearField8()
+		m.ClearField9()
+		m.ClearField10()
+		m.ClearField11()
+		m.ClearField12()
+		m.ClearField13()
+	}
+}
+
+type NinRepPackedNative struct {
+	xxx_sizeCached   int
+	field1           []float64
+	field2           []float32
+	field3           []int32
+	field4           []int64
+	field5           []uint32
+	field6           []uint64
+	field7           []int32
+	field8           []int64
+	field9           []uint32
+	field10          []int32
+	field11          []uint64
+	field12          []int64
+	field13          []bool
+	XXX_unrecognized []byte
+	xxx_LenField1    int
+	xxx_LenField2    int
+	xxx_LenField3    int
+	xxx_LenField4    int
+	xxx_LenField5    int
+	xxx_LenField6    int
+	xxx_LenField7    int
+	xxx_LenField8    int
+	xxx_LenField9    int
+	xxx_LenField10   int
+	xxx_LenField11   int
+	xxx_LenField12   int
+	xxx_LenField13   int
+}
+
+func (m *NinRepPackedNative) Reset()         { *m = NinRepPackedNative{} }
+func (m *NinRepPackedNative) String() string { return proto.CompactTextString(m) }
+func (*NinRepPackedNative) ProtoMessage()    {}
+
+func (m *NinRepPackedNative) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *NinRepPackedNative) AddField1(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field1) <= m.xxx_LenField1 {
+		newCapacity := 0
+		if len(m.field1) == 0 {
+			newCapacity = 8
+		} else if len(m.field1) < 1000000 {
+			newCapacity = m.xxx_LenField1 * 2
+		} else {
+			newCapacity = m.xxx_LenField1 + 1000000
+		}
+		t := make([]float64, newCapacity, newCapacity)
+		copy(t, m.field1)
+		m.field1 = t
 	}
+	m.field1[m.xxx_LenField1] = value
+	m.xxx_LenField1 += 1
 	return nil
 }
 
-type NinRepPackedNativeUnsafe struct {
-	Field1           []float64 `protobuf:"fixed64,1,rep,packed" json:"Field1,omitempty"`
-	Field2           []float32 `protobuf:"fixed32,2,rep,packed" json:"Field2,omitempty"`
-	Field3           []int32   `protobuf:"varint,3,rep,packed" json:"Field3,omitempty"`
-	Field4           []int64   `protobuf:"varint,4,rep,packed" json:"Field4,omitempty"`
-	Field5           []uint32  `protobuf:"varint,5,rep,packed" json:"Field5,omitempty"`
-	Field6           []uint64  `protobuf:"varint,6,rep,packed" json:"Field6,omitempty"`
-	Field7           []int32   `protobuf:"zigzag32,7,rep,packed" json:"Field7,omitempty"`
-	Field8           []int64   `protobuf:"zigzag64,8,rep,packed" json:"Field8,omitempty"`
-	Field9           []uint32  `protobuf:"fixed32,9,rep,packed" json:"Field9,omitempty"`
-	Field10          []int32   `protobuf:"fixed32,10,rep,packed" json:"Field10,omitempty"`
-	Field11          []uint64  `protobuf:"fixed64,11,rep,packed" json:"Field11,omitempty"`
-	Field12          []int64   `protobuf:"fixed64,12,rep,packed" json:"Field12,omitempty"`
-	Field13          []bool    `protobuf:"varint,13,rep,packed" json:"Field13,omitempty"`
-	XXX_unrecognized []byte    `json:"-"`
+func (m *NinRepPackedNative) SetField1(value float64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field1[index] = value
+	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) Reset()         { *m = NinRepPackedNativeUnsafe{} }
-func (m *NinRepPackedNativeUnsafe) String() string { return proto.CompactTextString(m) }
-func (*NinRepPackedNativeUnsafe) ProtoMessage()    {}
+func (m *NinRepPackedNative) Field1Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField1
+	}
+	return 0
+}
 
-func (m *NinRepPackedNativeUnsafe) GetField1() []float64 {
+func (m *NinRepPackedNative) ClearField1() {
 	if m != nil {
-		return m.Field1
+		m.xxx_LenField1 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField1(index int) (field float64, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return 0.0, errors.New("Index is out of bounds")
+	}
+	return m.field1[index], nil
+}
+
+func (m *NinRepPackedNative) AddField2(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field2) <= m.xxx_LenField2 {
+		newCapacity := 0
+		if len(m.field2) == 0 {
+			newCapacity = 8
+		} else if len(m.field2) < 1000000 {
+			newCapacity = m.xxx_LenField2 * 2
+		} else {
+			newCapacity = m.xxx_LenField2 + 1000000
+		}
+		t := make([]float32, newCapacity, newCapacity)
+		copy(t, m.field2)
+		m.field2 = t
 	}
+	m.field2[m.xxx_LenField2] = value
+	m.xxx_LenField2 += 1
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField2() []float32 {
-	if m != nil {
-		return m.Field2
+func (m *NinRepPackedNative) SetField2(value float32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field2[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField3() []int32 {
+func (m *NinRepPackedNative) Field2Size() (size int) {
 	if m != nil {
-		return m.Field3
+		return m.xxx_LenField2
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField4() []int64 {
+func (m *NinRepPackedNative) ClearField2() {
 	if m != nil {
-		return m.Field4
+		m.xxx_LenField2 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField2(index int) (field float32, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return 0.0, errors.New("Index is out of bounds")
+	}
+	return m.field2[index], nil
+}
+
+func (m *NinRepPackedNative) AddField3(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field3) <= m.xxx_LenField3 {
+		newCapacity := 0
+		if len(m.field3) == 0 {
+			newCapacity = 8
+		} else if len(m.field3) < 1000000 {
+			newCapacity = m.xxx_LenField3 * 2
+		} else {
+			newCapacity = m.xxx_LenField3 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field3)
+		m.field3 = t
 	}
+	m.field3[m.xxx_LenField3] = value
+	m.xxx_LenField3 += 1
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField5() []uint32 {
-	if m != nil {
-		return m.Field5
+func (m *NinRepPackedNative) SetField3(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field3[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField6() []uint64 {
+func (m *NinRepPackedNative) Field3Size() (size int) {
 	if m != nil {
-		return m.Field6
+		return m.xxx_LenField3
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField7() []int32 {
+func (m *NinRepPackedNative) ClearField3() {
 	if m != nil {
-		return m.Field7
+		m.xxx_LenField3 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField3(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field3[index], nil
+}
+
+func (m *NinRepPackedNative) AddField4(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field4) <= m.xxx_LenField4 {
+		newCapacity := 0
+		if len(m.field4) == 0 {
+			newCapacity = 8
+		} else if len(m.field4) < 1000000 {
+			newCapacity = m.xxx_LenField4 * 2
+		} else {
+			newCapacity = m.xxx_LenField4 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field4)
+		m.field4 = t
 	}
+	m.field4[m.xxx_LenField4] = value
+	m.xxx_LenField4 += 1
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField8() []int64 {
-	if m != nil {
-		return m.Field8
+func (m *NinRepPackedNative) SetField4(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field4[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField9() []uint32 {
+func (m *NinRepPackedNative) Field4Size() (size int) {
 	if m != nil {
-		return m.Field9
+		return m.xxx_LenField4
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField10() []int32 {
+func (m *NinRepPackedNative) ClearField4() {
 	if m != nil {
-		return m.Field10
+		m.xxx_LenField4 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField4(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field4[index], nil
+}
+
+func (m *NinRepPackedNative) AddField5(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field5) <= m.xxx_LenField5 {
+		newCapacity := 0
+		if len(m.field5) == 0 {
+			newCapacity = 8
+		} else if len(m.field5) < 1000000 {
+			newCapacity = m.xxx_LenField5 * 2
+		} else {
+			newCapacity = m.xxx_LenField5 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field5)
+		m.field5 = t
 	}
+	m.field5[m.xxx_LenField5] = value
+	m.xxx_LenField5 += 1
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField11() []uint64 {
-	if m != nil {
-		return m.Field11
+func (m *NinRepPackedNative) SetField5(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return errors.New("Index is out of bounds")
 	}
+	m.field5[index] = value
 	return nil
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField12() []int64 {
+func (m *NinRepPackedNative) Field5Size() (size int) {
 	if m != nil {
-		return m.Field12
+		return m.xxx_LenField5
 	}
-	return nil
+	return 0
 }
 
-func (m *NinRepPackedNativeUnsafe) GetField13() []bool {
+func (m *NinRepPackedNative) ClearField5() {
 	if m != nil {
-		return m.Field13
+		m.xxx_LenField5 = 0
 	}
-	return nil
 }
 
-func init() {
+func (m *NinRepPackedNative) GetField5(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field5[index], nil
 }
-func (m *NinRepNative) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *NinRepPackedNative) AddField6(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field6) <= m.xxx_LenField6 {
+		newCapacity := 0
+		if len(m.field6) == 0 {
+			newCapacity = 8
+		} else if len(m.field6) < 1000000 {
+			newCapacity = m.xxx_LenField6 * 2
+		} else {
+			newCapacity = m.xxx_LenField6 + 1000000
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field1", wireType)
-			}
-			var v uint64
-			i := index + 8
-			if i > l {
-				return io.ErrUnexpectedEOF
-			}
-			index = i
-			v = uint64(data[i-8])
-			v |= uint64(data[i-7]) << 8
-			v |= uint64(data[i-6]) << 16
-			v |= uint64(data[i-5]) << 24
-			v |= uint64(data[i-4]) << 32
-			v |= uint64(data[i-3]) << 40
-			v |= uint64(data[i-2]) << 48
-			v |= uint64(data[i-1]) << 56
-			v2 := math1.Float64frombits(v)
-			m.Field1 = append(m.Field1, v2)
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field2", wireType)
-			}
-			var v uint32
-			i := index + 4
-			if i > l {
-				return io.ErrUnexpectedEOF
-			}
-			index = i
-			v = uint32(data[i-4])
-			v |= uint32(data[i-3]) << 8
-			v |= uint32(data[i-2]) << 16
-			v |= uint32(data[i-1]) << 24
-			v2 := math1.Float32frombits(v)
-			m.Field2 = append(m.Field2, v2)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field3", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field3 = append(m.Field3, v)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field4", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field4 = append(m.Field4, v)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field5", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field5 = append(m.Field5, v)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field6", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field6 = append(m.Field6, v)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field7", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.Field7 = append(m.Field7, v)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field8", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-			m.Field8 = append(m.Field8, int64(v))
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field9", wireType)
-			}
-			var v uint32
-			i := index + 4
-			if i > l {
-				return io.ErrUnexpectedEOF
-			}
-			index = i
-			v = uint32(data[i-4])
-			v |= uint32(data[i-3]) << 8
-			v |= uint32(data[i-2]) << 16
-			v |= uint32(data[i-1]) << 24
-			m.Field9 = append(m.Field9, v)
-		case 10:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field10", wireType)
-			}
-			var v int32
-			i := index + 4
-			if i > l {
-				return io.ErrUnexpectedEOF
-			}
-			index = i
-			v = int32(data[i-4])
-			v |= int32(data[i-3]) << 8
-			v |= int32(data[i-2]) << 16
-			v |= int32(data[i-1]) << 24
-			m.Field10 = append(m.Field10, v)
-		case 11:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field11", wireType)
-			}
-			var v uint64
-			i := index + 8
-			if i > l {
-				return io.ErrUnexpectedEOF
-			}
-			index = i
-			v = uint64(data[i-8])
-			v |= uint64(data[i-7]) << 8
-			v |= uint64(data[i-6]) << 16
-			v |= uint64(data[i-5]) << 24
-			v |= uint64(data[i-4]) << 32
-			v |= uint64(data[i-3]) << 40
-			v |= uint64(data[i-2]) << 48
-			v |= uint64(data[i-1]) << 56
-			m.Field11 = append(m.Field11, v)
-		case 12:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field12", wireType)
-			}
-			var v int64
-			i := index + 8
-			if i > l {
-				return io.ErrUnexpectedEOF
-			}
-			index = i
-			v = int64(data[i-8])
-			v |= int64(data[i-7]) << 8
-			v |= int64(data[i-6]) << 16
-			v |= int64(data[i-5]) << 24
-			v |= int64(data[i-4]) << 32
-			v |= int64(data[i-3]) << 40
-			v |= int64(data[i-2]) << 48
-			v |= int64(data[i-1]) << 56
-			m.Field12 = append(m.Field12, v)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field13", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field13 = append(m.Field13, bool(v != 0))
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
-			}
-			index -= sizeOfWire
-			skippy, err := dropbox_gogoprotobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field6)
+		m.field6 = t
+	}
+	m.field6[m.xxx_LenField6] = value
+	m.xxx_LenField6 += 1
+	return nil
+}
+
+func (m *NinRepPackedNative) SetField6(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field6[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field6Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField6
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField6() {
+	if m != nil {
+		m.xxx_LenField6 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField6(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field6[index], nil
+}
+
+func (m *NinRepPackedNative) AddField7(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field7) <= m.xxx_LenField7 {
+		newCapacity := 0
+		if len(m.field7) == 0 {
+			newCapacity = 8
+		} else if len(m.field7) < 1000000 {
+			newCapacity = m.xxx_LenField7 * 2
+		} else {
+			newCapacity = m.xxx_LenField7 + 1000000
 		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field7)
+		m.field7 = t
 	}
+	m.field7[m.xxx_LenField7] = value
+	m.xxx_LenField7 += 1
 	return nil
 }
-func (m *NinRepPackedNative) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *NinRepPackedNative) SetField7(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field7[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field7Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField7
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField7() {
+	if m != nil {
+		m.xxx_LenField7 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField7(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field7[index], nil
+}
+
+func (m *NinRepPackedNative) AddField8(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field8) <= m.xxx_LenField8 {
+		newCapacity := 0
+		if len(m.field8) == 0 {
+			newCapacity = 8
+		} else if len(m.field8) < 1000000 {
+			newCapacity = m.xxx_LenField8 * 2
+		} else {
+			newCapacity = m.xxx_LenField8 + 1000000
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint64
-					i := index + 8
-					if i > l {
-						return io.ErrUnexpectedEOF
-					}
-					index = i
-					v = uint64(data[i-8])
-					v |= uint64(data[i-7]) << 8
-					v |= uint64(data[i-6]) << 16
-					v |= uint64(data[i-5]) << 24
-					v |= uint64(data[i-4]) << 32
-					v |= uint64(data[i-3]) << 40
-					v |= uint64(data[i-2]) << 48
-					v |= uint64(data[i-1]) << 56
-					v2 := math1.Float64frombits(v)
-					m.Field1 = append(m.Field1, v2)
-				}
-			} else if wireType == 1 {
-				var v uint64
-				i := index + 8
-				if i > l {
-					return io.ErrUnexpectedEOF
-				}
-				index = i
-				v = uint64(data[i-8])
-				v |= uint64(data[i-7]) << 8
-				v |= uint64(data[i-6]) << 16
-				v |= uint64(data[i-5]) << 24
-				v |= uint64(data[i-4]) << 32
-				v |= uint64(data[i-3]) << 40
-				v |= uint64(data[i-2]) << 48
-				v |= uint64(data[i-1]) << 56
-				v2 := math1.Float64frombits(v)
-				m.Field1 = append(m.Field1, v2)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field1", wireType)
-			}
-		case 2:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint32
-					i := index + 4
-					if i > l {
-						return io.ErrUnexpectedEOF
-					}
-					index = i
-					v = uint32(data[i-4])
-					v |= uint32(data[i-3]) << 8
-					v |= uint32(data[i-2]) << 16
-					v |= uint32(data[i-1]) << 24
-					v2 := math1.Float32frombits(v)
-					m.Field2 = append(m.Field2, v2)
-				}
-			} else if wireType == 5 {
-				var v uint32
-				i := index + 4
-				if i > l {
-					return io.ErrUnexpectedEOF
-				}
-				index = i
-				v = uint32(data[i-4])
-				v |= uint32(data[i-3]) << 8
-				v |= uint32(data[i-2]) << 16
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field8)
+		m.field8 = t
+	}
+	m.field8[m.xxx_LenField8] = value
+	m.xxx_LenField8 += 1
+	return nil
+}
+
+func (m *NinRepPackedNative) SetField8(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field8[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field8Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField8
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField8() {
+	if m != nil {
+		m.xxx_LenField8 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField8(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field8[index], nil
+}
+
+func (m *NinRepPackedNative) AddField9(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field9) <= m.xxx_LenField9 {
+		newCapacity := 0
+		if len(m.field9) == 0 {
+			newCapacity = 8
+		} else if len(m.field9) < 1000000 {
+			newCapacity = m.xxx_LenField9 * 2
+		} else {
+			newCapacity = m.xxx_LenField9 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field9)
+		m.field9 = t
+	}
+	m.field9[m.xxx_LenField9] = value
+	m.xxx_LenField9 += 1
+	return nil
+}
+
+func (m *NinRepPackedNative) SetField9(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field9[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field9Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField9
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField9() {
+	if m != nil {
+		m.xxx_LenField9 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField9(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field9[index], nil
+}
+
+func (m *NinRepPackedNative) AddField10(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field10) <= m.xxx_LenField10 {
+		newCapacity := 0
+		if len(m.field10) == 0 {
+			newCapacity = 8
+		} else if len(m.field10) < 1000000 {
+			newCapacity = m.xxx_LenField10 * 2
+		} else {
+			newCapacity = m.xxx_LenField10 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field10)
+		m.field10 = t
+	}
+	m.field10[m.xxx_LenField10] = value
+	m.xxx_LenField10 += 1
+	return nil
+}
+
+func (m *NinRepPackedNative) SetField10(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field10[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field10Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField10
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField10() {
+	if m != nil {
+		m.xxx_LenField10 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField10(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field10[index], nil
+}
+
+func (m *NinRepPackedNative) AddField11(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field11) <= m.xxx_LenField11 {
+		newCapacity := 0
+		if len(m.field11) == 0 {
+			newCapacity = 8
+		} else if len(m.field11) < 1000000 {
+			newCapacity = m.xxx_LenField11 * 2
+		} else {
+			newCapacity = m.xxx_LenField11 + 1000000
+		}
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field11)
+		m.field11 = t
+	}
+	m.field11[m.xxx_LenField11] = value
+	m.xxx_LenField11 += 1
+	return nil
+}
+
+func (m *NinRepPackedNative) SetField11(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field11[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field11Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField11
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField11() {
+	if m != nil {
+		m.xxx_LenField11 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField11(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field11[index], nil
+}
+
+func (m *NinRepPackedNative) AddField12(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field12) <= m.xxx_LenField12 {
+		newCapacity := 0
+		if len(m.field12) == 0 {
+			newCapacity = 8
+		} else if len(m.field12) < 1000000 {
+			newCapacity = m.xxx_LenField12 * 2
+		} else {
+			newCapacity = m.xxx_LenField12 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field12)
+		m.field12 = t
+	}
+	m.field12[m.xxx_LenField12] = value
+	m.xxx_LenField12 += 1
+	return nil
+}
+
+func (m *NinRepPackedNative) SetField12(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field12[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field12Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField12
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField12() {
+	if m != nil {
+		m.xxx_LenField12 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField12(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field12[index], nil
+}
+
+func (m *NinRepPackedNative) AddField13(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field13) <= m.xxx_LenField13 {
+		newCapacity := 0
+		if len(m.field13) == 0 {
+			newCapacity = 8
+		} else if len(m.field13) < 1000000 {
+			newCapacity = m.xxx_LenField13 * 2
+		} else {
+			newCapacity = m.xxx_LenField13 + 1000000
+		}
+		t := make([]bool, newCapacity, newCapacity)
+		copy(t, m.field13)
+		m.field13 = t
+	}
+	m.field13[m.xxx_LenField13] = value
+	m.xxx_LenField13 += 1
+	return nil
+}
+
+func (m *NinRepPackedNative) SetField13(value bool, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field13[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNative) Field13Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField13
+	}
+	return 0
+}
+
+func (m *NinRepPackedNative) ClearField13() {
+	if m != nil {
+		m.xxx_LenField13 = 0
+	}
+}
+
+func (m *NinRepPackedNative) GetField13(index int) (field bool, err error) {
+	if m == nil {
+		return false, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return false, errors.New("Index is out of bounds")
+	}
+	return m.field13[index], nil
+}
+
+func (m *NinRepPackedNative) Clear() {
+	if m != nil {
+		m.ClearField1()
+		m.ClearField2()
+		m.ClearField3()
+		m.ClearField4()
+		m.ClearField5()
+		m.ClearField6()
+		m.ClearField7()
+		m.ClearField8()
+		m.ClearField9()
+		m.ClearField10()
+		m.ClearField11()
+		m.ClearField12()
+		m.ClearField13()
+	}
+}
+
+type NinRepNativeUnsafe struct {
+	xxx_sizeCached   int
+	field1           []float64
+	field2           []float32
+	field3           []int32
+	field4           []int64
+	field5           []uint32
+	field6           []uint64
+	field7           []int32
+	field8           []int64
+	field9           []uint32
+	field10          []int32
+	field11          []uint64
+	field12          []int64
+	field13          []bool
+	XXX_unrecognized []byte
+	xxx_LenField1    int
+	xxx_LenField2    int
+	xxx_LenField3    int
+	xxx_LenField4    int
+	xxx_LenField5    int
+	xxx_LenField6    int
+	xxx_LenField7    int
+	xxx_LenField8    int
+	xxx_LenField9    int
+	xxx_LenField10   int
+	xxx_LenField11   int
+	xxx_LenField12   int
+	xxx_LenField13   int
+}
+
+func (m *NinRepNativeUnsafe) Reset()         { *m = NinRepNativeUnsafe{} }
+func (m *NinRepNativeUnsafe) String() string { return proto.CompactTextString(m) }
+func (*NinRepNativeUnsafe) ProtoMessage()    {}
+
+func (m *NinRepNativeUnsafe) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *NinRepNativeUnsafe) AddField1(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field1) <= m.xxx_LenField1 {
+		newCapacity := 0
+		if len(m.field1) == 0 {
+			newCapacity = 8
+		} else if len(m.field1) < 1000000 {
+			newCapacity = m.xxx_LenField1 * 2
+		} else {
+			newCapacity = m.xxx_LenField1 + 1000000
+		}
+		t := make([]float64, newCapacity, newCapacity)
+		copy(t, m.field1)
+		m.field1 = t
+	}
+	m.field1[m.xxx_LenField1] = value
+	m.xxx_LenField1 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField1(value float64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field1[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field1Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField1
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField1() {
+	if m != nil {
+		m.xxx_LenField1 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField1(index int) (field float64, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return 0.0, errors.New("Index is out of bounds")
+	}
+	return m.field1[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField2(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field2) <= m.xxx_LenField2 {
+		newCapacity := 0
+		if len(m.field2) == 0 {
+			newCapacity = 8
+		} else if len(m.field2) < 1000000 {
+			newCapacity = m.xxx_LenField2 * 2
+		} else {
+			newCapacity = m.xxx_LenField2 + 1000000
+		}
+		t := make([]float32, newCapacity, newCapacity)
+		copy(t, m.field2)
+		m.field2 = t
+	}
+	m.field2[m.xxx_LenField2] = value
+	m.xxx_LenField2 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField2(value float32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field2[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field2Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField2
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField2() {
+	if m != nil {
+		m.xxx_LenField2 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField2(index int) (field float32, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return 0.0, errors.New("Index is out of bounds")
+	}
+	return m.field2[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField3(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field3) <= m.xxx_LenField3 {
+		newCapacity := 0
+		if len(m.field3) == 0 {
+			newCapacity = 8
+		} else if len(m.field3) < 1000000 {
+			newCapacity = m.xxx_LenField3 * 2
+		} else {
+			newCapacity = m.xxx_LenField3 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field3)
+		m.field3 = t
+	}
+	m.field3[m.xxx_LenField3] = value
+	m.xxx_LenField3 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField3(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field3[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field3Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField3
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField3() {
+	if m != nil {
+		m.xxx_LenField3 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField3(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field3[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField4(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field4) <= m.xxx_LenField4 {
+		newCapacity := 0
+		if len(m.field4) == 0 {
+			newCapacity = 8
+		} else if len(m.field4) < 1000000 {
+			newCapacity = m.xxx_LenField4 * 2
+		} else {
+			newCapacity = m.xxx_LenField4 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field4)
+		m.field4 = t
+	}
+	m.field4[m.xxx_LenField4] = value
+	m.xxx_LenField4 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField4(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field4[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field4Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField4
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField4() {
+	if m != nil {
+		m.xxx_LenField4 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField4(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field4[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField5(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field5) <= m.xxx_LenField5 {
+		newCapacity := 0
+		if len(m.field5) == 0 {
+			newCapacity = 8
+		} else if len(m.field5) < 1000000 {
+			newCapacity = m.xxx_LenField5 * 2
+		} else {
+			newCapacity = m.xxx_LenField5 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field5)
+		m.field5 = t
+	}
+	m.field5[m.xxx_LenField5] = value
+	m.xxx_LenField5 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField5(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field5[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field5Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField5
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField5() {
+	if m != nil {
+		m.xxx_LenField5 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField5(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field5[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField6(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field6) <= m.xxx_LenField6 {
+		newCapacity := 0
+		if len(m.field6) == 0 {
+			newCapacity = 8
+		} else if len(m.field6) < 1000000 {
+			newCapacity = m.xxx_LenField6 * 2
+		} else {
+			newCapacity = m.xxx_LenField6 + 1000000
+		}
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field6)
+		m.field6 = t
+	}
+	m.field6[m.xxx_LenField6] = value
+	m.xxx_LenField6 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField6(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field6[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field6Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField6
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField6() {
+	if m != nil {
+		m.xxx_LenField6 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField6(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field6[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField7(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field7) <= m.xxx_LenField7 {
+		newCapacity := 0
+		if len(m.field7) == 0 {
+			newCapacity = 8
+		} else if len(m.field7) < 1000000 {
+			newCapacity = m.xxx_LenField7 * 2
+		} else {
+			newCapacity = m.xxx_LenField7 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field7)
+		m.field7 = t
+	}
+	m.field7[m.xxx_LenField7] = value
+	m.xxx_LenField7 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField7(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field7[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field7Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField7
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField7() {
+	if m != nil {
+		m.xxx_LenField7 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField7(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field7[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField8(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field8) <= m.xxx_LenField8 {
+		newCapacity := 0
+		if len(m.field8) == 0 {
+			newCapacity = 8
+		} else if len(m.field8) < 1000000 {
+			newCapacity = m.xxx_LenField8 * 2
+		} else {
+			newCapacity = m.xxx_LenField8 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field8)
+		m.field8 = t
+	}
+	m.field8[m.xxx_LenField8] = value
+	m.xxx_LenField8 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField8(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field8[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field8Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField8
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField8() {
+	if m != nil {
+		m.xxx_LenField8 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField8(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field8[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField9(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field9) <= m.xxx_LenField9 {
+		newCapacity := 0
+		if len(m.field9) == 0 {
+			newCapacity = 8
+		} else if len(m.field9) < 1000000 {
+			newCapacity = m.xxx_LenField9 * 2
+		} else {
+			newCapacity = m.xxx_LenField9 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field9)
+		m.field9 = t
+	}
+	m.field9[m.xxx_LenField9] = value
+	m.xxx_LenField9 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField9(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field9[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field9Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField9
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField9() {
+	if m != nil {
+		m.xxx_LenField9 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField9(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field9[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField10(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field10) <= m.xxx_LenField10 {
+		newCapacity := 0
+		if len(m.field10) == 0 {
+			newCapacity = 8
+		} else if len(m.field10) < 1000000 {
+			newCapacity = m.xxx_LenField10 * 2
+		} else {
+			newCapacity = m.xxx_LenField10 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field10)
+		m.field10 = t
+	}
+	m.field10[m.xxx_LenField10] = value
+	m.xxx_LenField10 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField10(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field10[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field10Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField10
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField10() {
+	if m != nil {
+		m.xxx_LenField10 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField10(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field10[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField11(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field11) <= m.xxx_LenField11 {
+		newCapacity := 0
+		if len(m.field11) == 0 {
+			newCapacity = 8
+		} else if len(m.field11) < 1000000 {
+			newCapacity = m.xxx_LenField11 * 2
+		} else {
+			newCapacity = m.xxx_LenField11 + 1000000
+		}
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field11)
+		m.field11 = t
+	}
+	m.field11[m.xxx_LenField11] = value
+	m.xxx_LenField11 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField11(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field11[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field11Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField11
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField11() {
+	if m != nil {
+		m.xxx_LenField11 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField11(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field11[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField12(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field12) <= m.xxx_LenField12 {
+		newCapacity := 0
+		if len(m.field12) == 0 {
+			newCapacity = 8
+		} else if len(m.field12) < 1000000 {
+			newCapacity = m.xxx_LenField12 * 2
+		} else {
+			newCapacity = m.xxx_LenField12 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field12)
+		m.field12 = t
+	}
+	m.field12[m.xxx_LenField12] = value
+	m.xxx_LenField12 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField12(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field12[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field12Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField12
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField12() {
+	if m != nil {
+		m.xxx_LenField12 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField12(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field12[index], nil
+}
+
+func (m *NinRepNativeUnsafe) AddField13(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field13) <= m.xxx_LenField13 {
+		newCapacity := 0
+		if len(m.field13) == 0 {
+			newCapacity = 8
+		} else if len(m.field13) < 1000000 {
+			newCapacity = m.xxx_LenField13 * 2
+		} else {
+			newCapacity = m.xxx_LenField13 + 1000000
+		}
+		t := make([]bool, newCapacity, newCapacity)
+		copy(t, m.field13)
+		m.field13 = t
+	}
+	m.field13[m.xxx_LenField13] = value
+	m.xxx_LenField13 += 1
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) SetField13(value bool, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field13[index] = value
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) Field13Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField13
+	}
+	return 0
+}
+
+func (m *NinRepNativeUnsafe) ClearField13() {
+	if m != nil {
+		m.xxx_LenField13 = 0
+	}
+}
+
+func (m *NinRepNativeUnsafe) GetField13(index int) (field bool, err error) {
+	if m == nil {
+		return false, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return false, errors.New("Index is out of bounds")
+	}
+	return m.field13[index], nil
+}
+
+func (m *NinRepNativeUnsafe) Clear() {
+	if m != nil {
+		m.ClearField1()
+		m.ClearField2()
+		m.ClearField3()
+		m.ClearField4()
+		m.ClearField5()
+		m.ClearField6()
+		m.ClearField7()
+		m.ClearField8()
+		m.ClearField9()
+		m.ClearField10()
+		m.ClearField11()
+		m.ClearField12()
+		m.ClearField13()
+	}
+}
+
+type NinRepPackedNativeUnsafe struct {
+	xxx_sizeCached   int
+	field1           []float64
+	field2           []float32
+	field3           []int32
+	field4           []int64
+	field5           []uint32
+	field6           []uint64
+	field7           []int32
+	field8           []int64
+	field9           []uint32
+	field10          []int32
+	field11          []uint64
+	field12          []int64
+	field13          []bool
+	XXX_unrecognized []byte
+	xxx_LenField1    int
+	xxx_LenField2    int
+	xxx_LenField3    int
+	xxx_LenField4    int
+	xxx_LenField5    int
+	xxx_LenField6    int
+	xxx_LenField7    int
+	xxx_LenField8    int
+	xxx_LenField9    int
+	xxx_LenField10   int
+	xxx_LenField11   int
+	xxx_LenField12   int
+	xxx_LenField13   int
+}
+
+func (m *NinRepPackedNativeUnsafe) Reset()         { *m = NinRepPackedNativeUnsafe{} }
+func (m *NinRepPackedNativeUnsafe) String() string { return proto.CompactTextString(m) }
+func (*NinRepPackedNativeUnsafe) ProtoMessage()    {}
+
+func (m *NinRepPackedNativeUnsafe) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField1(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field1) <= m.xxx_LenField1 {
+		newCapacity := 0
+		if len(m.field1) == 0 {
+			newCapacity = 8
+		} else if len(m.field1) < 1000000 {
+			newCapacity = m.xxx_LenField1 * 2
+		} else {
+			newCapacity = m.xxx_LenField1 + 1000000
+		}
+		t := make([]float64, newCapacity, newCapacity)
+		copy(t, m.field1)
+		m.field1 = t
+	}
+	m.field1[m.xxx_LenField1] = value
+	m.xxx_LenField1 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField1(value float64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field1[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field1Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField1
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField1() {
+	if m != nil {
+		m.xxx_LenField1 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField1(index int) (field float64, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField1 {
+		return 0.0, errors.New("Index is out of bounds")
+	}
+	return m.field1[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField2(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field2) <= m.xxx_LenField2 {
+		newCapacity := 0
+		if len(m.field2) == 0 {
+			newCapacity = 8
+		} else if len(m.field2) < 1000000 {
+			newCapacity = m.xxx_LenField2 * 2
+		} else {
+			newCapacity = m.xxx_LenField2 + 1000000
+		}
+		t := make([]float32, newCapacity, newCapacity)
+		copy(t, m.field2)
+		m.field2 = t
+	}
+	m.field2[m.xxx_LenField2] = value
+	m.xxx_LenField2 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField2(value float32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field2[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field2Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField2
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField2() {
+	if m != nil {
+		m.xxx_LenField2 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField2(index int) (field float32, err error) {
+	if m == nil {
+		return 0.0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField2 {
+		return 0.0, errors.New("Index is out of bounds")
+	}
+	return m.field2[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField3(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field3) <= m.xxx_LenField3 {
+		newCapacity := 0
+		if len(m.field3) == 0 {
+			newCapacity = 8
+		} else if len(m.field3) < 1000000 {
+			newCapacity = m.xxx_LenField3 * 2
+		} else {
+			newCapacity = m.xxx_LenField3 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field3)
+		m.field3 = t
+	}
+	m.field3[m.xxx_LenField3] = value
+	m.xxx_LenField3 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField3(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field3[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field3Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField3
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField3() {
+	if m != nil {
+		m.xxx_LenField3 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField3(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField3 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field3[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField4(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field4) <= m.xxx_LenField4 {
+		newCapacity := 0
+		if len(m.field4) == 0 {
+			newCapacity = 8
+		} else if len(m.field4) < 1000000 {
+			newCapacity = m.xxx_LenField4 * 2
+		} else {
+			newCapacity = m.xxx_LenField4 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field4)
+		m.field4 = t
+	}
+	m.field4[m.xxx_LenField4] = value
+	m.xxx_LenField4 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField4(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field4[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field4Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField4
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField4() {
+	if m != nil {
+		m.xxx_LenField4 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField4(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField4 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field4[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField5(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field5) <= m.xxx_LenField5 {
+		newCapacity := 0
+		if len(m.field5) == 0 {
+			newCapacity = 8
+		} else if len(m.field5) < 1000000 {
+			newCapacity = m.xxx_LenField5 * 2
+		} else {
+			newCapacity = m.xxx_LenField5 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field5)
+		m.field5 = t
+	}
+	m.field5[m.xxx_LenField5] = value
+	m.xxx_LenField5 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField5(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field5[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field5Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField5
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField5() {
+	if m != nil {
+		m.xxx_LenField5 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField5(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField5 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field5[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField6(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field6) <= m.xxx_LenField6 {
+		newCapacity := 0
+		if len(m.field6) == 0 {
+			newCapacity = 8
+		} else if len(m.field6) < 1000000 {
+			newCapacity = m.xxx_LenField6 * 2
+		} else {
+			newCapacity = m.xxx_LenField6 + 1000000
+		}
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field6)
+		m.field6 = t
+	}
+	m.field6[m.xxx_LenField6] = value
+	m.xxx_LenField6 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField6(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field6[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field6Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField6
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField6() {
+	if m != nil {
+		m.xxx_LenField6 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField6(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField6 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field6[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField7(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field7) <= m.xxx_LenField7 {
+		newCapacity := 0
+		if len(m.field7) == 0 {
+			newCapacity = 8
+		} else if len(m.field7) < 1000000 {
+			newCapacity = m.xxx_LenField7 * 2
+		} else {
+			newCapacity = m.xxx_LenField7 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field7)
+		m.field7 = t
+	}
+	m.field7[m.xxx_LenField7] = value
+	m.xxx_LenField7 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField7(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field7[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field7Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField7
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField7() {
+	if m != nil {
+		m.xxx_LenField7 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField7(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField7 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field7[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField8(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field8) <= m.xxx_LenField8 {
+		newCapacity := 0
+		if len(m.field8) == 0 {
+			newCapacity = 8
+		} else if len(m.field8) < 1000000 {
+			newCapacity = m.xxx_LenField8 * 2
+		} else {
+			newCapacity = m.xxx_LenField8 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field8)
+		m.field8 = t
+	}
+	m.field8[m.xxx_LenField8] = value
+	m.xxx_LenField8 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField8(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field8[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field8Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField8
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField8() {
+	if m != nil {
+		m.xxx_LenField8 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField8(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField8 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field8[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField9(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field9) <= m.xxx_LenField9 {
+		newCapacity := 0
+		if len(m.field9) == 0 {
+			newCapacity = 8
+		} else if len(m.field9) < 1000000 {
+			newCapacity = m.xxx_LenField9 * 2
+		} else {
+			newCapacity = m.xxx_LenField9 + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.field9)
+		m.field9 = t
+	}
+	m.field9[m.xxx_LenField9] = value
+	m.xxx_LenField9 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField9(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field9[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field9Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField9
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField9() {
+	if m != nil {
+		m.xxx_LenField9 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField9(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField9 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field9[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField10(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field10) <= m.xxx_LenField10 {
+		newCapacity := 0
+		if len(m.field10) == 0 {
+			newCapacity = 8
+		} else if len(m.field10) < 1000000 {
+			newCapacity = m.xxx_LenField10 * 2
+		} else {
+			newCapacity = m.xxx_LenField10 + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.field10)
+		m.field10 = t
+	}
+	m.field10[m.xxx_LenField10] = value
+	m.xxx_LenField10 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField10(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field10[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field10Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField10
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField10() {
+	if m != nil {
+		m.xxx_LenField10 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField10(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField10 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field10[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField11(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field11) <= m.xxx_LenField11 {
+		newCapacity := 0
+		if len(m.field11) == 0 {
+			newCapacity = 8
+		} else if len(m.field11) < 1000000 {
+			newCapacity = m.xxx_LenField11 * 2
+		} else {
+			newCapacity = m.xxx_LenField11 + 1000000
+		}
+		t := make([]uint64, newCapacity, newCapacity)
+		copy(t, m.field11)
+		m.field11 = t
+	}
+	m.field11[m.xxx_LenField11] = value
+	m.xxx_LenField11 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField11(value uint64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field11[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field11Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField11
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField11() {
+	if m != nil {
+		m.xxx_LenField11 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField11(index int) (field uint64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField11 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field11[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField12(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field12) <= m.xxx_LenField12 {
+		newCapacity := 0
+		if len(m.field12) == 0 {
+			newCapacity = 8
+		} else if len(m.field12) < 1000000 {
+			newCapacity = m.xxx_LenField12 * 2
+		} else {
+			newCapacity = m.xxx_LenField12 + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.field12)
+		m.field12 = t
+	}
+	m.field12[m.xxx_LenField12] = value
+	m.xxx_LenField12 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField12(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field12[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field12Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField12
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField12() {
+	if m != nil {
+		m.xxx_LenField12 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField12(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField12 {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.field12[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) AddField13(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.field13) <= m.xxx_LenField13 {
+		newCapacity := 0
+		if len(m.field13) == 0 {
+			newCapacity = 8
+		} else if len(m.field13) < 1000000 {
+			newCapacity = m.xxx_LenField13 * 2
+		} else {
+			newCapacity = m.xxx_LenField13 + 1000000
+		}
+		t := make([]bool, newCapacity, newCapacity)
+		copy(t, m.field13)
+		m.field13 = t
+	}
+	m.field13[m.xxx_LenField13] = value
+	m.xxx_LenField13 += 1
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) SetField13(value bool, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return errors.New("Index is out of bounds")
+	}
+	m.field13[index] = value
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Field13Size() (size int) {
+	if m != nil {
+		return m.xxx_LenField13
+	}
+	return 0
+}
+
+func (m *NinRepPackedNativeUnsafe) ClearField13() {
+	if m != nil {
+		m.xxx_LenField13 = 0
+	}
+}
+
+func (m *NinRepPackedNativeUnsafe) GetField13(index int) (field bool, err error) {
+	if m == nil {
+		return false, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenField13 {
+		return false, errors.New("Index is out of bounds")
+	}
+	return m.field13[index], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) Clear() {
+	if m != nil {
+		m.ClearField1()
+		m.ClearField2()
+		m.ClearField3()
+		m.ClearField4()
+		m.ClearField5()
+		m.ClearField6()
+		m.ClearField7()
+		m.ClearField8()
+		m.ClearField9()
+		m.ClearField10()
+		m.ClearField11()
+		m.ClearField12()
+		m.ClearField13()
+	}
+}
+
+func (m *NinRepNative) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		n += 9 * m.xxx_LenField1
+	}
+	if m.xxx_LenField2 > 0 {
+		n += 5 * m.xxx_LenField2
+	}
+	if m.xxx_LenField3 > 0 {
+		for i := 0; i < m.xxx_LenField3; i++ {
+			e := m.field3[i]
+			n += 1 + sovPacked(uint64(uint32(e)))
+		}
+	}
+	if m.xxx_LenField4 > 0 {
+		for i := 0; i < m.xxx_LenField4; i++ {
+			e := m.field4[i]
+			n += 1 + sovPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField5 > 0 {
+		for i := 0; i < m.xxx_LenField5; i++ {
+			e := m.field5[i]
+			n += 1 + sovPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField6 > 0 {
+		for i := 0; i < m.xxx_LenField6; i++ {
+			e := m.field6[i]
+			n += 1 + sovPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField7 > 0 {
+		for i := 0; i < m.xxx_LenField7; i++ {
+			e := m.field7[i]
+			n += 1 + sozPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField8 > 0 {
+		for i := 0; i < m.xxx_LenField8; i++ {
+			e := m.field8[i]
+			n += 1 + sozPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField9 > 0 {
+		n += 5 * m.xxx_LenField9
+	}
+	if m.xxx_LenField10 > 0 {
+		n += 5 * m.xxx_LenField10
+	}
+	if m.xxx_LenField11 > 0 {
+		n += 9 * m.xxx_LenField11
+	}
+	if m.xxx_LenField12 > 0 {
+		n += 9 * m.xxx_LenField12
+	}
+	if m.xxx_LenField13 > 0 {
+		n += 2 * m.xxx_LenField13
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *NinRepPackedNative) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField1*8)) + m.xxx_LenField1*8
+	}
+	if m.xxx_LenField2 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField2*4)) + m.xxx_LenField2*4
+	}
+	if m.xxx_LenField3 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField3; i++ {
+			e := m.field3[i]
+			l += sovPacked(uint64(uint32(e)))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField4 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField4; i++ {
+			e := m.field4[i]
+			l += sovPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField5 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField5; i++ {
+			e := m.field5[i]
+			l += sovPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField6 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField6; i++ {
+			e := m.field6[i]
+			l += sovPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField7 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField7; i++ {
+			e := m.field7[i]
+			l += sozPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField8 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField8; i++ {
+			e := m.field8[i]
+			l += sozPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField9 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField9*4)) + m.xxx_LenField9*4
+	}
+	if m.xxx_LenField10 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField10*4)) + m.xxx_LenField10*4
+	}
+	if m.xxx_LenField11 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField11*8)) + m.xxx_LenField11*8
+	}
+	if m.xxx_LenField12 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField12*8)) + m.xxx_LenField12*8
+	}
+	if m.xxx_LenField13 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField13)) + m.xxx_LenField13*1
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *NinRepNativeUnsafe) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		n += 9 * m.xxx_LenField1
+	}
+	if m.xxx_LenField2 > 0 {
+		n += 5 * m.xxx_LenField2
+	}
+	if m.xxx_LenField3 > 0 {
+		for i := 0; i < m.xxx_LenField3; i++ {
+			e := m.field3[i]
+			n += 1 + sovPacked(uint64(uint32(e)))
+		}
+	}
+	if m.xxx_LenField4 > 0 {
+		for i := 0; i < m.xxx_LenField4; i++ {
+			e := m.field4[i]
+			n += 1 + sovPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField5 > 0 {
+		for i := 0; i < m.xxx_LenField5; i++ {
+			e := m.field5[i]
+			n += 1 + sovPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField6 > 0 {
+		for i := 0; i < m.xxx_LenField6; i++ {
+			e := m.field6[i]
+			n += 1 + sovPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField7 > 0 {
+		for i := 0; i < m.xxx_LenField7; i++ {
+			e := m.field7[i]
+			n += 1 + sozPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField8 > 0 {
+		for i := 0; i < m.xxx_LenField8; i++ {
+			e := m.field8[i]
+			n += 1 + sozPacked(uint64(e))
+		}
+	}
+	if m.xxx_LenField9 > 0 {
+		n += 5 * m.xxx_LenField9
+	}
+	if m.xxx_LenField10 > 0 {
+		n += 5 * m.xxx_LenField10
+	}
+	if m.xxx_LenField11 > 0 {
+		n += 9 * m.xxx_LenField11
+	}
+	if m.xxx_LenField12 > 0 {
+		n += 9 * m.xxx_LenField12
+	}
+	if m.xxx_LenField13 > 0 {
+		n += 2 * m.xxx_LenField13
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *NinRepPackedNativeUnsafe) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField1*8)) + m.xxx_LenField1*8
+	}
+	if m.xxx_LenField2 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField2*4)) + m.xxx_LenField2*4
+	}
+	if m.xxx_LenField3 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField3; i++ {
+			e := m.field3[i]
+			l += sovPacked(uint64(uint32(e)))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField4 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField4; i++ {
+			e := m.field4[i]
+			l += sovPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField5 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField5; i++ {
+			e := m.field5[i]
+			l += sovPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField6 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField6; i++ {
+			e := m.field6[i]
+			l += sovPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField7 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField7; i++ {
+			e := m.field7[i]
+			l += sozPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField8 > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenField8; i++ {
+			e := m.field8[i]
+			l += sozPacked(uint64(e))
+		}
+		n += 1 + sovPacked(uint64(l)) + l
+	}
+	if m.xxx_LenField9 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField9*4)) + m.xxx_LenField9*4
+	}
+	if m.xxx_LenField10 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField10*4)) + m.xxx_LenField10*4
+	}
+	if m.xxx_LenField11 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField11*8)) + m.xxx_LenField11*8
+	}
+	if m.xxx_LenField12 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField12*8)) + m.xxx_LenField12*8
+	}
+	if m.xxx_LenField13 > 0 {
+		n += 1 + sovPacked(uint64(m.xxx_LenField13)) + m.xxx_LenField13*1
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovPacked(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPacked(x uint64) (n int) {
+	return sovPacked(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NinRepNative) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NinRepNative) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *NinRepNative) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		for idx := 0; idx < m.xxx_LenField1; idx++ {
+			num := m.field1[idx]
+			data[i] = 0x9
+			i++
+			f1 := math.Float64bits(float64(num))
+			data[i] = uint8(f1)
+			i++
+			data[i] = uint8(f1 >> 8)
+			i++
+			data[i] = uint8(f1 >> 16)
+			i++
+			data[i] = uint8(f1 >> 24)
+			i++
+			data[i] = uint8(f1 >> 32)
+			i++
+			data[i] = uint8(f1 >> 40)
+			i++
+			data[i] = uint8(f1 >> 48)
+			i++
+			data[i] = uint8(f1 >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField2 > 0 {
+		for idx := 0; idx < m.xxx_LenField2; idx++ {
+			num := m.field2[idx]
+			data[i] = 0x15
+			i++
+			f2 := math.Float32bits(float32(num))
+			data[i] = uint8(f2)
+			i++
+			data[i] = uint8(f2 >> 8)
+			i++
+			data[i] = uint8(f2 >> 16)
+			i++
+			data[i] = uint8(f2 >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField3 > 0 {
+		for idx := 0; idx < m.xxx_LenField3; idx++ {
+			num := m.field3[idx]
+			data[i] = 0x18
+			i++
+			i = encodeVarintPacked(data, i, uint64(uint32(num)))
+		}
+	}
+	if m.xxx_LenField4 > 0 {
+		for idx := 0; idx < m.xxx_LenField4; idx++ {
+			num := m.field4[idx]
+			data[i] = 0x20
+			i++
+			i = encodeVarintPacked(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenField5 > 0 {
+		for idx := 0; idx < m.xxx_LenField5; idx++ {
+			num := m.field5[idx]
+			data[i] = 0x28
+			i++
+			i = encodeVarintPacked(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenField6 > 0 {
+		for idx := 0; idx < m.xxx_LenField6; idx++ {
+			num := m.field6[idx]
+			data[i] = 0x30
+			i++
+			i = encodeVarintPacked(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenField7 > 0 {
+		for idx := 0; idx < m.xxx_LenField7; idx++ {
+			num := m.field7[idx]
+			data[i] = 0x38
+			i++
+			x3 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x3 >= 1<<7 {
+				data[i] = uint8(uint64(x3)&0x7f | 0x80)
+				x3 >>= 7
+				i++
+			}
+			data[i] = uint8(x3)
+			i++
+		}
+	}
+	if m.xxx_LenField8 > 0 {
+		for idx := 0; idx < m.xxx_LenField8; idx++ {
+			num := m.field8[idx]
+			data[i] = 0x40
+			i++
+			x4 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x4 >= 1<<7 {
+				data[i] = uint8(uint64(x4)&0x7f | 0x80)
+				x4 >>= 7
+				i++
+			}
+			data[i] = uint8(x4)
+			i++
+		}
+	}
+	if m.xxx_LenField9 > 0 {
+		for idx := 0; idx < m.xxx_LenField9; idx++ {
+			num := m.field9[idx]
+			data[i] = 0x4d
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField10 > 0 {
+		for idx := 0; idx < m.xxx_LenField10; idx++ {
+			num := m.field10[idx]
+			data[i] = 0x55
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField11 > 0 {
+		for idx := 0; idx < m.xxx_LenField11; idx++ {
+			num := m.field11[idx]
+			data[i] = 0x59
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField12 > 0 {
+		for idx := 0; idx < m.xxx_LenField12; idx++ {
+			num := m.field12[idx]
+			data[i] = 0x61
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField13 > 0 {
+		for idx := 0; idx < m.xxx_LenField13; idx++ {
+			b := m.field13[idx]
+			data[i] = 0x68
+			i++
+			if b {
+				data[i] = 1
+			} else {
+				data[i] = 0
+			}
+			i++
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *NinRepPackedNative) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NinRepPackedNative) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *NinRepPackedNative) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField1*8))
+		for idx := 0; idx < m.xxx_LenField1; idx++ {
+			num := m.field1[idx]
+			f5 := math.Float64bits(float64(num))
+			data[i] = uint8(f5)
+			i++
+			data[i] = uint8(f5 >> 8)
+			i++
+			data[i] = uint8(f5 >> 16)
+			i++
+			data[i] = uint8(f5 >> 24)
+			i++
+			data[i] = uint8(f5 >> 32)
+			i++
+			data[i] = uint8(f5 >> 40)
+			i++
+			data[i] = uint8(f5 >> 48)
+			i++
+			data[i] = uint8(f5 >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField2 > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField2*4))
+		for idx := 0; idx < m.xxx_LenField2; idx++ {
+			num := m.field2[idx]
+			f6 := math.Float32bits(float32(num))
+			data[i] = uint8(f6)
+			i++
+			data[i] = uint8(f6 >> 8)
+			i++
+			data[i] = uint8(f6 >> 16)
+			i++
+			data[i] = uint8(f6 >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField3 > 0 {
+		data8 := make([]byte, m.xxx_LenField3*10)
+		var j7 int
+		for idx := 0; idx < m.xxx_LenField3; idx++ {
+			num := uint32(m.field3[idx])
+			for num >= 1<<7 {
+				data8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			data8[j7] = uint8(num)
+			j7++
+		}
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPacked(data, i, uint64(j7))
+		i += copy(data[i:], data8[:j7])
+	}
+	if m.xxx_LenField4 > 0 {
+		data10 := make([]byte, m.xxx_LenField4*10)
+		var j9 int
+		for idx := 0; idx < m.xxx_LenField4; idx++ {
+			num := uint64(m.field4[idx])
+			for num >= 1<<7 {
+				data10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			data10[j9] = uint8(num)
+			j9++
+		}
+		data[i] = 0x22
+		i++
+		i = encodeVarintPacked(data, i, uint64(j9))
+		i += copy(data[i:], data10[:j9])
+	}
+	if m.xxx_LenField5 > 0 {
+		data12 := make([]byte, m.xxx_LenField5*10)
+		var j11 int
+		for idx := 0; idx < m.xxx_LenField5; idx++ {
+			num := m.field5[idx]
+			for num >= 1<<7 {
+				data12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			data12[j11] = uint8(num)
+			j11++
+		}
+		data[i] = 0x2a
+		i++
+		i = encodeVarintPacked(data, i, uint64(j11))
+		i += copy(data[i:], data12[:j11])
+	}
+	if m.xxx_LenField6 > 0 {
+		data14 := make([]byte, m.xxx_LenField6*10)
+		var j13 int
+		for idx := 0; idx < m.xxx_LenField6; idx++ {
+			num := m.field6[idx]
+			for num >= 1<<7 {
+				data14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			data14[j13] = uint8(num)
+			j13++
+		}
+		data[i] = 0x32
+		i++
+		i = encodeVarintPacked(data, i, uint64(j13))
+		i += copy(data[i:], data14[:j13])
+	}
+	if m.xxx_LenField7 > 0 {
+		data15 := make([]byte, m.xxx_LenField7*5)
+		var j16 int
+		for idx := 0; idx < m.xxx_LenField7; idx++ {
+			num := m.field7[idx]
+			x17 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x17 >= 1<<7 {
+				data15[j16] = uint8(uint64(x17)&0x7f | 0x80)
+				j16++
+				x17 >>= 7
+			}
+			data15[j16] = uint8(x17)
+			j16++
+		}
+		data[i] = 0x3a
+		i++
+		i = encodeVarintPacked(data, i, uint64(j16))
+		i += copy(data[i:], data15[:j16])
+	}
+	if m.xxx_LenField8 > 0 {
+		var j18 int
+		data20 := make([]byte, m.xxx_LenField8*10)
+		for idx := 0; idx < m.xxx_LenField8; idx++ {
+			num := m.field8[idx]
+			x19 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x19 >= 1<<7 {
+				data20[j18] = uint8(uint64(x19)&0x7f | 0x80)
+				j18++
+				x19 >>= 7
+			}
+			data20[j18] = uint8(x19)
+			j18++
+		}
+		data[i] = 0x42
+		i++
+		i = encodeVarintPacked(data, i, uint64(j18))
+		i += copy(data[i:], data20[:j18])
+	}
+	if m.xxx_LenField9 > 0 {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField9*4))
+		for idx := 0; idx < m.xxx_LenField9; idx++ {
+			num := m.field9[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField10 > 0 {
+		data[i] = 0x52
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField10*4))
+		for idx := 0; idx < m.xxx_LenField10; idx++ {
+			num := m.field10[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField11 > 0 {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField11*8))
+		for idx := 0; idx < m.xxx_LenField11; idx++ {
+			num := m.field11[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField12 > 0 {
+		data[i] = 0x62
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField12*8))
+		for idx := 0; idx < m.xxx_LenField12; idx++ {
+			num := m.field12[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField13 > 0 {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField13))
+		for idx := 0; idx < m.xxx_LenField13; idx++ {
+			b := m.field13[idx]
+			if b {
+				data[i] = 1
+			} else {
+				data[i] = 0
+			}
+			i++
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *NinRepNativeUnsafe) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NinRepNativeUnsafe) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *NinRepNativeUnsafe) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		for idx := 0; idx < m.xxx_LenField1; idx++ {
+			num := m.field1[idx]
+			data[i] = 0x9
+			i++
+			f21 := math.Float64bits(float64(num))
+			data[i] = uint8(f21)
+			i++
+			data[i] = uint8(f21 >> 8)
+			i++
+			data[i] = uint8(f21 >> 16)
+			i++
+			data[i] = uint8(f21 >> 24)
+			i++
+			data[i] = uint8(f21 >> 32)
+			i++
+			data[i] = uint8(f21 >> 40)
+			i++
+			data[i] = uint8(f21 >> 48)
+			i++
+			data[i] = uint8(f21 >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField2 > 0 {
+		for idx := 0; idx < m.xxx_LenField2; idx++ {
+			num := m.field2[idx]
+			data[i] = 0x15
+			i++
+			f22 := math.Float32bits(float32(num))
+			data[i] = uint8(f22)
+			i++
+			data[i] = uint8(f22 >> 8)
+			i++
+			data[i] = uint8(f22 >> 16)
+			i++
+			data[i] = uint8(f22 >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField3 > 0 {
+		for idx := 0; idx < m.xxx_LenField3; idx++ {
+			num := m.field3[idx]
+			data[i] = 0x18
+			i++
+			i = encodeVarintPacked(data, i, uint64(uint32(num)))
+		}
+	}
+	if m.xxx_LenField4 > 0 {
+		for idx := 0; idx < m.xxx_LenField4; idx++ {
+			num := m.field4[idx]
+			data[i] = 0x20
+			i++
+			i = encodeVarintPacked(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenField5 > 0 {
+		for idx := 0; idx < m.xxx_LenField5; idx++ {
+			num := m.field5[idx]
+			data[i] = 0x28
+			i++
+			i = encodeVarintPacked(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenField6 > 0 {
+		for idx := 0; idx < m.xxx_LenField6; idx++ {
+			num := m.field6[idx]
+			data[i] = 0x30
+			i++
+			i = encodeVarintPacked(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenField7 > 0 {
+		for idx := 0; idx < m.xxx_LenField7; idx++ {
+			num := m.field7[idx]
+			data[i] = 0x38
+			i++
+			x23 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x23 >= 1<<7 {
+				data[i] = uint8(uint64(x23)&0x7f | 0x80)
+				x23 >>= 7
+				i++
+			}
+			data[i] = uint8(x23)
+			i++
+		}
+	}
+	if m.xxx_LenField8 > 0 {
+		for idx := 0; idx < m.xxx_LenField8; idx++ {
+			num := m.field8[idx]
+			data[i] = 0x40
+			i++
+			x24 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x24 >= 1<<7 {
+				data[i] = uint8(uint64(x24)&0x7f | 0x80)
+				x24 >>= 7
+				i++
+			}
+			data[i] = uint8(x24)
+			i++
+		}
+	}
+	if m.xxx_LenField9 > 0 {
+		for idx := 0; idx < m.xxx_LenField9; idx++ {
+			num := m.field9[idx]
+			data[i] = 0x4d
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField10 > 0 {
+		for idx := 0; idx < m.xxx_LenField10; idx++ {
+			num := m.field10[idx]
+			data[i] = 0x55
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField11 > 0 {
+		for idx := 0; idx < m.xxx_LenField11; idx++ {
+			num := m.field11[idx]
+			data[i] = 0x59
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField12 > 0 {
+		for idx := 0; idx < m.xxx_LenField12; idx++ {
+			num := m.field12[idx]
+			data[i] = 0x61
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField13 > 0 {
+		for idx := 0; idx < m.xxx_LenField13; idx++ {
+			b := m.field13[idx]
+			data[i] = 0x68
+			i++
+			if b {
+				data[i] = 1
+			} else {
+				data[i] = 0
+			}
+			i++
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *NinRepPackedNativeUnsafe) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NinRepPackedNativeUnsafe) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *NinRepPackedNativeUnsafe) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenField1 > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField1*8))
+		for idx := 0; idx < m.xxx_LenField1; idx++ {
+			num := m.field1[idx]
+			f25 := math.Float64bits(float64(num))
+			data[i] = uint8(f25)
+			i++
+			data[i] = uint8(f25 >> 8)
+			i++
+			data[i] = uint8(f25 >> 16)
+			i++
+			data[i] = uint8(f25 >> 24)
+			i++
+			data[i] = uint8(f25 >> 32)
+			i++
+			data[i] = uint8(f25 >> 40)
+			i++
+			data[i] = uint8(f25 >> 48)
+			i++
+			data[i] = uint8(f25 >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField2 > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField2*4))
+		for idx := 0; idx < m.xxx_LenField2; idx++ {
+			num := m.field2[idx]
+			f26 := math.Float32bits(float32(num))
+			data[i] = uint8(f26)
+			i++
+			data[i] = uint8(f26 >> 8)
+			i++
+			data[i] = uint8(f26 >> 16)
+			i++
+			data[i] = uint8(f26 >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField3 > 0 {
+		data28 := make([]byte, m.xxx_LenField3*10)
+		var j27 int
+		for idx := 0; idx < m.xxx_LenField3; idx++ {
+			num := uint32(m.field3[idx])
+			for num >= 1<<7 {
+				data28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			data28[j27] = uint8(num)
+			j27++
+		}
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPacked(data, i, uint64(j27))
+		i += copy(data[i:], data28[:j27])
+	}
+	if m.xxx_LenField4 > 0 {
+		data30 := make([]byte, m.xxx_LenField4*10)
+		var j29 int
+		for idx := 0; idx < m.xxx_LenField4; idx++ {
+			num := uint64(m.field4[idx])
+			for num >= 1<<7 {
+				data30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			data30[j29] = uint8(num)
+			j29++
+		}
+		data[i] = 0x22
+		i++
+		i = encodeVarintPacked(data, i, uint64(j29))
+		i += copy(data[i:], data30[:j29])
+	}
+	if m.xxx_LenField5 > 0 {
+		data32 := make([]byte, m.xxx_LenField5*10)
+		var j31 int
+		for idx := 0; idx < m.xxx_LenField5; idx++ {
+			num := m.field5[idx]
+			for num >= 1<<7 {
+				data32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			data32[j31] = uint8(num)
+			j31++
+		}
+		data[i] = 0x2a
+		i++
+		i = encodeVarintPacked(data, i, uint64(j31))
+		i += copy(data[i:], data32[:j31])
+	}
+	if m.xxx_LenField6 > 0 {
+		data34 := make([]byte, m.xxx_LenField6*10)
+		var j33 int
+		for idx := 0; idx < m.xxx_LenField6; idx++ {
+			num := m.field6[idx]
+			for num >= 1<<7 {
+				data34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			data34[j33] = uint8(num)
+			j33++
+		}
+		data[i] = 0x32
+		i++
+		i = encodeVarintPacked(data, i, uint64(j33))
+		i += copy(data[i:], data34[:j33])
+	}
+	if m.xxx_LenField7 > 0 {
+		data35 := make([]byte, m.xxx_LenField7*5)
+		var j36 int
+		for idx := 0; idx < m.xxx_LenField7; idx++ {
+			num := m.field7[idx]
+			x37 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x37 >= 1<<7 {
+				data35[j36] = uint8(uint64(x37)&0x7f | 0x80)
+				j36++
+				x37 >>= 7
+			}
+			data35[j36] = uint8(x37)
+			j36++
+		}
+		data[i] = 0x3a
+		i++
+		i = encodeVarintPacked(data, i, uint64(j36))
+		i += copy(data[i:], data35[:j36])
+	}
+	if m.xxx_LenField8 > 0 {
+		var j38 int
+		data40 := make([]byte, m.xxx_LenField8*10)
+		for idx := 0; idx < m.xxx_LenField8; idx++ {
+			num := m.field8[idx]
+			x39 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x39 >= 1<<7 {
+				data40[j38] = uint8(uint64(x39)&0x7f | 0x80)
+				j38++
+				x39 >>= 7
+			}
+			data40[j38] = uint8(x39)
+			j38++
+		}
+		data[i] = 0x42
+		i++
+		i = encodeVarintPacked(data, i, uint64(j38))
+		i += copy(data[i:], data40[:j38])
+	}
+	if m.xxx_LenField9 > 0 {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField9*4))
+		for idx := 0; idx < m.xxx_LenField9; idx++ {
+			num := m.field9[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField10 > 0 {
+		data[i] = 0x52
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField10*4))
+		for idx := 0; idx < m.xxx_LenField10; idx++ {
+			num := m.field10[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_LenField11 > 0 {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField11*8))
+		for idx := 0; idx < m.xxx_LenField11; idx++ {
+			num := m.field11[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField12 > 0 {
+		data[i] = 0x62
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField12*8))
+		for idx := 0; idx < m.xxx_LenField12; idx++ {
+			num := m.field12[idx]
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+			data[i] = uint8(num >> 32)
+			i++
+			data[i] = uint8(num >> 40)
+			i++
+			data[i] = uint8(num >> 48)
+			i++
+			data[i] = uint8(num >> 56)
+			i++
+		}
+	}
+	if m.xxx_LenField13 > 0 {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintPacked(data, i, uint64(m.xxx_LenField13))
+		for idx := 0; idx < m.xxx_LenField13; idx++ {
+			b := m.field13[idx]
+			if b {
+				data[i] = 1
+			} else {
+				data[i] = 0
+			}
+			i++
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Packed(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Packed(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPacked(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *NinRepNative) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					v2 := math.Float64frombits(v)
+					if len(m.field1) <= m.xxx_LenField1 {
+						newCapacity := 0
+						if len(m.field1) == 0 {
+							newCapacity = 8
+						} else if len(m.field1) < 1000000 {
+							newCapacity = m.xxx_LenField1 * 2
+						} else {
+							newCapacity = m.xxx_LenField1 + 1000000
+						}
+						t := make([]float64, newCapacity, newCapacity)
+						copy(t, m.field1)
+						m.field1 = t
+					}
+					m.field1[m.xxx_LenField1] = float64(v2)
+					m.xxx_LenField1 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				v2 := math.Float64frombits(v)
+				if len(m.field1) <= m.xxx_LenField1 {
+					newCapacity := 0
+					if len(m.field1) == 0 {
+						newCapacity = 8
+					} else if len(m.field1) < 1000000 {
+						newCapacity = m.xxx_LenField1 * 2
+					} else {
+						newCapacity = m.xxx_LenField1 + 1000000
+					}
+					t := make([]float64, newCapacity, newCapacity)
+					copy(t, m.field1)
+					m.field1 = t
+				}
+				m.field1[m.xxx_LenField1] = float64(v2)
+				m.xxx_LenField1 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field1", wireType)
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					v2 := math.Float32frombits(v)
+					if len(m.field2) <= m.xxx_LenField2 {
+						newCapacity := 0
+						if len(m.field2) == 0 {
+							newCapacity = 8
+						} else if len(m.field2) < 1000000 {
+							newCapacity = m.xxx_LenField2 * 2
+						} else {
+							newCapacity = m.xxx_LenField2 + 1000000
+						}
+						t := make([]float32, newCapacity, newCapacity)
+						copy(t, m.field2)
+						m.field2 = t
+					}
+					m.field2[m.xxx_LenField2] = float32(v2)
+					m.xxx_LenField2 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				v2 := math.Float32frombits(v)
+				if len(m.field2) <= m.xxx_LenField2 {
+					newCapacity := 0
+					if len(m.field2) == 0 {
+						newCapacity = 8
+					} else if len(m.field2) < 1000000 {
+						newCapacity = m.xxx_LenField2 * 2
+					} else {
+						newCapacity = m.xxx_LenField2 + 1000000
+					}
+					t := make([]float32, newCapacity, newCapacity)
+					copy(t, m.field2)
+					m.field2 = t
+				}
+				m.field2[m.xxx_LenField2] = float32(v2)
+				m.xxx_LenField2 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field2", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field3) <= m.xxx_LenField3 {
+						newCapacity := 0
+						if len(m.field3) == 0 {
+							newCapacity = 8
+						} else if len(m.field3) < 1000000 {
+							newCapacity = m.xxx_LenField3 * 2
+						} else {
+							newCapacity = m.xxx_LenField3 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field3)
+						m.field3 = t
+					}
+					m.field3[m.xxx_LenField3] = int32(v)
+					m.xxx_LenField3 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field3) <= m.xxx_LenField3 {
+					newCapacity := 0
+					if len(m.field3) == 0 {
+						newCapacity = 8
+					} else if len(m.field3) < 1000000 {
+						newCapacity = m.xxx_LenField3 * 2
+					} else {
+						newCapacity = m.xxx_LenField3 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field3)
+					m.field3 = t
+				}
+				m.field3[m.xxx_LenField3] = int32(v)
+				m.xxx_LenField3 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field3", wireType)
+			}
+		case 4:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field4) <= m.xxx_LenField4 {
+						newCapacity := 0
+						if len(m.field4) == 0 {
+							newCapacity = 8
+						} else if len(m.field4) < 1000000 {
+							newCapacity = m.xxx_LenField4 * 2
+						} else {
+							newCapacity = m.xxx_LenField4 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field4)
+						m.field4 = t
+					}
+					m.field4[m.xxx_LenField4] = int64(v)
+					m.xxx_LenField4 += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field4) <= m.xxx_LenField4 {
+					newCapacity := 0
+					if len(m.field4) == 0 {
+						newCapacity = 8
+					} else if len(m.field4) < 1000000 {
+						newCapacity = m.xxx_LenField4 * 2
+					} else {
+						newCapacity = m.xxx_LenField4 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field4)
+					m.field4 = t
+				}
+				m.field4[m.xxx_LenField4] = int64(v)
+				m.xxx_LenField4 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field4", wireType)
+			}
+		case 5:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field5) <= m.xxx_LenField5 {
+						newCapacity := 0
+						if len(m.field5) == 0 {
+							newCapacity = 8
+						} else if len(m.field5) < 1000000 {
+							newCapacity = m.xxx_LenField5 * 2
+						} else {
+							newCapacity = m.xxx_LenField5 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field5)
+						m.field5 = t
+					}
+					m.field5[m.xxx_LenField5] = uint32(v)
+					m.xxx_LenField5 += 1
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field5) <= m.xxx_LenField5 {
+					newCapacity := 0
+					if len(m.field5) == 0 {
+						newCapacity = 8
+					} else if len(m.field5) < 1000000 {
+						newCapacity = m.xxx_LenField5 * 2
+					} else {
+						newCapacity = m.xxx_LenField5 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field5)
+					m.field5 = t
+				}
+				m.field5[m.xxx_LenField5] = uint32(v)
+				m.xxx_LenField5 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field5", wireType)
+			}
+		case 6:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field6) <= m.xxx_LenField6 {
+						newCapacity := 0
+						if len(m.field6) == 0 {
+							newCapacity = 8
+						} else if len(m.field6) < 1000000 {
+							newCapacity = m.xxx_LenField6 * 2
+						} else {
+							newCapacity = m.xxx_LenField6 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field6)
+						m.field6 = t
+					}
+					m.field6[m.xxx_LenField6] = uint64(v)
+					m.xxx_LenField6 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field6) <= m.xxx_LenField6 {
+					newCapacity := 0
+					if len(m.field6) == 0 {
+						newCapacity = 8
+					} else if len(m.field6) < 1000000 {
+						newCapacity = m.xxx_LenField6 * 2
+					} else {
+						newCapacity = m.xxx_LenField6 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field6)
+					m.field6 = t
+				}
+				m.field6[m.xxx_LenField6] = uint64(v)
+				m.xxx_LenField6 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field6", wireType)
+			}
+		case 7:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.field7) <= m.xxx_LenField7 {
+						newCapacity := 0
+						if len(m.field7) == 0 {
+							newCapacity = 8
+						} else if len(m.field7) < 1000000 {
+							newCapacity = m.xxx_LenField7 * 2
+						} else {
+							newCapacity = m.xxx_LenField7 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field7)
+						m.field7 = t
+					}
+					m.field7[m.xxx_LenField7] = int32(v)
+					m.xxx_LenField7 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.field7) <= m.xxx_LenField7 {
+					newCapacity := 0
+					if len(m.field7) == 0 {
+						newCapacity = 8
+					} else if len(m.field7) < 1000000 {
+						newCapacity = m.xxx_LenField7 * 2
+					} else {
+						newCapacity = m.xxx_LenField7 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field7)
+					m.field7 = t
+				}
+				m.field7[m.xxx_LenField7] = int32(v)
+				m.xxx_LenField7 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field7", wireType)
+			}
+		case 8:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					if len(m.field8) <= m.xxx_LenField8 {
+						newCapacity := 0
+						if len(m.field8) == 0 {
+							newCapacity = 8
+						} else if len(m.field8) < 1000000 {
+							newCapacity = m.xxx_LenField8 * 2
+						} else {
+							newCapacity = m.xxx_LenField8 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field8)
+						m.field8 = t
+					}
+					m.field8[m.xxx_LenField8] = int64(int64(v))
+					m.xxx_LenField8 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				if len(m.field8) <= m.xxx_LenField8 {
+					newCapacity := 0
+					if len(m.field8) == 0 {
+						newCapacity = 8
+					} else if len(m.field8) < 1000000 {
+						newCapacity = m.xxx_LenField8 * 2
+					} else {
+						newCapacity = m.xxx_LenField8 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field8)
+					m.field8 = t
+				}
+				m.field8[m.xxx_LenField8] = int64(int64(v))
+				m.xxx_LenField8 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field8", wireType)
+			}
+		case 9:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.field9) <= m.xxx_LenField9 {
+						newCapacity := 0
+						if len(m.field9) == 0 {
+							newCapacity = 8
+						} else if len(m.field9) < 1000000 {
+							newCapacity = m.xxx_LenField9 * 2
+						} else {
+							newCapacity = m.xxx_LenField9 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field9)
+						m.field9 = t
+					}
+					m.field9[m.xxx_LenField9] = uint32(v)
+					m.xxx_LenField9 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				if len(m.field9) <= m.xxx_LenField9 {
+					newCapacity := 0
+					if len(m.field9) == 0 {
+						newCapacity = 8
+					} else if len(m.field9) < 1000000 {
+						newCapacity = m.xxx_LenField9 * 2
+					} else {
+						newCapacity = m.xxx_LenField9 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field9)
+					m.field9 = t
+				}
+				m.field9[m.xxx_LenField9] = uint32(v)
+				m.xxx_LenField9 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field9", wireType)
+			}
+		case 10:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int32(data[i-4])
+					v |= int32(data[i-3]) << 8
+					v |= int32(data[i-2]) << 16
+					v |= int32(data[i-1]) << 24
+					if len(m.field10) <= m.xxx_LenField10 {
+						newCapacity := 0
+						if len(m.field10) == 0 {
+							newCapacity = 8
+						} else if len(m.field10) < 1000000 {
+							newCapacity = m.xxx_LenField10 * 2
+						} else {
+							newCapacity = m.xxx_LenField10 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field10)
+						m.field10 = t
+					}
+					m.field10[m.xxx_LenField10] = int32(v)
+					m.xxx_LenField10 += 1
+				}
+			} else if wireType == 5 {
+				var v int32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int32(data[i-4])
+				v |= int32(data[i-3]) << 8
+				v |= int32(data[i-2]) << 16
+				v |= int32(data[i-1]) << 24
+				if len(m.field10) <= m.xxx_LenField10 {
+					newCapacity := 0
+					if len(m.field10) == 0 {
+						newCapacity = 8
+					} else if len(m.field10) < 1000000 {
+						newCapacity = m.xxx_LenField10 * 2
+					} else {
+						newCapacity = m.xxx_LenField10 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field10)
+					m.field10 = t
+				}
+				m.field10[m.xxx_LenField10] = int32(v)
+				m.xxx_LenField10 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field10", wireType)
+			}
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					if len(m.field11) <= m.xxx_LenField11 {
+						newCapacity := 0
+						if len(m.field11) == 0 {
+							newCapacity = 8
+						} else if len(m.field11) < 1000000 {
+							newCapacity = m.xxx_LenField11 * 2
+						} else {
+							newCapacity = m.xxx_LenField11 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field11)
+						m.field11 = t
+					}
+					m.field11[m.xxx_LenField11] = uint64(v)
+					m.xxx_LenField11 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				if len(m.field11) <= m.xxx_LenField11 {
+					newCapacity := 0
+					if len(m.field11) == 0 {
+						newCapacity = 8
+					} else if len(m.field11) < 1000000 {
+						newCapacity = m.xxx_LenField11 * 2
+					} else {
+						newCapacity = m.xxx_LenField11 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field11)
+					m.field11 = t
+				}
+				m.field11[m.xxx_LenField11] = uint64(v)
+				m.xxx_LenField11 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field11", wireType)
+			}
+		case 12:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int64(data[i-8])
+					v |= int64(data[i-7]) << 8
+					v |= int64(data[i-6]) << 16
+					v |= int64(data[i-5]) << 24
+					v |= int64(data[i-4]) << 32
+					v |= int64(data[i-3]) << 40
+					v |= int64(data[i-2]) << 48
+					v |= int64(data[i-1]) << 56
+					if len(m.field12) <= m.xxx_LenField12 {
+						newCapacity := 0
+						if len(m.field12) == 0 {
+							newCapacity = 8
+						} else if len(m.field12) < 1000000 {
+							newCapacity = m.xxx_LenField12 * 2
+						} else {
+							newCapacity = m.xxx_LenField12 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field12)
+						m.field12 = t
+					}
+					m.field12[m.xxx_LenField12] = int64(v)
+					m.xxx_LenField12 += 1
+				}
+			} else if wireType == 1 {
+				var v int64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int64(data[i-8])
+				v |= int64(data[i-7]) << 8
+				v |= int64(data[i-6]) << 16
+				v |= int64(data[i-5]) << 24
+				v |= int64(data[i-4]) << 32
+				v |= int64(data[i-3]) << 40
+				v |= int64(data[i-2]) << 48
+				v |= int64(data[i-1]) << 56
+				if len(m.field12) <= m.xxx_LenField12 {
+					newCapacity := 0
+					if len(m.field12) == 0 {
+						newCapacity = 8
+					} else if len(m.field12) < 1000000 {
+						newCapacity = m.xxx_LenField12 * 2
+					} else {
+						newCapacity = m.xxx_LenField12 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field12)
+					m.field12 = t
+				}
+				m.field12[m.xxx_LenField12] = int64(v)
+				m.xxx_LenField12 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field12", wireType)
+			}
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field13) <= m.xxx_LenField13 {
+						newCapacity := 0
+						if len(m.field13) == 0 {
+							newCapacity = 8
+						} else if len(m.field13) < 1000000 {
+							newCapacity = m.xxx_LenField13 * 2
+						} else {
+							newCapacity = m.xxx_LenField13 + 1000000
+						}
+						t := make([]bool, newCapacity, newCapacity)
+						copy(t, m.field13)
+						m.field13 = t
+					}
+					m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+					m.xxx_LenField13 += 1
+				}
+			} else if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field13) <= m.xxx_LenField13 {
+					newCapacity := 0
+					if len(m.field13) == 0 {
+						newCapacity = 8
+					} else if len(m.field13) < 1000000 {
+						newCapacity = m.xxx_LenField13 * 2
+					} else {
+						newCapacity = m.xxx_LenField13 + 1000000
+					}
+					t := make([]bool, newCapacity, newCapacity)
+					copy(t, m.field13)
+					m.field13 = t
+				}
+				m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+				m.xxx_LenField13 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field13", wireType)
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *NinRepPackedNative) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					v2 := math.Float64frombits(v)
+					if len(m.field1) <= m.xxx_LenField1 {
+						newCapacity := 0
+						if len(m.field1) == 0 {
+							newCapacity = 8
+						} else if len(m.field1) < 1000000 {
+							newCapacity = m.xxx_LenField1 * 2
+						} else {
+							newCapacity = m.xxx_LenField1 + 1000000
+						}
+						t := make([]float64, newCapacity, newCapacity)
+						copy(t, m.field1)
+						m.field1 = t
+					}
+					m.field1[m.xxx_LenField1] = float64(v2)
+					m.xxx_LenField1 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				v2 := math.Float64frombits(v)
+				if len(m.field1) <= m.xxx_LenField1 {
+					newCapacity := 0
+					if len(m.field1) == 0 {
+						newCapacity = 8
+					} else if len(m.field1) < 1000000 {
+						newCapacity = m.xxx_LenField1 * 2
+					} else {
+						newCapacity = m.xxx_LenField1 + 1000000
+					}
+					t := make([]float64, newCapacity, newCapacity)
+					copy(t, m.field1)
+					m.field1 = t
+				}
+				m.field1[m.xxx_LenField1] = float64(v2)
+				m.xxx_LenField1 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field1", wireType)
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					v2 := math.Float32frombits(v)
+					if len(m.field2) <= m.xxx_LenField2 {
+						newCapacity := 0
+						if len(m.field2) == 0 {
+							newCapacity = 8
+						} else if len(m.field2) < 1000000 {
+							newCapacity = m.xxx_LenField2 * 2
+						} else {
+							newCapacity = m.xxx_LenField2 + 1000000
+						}
+						t := make([]float32, newCapacity, newCapacity)
+						copy(t, m.field2)
+						m.field2 = t
+					}
+					m.field2[m.xxx_LenField2] = float32(v2)
+					m.xxx_LenField2 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				v2 := math.Float32frombits(v)
+				if len(m.field2) <= m.xxx_LenField2 {
+					newCapacity := 0
+					if len(m.field2) == 0 {
+						newCapacity = 8
+					} else if len(m.field2) < 1000000 {
+						newCapacity = m.xxx_LenField2 * 2
+					} else {
+						newCapacity = m.xxx_LenField2 + 1000000
+					}
+					t := make([]float32, newCapacity, newCapacity)
+					copy(t, m.field2)
+					m.field2 = t
+				}
+				m.field2[m.xxx_LenField2] = float32(v2)
+				m.xxx_LenField2 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field2", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field3) <= m.xxx_LenField3 {
+						newCapacity := 0
+						if len(m.field3) == 0 {
+							newCapacity = 8
+						} else if len(m.field3) < 1000000 {
+							newCapacity = m.xxx_LenField3 * 2
+						} else {
+							newCapacity = m.xxx_LenField3 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field3)
+						m.field3 = t
+					}
+					m.field3[m.xxx_LenField3] = int32(v)
+					m.xxx_LenField3 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field3) <= m.xxx_LenField3 {
+					newCapacity := 0
+					if len(m.field3) == 0 {
+						newCapacity = 8
+					} else if len(m.field3) < 1000000 {
+						newCapacity = m.xxx_LenField3 * 2
+					} else {
+						newCapacity = m.xxx_LenField3 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field3)
+					m.field3 = t
+				}
+				m.field3[m.xxx_LenField3] = int32(v)
+				m.xxx_LenField3 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field3", wireType)
+			}
+		case 4:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field4) <= m.xxx_LenField4 {
+						newCapacity := 0
+						if len(m.field4) == 0 {
+							newCapacity = 8
+						} else if len(m.field4) < 1000000 {
+							newCapacity = m.xxx_LenField4 * 2
+						} else {
+							newCapacity = m.xxx_LenField4 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field4)
+						m.field4 = t
+					}
+					m.field4[m.xxx_LenField4] = int64(v)
+					m.xxx_LenField4 += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field4) <= m.xxx_LenField4 {
+					newCapacity := 0
+					if len(m.field4) == 0 {
+						newCapacity = 8
+					} else if len(m.field4) < 1000000 {
+						newCapacity = m.xxx_LenField4 * 2
+					} else {
+						newCapacity = m.xxx_LenField4 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field4)
+					m.field4 = t
+				}
+				m.field4[m.xxx_LenField4] = int64(v)
+				m.xxx_LenField4 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field4", wireType)
+			}
+		case 5:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field5) <= m.xxx_LenField5 {
+						newCapacity := 0
+						if len(m.field5) == 0 {
+							newCapacity = 8
+						} else if len(m.field5) < 1000000 {
+							newCapacity = m.xxx_LenField5 * 2
+						} else {
+							newCapacity = m.xxx_LenField5 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field5)
+						m.field5 = t
+					}
+					m.field5[m.xxx_LenField5] = uint32(v)
+					m.xxx_LenField5 += 1
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field5) <= m.xxx_LenField5 {
+					newCapacity := 0
+					if len(m.field5) == 0 {
+						newCapacity = 8
+					} else if len(m.field5) < 1000000 {
+						newCapacity = m.xxx_LenField5 * 2
+					} else {
+						newCapacity = m.xxx_LenField5 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field5)
+					m.field5 = t
+				}
+				m.field5[m.xxx_LenField5] = uint32(v)
+				m.xxx_LenField5 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field5", wireType)
+			}
+		case 6:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field6) <= m.xxx_LenField6 {
+						newCapacity := 0
+						if len(m.field6) == 0 {
+							newCapacity = 8
+						} else if len(m.field6) < 1000000 {
+							newCapacity = m.xxx_LenField6 * 2
+						} else {
+							newCapacity = m.xxx_LenField6 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field6)
+						m.field6 = t
+					}
+					m.field6[m.xxx_LenField6] = uint64(v)
+					m.xxx_LenField6 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field6) <= m.xxx_LenField6 {
+					newCapacity := 0
+					if len(m.field6) == 0 {
+						newCapacity = 8
+					} else if len(m.field6) < 1000000 {
+						newCapacity = m.xxx_LenField6 * 2
+					} else {
+						newCapacity = m.xxx_LenField6 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field6)
+					m.field6 = t
+				}
+				m.field6[m.xxx_LenField6] = uint64(v)
+				m.xxx_LenField6 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field6", wireType)
+			}
+		case 7:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.field7) <= m.xxx_LenField7 {
+						newCapacity := 0
+						if len(m.field7) == 0 {
+							newCapacity = 8
+						} else if len(m.field7) < 1000000 {
+							newCapacity = m.xxx_LenField7 * 2
+						} else {
+							newCapacity = m.xxx_LenField7 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field7)
+						m.field7 = t
+					}
+					m.field7[m.xxx_LenField7] = int32(v)
+					m.xxx_LenField7 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.field7) <= m.xxx_LenField7 {
+					newCapacity := 0
+					if len(m.field7) == 0 {
+						newCapacity = 8
+					} else if len(m.field7) < 1000000 {
+						newCapacity = m.xxx_LenField7 * 2
+					} else {
+						newCapacity = m.xxx_LenField7 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field7)
+					m.field7 = t
+				}
+				m.field7[m.xxx_LenField7] = int32(v)
+				m.xxx_LenField7 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field7", wireType)
+			}
+		case 8:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					if len(m.field8) <= m.xxx_LenField8 {
+						newCapacity := 0
+						if len(m.field8) == 0 {
+							newCapacity = 8
+						} else if len(m.field8) < 1000000 {
+							newCapacity = m.xxx_LenField8 * 2
+						} else {
+							newCapacity = m.xxx_LenField8 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field8)
+						m.field8 = t
+					}
+					m.field8[m.xxx_LenField8] = int64(int64(v))
+					m.xxx_LenField8 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				if len(m.field8) <= m.xxx_LenField8 {
+					newCapacity := 0
+					if len(m.field8) == 0 {
+						newCapacity = 8
+					} else if len(m.field8) < 1000000 {
+						newCapacity = m.xxx_LenField8 * 2
+					} else {
+						newCapacity = m.xxx_LenField8 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field8)
+					m.field8 = t
+				}
+				m.field8[m.xxx_LenField8] = int64(int64(v))
+				m.xxx_LenField8 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field8", wireType)
+			}
+		case 9:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.field9) <= m.xxx_LenField9 {
+						newCapacity := 0
+						if len(m.field9) == 0 {
+							newCapacity = 8
+						} else if len(m.field9) < 1000000 {
+							newCapacity = m.xxx_LenField9 * 2
+						} else {
+							newCapacity = m.xxx_LenField9 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field9)
+						m.field9 = t
+					}
+					m.field9[m.xxx_LenField9] = uint32(v)
+					m.xxx_LenField9 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				if len(m.field9) <= m.xxx_LenField9 {
+					newCapacity := 0
+					if len(m.field9) == 0 {
+						newCapacity = 8
+					} else if len(m.field9) < 1000000 {
+						newCapacity = m.xxx_LenField9 * 2
+					} else {
+						newCapacity = m.xxx_LenField9 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field9)
+					m.field9 = t
+				}
+				m.field9[m.xxx_LenField9] = uint32(v)
+				m.xxx_LenField9 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field9", wireType)
+			}
+		case 10:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int32(data[i-4])
+					v |= int32(data[i-3]) << 8
+					v |= int32(data[i-2]) << 16
+					v |= int32(data[i-1]) << 24
+					if len(m.field10) <= m.xxx_LenField10 {
+						newCapacity := 0
+						if len(m.field10) == 0 {
+							newCapacity = 8
+						} else if len(m.field10) < 1000000 {
+							newCapacity = m.xxx_LenField10 * 2
+						} else {
+							newCapacity = m.xxx_LenField10 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field10)
+						m.field10 = t
+					}
+					m.field10[m.xxx_LenField10] = int32(v)
+					m.xxx_LenField10 += 1
+				}
+			} else if wireType == 5 {
+				var v int32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int32(data[i-4])
+				v |= int32(data[i-3]) << 8
+				v |= int32(data[i-2]) << 16
+				v |= int32(data[i-1]) << 24
+				if len(m.field10) <= m.xxx_LenField10 {
+					newCapacity := 0
+					if len(m.field10) == 0 {
+						newCapacity = 8
+					} else if len(m.field10) < 1000000 {
+						newCapacity = m.xxx_LenField10 * 2
+					} else {
+						newCapacity = m.xxx_LenField10 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field10)
+					m.field10 = t
+				}
+				m.field10[m.xxx_LenField10] = int32(v)
+				m.xxx_LenField10 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field10", wireType)
+			}
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					if len(m.field11) <= m.xxx_LenField11 {
+						newCapacity := 0
+						if len(m.field11) == 0 {
+							newCapacity = 8
+						} else if len(m.field11) < 1000000 {
+							newCapacity = m.xxx_LenField11 * 2
+						} else {
+							newCapacity = m.xxx_LenField11 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field11)
+						m.field11 = t
+					}
+					m.field11[m.xxx_LenField11] = uint64(v)
+					m.xxx_LenField11 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				if len(m.field11) <= m.xxx_LenField11 {
+					newCapacity := 0
+					if len(m.field11) == 0 {
+						newCapacity = 8
+					} else if len(m.field11) < 1000000 {
+						newCapacity = m.xxx_LenField11 * 2
+					} else {
+						newCapacity = m.xxx_LenField11 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field11)
+					m.field11 = t
+				}
+				m.field11[m.xxx_LenField11] = uint64(v)
+				m.xxx_LenField11 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field11", wireType)
+			}
+		case 12:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int64(data[i-8])
+					v |= int64(data[i-7]) << 8
+					v |= int64(data[i-6]) << 16
+					v |= int64(data[i-5]) << 24
+					v |= int64(data[i-4]) << 32
+					v |= int64(data[i-3]) << 40
+					v |= int64(data[i-2]) << 48
+					v |= int64(data[i-1]) << 56
+					if len(m.field12) <= m.xxx_LenField12 {
+						newCapacity := 0
+						if len(m.field12) == 0 {
+							newCapacity = 8
+						} else if len(m.field12) < 1000000 {
+							newCapacity = m.xxx_LenField12 * 2
+						} else {
+							newCapacity = m.xxx_LenField12 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field12)
+						m.field12 = t
+					}
+					m.field12[m.xxx_LenField12] = int64(v)
+					m.xxx_LenField12 += 1
+				}
+			} else if wireType == 1 {
+				var v int64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int64(data[i-8])
+				v |= int64(data[i-7]) << 8
+				v |= int64(data[i-6]) << 16
+				v |= int64(data[i-5]) << 24
+				v |= int64(data[i-4]) << 32
+				v |= int64(data[i-3]) << 40
+				v |= int64(data[i-2]) << 48
+				v |= int64(data[i-1]) << 56
+				if len(m.field12) <= m.xxx_LenField12 {
+					newCapacity := 0
+					if len(m.field12) == 0 {
+						newCapacity = 8
+					} else if len(m.field12) < 1000000 {
+						newCapacity = m.xxx_LenField12 * 2
+					} else {
+						newCapacity = m.xxx_LenField12 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field12)
+					m.field12 = t
+				}
+				m.field12[m.xxx_LenField12] = int64(v)
+				m.xxx_LenField12 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field12", wireType)
+			}
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field13) <= m.xxx_LenField13 {
+						newCapacity := 0
+						if len(m.field13) == 0 {
+							newCapacity = 8
+						} else if len(m.field13) < 1000000 {
+							newCapacity = m.xxx_LenField13 * 2
+						} else {
+							newCapacity = m.xxx_LenField13 + 1000000
+						}
+						t := make([]bool, newCapacity, newCapacity)
+						copy(t, m.field13)
+						m.field13 = t
+					}
+					m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+					m.xxx_LenField13 += 1
+				}
+			} else if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field13) <= m.xxx_LenField13 {
+					newCapacity := 0
+					if len(m.field13) == 0 {
+						newCapacity = 8
+					} else if len(m.field13) < 1000000 {
+						newCapacity = m.xxx_LenField13 * 2
+					} else {
+						newCapacity = m.xxx_LenField13 + 1000000
+					}
+					t := make([]bool, newCapacity, newCapacity)
+					copy(t, m.field13)
+					m.field13 = t
+				}
+				m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+				m.xxx_LenField13 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field13", wireType)
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *NinRepNativeUnsafe) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					v2 := math.Float64frombits(v)
+					if len(m.field1) <= m.xxx_LenField1 {
+						newCapacity := 0
+						if len(m.field1) == 0 {
+							newCapacity = 8
+						} else if len(m.field1) < 1000000 {
+							newCapacity = m.xxx_LenField1 * 2
+						} else {
+							newCapacity = m.xxx_LenField1 + 1000000
+						}
+						t := make([]float64, newCapacity, newCapacity)
+						copy(t, m.field1)
+						m.field1 = t
+					}
+					m.field1[m.xxx_LenField1] = float64(v2)
+					m.xxx_LenField1 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				v2 := math.Float64frombits(v)
+				if len(m.field1) <= m.xxx_LenField1 {
+					newCapacity := 0
+					if len(m.field1) == 0 {
+						newCapacity = 8
+					} else if len(m.field1) < 1000000 {
+						newCapacity = m.xxx_LenField1 * 2
+					} else {
+						newCapacity = m.xxx_LenField1 + 1000000
+					}
+					t := make([]float64, newCapacity, newCapacity)
+					copy(t, m.field1)
+					m.field1 = t
+				}
+				m.field1[m.xxx_LenField1] = float64(v2)
+				m.xxx_LenField1 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field1", wireType)
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					v2 := math.Float32frombits(v)
+					if len(m.field2) <= m.xxx_LenField2 {
+						newCapacity := 0
+						if len(m.field2) == 0 {
+							newCapacity = 8
+						} else if len(m.field2) < 1000000 {
+							newCapacity = m.xxx_LenField2 * 2
+						} else {
+							newCapacity = m.xxx_LenField2 + 1000000
+						}
+						t := make([]float32, newCapacity, newCapacity)
+						copy(t, m.field2)
+						m.field2 = t
+					}
+					m.field2[m.xxx_LenField2] = float32(v2)
+					m.xxx_LenField2 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				v2 := math.Float32frombits(v)
+				if len(m.field2) <= m.xxx_LenField2 {
+					newCapacity := 0
+					if len(m.field2) == 0 {
+						newCapacity = 8
+					} else if len(m.field2) < 1000000 {
+						newCapacity = m.xxx_LenField2 * 2
+					} else {
+						newCapacity = m.xxx_LenField2 + 1000000
+					}
+					t := make([]float32, newCapacity, newCapacity)
+					copy(t, m.field2)
+					m.field2 = t
+				}
+				m.field2[m.xxx_LenField2] = float32(v2)
+				m.xxx_LenField2 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field2", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field3) <= m.xxx_LenField3 {
+						newCapacity := 0
+						if len(m.field3) == 0 {
+							newCapacity = 8
+						} else if len(m.field3) < 1000000 {
+							newCapacity = m.xxx_LenField3 * 2
+						} else {
+							newCapacity = m.xxx_LenField3 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field3)
+						m.field3 = t
+					}
+					m.field3[m.xxx_LenField3] = int32(v)
+					m.xxx_LenField3 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field3) <= m.xxx_LenField3 {
+					newCapacity := 0
+					if len(m.field3) == 0 {
+						newCapacity = 8
+					} else if len(m.field3) < 1000000 {
+						newCapacity = m.xxx_LenField3 * 2
+					} else {
+						newCapacity = m.xxx_LenField3 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field3)
+					m.field3 = t
+				}
+				m.field3[m.xxx_LenField3] = int32(v)
+				m.xxx_LenField3 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field3", wireType)
+			}
+		case 4:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field4) <= m.xxx_LenField4 {
+						newCapacity := 0
+						if len(m.field4) == 0 {
+							newCapacity = 8
+						} else if len(m.field4) < 1000000 {
+							newCapacity = m.xxx_LenField4 * 2
+						} else {
+							newCapacity = m.xxx_LenField4 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field4)
+						m.field4 = t
+					}
+					m.field4[m.xxx_LenField4] = int64(v)
+					m.xxx_LenField4 += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field4) <= m.xxx_LenField4 {
+					newCapacity := 0
+					if len(m.field4) == 0 {
+						newCapacity = 8
+					} else if len(m.field4) < 1000000 {
+						newCapacity = m.xxx_LenField4 * 2
+					} else {
+						newCapacity = m.xxx_LenField4 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field4)
+					m.field4 = t
+				}
+				m.field4[m.xxx_LenField4] = int64(v)
+				m.xxx_LenField4 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field4", wireType)
+			}
+		case 5:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field5) <= m.xxx_LenField5 {
+						newCapacity := 0
+						if len(m.field5) == 0 {
+							newCapacity = 8
+						} else if len(m.field5) < 1000000 {
+							newCapacity = m.xxx_LenField5 * 2
+						} else {
+							newCapacity = m.xxx_LenField5 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field5)
+						m.field5 = t
+					}
+					m.field5[m.xxx_LenField5] = uint32(v)
+					m.xxx_LenField5 += 1
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field5) <= m.xxx_LenField5 {
+					newCapacity := 0
+					if len(m.field5) == 0 {
+						newCapacity = 8
+					} else if len(m.field5) < 1000000 {
+						newCapacity = m.xxx_LenField5 * 2
+					} else {
+						newCapacity = m.xxx_LenField5 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field5)
+					m.field5 = t
+				}
+				m.field5[m.xxx_LenField5] = uint32(v)
+				m.xxx_LenField5 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field5", wireType)
+			}
+		case 6:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field6) <= m.xxx_LenField6 {
+						newCapacity := 0
+						if len(m.field6) == 0 {
+							newCapacity = 8
+						} else if len(m.field6) < 1000000 {
+							newCapacity = m.xxx_LenField6 * 2
+						} else {
+							newCapacity = m.xxx_LenField6 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field6)
+						m.field6 = t
+					}
+					m.field6[m.xxx_LenField6] = uint64(v)
+					m.xxx_LenField6 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field6) <= m.xxx_LenField6 {
+					newCapacity := 0
+					if len(m.field6) == 0 {
+						newCapacity = 8
+					} else if len(m.field6) < 1000000 {
+						newCapacity = m.xxx_LenField6 * 2
+					} else {
+						newCapacity = m.xxx_LenField6 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field6)
+					m.field6 = t
+				}
+				m.field6[m.xxx_LenField6] = uint64(v)
+				m.xxx_LenField6 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field6", wireType)
+			}
+		case 7:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.field7) <= m.xxx_LenField7 {
+						newCapacity := 0
+						if len(m.field7) == 0 {
+							newCapacity = 8
+						} else if len(m.field7) < 1000000 {
+							newCapacity = m.xxx_LenField7 * 2
+						} else {
+							newCapacity = m.xxx_LenField7 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field7)
+						m.field7 = t
+					}
+					m.field7[m.xxx_LenField7] = int32(v)
+					m.xxx_LenField7 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.field7) <= m.xxx_LenField7 {
+					newCapacity := 0
+					if len(m.field7) == 0 {
+						newCapacity = 8
+					} else if len(m.field7) < 1000000 {
+						newCapacity = m.xxx_LenField7 * 2
+					} else {
+						newCapacity = m.xxx_LenField7 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field7)
+					m.field7 = t
+				}
+				m.field7[m.xxx_LenField7] = int32(v)
+				m.xxx_LenField7 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field7", wireType)
+			}
+		case 8:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					if len(m.field8) <= m.xxx_LenField8 {
+						newCapacity := 0
+						if len(m.field8) == 0 {
+							newCapacity = 8
+						} else if len(m.field8) < 1000000 {
+							newCapacity = m.xxx_LenField8 * 2
+						} else {
+							newCapacity = m.xxx_LenField8 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field8)
+						m.field8 = t
+					}
+					m.field8[m.xxx_LenField8] = int64(int64(v))
+					m.xxx_LenField8 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				if len(m.field8) <= m.xxx_LenField8 {
+					newCapacity := 0
+					if len(m.field8) == 0 {
+						newCapacity = 8
+					} else if len(m.field8) < 1000000 {
+						newCapacity = m.xxx_LenField8 * 2
+					} else {
+						newCapacity = m.xxx_LenField8 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field8)
+					m.field8 = t
+				}
+				m.field8[m.xxx_LenField8] = int64(int64(v))
+				m.xxx_LenField8 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field8", wireType)
+			}
+		case 9:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.field9) <= m.xxx_LenField9 {
+						newCapacity := 0
+						if len(m.field9) == 0 {
+							newCapacity = 8
+						} else if len(m.field9) < 1000000 {
+							newCapacity = m.xxx_LenField9 * 2
+						} else {
+							newCapacity = m.xxx_LenField9 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field9)
+						m.field9 = t
+					}
+					m.field9[m.xxx_LenField9] = uint32(v)
+					m.xxx_LenField9 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				if len(m.field9) <= m.xxx_LenField9 {
+					newCapacity := 0
+					if len(m.field9) == 0 {
+						newCapacity = 8
+					} else if len(m.field9) < 1000000 {
+						newCapacity = m.xxx_LenField9 * 2
+					} else {
+						newCapacity = m.xxx_LenField9 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field9)
+					m.field9 = t
+				}
+				m.field9[m.xxx_LenField9] = uint32(v)
+				m.xxx_LenField9 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field9", wireType)
+			}
+		case 10:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int32(data[i-4])
+					v |= int32(data[i-3]) << 8
+					v |= int32(data[i-2]) << 16
+					v |= int32(data[i-1]) << 24
+					if len(m.field10) <= m.xxx_LenField10 {
+						newCapacity := 0
+						if len(m.field10) == 0 {
+							newCapacity = 8
+						} else if len(m.field10) < 1000000 {
+							newCapacity = m.xxx_LenField10 * 2
+						} else {
+							newCapacity = m.xxx_LenField10 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field10)
+						m.field10 = t
+					}
+					m.field10[m.xxx_LenField10] = int32(v)
+					m.xxx_LenField10 += 1
+				}
+			} else if wireType == 5 {
+				var v int32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int32(data[i-4])
+				v |= int32(data[i-3]) << 8
+				v |= int32(data[i-2]) << 16
+				v |= int32(data[i-1]) << 24
+				if len(m.field10) <= m.xxx_LenField10 {
+					newCapacity := 0
+					if len(m.field10) == 0 {
+						newCapacity = 8
+					} else if len(m.field10) < 1000000 {
+						newCapacity = m.xxx_LenField10 * 2
+					} else {
+						newCapacity = m.xxx_LenField10 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field10)
+					m.field10 = t
+				}
+				m.field10[m.xxx_LenField10] = int32(v)
+				m.xxx_LenField10 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field10", wireType)
+			}
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					if len(m.field11) <= m.xxx_LenField11 {
+						newCapacity := 0
+						if len(m.field11) == 0 {
+							newCapacity = 8
+						} else if len(m.field11) < 1000000 {
+							newCapacity = m.xxx_LenField11 * 2
+						} else {
+							newCapacity = m.xxx_LenField11 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field11)
+						m.field11 = t
+					}
+					m.field11[m.xxx_LenField11] = uint64(v)
+					m.xxx_LenField11 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				if len(m.field11) <= m.xxx_LenField11 {
+					newCapacity := 0
+					if len(m.field11) == 0 {
+						newCapacity = 8
+					} else if len(m.field11) < 1000000 {
+						newCapacity = m.xxx_LenField11 * 2
+					} else {
+						newCapacity = m.xxx_LenField11 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field11)
+					m.field11 = t
+				}
+				m.field11[m.xxx_LenField11] = uint64(v)
+				m.xxx_LenField11 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field11", wireType)
+			}
+		case 12:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int64(data[i-8])
+					v |= int64(data[i-7]) << 8
+					v |= int64(data[i-6]) << 16
+					v |= int64(data[i-5]) << 24
+					v |= int64(data[i-4]) << 32
+					v |= int64(data[i-3]) << 40
+					v |= int64(data[i-2]) << 48
+					v |= int64(data[i-1]) << 56
+					if len(m.field12) <= m.xxx_LenField12 {
+						newCapacity := 0
+						if len(m.field12) == 0 {
+							newCapacity = 8
+						} else if len(m.field12) < 1000000 {
+							newCapacity = m.xxx_LenField12 * 2
+						} else {
+							newCapacity = m.xxx_LenField12 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field12)
+						m.field12 = t
+					}
+					m.field12[m.xxx_LenField12] = int64(v)
+					m.xxx_LenField12 += 1
+				}
+			} else if wireType == 1 {
+				var v int64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int64(data[i-8])
+				v |= int64(data[i-7]) << 8
+				v |= int64(data[i-6]) << 16
+				v |= int64(data[i-5]) << 24
+				v |= int64(data[i-4]) << 32
+				v |= int64(data[i-3]) << 40
+				v |= int64(data[i-2]) << 48
+				v |= int64(data[i-1]) << 56
+				if len(m.field12) <= m.xxx_LenField12 {
+					newCapacity := 0
+					if len(m.field12) == 0 {
+						newCapacity = 8
+					} else if len(m.field12) < 1000000 {
+						newCapacity = m.xxx_LenField12 * 2
+					} else {
+						newCapacity = m.xxx_LenField12 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field12)
+					m.field12 = t
+				}
+				m.field12[m.xxx_LenField12] = int64(v)
+				m.xxx_LenField12 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field12", wireType)
+			}
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field13) <= m.xxx_LenField13 {
+						newCapacity := 0
+						if len(m.field13) == 0 {
+							newCapacity = 8
+						} else if len(m.field13) < 1000000 {
+							newCapacity = m.xxx_LenField13 * 2
+						} else {
+							newCapacity = m.xxx_LenField13 + 1000000
+						}
+						t := make([]bool, newCapacity, newCapacity)
+						copy(t, m.field13)
+						m.field13 = t
+					}
+					m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+					m.xxx_LenField13 += 1
+				}
+			} else if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field13) <= m.xxx_LenField13 {
+					newCapacity := 0
+					if len(m.field13) == 0 {
+						newCapacity = 8
+					} else if len(m.field13) < 1000000 {
+						newCapacity = m.xxx_LenField13 * 2
+					} else {
+						newCapacity = m.xxx_LenField13 + 1000000
+					}
+					t := make([]bool, newCapacity, newCapacity)
+					copy(t, m.field13)
+					m.field13 = t
+				}
+				m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+				m.xxx_LenField13 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field13", wireType)
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// UnmarshalZeroCopy is like Unmarshal, except that bytes and string fields
+// alias data instead of copying it, recursively for nested messages that
+// are zero copy as well. data must not be modified while m, or any value
+// read from it, is in use.
+func (m *NinRepNativeUnsafe) UnmarshalZeroCopy(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					v2 := math.Float64frombits(v)
+					if len(m.field1) <= m.xxx_LenField1 {
+						newCapacity := 0
+						if len(m.field1) == 0 {
+							newCapacity = 8
+						} else if len(m.field1) < 1000000 {
+							newCapacity = m.xxx_LenField1 * 2
+						} else {
+							newCapacity = m.xxx_LenField1 + 1000000
+						}
+						t := make([]float64, newCapacity, newCapacity)
+						copy(t, m.field1)
+						m.field1 = t
+					}
+					m.field1[m.xxx_LenField1] = float64(v2)
+					m.xxx_LenField1 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				v2 := math.Float64frombits(v)
+				if len(m.field1) <= m.xxx_LenField1 {
+					newCapacity := 0
+					if len(m.field1) == 0 {
+						newCapacity = 8
+					} else if len(m.field1) < 1000000 {
+						newCapacity = m.xxx_LenField1 * 2
+					} else {
+						newCapacity = m.xxx_LenField1 + 1000000
+					}
+					t := make([]float64, newCapacity, newCapacity)
+					copy(t, m.field1)
+					m.field1 = t
+				}
+				m.field1[m.xxx_LenField1] = float64(v2)
+				m.xxx_LenField1 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field1", wireType)
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					v2 := math.Float32frombits(v)
+					if len(m.field2) <= m.xxx_LenField2 {
+						newCapacity := 0
+						if len(m.field2) == 0 {
+							newCapacity = 8
+						} else if len(m.field2) < 1000000 {
+							newCapacity = m.xxx_LenField2 * 2
+						} else {
+							newCapacity = m.xxx_LenField2 + 1000000
+						}
+						t := make([]float32, newCapacity, newCapacity)
+						copy(t, m.field2)
+						m.field2 = t
+					}
+					m.field2[m.xxx_LenField2] = float32(v2)
+					m.xxx_LenField2 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				v2 := math.Float32frombits(v)
+				if len(m.field2) <= m.xxx_LenField2 {
+					newCapacity := 0
+					if len(m.field2) == 0 {
+						newCapacity = 8
+					} else if len(m.field2) < 1000000 {
+						newCapacity = m.xxx_LenField2 * 2
+					} else {
+						newCapacity = m.xxx_LenField2 + 1000000
+					}
+					t := make([]float32, newCapacity, newCapacity)
+					copy(t, m.field2)
+					m.field2 = t
+				}
+				m.field2[m.xxx_LenField2] = float32(v2)
+				m.xxx_LenField2 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field2", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field3) <= m.xxx_LenField3 {
+						newCapacity := 0
+						if len(m.field3) == 0 {
+							newCapacity = 8
+						} else if len(m.field3) < 1000000 {
+							newCapacity = m.xxx_LenField3 * 2
+						} else {
+							newCapacity = m.xxx_LenField3 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field3)
+						m.field3 = t
+					}
+					m.field3[m.xxx_LenField3] = int32(v)
+					m.xxx_LenField3 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field3) <= m.xxx_LenField3 {
+					newCapacity := 0
+					if len(m.field3) == 0 {
+						newCapacity = 8
+					} else if len(m.field3) < 1000000 {
+						newCapacity = m.xxx_LenField3 * 2
+					} else {
+						newCapacity = m.xxx_LenField3 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field3)
+					m.field3 = t
+				}
+				m.field3[m.xxx_LenField3] = int32(v)
+				m.xxx_LenField3 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field3", wireType)
+			}
+		case 4:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field4) <= m.xxx_LenField4 {
+						newCapacity := 0
+						if len(m.field4) == 0 {
+							newCapacity = 8
+						} else if len(m.field4) < 1000000 {
+							newCapacity = m.xxx_LenField4 * 2
+						} else {
+							newCapacity = m.xxx_LenField4 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field4)
+						m.field4 = t
+					}
+					m.field4[m.xxx_LenField4] = int64(v)
+					m.xxx_LenField4 += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field4) <= m.xxx_LenField4 {
+					newCapacity := 0
+					if len(m.field4) == 0 {
+						newCapacity = 8
+					} else if len(m.field4) < 1000000 {
+						newCapacity = m.xxx_LenField4 * 2
+					} else {
+						newCapacity = m.xxx_LenField4 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field4)
+					m.field4 = t
+				}
+				m.field4[m.xxx_LenField4] = int64(v)
+				m.xxx_LenField4 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field4", wireType)
+			}
+		case 5:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field5) <= m.xxx_LenField5 {
+						newCapacity := 0
+						if len(m.field5) == 0 {
+							newCapacity = 8
+						} else if len(m.field5) < 1000000 {
+							newCapacity = m.xxx_LenField5 * 2
+						} else {
+							newCapacity = m.xxx_LenField5 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field5)
+						m.field5 = t
+					}
+					m.field5[m.xxx_LenField5] = uint32(v)
+					m.xxx_LenField5 += 1
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field5) <= m.xxx_LenField5 {
+					newCapacity := 0
+					if len(m.field5) == 0 {
+						newCapacity = 8
+					} else if len(m.field5) < 1000000 {
+						newCapacity = m.xxx_LenField5 * 2
+					} else {
+						newCapacity = m.xxx_LenField5 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field5)
+					m.field5 = t
+				}
+				m.field5[m.xxx_LenField5] = uint32(v)
+				m.xxx_LenField5 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field5", wireType)
+			}
+		case 6:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field6) <= m.xxx_LenField6 {
+						newCapacity := 0
+						if len(m.field6) == 0 {
+							newCapacity = 8
+						} else if len(m.field6) < 1000000 {
+							newCapacity = m.xxx_LenField6 * 2
+						} else {
+							newCapacity = m.xxx_LenField6 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field6)
+						m.field6 = t
+					}
+					m.field6[m.xxx_LenField6] = uint64(v)
+					m.xxx_LenField6 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field6) <= m.xxx_LenField6 {
+					newCapacity := 0
+					if len(m.field6) == 0 {
+						newCapacity = 8
+					} else if len(m.field6) < 1000000 {
+						newCapacity = m.xxx_LenField6 * 2
+					} else {
+						newCapacity = m.xxx_LenField6 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field6)
+					m.field6 = t
+				}
+				m.field6[m.xxx_LenField6] = uint64(v)
+				m.xxx_LenField6 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field6", wireType)
+			}
+		case 7:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.field7) <= m.xxx_LenField7 {
+						newCapacity := 0
+						if len(m.field7) == 0 {
+							newCapacity = 8
+						} else if len(m.field7) < 1000000 {
+							newCapacity = m.xxx_LenField7 * 2
+						} else {
+							newCapacity = m.xxx_LenField7 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field7)
+						m.field7 = t
+					}
+					m.field7[m.xxx_LenField7] = int32(v)
+					m.xxx_LenField7 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.field7) <= m.xxx_LenField7 {
+					newCapacity := 0
+					if len(m.field7) == 0 {
+						newCapacity = 8
+					} else if len(m.field7) < 1000000 {
+						newCapacity = m.xxx_LenField7 * 2
+					} else {
+						newCapacity = m.xxx_LenField7 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field7)
+					m.field7 = t
+				}
+				m.field7[m.xxx_LenField7] = int32(v)
+				m.xxx_LenField7 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field7", wireType)
+			}
+		case 8:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					if len(m.field8) <= m.xxx_LenField8 {
+						newCapacity := 0
+						if len(m.field8) == 0 {
+							newCapacity = 8
+						} else if len(m.field8) < 1000000 {
+							newCapacity = m.xxx_LenField8 * 2
+						} else {
+							newCapacity = m.xxx_LenField8 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field8)
+						m.field8 = t
+					}
+					m.field8[m.xxx_LenField8] = int64(int64(v))
+					m.xxx_LenField8 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				if len(m.field8) <= m.xxx_LenField8 {
+					newCapacity := 0
+					if len(m.field8) == 0 {
+						newCapacity = 8
+					} else if len(m.field8) < 1000000 {
+						newCapacity = m.xxx_LenField8 * 2
+					} else {
+						newCapacity = m.xxx_LenField8 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field8)
+					m.field8 = t
+				}
+				m.field8[m.xxx_LenField8] = int64(int64(v))
+				m.xxx_LenField8 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field8", wireType)
+			}
+		case 9:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.field9) <= m.xxx_LenField9 {
+						newCapacity := 0
+						if len(m.field9) == 0 {
+							newCapacity = 8
+						} else if len(m.field9) < 1000000 {
+							newCapacity = m.xxx_LenField9 * 2
+						} else {
+							newCapacity = m.xxx_LenField9 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field9)
+						m.field9 = t
+					}
+					m.field9[m.xxx_LenField9] = uint32(v)
+					m.xxx_LenField9 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				if len(m.field9) <= m.xxx_LenField9 {
+					newCapacity := 0
+					if len(m.field9) == 0 {
+						newCapacity = 8
+					} else if len(m.field9) < 1000000 {
+						newCapacity = m.xxx_LenField9 * 2
+					} else {
+						newCapacity = m.xxx_LenField9 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field9)
+					m.field9 = t
+				}
+				m.field9[m.xxx_LenField9] = uint32(v)
+				m.xxx_LenField9 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field9", wireType)
+			}
+		case 10:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int32(data[i-4])
+					v |= int32(data[i-3]) << 8
+					v |= int32(data[i-2]) << 16
+					v |= int32(data[i-1]) << 24
+					if len(m.field10) <= m.xxx_LenField10 {
+						newCapacity := 0
+						if len(m.field10) == 0 {
+							newCapacity = 8
+						} else if len(m.field10) < 1000000 {
+							newCapacity = m.xxx_LenField10 * 2
+						} else {
+							newCapacity = m.xxx_LenField10 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field10)
+						m.field10 = t
+					}
+					m.field10[m.xxx_LenField10] = int32(v)
+					m.xxx_LenField10 += 1
+				}
+			} else if wireType == 5 {
+				var v int32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int32(data[i-4])
+				v |= int32(data[i-3]) << 8
+				v |= int32(data[i-2]) << 16
+				v |= int32(data[i-1]) << 24
+				if len(m.field10) <= m.xxx_LenField10 {
+					newCapacity := 0
+					if len(m.field10) == 0 {
+						newCapacity = 8
+					} else if len(m.field10) < 1000000 {
+						newCapacity = m.xxx_LenField10 * 2
+					} else {
+						newCapacity = m.xxx_LenField10 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field10)
+					m.field10 = t
+				}
+				m.field10[m.xxx_LenField10] = int32(v)
+				m.xxx_LenField10 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field10", wireType)
+			}
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					if len(m.field11) <= m.xxx_LenField11 {
+						newCapacity := 0
+						if len(m.field11) == 0 {
+							newCapacity = 8
+						} else if len(m.field11) < 1000000 {
+							newCapacity = m.xxx_LenField11 * 2
+						} else {
+							newCapacity = m.xxx_LenField11 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field11)
+						m.field11 = t
+					}
+					m.field11[m.xxx_LenField11] = uint64(v)
+					m.xxx_LenField11 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				if len(m.field11) <= m.xxx_LenField11 {
+					newCapacity := 0
+					if len(m.field11) == 0 {
+						newCapacity = 8
+					} else if len(m.field11) < 1000000 {
+						newCapacity = m.xxx_LenField11 * 2
+					} else {
+						newCapacity = m.xxx_LenField11 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field11)
+					m.field11 = t
+				}
+				m.field11[m.xxx_LenField11] = uint64(v)
+				m.xxx_LenField11 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field11", wireType)
+			}
+		case 12:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int64(data[i-8])
+					v |= int64(data[i-7]) << 8
+					v |= int64(data[i-6]) << 16
+					v |= int64(data[i-5]) << 24
+					v |= int64(data[i-4]) << 32
+					v |= int64(data[i-3]) << 40
+					v |= int64(data[i-2]) << 48
+					v |= int64(data[i-1]) << 56
+					if len(m.field12) <= m.xxx_LenField12 {
+						newCapacity := 0
+						if len(m.field12) == 0 {
+							newCapacity = 8
+						} else if len(m.field12) < 1000000 {
+							newCapacity = m.xxx_LenField12 * 2
+						} else {
+							newCapacity = m.xxx_LenField12 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field12)
+						m.field12 = t
+					}
+					m.field12[m.xxx_LenField12] = int64(v)
+					m.xxx_LenField12 += 1
+				}
+			} else if wireType == 1 {
+				var v int64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int64(data[i-8])
+				v |= int64(data[i-7]) << 8
+				v |= int64(data[i-6]) << 16
+				v |= int64(data[i-5]) << 24
+				v |= int64(data[i-4]) << 32
+				v |= int64(data[i-3]) << 40
+				v |= int64(data[i-2]) << 48
+				v |= int64(data[i-1]) << 56
+				if len(m.field12) <= m.xxx_LenField12 {
+					newCapacity := 0
+					if len(m.field12) == 0 {
+						newCapacity = 8
+					} else if len(m.field12) < 1000000 {
+						newCapacity = m.xxx_LenField12 * 2
+					} else {
+						newCapacity = m.xxx_LenField12 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field12)
+					m.field12 = t
+				}
+				m.field12[m.xxx_LenField12] = int64(v)
+				m.xxx_LenField12 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field12", wireType)
+			}
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field13) <= m.xxx_LenField13 {
+						newCapacity := 0
+						if len(m.field13) == 0 {
+							newCapacity = 8
+						} else if len(m.field13) < 1000000 {
+							newCapacity = m.xxx_LenField13 * 2
+						} else {
+							newCapacity = m.xxx_LenField13 + 1000000
+						}
+						t := make([]bool, newCapacity, newCapacity)
+						copy(t, m.field13)
+						m.field13 = t
+					}
+					m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+					m.xxx_LenField13 += 1
+				}
+			} else if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field13) <= m.xxx_LenField13 {
+					newCapacity := 0
+					if len(m.field13) == 0 {
+						newCapacity = 8
+					} else if len(m.field13) < 1000000 {
+						newCapacity = m.xxx_LenField13 * 2
+					} else {
+						newCapacity = m.xxx_LenField13 + 1000000
+					}
+					t := make([]bool, newCapacity, newCapacity)
+					copy(t, m.field13)
+					m.field13 = t
+				}
+				m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+				m.xxx_LenField13 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field13", wireType)
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *NinRepPackedNativeUnsafe) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					v2 := math.Float64frombits(v)
+					if len(m.field1) <= m.xxx_LenField1 {
+						newCapacity := 0
+						if len(m.field1) == 0 {
+							newCapacity = 8
+						} else if len(m.field1) < 1000000 {
+							newCapacity = m.xxx_LenField1 * 2
+						} else {
+							newCapacity = m.xxx_LenField1 + 1000000
+						}
+						t := make([]float64, newCapacity, newCapacity)
+						copy(t, m.field1)
+						m.field1 = t
+					}
+					m.field1[m.xxx_LenField1] = float64(v2)
+					m.xxx_LenField1 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				v2 := math.Float64frombits(v)
+				if len(m.field1) <= m.xxx_LenField1 {
+					newCapacity := 0
+					if len(m.field1) == 0 {
+						newCapacity = 8
+					} else if len(m.field1) < 1000000 {
+						newCapacity = m.xxx_LenField1 * 2
+					} else {
+						newCapacity = m.xxx_LenField1 + 1000000
+					}
+					t := make([]float64, newCapacity, newCapacity)
+					copy(t, m.field1)
+					m.field1 = t
+				}
+				m.field1[m.xxx_LenField1] = float64(v2)
+				m.xxx_LenField1 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field1", wireType)
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					v2 := math.Float32frombits(v)
+					if len(m.field2) <= m.xxx_LenField2 {
+						newCapacity := 0
+						if len(m.field2) == 0 {
+							newCapacity = 8
+						} else if len(m.field2) < 1000000 {
+							newCapacity = m.xxx_LenField2 * 2
+						} else {
+							newCapacity = m.xxx_LenField2 + 1000000
+						}
+						t := make([]float32, newCapacity, newCapacity)
+						copy(t, m.field2)
+						m.field2 = t
+					}
+					m.field2[m.xxx_LenField2] = float32(v2)
+					m.xxx_LenField2 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				v2 := math.Float32frombits(v)
+				if len(m.field2) <= m.xxx_LenField2 {
+					newCapacity := 0
+					if len(m.field2) == 0 {
+						newCapacity = 8
+					} else if len(m.field2) < 1000000 {
+						newCapacity = m.xxx_LenField2 * 2
+					} else {
+						newCapacity = m.xxx_LenField2 + 1000000
+					}
+					t := make([]float32, newCapacity, newCapacity)
+					copy(t, m.field2)
+					m.field2 = t
+				}
+				m.field2[m.xxx_LenField2] = float32(v2)
+				m.xxx_LenField2 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field2", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field3) <= m.xxx_LenField3 {
+						newCapacity := 0
+						if len(m.field3) == 0 {
+							newCapacity = 8
+						} else if len(m.field3) < 1000000 {
+							newCapacity = m.xxx_LenField3 * 2
+						} else {
+							newCapacity = m.xxx_LenField3 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field3)
+						m.field3 = t
+					}
+					m.field3[m.xxx_LenField3] = int32(v)
+					m.xxx_LenField3 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field3) <= m.xxx_LenField3 {
+					newCapacity := 0
+					if len(m.field3) == 0 {
+						newCapacity = 8
+					} else if len(m.field3) < 1000000 {
+						newCapacity = m.xxx_LenField3 * 2
+					} else {
+						newCapacity = m.xxx_LenField3 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field3)
+					m.field3 = t
+				}
+				m.field3[m.xxx_LenField3] = int32(v)
+				m.xxx_LenField3 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field3", wireType)
+			}
+		case 4:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field4) <= m.xxx_LenField4 {
+						newCapacity := 0
+						if len(m.field4) == 0 {
+							newCapacity = 8
+						} else if len(m.field4) < 1000000 {
+							newCapacity = m.xxx_LenField4 * 2
+						} else {
+							newCapacity = m.xxx_LenField4 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field4)
+						m.field4 = t
+					}
+					m.field4[m.xxx_LenField4] = int64(v)
+					m.xxx_LenField4 += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field4) <= m.xxx_LenField4 {
+					newCapacity := 0
+					if len(m.field4) == 0 {
+						newCapacity = 8
+					} else if len(m.field4) < 1000000 {
+						newCapacity = m.xxx_LenField4 * 2
+					} else {
+						newCapacity = m.xxx_LenField4 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field4)
+					m.field4 = t
+				}
+				m.field4[m.xxx_LenField4] = int64(v)
+				m.xxx_LenField4 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field4", wireType)
+			}
+		case 5:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field5) <= m.xxx_LenField5 {
+						newCapacity := 0
+						if len(m.field5) == 0 {
+							newCapacity = 8
+						} else if len(m.field5) < 1000000 {
+							newCapacity = m.xxx_LenField5 * 2
+						} else {
+							newCapacity = m.xxx_LenField5 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field5)
+						m.field5 = t
+					}
+					m.field5[m.xxx_LenField5] = uint32(v)
+					m.xxx_LenField5 += 1
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field5) <= m.xxx_LenField5 {
+					newCapacity := 0
+					if len(m.field5) == 0 {
+						newCapacity = 8
+					} else if len(m.field5) < 1000000 {
+						newCapacity = m.xxx_LenField5 * 2
+					} else {
+						newCapacity = m.xxx_LenField5 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field5)
+					m.field5 = t
+				}
+				m.field5[m.xxx_LenField5] = uint32(v)
+				m.xxx_LenField5 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field5", wireType)
+			}
+		case 6:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field6) <= m.xxx_LenField6 {
+						newCapacity := 0
+						if len(m.field6) == 0 {
+							newCapacity = 8
+						} else if len(m.field6) < 1000000 {
+							newCapacity = m.xxx_LenField6 * 2
+						} else {
+							newCapacity = m.xxx_LenField6 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field6)
+						m.field6 = t
+					}
+					m.field6[m.xxx_LenField6] = uint64(v)
+					m.xxx_LenField6 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field6) <= m.xxx_LenField6 {
+					newCapacity := 0
+					if len(m.field6) == 0 {
+						newCapacity = 8
+					} else if len(m.field6) < 1000000 {
+						newCapacity = m.xxx_LenField6 * 2
+					} else {
+						newCapacity = m.xxx_LenField6 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field6)
+					m.field6 = t
+				}
+				m.field6[m.xxx_LenField6] = uint64(v)
+				m.xxx_LenField6 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field6", wireType)
+			}
+		case 7:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.field7) <= m.xxx_LenField7 {
+						newCapacity := 0
+						if len(m.field7) == 0 {
+							newCapacity = 8
+						} else if len(m.field7) < 1000000 {
+							newCapacity = m.xxx_LenField7 * 2
+						} else {
+							newCapacity = m.xxx_LenField7 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field7)
+						m.field7 = t
+					}
+					m.field7[m.xxx_LenField7] = int32(v)
+					m.xxx_LenField7 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.field7) <= m.xxx_LenField7 {
+					newCapacity := 0
+					if len(m.field7) == 0 {
+						newCapacity = 8
+					} else if len(m.field7) < 1000000 {
+						newCapacity = m.xxx_LenField7 * 2
+					} else {
+						newCapacity = m.xxx_LenField7 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field7)
+					m.field7 = t
+				}
+				m.field7[m.xxx_LenField7] = int32(v)
+				m.xxx_LenField7 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field7", wireType)
+			}
+		case 8:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					if len(m.field8) <= m.xxx_LenField8 {
+						newCapacity := 0
+						if len(m.field8) == 0 {
+							newCapacity = 8
+						} else if len(m.field8) < 1000000 {
+							newCapacity = m.xxx_LenField8 * 2
+						} else {
+							newCapacity = m.xxx_LenField8 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field8)
+						m.field8 = t
+					}
+					m.field8[m.xxx_LenField8] = int64(int64(v))
+					m.xxx_LenField8 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				if len(m.field8) <= m.xxx_LenField8 {
+					newCapacity := 0
+					if len(m.field8) == 0 {
+						newCapacity = 8
+					} else if len(m.field8) < 1000000 {
+						newCapacity = m.xxx_LenField8 * 2
+					} else {
+						newCapacity = m.xxx_LenField8 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field8)
+					m.field8 = t
+				}
+				m.field8[m.xxx_LenField8] = int64(int64(v))
+				m.xxx_LenField8 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field8", wireType)
+			}
+		case 9:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.field9) <= m.xxx_LenField9 {
+						newCapacity := 0
+						if len(m.field9) == 0 {
+							newCapacity = 8
+						} else if len(m.field9) < 1000000 {
+							newCapacity = m.xxx_LenField9 * 2
+						} else {
+							newCapacity = m.xxx_LenField9 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field9)
+						m.field9 = t
+					}
+					m.field9[m.xxx_LenField9] = uint32(v)
+					m.xxx_LenField9 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				if len(m.field9) <= m.xxx_LenField9 {
+					newCapacity := 0
+					if len(m.field9) == 0 {
+						newCapacity = 8
+					} else if len(m.field9) < 1000000 {
+						newCapacity = m.xxx_LenField9 * 2
+					} else {
+						newCapacity = m.xxx_LenField9 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field9)
+					m.field9 = t
+				}
+				m.field9[m.xxx_LenField9] = uint32(v)
+				m.xxx_LenField9 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field9", wireType)
+			}
+		case 10:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int32(data[i-4])
+					v |= int32(data[i-3]) << 8
+					v |= int32(data[i-2]) << 16
+					v |= int32(data[i-1]) << 24
+					if len(m.field10) <= m.xxx_LenField10 {
+						newCapacity := 0
+						if len(m.field10) == 0 {
+							newCapacity = 8
+						} else if len(m.field10) < 1000000 {
+							newCapacity = m.xxx_LenField10 * 2
+						} else {
+							newCapacity = m.xxx_LenField10 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field10)
+						m.field10 = t
+					}
+					m.field10[m.xxx_LenField10] = int32(v)
+					m.xxx_LenField10 += 1
+				}
+			} else if wireType == 5 {
+				var v int32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int32(data[i-4])
+				v |= int32(data[i-3]) << 8
+				v |= int32(data[i-2]) << 16
+				v |= int32(data[i-1]) << 24
+				if len(m.field10) <= m.xxx_LenField10 {
+					newCapacity := 0
+					if len(m.field10) == 0 {
+						newCapacity = 8
+					} else if len(m.field10) < 1000000 {
+						newCapacity = m.xxx_LenField10 * 2
+					} else {
+						newCapacity = m.xxx_LenField10 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field10)
+					m.field10 = t
+				}
+				m.field10[m.xxx_LenField10] = int32(v)
+				m.xxx_LenField10 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field10", wireType)
+			}
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					if len(m.field11) <= m.xxx_LenField11 {
+						newCapacity := 0
+						if len(m.field11) == 0 {
+							newCapacity = 8
+						} else if len(m.field11) < 1000000 {
+							newCapacity = m.xxx_LenField11 * 2
+						} else {
+							newCapacity = m.xxx_LenField11 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field11)
+						m.field11 = t
+					}
+					m.field11[m.xxx_LenField11] = uint64(v)
+					m.xxx_LenField11 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				if len(m.field11) <= m.xxx_LenField11 {
+					newCapacity := 0
+					if len(m.field11) == 0 {
+						newCapacity = 8
+					} else if len(m.field11) < 1000000 {
+						newCapacity = m.xxx_LenField11 * 2
+					} else {
+						newCapacity = m.xxx_LenField11 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field11)
+					m.field11 = t
+				}
+				m.field11[m.xxx_LenField11] = uint64(v)
+				m.xxx_LenField11 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field11", wireType)
+			}
+		case 12:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int64(data[i-8])
+					v |= int64(data[i-7]) << 8
+					v |= int64(data[i-6]) << 16
+					v |= int64(data[i-5]) << 24
+					v |= int64(data[i-4]) << 32
+					v |= int64(data[i-3]) << 40
+					v |= int64(data[i-2]) << 48
+					v |= int64(data[i-1]) << 56
+					if len(m.field12) <= m.xxx_LenField12 {
+						newCapacity := 0
+						if len(m.field12) == 0 {
+							newCapacity = 8
+						} else if len(m.field12) < 1000000 {
+							newCapacity = m.xxx_LenField12 * 2
+						} else {
+							newCapacity = m.xxx_LenField12 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field12)
+						m.field12 = t
+					}
+					m.field12[m.xxx_LenField12] = int64(v)
+					m.xxx_LenField12 += 1
+				}
+			} else if wireType == 1 {
+				var v int64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int64(data[i-8])
+				v |= int64(data[i-7]) << 8
+				v |= int64(data[i-6]) << 16
+				v |= int64(data[i-5]) << 24
+				v |= int64(data[i-4]) << 32
+				v |= int64(data[i-3]) << 40
+				v |= int64(data[i-2]) << 48
+				v |= int64(data[i-1]) << 56
+				if len(m.field12) <= m.xxx_LenField12 {
+					newCapacity := 0
+					if len(m.field12) == 0 {
+						newCapacity = 8
+					} else if len(m.field12) < 1000000 {
+						newCapacity = m.xxx_LenField12 * 2
+					} else {
+						newCapacity = m.xxx_LenField12 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field12)
+					m.field12 = t
+				}
+				m.field12[m.xxx_LenField12] = int64(v)
+				m.xxx_LenField12 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field12", wireType)
+			}
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field13) <= m.xxx_LenField13 {
+						newCapacity := 0
+						if len(m.field13) == 0 {
+							newCapacity = 8
+						} else if len(m.field13) < 1000000 {
+							newCapacity = m.xxx_LenField13 * 2
+						} else {
+							newCapacity = m.xxx_LenField13 + 1000000
+						}
+						t := make([]bool, newCapacity, newCapacity)
+						copy(t, m.field13)
+						m.field13 = t
+					}
+					m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+					m.xxx_LenField13 += 1
+				}
+			} else if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field13) <= m.xxx_LenField13 {
+					newCapacity := 0
+					if len(m.field13) == 0 {
+						newCapacity = 8
+					} else if len(m.field13) < 1000000 {
+						newCapacity = m.xxx_LenField13 * 2
+					} else {
+						newCapacity = m.xxx_LenField13 + 1000000
+					}
+					t := make([]bool, newCapacity, newCapacity)
+					copy(t, m.field13)
+					m.field13 = t
+				}
+				m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+				m.xxx_LenField13 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field13", wireType)
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// UnmarshalZeroCopy is like Unmarshal, except that bytes and string fields
+// alias data instead of copying it, recursively for nested messages that
+// are zero copy as well. data must not be modified while m, or any value
+// read from it, is in use.
+func (m *NinRepPackedNativeUnsafe) UnmarshalZeroCopy(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					v2 := math.Float64frombits(v)
+					if len(m.field1) <= m.xxx_LenField1 {
+						newCapacity := 0
+						if len(m.field1) == 0 {
+							newCapacity = 8
+						} else if len(m.field1) < 1000000 {
+							newCapacity = m.xxx_LenField1 * 2
+						} else {
+							newCapacity = m.xxx_LenField1 + 1000000
+						}
+						t := make([]float64, newCapacity, newCapacity)
+						copy(t, m.field1)
+						m.field1 = t
+					}
+					m.field1[m.xxx_LenField1] = float64(v2)
+					m.xxx_LenField1 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				v2 := math.Float64frombits(v)
+				if len(m.field1) <= m.xxx_LenField1 {
+					newCapacity := 0
+					if len(m.field1) == 0 {
+						newCapacity = 8
+					} else if len(m.field1) < 1000000 {
+						newCapacity = m.xxx_LenField1 * 2
+					} else {
+						newCapacity = m.xxx_LenField1 + 1000000
+					}
+					t := make([]float64, newCapacity, newCapacity)
+					copy(t, m.field1)
+					m.field1 = t
+				}
+				m.field1[m.xxx_LenField1] = float64(v2)
+				m.xxx_LenField1 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field1", wireType)
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					v2 := math.Float32frombits(v)
+					if len(m.field2) <= m.xxx_LenField2 {
+						newCapacity := 0
+						if len(m.field2) == 0 {
+							newCapacity = 8
+						} else if len(m.field2) < 1000000 {
+							newCapacity = m.xxx_LenField2 * 2
+						} else {
+							newCapacity = m.xxx_LenField2 + 1000000
+						}
+						t := make([]float32, newCapacity, newCapacity)
+						copy(t, m.field2)
+						m.field2 = t
+					}
+					m.field2[m.xxx_LenField2] = float32(v2)
+					m.xxx_LenField2 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				v2 := math.Float32frombits(v)
+				if len(m.field2) <= m.xxx_LenField2 {
+					newCapacity := 0
+					if len(m.field2) == 0 {
+						newCapacity = 8
+					} else if len(m.field2) < 1000000 {
+						newCapacity = m.xxx_LenField2 * 2
+					} else {
+						newCapacity = m.xxx_LenField2 + 1000000
+					}
+					t := make([]float32, newCapacity, newCapacity)
+					copy(t, m.field2)
+					m.field2 = t
+				}
+				m.field2[m.xxx_LenField2] = float32(v2)
+				m.xxx_LenField2 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field2", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field3) <= m.xxx_LenField3 {
+						newCapacity := 0
+						if len(m.field3) == 0 {
+							newCapacity = 8
+						} else if len(m.field3) < 1000000 {
+							newCapacity = m.xxx_LenField3 * 2
+						} else {
+							newCapacity = m.xxx_LenField3 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field3)
+						m.field3 = t
+					}
+					m.field3[m.xxx_LenField3] = int32(v)
+					m.xxx_LenField3 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field3) <= m.xxx_LenField3 {
+					newCapacity := 0
+					if len(m.field3) == 0 {
+						newCapacity = 8
+					} else if len(m.field3) < 1000000 {
+						newCapacity = m.xxx_LenField3 * 2
+					} else {
+						newCapacity = m.xxx_LenField3 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field3)
+					m.field3 = t
+				}
+				m.field3[m.xxx_LenField3] = int32(v)
+				m.xxx_LenField3 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field3", wireType)
+			}
+		case 4:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field4) <= m.xxx_LenField4 {
+						newCapacity := 0
+						if len(m.field4) == 0 {
+							newCapacity = 8
+						} else if len(m.field4) < 1000000 {
+							newCapacity = m.xxx_LenField4 * 2
+						} else {
+							newCapacity = m.xxx_LenField4 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field4)
+						m.field4 = t
+					}
+					m.field4[m.xxx_LenField4] = int64(v)
+					m.xxx_LenField4 += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field4) <= m.xxx_LenField4 {
+					newCapacity := 0
+					if len(m.field4) == 0 {
+						newCapacity = 8
+					} else if len(m.field4) < 1000000 {
+						newCapacity = m.xxx_LenField4 * 2
+					} else {
+						newCapacity = m.xxx_LenField4 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field4)
+					m.field4 = t
+				}
+				m.field4[m.xxx_LenField4] = int64(v)
+				m.xxx_LenField4 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field4", wireType)
+			}
+		case 5:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field5) <= m.xxx_LenField5 {
+						newCapacity := 0
+						if len(m.field5) == 0 {
+							newCapacity = 8
+						} else if len(m.field5) < 1000000 {
+							newCapacity = m.xxx_LenField5 * 2
+						} else {
+							newCapacity = m.xxx_LenField5 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field5)
+						m.field5 = t
+					}
+					m.field5[m.xxx_LenField5] = uint32(v)
+					m.xxx_LenField5 += 1
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field5) <= m.xxx_LenField5 {
+					newCapacity := 0
+					if len(m.field5) == 0 {
+						newCapacity = 8
+					} else if len(m.field5) < 1000000 {
+						newCapacity = m.xxx_LenField5 * 2
+					} else {
+						newCapacity = m.xxx_LenField5 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field5)
+					m.field5 = t
+				}
+				m.field5[m.xxx_LenField5] = uint32(v)
+				m.xxx_LenField5 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field5", wireType)
+			}
+		case 6:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field6) <= m.xxx_LenField6 {
+						newCapacity := 0
+						if len(m.field6) == 0 {
+							newCapacity = 8
+						} else if len(m.field6) < 1000000 {
+							newCapacity = m.xxx_LenField6 * 2
+						} else {
+							newCapacity = m.xxx_LenField6 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field6)
+						m.field6 = t
+					}
+					m.field6[m.xxx_LenField6] = uint64(v)
+					m.xxx_LenField6 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field6) <= m.xxx_LenField6 {
+					newCapacity := 0
+					if len(m.field6) == 0 {
+						newCapacity = 8
+					} else if len(m.field6) < 1000000 {
+						newCapacity = m.xxx_LenField6 * 2
+					} else {
+						newCapacity = m.xxx_LenField6 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field6)
+					m.field6 = t
+				}
+				m.field6[m.xxx_LenField6] = uint64(v)
+				m.xxx_LenField6 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field6", wireType)
+			}
+		case 7:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					if len(m.field7) <= m.xxx_LenField7 {
+						newCapacity := 0
+						if len(m.field7) == 0 {
+							newCapacity = 8
+						} else if len(m.field7) < 1000000 {
+							newCapacity = m.xxx_LenField7 * 2
+						} else {
+							newCapacity = m.xxx_LenField7 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field7)
+						m.field7 = t
+					}
+					m.field7[m.xxx_LenField7] = int32(v)
+					m.xxx_LenField7 += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				if len(m.field7) <= m.xxx_LenField7 {
+					newCapacity := 0
+					if len(m.field7) == 0 {
+						newCapacity = 8
+					} else if len(m.field7) < 1000000 {
+						newCapacity = m.xxx_LenField7 * 2
+					} else {
+						newCapacity = m.xxx_LenField7 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field7)
+					m.field7 = t
+				}
+				m.field7[m.xxx_LenField7] = int32(v)
+				m.xxx_LenField7 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field7", wireType)
+			}
+		case 8:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					if len(m.field8) <= m.xxx_LenField8 {
+						newCapacity := 0
+						if len(m.field8) == 0 {
+							newCapacity = 8
+						} else if len(m.field8) < 1000000 {
+							newCapacity = m.xxx_LenField8 * 2
+						} else {
+							newCapacity = m.xxx_LenField8 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field8)
+						m.field8 = t
+					}
+					m.field8[m.xxx_LenField8] = int64(int64(v))
+					m.xxx_LenField8 += 1
+				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				if len(m.field8) <= m.xxx_LenField8 {
+					newCapacity := 0
+					if len(m.field8) == 0 {
+						newCapacity = 8
+					} else if len(m.field8) < 1000000 {
+						newCapacity = m.xxx_LenField8 * 2
+					} else {
+						newCapacity = m.xxx_LenField8 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field8)
+					m.field8 = t
+				}
+				m.field8[m.xxx_LenField8] = int64(int64(v))
+				m.xxx_LenField8 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field8", wireType)
+			}
+		case 9:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.field9) <= m.xxx_LenField9 {
+						newCapacity := 0
+						if len(m.field9) == 0 {
+							newCapacity = 8
+						} else if len(m.field9) < 1000000 {
+							newCapacity = m.xxx_LenField9 * 2
+						} else {
+							newCapacity = m.xxx_LenField9 + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.field9)
+						m.field9 = t
+					}
+					m.field9[m.xxx_LenField9] = uint32(v)
+					m.xxx_LenField9 += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
 				v |= uint32(data[i-1]) << 24
-				v2 := math1.Float32frombits(v)
-				m.Field2 = append(m.Field2, v2)
+				if len(m.field9) <= m.xxx_LenField9 {
+					newCapacity := 0
+					if len(m.field9) == 0 {
+						newCapacity = 8
+					} else if len(m.field9) < 1000000 {
+						newCapacity = m.xxx_LenField9 * 2
+					} else {
+						newCapacity = m.xxx_LenField9 + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.field9)
+					m.field9 = t
+				}
+				m.field9[m.xxx_LenField9] = uint32(v)
+				m.xxx_LenField9 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field9", wireType)
+			}
+		case 10:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int32(data[i-4])
+					v |= int32(data[i-3]) << 8
+					v |= int32(data[i-2]) << 16
+					v |= int32(data[i-1]) << 24
+					if len(m.field10) <= m.xxx_LenField10 {
+						newCapacity := 0
+						if len(m.field10) == 0 {
+							newCapacity = 8
+						} else if len(m.field10) < 1000000 {
+							newCapacity = m.xxx_LenField10 * 2
+						} else {
+							newCapacity = m.xxx_LenField10 + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.field10)
+						m.field10 = t
+					}
+					m.field10[m.xxx_LenField10] = int32(v)
+					m.xxx_LenField10 += 1
+				}
+			} else if wireType == 5 {
+				var v int32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int32(data[i-4])
+				v |= int32(data[i-3]) << 8
+				v |= int32(data[i-2]) << 16
+				v |= int32(data[i-1]) << 24
+				if len(m.field10) <= m.xxx_LenField10 {
+					newCapacity := 0
+					if len(m.field10) == 0 {
+						newCapacity = 8
+					} else if len(m.field10) < 1000000 {
+						newCapacity = m.xxx_LenField10 * 2
+					} else {
+						newCapacity = m.xxx_LenField10 + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.field10)
+					m.field10 = t
+				}
+				m.field10[m.xxx_LenField10] = int32(v)
+				m.xxx_LenField10 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field10", wireType)
+			}
+		case 11:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint64(data[i-8])
+					v |= uint64(data[i-7]) << 8
+					v |= uint64(data[i-6]) << 16
+					v |= uint64(data[i-5]) << 24
+					v |= uint64(data[i-4]) << 32
+					v |= uint64(data[i-3]) << 40
+					v |= uint64(data[i-2]) << 48
+					v |= uint64(data[i-1]) << 56
+					if len(m.field11) <= m.xxx_LenField11 {
+						newCapacity := 0
+						if len(m.field11) == 0 {
+							newCapacity = 8
+						} else if len(m.field11) < 1000000 {
+							newCapacity = m.xxx_LenField11 * 2
+						} else {
+							newCapacity = m.xxx_LenField11 + 1000000
+						}
+						t := make([]uint64, newCapacity, newCapacity)
+						copy(t, m.field11)
+						m.field11 = t
+					}
+					m.field11[m.xxx_LenField11] = uint64(v)
+					m.xxx_LenField11 += 1
+				}
+			} else if wireType == 1 {
+				var v uint64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint64(data[i-8])
+				v |= uint64(data[i-7]) << 8
+				v |= uint64(data[i-6]) << 16
+				v |= uint64(data[i-5]) << 24
+				v |= uint64(data[i-4]) << 32
+				v |= uint64(data[i-3]) << 40
+				v |= uint64(data[i-2]) << 48
+				v |= uint64(data[i-1]) << 56
+				if len(m.field11) <= m.xxx_LenField11 {
+					newCapacity := 0
+					if len(m.field11) == 0 {
+						newCapacity = 8
+					} else if len(m.field11) < 1000000 {
+						newCapacity = m.xxx_LenField11 * 2
+					} else {
+						newCapacity = m.xxx_LenField11 + 1000000
+					}
+					t := make([]uint64, newCapacity, newCapacity)
+					copy(t, m.field11)
+					m.field11 = t
+				}
+				m.field11[m.xxx_LenField11] = uint64(v)
+				m.xxx_LenField11 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field11", wireType)
+			}
+		case 12:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					i := index + 8
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = int64(data[i-8])
+					v |= int64(data[i-7]) << 8
+					v |= int64(data[i-6]) << 16
+					v |= int64(data[i-5]) << 24
+					v |= int64(data[i-4]) << 32
+					v |= int64(data[i-3]) << 40
+					v |= int64(data[i-2]) << 48
+					v |= int64(data[i-1]) << 56
+					if len(m.field12) <= m.xxx_LenField12 {
+						newCapacity := 0
+						if len(m.field12) == 0 {
+							newCapacity = 8
+						} else if len(m.field12) < 1000000 {
+							newCapacity = m.xxx_LenField12 * 2
+						} else {
+							newCapacity = m.xxx_LenField12 + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.field12)
+						m.field12 = t
+					}
+					m.field12[m.xxx_LenField12] = int64(v)
+					m.xxx_LenField12 += 1
+				}
+			} else if wireType == 1 {
+				var v int64
+				i := index + 8
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = int64(data[i-8])
+				v |= int64(data[i-7]) << 8
+				v |= int64(data[i-6]) << 16
+				v |= int64(data[i-5]) << 24
+				v |= int64(data[i-4]) << 32
+				v |= int64(data[i-3]) << 40
+				v |= int64(data[i-2]) << 48
+				v |= int64(data[i-1]) << 56
+				if len(m.field12) <= m.xxx_LenField12 {
+					newCapacity := 0
+					if len(m.field12) == 0 {
+						newCapacity = 8
+					} else if len(m.field12) < 1000000 {
+						newCapacity = m.xxx_LenField12 * 2
+					} else {
+						newCapacity = m.xxx_LenField12 + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.field12)
+					m.field12 = t
+				}
+				m.field12[m.xxx_LenField12] = int64(v)
+				m.xxx_LenField12 += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field field12", wireType)
+			}
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.field13) <= m.xxx_LenField13 {
+						newCapacity := 0
+						if len(m.field13) == 0 {
+							newCapacity = 8
+						} else if len(m.field13) < 1000000 {
+							newCapacity = m.xxx_LenField13 * 2
+						} else {
+							newCapacity = m.xxx_LenField13 + 1000000
+						}
+						t := make([]bool, newCapacity, newCapacity)
+						copy(t, m.field13)
+						m.field13 = t
+					}
+					m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+					m.xxx_LenField13 += 1
+				}
+			} else if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.field13) <= m.xxx_LenField13 {
+					newCapacity := 0
+					if len(m.field13) == 0 {
+						newCapacity = 8
+					} else if len(m.field13) < 1000000 {
+						newCapacity = m.xxx_LenField13 * 2
+					} else {
+						newCapacity = m.xxx_LenField13 + 1000000
+					}
+					t := make([]bool, newCapacity, newCapacity)
+					copy(t, m.field13)
+					m.field13 = t
+				}
+				m.field13[m.xxx_LenField13] = bool(bool(v != 0))
+				m.xxx_LenField13 += 1
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field field13", wireType)
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *NinRepNative) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *NinRepNative) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *NinRepPackedNative) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *NinRepPackedNative) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *NinRepNativeUnsafe) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *NinRepNativeUnsafe) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *NinRepPackedNativeUnsafe) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *NinRepPackedNativeUnsafe) CheckInitialized() error {
+	return nil
+}
+
+func (m *NinRepNative) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_LenField1 > 0 || w.EmitDefaults() {
+		w.Field("Field1", "Field1")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField1; i++ {
+			w.Float64(m.field1[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField2 > 0 || w.EmitDefaults() {
+		w.Field("Field2", "Field2")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField2; i++ {
+			w.Float32(m.field2[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField3 > 0 || w.EmitDefaults() {
+		w.Field("Field3", "Field3")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField3; i++ {
+			w.Int32(m.field3[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField4 > 0 || w.EmitDefaults() {
+		w.Field("Field4", "Field4")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField4; i++ {
+			w.Int64(m.field4[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField5 > 0 || w.EmitDefaults() {
+		w.Field("Field5", "Field5")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField5; i++ {
+			w.Uint32(m.field5[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField6 > 0 || w.EmitDefaults() {
+		w.Field("Field6", "Field6")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField6; i++ {
+			w.Uint64(m.field6[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField7 > 0 || w.EmitDefaults() {
+		w.Field("Field7", "Field7")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField7; i++ {
+			w.Int32(m.field7[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField8 > 0 || w.EmitDefaults() {
+		w.Field("Field8", "Field8")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField8; i++ {
+			w.Int64(m.field8[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField9 > 0 || w.EmitDefaults() {
+		w.Field("Field9", "Field9")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField9; i++ {
+			w.Uint32(m.field9[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField10 > 0 || w.EmitDefaults() {
+		w.Field("Field10", "Field10")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField10; i++ {
+			w.Int32(m.field10[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField11 > 0 || w.EmitDefaults() {
+		w.Field("Field11", "Field11")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField11; i++ {
+			w.Uint64(m.field11[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField12 > 0 || w.EmitDefaults() {
+		w.Field("Field12", "Field12")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField12; i++ {
+			w.Int64(m.field12[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField13 > 0 || w.EmitDefaults() {
+		w.Field("Field13", "Field13")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField13; i++ {
+			w.Bool(m.field13[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *NinRepNative) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("Field1", "Field1"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField1(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field2", "Field2"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField2(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field3", "Field3"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField3(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field4", "Field4"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField4(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field5", "Field5"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField5(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field6", "Field6"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField6(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field7", "Field7"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField7(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field8", "Field8"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField8(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field9", "Field9"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField9(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field10", "Field10"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField10(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field11", "Field11"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField11(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field12", "Field12"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField12(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field13", "Field13"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Bool(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField13(v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *NinRepNative) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *NinRepNative) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *NinRepPackedNative) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_LenField1 > 0 || w.EmitDefaults() {
+		w.Field("Field1", "Field1")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField1; i++ {
+			w.Float64(m.field1[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField2 > 0 || w.EmitDefaults() {
+		w.Field("Field2", "Field2")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField2; i++ {
+			w.Float32(m.field2[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField3 > 0 || w.EmitDefaults() {
+		w.Field("Field3", "Field3")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField3; i++ {
+			w.Int32(m.field3[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField4 > 0 || w.EmitDefaults() {
+		w.Field("Field4", "Field4")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField4; i++ {
+			w.Int64(m.field4[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField5 > 0 || w.EmitDefaults() {
+		w.Field("Field5", "Field5")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField5; i++ {
+			w.Uint32(m.field5[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField6 > 0 || w.EmitDefaults() {
+		w.Field("Field6", "Field6")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField6; i++ {
+			w.Uint64(m.field6[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField7 > 0 || w.EmitDefaults() {
+		w.Field("Field7", "Field7")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField7; i++ {
+			w.Int32(m.field7[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField8 > 0 || w.EmitDefaults() {
+		w.Field("Field8", "Field8")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField8; i++ {
+			w.Int64(m.field8[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField9 > 0 || w.EmitDefaults() {
+		w.Field("Field9", "Field9")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField9; i++ {
+			w.Uint32(m.field9[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField10 > 0 || w.EmitDefaults() {
+		w.Field("Field10", "Field10")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField10; i++ {
+			w.Int32(m.field10[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField11 > 0 || w.EmitDefaults() {
+		w.Field("Field11", "Field11")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField11; i++ {
+			w.Uint64(m.field11[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField12 > 0 || w.EmitDefaults() {
+		w.Field("Field12", "Field12")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField12; i++ {
+			w.Int64(m.field12[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField13 > 0 || w.EmitDefaults() {
+		w.Field("Field13", "Field13")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField13; i++ {
+			w.Bool(m.field13[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *NinRepPackedNative) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("Field1", "Field1"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField1(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field2", "Field2"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField2(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field3", "Field3"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField3(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field4", "Field4"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField4(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field5", "Field5"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField5(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field6", "Field6"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField6(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field7", "Field7"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField7(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field8", "Field8"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField8(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field9", "Field9"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField9(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field10", "Field10"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField10(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field11", "Field11"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField11(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field12", "Field12"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField12(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field13", "Field13"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Bool(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField13(v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *NinRepPackedNative) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *NinRepPackedNative) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *NinRepNativeUnsafe) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_LenField1 > 0 || w.EmitDefaults() {
+		w.Field("Field1", "Field1")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField1; i++ {
+			w.Float64(m.field1[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField2 > 0 || w.EmitDefaults() {
+		w.Field("Field2", "Field2")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField2; i++ {
+			w.Float32(m.field2[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField3 > 0 || w.EmitDefaults() {
+		w.Field("Field3", "Field3")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField3; i++ {
+			w.Int32(m.field3[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField4 > 0 || w.EmitDefaults() {
+		w.Field("Field4", "Field4")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField4; i++ {
+			w.Int64(m.field4[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField5 > 0 || w.EmitDefaults() {
+		w.Field("Field5", "Field5")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField5; i++ {
+			w.Uint32(m.field5[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField6 > 0 || w.EmitDefaults() {
+		w.Field("Field6", "Field6")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField6; i++ {
+			w.Uint64(m.field6[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField7 > 0 || w.EmitDefaults() {
+		w.Field("Field7", "Field7")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField7; i++ {
+			w.Int32(m.field7[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField8 > 0 || w.EmitDefaults() {
+		w.Field("Field8", "Field8")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField8; i++ {
+			w.Int64(m.field8[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField9 > 0 || w.EmitDefaults() {
+		w.Field("Field9", "Field9")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField9; i++ {
+			w.Uint32(m.field9[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField10 > 0 || w.EmitDefaults() {
+		w.Field("Field10", "Field10")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField10; i++ {
+			w.Int32(m.field10[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField11 > 0 || w.EmitDefaults() {
+		w.Field("Field11", "Field11")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField11; i++ {
+			w.Uint64(m.field11[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField12 > 0 || w.EmitDefaults() {
+		w.Field("Field12", "Field12")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField12; i++ {
+			w.Int64(m.field12[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField13 > 0 || w.EmitDefaults() {
+		w.Field("Field13", "Field13")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField13; i++ {
+			w.Bool(m.field13[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *NinRepNativeUnsafe) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("Field1", "Field1"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField1(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field2", "Field2"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField2(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field3", "Field3"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField3(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field4", "Field4"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField4(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field5", "Field5"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField5(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field6", "Field6"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField6(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field7", "Field7"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField7(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field8", "Field8"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField8(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field9", "Field9"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField9(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field10", "Field10"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField10(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field11", "Field11"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField11(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field12", "Field12"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField12(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field13", "Field13"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Bool(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField13(v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *NinRepNativeUnsafe) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *NinRepNativeUnsafe) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *NinRepPackedNativeUnsafe) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_LenField1 > 0 || w.EmitDefaults() {
+		w.Field("Field1", "Field1")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField1; i++ {
+			w.Float64(m.field1[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField2 > 0 || w.EmitDefaults() {
+		w.Field("Field2", "Field2")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField2; i++ {
+			w.Float32(m.field2[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField3 > 0 || w.EmitDefaults() {
+		w.Field("Field3", "Field3")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField3; i++ {
+			w.Int32(m.field3[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField4 > 0 || w.EmitDefaults() {
+		w.Field("Field4", "Field4")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField4; i++ {
+			w.Int64(m.field4[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField5 > 0 || w.EmitDefaults() {
+		w.Field("Field5", "Field5")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField5; i++ {
+			w.Uint32(m.field5[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField6 > 0 || w.EmitDefaults() {
+		w.Field("Field6", "Field6")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField6; i++ {
+			w.Uint64(m.field6[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField7 > 0 || w.EmitDefaults() {
+		w.Field("Field7", "Field7")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField7; i++ {
+			w.Int32(m.field7[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField8 > 0 || w.EmitDefaults() {
+		w.Field("Field8", "Field8")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField8; i++ {
+			w.Int64(m.field8[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField9 > 0 || w.EmitDefaults() {
+		w.Field("Field9", "Field9")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField9; i++ {
+			w.Uint32(m.field9[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField10 > 0 || w.EmitDefaults() {
+		w.Field("Field10", "Field10")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField10; i++ {
+			w.Int32(m.field10[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField11 > 0 || w.EmitDefaults() {
+		w.Field("Field11", "Field11")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField11; i++ {
+			w.Uint64(m.field11[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField12 > 0 || w.EmitDefaults() {
+		w.Field("Field12", "Field12")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField12; i++ {
+			w.Int64(m.field12[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenField13 > 0 || w.EmitDefaults() {
+		w.Field("Field13", "Field13")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenField13; i++ {
+			w.Bool(m.field13[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *NinRepPackedNativeUnsafe) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("Field1", "Field1"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float64(elem)
+			if err != nil {
+				return err
 			}
-		case 3:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Field3 = append(m.Field3, v)
-				}
-			} else if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Field3 = append(m.Field3, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field3", wireType)
+			if err := m.AddField1(v); err != nil {
+				return err
 			}
-		case 4:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Field4 = append(m.Field4, v)
-				}
-			} else if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Field4 = append(m.Field4, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field4", wireType)
+		}
+	}
+	if raw, ok := fields.Get("Field2", "Field2"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Float32(elem)
+			if err != nil {
+				return err
 			}
-		case 5:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Field5 = append(m.Field5, v)
-				}
-			} else if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (uint32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Field5 = append(m.Field5, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field5", wireType)
+			if err := m.AddField2(v); err != nil {
+				return err
 			}
-		case 6:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Field6 = append(m.Field6, v)
-				}
-			} else if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Field6 = append(m.Field6, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field6", wireType)
+		}
+	}
+	if raw, ok := fields.Get("Field3", "Field3"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
 			}
-		case 7:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.Field7 = append(m.Field7, v)
-				}
-			} else if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-				m.Field7 = append(m.Field7, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field7", wireType)
+			if err := m.AddField3(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field4", "Field4"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField4(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field5", "Field5"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
 			}
-		case 8:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.Field8 = append(m.Field8, int64(v))
-				}
-			} else if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-				m.Field8 = append(m.Field8, int64(v))
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field8", wireType)
+			if err := m.AddField5(v); err != nil {
+				return err
 			}
-		case 9:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint32
-					i := index + 4
-					if i > l {
-						return io.ErrUnexpectedEOF
-					}
-					index = i
-					v = uint32(data[i-4])
-					v |= uint32(data[i-3]) << 8
-					v |= uint32(data[i-2]) << 16
-					v |= uint32(data[i-1]) << 24
-					m.Field9 = append(m.Field9, v)
-				}
-			} else if wireType == 5 {
-				var v uint32
-				i := index + 4
-				if i > l {
-					return io.ErrUnexpectedEOF
-				}
-				index = i
-				v = uint32(data[i-4])
-				v |= uint32(data[i-3]) << 8
-				v |= uint32(data[i-2]) << 16
-				v |= uint32(data[i-1]) << 24
-				m.Field9 = append(m.Field9, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field9", wireType)
+		}
+	}
+	if raw, ok := fields.Get("Field6", "Field6"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
 			}
-		case 10:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int32
-					i := index + 4
-					if i > l {
-						return io.ErrUnexpectedEOF
-					}
-					index = i
-					v = int32(data[i-4])
-					v |= int32(data[i-3]) << 8
-					v |= int32(data[i-2]) << 16
-					v |= int32(data[i-1]) << 24
-					m.Field10 = append(m.Field10, v)
-				}
-			} else if wireType == 5 {
-				var v int32
-				i := index + 4
-				if i > l {
-					return io.ErrUnexpectedEOF
-				}
-				index = i
-				v = int32(data[i-4])
-				v |= int32(data[i-3]) << 8
-				v |= int32(data[i-2]) << 16
-				v |= int32(data[i-1]) << 24
-				m.Field10 = append(m.Field10, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field10", wireType)
+			if err := m.AddField6(v); err != nil {
+				return err
 			}
-		case 11:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint64
-					i := index + 8
-					if i > l {
-						return io.ErrUnexpectedEOF
-					}
-					index = i
-					v = uint64(data[i-8])
-					v |= uint64(data[i-7]) << 8
-					v |= uint64(data[i-6]) << 16
-					v |= uint64(data[i-5]) << 24
-					v |= uint64(data[i-4]) << 32
-					v |= uint64(data[i-3]) << 40
-					v |= uint64(data[i-2]) << 48
-					v |= uint64(data[i-1]) << 56
-					m.Field11 = append(m.Field11, v)
-				}
-			} else if wireType == 1 {
-				var v uint64
-				i := index + 8
-				if i > l {
-					return io.ErrUnexpectedEOF
-				}
-				index = i
-				v = uint64(data[i-8])
-				v |= uint64(data[i-7]) << 8
-				v |= uint64(data[i-6]) << 16
-				v |= uint64(data[i-5]) << 24
-				v |= uint64(data[i-4]) << 32
-				v |= uint64(data[i-3]) << 40
-				v |= uint64(data[i-2]) << 48
-				v |= uint64(data[i-1]) << 56
-				m.Field11 = append(m.Field11, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field11", wireType)
+		}
+	}
+	if raw, ok := fields.Get("Field7", "Field7"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
 			}
-		case 12:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int64
-					i := index + 8
-					if i > l {
-						return io.ErrUnexpectedEOF
-					}
-					index = i
-					v = int64(data[i-8])
-					v |= int64(data[i-7]) << 8
-					v |= int64(data[i-6]) << 16
-					v |= int64(data[i-5]) << 24
-					v |= int64(data[i-4]) << 32
-					v |= int64(data[i-3]) << 40
-					v |= int64(data[i-2]) << 48
-					v |= int64(data[i-1]) << 56
-					m.Field12 = append(m.Field12, v)
-				}
-			} else if wireType == 1 {
-				var v int64
-				i := index + 8
-				if i > l {
-					return io.ErrUnexpectedEOF
-				}
-				index = i
-				v = int64(data[i-8])
-				v |= int64(data[i-7]) << 8
-				v |= int64(data[i-6]) << 16
-				v |= int64(data[i-5]) << 24
-				v |= int64(data[i-4]) << 32
-				v |= int64(data[i-3]) << 40
-				v |= int64(data[i-2]) << 48
-				v |= int64(data[i-1]) << 56
-				m.Field12 = append(m.Field12, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field12", wireType)
+			if err := m.AddField7(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field8", "Field8"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
 			}
-		case 13:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Field13 = append(m.Field13, bool(v != 0))
-				}
-			} else if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Field13 = append(m.Field13, bool(v != 0))
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field13", wireType)
+			if err := m.AddField8(v); err != nil {
+				return err
 			}
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
+		}
+	}
+	if raw, ok := fields.Get("Field9", "Field9"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
 			}
-			index -= sizeOfWire
-			skippy, err := dropbox_gogoprotobuf_proto.Skip(data[index:])
+			if err := m.AddField9(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field10", "Field10"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
 			if err != nil {
 				return err
 			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.AddField10(v); err != nil {
+				return err
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
 		}
 	}
-	return nil
+	if raw, ok := fields.Get("Field11", "Field11"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField11(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field12", "Field12"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField12(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("Field13", "Field13"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Bool(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddField13(v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *NinRepPackedNativeUnsafe) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *NinRepPackedNativeUnsafe) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *NinRepNative) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenField1; i++ {
+		w.Field("Field1")
+		w.Value(m.field1[i])
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		w.Field("Field2")
+		w.Value(m.field2[i])
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		w.Field("Field3")
+		w.Value(m.field3[i])
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		w.Field("Field4")
+		w.Value(m.field4[i])
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		w.Field("Field5")
+		w.Value(m.field5[i])
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		w.Field("Field6")
+		w.Value(m.field6[i])
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		w.Field("Field7")
+		w.Value(m.field7[i])
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		w.Field("Field8")
+		w.Value(m.field8[i])
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		w.Field("Field9")
+		w.Value(m.field9[i])
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		w.Field("Field10")
+		w.Value(m.field10[i])
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		w.Field("Field11")
+		w.Value(m.field11[i])
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		w.Field("Field12")
+		w.Value(m.field12[i])
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		w.Field("Field13")
+		w.Value(m.field13[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *NinRepNative) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "Field1":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField1(v)
+	case "Field2":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField2(v)
+	case "Field3":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField3(v)
+	case "Field4":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField4(v)
+	case "Field5":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField5(v)
+	case "Field6":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField6(v)
+	case "Field7":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField7(v)
+	case "Field8":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField8(v)
+	case "Field9":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField9(v)
+	case "Field10":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField10(v)
+	case "Field11":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField11(v)
+	case "Field12":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField12(v)
+	case "Field13":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField13(v)
+	}
+	return false, nil
+}
+
+func (m *NinRepPackedNative) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenField1; i++ {
+		w.Field("Field1")
+		w.Value(m.field1[i])
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		w.Field("Field2")
+		w.Value(m.field2[i])
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		w.Field("Field3")
+		w.Value(m.field3[i])
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		w.Field("Field4")
+		w.Value(m.field4[i])
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		w.Field("Field5")
+		w.Value(m.field5[i])
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		w.Field("Field6")
+		w.Value(m.field6[i])
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		w.Field("Field7")
+		w.Value(m.field7[i])
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		w.Field("Field8")
+		w.Value(m.field8[i])
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		w.Field("Field9")
+		w.Value(m.field9[i])
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		w.Field("Field10")
+		w.Value(m.field10[i])
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		w.Field("Field11")
+		w.Value(m.field11[i])
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		w.Field("Field12")
+		w.Value(m.field12[i])
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		w.Field("Field13")
+		w.Value(m.field13[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *NinRepPackedNative) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "Field1":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField1(v)
+	case "Field2":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField2(v)
+	case "Field3":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField3(v)
+	case "Field4":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField4(v)
+	case "Field5":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField5(v)
+	case "Field6":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField6(v)
+	case "Field7":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField7(v)
+	case "Field8":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField8(v)
+	case "Field9":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField9(v)
+	case "Field10":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField10(v)
+	case "Field11":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField11(v)
+	case "Field12":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField12(v)
+	case "Field13":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField13(v)
+	}
+	return false, nil
+}
+
+func (m *NinRepNativeUnsafe) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenField1; i++ {
+		w.Field("Field1")
+		w.Value(m.field1[i])
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		w.Field("Field2")
+		w.Value(m.field2[i])
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		w.Field("Field3")
+		w.Value(m.field3[i])
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		w.Field("Field4")
+		w.Value(m.field4[i])
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		w.Field("Field5")
+		w.Value(m.field5[i])
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		w.Field("Field6")
+		w.Value(m.field6[i])
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		w.Field("Field7")
+		w.Value(m.field7[i])
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		w.Field("Field8")
+		w.Value(m.field8[i])
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		w.Field("Field9")
+		w.Value(m.field9[i])
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		w.Field("Field10")
+		w.Value(m.field10[i])
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		w.Field("Field11")
+		w.Value(m.field11[i])
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		w.Field("Field12")
+		w.Value(m.field12[i])
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		w.Field("Field13")
+		w.Value(m.field13[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *NinRepNativeUnsafe) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "Field1":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField1(v)
+	case "Field2":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField2(v)
+	case "Field3":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField3(v)
+	case "Field4":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField4(v)
+	case "Field5":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField5(v)
+	case "Field6":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField6(v)
+	case "Field7":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField7(v)
+	case "Field8":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField8(v)
+	case "Field9":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField9(v)
+	case "Field10":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField10(v)
+	case "Field11":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField11(v)
+	case "Field12":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField12(v)
+	case "Field13":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField13(v)
+	}
+	return false, nil
+}
+
+func (m *NinRepPackedNativeUnsafe) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenField1; i++ {
+		w.Field("Field1")
+		w.Value(m.field1[i])
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		w.Field("Field2")
+		w.Value(m.field2[i])
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		w.Field("Field3")
+		w.Value(m.field3[i])
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		w.Field("Field4")
+		w.Value(m.field4[i])
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		w.Field("Field5")
+		w.Value(m.field5[i])
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		w.Field("Field6")
+		w.Value(m.field6[i])
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		w.Field("Field7")
+		w.Value(m.field7[i])
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		w.Field("Field8")
+		w.Value(m.field8[i])
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		w.Field("Field9")
+		w.Value(m.field9[i])
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		w.Field("Field10")
+		w.Value(m.field10[i])
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		w.Field("Field11")
+		w.Value(m.field11[i])
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		w.Field("Field12")
+		w.Value(m.field12[i])
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		w.Field("Field13")
+		w.Value(m.field13[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *NinRepPackedNativeUnsafe) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "Field1":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField1(v)
+	case "Field2":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField2(v)
+	case "Field3":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField3(v)
+	case "Field4":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField4(v)
+	case "Field5":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField5(v)
+	case "Field6":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField6(v)
+	case "Field7":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField7(v)
+	case "Field8":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField8(v)
+	case "Field9":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField9(v)
+	case "Field10":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField10(v)
+	case "Field11":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField11(v)
+	case "Field12":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField12(v)
+	case "Field13":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddField13(v)
+	}
+	return false, nil
+}
+
+func (m *NinRepNative) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &NinRepNative{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *NinRepNative) MergeFrom(src proto.Message) {
+	s, ok := src.(*NinRepNative)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenField1; i++ {
+		m.AddField1(s.field1[i])
+	}
+	for i := 0; i < s.xxx_LenField2; i++ {
+		m.AddField2(s.field2[i])
+	}
+	for i := 0; i < s.xxx_LenField3; i++ {
+		m.AddField3(s.field3[i])
+	}
+	for i := 0; i < s.xxx_LenField4; i++ {
+		m.AddField4(s.field4[i])
+	}
+	for i := 0; i < s.xxx_LenField5; i++ {
+		m.AddField5(s.field5[i])
+	}
+	for i := 0; i < s.xxx_LenField6; i++ {
+		m.AddField6(s.field6[i])
+	}
+	for i := 0; i < s.xxx_LenField7; i++ {
+		m.AddField7(s.field7[i])
+	}
+	for i := 0; i < s.xxx_LenField8; i++ {
+		m.AddField8(s.field8[i])
+	}
+	for i := 0; i < s.xxx_LenField9; i++ {
+		m.AddField9(s.field9[i])
+	}
+	for i := 0; i < s.xxx_LenField10; i++ {
+		m.AddField10(s.field10[i])
+	}
+	for i := 0; i < s.xxx_LenField11; i++ {
+		m.AddField11(s.field11[i])
+	}
+	for i := 0; i < s.xxx_LenField12; i++ {
+		m.AddField12(s.field12[i])
+	}
+	for i := 0; i < s.xxx_LenField13; i++ {
+		m.AddField13(s.field13[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *NinRepNative) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*NinRepNative)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenField1 != o.xxx_LenField1 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField1; i++ {
+		if m.field1[i] != o.field1[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField2 != o.xxx_LenField2 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		if m.field2[i] != o.field2[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField3 != o.xxx_LenField3 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		if m.field3[i] != o.field3[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField4 != o.xxx_LenField4 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		if m.field4[i] != o.field4[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField5 != o.xxx_LenField5 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		if m.field5[i] != o.field5[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField6 != o.xxx_LenField6 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		if m.field6[i] != o.field6[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField7 != o.xxx_LenField7 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		if m.field7[i] != o.field7[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField8 != o.xxx_LenField8 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		if m.field8[i] != o.field8[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField9 != o.xxx_LenField9 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		if m.field9[i] != o.field9[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField10 != o.xxx_LenField10 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		if m.field10[i] != o.field10[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField11 != o.xxx_LenField11 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		if m.field11[i] != o.field11[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField12 != o.xxx_LenField12 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		if m.field12[i] != o.field12[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField13 != o.xxx_LenField13 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		if m.field13[i] != o.field13[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-func (m *NinRepNativeUnsafe) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io1.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *NinRepPackedNative) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &NinRepPackedNative{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *NinRepPackedNative) MergeFrom(src proto.Message) {
+	s, ok := src.(*NinRepPackedNative)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenField1; i++ {
+		m.AddField1(s.field1[i])
+	}
+	for i := 0; i < s.xxx_LenField2; i++ {
+		m.AddField2(s.field2[i])
+	}
+	for i := 0; i < s.xxx_LenField3; i++ {
+		m.AddField3(s.field3[i])
+	}
+	for i := 0; i < s.xxx_LenField4; i++ {
+		m.AddField4(s.field4[i])
+	}
+	for i := 0; i < s.xxx_LenField5; i++ {
+		m.AddField5(s.field5[i])
+	}
+	for i := 0; i < s.xxx_LenField6; i++ {
+		m.AddField6(s.field6[i])
+	}
+	for i := 0; i < s.xxx_LenField7; i++ {
+		m.AddField7(s.field7[i])
+	}
+	for i := 0; i < s.xxx_LenField8; i++ {
+		m.AddField8(s.field8[i])
+	}
+	for i := 0; i < s.xxx_LenField9; i++ {
+		m.AddField9(s.field9[i])
+	}
+	for i := 0; i < s.xxx_LenField10; i++ {
+		m.AddField10(s.field10[i])
+	}
+	for i := 0; i < s.xxx_LenField11; i++ {
+		m.AddField11(s.field11[i])
+	}
+	for i := 0; i < s.xxx_LenField12; i++ {
+		m.AddField12(s.field12[i])
+	}
+	for i := 0; i < s.xxx_LenField13; i++ {
+		m.AddField13(s.field13[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *NinRepPackedNative) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*NinRepPackedNative)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenField1 != o.xxx_LenField1 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField1; i++ {
+		if m.field1[i] != o.field1[i] {
+			return false
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field1", wireType)
-			}
-			var v float64
-			if index+8 > l {
-				return io1.ErrUnexpectedEOF
-			}
-			v = *(*float64)(unsafe.Pointer(&data[index]))
-			index += 8
-			m.Field1 = append(m.Field1, v)
-		case 2:
-			if wireType != 5 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field2", wireType)
-			}
-			var v float32
-			if index+4 > l {
-				return io1.ErrUnexpectedEOF
-			}
-			v = *(*float32)(unsafe.Pointer(&data[index]))
-			index += 4
-			m.Field2 = append(m.Field2, v)
-		case 3:
-			if wireType != 0 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field3", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io1.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field3 = append(m.Field3, v)
-		case 4:
-			if wireType != 0 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field4", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io1.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field4 = append(m.Field4, v)
-		case 5:
-			if wireType != 0 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field5", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io1.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field5 = append(m.Field5, v)
-		case 6:
-			if wireType != 0 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field6", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io1.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field6 = append(m.Field6, v)
-		case 7:
-			if wireType != 0 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field7", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io1.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.Field7 = append(m.Field7, v)
-		case 8:
-			if wireType != 0 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field8", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io1.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-			m.Field8 = append(m.Field8, int64(v))
-		case 9:
-			if wireType != 5 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field9", wireType)
-			}
-			var v uint32
-			if index+4 > l {
-				return io1.ErrUnexpectedEOF
-			}
-			v = *(*uint32)(unsafe.Pointer(&data[index]))
-			index += 4
-			m.Field9 = append(m.Field9, v)
-		case 10:
-			if wireType != 5 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field10", wireType)
-			}
-			var v int32
-			if index+4 > l {
-				return io1.ErrUnexpectedEOF
-			}
-			v = *(*int32)(unsafe.Pointer(&data[index]))
-			index += 4
-			m.Field10 = append(m.Field10, v)
-		case 11:
-			if wireType != 1 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field11", wireType)
-			}
-			var v uint64
-			if index+8 > l {
-				return io1.ErrUnexpectedEOF
-			}
-			v = *(*uint64)(unsafe.Pointer(&data[index]))
-			index += 8
-			m.Field11 = append(m.Field11, v)
-		case 12:
-			if wireType != 1 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field12", wireType)
-			}
-			var v int64
-			if index+8 > l {
-				return io1.ErrUnexpectedEOF
-			}
-			v = *(*int64)(unsafe.Pointer(&data[index]))
-			index += 8
-			m.Field12 = append(m.Field12, v)
-		case 13:
-			if wireType != 0 {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field13", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io1.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Field13 = append(m.Field13, bool(v != 0))
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
-			}
-			index -= sizeOfWire
-			skippy, err := dropbox_gogoprotobuf_proto1.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io1.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
+	}
+	if m.xxx_LenField2 != o.xxx_LenField2 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		if m.field2[i] != o.field2[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField3 != o.xxx_LenField3 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		if m.field3[i] != o.field3[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField4 != o.xxx_LenField4 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		if m.field4[i] != o.field4[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField5 != o.xxx_LenField5 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		if m.field5[i] != o.field5[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField6 != o.xxx_LenField6 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		if m.field6[i] != o.field6[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField7 != o.xxx_LenField7 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		if m.field7[i] != o.field7[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField8 != o.xxx_LenField8 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		if m.field8[i] != o.field8[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField9 != o.xxx_LenField9 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		if m.field9[i] != o.field9[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField10 != o.xxx_LenField10 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		if m.field10[i] != o.field10[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField11 != o.xxx_LenField11 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		if m.field11[i] != o.field11[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField12 != o.xxx_LenField12 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		if m.field12[i] != o.field12[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField13 != o.xxx_LenField13 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		if m.field13[i] != o.field13[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *NinRepNativeUnsafe) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &NinRepNativeUnsafe{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *NinRepNativeUnsafe) MergeFrom(src proto.Message) {
+	s, ok := src.(*NinRepNativeUnsafe)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenField1; i++ {
+		m.AddField1(s.field1[i])
+	}
+	for i := 0; i < s.xxx_LenField2; i++ {
+		m.AddField2(s.field2[i])
+	}
+	for i := 0; i < s.xxx_LenField3; i++ {
+		m.AddField3(s.field3[i])
+	}
+	for i := 0; i < s.xxx_LenField4; i++ {
+		m.AddField4(s.field4[i])
+	}
+	for i := 0; i < s.xxx_LenField5; i++ {
+		m.AddField5(s.field5[i])
+	}
+	for i := 0; i < s.xxx_LenField6; i++ {
+		m.AddField6(s.field6[i])
+	}
+	for i := 0; i < s.xxx_LenField7; i++ {
+		m.AddField7(s.field7[i])
+	}
+	for i := 0; i < s.xxx_LenField8; i++ {
+		m.AddField8(s.field8[i])
+	}
+	for i := 0; i < s.xxx_LenField9; i++ {
+		m.AddField9(s.field9[i])
+	}
+	for i := 0; i < s.xxx_LenField10; i++ {
+		m.AddField10(s.field10[i])
+	}
+	for i := 0; i < s.xxx_LenField11; i++ {
+		m.AddField11(s.field11[i])
+	}
+	for i := 0; i < s.xxx_LenField12; i++ {
+		m.AddField12(s.field12[i])
+	}
+	for i := 0; i < s.xxx_LenField13; i++ {
+		m.AddField13(s.field13[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *NinRepNativeUnsafe) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*NinRepNativeUnsafe)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenField1 != o.xxx_LenField1 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField1; i++ {
+		if m.field1[i] != o.field1[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField2 != o.xxx_LenField2 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		if m.field2[i] != o.field2[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField3 != o.xxx_LenField3 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		if m.field3[i] != o.field3[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField4 != o.xxx_LenField4 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		if m.field4[i] != o.field4[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField5 != o.xxx_LenField5 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		if m.field5[i] != o.field5[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField6 != o.xxx_LenField6 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		if m.field6[i] != o.field6[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField7 != o.xxx_LenField7 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		if m.field7[i] != o.field7[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField8 != o.xxx_LenField8 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		if m.field8[i] != o.field8[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField9 != o.xxx_LenField9 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		if m.field9[i] != o.field9[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField10 != o.xxx_LenField10 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		if m.field10[i] != o.field10[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField11 != o.xxx_LenField11 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		if m.field11[i] != o.field11[i] {
+			return false
 		}
 	}
-	return nil
+	if m.xxx_LenField12 != o.xxx_LenField12 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		if m.field12[i] != o.field12[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField13 != o.xxx_LenField13 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		if m.field13[i] != o.field13[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *NinRepPackedNativeUnsafe) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &NinRepPackedNativeUnsafe{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *NinRepPackedNativeUnsafe) MergeFrom(src proto.Message) {
+	s, ok := src.(*NinRepPackedNativeUnsafe)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenField1; i++ {
+		m.AddField1(s.field1[i])
+	}
+	for i := 0; i < s.xxx_LenField2; i++ {
+		m.AddField2(s.field2[i])
+	}
+	for i := 0; i < s.xxx_LenField3; i++ {
+		m.AddField3(s.field3[i])
+	}
+	for i := 0; i < s.xxx_LenField4; i++ {
+		m.AddField4(s.field4[i])
+	}
+	for i := 0; i < s.xxx_LenField5; i++ {
+		m.AddField5(s.field5[i])
+	}
+	for i := 0; i < s.xxx_LenField6; i++ {
+		m.AddField6(s.field6[i])
+	}
+	for i := 0; i < s.xxx_LenField7; i++ {
+		m.AddField7(s.field7[i])
+	}
+	for i := 0; i < s.xxx_LenField8; i++ {
+		m.AddField8(s.field8[i])
+	}
+	for i := 0; i < s.xxx_LenField9; i++ {
+		m.AddField9(s.field9[i])
+	}
+	for i := 0; i < s.xxx_LenField10; i++ {
+		m.AddField10(s.field10[i])
+	}
+	for i := 0; i < s.xxx_LenField11; i++ {
+		m.AddField11(s.field11[i])
+	}
+	for i := 0; i < s.xxx_LenField12; i++ {
+		m.AddField12(s.field12[i])
+	}
+	for i := 0; i < s.xxx_LenField13; i++ {
+		m.AddField13(s.field13[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
 }
-func (m *NinRepPackedNativeUnsafe) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io1.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *NinRepPackedNativeUnsafe) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*NinRepPackedNativeUnsafe)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenField1 != o.xxx_LenField1 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField1; i++ {
+		if m.field1[i] != o.field1[i] {
+			return false
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v float64
-					if index+8 > l {
-						return io1.ErrUnexpectedEOF
-					}
-					v = *(*float64)(unsafe.Pointer(&data[index]))
-					index += 8
-					m.Field1 = append(m.Field1, v)
-				}
-			} else if wireType == 1 {
-				var v float64
-				if index+8 > l {
-					return io1.ErrUnexpectedEOF
-				}
-				v = *(*float64)(unsafe.Pointer(&data[index]))
-				index += 8
-				m.Field1 = append(m.Field1, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field1", wireType)
-			}
-		case 2:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v float32
-					if index+4 > l {
-						return io1.ErrUnexpectedEOF
-					}
-					v = *(*float32)(unsafe.Pointer(&data[index]))
-					index += 4
-					m.Field2 = append(m.Field2, v)
-				}
-			} else if wireType == 5 {
-				var v float32
-				if index+4 > l {
-					return io1.ErrUnexpectedEOF
-				}
-				v = *(*float32)(unsafe.Pointer(&data[index]))
-				index += 4
-				m.Field2 = append(m.Field2, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field2", wireType)
-			}
-		case 3:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io1.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Field3 = append(m.Field3, v)
-				}
-			} else if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Field3 = append(m.Field3, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field3", wireType)
-			}
-		case 4:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io1.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+	}
+	if m.xxx_LenField2 != o.xxx_LenField2 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField2; i++ {
+		if m.field2[i] != o.field2[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField3 != o.xxx_LenField3 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField3; i++ {
+		if m.field3[i] != o.field3[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField4 != o.xxx_LenField4 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField4; i++ {
+		if m.field4[i] != o.field4[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField5 != o.xxx_LenField5 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField5; i++ {
+		if m.field5[i] != o.field5[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField6 != o.xxx_LenField6 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField6; i++ {
+		if m.field6[i] != o.field6[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField7 != o.xxx_LenField7 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField7; i++ {
+		if m.field7[i] != o.field7[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField8 != o.xxx_LenField8 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField8; i++ {
+		if m.field8[i] != o.field8[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField9 != o.xxx_LenField9 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField9; i++ {
+		if m.field9[i] != o.field9[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField10 != o.xxx_LenField10 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField10; i++ {
+		if m.field10[i] != o.field10[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField11 != o.xxx_LenField11 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField11; i++ {
+		if m.field11[i] != o.field11[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField12 != o.xxx_LenField12 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField12; i++ {
+		if m.field12[i] != o.field12[i] {
+			return false
+		}
+	}
+	if m.xxx_LenField13 != o.xxx_LenField13 {
+		return false
+	}
+	for i := 0; i < m.xxx_LenField13; i++ {
+		if m.field13[i] != o.field13[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionNinRepNative = &proto.MessageInfo{
+	Name: "packed.NinRepNative",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "Field1",
+			Kind:   proto.DoubleKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]float64, x.xxx_LenField1)
+				copy(v, x.field1)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field1", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField1()
+				for _, e := range value {
+					if err := x.AddField1(e); err != nil {
+						return err
 					}
-					m.Field4 = append(m.Field4, v)
 				}
-			} else if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field1Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField1()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "Field2",
+			Kind:   proto.FloatKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]float32, x.xxx_LenField2)
+				copy(v, x.field2)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field2", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField2()
+				for _, e := range value {
+					if err := x.AddField2(e); err != nil {
+						return err
 					}
 				}
-				m.Field4 = append(m.Field4, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field4", wireType)
-			}
-		case 5:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field2Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField2()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "Field3",
+			Kind:   proto.Int32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]int32, x.xxx_LenField3)
+				copy(v, x.field3)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field3", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField3()
+				for _, e := range value {
+					if err := x.AddField3(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io1.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field3Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField3()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "Field4",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]int64, x.xxx_LenField4)
+				copy(v, x.field4)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field4", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField4()
+				for _, e := range value {
+					if err := x.AddField4(e); err != nil {
+						return err
 					}
-					m.Field5 = append(m.Field5, v)
 				}
-			} else if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					v |= (uint32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field4Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField4()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "Field5",
+			Kind:   proto.Uint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]uint32, x.xxx_LenField5)
+				copy(v, x.field5)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field5", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField5()
+				for _, e := range value {
+					if err := x.AddField5(e); err != nil {
+						return err
 					}
 				}
-				m.Field5 = append(m.Field5, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field5", wireType)
-			}
-		case 6:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
-					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field5Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField5()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "Field6",
+			Kind:   proto.Uint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]uint64, x.xxx_LenField6)
+				copy(v, x.field6)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field6", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField6()
+				for _, e := range value {
+					if err := x.AddField6(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
-				}
-				for index < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io1.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field6Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField6()
+			},
+		},
+		{
+			Number: 7,
+			Name:   "Field7",
+			Kind:   proto.Sint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]int32, x.xxx_LenField7)
+				copy(v, x.field7)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field7", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField7()
+				for _, e := range value {
+					if err := x.AddField7(e); err != nil {
+						return err
 					}
-					m.Field6 = append(m.Field6, v)
 				}
-			} else if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field7Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField7()
+			},
+		},
+		{
+			Number: 8,
+			Name:   "Field8",
+			Kind:   proto.Sint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]int64, x.xxx_LenField8)
+				copy(v, x.field8)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field8", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField8()
+				for _, e := range value {
+					if err := x.AddField8(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field8Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField8()
+			},
+		},
+		{
+			Number: 9,
+			Name:   "Field9",
+			Kind:   proto.Fixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]uint32, x.xxx_LenField9)
+				copy(v, x.field9)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field9", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField9()
+				for _, e := range value {
+					if err := x.AddField9(e); err != nil {
+						return err
 					}
 				}
-				m.Field6 = append(m.Field6, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field6", wireType)
-			}
-		case 7:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field9Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField9()
+			},
+		},
+		{
+			Number: 10,
+			Name:   "Field10",
+			Kind:   proto.Sfixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]int32, x.xxx_LenField10)
+				copy(v, x.field10)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field10", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField10()
+				for _, e := range value {
+					if err := x.AddField10(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field10Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField10()
+			},
+		},
+		{
+			Number: 11,
+			Name:   "Field11",
+			Kind:   proto.Fixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]uint64, x.xxx_LenField11)
+				copy(v, x.field11)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field11", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField11()
+				for _, e := range value {
+					if err := x.AddField11(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field11Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField11()
+			},
+		},
+		{
+			Number: 12,
+			Name:   "Field12",
+			Kind:   proto.Sfixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]int64, x.xxx_LenField12)
+				copy(v, x.field12)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field12", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField12()
+				for _, e := range value {
+					if err := x.AddField12(e); err != nil {
+						return err
+					}
 				}
-				for index < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io1.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field12Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField12()
+			},
+		},
+		{
+			Number: 13,
+			Name:   "Field13",
+			Kind:   proto.BoolKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNative)
+				v := make([]bool, x.xxx_LenField13)
+				copy(v, x.field13)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field13", v)
+				}
+				x := m.(*NinRepNative)
+				x.ClearField13()
+				for _, e := range value {
+					if err := x.AddField13(e); err != nil {
+						return err
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.Field7 = append(m.Field7, v)
 				}
-			} else if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNative).Field13Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNative).ClearField13()
+			},
+		},
+	},
+}
+
+func (m *NinRepNative) ProtoReflect() *proto.MessageInfo {
+	return reflectionNinRepNative
+}
+
+var reflectionNinRepPackedNative = &proto.MessageInfo{
+	Name: "packed.NinRepPackedNative",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "Field1",
+			Kind:   proto.DoubleKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]float64, x.xxx_LenField1)
+				copy(v, x.field1)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field1", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField1()
+				for _, e := range value {
+					if err := x.AddField1(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field1Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField1()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "Field2",
+			Kind:   proto.FloatKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]float32, x.xxx_LenField2)
+				copy(v, x.field2)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field2", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField2()
+				for _, e := range value {
+					if err := x.AddField2(e); err != nil {
+						return err
 					}
 				}
-				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-				m.Field7 = append(m.Field7, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field7", wireType)
-			}
-		case 8:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field2Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField2()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "Field3",
+			Kind:   proto.Int32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]int32, x.xxx_LenField3)
+				copy(v, x.field3)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field3", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField3()
+				for _, e := range value {
+					if err := x.AddField3(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field3Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField3()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "Field4",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]int64, x.xxx_LenField4)
+				copy(v, x.field4)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field4", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField4()
+				for _, e := range value {
+					if err := x.AddField4(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field4Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField4()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "Field5",
+			Kind:   proto.Uint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]uint32, x.xxx_LenField5)
+				copy(v, x.field5)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field5", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField5()
+				for _, e := range value {
+					if err := x.AddField5(e); err != nil {
+						return err
+					}
 				}
-				for index < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io1.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field5Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField5()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "Field6",
+			Kind:   proto.Uint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]uint64, x.xxx_LenField6)
+				copy(v, x.field6)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field6", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField6()
+				for _, e := range value {
+					if err := x.AddField6(e); err != nil {
+						return err
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.Field8 = append(m.Field8, int64(v))
 				}
-			} else if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field6Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField6()
+			},
+		},
+		{
+			Number: 7,
+			Name:   "Field7",
+			Kind:   proto.Sint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]int32, x.xxx_LenField7)
+				copy(v, x.field7)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field7", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField7()
+				for _, e := range value {
+					if err := x.AddField7(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field7Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField7()
+			},
+		},
+		{
+			Number: 8,
+			Name:   "Field8",
+			Kind:   proto.Sint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]int64, x.xxx_LenField8)
+				copy(v, x.field8)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field8", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField8()
+				for _, e := range value {
+					if err := x.AddField8(e); err != nil {
+						return err
 					}
 				}
-				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-				m.Field8 = append(m.Field8, int64(v))
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field8", wireType)
-			}
-		case 9:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field8Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField8()
+			},
+		},
+		{
+			Number: 9,
+			Name:   "Field9",
+			Kind:   proto.Fixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]uint32, x.xxx_LenField9)
+				copy(v, x.field9)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field9", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField9()
+				for _, e := range value {
+					if err := x.AddField9(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field9Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField9()
+			},
+		},
+		{
+			Number: 10,
+			Name:   "Field10",
+			Kind:   proto.Sfixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]int32, x.xxx_LenField10)
+				copy(v, x.field10)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field10", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField10()
+				for _, e := range value {
+					if err := x.AddField10(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field10Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField10()
+			},
+		},
+		{
+			Number: 11,
+			Name:   "Field11",
+			Kind:   proto.Fixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]uint64, x.xxx_LenField11)
+				copy(v, x.field11)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field11", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField11()
+				for _, e := range value {
+					if err := x.AddField11(e); err != nil {
+						return err
+					}
 				}
-				for index < postIndex {
-					var v uint32
-					if index+4 > l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field11Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField11()
+			},
+		},
+		{
+			Number: 12,
+			Name:   "Field12",
+			Kind:   proto.Sfixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]int64, x.xxx_LenField12)
+				copy(v, x.field12)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field12", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField12()
+				for _, e := range value {
+					if err := x.AddField12(e); err != nil {
+						return err
 					}
-					v = *(*uint32)(unsafe.Pointer(&data[index]))
-					index += 4
-					m.Field9 = append(m.Field9, v)
 				}
-			} else if wireType == 5 {
-				var v uint32
-				if index+4 > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field12Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField12()
+			},
+		},
+		{
+			Number: 13,
+			Name:   "Field13",
+			Kind:   proto.BoolKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNative)
+				v := make([]bool, x.xxx_LenField13)
+				copy(v, x.field13)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field13", v)
+				}
+				x := m.(*NinRepPackedNative)
+				x.ClearField13()
+				for _, e := range value {
+					if err := x.AddField13(e); err != nil {
+						return err
+					}
 				}
-				v = *(*uint32)(unsafe.Pointer(&data[index]))
-				index += 4
-				m.Field9 = append(m.Field9, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field9", wireType)
-			}
-		case 10:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNative).Field13Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNative).ClearField13()
+			},
+		},
+	},
+}
+
+func (m *NinRepPackedNative) ProtoReflect() *proto.MessageInfo {
+	return reflectionNinRepPackedNative
+}
+
+var reflectionNinRepNativeUnsafe = &proto.MessageInfo{
+	Name: "packed.NinRepNativeUnsafe",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "Field1",
+			Kind:   proto.DoubleKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]float64, x.xxx_LenField1)
+				copy(v, x.field1)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field1", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField1()
+				for _, e := range value {
+					if err := x.AddField1(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field1Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField1()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "Field2",
+			Kind:   proto.FloatKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]float32, x.xxx_LenField2)
+				copy(v, x.field2)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field2", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField2()
+				for _, e := range value {
+					if err := x.AddField2(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field2Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField2()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "Field3",
+			Kind:   proto.Int32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]int32, x.xxx_LenField3)
+				copy(v, x.field3)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field3", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField3()
+				for _, e := range value {
+					if err := x.AddField3(e); err != nil {
+						return err
+					}
 				}
-				for index < postIndex {
-					var v int32
-					if index+4 > l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field3Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField3()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "Field4",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]int64, x.xxx_LenField4)
+				copy(v, x.field4)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field4", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField4()
+				for _, e := range value {
+					if err := x.AddField4(e); err != nil {
+						return err
 					}
-					v = *(*int32)(unsafe.Pointer(&data[index]))
-					index += 4
-					m.Field10 = append(m.Field10, v)
 				}
-			} else if wireType == 5 {
-				var v int32
-				if index+4 > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field4Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField4()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "Field5",
+			Kind:   proto.Uint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]uint32, x.xxx_LenField5)
+				copy(v, x.field5)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field5", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField5()
+				for _, e := range value {
+					if err := x.AddField5(e); err != nil {
+						return err
+					}
 				}
-				v = *(*int32)(unsafe.Pointer(&data[index]))
-				index += 4
-				m.Field10 = append(m.Field10, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field10", wireType)
-			}
-		case 11:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field5Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField5()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "Field6",
+			Kind:   proto.Uint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]uint64, x.xxx_LenField6)
+				copy(v, x.field6)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field6", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField6()
+				for _, e := range value {
+					if err := x.AddField6(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field6Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField6()
+			},
+		},
+		{
+			Number: 7,
+			Name:   "Field7",
+			Kind:   proto.Sint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]int32, x.xxx_LenField7)
+				copy(v, x.field7)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field7", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField7()
+				for _, e := range value {
+					if err := x.AddField7(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field7Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField7()
+			},
+		},
+		{
+			Number: 8,
+			Name:   "Field8",
+			Kind:   proto.Sint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]int64, x.xxx_LenField8)
+				copy(v, x.field8)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field8", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField8()
+				for _, e := range value {
+					if err := x.AddField8(e); err != nil {
+						return err
+					}
 				}
-				for index < postIndex {
-					var v uint64
-					if index+8 > l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field8Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField8()
+			},
+		},
+		{
+			Number: 9,
+			Name:   "Field9",
+			Kind:   proto.Fixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]uint32, x.xxx_LenField9)
+				copy(v, x.field9)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field9", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField9()
+				for _, e := range value {
+					if err := x.AddField9(e); err != nil {
+						return err
 					}
-					v = *(*uint64)(unsafe.Pointer(&data[index]))
-					index += 8
-					m.Field11 = append(m.Field11, v)
 				}
-			} else if wireType == 1 {
-				var v uint64
-				if index+8 > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field9Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField9()
+			},
+		},
+		{
+			Number: 10,
+			Name:   "Field10",
+			Kind:   proto.Sfixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]int32, x.xxx_LenField10)
+				copy(v, x.field10)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field10", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField10()
+				for _, e := range value {
+					if err := x.AddField10(e); err != nil {
+						return err
+					}
 				}
-				v = *(*uint64)(unsafe.Pointer(&data[index]))
-				index += 8
-				m.Field11 = append(m.Field11, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field11", wireType)
-			}
-		case 12:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field10Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField10()
+			},
+		},
+		{
+			Number: 11,
+			Name:   "Field11",
+			Kind:   proto.Fixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]uint64, x.xxx_LenField11)
+				copy(v, x.field11)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field11", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField11()
+				for _, e := range value {
+					if err := x.AddField11(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field11Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField11()
+			},
+		},
+		{
+			Number: 12,
+			Name:   "Field12",
+			Kind:   proto.Sfixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]int64, x.xxx_LenField12)
+				copy(v, x.field12)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field12", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField12()
+				for _, e := range value {
+					if err := x.AddField12(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field12Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField12()
+			},
+		},
+		{
+			Number: 13,
+			Name:   "Field13",
+			Kind:   proto.BoolKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepNativeUnsafe)
+				v := make([]bool, x.xxx_LenField13)
+				copy(v, x.field13)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field13", v)
+				}
+				x := m.(*NinRepNativeUnsafe)
+				x.ClearField13()
+				for _, e := range value {
+					if err := x.AddField13(e); err != nil {
+						return err
+					}
 				}
-				for index < postIndex {
-					var v int64
-					if index+8 > l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepNativeUnsafe).Field13Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepNativeUnsafe).ClearField13()
+			},
+		},
+	},
+}
+
+func (m *NinRepNativeUnsafe) ProtoReflect() *proto.MessageInfo {
+	return reflectionNinRepNativeUnsafe
+}
+
+var reflectionNinRepPackedNativeUnsafe = &proto.MessageInfo{
+	Name: "packed.NinRepPackedNativeUnsafe",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "Field1",
+			Kind:   proto.DoubleKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]float64, x.xxx_LenField1)
+				copy(v, x.field1)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field1", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField1()
+				for _, e := range value {
+					if err := x.AddField1(e); err != nil {
+						return err
 					}
-					v = *(*int64)(unsafe.Pointer(&data[index]))
-					index += 8
-					m.Field12 = append(m.Field12, v)
 				}
-			} else if wireType == 1 {
-				var v int64
-				if index+8 > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field1Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField1()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "Field2",
+			Kind:   proto.FloatKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]float32, x.xxx_LenField2)
+				copy(v, x.field2)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field2", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField2()
+				for _, e := range value {
+					if err := x.AddField2(e); err != nil {
+						return err
+					}
 				}
-				v = *(*int64)(unsafe.Pointer(&data[index]))
-				index += 8
-				m.Field12 = append(m.Field12, v)
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field12", wireType)
-			}
-		case 13:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field2Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField2()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "Field3",
+			Kind:   proto.Int32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]int32, x.xxx_LenField3)
+				copy(v, x.field3)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field3", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField3()
+				for _, e := range value {
+					if err := x.AddField3(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field3Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField3()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "Field4",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]int64, x.xxx_LenField4)
+				copy(v, x.field4)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field4", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField4()
+				for _, e := range value {
+					if err := x.AddField4(e); err != nil {
+						return err
 					}
 				}
-				postIndex := index + packedLen
-				if postIndex > l {
-					return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field4Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField4()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "Field5",
+			Kind:   proto.Uint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]uint32, x.xxx_LenField5)
+				copy(v, x.field5)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field5", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField5()
+				for _, e := range value {
+					if err := x.AddField5(e); err != nil {
+						return err
+					}
 				}
-				for index < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if index >= l {
-							return io1.ErrUnexpectedEOF
-						}
-						b := data[index]
-						index++
-						v |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field5Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField5()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "Field6",
+			Kind:   proto.Uint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]uint64, x.xxx_LenField6)
+				copy(v, x.field6)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field6", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField6()
+				for _, e := range value {
+					if err := x.AddField6(e); err != nil {
+						return err
 					}
-					m.Field13 = append(m.Field13, bool(v != 0))
 				}
-			} else if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if index >= l {
-						return io1.ErrUnexpectedEOF
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field6Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField6()
+			},
+		},
+		{
+			Number: 7,
+			Name:   "Field7",
+			Kind:   proto.Sint32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]int32, x.xxx_LenField7)
+				copy(v, x.field7)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field7", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField7()
+				for _, e := range value {
+					if err := x.AddField7(e); err != nil {
+						return err
 					}
-					b := data[index]
-					index++
-					v |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field7Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField7()
+			},
+		},
+		{
+			Number: 8,
+			Name:   "Field8",
+			Kind:   proto.Sint64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]int64, x.xxx_LenField8)
+				copy(v, x.field8)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field8", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField8()
+				for _, e := range value {
+					if err := x.AddField8(e); err != nil {
+						return err
 					}
 				}
-				m.Field13 = append(m.Field13, bool(v != 0))
-			} else {
-				return fmt1.Errorf("proto: wrong wireType = %d for field Field13", wireType)
-			}
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field8Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField8()
+			},
+		},
+		{
+			Number: 9,
+			Name:   "Field9",
+			Kind:   proto.Fixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]uint32, x.xxx_LenField9)
+				copy(v, x.field9)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field9", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField9()
+				for _, e := range value {
+					if err := x.AddField9(e); err != nil {
+						return err
+					}
 				}
-			}
-			index -= sizeOfWire
-			skippy, err := dropbox_gogoprotobuf_proto1.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io1.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-	return nil
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field9Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField9()
+			},
+		},
+		{
+			Number: 10,
+			Name:   "Field10",
+			Kind:   proto.Sfixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]int32, x.xxx_LenField10)
+				copy(v, x.field10)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field10", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField10()
+				for _, e := range value {
+					if err := x.AddField10(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field10Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField10()
+			},
+		},
+		{
+			Number: 11,
+			Name:   "Field11",
+			Kind:   proto.Fixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]uint64, x.xxx_LenField11)
+				copy(v, x.field11)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field11", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField11()
+				for _, e := range value {
+					if err := x.AddField11(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field11Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField11()
+			},
+		},
+		{
+			Number: 12,
+			Name:   "Field12",
+			Kind:   proto.Sfixed64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]int64, x.xxx_LenField12)
+				copy(v, x.field12)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field12", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField12()
+				for _, e := range value {
+					if err := x.AddField12(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field12Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField12()
+			},
+		},
+		{
+			Number: 13,
+			Name:   "Field13",
+			Kind:   proto.BoolKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*NinRepPackedNativeUnsafe)
+				v := make([]bool, x.xxx_LenField13)
+				copy(v, x.field13)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "Field13", v)
+				}
+				x := m.(*NinRepPackedNativeUnsafe)
+				x.ClearField13()
+				for _, e := range value {
+					if err := x.AddField13(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*NinRepPackedNativeUnsafe).Field13Size() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*NinRepPackedNativeUnsafe).ClearField13()
+			},
+		},
+	},
+}
+
+func (m *NinRepPackedNativeUnsafe) ProtoReflect() *proto.MessageInfo {
+	return reflectionNinRepPackedNativeUnsafe
+}
+
+func init() {
+	proto.RegisterType((*NinRepNative)(nil), "packed.NinRepNative")
+	proto.RegisterType((*NinRepPackedNative)(nil), "packed.NinRepPackedNative")
+	proto.RegisterType((*NinRepNativeUnsafe)(nil), "packed.NinRepNativeUnsafe")
+	proto.RegisterType((*NinRepPackedNativeUnsafe)(nil), "packed.NinRepPackedNativeUnsafe")
 }
 func NewPopulatedNinRepNative(r randyPacked, easy bool) *NinRepNative {
 	this := &NinRepNative{}
 	if r.Intn(10) != 0 {
 		v1 := r.Intn(100)
-		this.Field1 = make([]float64, v1)
+		this.field1 = make([]float64, v1)
 		for i := 0; i < v1; i++ {
-			this.Field1[i] = r.Float64()
+			this.xxx_LenField1 += 1
+			this.field1[i] = (r.Float64())
 			if r.Intn(2) == 0 {
-				this.Field1[i] *= -1
+				this.field1[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v2 := r.Intn(100)
-		this.Field2 = make([]float32, v2)
+		this.field2 = make([]float32, v2)
 		for i := 0; i < v2; i++ {
-			this.Field2[i] = r.Float32()
+			this.xxx_LenField2 += 1
+			this.field2[i] = (r.Float32())
 			if r.Intn(2) == 0 {
-				this.Field2[i] *= -1
+				this.field2[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v3 := r.Intn(100)
-		this.Field3 = make([]int32, v3)
+		this.field3 = make([]int32, v3)
 		for i := 0; i < v3; i++ {
-			this.Field3[i] = r.Int31()
+			this.xxx_LenField3 += 1
+			this.field3[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field3[i] *= -1
+				this.field3[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v4 := r.Intn(100)
-		this.Field4 = make([]int64, v4)
+		this.field4 = make([]int64, v4)
 		for i := 0; i < v4; i++ {
-			this.Field4[i] = r.Int63()
+			this.xxx_LenField4 += 1
+			this.field4[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field4[i] *= -1
+				this.field4[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v5 := r.Intn(100)
-		this.Field5 = make([]uint32, v5)
+		this.field5 = make([]uint32, v5)
 		for i := 0; i < v5; i++ {
-			this.Field5[i] = r.Uint32()
+			this.xxx_LenField5 += 1
+			this.field5[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v6 := r.Intn(100)
-		this.Field6 = make([]uint64, v6)
+		this.field6 = make([]uint64, v6)
 		for i := 0; i < v6; i++ {
-			this.Field6[i] = uint64(r.Uint32())
+			this.xxx_LenField6 += 1
+			this.field6[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v7 := r.Intn(100)
-		this.Field7 = make([]int32, v7)
+		this.field7 = make([]int32, v7)
 		for i := 0; i < v7; i++ {
-			this.Field7[i] = r.Int31()
+			this.xxx_LenField7 += 1
+			this.field7[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field7[i] *= -1
+				this.field7[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v8 := r.Intn(100)
-		this.Field8 = make([]int64, v8)
+		this.field8 = make([]int64, v8)
 		for i := 0; i < v8; i++ {
-			this.Field8[i] = r.Int63()
+			this.xxx_LenField8 += 1
+			this.field8[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field8[i] *= -1
+				this.field8[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v9 := r.Intn(100)
-		this.Field9 = make([]uint32, v9)
+		this.field9 = make([]uint32, v9)
 		for i := 0; i < v9; i++ {
-			this.Field9[i] = r.Uint32()
+			this.xxx_LenField9 += 1
+			this.field9[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v10 := r.Intn(100)
-		this.Field10 = make([]int32, v10)
+		this.field10 = make([]int32, v10)
 		for i := 0; i < v10; i++ {
-			this.Field10[i] = r.Int31()
+			this.xxx_LenField10 += 1
+			this.field10[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field10[i] *= -1
+				this.field10[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v11 := r.Intn(100)
-		this.Field11 = make([]uint64, v11)
+		this.field11 = make([]uint64, v11)
 		for i := 0; i < v11; i++ {
-			this.Field11[i] = uint64(r.Uint32())
+			this.xxx_LenField11 += 1
+			this.field11[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v12 := r.Intn(100)
-		this.Field12 = make([]int64, v12)
+		this.field12 = make([]int64, v12)
 		for i := 0; i < v12; i++ {
-			this.Field12[i] = r.Int63()
+			this.xxx_LenField12 += 1
+			this.field12[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field12[i] *= -1
+				this.field12[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v13 := r.Intn(100)
-		this.Field13 = make([]bool, v13)
+		this.field13 = make([]bool, v13)
 		for i := 0; i < v13; i++ {
-			this.Field13[i] = bool(r.Intn(2) == 0)
+			this.xxx_LenField13 += 1
+			this.field13[i] = (bool(r.Intn(2) == 0))
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -2432,117 +15357,130 @@ func NewPopulatedNinRepPackedNative(r randyPacked, easy bool) *NinRepPackedNativ
 	this := &NinRepPackedNative{}
 	if r.Intn(10) != 0 {
 		v14 := r.Intn(100)
-		this.Field1 = make([]float64, v14)
+		this.field1 = make([]float64, v14)
 		for i := 0; i < v14; i++ {
-			this.Field1[i] = r.Float64()
+			this.xxx_LenField1 += 1
+			this.field1[i] = (r.Float64())
 			if r.Intn(2) == 0 {
-				this.Field1[i] *= -1
+				this.field1[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v15 := r.Intn(100)
-		this.Field2 = make([]float32, v15)
+		this.field2 = make([]float32, v15)
 		for i := 0; i < v15; i++ {
-			this.Field2[i] = r.Float32()
+			this.xxx_LenField2 += 1
+			this.field2[i] = (r.Float32())
 			if r.Intn(2) == 0 {
-				this.Field2[i] *= -1
+				this.field2[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v16 := r.Intn(100)
-		this.Field3 = make([]int32, v16)
+		this.field3 = make([]int32, v16)
 		for i := 0; i < v16; i++ {
-			this.Field3[i] = r.Int31()
+			this.xxx_LenField3 += 1
+			this.field3[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field3[i] *= -1
+				this.field3[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v17 := r.Intn(100)
-		this.Field4 = make([]int64, v17)
+		this.field4 = make([]int64, v17)
 		for i := 0; i < v17; i++ {
-			this.Field4[i] = r.Int63()
+			this.xxx_LenField4 += 1
+			this.field4[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field4[i] *= -1
+				this.field4[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v18 := r.Intn(100)
-		this.Field5 = make([]uint32, v18)
+		this.field5 = make([]uint32, v18)
 		for i := 0; i < v18; i++ {
-			this.Field5[i] = r.Uint32()
+			this.xxx_LenField5 += 1
+			this.field5[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v19 := r.Intn(100)
-		this.Field6 = make([]uint64, v19)
+		this.field6 = make([]uint64, v19)
 		for i := 0; i < v19; i++ {
-			this.Field6[i] = uint64(r.Uint32())
+			this.xxx_LenField6 += 1
+			this.field6[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v20 := r.Intn(100)
-		this.Field7 = make([]int32, v20)
+		this.field7 = make([]int32, v20)
 		for i := 0; i < v20; i++ {
-			this.Field7[i] = r.Int31()
+			this.xxx_LenField7 += 1
+			this.field7[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field7[i] *= -1
+				this.field7[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v21 := r.Intn(100)
-		this.Field8 = make([]int64, v21)
+		this.field8 = make([]int64, v21)
 		for i := 0; i < v21; i++ {
-			this.Field8[i] = r.Int63()
+			this.xxx_LenField8 += 1
+			this.field8[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field8[i] *= -1
+				this.field8[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v22 := r.Intn(100)
-		this.Field9 = make([]uint32, v22)
+		this.field9 = make([]uint32, v22)
 		for i := 0; i < v22; i++ {
-			this.Field9[i] = r.Uint32()
+			this.xxx_LenField9 += 1
+			this.field9[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v23 := r.Intn(100)
-		this.Field10 = make([]int32, v23)
+		this.field10 = make([]int32, v23)
 		for i := 0; i < v23; i++ {
-			this.Field10[i] = r.Int31()
+			this.xxx_LenField10 += 1
+			this.field10[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field10[i] *= -1
+				this.field10[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v24 := r.Intn(100)
-		this.Field11 = make([]uint64, v24)
+		this.field11 = make([]uint64, v24)
 		for i := 0; i < v24; i++ {
-			this.Field11[i] = uint64(r.Uint32())
+			this.xxx_LenField11 += 1
+			this.field11[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v25 := r.Intn(100)
-		this.Field12 = make([]int64, v25)
+		this.field12 = make([]int64, v25)
 		for i := 0; i < v25; i++ {
-			this.Field12[i] = r.Int63()
+			this.xxx_LenField12 += 1
+			this.field12[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field12[i] *= -1
+				this.field12[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v26 := r.Intn(100)
-		this.Field13 = make([]bool, v26)
+		this.field13 = make([]bool, v26)
 		for i := 0; i < v26; i++ {
-			this.Field13[i] = bool(r.Intn(2) == 0)
+			this.xxx_LenField13 += 1
+			this.field13[i] = (bool(r.Intn(2) == 0))
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -2555,117 +15493,130 @@ func NewPopulatedNinRepNativeUnsafe(r randyPacked, easy bool) *NinRepNativeUnsaf
 	this := &NinRepNativeUnsafe{}
 	if r.Intn(10) != 0 {
 		v27 := r.Intn(100)
-		this.Field1 = make([]float64, v27)
+		this.field1 = make([]float64, v27)
 		for i := 0; i < v27; i++ {
-			this.Field1[i] = r.Float64()
+			this.xxx_LenField1 += 1
+			this.field1[i] = (r.Float64())
 			if r.Intn(2) == 0 {
-				this.Field1[i] *= -1
+				this.field1[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v28 := r.Intn(100)
-		this.Field2 = make([]float32, v28)
+		this.field2 = make([]float32, v28)
 		for i := 0; i < v28; i++ {
-			this.Field2[i] = r.Float32()
+			this.xxx_LenField2 += 1
+			this.field2[i] = (r.Float32())
 			if r.Intn(2) == 0 {
-				this.Field2[i] *= -1
+				this.field2[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v29 := r.Intn(100)
-		this.Field3 = make([]int32, v29)
+		this.field3 = make([]int32, v29)
 		for i := 0; i < v29; i++ {
-			this.Field3[i] = r.Int31()
+			this.xxx_LenField3 += 1
+			this.field3[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field3[i] *= -1
+				this.field3[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v30 := r.Intn(100)
-		this.Field4 = make([]int64, v30)
+		this.field4 = make([]int64, v30)
 		for i := 0; i < v30; i++ {
-			this.Field4[i] = r.Int63()
+			this.xxx_LenField4 += 1
+			this.field4[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field4[i] *= -1
+				this.field4[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v31 := r.Intn(100)
-		this.Field5 = make([]uint32, v31)
+		this.field5 = make([]uint32, v31)
 		for i := 0; i < v31; i++ {
-			this.Field5[i] = r.Uint32()
+			this.xxx_LenField5 += 1
+			this.field5[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v32 := r.Intn(100)
-		this.Field6 = make([]uint64, v32)
+		this.field6 = make([]uint64, v32)
 		for i := 0; i < v32; i++ {
-			this.Field6[i] = uint64(r.Uint32())
+			this.xxx_LenField6 += 1
+			this.field6[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v33 := r.Intn(100)
-		this.Field7 = make([]int32, v33)
+		this.field7 = make([]int32, v33)
 		for i := 0; i < v33; i++ {
-			this.Field7[i] = r.Int31()
+			this.xxx_LenField7 += 1
+			this.field7[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field7[i] *= -1
+				this.field7[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v34 := r.Intn(100)
-		this.Field8 = make([]int64, v34)
+		this.field8 = make([]int64, v34)
 		for i := 0; i < v34; i++ {
-			this.Field8[i] = r.Int63()
+			this.xxx_LenField8 += 1
+			this.field8[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field8[i] *= -1
+				this.field8[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v35 := r.Intn(100)
-		this.Field9 = make([]uint32, v35)
+		this.field9 = make([]uint32, v35)
 		for i := 0; i < v35; i++ {
-			this.Field9[i] = r.Uint32()
+			this.xxx_LenField9 += 1
+			this.field9[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v36 := r.Intn(100)
-		this.Field10 = make([]int32, v36)
+		this.field10 = make([]int32, v36)
 		for i := 0; i < v36; i++ {
-			this.Field10[i] = r.Int31()
+			this.xxx_LenField10 += 1
+			this.field10[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field10[i] *= -1
+				this.field10[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v37 := r.Intn(100)
-		this.Field11 = make([]uint64, v37)
+		this.field11 = make([]uint64, v37)
 		for i := 0; i < v37; i++ {
-			this.Field11[i] = uint64(r.Uint32())
+			this.xxx_LenField11 += 1
+			this.field11[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v38 := r.Intn(100)
-		this.Field12 = make([]int64, v38)
+		this.field12 = make([]int64, v38)
 		for i := 0; i < v38; i++ {
-			this.Field12[i] = r.Int63()
+			this.xxx_LenField12 += 1
+			this.field12[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field12[i] *= -1
+				this.field12[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v39 := r.Intn(100)
-		this.Field13 = make([]bool, v39)
+		this.field13 = make([]bool, v39)
 		for i := 0; i < v39; i++ {
-			this.Field13[i] = bool(r.Intn(2) == 0)
+			this.xxx_LenField13 += 1
+			this.field13[i] = (bool(r.Intn(2) == 0))
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -2678,117 +15629,130 @@ func NewPopulatedNinRepPackedNativeUnsafe(r randyPacked, easy bool) *NinRepPacke
 	this := &NinRepPackedNativeUnsafe{}
 	if r.Intn(10) != 0 {
 		v40 := r.Intn(100)
-		this.Field1 = make([]float64, v40)
+		this.field1 = make([]float64, v40)
 		for i := 0; i < v40; i++ {
-			this.Field1[i] = r.Float64()
+			this.xxx_LenField1 += 1
+			this.field1[i] = (r.Float64())
 			if r.Intn(2) == 0 {
-				this.Field1[i] *= -1
+				this.field1[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v41 := r.Intn(100)
-		this.Field2 = make([]float32, v41)
+		this.field2 = make([]float32, v41)
 		for i := 0; i < v41; i++ {
-			this.Field2[i] = r.Float32()
+			this.xxx_LenField2 += 1
+			this.field2[i] = (r.Float32())
 			if r.Intn(2) == 0 {
-				this.Field2[i] *= -1
+				this.field2[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v42 := r.Intn(100)
-		this.Field3 = make([]int32, v42)
+		this.field3 = make([]int32, v42)
 		for i := 0; i < v42; i++ {
-			this.Field3[i] = r.Int31()
+			this.xxx_LenField3 += 1
+			this.field3[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field3[i] *= -1
+				this.field3[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v43 := r.Intn(100)
-		this.Field4 = make([]int64, v43)
+		this.field4 = make([]int64, v43)
 		for i := 0; i < v43; i++ {
-			this.Field4[i] = r.Int63()
+			this.xxx_LenField4 += 1
+			this.field4[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field4[i] *= -1
+				this.field4[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v44 := r.Intn(100)
-		this.Field5 = make([]uint32, v44)
+		this.field5 = make([]uint32, v44)
 		for i := 0; i < v44; i++ {
-			this.Field5[i] = r.Uint32()
+			this.xxx_LenField5 += 1
+			this.field5[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v45 := r.Intn(100)
-		this.Field6 = make([]uint64, v45)
+		this.field6 = make([]uint64, v45)
 		for i := 0; i < v45; i++ {
-			this.Field6[i] = uint64(r.Uint32())
+			this.xxx_LenField6 += 1
+			this.field6[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v46 := r.Intn(100)
-		this.Field7 = make([]int32, v46)
+		this.field7 = make([]int32, v46)
 		for i := 0; i < v46; i++ {
-			this.Field7[i] = r.Int31()
+			this.xxx_LenField7 += 1
+			this.field7[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field7[i] *= -1
+				this.field7[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v47 := r.Intn(100)
-		this.Field8 = make([]int64, v47)
+		this.field8 = make([]int64, v47)
 		for i := 0; i < v47; i++ {
-			this.Field8[i] = r.Int63()
+			this.xxx_LenField8 += 1
+			this.field8[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field8[i] *= -1
+				this.field8[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v48 := r.Intn(100)
-		this.Field9 = make([]uint32, v48)
+		this.field9 = make([]uint32, v48)
 		for i := 0; i < v48; i++ {
-			this.Field9[i] = r.Uint32()
+			this.xxx_LenField9 += 1
+			this.field9[i] = (r.Uint32())
 		}
 	}
 	if r.Intn(10) != 0 {
 		v49 := r.Intn(100)
-		this.Field10 = make([]int32, v49)
+		this.field10 = make([]int32, v49)
 		for i := 0; i < v49; i++ {
-			this.Field10[i] = r.Int31()
+			this.xxx_LenField10 += 1
+			this.field10[i] = (r.Int31())
 			if r.Intn(2) == 0 {
-				this.Field10[i] *= -1
+				this.field10[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v50 := r.Intn(100)
-		this.Field11 = make([]uint64, v50)
+		this.field11 = make([]uint64, v50)
 		for i := 0; i < v50; i++ {
-			this.Field11[i] = uint64(r.Uint32())
+			this.xxx_LenField11 += 1
+			this.field11[i] = (uint64(r.Uint32()))
 		}
 	}
 	if r.Intn(10) != 0 {
 		v51 := r.Intn(100)
-		this.Field12 = make([]int64, v51)
+		this.field12 = make([]int64, v51)
 		for i := 0; i < v51; i++ {
-			this.Field12[i] = r.Int63()
+			this.xxx_LenField12 += 1
+			this.field12[i] = (r.Int63())
 			if r.Intn(2) == 0 {
-				this.Field12[i] *= -1
+				this.field12[i] *= (-1)
 			}
 		}
 	}
 	if r.Intn(10) != 0 {
 		v52 := r.Intn(100)
-		this.Field13 = make([]bool, v52)
+		this.field13 = make([]bool, v52)
 		for i := 0; i < v52; i++ {
-			this.Field13[i] = bool(r.Intn(2) == 0)
+			this.xxx_LenField13 += 1
+			this.field13[i] = (bool(r.Intn(2) == 0))
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
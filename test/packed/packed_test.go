@@ -80,6 +80,85 @@ func TestUnsafeIssue21(t *testing.T) {
 	}
 }
 
+func TestSafeIssue21Packed(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	msg1 := NewPopulatedNinRepPackedNative(popr, true)
+	data1, err := proto.Marshal(msg1)
+	if err != nil {
+		panic(err)
+	}
+	unpackedmsg := &NinRepNative{}
+	err = proto.Unmarshal(data1, unpackedmsg)
+	if err != nil {
+		panic(err)
+	}
+	if len(unpackedmsg.XXX_unrecognized) != 0 {
+		t.Fatalf("unpacked msg unmarshaled unrecognized fields, even though there aren't any")
+	}
+	if err := VerboseEqual(unpackedmsg, msg1); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestUnsafeIssue21Packed(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	msg1 := NewPopulatedNinRepPackedNativeUnsafe(popr, true)
+	data1, err := proto.Marshal(msg1)
+	if err != nil {
+		panic(err)
+	}
+	unpackedmsg := &NinRepNativeUnsafe{}
+	err = unpackedmsg.UnmarshalZeroCopy(data1)
+	if err != nil {
+		panic(err)
+	}
+	if len(unpackedmsg.XXX_unrecognized) != 0 {
+		t.Fatalf("unpacked msg unmarshaled unrecognized fields, even though there aren't any")
+	}
+	if err := VerboseEqualUnsafe(unpackedmsg, msg1); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+// Both encodings may occur for the same field in one message, and the
+// values of all of them are appended.
+func TestMixedIssue21(t *testing.T) {
+	data := []byte{
+		0x18, 0x01, // Field3: 1
+		0x1a, 0x02, 0x02, 0x03, // Field3: [2, 3] packed
+		0x18, 0x04, // Field3: 4
+		0x6a, 0x02, 0x01, 0x00, // Field13: [true, false] packed
+		0x68, 0x01, // Field13: true
+	}
+	msg1 := &NinRepNative{}
+	if err := proto.Unmarshal(data, msg1); err != nil {
+		t.Fatal(err)
+	}
+	msg2 := &NinRepPackedNative{}
+	if err := proto.Unmarshal(data, msg2); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerboseEqual(msg1, msg2); err != nil {
+		t.Fatalf("%v", err)
+	}
+	var field3 []int32
+	for i := 0; i < msg1.Field3Size(); i++ {
+		v, _ := msg1.GetField3(i)
+		field3 = append(field3, v)
+	}
+	if fmt.Sprint(field3) != "[1 2 3 4]" {
+		t.Fatalf("Field3 is %v, want [1 2 3 4]", field3)
+	}
+	var field13 []bool
+	for i := 0; i < msg1.Field13Size(); i++ {
+		v, _ := msg1.GetField13(i)
+		field13 = append(field13, v)
+	}
+	if fmt.Sprint(field13) != "[true false true]" {
+		t.Fatalf("Field13 is %v, want [true false true]", field13)
+	}
+}
+
 func VerboseEqual(this *NinRepNative, that *NinRepPackedNative) error {
 	if that == nil {
 		if this == nil {
@@ -90,108 +169,134 @@ func VerboseEqual(this *NinRepNative, that *NinRepPackedNative) error {
 		return fmt.Errorf("that != nil && this == nil")
 	}
 
-	if len(this.Field1) != len(that.Field1) {
-		return fmt.Errorf("Field1 this(%v) Not Equal that(%v)", len(this.Field1), len(that.Field1))
-	}
-	for i := range this.Field1 {
-		if this.Field1[i] != that.Field1[i] {
-			return fmt.Errorf("Field1 this[%v](%v) Not Equal that[%v](%v)", i, this.Field1[i], i, that.Field1[i])
+	if this.Field1Size() != that.Field1Size() {
+		return fmt.Errorf("Field1 this(%v) Not Equal that(%v)", this.Field1Size(), that.Field1Size())
+	}
+	for i := 0; i < this.Field1Size(); i++ {
+		thisValue, _ := this.GetField1(i)
+		thatValue, _ := that.GetField1(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field1 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field2) != len(that.Field2) {
-		return fmt.Errorf("Field2 this(%v) Not Equal that(%v)", len(this.Field2), len(that.Field2))
+	if this.Field2Size() != that.Field2Size() {
+		return fmt.Errorf("Field2 this(%v) Not Equal that(%v)", this.Field2Size(), that.Field2Size())
 	}
-	for i := range this.Field2 {
-		if this.Field2[i] != that.Field2[i] {
-			return fmt.Errorf("Field2 this[%v](%v) Not Equal that[%v](%v)", i, this.Field2[i], i, that.Field2[i])
+	for i := 0; i < this.Field2Size(); i++ {
+		thisValue, _ := this.GetField2(i)
+		thatValue, _ := that.GetField2(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field2 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field3) != len(that.Field3) {
-		return fmt.Errorf("Field3 this(%v) Not Equal that(%v)", len(this.Field3), len(that.Field3))
+	if this.Field3Size() != that.Field3Size() {
+		return fmt.Errorf("Field3 this(%v) Not Equal that(%v)", this.Field3Size(), that.Field3Size())
 	}
-	for i := range this.Field3 {
-		if this.Field3[i] != that.Field3[i] {
-			return fmt.Errorf("Field3 this[%v](%v) Not Equal that[%v](%v)", i, this.Field3[i], i, that.Field3[i])
+	for i := 0; i < this.Field3Size(); i++ {
+		thisValue, _ := this.GetField3(i)
+		thatValue, _ := that.GetField3(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field3 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field4) != len(that.Field4) {
-		return fmt.Errorf("Field4 this(%v) Not Equal that(%v)", len(this.Field4), len(that.Field4))
+	if this.Field4Size() != that.Field4Size() {
+		return fmt.Errorf("Field4 this(%v) Not Equal that(%v)", this.Field4Size(), that.Field4Size())
 	}
-	for i := range this.Field4 {
-		if this.Field4[i] != that.Field4[i] {
-			return fmt.Errorf("Field4 this[%v](%v) Not Equal that[%v](%v)", i, this.Field4[i], i, that.Field4[i])
+	for i := 0; i < this.Field4Size(); i++ {
+		thisValue, _ := this.GetField4(i)
+		thatValue, _ := that.GetField4(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field4 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field5) != len(that.Field5) {
-		return fmt.Errorf("Field5 this(%v) Not Equal that(%v)", len(this.Field5), len(that.Field5))
+	if this.Field5Size() != that.Field5Size() {
+		return fmt.Errorf("Field5 this(%v) Not Equal that(%v)", this.Field5Size(), that.Field5Size())
 	}
-	for i := range this.Field5 {
-		if this.Field5[i] != that.Field5[i] {
-			return fmt.Errorf("Field5 this[%v](%v) Not Equal that[%v](%v)", i, this.Field5[i], i, that.Field5[i])
+	for i := 0; i < this.Field5Size(); i++ {
+		thisValue, _ := this.GetField5(i)
+		thatValue, _ := that.GetField5(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field5 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field6) != len(that.Field6) {
-		return fmt.Errorf("Field6 this(%v) Not Equal that(%v)", len(this.Field6), len(that.Field6))
+	if this.Field6Size() != that.Field6Size() {
+		return fmt.Errorf("Field6 this(%v) Not Equal that(%v)", this.Field6Size(), that.Field6Size())
 	}
-	for i := range this.Field6 {
-		if this.Field6[i] != that.Field6[i] {
-			return fmt.Errorf("Field6 this[%v](%v) Not Equal that[%v](%v)", i, this.Field6[i], i, that.Field6[i])
+	for i := 0; i < this.Field6Size(); i++ {
+		thisValue, _ := this.GetField6(i)
+		thatValue, _ := that.GetField6(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field6 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field7) != len(that.Field7) {
-		return fmt.Errorf("Field7 this(%v) Not Equal that(%v)", len(this.Field7), len(that.Field7))
+	if this.Field7Size() != that.Field7Size() {
+		return fmt.Errorf("Field7 this(%v) Not Equal that(%v)", this.Field7Size(), that.Field7Size())
 	}
-	for i := range this.Field7 {
-		if this.Field7[i] != that.Field7[i] {
-			return fmt.Errorf("Field7 this[%v](%v) Not Equal that[%v](%v)", i, this.Field7[i], i, that.Field7[i])
+	for i := 0; i < this.Field7Size(); i++ {
+		thisValue, _ := this.GetField7(i)
+		thatValue, _ := that.GetField7(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field7 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field8) != len(that.Field8) {
-		return fmt.Errorf("Field8 this(%v) Not Equal that(%v)", len(this.Field8), len(that.Field8))
+	if this.Field8Size() != that.Field8Size() {
+		return fmt.Errorf("Field8 this(%v) Not Equal that(%v)", this.Field8Size(), that.Field8Size())
 	}
-	for i := range this.Field8 {
-		if this.Field8[i] != that.Field8[i] {
-			return fmt.Errorf("Field8 this[%v](%v) Not Equal that[%v](%v)", i, this.Field8[i], i, that.Field8[i])
+	for i := 0; i < this.Field8Size(); i++ {
+		thisValue, _ := this.GetField8(i)
+		thatValue, _ := that.GetField8(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field8 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field9) != len(that.Field9) {
-		return fmt.Errorf("Field9 this(%v) Not Equal that(%v)", len(this.Field9), len(that.Field9))
+	if this.Field9Size() != that.Field9Size() {
+		return fmt.Errorf("Field9 this(%v) Not Equal that(%v)", this.Field9Size(), that.Field9Size())
 	}
-	for i := range this.Field9 {
-		if this.Field9[i] != that.Field9[i] {
-			return fmt.Errorf("Field9 this[%v](%v) Not Equal that[%v](%v)", i, this.Field9[i], i, that.Field9[i])
+	for i := 0; i < this.Field9Size(); i++ {
+		thisValue, _ := this.GetField9(i)
+		thatValue, _ := that.GetField9(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field9 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field10) != len(that.Field10) {
-		return fmt.Errorf("Field10 this(%v) Not Equal that(%v)", len(this.Field10), len(that.Field10))
+	if this.Field10Size() != that.Field10Size() {
+		return fmt.Errorf("Field10 this(%v) Not Equal that(%v)", this.Field10Size(), that.Field10Size())
 	}
-	for i := range this.Field10 {
-		if this.Field10[i] != that.Field10[i] {
-			return fmt.Errorf("Field10 this[%v](%v) Not Equal that[%v](%v)", i, this.Field10[i], i, that.Field10[i])
+	for i := 0; i < this.Field10Size(); i++ {
+		thisValue, _ := this.GetField10(i)
+		thatValue, _ := that.GetField10(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field10 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field11) != len(that.Field11) {
-		return fmt.Errorf("Field11 this(%v) Not Equal that(%v)", len(this.Field11), len(that.Field11))
+	if this.Field11Size() != that.Field11Size() {
+		return fmt.Errorf("Field11 this(%v) Not Equal that(%v)", this.Field11Size(), that.Field11Size())
 	}
-	for i := range this.Field11 {
-		if this.Field11[i] != that.Field11[i] {
-			return fmt.Errorf("Field11 this[%v](%v) Not Equal that[%v](%v)", i, this.Field11[i], i, that.Field11[i])
+	for i := 0; i < this.Field11Size(); i++ {
+		thisValue, _ := this.GetField11(i)
+		thatValue, _ := that.GetField11(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field11 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field12) != len(that.Field12) {
-		return fmt.Errorf("Field12 this(%v) Not Equal that(%v)", len(this.Field12), len(that.Field12))
+	if this.Field12Size() != that.Field12Size() {
+		return fmt.Errorf("Field12 this(%v) Not Equal that(%v)", this.Field12Size(), that.Field12Size())
 	}
-	for i := range this.Field12 {
-		if this.Field12[i] != that.Field12[i] {
-			return fmt.Errorf("Field12 this[%v](%v) Not Equal that[%v](%v)", i, this.Field12[i], i, that.Field12[i])
+	for i := 0; i < this.Field12Size(); i++ {
+		thisValue, _ := this.GetField12(i)
+		thatValue, _ := that.GetField12(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field12 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field13) != len(that.Field13) {
-		return fmt.Errorf("Field13 this(%v) Not Equal that(%v)", len(this.Field13), len(that.Field13))
+	if this.Field13Size() != that.Field13Size() {
+		return fmt.Errorf("Field13 this(%v) Not Equal that(%v)", this.Field13Size(), that.Field13Size())
 	}
-	for i := range this.Field13 {
-		if this.Field13[i] != that.Field13[i] {
-			return fmt.Errorf("Field13 this[%v](%v) Not Equal that[%v](%v)", i, this.Field13[i], i, that.Field13[i])
+	for i := 0; i < this.Field13Size(); i++ {
+		thisValue, _ := this.GetField13(i)
+		thatValue, _ := that.GetField13(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field13 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that.XXX_unrecognized) {
@@ -210,108 +315,134 @@ func VerboseEqualUnsafe(this *NinRepNativeUnsafe, that *NinRepPackedNativeUnsafe
 		return fmt.Errorf("that != nil && this == nil")
 	}
 
-	if len(this.Field1) != len(that.Field1) {
-		return fmt.Errorf("Field1 this(%v) Not Equal that(%v)", len(this.Field1), len(that.Field1))
-	}
-	for i := range this.Field1 {
-		if this.Field1[i] != that.Field1[i] {
-			return fmt.Errorf("Field1 this[%v](%v) Not Equal that[%v](%v)", i, this.Field1[i], i, that.Field1[i])
+	if this.Field1Size() != that.Field1Size() {
+		return fmt.Errorf("Field1 this(%v) Not Equal that(%v)", this.Field1Size(), that.Field1Size())
+	}
+	for i := 0; i < this.Field1Size(); i++ {
+		thisValue, _ := this.GetField1(i)
+		thatValue, _ := that.GetField1(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field1 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field2) != len(that.Field2) {
-		return fmt.Errorf("Field2 this(%v) Not Equal that(%v)", len(this.Field2), len(that.Field2))
+	if this.Field2Size() != that.Field2Size() {
+		return fmt.Errorf("Field2 this(%v) Not Equal that(%v)", this.Field2Size(), that.Field2Size())
 	}
-	for i := range this.Field2 {
-		if this.Field2[i] != that.Field2[i] {
-			return fmt.Errorf("Field2 this[%v](%v) Not Equal that[%v](%v)", i, this.Field2[i], i, that.Field2[i])
+	for i := 0; i < this.Field2Size(); i++ {
+		thisValue, _ := this.GetField2(i)
+		thatValue, _ := that.GetField2(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field2 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field3) != len(that.Field3) {
-		return fmt.Errorf("Field3 this(%v) Not Equal that(%v)", len(this.Field3), len(that.Field3))
+	if this.Field3Size() != that.Field3Size() {
+		return fmt.Errorf("Field3 this(%v) Not Equal that(%v)", this.Field3Size(), that.Field3Size())
 	}
-	for i := range this.Field3 {
-		if this.Field3[i] != that.Field3[i] {
-			return fmt.Errorf("Field3 this[%v](%v) Not Equal that[%v](%v)", i, this.Field3[i], i, that.Field3[i])
+	for i := 0; i < this.Field3Size(); i++ {
+		thisValue, _ := this.GetField3(i)
+		thatValue, _ := that.GetField3(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field3 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field4) != len(that.Field4) {
-		return fmt.Errorf("Field4 this(%v) Not Equal that(%v)", len(this.Field4), len(that.Field4))
+	if this.Field4Size() != that.Field4Size() {
+		return fmt.Errorf("Field4 this(%v) Not Equal that(%v)", this.Field4Size(), that.Field4Size())
 	}
-	for i := range this.Field4 {
-		if this.Field4[i] != that.Field4[i] {
-			return fmt.Errorf("Field4 this[%v](%v) Not Equal that[%v](%v)", i, this.Field4[i], i, that.Field4[i])
+	for i := 0; i < this.Field4Size(); i++ {
+		thisValue, _ := this.GetField4(i)
+		thatValue, _ := that.GetField4(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field4 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field5) != len(that.Field5) {
-		return fmt.Errorf("Field5 this(%v) Not Equal that(%v)", len(this.Field5), len(that.Field5))
+	if this.Field5Size() != that.Field5Size() {
+		return fmt.Errorf("Field5 this(%v) Not Equal that(%v)", this.Field5Size(), that.Field5Size())
 	}
-	for i := range this.Field5 {
-		if this.Field5[i] != that.Field5[i] {
-			return fmt.Errorf("Field5 this[%v](%v) Not Equal that[%v](%v)", i, this.Field5[i], i, that.Field5[i])
+	for i := 0; i < this.Field5Size(); i++ {
+		thisValue, _ := this.GetField5(i)
+		thatValue, _ := that.GetField5(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field5 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field6) != len(that.Field6) {
-		return fmt.Errorf("Field6 this(%v) Not Equal that(%v)", len(this.Field6), len(that.Field6))
+	if this.Field6Size() != that.Field6Size() {
+		return fmt.Errorf("Field6 this(%v) Not Equal that(%v)", this.Field6Size(), that.Field6Size())
 	}
-	for i := range this.Field6 {
-		if this.Field6[i] != that.Field6[i] {
-			return fmt.Errorf("Field6 this[%v](%v) Not Equal that[%v](%v)", i, this.Field6[i], i, that.Field6[i])
+	for i := 0; i < this.Field6Size(); i++ {
+		thisValue, _ := this.GetField6(i)
+		thatValue, _ := that.GetField6(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field6 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field7) != len(that.Field7) {
-		return fmt.Errorf("Field7 this(%v) Not Equal that(%v)", len(this.Field7), len(that.Field7))
+	if this.Field7Size() != that.Field7Size() {
+		return fmt.Errorf("Field7 this(%v) Not Equal that(%v)", this.Field7Size(), that.Field7Size())
 	}
-	for i := range this.Field7 {
-		if this.Field7[i] != that.Field7[i] {
-			return fmt.Errorf("Field7 this[%v](%v) Not Equal that[%v](%v)", i, this.Field7[i], i, that.Field7[i])
+	for i := 0; i < this.Field7Size(); i++ {
+		thisValue, _ := this.GetField7(i)
+		thatValue, _ := that.GetField7(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field7 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field8) != len(that.Field8) {
-		return fmt.Errorf("Field8 this(%v) Not Equal that(%v)", len(this.Field8), len(that.Field8))
+	if this.Field8Size() != that.Field8Size() {
+		return fmt.Errorf("Field8 this(%v) Not Equal that(%v)", this.Field8Size(), that.Field8Size())
 	}
-	for i := range this.Field8 {
-		if this.Field8[i] != that.Field8[i] {
-			return fmt.Errorf("Field8 this[%v](%v) Not Equal that[%v](%v)", i, this.Field8[i], i, that.Field8[i])
+	for i := 0; i < this.Field8Size(); i++ {
+		thisValue, _ := this.GetField8(i)
+		thatValue, _ := that.GetField8(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field8 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field9) != len(that.Field9) {
-		return fmt.Errorf("Field9 this(%v) Not Equal that(%v)", len(this.Field9), len(that.Field9))
+	if this.Field9Size() != that.Field9Size() {
+		return fmt.Errorf("Field9 this(%v) Not Equal that(%v)", this.Field9Size(), that.Field9Size())
 	}
-	for i := range this.Field9 {
-		if this.Field9[i] != that.Field9[i] {
-			return fmt.Errorf("Field9 this[%v](%v) Not Equal that[%v](%v)", i, this.Field9[i], i, that.Field9[i])
+	for i := 0; i < this.Field9Size(); i++ {
+		thisValue, _ := this.GetField9(i)
+		thatValue, _ := that.GetField9(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field9 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field10) != len(that.Field10) {
-		return fmt.Errorf("Field10 this(%v) Not Equal that(%v)", len(this.Field10), len(that.Field10))
+	if this.Field10Size() != that.Field10Size() {
+		return fmt.Errorf("Field10 this(%v) Not Equal that(%v)", this.Field10Size(), that.Field10Size())
 	}
-	for i := range this.Field10 {
-		if this.Field10[i] != that.Field10[i] {
-			return fmt.Errorf("Field10 this[%v](%v) Not Equal that[%v](%v)", i, this.Field10[i], i, that.Field10[i])
+	for i := 0; i < this.Field10Size(); i++ {
+		thisValue, _ := this.GetField10(i)
+		thatValue, _ := that.GetField10(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field10 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field11) != len(that.Field11) {
-		return fmt.Errorf("Field11 this(%v) Not Equal that(%v)", len(this.Field11), len(that.Field11))
+	if this.Field11Size() != that.Field11Size() {
+		return fmt.Errorf("Field11 this(%v) Not Equal that(%v)", this.Field11Size(), that.Field11Size())
 	}
-	for i := range this.Field11 {
-		if this.Field11[i] != that.Field11[i] {
-			return fmt.Errorf("Field11 this[%v](%v) Not Equal that[%v](%v)", i, this.Field11[i], i, that.Field11[i])
+	for i := 0; i < this.Field11Size(); i++ {
+		thisValue, _ := this.GetField11(i)
+		thatValue, _ := that.GetField11(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field11 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field12) != len(that.Field12) {
-		return fmt.Errorf("Field12 this(%v) Not Equal that(%v)", len(this.Field12), len(that.Field12))
+	if this.Field12Size() != that.Field12Size() {
+		return fmt.Errorf("Field12 this(%v) Not Equal that(%v)", this.Field12Size(), that.Field12Size())
 	}
-	for i := range this.Field12 {
-		if this.Field12[i] != that.Field12[i] {
-			return fmt.Errorf("Field12 this[%v](%v) Not Equal that[%v](%v)", i, this.Field12[i], i, that.Field12[i])
+	for i := 0; i < this.Field12Size(); i++ {
+		thisValue, _ := this.GetField12(i)
+		thatValue, _ := that.GetField12(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field12 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
-	if len(this.Field13) != len(that.Field13) {
-		return fmt.Errorf("Field13 this(%v) Not Equal that(%v)", len(this.Field13), len(that.Field13))
+	if this.Field13Size() != that.Field13Size() {
+		return fmt.Errorf("Field13 this(%v) Not Equal that(%v)", this.Field13Size(), that.Field13Size())
 	}
-	for i := range this.Field13 {
-		if this.Field13[i] != that.Field13[i] {
-			return fmt.Errorf("Field13 this[%v](%v) Not Equal that[%v](%v)", i, this.Field13[i], i, that.Field13[i])
+	for i := 0; i < this.Field13Size(); i++ {
+		thisValue, _ := this.GetField13(i)
+		thatValue, _ := that.GetField13(i)
+		if thisValue != thatValue {
+			return fmt.Errorf("Field13 this[%v](%v) Not Equal that[%v](%v)", i, thisValue, i, thatValue)
 		}
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that.XXX_unrecognized) {
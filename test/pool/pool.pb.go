@@ -0,0 +1,1308 @@
+// Code generated by protoc-gen-dgo.
+// source: pool.proto
+// DO NOT EDIT!
+
+/*
+Package pool is a generated protocol buffer package.
+
+It is generated from these files:
+
+	pool.proto
+
+It has these top-level messages:
+
+	Point
+	Path
+*/
+package pool
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+import sync "sync"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Point struct {
+	xxx_sizeCached   int
+	x                int64
+	y                int64
+	XXX_unrecognized []byte
+	xxx_IsXSet       bool
+	xxx_IsYSet       bool
+}
+
+func (m *Point) Reset()         { *m = Point{} }
+func (m *Point) String() string { return proto.CompactTextString(m) }
+func (*Point) ProtoMessage()    {}
+
+func (m *Point) GetX() int64 {
+	if m != nil && m.xxx_IsXSet {
+		return m.x
+	}
+	return 0
+}
+
+func (m *Point) GetY() int64 {
+	if m != nil && m.xxx_IsYSet {
+		return m.y
+	}
+	return 0
+}
+
+func (m *Point) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Point) SetX(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsXSet = true
+	m.x = value
+	return nil
+}
+
+func (m *Point) HasX() (isSet bool) {
+	if m != nil && m.xxx_IsXSet {
+		return true
+	}
+	return false
+}
+
+func (m *Point) ClearX() {
+	if m != nil {
+		m.xxx_IsXSet = false
+	}
+}
+
+func (m *Point) SetY(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsYSet = true
+	m.y = value
+	return nil
+}
+
+func (m *Point) HasY() (isSet bool) {
+	if m != nil && m.xxx_IsYSet {
+		return true
+	}
+	return false
+}
+
+func (m *Point) ClearY() {
+	if m != nil {
+		m.xxx_IsYSet = false
+	}
+}
+
+func (m *Point) Clear() {
+	if m != nil {
+		m.ClearX()
+		m.ClearY()
+	}
+}
+
+type Path struct {
+	xxx_sizeCached   int
+	points           []*Point
+	weights          []int64
+	origin           *Point
+	XXX_unrecognized []byte
+	xxx_LenPoints    int
+	xxx_LenWeights   int
+	xxx_IsOriginSet  bool
+}
+
+func (m *Path) Reset()         { *m = Path{} }
+func (m *Path) String() string { return proto.CompactTextString(m) }
+func (*Path) ProtoMessage()    {}
+
+func (m *Path) GetOrigin() *Point {
+	if m != nil && m.xxx_IsOriginSet {
+		return m.origin
+	}
+	return nil
+}
+func (m *Path) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Path) AddPoints() (field *Point, err error) {
+	if m != nil {
+		if len(m.points) <= m.xxx_LenPoints {
+			newCapacity := 0
+			if len(m.points) == 0 {
+				newCapacity = 8
+			} else if len(m.points) < 1000000 {
+				newCapacity = m.xxx_LenPoints * 2
+			} else {
+				newCapacity = m.xxx_LenPoints + 1000000
+			}
+			t := make([]*Point, newCapacity, newCapacity)
+			copy(t, m.points)
+			m.points = t
+		}
+		field = m.points[m.xxx_LenPoints]
+		if field == nil {
+			field = new(Point)
+			m.points[m.xxx_LenPoints] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenPoints += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Path) MutatePoints(index int) (field *Point, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPoints {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.points[index] == nil {
+		m.points[index] = new(Point)
+	}
+	return m.points[index], nil
+}
+
+func (m *Path) PointsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenPoints
+	}
+	return 0
+}
+
+func (m *Path) ClearPoints() {
+	if m != nil {
+		for i := 0; i < m.PointsSize(); i++ {
+			m.points[i].Clear()
+		}
+		m.xxx_LenPoints = 0
+
+	}
+}
+
+func (m *Path) GetPoints(index int) (field *Point, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPoints {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.points[index], nil
+}
+
+func (m *Path) AddWeights(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.weights) <= m.xxx_LenWeights {
+		newCapacity := 0
+		if len(m.weights) == 0 {
+			newCapacity = 8
+		} else if len(m.weights) < 1000000 {
+			newCapacity = m.xxx_LenWeights * 2
+		} else {
+			newCapacity = m.xxx_LenWeights + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.weights)
+		m.weights = t
+	}
+	m.weights[m.xxx_LenWeights] = value
+	m.xxx_LenWeights += 1
+	return nil
+}
+
+func (m *Path) SetWeights(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenWeights {
+		return errors.New("Index is out of bounds")
+	}
+	m.weights[index] = value
+	return nil
+}
+
+func (m *Path) WeightsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenWeights
+	}
+	return 0
+}
+
+func (m *Path) ClearWeights() {
+	if m != nil {
+		m.xxx_LenWeights = 0
+	}
+}
+
+func (m *Path) GetWeights(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenWeights {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.weights[index], nil
+}
+
+func (m *Path) MutateOrigin() (field *Point, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsOriginSet {
+		m.xxx_IsOriginSet = true
+		if m.origin == nil {
+			m.origin = new(Point)
+		} else {
+			m.origin.Clear()
+		}
+	}
+	return m.origin, nil
+}
+
+func (m *Path) HasOrigin() (isSet bool) {
+	if m != nil && m.xxx_IsOriginSet {
+		return true
+	}
+	return false
+}
+
+func (m *Path) ClearOrigin() {
+	if m != nil {
+		m.origin.Clear()
+		m.xxx_IsOriginSet = false
+
+	}
+}
+
+func (m *Path) Clear() {
+	if m != nil {
+		for i := 0; i < m.PointsSize(); i++ {
+			m.points[i].Clear()
+		}
+		m.xxx_LenPoints = 0
+
+		m.ClearWeights()
+		m.origin.Clear()
+		m.xxx_IsOriginSet = false
+
+	}
+}
+
+func (m *Point) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsXSet {
+		n += 1 + sovPool(uint64(m.x))
+	}
+	if m.xxx_IsYSet {
+		n += 1 + sovPool(uint64(m.y))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Path) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenPoints > 0 {
+		for i := 0; i < m.xxx_LenPoints; i++ {
+			e := m.points[i]
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if m.xxx_LenWeights > 0 {
+		for i := 0; i < m.xxx_LenWeights; i++ {
+			e := m.weights[i]
+			n += 1 + sovPool(uint64(e))
+		}
+	}
+	if m.xxx_IsOriginSet {
+		l = m.origin.Size()
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Point) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Point) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Point) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsXSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintPool(data, i, uint64(m.x))
+	}
+	if m.xxx_IsYSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintPool(data, i, uint64(m.y))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Path) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Path) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Path) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenPoints > 0 {
+		for idx := 0; idx < m.xxx_LenPoints; idx++ {
+			msg := m.points[idx]
+			data[i] = 0xa
+			i++
+			i = encodeVarintPool(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.xxx_LenWeights > 0 {
+		for idx := 0; idx < m.xxx_LenWeights; idx++ {
+			num := m.weights[idx]
+			data[i] = 0x10
+			i++
+			i = encodeVarintPool(data, i, uint64(num))
+		}
+	}
+	if m.xxx_IsOriginSet {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintPool(data, i, uint64(m.origin.SizeCached()))
+		n1, err := m.origin.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Pool(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Pool(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPool(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Point) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field x", wireType)
+			}
+			m.xxx_IsXSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.x |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field y", wireType)
+			}
+			m.xxx_IsYSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.y |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Path) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddPoints()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.weights) <= m.xxx_LenWeights {
+						newCapacity := 0
+						if len(m.weights) == 0 {
+							newCapacity = 8
+						} else if len(m.weights) < 1000000 {
+							newCapacity = m.xxx_LenWeights * 2
+						} else {
+							newCapacity = m.xxx_LenWeights + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.weights)
+						m.weights = t
+					}
+					m.weights[m.xxx_LenWeights] = int64(v)
+					m.xxx_LenWeights += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.weights) <= m.xxx_LenWeights {
+					newCapacity := 0
+					if len(m.weights) == 0 {
+						newCapacity = 8
+					} else if len(m.weights) < 1000000 {
+						newCapacity = m.xxx_LenWeights * 2
+					} else {
+						newCapacity = m.xxx_LenWeights + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.weights)
+					m.weights = t
+				}
+				m.weights[m.xxx_LenWeights] = int64(v)
+				m.xxx_LenWeights += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field weights", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateOrigin()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Point) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Point) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Path) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Path) CheckInitialized() error {
+	return nil
+}
+
+func (m *Point) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsXSet || w.EmitDefaults() {
+		w.Field("x", "x")
+		w.Int64(m.GetX())
+	}
+	if m.xxx_IsYSet || w.EmitDefaults() {
+		w.Field("y", "y")
+		w.Int64(m.GetY())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Point) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("x", "x"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetX(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("y", "y"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetY(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Point) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Point) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Path) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_LenPoints > 0 || w.EmitDefaults() {
+		w.Field("points", "points")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenPoints; i++ {
+			w.Message(m.points[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenWeights > 0 || w.EmitDefaults() {
+		w.Field("weights", "weights")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenWeights; i++ {
+			w.Int64(m.weights[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_IsOriginSet {
+		w.Field("origin", "origin")
+		w.Message(m.GetOrigin())
+	} else if w.EmitDefaults() {
+		w.Field("origin", "origin")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Path) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("points", "points"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddPoints()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("weights", "weights"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddWeights(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("origin", "origin"); ok {
+		v, err := m.MutateOrigin()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Path) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Path) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Point) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsXSet {
+		w.Field("x")
+		w.Value(m.x)
+	}
+	if m.xxx_IsYSet {
+		w.Field("y")
+		w.Value(m.y)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Point) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "x":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetX(v)
+	case "y":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetY(v)
+	}
+	return false, nil
+}
+
+func (m *Path) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenPoints; i++ {
+		w.Field("points")
+		w.Message(m.points[i])
+	}
+	for i := 0; i < m.xxx_LenWeights; i++ {
+		w.Field("weights")
+		w.Value(m.weights[i])
+	}
+	if m.xxx_IsOriginSet {
+		w.Field("origin")
+		w.Message(m.origin)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Path) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "points":
+		v, err := m.AddPoints()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "weights":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddWeights(v)
+	case "origin":
+		v, err := m.MutateOrigin()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Point) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Point{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Point) MergeFrom(src proto.Message) {
+	s, ok := src.(*Point)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsXSet {
+		m.SetX(s.x)
+	}
+	if s.xxx_IsYSet {
+		m.SetY(s.y)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Point) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Point)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsXSet != o.xxx_IsXSet {
+		return false
+	}
+	if m.xxx_IsXSet && m.x != o.x {
+		return false
+	}
+	if m.xxx_IsYSet != o.xxx_IsYSet {
+		return false
+	}
+	if m.xxx_IsYSet && m.y != o.y {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Path) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Path{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Path) MergeFrom(src proto.Message) {
+	s, ok := src.(*Path)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenPoints; i++ {
+		v, _ := m.AddPoints()
+		v.MergeFrom(s.points[i])
+	}
+	for i := 0; i < s.xxx_LenWeights; i++ {
+		m.AddWeights(s.weights[i])
+	}
+	if s.xxx_IsOriginSet {
+		v, _ := m.MutateOrigin()
+		v.MergeFrom(s.origin)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Path) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Path)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenPoints != o.xxx_LenPoints {
+		return false
+	}
+	for i := 0; i < m.xxx_LenPoints; i++ {
+		if !m.points[i].Equal(o.points[i]) {
+			return false
+		}
+	}
+	if m.xxx_LenWeights != o.xxx_LenWeights {
+		return false
+	}
+	for i := 0; i < m.xxx_LenWeights; i++ {
+		if m.weights[i] != o.weights[i] {
+			return false
+		}
+	}
+	if m.xxx_IsOriginSet != o.xxx_IsOriginSet {
+		return false
+	}
+	if m.xxx_IsOriginSet && !m.origin.Equal(o.origin) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var poolPoint = sync.Pool{New: func() interface{} { return new(Point) }}
+
+// AcquirePoint returns an empty Point from the pool. Call Release once the
+// message is no longer used.
+func AcquirePoint() *Point {
+	return poolPoint.Get().(*Point)
+}
+
+// Release clears m and returns it to the pool. Neither m nor any of the
+// messages it holds may be used after the call.
+func (m *Point) Release() {
+	if m == nil {
+		return
+	}
+	m.Clear()
+	m.XXX_unrecognized = m.XXX_unrecognized[:0]
+	poolPoint.Put(m)
+}
+
+var poolPath = sync.Pool{New: func() interface{} { return new(Path) }}
+
+// AcquirePath returns an empty Path from the pool. Call Release once the
+// message is no longer used.
+func AcquirePath() *Path {
+	return poolPath.Get().(*Path)
+}
+
+// Release clears m and returns it to the pool. Neither m nor any of the
+// messages it holds may be used after the call.
+func (m *Path) Release() {
+	if m == nil {
+		return
+	}
+	m.Clear()
+	m.XXX_unrecognized = m.XXX_unrecognized[:0]
+	poolPath.Put(m)
+}
+
+var reflectionPoint = &proto.MessageInfo{
+	Name: "pool.Point",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "x",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Point)(nil).GetX(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Point).GetX()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "x", v)
+				}
+				x := m.(*Point)
+				return x.SetX(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Point).HasX()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Point).ClearX()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "y",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Point)(nil).GetY(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Point).GetY()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "y", v)
+				}
+				x := m.(*Point)
+				return x.SetY(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Point).HasY()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Point).ClearY()
+			},
+		},
+	},
+}
+
+func (m *Point) ProtoReflect() *proto.MessageInfo {
+	return reflectionPoint
+}
+
+var reflectionPath = &proto.MessageInfo{
+	Name: "pool.Path",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "points",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Point)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Path)
+				v := make([]*Point, x.xxx_LenPoints)
+				copy(v, x.points)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Point)
+				if !ok {
+					return proto.NewFieldTypeError(m, "points", v)
+				}
+				x := m.(*Path)
+				x.ClearPoints()
+				for _, e := range value {
+					field, err := x.AddPoints()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Path).PointsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Path).ClearPoints()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "weights",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Path)
+				v := make([]int64, x.xxx_LenWeights)
+				copy(v, x.weights)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "weights", v)
+				}
+				x := m.(*Path)
+				x.ClearWeights()
+				for _, e := range value {
+					if err := x.AddWeights(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Path).WeightsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Path).ClearWeights()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "origin",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Point)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Path).GetOrigin()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Point)
+				if !ok {
+					return proto.NewFieldTypeError(m, "origin", v)
+				}
+				x := m.(*Path)
+				x.ClearOrigin()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateOrigin()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Path).HasOrigin()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Path).ClearOrigin()
+			},
+		},
+	},
+}
+
+func (m *Path) ProtoReflect() *proto.MessageInfo {
+	return reflectionPath
+}
+
+func init() {
+	proto.RegisterType((*Point)(nil), "pool.Point")
+	proto.RegisterType((*Path)(nil), "pool.Path")
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package pool
+
+import (
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newPath(n int) *Path {
+	m := &Path{}
+	for i := 0; i < n; i++ {
+		p, _ := m.AddPoints()
+		p.SetX(int64(i))
+		p.SetY(int64(-i))
+		m.AddWeights(int64(i * 10))
+	}
+	origin, _ := m.MutateOrigin()
+	origin.SetX(7)
+	return m
+}
+
+func TestAcquireRelease(t *testing.T) {
+	m := AcquirePath()
+	if m.PointsSize() != 0 || m.HasOrigin() {
+		t.Fatalf("acquired message is not empty: %v", m)
+	}
+	p, _ := m.AddPoints()
+	p.SetX(1)
+	m.Release()
+
+	m = AcquirePath()
+	defer m.Release()
+	if m.PointsSize() != 0 || m.WeightsSize() != 0 || m.HasOrigin() {
+		t.Fatalf("reacquired message is not empty: %v", m)
+	}
+
+	var nilPath *Path
+	nilPath.Release()
+}
+
+func TestUnmarshalReuse(t *testing.T) {
+	big, err := proto.Marshal(newPath(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	small := newPath(2)
+	want := proto.CompactTextString(small)
+	smallData, err := proto.Marshal(small)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Path{}
+	if err := m.Unmarshal(big); err != nil {
+		t.Fatal(err)
+	}
+	first, _ := m.GetPoints(0)
+	m.Clear()
+	if err := m.Unmarshal(smallData); err != nil {
+		t.Fatal(err)
+	}
+	if got := proto.CompactTextString(m); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if p, _ := m.GetPoints(0); p != first {
+		t.Fatalf("Unmarshal allocated a new Point instead of reusing the cleared one")
+	}
+}
+
+func TestAddReusesCleared(t *testing.T) {
+	m := newPath(3)
+	first, _ := m.GetPoints(0)
+	m.ClearPoints()
+	p, _ := m.AddPoints()
+	if p != first {
+		t.Fatalf("AddPoints did not reuse the cleared Point")
+	}
+	if p.HasX() || p.HasY() {
+		t.Fatalf("reused Point is not empty: %v", p)
+	}
+}
+
+func TestPoolAllocs(t *testing.T) {
+	data, err := proto.Marshal(newPath(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh := testing.AllocsPerRun(100, func() {
+		m := &Path{}
+		if err := m.Unmarshal(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+	pooled := testing.AllocsPerRun(100, func() {
+		m := AcquirePath()
+		if err := m.Unmarshal(data); err != nil {
+			t.Fatal(err)
+		}
+		m.Release()
+	})
+	if pooled >= fresh {
+		t.Fatalf("pooled unmarshal made %v allocations, fresh unmarshal made %v", pooled, fresh)
+	}
+}
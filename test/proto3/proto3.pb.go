@@ -0,0 +1,2776 @@
+// Code generated by protoc-gen-dgo.
+// source: proto3.proto
+// DO NOT EDIT!
+
+/*
+Package proto3 is a generated protocol buffer package.
+
+It is generated from these files:
+
+	proto3.proto
+
+It has these top-level messages:
+
+	Inner
+	Scalars
+*/
+package proto3
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Color int32
+
+const (
+	Color_RED   Color = 0
+	Color_GREEN Color = 1
+	Color_BLUE  Color = 2
+)
+
+var Color_name = map[int32]string{
+	0: "RED",
+	1: "GREEN",
+	2: "BLUE",
+}
+var Color_value = map[string]int32{
+	"RED":   0,
+	"GREEN": 1,
+	"BLUE":  2,
+}
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+
+type Inner struct {
+	xxx_sizeCached   int
+	value            int32
+	XXX_unrecognized []byte
+}
+
+func (m *Inner) Reset()         { *m = Inner{} }
+func (m *Inner) String() string { return proto.CompactTextString(m) }
+func (*Inner) ProtoMessage()    {}
+
+func (m *Inner) GetValue() int32 {
+	if m != nil {
+		return m.value
+	}
+	return 0
+}
+
+func (m *Inner) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Inner) SetValue(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *Inner) ClearValue() {
+	if m != nil {
+		m.value = 0
+	}
+}
+
+func (m *Inner) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+type Scalars struct {
+	xxx_sizeCached       int
+	intValue             int32
+	longValue            int64
+	uintValue            uint32
+	sintValue            int64
+	fixedValue           uint32
+	doubleValue          float64
+	floatValue           float32
+	boolValue            bool
+	stringValue          string
+	bytesValue           []byte
+	color                Color
+	inner                *Inner
+	packedInts           []int32
+	colors               []Color
+	unpackedLongs        []int64
+	names                []string
+	XXX_unrecognized     []byte
+	xxx_IsInnerSet       bool
+	xxx_LenPackedInts    int
+	xxx_LenColors        int
+	xxx_LenUnpackedLongs int
+	xxx_LenNames         int
+}
+
+func (m *Scalars) Reset()         { *m = Scalars{} }
+func (m *Scalars) String() string { return proto.CompactTextString(m) }
+func (*Scalars) ProtoMessage()    {}
+
+func (m *Scalars) GetIntValue() int32 {
+	if m != nil {
+		return m.intValue
+	}
+	return 0
+}
+
+func (m *Scalars) GetLongValue() int64 {
+	if m != nil {
+		return m.longValue
+	}
+	return 0
+}
+
+func (m *Scalars) GetUintValue() uint32 {
+	if m != nil {
+		return m.uintValue
+	}
+	return 0
+}
+
+func (m *Scalars) GetSintValue() int64 {
+	if m != nil {
+		return m.sintValue
+	}
+	return 0
+}
+
+func (m *Scalars) GetFixedValue() uint32 {
+	if m != nil {
+		return m.fixedValue
+	}
+	return 0
+}
+
+func (m *Scalars) GetDoubleValue() float64 {
+	if m != nil {
+		return m.doubleValue
+	}
+	return 0
+}
+
+func (m *Scalars) GetFloatValue() float32 {
+	if m != nil {
+		return m.floatValue
+	}
+	return 0
+}
+
+func (m *Scalars) GetBoolValue() bool {
+	if m != nil {
+		return m.boolValue
+	}
+	return false
+}
+
+func (m *Scalars) GetStringValue() string {
+	if m != nil {
+		return m.stringValue
+	}
+	return ""
+}
+
+func (m *Scalars) GetBytesValue() []byte {
+	if m != nil {
+		return m.bytesValue
+	}
+	return nil
+}
+func (m *Scalars) GetColor() Color {
+	if m != nil {
+		return m.color
+	}
+	return Color_RED
+}
+
+func (m *Scalars) GetInner() *Inner {
+	if m != nil && m.xxx_IsInnerSet {
+		return m.inner
+	}
+	return nil
+}
+func (m *Scalars) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Scalars) SetIntValue(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.intValue = value
+	return nil
+}
+
+func (m *Scalars) ClearIntValue() {
+	if m != nil {
+		m.intValue = 0
+	}
+}
+
+func (m *Scalars) SetLongValue(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.longValue = value
+	return nil
+}
+
+func (m *Scalars) ClearLongValue() {
+	if m != nil {
+		m.longValue = 0
+	}
+}
+
+func (m *Scalars) SetUintValue(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.uintValue = value
+	return nil
+}
+
+func (m *Scalars) ClearUintValue() {
+	if m != nil {
+		m.uintValue = 0
+	}
+}
+
+func (m *Scalars) SetSintValue(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.sintValue = value
+	return nil
+}
+
+func (m *Scalars) ClearSintValue() {
+	if m != nil {
+		m.sintValue = 0
+	}
+}
+
+func (m *Scalars) SetFixedValue(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.fixedValue = value
+	return nil
+}
+
+func (m *Scalars) ClearFixedValue() {
+	if m != nil {
+		m.fixedValue = 0
+	}
+}
+
+func (m *Scalars) SetDoubleValue(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.doubleValue = value
+	return nil
+}
+
+func (m *Scalars) ClearDoubleValue() {
+	if m != nil {
+		m.doubleValue = 0.0
+	}
+}
+
+func (m *Scalars) SetFloatValue(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.floatValue = value
+	return nil
+}
+
+func (m *Scalars) ClearFloatValue() {
+	if m != nil {
+		m.floatValue = 0.0
+	}
+}
+
+func (m *Scalars) SetBoolValue(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.boolValue = value
+	return nil
+}
+
+func (m *Scalars) ClearBoolValue() {
+	if m != nil {
+		m.boolValue = false
+	}
+}
+
+func (m *Scalars) SetStringValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.stringValue = value
+	return nil
+}
+
+func (m *Scalars) ClearStringValue() {
+	if m != nil {
+		m.stringValue = ""
+	}
+}
+
+func (m *Scalars) SetBytesValue(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.bytesValue = value
+	return nil
+}
+
+func (m *Scalars) ClearBytesValue() {
+	if m != nil {
+		m.bytesValue = nil
+	}
+}
+
+func (m *Scalars) SetColor(value Color) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.color = value
+	return nil
+}
+
+func (m *Scalars) ClearColor() {
+	if m != nil {
+		m.color = 0
+	}
+}
+
+func (m *Scalars) MutateInner() (field *Inner, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsInnerSet {
+		m.xxx_IsInnerSet = true
+		if m.inner == nil {
+			m.inner = new(Inner)
+		} else {
+			m.inner.Clear()
+		}
+	}
+	return m.inner, nil
+}
+
+func (m *Scalars) HasInner() (isSet bool) {
+	if m != nil && m.xxx_IsInnerSet {
+		return true
+	}
+	return false
+}
+
+func (m *Scalars) ClearInner() {
+	if m != nil {
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+	}
+}
+
+func (m *Scalars) AddPackedInts(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.packedInts) <= m.xxx_LenPackedInts {
+		newCapacity := 0
+		if len(m.packedInts) == 0 {
+			newCapacity = 8
+		} else if len(m.packedInts) < 1000000 {
+			newCapacity = m.xxx_LenPackedInts * 2
+		} else {
+			newCapacity = m.xxx_LenPackedInts + 1000000
+		}
+		t := make([]int32, newCapacity, newCapacity)
+		copy(t, m.packedInts)
+		m.packedInts = t
+	}
+	m.packedInts[m.xxx_LenPackedInts] = value
+	m.xxx_LenPackedInts += 1
+	return nil
+}
+
+func (m *Scalars) SetPackedInts(value int32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPackedInts {
+		return errors.New("Index is out of bounds")
+	}
+	m.packedInts[index] = value
+	return nil
+}
+
+func (m *Scalars) PackedIntsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenPackedInts
+	}
+	return 0
+}
+
+func (m *Scalars) ClearPackedInts() {
+	if m != nil {
+		m.xxx_LenPackedInts = 0
+	}
+}
+
+func (m *Scalars) GetPackedInts(index int) (field int32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPackedInts {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.packedInts[index], nil
+}
+
+func (m *Scalars) AddColors(value Color) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.colors) <= m.xxx_LenColors {
+		newCapacity := 0
+		if len(m.colors) == 0 {
+			newCapacity = 8
+		} else if len(m.colors) < 1000000 {
+			newCapacity = m.xxx_LenColors * 2
+		} else {
+			newCapacity = m.xxx_LenColors + 1000000
+		}
+		t := make([]Color, newCapacity, newCapacity)
+		copy(t, m.colors)
+		m.colors = t
+	}
+	m.colors[m.xxx_LenColors] = value
+	m.xxx_LenColors += 1
+	return nil
+}
+
+func (m *Scalars) SetColors(value Color, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenColors {
+		return errors.New("Index is out of bounds")
+	}
+	m.colors[index] = value
+	return nil
+}
+
+func (m *Scalars) ColorsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenColors
+	}
+	return 0
+}
+
+func (m *Scalars) ClearColors() {
+	if m != nil {
+		m.xxx_LenColors = 0
+	}
+}
+
+func (m *Scalars) GetColors(index int) (field Color, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenColors {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.colors[index], nil
+}
+
+func (m *Scalars) AddUnpackedLongs(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.unpackedLongs) <= m.xxx_LenUnpackedLongs {
+		newCapacity := 0
+		if len(m.unpackedLongs) == 0 {
+			newCapacity = 8
+		} else if len(m.unpackedLongs) < 1000000 {
+			newCapacity = m.xxx_LenUnpackedLongs * 2
+		} else {
+			newCapacity = m.xxx_LenUnpackedLongs + 1000000
+		}
+		t := make([]int64, newCapacity, newCapacity)
+		copy(t, m.unpackedLongs)
+		m.unpackedLongs = t
+	}
+	m.unpackedLongs[m.xxx_LenUnpackedLongs] = value
+	m.xxx_LenUnpackedLongs += 1
+	return nil
+}
+
+func (m *Scalars) SetUnpackedLongs(value int64, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenUnpackedLongs {
+		return errors.New("Index is out of bounds")
+	}
+	m.unpackedLongs[index] = value
+	return nil
+}
+
+func (m *Scalars) UnpackedLongsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenUnpackedLongs
+	}
+	return 0
+}
+
+func (m *Scalars) ClearUnpackedLongs() {
+	if m != nil {
+		m.xxx_LenUnpackedLongs = 0
+	}
+}
+
+func (m *Scalars) GetUnpackedLongs(index int) (field int64, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenUnpackedLongs {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.unpackedLongs[index], nil
+}
+
+func (m *Scalars) AddNames(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.names) <= m.xxx_LenNames {
+		newCapacity := 0
+		if len(m.names) == 0 {
+			newCapacity = 8
+		} else if len(m.names) < 1000000 {
+			newCapacity = m.xxx_LenNames * 2
+		} else {
+			newCapacity = m.xxx_LenNames + 1000000
+		}
+		t := make([]string, newCapacity, newCapacity)
+		copy(t, m.names)
+		m.names = t
+	}
+	m.names[m.xxx_LenNames] = value
+	m.xxx_LenNames += 1
+	return nil
+}
+
+func (m *Scalars) SetNames(value string, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenNames {
+		return errors.New("Index is out of bounds")
+	}
+	m.names[index] = value
+	return nil
+}
+
+func (m *Scalars) NamesSize() (size int) {
+	if m != nil {
+		return m.xxx_LenNames
+	}
+	return 0
+}
+
+func (m *Scalars) ClearNames() {
+	if m != nil {
+		m.xxx_LenNames = 0
+	}
+}
+
+func (m *Scalars) GetNames(index int) (field string, err error) {
+	if m == nil {
+		return "", errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenNames {
+		return "", errors.New("Index is out of bounds")
+	}
+	return m.names[index], nil
+}
+
+func (m *Scalars) Clear() {
+	if m != nil {
+		m.ClearIntValue()
+		m.ClearLongValue()
+		m.ClearUintValue()
+		m.ClearSintValue()
+		m.ClearFixedValue()
+		m.ClearDoubleValue()
+		m.ClearFloatValue()
+		m.ClearBoolValue()
+		m.ClearStringValue()
+		m.ClearBytesValue()
+		m.ClearColor()
+		m.inner.Clear()
+		m.xxx_IsInnerSet = false
+
+		m.ClearPackedInts()
+		m.ClearColors()
+		m.ClearUnpackedLongs()
+		m.ClearNames()
+	}
+}
+
+func (m *Inner) Size() (n int) {
+	var l int
+	_ = l
+	if m.value != 0 {
+		n += 1 + sovProto3(uint64(uint32(m.value)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Scalars) Size() (n int) {
+	var l int
+	_ = l
+	if m.intValue != 0 {
+		n += 1 + sovProto3(uint64(uint32(m.intValue)))
+	}
+	if m.longValue != 0 {
+		n += 1 + sovProto3(uint64(m.longValue))
+	}
+	if m.uintValue != 0 {
+		n += 1 + sovProto3(uint64(m.uintValue))
+	}
+	if m.sintValue != 0 {
+		n += 1 + sozProto3(uint64(m.sintValue))
+	}
+	if m.fixedValue != 0 {
+		n += 5
+	}
+	if math.Float64bits(m.doubleValue) != 0 {
+		n += 9
+	}
+	if math.Float32bits(m.floatValue) != 0 {
+		n += 5
+	}
+	if m.boolValue {
+		n += 2
+	}
+	if len(m.stringValue) > 0 {
+		l = len(m.stringValue)
+		n += 1 + l + sovProto3(uint64(l))
+	}
+	if len(m.bytesValue) > 0 {
+		l = len(m.bytesValue)
+		n += 1 + l + sovProto3(uint64(l))
+	}
+	if m.color != 0 {
+		n += 1 + sovProto3(uint64(m.color))
+	}
+	if m.xxx_IsInnerSet {
+		l = m.inner.Size()
+		n += 1 + l + sovProto3(uint64(l))
+	}
+	if m.xxx_LenPackedInts > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenPackedInts; i++ {
+			e := m.packedInts[i]
+			l += sovProto3(uint64(uint32(e)))
+		}
+		n += 1 + sovProto3(uint64(l)) + l
+	}
+	if m.xxx_LenColors > 0 {
+		l = 0
+		for i := 0; i < m.xxx_LenColors; i++ {
+			e := m.colors[i]
+			l += sovProto3(uint64(e))
+		}
+		n += 1 + sovProto3(uint64(l)) + l
+	}
+	if m.xxx_LenUnpackedLongs > 0 {
+		for i := 0; i < m.xxx_LenUnpackedLongs; i++ {
+			e := m.unpackedLongs[i]
+			n += 1 + sovProto3(uint64(e))
+		}
+	}
+	if m.xxx_LenNames > 0 {
+		for i := 0; i < m.xxx_LenNames; i++ {
+			s := m.names[i]
+			l = len(s)
+			n += 2 + l + sovProto3(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovProto3(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozProto3(x uint64) (n int) {
+	return sovProto3(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Inner) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Inner) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Inner) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.value != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintProto3(data, i, uint64(uint32(m.value)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Scalars) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Scalars) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Scalars) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.intValue != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintProto3(data, i, uint64(uint32(m.intValue)))
+	}
+	if m.longValue != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintProto3(data, i, uint64(m.longValue))
+	}
+	if m.uintValue != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintProto3(data, i, uint64(m.uintValue))
+	}
+	if m.sintValue != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintProto3(data, i, uint64((uint64(m.sintValue)<<1)^uint64((m.sintValue>>63))))
+	}
+	if m.fixedValue != 0 {
+		data[i] = 0x2d
+		i++
+		i = encodeFixed32Proto3(data, i, uint32(m.fixedValue))
+	}
+	if math.Float64bits(m.doubleValue) != 0 {
+		data[i] = 0x31
+		i++
+		i = encodeFixed64Proto3(data, i, uint64(math.Float64bits(float64(m.doubleValue))))
+	}
+	if math.Float32bits(m.floatValue) != 0 {
+		data[i] = 0x3d
+		i++
+		i = encodeFixed32Proto3(data, i, uint32(math.Float32bits(float32(m.floatValue))))
+	}
+	if m.boolValue {
+		data[i] = 0x40
+		i++
+		if m.boolValue {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.stringValue) > 0 {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintProto3(data, i, uint64(len(m.stringValue)))
+		i += copy(data[i:], m.stringValue)
+	}
+	if len(m.bytesValue) > 0 {
+		data[i] = 0x52
+		i++
+		i = encodeVarintProto3(data, i, uint64(len(m.bytesValue)))
+		i += copy(data[i:], m.bytesValue)
+	}
+	if m.color != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintProto3(data, i, uint64(m.color))
+	}
+	if m.xxx_IsInnerSet {
+		data[i] = 0x62
+		i++
+		i = encodeVarintProto3(data, i, uint64(m.inner.SizeCached()))
+		n1, err := m.inner.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_LenPackedInts > 0 {
+		data3 := make([]byte, m.xxx_LenPackedInts*10)
+		var j2 int
+		for idx := 0; idx < m.xxx_LenPackedInts; idx++ {
+			num := uint32(m.packedInts[idx])
+			for num >= 1<<7 {
+				data3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			data3[j2] = uint8(num)
+			j2++
+		}
+		data[i] = 0x6a
+		i++
+		i = encodeVarintProto3(data, i, uint64(j2))
+		i += copy(data[i:], data3[:j2])
+	}
+	if m.xxx_LenColors > 0 {
+		data5 := make([]byte, m.xxx_LenColors*10)
+		var j4 int
+		for idx := 0; idx < m.xxx_LenColors; idx++ {
+			num := m.colors[idx]
+			for num >= 1<<7 {
+				data5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			data5[j4] = uint8(num)
+			j4++
+		}
+		data[i] = 0x72
+		i++
+		i = encodeVarintProto3(data, i, uint64(j4))
+		i += copy(data[i:], data5[:j4])
+	}
+	if m.xxx_LenUnpackedLongs > 0 {
+		for idx := 0; idx < m.xxx_LenUnpackedLongs; idx++ {
+			num := m.unpackedLongs[idx]
+			data[i] = 0x78
+			i++
+			i = encodeVarintProto3(data, i, uint64(num))
+		}
+	}
+	if m.xxx_LenNames > 0 {
+		for idx := 0; idx < m.xxx_LenNames; idx++ {
+			s := m.names[idx]
+			data[i] = 0x82
+			i++
+			data[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Proto3(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Proto3(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintProto3(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Inner) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Scalars) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field intValue", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.intValue |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field longValue", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.longValue |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field uintValue", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.uintValue |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field sintValue", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.sintValue = int64(int64(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fixedValue", wireType)
+			}
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			m.fixedValue = uint32(data[i-4])
+			m.fixedValue |= uint32(data[i-3]) << 8
+			m.fixedValue |= uint32(data[i-2]) << 16
+			m.fixedValue |= uint32(data[i-1]) << 24
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field doubleValue", wireType)
+			}
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			m.doubleValue = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field floatValue", wireType)
+			}
+			var v uint32
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint32(data[i-4])
+			v |= uint32(data[i-3]) << 8
+			v |= uint32(data[i-2]) << 16
+			v |= uint32(data[i-1]) << 24
+			m.floatValue = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field boolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.boolValue = bool(bool(v != 0))
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field stringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.stringValue = string(data[index:postIndex])
+			index = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field bytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.bytesValue = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field color", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.color |= (Color(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field inner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateInner()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 13:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.packedInts) <= m.xxx_LenPackedInts {
+						newCapacity := 0
+						if len(m.packedInts) == 0 {
+							newCapacity = 8
+						} else if len(m.packedInts) < 1000000 {
+							newCapacity = m.xxx_LenPackedInts * 2
+						} else {
+							newCapacity = m.xxx_LenPackedInts + 1000000
+						}
+						t := make([]int32, newCapacity, newCapacity)
+						copy(t, m.packedInts)
+						m.packedInts = t
+					}
+					m.packedInts[m.xxx_LenPackedInts] = int32(v)
+					m.xxx_LenPackedInts += 1
+				}
+			} else if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.packedInts) <= m.xxx_LenPackedInts {
+					newCapacity := 0
+					if len(m.packedInts) == 0 {
+						newCapacity = 8
+					} else if len(m.packedInts) < 1000000 {
+						newCapacity = m.xxx_LenPackedInts * 2
+					} else {
+						newCapacity = m.xxx_LenPackedInts + 1000000
+					}
+					t := make([]int32, newCapacity, newCapacity)
+					copy(t, m.packedInts)
+					m.packedInts = t
+				}
+				m.packedInts[m.xxx_LenPackedInts] = int32(v)
+				m.xxx_LenPackedInts += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field packedInts", wireType)
+			}
+		case 14:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v Color
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (Color(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.colors) <= m.xxx_LenColors {
+						newCapacity := 0
+						if len(m.colors) == 0 {
+							newCapacity = 8
+						} else if len(m.colors) < 1000000 {
+							newCapacity = m.xxx_LenColors * 2
+						} else {
+							newCapacity = m.xxx_LenColors + 1000000
+						}
+						t := make([]Color, newCapacity, newCapacity)
+						copy(t, m.colors)
+						m.colors = t
+					}
+					m.colors[m.xxx_LenColors] = v
+					m.xxx_LenColors += 1
+				}
+			} else if wireType == 0 {
+				var v Color
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (Color(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.colors) <= m.xxx_LenColors {
+					newCapacity := 0
+					if len(m.colors) == 0 {
+						newCapacity = 8
+					} else if len(m.colors) < 1000000 {
+						newCapacity = m.xxx_LenColors * 2
+					} else {
+						newCapacity = m.xxx_LenColors + 1000000
+					}
+					t := make([]Color, newCapacity, newCapacity)
+					copy(t, m.colors)
+					m.colors = t
+				}
+				m.colors[m.xxx_LenColors] = v
+				m.xxx_LenColors += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field colors", wireType)
+			}
+		case 15:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if len(m.unpackedLongs) <= m.xxx_LenUnpackedLongs {
+						newCapacity := 0
+						if len(m.unpackedLongs) == 0 {
+							newCapacity = 8
+						} else if len(m.unpackedLongs) < 1000000 {
+							newCapacity = m.xxx_LenUnpackedLongs * 2
+						} else {
+							newCapacity = m.xxx_LenUnpackedLongs + 1000000
+						}
+						t := make([]int64, newCapacity, newCapacity)
+						copy(t, m.unpackedLongs)
+						m.unpackedLongs = t
+					}
+					m.unpackedLongs[m.xxx_LenUnpackedLongs] = int64(v)
+					m.xxx_LenUnpackedLongs += 1
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if len(m.unpackedLongs) <= m.xxx_LenUnpackedLongs {
+					newCapacity := 0
+					if len(m.unpackedLongs) == 0 {
+						newCapacity = 8
+					} else if len(m.unpackedLongs) < 1000000 {
+						newCapacity = m.xxx_LenUnpackedLongs * 2
+					} else {
+						newCapacity = m.xxx_LenUnpackedLongs + 1000000
+					}
+					t := make([]int64, newCapacity, newCapacity)
+					copy(t, m.unpackedLongs)
+					m.unpackedLongs = t
+				}
+				m.unpackedLongs[m.xxx_LenUnpackedLongs] = int64(v)
+				m.xxx_LenUnpackedLongs += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field unpackedLongs", wireType)
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.names) <= m.xxx_LenNames {
+				newCapacity := 0
+				if len(m.names) == 0 {
+					newCapacity = 8
+				} else if len(m.names) < 1000000 {
+					newCapacity = m.xxx_LenNames * 2
+				} else {
+					newCapacity = m.xxx_LenNames + 1000000
+				}
+				t := make([]string, newCapacity, newCapacity)
+				copy(t, m.names)
+				m.names = t
+			}
+			m.names[m.xxx_LenNames] = string(data[index:postIndex])
+			m.xxx_LenNames += 1
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Inner) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Inner) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Scalars) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Scalars) CheckInitialized() error {
+	return nil
+}
+
+func (m *Inner) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.value != 0 || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Int32(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Inner) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Inner) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Inner) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Scalars) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.intValue != 0 || w.EmitDefaults() {
+		w.Field("intValue", "int_value")
+		w.Int32(m.GetIntValue())
+	}
+	if m.longValue != 0 || w.EmitDefaults() {
+		w.Field("longValue", "long_value")
+		w.Int64(m.GetLongValue())
+	}
+	if m.uintValue != 0 || w.EmitDefaults() {
+		w.Field("uintValue", "uint_value")
+		w.Uint32(m.GetUintValue())
+	}
+	if m.sintValue != 0 || w.EmitDefaults() {
+		w.Field("sintValue", "sint_value")
+		w.Int64(m.GetSintValue())
+	}
+	if m.fixedValue != 0 || w.EmitDefaults() {
+		w.Field("fixedValue", "fixed_value")
+		w.Uint32(m.GetFixedValue())
+	}
+	if math.Float64bits(m.doubleValue) != 0 || w.EmitDefaults() {
+		w.Field("doubleValue", "double_value")
+		w.Float64(m.GetDoubleValue())
+	}
+	if math.Float32bits(m.floatValue) != 0 || w.EmitDefaults() {
+		w.Field("floatValue", "float_value")
+		w.Float32(m.GetFloatValue())
+	}
+	if m.boolValue || w.EmitDefaults() {
+		w.Field("boolValue", "bool_value")
+		w.Bool(m.GetBoolValue())
+	}
+	if len(m.stringValue) > 0 || w.EmitDefaults() {
+		w.Field("stringValue", "string_value")
+		w.String(m.GetStringValue())
+	}
+	if len(m.bytesValue) > 0 || w.EmitDefaults() {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.GetBytesValue())
+	}
+	if m.color != 0 || w.EmitDefaults() {
+		w.Field("color", "color")
+		w.Enum(int32(m.GetColor()), Color_name)
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner", "inner")
+		w.Message(m.GetInner())
+	} else if w.EmitDefaults() {
+		w.Field("inner", "inner")
+		w.Null()
+	}
+	if m.xxx_LenPackedInts > 0 || w.EmitDefaults() {
+		w.Field("packedInts", "packed_ints")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenPackedInts; i++ {
+			w.Int32(m.packedInts[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenColors > 0 || w.EmitDefaults() {
+		w.Field("colors", "colors")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenColors; i++ {
+			w.Enum(int32(m.colors[i]), Color_name)
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenUnpackedLongs > 0 || w.EmitDefaults() {
+		w.Field("unpackedLongs", "unpacked_longs")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenUnpackedLongs; i++ {
+			w.Int64(m.unpackedLongs[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenNames > 0 || w.EmitDefaults() {
+		w.Field("names", "names")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenNames; i++ {
+			w.String(m.names[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Scalars) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("intValue", "int_value"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetIntValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("longValue", "long_value"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetLongValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("uintValue", "uint_value"); ok {
+		v, err := jsonpb.Uint32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetUintValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("sintValue", "sint_value"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetSintValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("fixedValue", "fixed_value"); ok {
+		v, err := jsonpb.Uint32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFixedValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("doubleValue", "double_value"); ok {
+		v, err := jsonpb.Float64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetDoubleValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("floatValue", "float_value"); ok {
+		v, err := jsonpb.Float32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetFloatValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("boolValue", "bool_value"); ok {
+		v, err := jsonpb.Bool(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetBoolValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("stringValue", "string_value"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetStringValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("bytesValue", "bytes_value"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetBytesValue(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("color", "color"); ok {
+		v, err := jsonpb.Enum(raw, Color_value)
+		if err != nil {
+			return err
+		}
+		if err := m.SetColor(Color(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("inner", "inner"); ok {
+		v, err := m.MutateInner()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("packedInts", "packed_ints"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddPackedInts(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("colors", "colors"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Enum(elem, Color_value)
+			if err != nil {
+				return err
+			}
+			if err := m.AddColors(Color(v)); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("unpackedLongs", "unpacked_longs"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Int64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddUnpackedLongs(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("names", "names"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddNames(v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Scalars) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Scalars) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Inner) MarshalTextFields(w *proto.TextWriter) {
+	if m.value != 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Inner) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Scalars) MarshalTextFields(w *proto.TextWriter) {
+	if m.intValue != 0 {
+		w.Field("int_value")
+		w.Value(m.intValue)
+	}
+	if m.longValue != 0 {
+		w.Field("long_value")
+		w.Value(m.longValue)
+	}
+	if m.uintValue != 0 {
+		w.Field("uint_value")
+		w.Value(m.uintValue)
+	}
+	if m.sintValue != 0 {
+		w.Field("sint_value")
+		w.Value(m.sintValue)
+	}
+	if m.fixedValue != 0 {
+		w.Field("fixed_value")
+		w.Value(m.fixedValue)
+	}
+	if math.Float64bits(m.doubleValue) != 0 {
+		w.Field("double_value")
+		w.Value(m.doubleValue)
+	}
+	if math.Float32bits(m.floatValue) != 0 {
+		w.Field("float_value")
+		w.Value(m.floatValue)
+	}
+	if m.boolValue {
+		w.Field("bool_value")
+		w.Value(m.boolValue)
+	}
+	if len(m.stringValue) > 0 {
+		w.Field("string_value")
+		w.Value(m.stringValue)
+	}
+	if len(m.bytesValue) > 0 {
+		w.Field("bytes_value")
+		w.Value(m.bytesValue)
+	}
+	if m.color != 0 {
+		w.Field("color")
+		w.Enum(int32(m.color), Color_name)
+	}
+	if m.xxx_IsInnerSet {
+		w.Field("inner")
+		w.Message(m.inner)
+	}
+	for i := 0; i < m.xxx_LenPackedInts; i++ {
+		w.Field("packed_ints")
+		w.Value(m.packedInts[i])
+	}
+	for i := 0; i < m.xxx_LenColors; i++ {
+		w.Field("colors")
+		w.Enum(int32(m.colors[i]), Color_name)
+	}
+	for i := 0; i < m.xxx_LenUnpackedLongs; i++ {
+		w.Field("unpacked_longs")
+		w.Value(m.unpackedLongs[i])
+	}
+	for i := 0; i < m.xxx_LenNames; i++ {
+		w.Field("names")
+		w.Value(m.names[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Scalars) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "int_value":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetIntValue(v)
+	case "long_value":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetLongValue(v)
+	case "uint_value":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetUintValue(v)
+	case "sint_value":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetSintValue(v)
+	case "fixed_value":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFixedValue(v)
+	case "double_value":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetDoubleValue(v)
+	case "float_value":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetFloatValue(v)
+	case "bool_value":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetBoolValue(v)
+	case "string_value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetStringValue(v)
+	case "bytes_value":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetBytesValue(v)
+	case "color":
+		v, err := p.ReadEnum(Color_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetColor(Color(v))
+	case "inner":
+		v, err := m.MutateInner()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "packed_ints":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddPackedInts(v)
+	case "colors":
+		v, err := p.ReadEnum(Color_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddColors(Color(v))
+	case "unpacked_longs":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddUnpackedLongs(v)
+	case "names":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddNames(v)
+	}
+	return false, nil
+}
+
+func (m *Inner) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Inner{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Inner) MergeFrom(src proto.Message) {
+	s, ok := src.(*Inner)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.value != 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Inner) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Inner)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Scalars) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Scalars{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Scalars) MergeFrom(src proto.Message) {
+	s, ok := src.(*Scalars)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.intValue != 0 {
+		m.SetIntValue(s.intValue)
+	}
+	if s.longValue != 0 {
+		m.SetLongValue(s.longValue)
+	}
+	if s.uintValue != 0 {
+		m.SetUintValue(s.uintValue)
+	}
+	if s.sintValue != 0 {
+		m.SetSintValue(s.sintValue)
+	}
+	if s.fixedValue != 0 {
+		m.SetFixedValue(s.fixedValue)
+	}
+	if math.Float64bits(s.doubleValue) != 0 {
+		m.SetDoubleValue(s.doubleValue)
+	}
+	if math.Float32bits(s.floatValue) != 0 {
+		m.SetFloatValue(s.floatValue)
+	}
+	if s.boolValue {
+		m.SetBoolValue(s.boolValue)
+	}
+	if len(s.stringValue) > 0 {
+		m.SetStringValue(s.stringValue)
+	}
+	if len(s.bytesValue) > 0 {
+		m.SetBytesValue(append([]byte{}, s.bytesValue...))
+	}
+	if s.color != 0 {
+		m.SetColor(s.color)
+	}
+	if s.xxx_IsInnerSet {
+		v, _ := m.MutateInner()
+		v.MergeFrom(s.inner)
+	}
+	for i := 0; i < s.xxx_LenPackedInts; i++ {
+		m.AddPackedInts(s.packedInts[i])
+	}
+	for i := 0; i < s.xxx_LenColors; i++ {
+		m.AddColors(s.colors[i])
+	}
+	for i := 0; i < s.xxx_LenUnpackedLongs; i++ {
+		m.AddUnpackedLongs(s.unpackedLongs[i])
+	}
+	for i := 0; i < s.xxx_LenNames; i++ {
+		m.AddNames(s.names[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Scalars) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Scalars)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.intValue != o.intValue {
+		return false
+	}
+	if m.longValue != o.longValue {
+		return false
+	}
+	if m.uintValue != o.uintValue {
+		return false
+	}
+	if m.sintValue != o.sintValue {
+		return false
+	}
+	if m.fixedValue != o.fixedValue {
+		return false
+	}
+	if math.Float64bits(m.doubleValue) != math.Float64bits(o.doubleValue) {
+		return false
+	}
+	if math.Float32bits(m.floatValue) != math.Float32bits(o.floatValue) {
+		return false
+	}
+	if m.boolValue != o.boolValue {
+		return false
+	}
+	if m.stringValue != o.stringValue {
+		return false
+	}
+	if !bytes.Equal(m.bytesValue, o.bytesValue) {
+		return false
+	}
+	if m.color != o.color {
+		return false
+	}
+	if m.xxx_IsInnerSet != o.xxx_IsInnerSet {
+		return false
+	}
+	if m.xxx_IsInnerSet && !m.inner.Equal(o.inner) {
+		return false
+	}
+	if m.xxx_LenPackedInts != o.xxx_LenPackedInts {
+		return false
+	}
+	for i := 0; i < m.xxx_LenPackedInts; i++ {
+		if m.packedInts[i] != o.packedInts[i] {
+			return false
+		}
+	}
+	if m.xxx_LenColors != o.xxx_LenColors {
+		return false
+	}
+	for i := 0; i < m.xxx_LenColors; i++ {
+		if m.colors[i] != o.colors[i] {
+			return false
+		}
+	}
+	if m.xxx_LenUnpackedLongs != o.xxx_LenUnpackedLongs {
+		return false
+	}
+	for i := 0; i < m.xxx_LenUnpackedLongs; i++ {
+		if m.unpackedLongs[i] != o.unpackedLongs[i] {
+			return false
+		}
+	}
+	if m.xxx_LenNames != o.xxx_LenNames {
+		return false
+	}
+	for i := 0; i < m.xxx_LenNames; i++ {
+		if m.names[i] != o.names[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionInner = &proto.MessageInfo{
+	Name: "proto3.Inner",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Inner)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Inner).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Inner)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Inner)
+				return x.value != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Inner).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Inner) ProtoReflect() *proto.MessageInfo {
+	return reflectionInner
+}
+
+var reflectionScalars = &proto.MessageInfo{
+	Name: "proto3.Scalars",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "int_value",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetIntValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetIntValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "int_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetIntValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return x.intValue != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearIntValue()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "long_value",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetLongValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetLongValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "long_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetLongValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return x.longValue != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearLongValue()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "uint_value",
+			Kind:    proto.Uint32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetUintValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetUintValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "uint_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetUintValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return x.uintValue != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearUintValue()
+			},
+		},
+		{
+			Number:  4,
+			Name:    "sint_value",
+			Kind:    proto.Sint64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetSintValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetSintValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "sint_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetSintValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return x.sintValue != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearSintValue()
+			},
+		},
+		{
+			Number:  5,
+			Name:    "fixed_value",
+			Kind:    proto.Fixed32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetFixedValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetFixedValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "fixed_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetFixedValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return x.fixedValue != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearFixedValue()
+			},
+		},
+		{
+			Number:  6,
+			Name:    "double_value",
+			Kind:    proto.DoubleKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetDoubleValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetDoubleValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "double_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetDoubleValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return math.Float64bits(x.doubleValue) != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearDoubleValue()
+			},
+		},
+		{
+			Number:  7,
+			Name:    "float_value",
+			Kind:    proto.FloatKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetFloatValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetFloatValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "float_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetFloatValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return math.Float32bits(x.floatValue) != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearFloatValue()
+			},
+		},
+		{
+			Number:  8,
+			Name:    "bool_value",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetBoolValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetBoolValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "bool_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetBoolValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return x.boolValue
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearBoolValue()
+			},
+		},
+		{
+			Number:  9,
+			Name:    "string_value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetStringValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetStringValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "string_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetStringValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return len(x.stringValue) > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearStringValue()
+			},
+		},
+		{
+			Number:  10,
+			Name:    "bytes_value",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetBytesValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetBytesValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "bytes_value", v)
+				}
+				x := m.(*Scalars)
+				return x.SetBytesValue(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return len(x.bytesValue) > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearBytesValue()
+			},
+		},
+		{
+			Number:  11,
+			Name:    "color",
+			Kind:    proto.EnumKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Scalars)(nil).GetColor(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetColor()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Color)
+				if !ok {
+					return proto.NewFieldTypeError(m, "color", v)
+				}
+				x := m.(*Scalars)
+				return x.SetColor(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Scalars)
+				return x.color != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearColor()
+			},
+		},
+		{
+			Number: 12,
+			Name:   "inner",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Inner)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Scalars).GetInner()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Inner)
+				if !ok {
+					return proto.NewFieldTypeError(m, "inner", v)
+				}
+				x := m.(*Scalars)
+				x.ClearInner()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateInner()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Scalars).HasInner()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearInner()
+			},
+		},
+		{
+			Number: 13,
+			Name:   "packed_ints",
+			Kind:   proto.Int32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Scalars)
+				v := make([]int32, x.xxx_LenPackedInts)
+				copy(v, x.packedInts)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "packed_ints", v)
+				}
+				x := m.(*Scalars)
+				x.ClearPackedInts()
+				for _, e := range value {
+					if err := x.AddPackedInts(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Scalars).PackedIntsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearPackedInts()
+			},
+		},
+		{
+			Number: 14,
+			Name:   "colors",
+			Kind:   proto.EnumKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Scalars)
+				v := make([]Color, x.xxx_LenColors)
+				copy(v, x.colors)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]Color)
+				if !ok {
+					return proto.NewFieldTypeError(m, "colors", v)
+				}
+				x := m.(*Scalars)
+				x.ClearColors()
+				for _, e := range value {
+					if err := x.AddColors(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Scalars).ColorsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearColors()
+			},
+		},
+		{
+			Number: 15,
+			Name:   "unpacked_longs",
+			Kind:   proto.Int64Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Scalars)
+				v := make([]int64, x.xxx_LenUnpackedLongs)
+				copy(v, x.unpackedLongs)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "unpacked_longs", v)
+				}
+				x := m.(*Scalars)
+				x.ClearUnpackedLongs()
+				for _, e := range value {
+					if err := x.AddUnpackedLongs(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Scalars).UnpackedLongsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearUnpackedLongs()
+			},
+		},
+		{
+			Number: 16,
+			Name:   "names",
+			Kind:   proto.StringKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Scalars)
+				v := make([]string, x.xxx_LenNames)
+				copy(v, x.names)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "names", v)
+				}
+				x := m.(*Scalars)
+				x.ClearNames()
+				for _, e := range value {
+					if err := x.AddNames(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Scalars).NamesSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Scalars).ClearNames()
+			},
+		},
+	},
+}
+
+func (m *Scalars) ProtoReflect() *proto.MessageInfo {
+	return reflectionScalars
+}
+
+func init() {
+	proto.RegisterEnum("proto3.Color", Color_name, Color_value)
+	proto.RegisterType((*Inner)(nil), "proto3.Inner")
+	proto.RegisterType((*Scalars)(nil), "proto3.Scalars")
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto3
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func TestZeroValuesOmitted(t *testing.T) {
+	m := &Scalars{}
+	m.SetIntValue(0)
+	m.SetBoolValue(false)
+	m.SetStringValue("")
+	m.SetBytesValue([]byte{})
+	m.SetColor(Color_RED)
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 0 {
+		t.Fatalf("expected zero values to be omitted, got %x", data)
+	}
+}
+
+func TestNoHasMethods(t *testing.T) {
+	typ := reflect.TypeOf(&Scalars{})
+	for _, name := range []string{"HasIntValue", "HasStringValue", "HasColor"} {
+		if _, ok := typ.MethodByName(name); ok {
+			t.Fatalf("%s should not be generated for proto3 scalars", name)
+		}
+	}
+	if _, ok := typ.MethodByName("HasInner"); !ok {
+		t.Fatalf("message fields should keep their Has method")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	m := &Scalars{}
+	m.SetIntValue(-1)
+	m.SetLongValue(2)
+	m.SetUintValue(3)
+	m.SetSintValue(-4)
+	m.SetFixedValue(5)
+	m.SetDoubleValue(6.5)
+	m.SetFloatValue(7.5)
+	m.SetBoolValue(true)
+	m.SetStringValue("eight")
+	m.SetBytesValue([]byte("nine"))
+	m.SetColor(Color_BLUE)
+	inner, _ := m.MutateInner()
+	inner.SetValue(10)
+	m.AddNames("a")
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &Scalars{}
+	if err := m2.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if m2.GetIntValue() != -1 || m2.GetLongValue() != 2 || m2.GetUintValue() != 3 ||
+		m2.GetSintValue() != -4 || m2.GetFixedValue() != 5 || m2.GetDoubleValue() != 6.5 ||
+		m2.GetFloatValue() != 7.5 || !m2.GetBoolValue() || m2.GetStringValue() != "eight" ||
+		string(m2.GetBytesValue()) != "nine" || m2.GetColor() != Color_BLUE ||
+		m2.GetInner().GetValue() != 10 || m2.NamesSize() != 1 {
+		t.Fatalf("round trip lost values: %v", m2)
+	}
+	m2.ClearIntValue()
+	m2.ClearStringValue()
+	if m2.GetIntValue() != 0 || m2.GetStringValue() != "" {
+		t.Fatalf("clear should reset the value to zero")
+	}
+}
+
+func TestPackedByDefault(t *testing.T) {
+	m := &Scalars{}
+	m.AddPackedInts(1)
+	m.AddPackedInts(2)
+	m.AddColors(Color_GREEN)
+	m.AddUnpackedLongs(3)
+	m.AddUnpackedLongs(4)
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{0x6a, 0x02, 0x01, 0x02, 0x72, 0x01, 0x01, 0x78, 0x03, 0x78, 0x04}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("expected %x, got %x", expected, data)
+	}
+}
+
+func TestOpenEnum(t *testing.T) {
+	data := []byte{0x58, 0x07}
+	m := &Scalars{}
+	if err := m.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetColor() != Color(7) {
+		t.Fatalf("expected unknown enum value 7, got %d", m.GetColor())
+	}
+	out, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatalf("expected %x, got %x", data, out)
+	}
+}
+
+type tagged struct {
+	Number int32  `protobuf:"varint,1,opt,name=number,proto3"`
+	Flag   bool   `protobuf:"varint,2,opt,name=flag,proto3"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3"`
+	Data   []byte `protobuf:"bytes,4,opt,name=data,proto3"`
+}
+
+func (m *tagged) Reset()         { *m = tagged{} }
+func (m *tagged) String() string { return proto.CompactTextString(m) }
+func (*tagged) ProtoMessage()    {}
+
+func TestRuntimeProto3(t *testing.T) {
+	m := &tagged{Data: []byte{}}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 0 {
+		t.Fatalf("expected zero values to be omitted, got %x", data)
+	}
+	if !proto.Equal(m, &tagged{}) {
+		t.Fatalf("empty and nil proto3 bytes should be equal")
+	}
+	m.Number = 5
+	data, err = proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, []byte{0x08, 0x05}) {
+		t.Fatalf("expected 0805, got %x", data)
+	}
+	m2 := &tagged{}
+	if err := proto.Unmarshal(data, m2); err != nil {
+		t.Fatal(err)
+	}
+	if m2.Number != 5 {
+		t.Fatalf("expected 5, got %d", m2.Number)
+	}
+}
+
+type taggedFloats struct {
+	DoubleValue float64 `protobuf:"fixed64,6,opt,name=double_value,proto3"`
+	FloatValue  float32 `protobuf:"fixed32,7,opt,name=float_value,proto3"`
+}
+
+func (m *taggedFloats) Reset()         { *m = taggedFloats{} }
+func (m *taggedFloats) String() string { return proto.CompactTextString(m) }
+func (*taggedFloats) ProtoMessage()    {}
+
+func TestNegativeZero(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	m := &Scalars{}
+	m.SetDoubleValue(negZero)
+	m.SetFloatValue(float32(negZero))
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := proto.Marshal(&taggedFloats{DoubleValue: negZero, FloatValue: float32(negZero)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(want) == 0 || !bytes.Equal(data, want) {
+		t.Fatalf("expected %x, got %x", want, data)
+	}
+	m2 := &Scalars{}
+	if err := proto.Unmarshal(data, m2); err != nil {
+		t.Fatal(err)
+	}
+	if !math.Signbit(m2.GetDoubleValue()) || !math.Signbit(float64(m2.GetFloatValue())) {
+		t.Fatalf("round trip lost the sign of zero: %v", m2)
+	}
+}
+
+func TestEqualNegativeZero(t *testing.T) {
+	m1, m2 := &Scalars{}, &Scalars{}
+	m1.SetDoubleValue(math.Copysign(0, -1))
+	if m1.Equal(m2) {
+		t.Fatalf("-0.0 should not equal an unset double")
+	}
+	m2.SetDoubleValue(math.Copysign(0, -1))
+	if !m1.Equal(m2) {
+		t.Fatalf("expected equal messages")
+	}
+	m1.SetFloatValue(float32(math.Copysign(0, -1)))
+	if m1.Equal(m2) {
+		t.Fatalf("-0.0 should not equal an unset float")
+	}
+}
+
+func TestJSONOpenEnum(t *testing.T) {
+	m := &Scalars{}
+	if err := new(jsonpb.Unmarshaler).UnmarshalString(`{"color":7}`, m); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetColor() != Color(7) {
+		t.Fatalf("expected 7, got %v", m.GetColor())
+	}
+	s, err := new(jsonpb.Marshaler).MarshalToString(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != `{"color":7}` {
+		t.Fatalf("unexpected output %s", s)
+	}
+}
@@ -0,0 +1,2734 @@
+// Code generated by protoc-gen-dgo.
+// source: reflection.proto
+// DO NOT EDIT!
+
+/*
+Package reflection is a generated protocol buffer package.
+
+It is generated from these files:
+
+	reflection.proto
+
+It has these top-level messages:
+
+	Credentials
+	Account
+*/
+package reflection
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Level int32
+
+const (
+	Level_LOW  Level = 1
+	Level_HIGH Level = 2
+)
+
+var Level_name = map[int32]string{
+	1: "LOW",
+	2: "HIGH",
+}
+var Level_value = map[string]int32{
+	"LOW":  1,
+	"HIGH": 2,
+}
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+func (x Level) String() string {
+	return proto.EnumName(Level_name, int32(x))
+}
+
+type Credentials struct {
+	xxx_sizeCached   int
+	user             string
+	secret           string
+	XXX_unrecognized []byte
+	xxx_IsUserSet    bool
+	xxx_IsSecretSet  bool
+}
+
+func (m *Credentials) Reset()         { *m = Credentials{} }
+func (m *Credentials) String() string { return proto.CompactTextString(m) }
+func (*Credentials) ProtoMessage()    {}
+
+func (m *Credentials) GetUser() string {
+	if m != nil && m.xxx_IsUserSet {
+		return m.user
+	}
+	return ""
+}
+
+func (m *Credentials) GetSecret() string {
+	if m != nil && m.xxx_IsSecretSet {
+		return m.secret
+	}
+	return ""
+}
+
+func (m *Credentials) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Credentials) SetUser(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsUserSet = true
+	m.user = value
+	return nil
+}
+
+func (m *Credentials) HasUser() (isSet bool) {
+	if m != nil && m.xxx_IsUserSet {
+		return true
+	}
+	return false
+}
+
+func (m *Credentials) ClearUser() {
+	if m != nil {
+		m.xxx_IsUserSet = false
+		m.user = ""
+	}
+}
+
+func (m *Credentials) SetSecret(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsSecretSet = true
+	m.secret = value
+	return nil
+}
+
+func (m *Credentials) HasSecret() (isSet bool) {
+	if m != nil && m.xxx_IsSecretSet {
+		return true
+	}
+	return false
+}
+
+func (m *Credentials) ClearSecret() {
+	if m != nil {
+		m.xxx_IsSecretSet = false
+		m.secret = ""
+	}
+}
+
+func (m *Credentials) Clear() {
+	if m != nil {
+		m.ClearUser()
+		m.ClearSecret()
+	}
+}
+
+type Account struct {
+	xxx_sizeCached       int
+	id                   int64
+	name                 string
+	level                Level
+	credentials          *Credentials
+	history              []*Credentials
+	tags                 []string
+	services             map[string]*Credentials
+	email                string
+	phone                int64
+	avatar               []byte
+	XXX_unrecognized     []byte
+	xxx_IsIdSet          bool
+	xxx_IsNameSet        bool
+	xxx_IsLevelSet       bool
+	xxx_IsCredentialsSet bool
+	xxx_LenHistory       int
+	xxx_LenTags          int
+	xxx_IsEmailSet       bool
+	xxx_IsPhoneSet       bool
+	xxx_IsAvatarSet      bool
+	xxx_ContactCase      Account_ContactCase
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+
+const Default_Account_Name string = "anonymous"
+const Default_Account_Level Level = Level_HIGH
+
+func (m *Account) GetId() int64 {
+	if m != nil && m.xxx_IsIdSet {
+		return m.id
+	}
+	return 0
+}
+
+func (m *Account) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return Default_Account_Name
+}
+
+func (m *Account) GetLevel() Level {
+	if m != nil && m.xxx_IsLevelSet {
+		return m.level
+	}
+	return Default_Account_Level
+}
+
+func (m *Account) GetCredentials() *Credentials {
+	if m != nil && m.xxx_IsCredentialsSet {
+		return m.credentials
+	}
+	return nil
+}
+func (m *Account) GetEmail() string {
+	if m != nil && m.xxx_IsEmailSet {
+		return m.email
+	}
+	return ""
+}
+
+func (m *Account) GetPhone() int64 {
+	if m != nil && m.xxx_IsPhoneSet {
+		return m.phone
+	}
+	return 0
+}
+
+func (m *Account) GetAvatar() []byte {
+	if m != nil && m.xxx_IsAvatarSet {
+		return m.avatar
+	}
+	return nil
+}
+func (m *Account) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Account_ContactCase int32
+
+const (
+	Account_ContactCase_NotSet Account_ContactCase = 0
+	Account_ContactCase_Email  Account_ContactCase = 8
+	Account_ContactCase_Phone  Account_ContactCase = 9
+)
+
+func (m *Account) WhichContact() Account_ContactCase {
+	if m != nil {
+		return m.xxx_ContactCase
+	}
+	return Account_ContactCase_NotSet
+}
+
+func (m *Account) ClearContact() {
+	if m != nil {
+		switch m.xxx_ContactCase {
+		case Account_ContactCase_Email:
+			m.ClearEmail()
+		case Account_ContactCase_Phone:
+			m.ClearPhone()
+		}
+		m.xxx_ContactCase = Account_ContactCase_NotSet
+	}
+}
+
+func (m *Account) SetId(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIdSet = true
+	m.id = value
+	return nil
+}
+
+func (m *Account) HasId() (isSet bool) {
+	if m != nil && m.xxx_IsIdSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account) ClearId() {
+	if m != nil {
+		m.xxx_IsIdSet = false
+	}
+}
+
+func (m *Account) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Account) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Account) SetLevel(value Level) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsLevelSet = true
+	m.level = value
+	return nil
+}
+
+func (m *Account) HasLevel() (isSet bool) {
+	if m != nil && m.xxx_IsLevelSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account) ClearLevel() {
+	if m != nil {
+		m.xxx_IsLevelSet = false
+	}
+}
+
+func (m *Account) MutateCredentials() (field *Credentials, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsCredentialsSet {
+		m.xxx_IsCredentialsSet = true
+		if m.credentials == nil {
+			m.credentials = new(Credentials)
+		} else {
+			m.credentials.Clear()
+		}
+	}
+	return m.credentials, nil
+}
+
+func (m *Account) HasCredentials() (isSet bool) {
+	if m != nil && m.xxx_IsCredentialsSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account) ClearCredentials() {
+	if m != nil {
+		m.credentials.Clear()
+		m.xxx_IsCredentialsSet = false
+
+	}
+}
+
+func (m *Account) AddHistory() (field *Credentials, err error) {
+	if m != nil {
+		if len(m.history) <= m.xxx_LenHistory {
+			newCapacity := 0
+			if len(m.history) == 0 {
+				newCapacity = 8
+			} else if len(m.history) < 1000000 {
+				newCapacity = m.xxx_LenHistory * 2
+			} else {
+				newCapacity = m.xxx_LenHistory + 1000000
+			}
+			t := make([]*Credentials, newCapacity, newCapacity)
+			copy(t, m.history)
+			m.history = t
+		}
+		field = m.history[m.xxx_LenHistory]
+		if field == nil {
+			field = new(Credentials)
+			m.history[m.xxx_LenHistory] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenHistory += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Account) MutateHistory(index int) (field *Credentials, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenHistory {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.history[index] == nil {
+		m.history[index] = new(Credentials)
+	}
+	return m.history[index], nil
+}
+
+func (m *Account) HistorySize() (size int) {
+	if m != nil {
+		return m.xxx_LenHistory
+	}
+	return 0
+}
+
+func (m *Account) ClearHistory() {
+	if m != nil {
+		for i := 0; i < m.HistorySize(); i++ {
+			m.history[i].Clear()
+		}
+		m.xxx_LenHistory = 0
+
+	}
+}
+
+func (m *Account) GetHistory(index int) (field *Credentials, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenHistory {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.history[index], nil
+}
+
+func (m *Account) AddTags(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.tags) <= m.xxx_LenTags {
+		newCapacity := 0
+		if len(m.tags) == 0 {
+			newCapacity = 8
+		} else if len(m.tags) < 1000000 {
+			newCapacity = m.xxx_LenTags * 2
+		} else {
+			newCapacity = m.xxx_LenTags + 1000000
+		}
+		t := make([]string, newCapacity, newCapacity)
+		copy(t, m.tags)
+		m.tags = t
+	}
+	m.tags[m.xxx_LenTags] = value
+	m.xxx_LenTags += 1
+	return nil
+}
+
+func (m *Account) SetTags(value string, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return errors.New("Index is out of bounds")
+	}
+	m.tags[index] = value
+	return nil
+}
+
+func (m *Account) TagsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenTags
+	}
+	return 0
+}
+
+func (m *Account) ClearTags() {
+	if m != nil {
+		m.xxx_LenTags = 0
+	}
+}
+
+func (m *Account) GetTags(index int) (field string, err error) {
+	if m == nil {
+		return "", errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return "", errors.New("Index is out of bounds")
+	}
+	return m.tags[index], nil
+}
+
+func (m *Account) GetServices(key string) (value *Credentials, ok bool) {
+	if m != nil {
+		value, ok = m.services[key]
+	}
+	return value, ok
+}
+
+func (m *Account) PutServices(key string, value *Credentials) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.services == nil {
+		m.services = make(map[string]*Credentials)
+	}
+	m.services[key] = value
+	return nil
+}
+
+func (m *Account) DeleteServices(key string) {
+	if m != nil {
+		delete(m.services, key)
+	}
+}
+
+func (m *Account) ServicesLen() (size int) {
+	if m != nil {
+		return len(m.services)
+	}
+	return 0
+}
+
+func (m *Account) RangeServices(f func(key string, value *Credentials) bool) {
+	if m != nil {
+		for k, v := range m.services {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Account) ClearServices() {
+	if m != nil {
+		m.services = nil
+	}
+}
+
+func (m *Account) SetEmail(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ContactCase != Account_ContactCase_Email {
+		m.ClearContact()
+		m.xxx_ContactCase = Account_ContactCase_Email
+	}
+	m.xxx_IsEmailSet = true
+	m.email = value
+	return nil
+}
+
+func (m *Account) HasEmail() (isSet bool) {
+	if m != nil && m.xxx_IsEmailSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account) ClearEmail() {
+	if m != nil {
+		m.xxx_IsEmailSet = false
+		m.email = ""
+		if m.xxx_ContactCase == Account_ContactCase_Email {
+			m.xxx_ContactCase = Account_ContactCase_NotSet
+		}
+	}
+}
+
+func (m *Account) SetPhone(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_ContactCase != Account_ContactCase_Phone {
+		m.ClearContact()
+		m.xxx_ContactCase = Account_ContactCase_Phone
+	}
+	m.xxx_IsPhoneSet = true
+	m.phone = value
+	return nil
+}
+
+func (m *Account) HasPhone() (isSet bool) {
+	if m != nil && m.xxx_IsPhoneSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account) ClearPhone() {
+	if m != nil {
+		m.xxx_IsPhoneSet = false
+		if m.xxx_ContactCase == Account_ContactCase_Phone {
+			m.xxx_ContactCase = Account_ContactCase_NotSet
+		}
+	}
+}
+
+func (m *Account) SetAvatar(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.xxx_IsAvatarSet = true
+	m.avatar = value
+	return nil
+}
+
+func (m *Account) HasAvatar() (isSet bool) {
+	if m != nil && m.xxx_IsAvatarSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account) ClearAvatar() {
+	if m != nil {
+		m.xxx_IsAvatarSet = false
+		m.avatar = nil
+	}
+}
+
+func (m *Account) Clear() {
+	if m != nil {
+		m.ClearId()
+		m.ClearName()
+		m.ClearLevel()
+		m.credentials.Clear()
+		m.xxx_IsCredentialsSet = false
+
+		for i := 0; i < m.HistorySize(); i++ {
+			m.history[i].Clear()
+		}
+		m.xxx_LenHistory = 0
+
+		m.ClearTags()
+		m.ClearServices()
+		m.ClearEmail()
+		m.ClearPhone()
+		m.ClearAvatar()
+		m.xxx_ContactCase = Account_ContactCase_NotSet
+	}
+}
+
+type Account_ServicesEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            *Credentials
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Account_ServicesEntry) Reset()         { *m = Account_ServicesEntry{} }
+func (m *Account_ServicesEntry) String() string { return proto.CompactTextString(m) }
+func (*Account_ServicesEntry) ProtoMessage()    {}
+
+func (m *Account_ServicesEntry) GetKey() string {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Account_ServicesEntry) GetValue() *Credentials {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Account_ServicesEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Account_ServicesEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Account_ServicesEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Account_ServicesEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+		m.key = ""
+	}
+}
+
+func (m *Account_ServicesEntry) MutateValue() (field *Credentials, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Credentials)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Account_ServicesEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Account_ServicesEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Account_ServicesEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Credentials) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsUserSet {
+		l = len(m.user)
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.xxx_IsSecretSet {
+		l = len(m.secret)
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Account) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		n += 1 + sovReflection(uint64(m.id))
+	}
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.xxx_IsLevelSet {
+		n += 1 + sovReflection(uint64(m.level))
+	}
+	if m.xxx_IsCredentialsSet {
+		l = m.credentials.Size()
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.xxx_LenHistory > 0 {
+		for i := 0; i < m.xxx_LenHistory; i++ {
+			e := m.history[i]
+			l = e.Size()
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	if m.xxx_LenTags > 0 {
+		for i := 0; i < m.xxx_LenTags; i++ {
+			s := m.tags[i]
+			l = len(s)
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	if len(m.services) > 0 {
+		for k, v := range m.services {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovReflection(uint64(len(k))) + 1 + l + sovReflection(uint64(l))
+			n += 1 + mapEntrySize + sovReflection(uint64(mapEntrySize))
+		}
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Email {
+		l = len(m.email)
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Phone {
+		n += 1 + sovReflection(uint64(m.phone))
+	}
+	if m.xxx_IsAvatarSet {
+		l = len(m.avatar)
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Account_ServicesEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovReflection(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozReflection(x uint64) (n int) {
+	return sovReflection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Credentials) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Credentials) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Credentials) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsUserSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintReflection(data, i, uint64(len(m.user)))
+		i += copy(data[i:], m.user)
+	}
+	if m.xxx_IsSecretSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintReflection(data, i, uint64(len(m.secret)))
+		i += copy(data[i:], m.secret)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Account) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], m.CheckInitialized()
+}
+
+func (m *Account) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	n, err = m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return n, err
+	}
+	return n, m.CheckInitialized()
+}
+
+func (m *Account) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintReflection(data, i, uint64(m.id))
+	}
+	if m.xxx_IsNameSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintReflection(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsLevelSet {
+		data[i] = 0x18
+		i++
+		i = encodeVarintReflection(data, i, uint64(m.level))
+	}
+	if m.xxx_IsCredentialsSet {
+		data[i] = 0x22
+		i++
+		i = encodeVarintReflection(data, i, uint64(m.credentials.SizeCached()))
+		n1, err := m.credentials.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_LenHistory > 0 {
+		for idx := 0; idx < m.xxx_LenHistory; idx++ {
+			msg := m.history[idx]
+			data[i] = 0x2a
+			i++
+			i = encodeVarintReflection(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.xxx_LenTags > 0 {
+		for idx := 0; idx < m.xxx_LenTags; idx++ {
+			s := m.tags[idx]
+			data[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.services) > 0 {
+		keys := make([]string, 0, len(m.services))
+		for k := range m.services {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.services[k]
+			data[i] = 0x3a
+			i++
+			mapEntrySize := 1 + len(k) + sovReflection(uint64(len(k))) + 1 + v.SizeCached() + sovReflection(uint64(v.SizeCached()))
+			i = encodeVarintReflection(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintReflection(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintReflection(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Email {
+		data[i] = 0x42
+		i++
+		i = encodeVarintReflection(data, i, uint64(len(m.email)))
+		i += copy(data[i:], m.email)
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Phone {
+		data[i] = 0x48
+		i++
+		i = encodeVarintReflection(data, i, uint64(m.phone))
+	}
+	if m.xxx_IsAvatarSet {
+		data[i] = 0x52
+		i++
+		i = encodeVarintReflection(data, i, uint64(len(m.avatar)))
+		i += copy(data[i:], m.avatar)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Account_ServicesEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Account_ServicesEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Account_ServicesEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintReflection(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintReflection(data, i, uint64(m.value.SizeCached()))
+		n2, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Reflection(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Reflection(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintReflection(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Credentials) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field user", wireType)
+			}
+			m.xxx_IsUserSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.user = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field secret", wireType)
+			}
+			m.xxx_IsSecretSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.secret = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Account) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field id", wireType)
+			}
+			m.xxx_IsIdSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field level", wireType)
+			}
+			m.xxx_IsLevelSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.level |= (Level(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateCredentials()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field history", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddHistory()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.tags) <= m.xxx_LenTags {
+				newCapacity := 0
+				if len(m.tags) == 0 {
+					newCapacity = 8
+				} else if len(m.tags) < 1000000 {
+					newCapacity = m.xxx_LenTags * 2
+				} else {
+					newCapacity = m.xxx_LenTags + 1000000
+				}
+				t := make([]string, newCapacity, newCapacity)
+				copy(t, m.tags)
+				m.tags = t
+			}
+			m.tags[m.xxx_LenTags] = string(data[index:postIndex])
+			m.xxx_LenTags += 1
+			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Account_ServicesEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.services == nil {
+				m.services = make(map[string]*Credentials)
+			}
+			if entry.value == nil {
+				entry.value = new(Credentials)
+			}
+			m.services[entry.key] = entry.value
+			index = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field email", wireType)
+			}
+			if m.xxx_ContactCase != Account_ContactCase_Email {
+				m.ClearContact()
+				m.xxx_ContactCase = Account_ContactCase_Email
+			}
+			m.xxx_IsEmailSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.email = string(data[index:postIndex])
+			index = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field phone", wireType)
+			}
+			if m.xxx_ContactCase != Account_ContactCase_Phone {
+				m.ClearContact()
+				m.xxx_ContactCase = Account_ContactCase_Phone
+			}
+			m.xxx_IsPhoneSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.phone |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field avatar", wireType)
+			}
+			m.xxx_IsAvatarSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.avatar = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return m.CheckInitialized()
+}
+func (m *Account_ServicesEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Credentials) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Credentials) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Account) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Account) CheckInitialized() error {
+	if !(m.xxx_IsIdSet) {
+		return proto.NewRequiredNotSetError("Id")
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Account_ServicesEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Account_ServicesEntry) CheckInitialized() error {
+	return nil
+}
+
+func (m *Credentials) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsUserSet || w.EmitDefaults() {
+		w.Field("user", "user")
+		w.String(m.GetUser())
+	}
+	if m.xxx_IsSecretSet || w.EmitDefaults() {
+		w.Field("secret", "secret")
+		w.String(m.GetSecret())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Credentials) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("user", "user"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetUser(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("secret", "secret"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetSecret(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Credentials) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Credentials) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Account) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsIdSet || w.EmitDefaults() {
+		w.Field("id", "id")
+		w.Int64(m.GetId())
+	}
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_IsLevelSet || w.EmitDefaults() {
+		w.Field("level", "level")
+		w.Enum(int32(m.GetLevel()), Level_name)
+	}
+	if m.xxx_IsCredentialsSet {
+		w.Field("credentials", "credentials")
+		w.Message(m.GetCredentials())
+	} else if w.EmitDefaults() {
+		w.Field("credentials", "credentials")
+		w.Null()
+	}
+	if m.xxx_LenHistory > 0 || w.EmitDefaults() {
+		w.Field("history", "history")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenHistory; i++ {
+			w.Message(m.history[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenTags > 0 || w.EmitDefaults() {
+		w.Field("tags", "tags")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenTags; i++ {
+			w.String(m.tags[i])
+		}
+		w.EndArray()
+	}
+	if len(m.services) > 0 || w.EmitDefaults() {
+		w.Field("services", "services")
+		w.BeginObject()
+		keys := make([]string, 0, len(m.services))
+		for k := range m.services {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(k)
+			w.Message(m.services[k])
+		}
+		w.EndObject()
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Email {
+		w.Field("email", "email")
+		w.String(m.GetEmail())
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Phone {
+		w.Field("phone", "phone")
+		w.Int64(m.GetPhone())
+	}
+	if m.xxx_IsAvatarSet || w.EmitDefaults() {
+		w.Field("avatar", "avatar")
+		w.Base64(m.GetAvatar())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Account) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("id", "id"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetId(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("level", "level"); ok {
+		v, err := jsonpb.ClosedEnum(raw, Level_value, Level_name)
+		if err != nil {
+			return err
+		}
+		if err := m.SetLevel(Level(v)); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("credentials", "credentials"); ok {
+		v, err := m.MutateCredentials()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("history", "history"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddHistory()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("tags", "tags"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddTags(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("services", "services"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v := new(Credentials)
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+			if err := m.PutServices(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("email", "email"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetEmail(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("phone", "phone"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetPhone(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("avatar", "avatar"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetAvatar(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Account) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Account) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Account_ServicesEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Account_ServicesEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Account_ServicesEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Account_ServicesEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Credentials) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsUserSet {
+		w.Field("user")
+		w.Value(m.user)
+	}
+	if m.xxx_IsSecretSet {
+		w.Field("secret")
+		w.Value(m.secret)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Credentials) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "user":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetUser(v)
+	case "secret":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetSecret(v)
+	}
+	return false, nil
+}
+
+func (m *Account) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsIdSet {
+		w.Field("id")
+		w.Value(m.id)
+	}
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_IsLevelSet {
+		w.Field("level")
+		w.Enum(int32(m.level), Level_name)
+	}
+	if m.xxx_IsCredentialsSet {
+		w.Field("credentials")
+		w.Message(m.credentials)
+	}
+	for i := 0; i < m.xxx_LenHistory; i++ {
+		w.Field("history")
+		w.Message(m.history[i])
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		w.Field("tags")
+		w.Value(m.tags[i])
+	}
+	if len(m.services) > 0 {
+		keys := make([]string, 0, len(m.services))
+		for k := range m.services {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.services[k]
+			w.Field("services")
+			w.Message(&Account_ServicesEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Email {
+		w.Field("email")
+		w.Value(m.email)
+	}
+	if m.xxx_ContactCase == Account_ContactCase_Phone {
+		w.Field("phone")
+		w.Value(m.phone)
+	}
+	if m.xxx_IsAvatarSet {
+		w.Field("avatar")
+		w.Value(m.avatar)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Account) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "id":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetId(v)
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "level":
+		v, err := p.ReadEnum(Level_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetLevel(Level(v))
+	case "credentials":
+		v, err := m.MutateCredentials()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "history":
+		v, err := m.AddHistory()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "tags":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddTags(v)
+	case "services":
+		entry := &Account_ServicesEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.services == nil {
+			m.services = make(map[string]*Credentials)
+		}
+		if entry.value == nil {
+			entry.value = new(Credentials)
+		}
+		m.services[entry.key] = entry.value
+		return true, nil
+	case "email":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetEmail(v)
+	case "phone":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetPhone(v)
+	case "avatar":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetAvatar(v)
+	}
+	return false, nil
+}
+
+func (m *Account_ServicesEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Account_ServicesEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Credentials) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Credentials{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Credentials) MergeFrom(src proto.Message) {
+	s, ok := src.(*Credentials)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsUserSet {
+		m.SetUser(s.user)
+	}
+	if s.xxx_IsSecretSet {
+		m.SetSecret(s.secret)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Credentials) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Credentials)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsUserSet != o.xxx_IsUserSet {
+		return false
+	}
+	if m.xxx_IsUserSet && m.user != o.user {
+		return false
+	}
+	if m.xxx_IsSecretSet != o.xxx_IsSecretSet {
+		return false
+	}
+	if m.xxx_IsSecretSet && m.secret != o.secret {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Account) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Account{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Account) MergeFrom(src proto.Message) {
+	s, ok := src.(*Account)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsIdSet {
+		m.SetId(s.id)
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsLevelSet {
+		m.SetLevel(s.level)
+	}
+	if s.xxx_IsCredentialsSet {
+		v, _ := m.MutateCredentials()
+		v.MergeFrom(s.credentials)
+	}
+	for i := 0; i < s.xxx_LenHistory; i++ {
+		v, _ := m.AddHistory()
+		v.MergeFrom(s.history[i])
+	}
+	for i := 0; i < s.xxx_LenTags; i++ {
+		m.AddTags(s.tags[i])
+	}
+	if len(s.services) > 0 {
+		if m.services == nil {
+			m.services = make(map[string]*Credentials, len(s.services))
+		}
+		for k, v := range s.services {
+			m.services[k] = v.Clone().(*Credentials)
+		}
+	}
+	if s.xxx_ContactCase == Account_ContactCase_Email {
+		m.SetEmail(s.email)
+	}
+	if s.xxx_ContactCase == Account_ContactCase_Phone {
+		m.SetPhone(s.phone)
+	}
+	if s.xxx_IsAvatarSet {
+		m.SetAvatar(append([]byte{}, s.avatar...))
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Account) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Account)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsIdSet != o.xxx_IsIdSet {
+		return false
+	}
+	if m.xxx_IsIdSet && m.id != o.id {
+		return false
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsLevelSet != o.xxx_IsLevelSet {
+		return false
+	}
+	if m.xxx_IsLevelSet && m.level != o.level {
+		return false
+	}
+	if m.xxx_IsCredentialsSet != o.xxx_IsCredentialsSet {
+		return false
+	}
+	if m.xxx_IsCredentialsSet && !m.credentials.Equal(o.credentials) {
+		return false
+	}
+	if m.xxx_LenHistory != o.xxx_LenHistory {
+		return false
+	}
+	for i := 0; i < m.xxx_LenHistory; i++ {
+		if !m.history[i].Equal(o.history[i]) {
+			return false
+		}
+	}
+	if m.xxx_LenTags != o.xxx_LenTags {
+		return false
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		if m.tags[i] != o.tags[i] {
+			return false
+		}
+	}
+	if len(m.services) != len(o.services) {
+		return false
+	}
+	for k, v := range m.services {
+		if v2, ok := o.services[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if (m.xxx_ContactCase == Account_ContactCase_Email) != (o.xxx_ContactCase == Account_ContactCase_Email) {
+		return false
+	}
+	if (m.xxx_ContactCase == Account_ContactCase_Email) && m.email != o.email {
+		return false
+	}
+	if (m.xxx_ContactCase == Account_ContactCase_Phone) != (o.xxx_ContactCase == Account_ContactCase_Phone) {
+		return false
+	}
+	if (m.xxx_ContactCase == Account_ContactCase_Phone) && m.phone != o.phone {
+		return false
+	}
+	if m.xxx_IsAvatarSet != o.xxx_IsAvatarSet {
+		return false
+	}
+	if m.xxx_IsAvatarSet && !bytes.Equal(m.avatar, o.avatar) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Account_ServicesEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Account_ServicesEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Account_ServicesEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Account_ServicesEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Account_ServicesEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Account_ServicesEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionCredentials = &proto.MessageInfo{
+	Name: "reflection.Credentials",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "user",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Credentials)(nil).GetUser(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Credentials).GetUser()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "user", v)
+				}
+				x := m.(*Credentials)
+				return x.SetUser(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Credentials).HasUser()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Credentials).ClearUser()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "secret",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Credentials)(nil).GetSecret(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Credentials).GetSecret()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "secret", v)
+				}
+				x := m.(*Credentials)
+				return x.SetSecret(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Credentials).HasSecret()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Credentials).ClearSecret()
+			},
+		},
+	},
+}
+
+func (m *Credentials) ProtoReflect() *proto.MessageInfo {
+	return reflectionCredentials
+}
+
+var reflectionAccount = &proto.MessageInfo{
+	Name: "reflection.Account",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "id",
+			Kind:    proto.Int64Kind,
+			Label:   proto.RequiredLabel,
+			Default: (*Account)(nil).GetId(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account).GetId()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "id", v)
+				}
+				x := m.(*Account)
+				return x.SetId(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HasId()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearId()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Account)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Account)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearName()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "level",
+			Kind:    proto.EnumKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Account)(nil).GetLevel(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account).GetLevel()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(Level)
+				if !ok {
+					return proto.NewFieldTypeError(m, "level", v)
+				}
+				x := m.(*Account)
+				return x.SetLevel(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HasLevel()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearLevel()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "credentials",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Credentials)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account).GetCredentials()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Credentials)
+				if !ok {
+					return proto.NewFieldTypeError(m, "credentials", v)
+				}
+				x := m.(*Account)
+				x.ClearCredentials()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateCredentials()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HasCredentials()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearCredentials()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "history",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Credentials)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Account)
+				v := make([]*Credentials, x.xxx_LenHistory)
+				copy(v, x.history)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Credentials)
+				if !ok {
+					return proto.NewFieldTypeError(m, "history", v)
+				}
+				x := m.(*Account)
+				x.ClearHistory()
+				for _, e := range value {
+					field, err := x.AddHistory()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HistorySize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearHistory()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "tags",
+			Kind:   proto.StringKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Account)
+				v := make([]string, x.xxx_LenTags)
+				copy(v, x.tags)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "tags", v)
+				}
+				x := m.(*Account)
+				x.ClearTags()
+				for _, e := range value {
+					if err := x.AddTags(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).TagsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearTags()
+			},
+		},
+		{
+			Number: 7,
+			Name:   "services",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Account)
+				v := make(map[string]*Credentials, x.ServicesLen())
+				x.RangeServices(func(key string, value *Credentials) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]*Credentials)
+				if !ok {
+					return proto.NewFieldTypeError(m, "services", v)
+				}
+				x := m.(*Account)
+				x.ClearServices()
+				for k, e := range value {
+					if err := x.PutServices(k, e.Clone().(*Credentials)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).ServicesLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearServices()
+			},
+		},
+		{
+			Number:  8,
+			Name:    "email",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "contact",
+			Default: (*Account)(nil).GetEmail(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account).GetEmail()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "email", v)
+				}
+				x := m.(*Account)
+				return x.SetEmail(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HasEmail()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearEmail()
+			},
+		},
+		{
+			Number:  9,
+			Name:    "phone",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "contact",
+			Default: (*Account)(nil).GetPhone(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account).GetPhone()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "phone", v)
+				}
+				x := m.(*Account)
+				return x.SetPhone(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HasPhone()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearPhone()
+			},
+		},
+		{
+			Number:  10,
+			Name:    "avatar",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Account)(nil).GetAvatar(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account).GetAvatar()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "avatar", v)
+				}
+				x := m.(*Account)
+				return x.SetAvatar(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account).HasAvatar()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account).ClearAvatar()
+			},
+		},
+	},
+}
+
+func (m *Account) ProtoReflect() *proto.MessageInfo {
+	return reflectionAccount
+}
+
+var reflectionAccount_ServicesEntry = &proto.MessageInfo{
+	Name: "reflection.Account.ServicesEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Account_ServicesEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account_ServicesEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Account_ServicesEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account_ServicesEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account_ServicesEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Credentials)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Account_ServicesEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Credentials)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Account_ServicesEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Account_ServicesEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Account_ServicesEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Account_ServicesEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionAccount_ServicesEntry
+}
+
+func init() {
+	proto.RegisterEnum("reflection.Level", Level_name, Level_value)
+	proto.RegisterType((*Credentials)(nil), "reflection.Credentials")
+	proto.RegisterType((*Account)(nil), "reflection.Account")
+}
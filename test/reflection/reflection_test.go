@@ -0,0 +1,180 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package reflection
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+	descriptor "github.com/dropbox/goprotoc/protoc-gen-dgo/descriptor"
+)
+
+func newAccount() *Account {
+	m := &Account{}
+	m.SetId(1)
+	m.SetLevel(Level_LOW)
+	c, _ := m.MutateCredentials()
+	c.SetUser("user")
+	c.SetSecret("current")
+	c, _ = m.AddHistory()
+	c.SetSecret("old")
+	m.AddTags("a")
+	s := &Credentials{}
+	s.SetSecret("service")
+	m.PutServices("mail", s)
+	m.SetEmail("user@example.com")
+	return m
+}
+
+// Clears the fields named secret of m and of all the messages it holds,
+// without knowing their types.
+func redact(m proto.Message) {
+	info := proto.Reflect(m)
+	info.Range(m, func(f *proto.FieldInfo, v interface{}) bool {
+		switch {
+		case f.Name == "secret":
+			f.Clear(m)
+		case f.Map:
+			r := reflect.ValueOf(v)
+			for _, k := range r.MapKeys() {
+				redact(r.MapIndex(k).Interface().(proto.Message))
+			}
+		case f.Kind == proto.MessageKind && f.Label == proto.RepeatedLabel:
+			r := reflect.ValueOf(v)
+			for i := 0; i < r.Len(); i++ {
+				redact(r.Index(i).Interface().(proto.Message))
+			}
+		case f.Kind == proto.MessageKind:
+			redact(v.(proto.Message))
+		}
+		return true
+	})
+}
+
+func TestRedact(t *testing.T) {
+	m := newAccount()
+	redact(m)
+	if m.GetCredentials().HasSecret() || m.GetCredentials().GetUser() != "user" {
+		t.Errorf("credentials are %v", m.GetCredentials())
+	}
+	if c, _ := m.GetHistory(0); c.HasSecret() {
+		t.Errorf("history is %v", c)
+	}
+	if c, _ := m.GetServices("mail"); c.HasSecret() {
+		t.Errorf("service is %v", c)
+	}
+}
+
+func TestInfo(t *testing.T) {
+	info := proto.Reflect(&Account{})
+	if info == nil || info.Name != "reflection.Account" || len(info.Fields) != 10 {
+		t.Fatalf("info is %+v", info)
+	}
+	for _, c := range []struct {
+		name   string
+		number int32
+		kind   proto.Kind
+		label  proto.Label
+		def    interface{}
+	}{
+		{"id", 1, proto.Int64Kind, proto.RequiredLabel, int64(0)},
+		{"name", 2, proto.StringKind, proto.OptionalLabel, "anonymous"},
+		{"level", 3, proto.EnumKind, proto.OptionalLabel, Level_HIGH},
+		{"credentials", 4, proto.MessageKind, proto.OptionalLabel, nil},
+		{"history", 5, proto.MessageKind, proto.RepeatedLabel, nil},
+		{"avatar", 10, proto.BytesKind, proto.OptionalLabel, []byte(nil)},
+	} {
+		f := info.FieldByName(c.name)
+		if f == nil || info.FieldByNumber(c.number) != f {
+			t.Errorf("field %s is not number %d", c.name, c.number)
+			continue
+		}
+		if f.Kind != c.kind || f.Label != c.label || !reflect.DeepEqual(f.Default, c.def) {
+			t.Errorf("field %s is %v %v with default %#v", c.name, f.Label, f.Kind, f.Default)
+		}
+	}
+	if f := info.FieldByName("services"); !f.Map {
+		t.Errorf("services is not a map")
+	}
+	if f := info.FieldByName("phone"); f.Oneof != "contact" {
+		t.Errorf("phone is in oneof %q", f.Oneof)
+	}
+	if proto.Reflect(&descriptor.FileDescriptorProto{}) != nil {
+		t.Errorf("non generated message has a reflection table")
+	}
+}
+
+func TestSet(t *testing.T) {
+	info := proto.Reflect(&Account{})
+	m := &Account{}
+	c := &Credentials{}
+	c.SetUser("user")
+	for name, v := range map[string]interface{}{
+		"id":          int64(2),
+		"level":       Level_LOW,
+		"credentials": c,
+		"history":     []*Credentials{c, c},
+		"tags":        []string{"a", "b"},
+		"services":    map[string]*Credentials{"mail": c},
+		"phone":       int64(5),
+		"avatar":      []byte{1},
+	} {
+		if err := info.FieldByName(name).Set(m, v); err != nil {
+			t.Fatalf("set %s: %v", name, err)
+		}
+		if got := info.FieldByName(name).Get(m); !reflect.DeepEqual(got, v) {
+			t.Errorf("%s is %#v, want %#v", name, got, v)
+		}
+	}
+	if m.GetId() != 2 || m.GetLevel() != Level_LOW || m.HistorySize() != 2 || m.WhichContact() != Account_ContactCase_Phone {
+		t.Errorf("set %v", m)
+	}
+	// Messages are copied.
+	c.SetUser("changed")
+	if m.GetCredentials().GetUser() != "user" {
+		t.Errorf("credentials were not copied")
+	}
+
+	email := info.FieldByName("email")
+	if err := email.Set(m, "user@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if info.FieldByName("phone").Has(m) || !email.Has(m) {
+		t.Errorf("setting email did not clear phone")
+	}
+	email.Clear(m)
+	if email.Has(m) {
+		t.Errorf("email was not cleared")
+	}
+	if err := info.FieldByName("id").Set(m, int32(1)); err == nil {
+		t.Errorf("set int64 field to an int32")
+	}
+	if err := info.FieldByName("credentials").Set(m, (*Credentials)(nil)); err != nil || m.HasCredentials() {
+		t.Errorf("setting nil credentials did not clear them: %v", err)
+	}
+}
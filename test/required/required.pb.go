@@ -0,0 +1,2520 @@
+// Code generated by protoc-gen-dgo.
+// source: required.proto
+// DO NOT EDIT!
+
+/*
+Package required is a generated protocol buffer package.
+
+It is generated from these files:
+
+	required.proto
+
+It has these top-level messages:
+
+	Leaf
+	Node
+	Plain
+*/
+package required
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Leaf struct {
+	xxx_sizeCached   int
+	name             string
+	weight           int64
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+	xxx_IsWeightSet  bool
+}
+
+func (m *Leaf) Reset()         { *m = Leaf{} }
+func (m *Leaf) String() string { return proto.CompactTextString(m) }
+func (*Leaf) ProtoMessage()    {}
+
+func (m *Leaf) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Leaf) GetWeight() int64 {
+	if m != nil && m.xxx_IsWeightSet {
+		return m.weight
+	}
+	return 0
+}
+
+func (m *Leaf) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Leaf) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Leaf) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Leaf) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Leaf) SetWeight(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsWeightSet = true
+	m.weight = value
+	return nil
+}
+
+func (m *Leaf) HasWeight() (isSet bool) {
+	if m != nil && m.xxx_IsWeightSet {
+		return true
+	}
+	return false
+}
+
+func (m *Leaf) ClearWeight() {
+	if m != nil {
+		m.xxx_IsWeightSet = false
+	}
+}
+
+func (m *Leaf) Clear() {
+	if m != nil {
+		m.ClearName()
+		m.ClearWeight()
+	}
+}
+
+type Node struct {
+	xxx_sizeCached   int
+	id               int64
+	leaf             *Leaf
+	leaves           []*Leaf
+	named            map[string]*Leaf
+	child            *Node
+	plain            *Plain
+	XXX_unrecognized []byte
+	xxx_IsIdSet      bool
+	xxx_IsLeafSet    bool
+	xxx_LenLeaves    int
+	xxx_IsChildSet   bool
+	xxx_IsPlainSet   bool
+}
+
+func (m *Node) Reset()         { *m = Node{} }
+func (m *Node) String() string { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()    {}
+
+func (m *Node) GetId() int64 {
+	if m != nil && m.xxx_IsIdSet {
+		return m.id
+	}
+	return 0
+}
+
+func (m *Node) GetLeaf() *Leaf {
+	if m != nil && m.xxx_IsLeafSet {
+		return m.leaf
+	}
+	return nil
+}
+func (m *Node) GetChild() *Node {
+	if m != nil && m.xxx_IsChildSet {
+		return m.child
+	}
+	return nil
+}
+func (m *Node) GetPlain() *Plain {
+	if m != nil && m.xxx_IsPlainSet {
+		return m.plain
+	}
+	return nil
+}
+func (m *Node) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Node) SetId(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIdSet = true
+	m.id = value
+	return nil
+}
+
+func (m *Node) HasId() (isSet bool) {
+	if m != nil && m.xxx_IsIdSet {
+		return true
+	}
+	return false
+}
+
+func (m *Node) ClearId() {
+	if m != nil {
+		m.xxx_IsIdSet = false
+	}
+}
+
+func (m *Node) MutateLeaf() (field *Leaf, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsLeafSet {
+		m.xxx_IsLeafSet = true
+		if m.leaf == nil {
+			m.leaf = new(Leaf)
+		} else {
+			m.leaf.Clear()
+		}
+	}
+	return m.leaf, nil
+}
+
+func (m *Node) HasLeaf() (isSet bool) {
+	if m != nil && m.xxx_IsLeafSet {
+		return true
+	}
+	return false
+}
+
+func (m *Node) ClearLeaf() {
+	if m != nil {
+		m.leaf.Clear()
+		m.xxx_IsLeafSet = false
+
+	}
+}
+
+func (m *Node) AddLeaves() (field *Leaf, err error) {
+	if m != nil {
+		if len(m.leaves) <= m.xxx_LenLeaves {
+			newCapacity := 0
+			if len(m.leaves) == 0 {
+				newCapacity = 8
+			} else if len(m.leaves) < 1000000 {
+				newCapacity = m.xxx_LenLeaves * 2
+			} else {
+				newCapacity = m.xxx_LenLeaves + 1000000
+			}
+			t := make([]*Leaf, newCapacity, newCapacity)
+			copy(t, m.leaves)
+			m.leaves = t
+		}
+		field = m.leaves[m.xxx_LenLeaves]
+		if field == nil {
+			field = new(Leaf)
+			m.leaves[m.xxx_LenLeaves] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenLeaves += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Node) MutateLeaves(index int) (field *Leaf, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenLeaves {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.leaves[index] == nil {
+		m.leaves[index] = new(Leaf)
+	}
+	return m.leaves[index], nil
+}
+
+func (m *Node) LeavesSize() (size int) {
+	if m != nil {
+		return m.xxx_LenLeaves
+	}
+	return 0
+}
+
+func (m *Node) ClearLeaves() {
+	if m != nil {
+		for i := 0; i < m.LeavesSize(); i++ {
+			m.leaves[i].Clear()
+		}
+		m.xxx_LenLeaves = 0
+
+	}
+}
+
+func (m *Node) GetLeaves(index int) (field *Leaf, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenLeaves {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.leaves[index], nil
+}
+
+func (m *Node) GetNamed(key string) (value *Leaf, ok bool) {
+	if m != nil {
+		value, ok = m.named[key]
+	}
+	return value, ok
+}
+
+func (m *Node) PutNamed(key string, value *Leaf) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.named == nil {
+		m.named = make(map[string]*Leaf)
+	}
+	m.named[key] = value
+	return nil
+}
+
+func (m *Node) DeleteNamed(key string) {
+	if m != nil {
+		delete(m.named, key)
+	}
+}
+
+func (m *Node) NamedLen() (size int) {
+	if m != nil {
+		return len(m.named)
+	}
+	return 0
+}
+
+func (m *Node) RangeNamed(f func(key string, value *Leaf) bool) {
+	if m != nil {
+		for k, v := range m.named {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Node) ClearNamed() {
+	if m != nil {
+		m.named = nil
+	}
+}
+
+func (m *Node) MutateChild() (field *Node, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsChildSet {
+		m.xxx_IsChildSet = true
+		if m.child == nil {
+			m.child = new(Node)
+		} else {
+			m.child.Clear()
+		}
+	}
+	return m.child, nil
+}
+
+func (m *Node) HasChild() (isSet bool) {
+	if m != nil && m.xxx_IsChildSet {
+		return true
+	}
+	return false
+}
+
+func (m *Node) ClearChild() {
+	if m != nil {
+		m.child.Clear()
+		m.xxx_IsChildSet = false
+
+	}
+}
+
+func (m *Node) MutatePlain() (field *Plain, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsPlainSet {
+		m.xxx_IsPlainSet = true
+		if m.plain == nil {
+			m.plain = new(Plain)
+		} else {
+			m.plain.Clear()
+		}
+	}
+	return m.plain, nil
+}
+
+func (m *Node) HasPlain() (isSet bool) {
+	if m != nil && m.xxx_IsPlainSet {
+		return true
+	}
+	return false
+}
+
+func (m *Node) ClearPlain() {
+	if m != nil {
+		m.plain.Clear()
+		m.xxx_IsPlainSet = false
+
+	}
+}
+
+func (m *Node) Clear() {
+	if m != nil {
+		m.ClearId()
+		m.leaf.Clear()
+		m.xxx_IsLeafSet = false
+
+		for i := 0; i < m.LeavesSize(); i++ {
+			m.leaves[i].Clear()
+		}
+		m.xxx_LenLeaves = 0
+
+		m.ClearNamed()
+		m.child.Clear()
+		m.xxx_IsChildSet = false
+
+		m.plain.Clear()
+		m.xxx_IsPlainSet = false
+
+	}
+}
+
+type Node_NamedEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            *Leaf
+	XXX_unrecognized []byte
+	xxx_IsKeySet     bool
+	xxx_IsValueSet   bool
+}
+
+func (m *Node_NamedEntry) Reset()         { *m = Node_NamedEntry{} }
+func (m *Node_NamedEntry) String() string { return proto.CompactTextString(m) }
+func (*Node_NamedEntry) ProtoMessage()    {}
+
+func (m *Node_NamedEntry) GetKey() string {
+	if m != nil && m.xxx_IsKeySet {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Node_NamedEntry) GetValue() *Leaf {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Node_NamedEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Node_NamedEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsKeySet = true
+	m.key = value
+	return nil
+}
+
+func (m *Node_NamedEntry) HasKey() (isSet bool) {
+	if m != nil && m.xxx_IsKeySet {
+		return true
+	}
+	return false
+}
+
+func (m *Node_NamedEntry) ClearKey() {
+	if m != nil {
+		m.xxx_IsKeySet = false
+		m.key = ""
+	}
+}
+
+func (m *Node_NamedEntry) MutateValue() (field *Leaf, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Leaf)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Node_NamedEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Node_NamedEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Node_NamedEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+type Plain struct {
+	xxx_sizeCached   int
+	name             string
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+}
+
+func (m *Plain) Reset()         { *m = Plain{} }
+func (m *Plain) String() string { return proto.CompactTextString(m) }
+func (*Plain) ProtoMessage()    {}
+
+func (m *Plain) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Plain) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Plain) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Plain) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Plain) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Plain) Clear() {
+	if m != nil {
+		m.ClearName()
+	}
+}
+
+func (m *Leaf) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovRequired(uint64(l))
+	}
+	if m.xxx_IsWeightSet {
+		n += 1 + sovRequired(uint64(m.weight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Node) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		n += 1 + sovRequired(uint64(m.id))
+	}
+	if m.xxx_IsLeafSet {
+		l = m.leaf.Size()
+		n += 1 + l + sovRequired(uint64(l))
+	}
+	if m.xxx_LenLeaves > 0 {
+		for i := 0; i < m.xxx_LenLeaves; i++ {
+			e := m.leaves[i]
+			l = e.Size()
+			n += 1 + l + sovRequired(uint64(l))
+		}
+	}
+	if len(m.named) > 0 {
+		for k, v := range m.named {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovRequired(uint64(len(k))) + 1 + l + sovRequired(uint64(l))
+			n += 1 + mapEntrySize + sovRequired(uint64(mapEntrySize))
+		}
+	}
+	if m.xxx_IsChildSet {
+		l = m.child.Size()
+		n += 1 + l + sovRequired(uint64(l))
+	}
+	if m.xxx_IsPlainSet {
+		l = m.plain.Size()
+		n += 1 + l + sovRequired(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Node_NamedEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		l = len(m.key)
+		n += 1 + l + sovRequired(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovRequired(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Plain) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovRequired(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovRequired(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRequired(x uint64) (n int) {
+	return sovRequired(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Leaf) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], m.CheckInitialized()
+}
+
+func (m *Leaf) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	n, err = m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return n, err
+	}
+	return n, m.CheckInitialized()
+}
+
+func (m *Leaf) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRequired(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsWeightSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintRequired(data, i, uint64(m.weight))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Node) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], m.CheckInitialized()
+}
+
+func (m *Node) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	n, err = m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return n, err
+	}
+	return n, m.CheckInitialized()
+}
+
+func (m *Node) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintRequired(data, i, uint64(m.id))
+	}
+	if m.xxx_IsLeafSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintRequired(data, i, uint64(m.leaf.SizeCached()))
+		n1, err := m.leaf.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_LenLeaves > 0 {
+		for idx := 0; idx < m.xxx_LenLeaves; idx++ {
+			msg := m.leaves[idx]
+			data[i] = 0x1a
+			i++
+			i = encodeVarintRequired(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.named) > 0 {
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			data[i] = 0x22
+			i++
+			mapEntrySize := 1 + len(k) + sovRequired(uint64(len(k))) + 1 + v.SizeCached() + sovRequired(uint64(v.SizeCached()))
+			i = encodeVarintRequired(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintRequired(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintRequired(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if m.xxx_IsChildSet {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintRequired(data, i, uint64(m.child.SizeCached()))
+		n2, err := m.child.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.xxx_IsPlainSet {
+		data[i] = 0x32
+		i++
+		i = encodeVarintRequired(data, i, uint64(m.plain.SizeCached()))
+		n3, err := m.plain.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Node_NamedEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], m.CheckInitialized()
+}
+
+func (m *Node_NamedEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	n, err = m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return n, err
+	}
+	return n, m.CheckInitialized()
+}
+
+func (m *Node_NamedEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsKeySet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRequired(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintRequired(data, i, uint64(m.value.SizeCached()))
+		n4, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Plain) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Plain) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Plain) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRequired(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Required(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Required(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintRequired(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Leaf) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field weight", wireType)
+			}
+			m.xxx_IsWeightSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.weight |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return m.CheckInitialized()
+}
+func (m *Node) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field id", wireType)
+			}
+			m.xxx_IsIdSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field leaf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateLeaf()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				if _, ok := err.(*proto.RequiredNotSetError); !ok {
+					return err
+				}
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field leaves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddLeaves()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				if _, ok := err.(*proto.RequiredNotSetError); !ok {
+					return err
+				}
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field named", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Node_NamedEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				if _, ok := err.(*proto.RequiredNotSetError); !ok {
+					return err
+				}
+			}
+			if m.named == nil {
+				m.named = make(map[string]*Leaf)
+			}
+			if entry.value == nil {
+				entry.value = new(Leaf)
+			}
+			m.named[entry.key] = entry.value
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateChild()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				if _, ok := err.(*proto.RequiredNotSetError); !ok {
+					return err
+				}
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field plain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutatePlain()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return m.CheckInitialized()
+}
+func (m *Node_NamedEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			m.xxx_IsKeySet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				if _, ok := err.(*proto.RequiredNotSetError); !ok {
+					return err
+				}
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return m.CheckInitialized()
+}
+func (m *Plain) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Leaf) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Leaf) CheckInitialized() error {
+	if !(m.xxx_IsNameSet) {
+		return proto.NewRequiredNotSetError("Name")
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Node) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Node) CheckInitialized() error {
+	if !(m.xxx_IsIdSet) {
+		return proto.NewRequiredNotSetError("Id")
+	}
+	if m.xxx_IsLeafSet {
+		if err := m.leaf.CheckInitialized(); err != nil {
+			return proto.NewRequiredNotSetError("Leaf." + err.(*proto.RequiredNotSetError).Field())
+		}
+	}
+	for i := 0; i < m.xxx_LenLeaves; i++ {
+		if err := m.leaves[i].CheckInitialized(); err != nil {
+			return proto.NewRequiredNotSetError("Leaves." + err.(*proto.RequiredNotSetError).Field())
+		}
+	}
+	for _, v := range m.named {
+		if v == nil {
+			continue
+		}
+		if err := v.CheckInitialized(); err != nil {
+			return proto.NewRequiredNotSetError("Named." + err.(*proto.RequiredNotSetError).Field())
+		}
+	}
+	if m.xxx_IsChildSet {
+		if err := m.child.CheckInitialized(); err != nil {
+			return proto.NewRequiredNotSetError("Child." + err.(*proto.RequiredNotSetError).Field())
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Node_NamedEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Node_NamedEntry) CheckInitialized() error {
+	if m.xxx_IsValueSet {
+		if err := m.value.CheckInitialized(); err != nil {
+			return proto.NewRequiredNotSetError("Value." + err.(*proto.RequiredNotSetError).Field())
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Plain) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Plain) CheckInitialized() error {
+	return nil
+}
+
+func (m *Leaf) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_IsWeightSet || w.EmitDefaults() {
+		w.Field("weight", "weight")
+		w.Int64(m.GetWeight())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Leaf) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("weight", "weight"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetWeight(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Leaf) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Leaf) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Node) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsIdSet || w.EmitDefaults() {
+		w.Field("id", "id")
+		w.Int64(m.GetId())
+	}
+	if m.xxx_IsLeafSet {
+		w.Field("leaf", "leaf")
+		w.Message(m.GetLeaf())
+	} else if w.EmitDefaults() {
+		w.Field("leaf", "leaf")
+		w.Null()
+	}
+	if m.xxx_LenLeaves > 0 || w.EmitDefaults() {
+		w.Field("leaves", "leaves")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenLeaves; i++ {
+			w.Message(m.leaves[i])
+		}
+		w.EndArray()
+	}
+	if len(m.named) > 0 || w.EmitDefaults() {
+		w.Field("named", "named")
+		w.BeginObject()
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			w.Key(k)
+			w.Message(m.named[k])
+		}
+		w.EndObject()
+	}
+	if m.xxx_IsChildSet {
+		w.Field("child", "child")
+		w.Message(m.GetChild())
+	} else if w.EmitDefaults() {
+		w.Field("child", "child")
+		w.Null()
+	}
+	if m.xxx_IsPlainSet {
+		w.Field("plain", "plain")
+		w.Message(m.GetPlain())
+	} else if w.EmitDefaults() {
+		w.Field("plain", "plain")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Node) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("id", "id"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetId(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("leaf", "leaf"); ok {
+		v, err := m.MutateLeaf()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("leaves", "leaves"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddLeaves()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("named", "named"); ok {
+		entries, err := jsonpb.Object(raw)
+		if err != nil {
+			return err
+		}
+		for key, elem := range entries {
+			v := new(Leaf)
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+			if err := m.PutNamed(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("child", "child"); ok {
+		v, err := m.MutateChild()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("plain", "plain"); ok {
+		v, err := m.MutatePlain()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Node) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Node) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Node_NamedEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsKeySet || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Node_NamedEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Node_NamedEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Node_NamedEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Plain) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Plain) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Plain) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Plain) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Leaf) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_IsWeightSet {
+		w.Field("weight")
+		w.Value(m.weight)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Leaf) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "weight":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetWeight(v)
+	}
+	return false, nil
+}
+
+func (m *Node) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsIdSet {
+		w.Field("id")
+		w.Value(m.id)
+	}
+	if m.xxx_IsLeafSet {
+		w.Field("leaf")
+		w.Message(m.leaf)
+	}
+	for i := 0; i < m.xxx_LenLeaves; i++ {
+		w.Field("leaves")
+		w.Message(m.leaves[i])
+	}
+	if len(m.named) > 0 {
+		keys := make([]string, 0, len(m.named))
+		for k := range m.named {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.named[k]
+			w.Field("named")
+			w.Message(&Node_NamedEntry{key: k, xxx_IsKeySet: true, value: v, xxx_IsValueSet: true})
+		}
+	}
+	if m.xxx_IsChildSet {
+		w.Field("child")
+		w.Message(m.child)
+	}
+	if m.xxx_IsPlainSet {
+		w.Field("plain")
+		w.Message(m.plain)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Node) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "id":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetId(v)
+	case "leaf":
+		v, err := m.MutateLeaf()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "leaves":
+		v, err := m.AddLeaves()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "named":
+		entry := &Node_NamedEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.named == nil {
+			m.named = make(map[string]*Leaf)
+		}
+		if entry.value == nil {
+			entry.value = new(Leaf)
+		}
+		m.named[entry.key] = entry.value
+		return true, nil
+	case "child":
+		v, err := m.MutateChild()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "plain":
+		v, err := m.MutatePlain()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Node_NamedEntry) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsKeySet {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Node_NamedEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Plain) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Plain) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	}
+	return false, nil
+}
+
+func (m *Leaf) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Leaf{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Leaf) MergeFrom(src proto.Message) {
+	s, ok := src.(*Leaf)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsWeightSet {
+		m.SetWeight(s.weight)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Leaf) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Leaf)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsWeightSet != o.xxx_IsWeightSet {
+		return false
+	}
+	if m.xxx_IsWeightSet && m.weight != o.weight {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Node) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Node{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Node) MergeFrom(src proto.Message) {
+	s, ok := src.(*Node)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsIdSet {
+		m.SetId(s.id)
+	}
+	if s.xxx_IsLeafSet {
+		v, _ := m.MutateLeaf()
+		v.MergeFrom(s.leaf)
+	}
+	for i := 0; i < s.xxx_LenLeaves; i++ {
+		v, _ := m.AddLeaves()
+		v.MergeFrom(s.leaves[i])
+	}
+	if len(s.named) > 0 {
+		if m.named == nil {
+			m.named = make(map[string]*Leaf, len(s.named))
+		}
+		for k, v := range s.named {
+			m.named[k] = v.Clone().(*Leaf)
+		}
+	}
+	if s.xxx_IsChildSet {
+		v, _ := m.MutateChild()
+		v.MergeFrom(s.child)
+	}
+	if s.xxx_IsPlainSet {
+		v, _ := m.MutatePlain()
+		v.MergeFrom(s.plain)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Node) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Node)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsIdSet != o.xxx_IsIdSet {
+		return false
+	}
+	if m.xxx_IsIdSet && m.id != o.id {
+		return false
+	}
+	if m.xxx_IsLeafSet != o.xxx_IsLeafSet {
+		return false
+	}
+	if m.xxx_IsLeafSet && !m.leaf.Equal(o.leaf) {
+		return false
+	}
+	if m.xxx_LenLeaves != o.xxx_LenLeaves {
+		return false
+	}
+	for i := 0; i < m.xxx_LenLeaves; i++ {
+		if !m.leaves[i].Equal(o.leaves[i]) {
+			return false
+		}
+	}
+	if len(m.named) != len(o.named) {
+		return false
+	}
+	for k, v := range m.named {
+		if v2, ok := o.named[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if m.xxx_IsChildSet != o.xxx_IsChildSet {
+		return false
+	}
+	if m.xxx_IsChildSet && !m.child.Equal(o.child) {
+		return false
+	}
+	if m.xxx_IsPlainSet != o.xxx_IsPlainSet {
+		return false
+	}
+	if m.xxx_IsPlainSet && !m.plain.Equal(o.plain) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Node_NamedEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Node_NamedEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Node_NamedEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Node_NamedEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsKeySet {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Node_NamedEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Node_NamedEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsKeySet != o.xxx_IsKeySet {
+		return false
+	}
+	if m.xxx_IsKeySet && m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Plain) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Plain{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Plain) MergeFrom(src proto.Message) {
+	s, ok := src.(*Plain)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Plain) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Plain)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionLeaf = &proto.MessageInfo{
+	Name: "required.Leaf",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.RequiredLabel,
+			Default: (*Leaf)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Leaf).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Leaf)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Leaf).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Leaf).ClearName()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "weight",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Leaf)(nil).GetWeight(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Leaf).GetWeight()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "weight", v)
+				}
+				x := m.(*Leaf)
+				return x.SetWeight(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Leaf).HasWeight()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Leaf).ClearWeight()
+			},
+		},
+	},
+}
+
+func (m *Leaf) ProtoReflect() *proto.MessageInfo {
+	return reflectionLeaf
+}
+
+var reflectionNode = &proto.MessageInfo{
+	Name: "required.Node",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "id",
+			Kind:    proto.Int64Kind,
+			Label:   proto.RequiredLabel,
+			Default: (*Node)(nil).GetId(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Node).GetId()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "id", v)
+				}
+				x := m.(*Node)
+				return x.SetId(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node).HasId()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node).ClearId()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "leaf",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Leaf)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Node).GetLeaf()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Leaf)
+				if !ok {
+					return proto.NewFieldTypeError(m, "leaf", v)
+				}
+				x := m.(*Node)
+				x.ClearLeaf()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateLeaf()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node).HasLeaf()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node).ClearLeaf()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "leaves",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Leaf)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Node)
+				v := make([]*Leaf, x.xxx_LenLeaves)
+				copy(v, x.leaves)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Leaf)
+				if !ok {
+					return proto.NewFieldTypeError(m, "leaves", v)
+				}
+				x := m.(*Node)
+				x.ClearLeaves()
+				for _, e := range value {
+					field, err := x.AddLeaves()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node).LeavesSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node).ClearLeaves()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "named",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Node)
+				v := make(map[string]*Leaf, x.NamedLen())
+				x.RangeNamed(func(key string, value *Leaf) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]*Leaf)
+				if !ok {
+					return proto.NewFieldTypeError(m, "named", v)
+				}
+				x := m.(*Node)
+				x.ClearNamed()
+				for k, e := range value {
+					if err := x.PutNamed(k, e.Clone().(*Leaf)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node).NamedLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node).ClearNamed()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "child",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Node)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Node).GetChild()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Node)
+				if !ok {
+					return proto.NewFieldTypeError(m, "child", v)
+				}
+				x := m.(*Node)
+				x.ClearChild()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateChild()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node).HasChild()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node).ClearChild()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "plain",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Plain)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Node).GetPlain()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Plain)
+				if !ok {
+					return proto.NewFieldTypeError(m, "plain", v)
+				}
+				x := m.(*Node)
+				x.ClearPlain()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutatePlain()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node).HasPlain()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node).ClearPlain()
+			},
+		},
+	},
+}
+
+func (m *Node) ProtoReflect() *proto.MessageInfo {
+	return reflectionNode
+}
+
+var reflectionNode_NamedEntry = &proto.MessageInfo{
+	Name: "required.Node.NamedEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Node_NamedEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Node_NamedEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Node_NamedEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node_NamedEntry).HasKey()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node_NamedEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Leaf)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Node_NamedEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Leaf)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Node_NamedEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Node_NamedEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Node_NamedEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Node_NamedEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionNode_NamedEntry
+}
+
+var reflectionPlain = &proto.MessageInfo{
+	Name: "required.Plain",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Plain)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Plain).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Plain)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Plain).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Plain).ClearName()
+			},
+		},
+	},
+}
+
+func (m *Plain) ProtoReflect() *proto.MessageInfo {
+	return reflectionPlain
+}
+
+func init() {
+	proto.RegisterType((*Leaf)(nil), "required.Leaf")
+	proto.RegisterType((*Node)(nil), "required.Node")
+	proto.RegisterType((*Plain)(nil), "required.Plain")
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package required
+
+import (
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newNode() *Node {
+	m := &Node{}
+	m.SetId(1)
+	leaf, _ := m.MutateLeaf()
+	leaf.SetName("leaf")
+	leaf, _ = m.AddLeaves()
+	leaf.SetName("first")
+	named := &Leaf{}
+	named.SetName("named")
+	m.PutNamed("a", named)
+	child, _ := m.MutateChild()
+	child.SetId(2)
+	return m
+}
+
+func checkRequiredNotSet(t *testing.T, err error, field string) {
+	rerr, ok := err.(*proto.RequiredNotSetError)
+	if !ok {
+		t.Fatalf("err is %v, want a RequiredNotSetError", err)
+	}
+	if rerr.Field() != field {
+		t.Fatalf("unset field is %q, want %q", rerr.Field(), field)
+	}
+}
+
+func TestInitialized(t *testing.T) {
+	m := newNode()
+	if !m.IsInitialized() {
+		t.Fatalf("%v is not initialized: %v", m, m.CheckInitialized())
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(data, &Node{}); err != nil {
+		t.Fatal(err)
+	}
+	if !(&Plain{}).IsInitialized() {
+		t.Fatalf("a message without required fields is not initialized")
+	}
+}
+
+func TestCheckInitializedPath(t *testing.T) {
+	m := newNode()
+	m.ClearId()
+	checkRequiredNotSet(t, m.CheckInitialized(), "Id")
+
+	m = newNode()
+	m.GetLeaf().ClearName()
+	checkRequiredNotSet(t, m.CheckInitialized(), "Leaf.Name")
+
+	m = newNode()
+	m.AddLeaves()
+	checkRequiredNotSet(t, m.CheckInitialized(), "Leaves.Name")
+
+	m = newNode()
+	m.PutNamed("b", &Leaf{})
+	checkRequiredNotSet(t, m.CheckInitialized(), "Named.Name")
+
+	m = newNode()
+	child, _ := m.MutateChild()
+	leaf, _ := child.MutateLeaf()
+	leaf.SetWeight(3)
+	checkRequiredNotSet(t, m.CheckInitialized(), "Child.Leaf.Name")
+	if m.IsInitialized() {
+		t.Fatalf("%v is initialized", m)
+	}
+}
+
+func TestMarshalRequiredNotSet(t *testing.T) {
+	m := newNode()
+	child, _ := m.MutateChild()
+	child.ClearId()
+	want := proto.CompactTextString(m)
+
+	data, err := proto.Marshal(m)
+	checkRequiredNotSet(t, err, "Child.Id")
+	if len(data) != m.Size() {
+		t.Fatalf("marshaled %d bytes, want %d", len(data), m.Size())
+	}
+	buf := make([]byte, m.Size())
+	n, err := m.MarshalTo(buf)
+	checkRequiredNotSet(t, err, "Child.Id")
+	if n != len(data) {
+		t.Fatalf("MarshalTo wrote %d bytes, want %d", n, len(data))
+	}
+
+	u := &Node{}
+	err = proto.Unmarshal(data, u)
+	checkRequiredNotSet(t, err, "Child.Id")
+	if got := proto.CompactTextString(u); got != want {
+		t.Fatalf("unmarshaled %q, want %q", got, want)
+	}
+}
+
+func TestBufferMarshalRequiredNotSet(t *testing.T) {
+	m := newNode()
+	m.ClearId()
+	b := proto.NewBuffer(nil)
+	checkRequiredNotSet(t, b.Marshal(m), "Id")
+	if len(b.Bytes()) != m.Size() {
+		t.Fatalf("buffer holds %d bytes, want %d", len(b.Bytes()), m.Size())
+	}
+}
@@ -0,0 +1,996 @@
+// Code generated by protoc-gen-dgo.
+// source: service.proto
+// DO NOT EDIT!
+
+/*
+Package service is a generated protocol buffer package.
+
+It is generated from these files:
+
+	service.proto
+
+It has these top-level messages:
+
+	EchoRequest
+	EchoResponse
+*/
+package service
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+import rpc "github.com/dropbox/goprotoc/rpc"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type EchoRequest struct {
+	xxx_sizeCached   int
+	text             string
+	repeat           int32
+	XXX_unrecognized []byte
+	xxx_IsTextSet    bool
+	xxx_IsRepeatSet  bool
+}
+
+func (m *EchoRequest) Reset()         { *m = EchoRequest{} }
+func (m *EchoRequest) String() string { return proto.CompactTextString(m) }
+func (*EchoRequest) ProtoMessage()    {}
+
+func (m *EchoRequest) GetText() string {
+	if m != nil && m.xxx_IsTextSet {
+		return m.text
+	}
+	return ""
+}
+
+func (m *EchoRequest) GetRepeat() int32 {
+	if m != nil && m.xxx_IsRepeatSet {
+		return m.repeat
+	}
+	return 0
+}
+
+func (m *EchoRequest) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *EchoRequest) SetText(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsTextSet = true
+	m.text = value
+	return nil
+}
+
+func (m *EchoRequest) HasText() (isSet bool) {
+	if m != nil && m.xxx_IsTextSet {
+		return true
+	}
+	return false
+}
+
+func (m *EchoRequest) ClearText() {
+	if m != nil {
+		m.xxx_IsTextSet = false
+		m.text = ""
+	}
+}
+
+func (m *EchoRequest) SetRepeat(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsRepeatSet = true
+	m.repeat = value
+	return nil
+}
+
+func (m *EchoRequest) HasRepeat() (isSet bool) {
+	if m != nil && m.xxx_IsRepeatSet {
+		return true
+	}
+	return false
+}
+
+func (m *EchoRequest) ClearRepeat() {
+	if m != nil {
+		m.xxx_IsRepeatSet = false
+	}
+}
+
+func (m *EchoRequest) Clear() {
+	if m != nil {
+		m.ClearText()
+		m.ClearRepeat()
+	}
+}
+
+type EchoResponse struct {
+	xxx_sizeCached   int
+	texts            []string
+	XXX_unrecognized []byte
+	xxx_LenTexts     int
+}
+
+func (m *EchoResponse) Reset()         { *m = EchoResponse{} }
+func (m *EchoResponse) String() string { return proto.CompactTextString(m) }
+func (*EchoResponse) ProtoMessage()    {}
+
+func (m *EchoResponse) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *EchoResponse) AddTexts(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.texts) <= m.xxx_LenTexts {
+		newCapacity := 0
+		if len(m.texts) == 0 {
+			newCapacity = 8
+		} else if len(m.texts) < 1000000 {
+			newCapacity = m.xxx_LenTexts * 2
+		} else {
+			newCapacity = m.xxx_LenTexts + 1000000
+		}
+		t := make([]string, newCapacity, newCapacity)
+		copy(t, m.texts)
+		m.texts = t
+	}
+	m.texts[m.xxx_LenTexts] = value
+	m.xxx_LenTexts += 1
+	return nil
+}
+
+func (m *EchoResponse) SetTexts(value string, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTexts {
+		return errors.New("Index is out of bounds")
+	}
+	m.texts[index] = value
+	return nil
+}
+
+func (m *EchoResponse) TextsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenTexts
+	}
+	return 0
+}
+
+func (m *EchoResponse) ClearTexts() {
+	if m != nil {
+		m.xxx_LenTexts = 0
+	}
+}
+
+func (m *EchoResponse) GetTexts(index int) (field string, err error) {
+	if m == nil {
+		return "", errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTexts {
+		return "", errors.New("Index is out of bounds")
+	}
+	return m.texts[index], nil
+}
+
+func (m *EchoResponse) Clear() {
+	if m != nil {
+		m.ClearTexts()
+	}
+}
+
+func (m *EchoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsTextSet {
+		l = len(m.text)
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.xxx_IsRepeatSet {
+		n += 1 + sovService(uint64(uint32(m.repeat)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *EchoResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenTexts > 0 {
+		for i := 0; i < m.xxx_LenTexts; i++ {
+			s := m.texts[i]
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EchoRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EchoRequest) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *EchoRequest) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsTextSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintService(data, i, uint64(len(m.text)))
+		i += copy(data[i:], m.text)
+	}
+	if m.xxx_IsRepeatSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintService(data, i, uint64(uint32(m.repeat)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *EchoResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EchoResponse) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *EchoResponse) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenTexts > 0 {
+		for idx := 0; idx < m.xxx_LenTexts; idx++ {
+			s := m.texts[idx]
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Service(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Service(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintService(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *EchoRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field text", wireType)
+			}
+			m.xxx_IsTextSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.text = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field repeat", wireType)
+			}
+			m.xxx_IsRepeatSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.repeat |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *EchoResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field texts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.texts) <= m.xxx_LenTexts {
+				newCapacity := 0
+				if len(m.texts) == 0 {
+					newCapacity = 8
+				} else if len(m.texts) < 1000000 {
+					newCapacity = m.xxx_LenTexts * 2
+				} else {
+					newCapacity = m.xxx_LenTexts + 1000000
+				}
+				t := make([]string, newCapacity, newCapacity)
+				copy(t, m.texts)
+				m.texts = t
+			}
+			m.texts[m.xxx_LenTexts] = string(data[index:postIndex])
+			m.xxx_LenTexts += 1
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *EchoRequest) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *EchoRequest) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *EchoResponse) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *EchoResponse) CheckInitialized() error {
+	return nil
+}
+
+func (m *EchoRequest) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsTextSet || w.EmitDefaults() {
+		w.Field("text", "text")
+		w.String(m.GetText())
+	}
+	if m.xxx_IsRepeatSet || w.EmitDefaults() {
+		w.Field("repeat", "repeat")
+		w.Int32(m.GetRepeat())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *EchoRequest) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("text", "text"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetText(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("repeat", "repeat"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetRepeat(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *EchoRequest) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *EchoRequest) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *EchoResponse) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_LenTexts > 0 || w.EmitDefaults() {
+		w.Field("texts", "texts")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenTexts; i++ {
+			w.String(m.texts[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *EchoResponse) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("texts", "texts"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddTexts(v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *EchoResponse) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *EchoResponse) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *EchoRequest) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsTextSet {
+		w.Field("text")
+		w.Value(m.text)
+	}
+	if m.xxx_IsRepeatSet {
+		w.Field("repeat")
+		w.Value(m.repeat)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *EchoRequest) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "text":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetText(v)
+	case "repeat":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetRepeat(v)
+	}
+	return false, nil
+}
+
+func (m *EchoResponse) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenTexts; i++ {
+		w.Field("texts")
+		w.Value(m.texts[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *EchoResponse) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "texts":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddTexts(v)
+	}
+	return false, nil
+}
+
+func (m *EchoRequest) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &EchoRequest{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *EchoRequest) MergeFrom(src proto.Message) {
+	s, ok := src.(*EchoRequest)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsTextSet {
+		m.SetText(s.text)
+	}
+	if s.xxx_IsRepeatSet {
+		m.SetRepeat(s.repeat)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *EchoRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*EchoRequest)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsTextSet != o.xxx_IsTextSet {
+		return false
+	}
+	if m.xxx_IsTextSet && m.text != o.text {
+		return false
+	}
+	if m.xxx_IsRepeatSet != o.xxx_IsRepeatSet {
+		return false
+	}
+	if m.xxx_IsRepeatSet && m.repeat != o.repeat {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *EchoResponse) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &EchoResponse{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *EchoResponse) MergeFrom(src proto.Message) {
+	s, ok := src.(*EchoResponse)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenTexts; i++ {
+		m.AddTexts(s.texts[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *EchoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*EchoResponse)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenTexts != o.xxx_LenTexts {
+		return false
+	}
+	for i := 0; i < m.xxx_LenTexts; i++ {
+		if m.texts[i] != o.texts[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionEchoRequest = &proto.MessageInfo{
+	Name: "service.EchoRequest",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "text",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*EchoRequest)(nil).GetText(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*EchoRequest).GetText()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "text", v)
+				}
+				x := m.(*EchoRequest)
+				return x.SetText(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*EchoRequest).HasText()
+			},
+			Clear: func(m proto.Message) {
+				m.(*EchoRequest).ClearText()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "repeat",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*EchoRequest)(nil).GetRepeat(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*EchoRequest).GetRepeat()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "repeat", v)
+				}
+				x := m.(*EchoRequest)
+				return x.SetRepeat(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*EchoRequest).HasRepeat()
+			},
+			Clear: func(m proto.Message) {
+				m.(*EchoRequest).ClearRepeat()
+			},
+		},
+	},
+}
+
+func (m *EchoRequest) ProtoReflect() *proto.MessageInfo {
+	return reflectionEchoRequest
+}
+
+var reflectionEchoResponse = &proto.MessageInfo{
+	Name: "service.EchoResponse",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "texts",
+			Kind:   proto.StringKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*EchoResponse)
+				v := make([]string, x.xxx_LenTexts)
+				copy(v, x.texts)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "texts", v)
+				}
+				x := m.(*EchoResponse)
+				x.ClearTexts()
+				for _, e := range value {
+					if err := x.AddTexts(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*EchoResponse).TextsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*EchoResponse).ClearTexts()
+			},
+		},
+	},
+}
+
+func (m *EchoResponse) ProtoReflect() *proto.MessageInfo {
+	return reflectionEchoResponse
+}
+
+// Client API for Echo service
+
+// Echo repeats the text it is given.
+type EchoClient struct {
+	transport rpc.Transport
+}
+
+func NewEchoClient(transport rpc.Transport) *EchoClient {
+	return &EchoClient{transport}
+}
+
+// Echo returns the request text repeated the requested number of times.
+func (c *EchoClient) Echo(in *EchoRequest) (*EchoResponse, error) {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	data, err = c.transport.Call("/service.Echo/Echo", data)
+	if err != nil {
+		return nil, err
+	}
+	out := new(EchoResponse)
+	if err := proto.Unmarshal(data, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Fail always returns an error.
+func (c *EchoClient) Fail(in *EchoRequest) (*EchoResponse, error) {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	data, err = c.transport.Call("/service.Echo/Fail", data)
+	if err != nil {
+		return nil, err
+	}
+	out := new(EchoResponse)
+	if err := proto.Unmarshal(data, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Echo service
+
+// Echo repeats the text it is given.
+type EchoServer interface {
+	// Echo returns the request text repeated the requested number of times.
+	Echo(in *EchoRequest) (*EchoResponse, error)
+	// Fail always returns an error.
+	Fail(in *EchoRequest) (*EchoResponse, error)
+}
+
+func RegisterEchoServer(s *rpc.Server, srv EchoServer) {
+	s.RegisterService(&_Echo_serviceDesc, srv)
+}
+
+func _Echo_Echo_Handler(srv interface{}, data []byte) ([]byte, error) {
+	in := new(EchoRequest)
+	if err := proto.Unmarshal(data, in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(EchoServer).Echo(in)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, errors.New("rpc: Echo.Echo returned a nil response")
+	}
+	return proto.Marshal(out)
+}
+
+func _Echo_Fail_Handler(srv interface{}, data []byte) ([]byte, error) {
+	in := new(EchoRequest)
+	if err := proto.Unmarshal(data, in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(EchoServer).Fail(in)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, errors.New("rpc: Echo.Fail returned a nil response")
+	}
+	return proto.Marshal(out)
+}
+
+var _Echo_serviceDesc = rpc.ServiceDesc{
+	ServiceName: "service.Echo",
+	HandlerType: (*EchoServer)(nil),
+	Methods: []rpc.MethodDesc{
+		{
+			MethodName: "Echo",
+			Handler:    _Echo_Echo_Handler,
+		},
+		{
+			MethodName: "Fail",
+			Handler:    _Echo_Fail_Handler,
+		},
+	},
+}
+
+func init() {
+	proto.RegisterType((*EchoRequest)(nil), "service.EchoRequest")
+	proto.RegisterType((*EchoResponse)(nil), "service.EchoResponse")
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/dropbox/godropbox/errors"
+	"github.com/dropbox/goprotoc/rpc"
+)
+
+type echoServer struct{}
+
+func (echoServer) Echo(in *EchoRequest) (*EchoResponse, error) {
+	out := &EchoResponse{}
+	for i := int32(0); i < in.GetRepeat(); i++ {
+		out.AddTexts(in.GetText())
+	}
+	return out, nil
+}
+
+func (echoServer) Fail(in *EchoRequest) (*EchoResponse, error) {
+	return nil, errors.New("fail: " + in.GetText())
+}
+
+func newClient() *EchoClient {
+	srv := rpc.NewServer()
+	RegisterEchoServer(srv, echoServer{})
+	return NewEchoClient(srv)
+}
+
+func TestEcho(t *testing.T) {
+	client := newClient()
+	in := &EchoRequest{}
+	in.SetText("hello")
+	in.SetRepeat(3)
+	out, err := client.Echo(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.TextsSize() != 3 {
+		t.Fatalf("expected 3 texts, got %d", out.TextsSize())
+	}
+	for i := 0; i < out.TextsSize(); i++ {
+		text, err := out.GetTexts(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if text != "hello" {
+			t.Fatalf("expected hello, got %q", text)
+		}
+	}
+}
+
+func TestFail(t *testing.T) {
+	client := newClient()
+	in := &EchoRequest{}
+	in.SetText("oops")
+	out, err := client.Fail(in)
+	if err == nil {
+		t.Fatalf("expected an error, got %v", out)
+	}
+	if errors.GetMessage(err) != "fail: oops" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestUnknownMethod(t *testing.T) {
+	srv := rpc.NewServer()
+	RegisterEchoServer(srv, echoServer{})
+	if _, err := srv.Call("/service.Echo/Missing", nil); err == nil {
+		t.Fatalf("expected an error for an unknown method")
+	}
+	if _, err := srv.Call("/service.Missing/Echo", nil); err == nil {
+		t.Fatalf("expected an error for an unknown service")
+	}
+	for _, method := range []string{"service.Echo.Echo", "/", "Svc/M"} {
+		if _, err := srv.Call(method, nil); err == nil {
+			t.Fatalf("expected an error for the malformed method name %q", method)
+		}
+	}
+}
+
+func TestRegisterWrongImplementation(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected a panic for an implementation of the wrong interface")
+		}
+	}()
+	rpc.NewServer().RegisterService(&_Echo_serviceDesc, struct{}{})
+}
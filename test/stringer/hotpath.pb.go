@@ -0,0 +1,990 @@
+// Code generated by protoc-gen-dgo.
+// source: hotpath.proto
+// DO NOT EDIT!
+
+package stringer
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+import strings "strings"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Sample struct {
+	xxx_sizeCached   int
+	value            int64
+	XXX_unrecognized []byte
+	xxx_IsValueSet   bool
+}
+
+func (m *Sample) Reset()      { *m = Sample{} }
+func (*Sample) ProtoMessage() {}
+
+func (m *Sample) GetValue() int64 {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return 0
+}
+
+func (m *Sample) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Sample) SetValue(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsValueSet = true
+	m.value = value
+	return nil
+}
+
+func (m *Sample) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Sample) ClearValue() {
+	if m != nil {
+		m.xxx_IsValueSet = false
+	}
+}
+
+func (m *Sample) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+type Summary struct {
+	xxx_sizeCached   int
+	count            int64
+	XXX_unrecognized []byte
+	xxx_IsCountSet   bool
+}
+
+func (m *Summary) Reset()         { *m = Summary{} }
+func (m *Summary) String() string { return proto.CompactTextString(m) }
+func (*Summary) ProtoMessage()    {}
+
+func (m *Summary) GetCount() int64 {
+	if m != nil && m.xxx_IsCountSet {
+		return m.count
+	}
+	return 0
+}
+
+func (m *Summary) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Summary) SetCount(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsCountSet = true
+	m.count = value
+	return nil
+}
+
+func (m *Summary) HasCount() (isSet bool) {
+	if m != nil && m.xxx_IsCountSet {
+		return true
+	}
+	return false
+}
+
+func (m *Summary) ClearCount() {
+	if m != nil {
+		m.xxx_IsCountSet = false
+	}
+}
+
+func (m *Summary) Clear() {
+	if m != nil {
+		m.ClearCount()
+	}
+}
+
+type Detailed struct {
+	xxx_sizeCached   int
+	count            int64
+	XXX_unrecognized []byte
+	xxx_IsCountSet   bool
+}
+
+func (m *Detailed) Reset()      { *m = Detailed{} }
+func (*Detailed) ProtoMessage() {}
+
+func (m *Detailed) GetCount() int64 {
+	if m != nil && m.xxx_IsCountSet {
+		return m.count
+	}
+	return 0
+}
+
+func (m *Detailed) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Detailed) SetCount(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsCountSet = true
+	m.count = value
+	return nil
+}
+
+func (m *Detailed) HasCount() (isSet bool) {
+	if m != nil && m.xxx_IsCountSet {
+		return true
+	}
+	return false
+}
+
+func (m *Detailed) ClearCount() {
+	if m != nil {
+		m.xxx_IsCountSet = false
+	}
+}
+
+func (m *Detailed) Clear() {
+	if m != nil {
+		m.ClearCount()
+	}
+}
+
+func (m *Sample) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsValueSet {
+		n += 1 + sovHotpath(uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Summary) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsCountSet {
+		n += 1 + sovHotpath(uint64(m.count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Detailed) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsCountSet {
+		n += 1 + sovHotpath(uint64(m.count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovHotpath(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozHotpath(x uint64) (n int) {
+	return sovHotpath(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sample) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Sample) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Sample) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsValueSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintHotpath(data, i, uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Summary) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Summary) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Summary) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsCountSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintHotpath(data, i, uint64(m.count))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Detailed) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Detailed) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Detailed) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsCountSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintHotpath(data, i, uint64(m.count))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Hotpath(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Hotpath(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintHotpath(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Sample) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			m.xxx_IsValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Summary) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field count", wireType)
+			}
+			m.xxx_IsCountSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Detailed) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field count", wireType)
+			}
+			m.xxx_IsCountSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Sample) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Sample) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Summary) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Summary) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Detailed) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Detailed) CheckInitialized() error {
+	return nil
+}
+
+func (m *Sample) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsValueSet || w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Int64(m.GetValue())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Sample) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetValue(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Sample) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Sample) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Summary) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsCountSet || w.EmitDefaults() {
+		w.Field("count", "count")
+		w.Int64(m.GetCount())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Summary) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("count", "count"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetCount(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Summary) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Summary) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Detailed) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsCountSet || w.EmitDefaults() {
+		w.Field("count", "count")
+		w.Int64(m.GetCount())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Detailed) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("count", "count"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetCount(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Detailed) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Detailed) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Sample) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Sample) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Summary) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsCountSet {
+		w.Field("count")
+		w.Value(m.count)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Summary) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "count":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetCount(v)
+	}
+	return false, nil
+}
+
+func (m *Detailed) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsCountSet {
+		w.Field("count")
+		w.Value(m.count)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Detailed) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "count":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetCount(v)
+	}
+	return false, nil
+}
+
+func (m *Sample) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Sample{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Sample) MergeFrom(src proto.Message) {
+	s, ok := src.(*Sample)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsValueSet {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Sample) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Sample)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Summary) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Summary{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Summary) MergeFrom(src proto.Message) {
+	s, ok := src.(*Summary)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsCountSet {
+		m.SetCount(s.count)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Summary) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Summary)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsCountSet != o.xxx_IsCountSet {
+		return false
+	}
+	if m.xxx_IsCountSet && m.count != o.count {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Detailed) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Detailed{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Detailed) MergeFrom(src proto.Message) {
+	s, ok := src.(*Detailed)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsCountSet {
+		m.SetCount(s.count)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Detailed) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Detailed)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsCountSet != o.xxx_IsCountSet {
+		return false
+	}
+	if m.xxx_IsCountSet && m.count != o.count {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionSample = &proto.MessageInfo{
+	Name: "stringer.Sample",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Sample)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Sample).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Sample)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Sample).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Sample).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Sample) ProtoReflect() *proto.MessageInfo {
+	return reflectionSample
+}
+
+var reflectionSummary = &proto.MessageInfo{
+	Name: "stringer.Summary",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "count",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Summary)(nil).GetCount(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Summary).GetCount()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "count", v)
+				}
+				x := m.(*Summary)
+				return x.SetCount(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Summary).HasCount()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Summary).ClearCount()
+			},
+		},
+	},
+}
+
+func (m *Summary) ProtoReflect() *proto.MessageInfo {
+	return reflectionSummary
+}
+
+var reflectionDetailed = &proto.MessageInfo{
+	Name: "stringer.Detailed",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "count",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Detailed)(nil).GetCount(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Detailed).GetCount()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "count", v)
+				}
+				x := m.(*Detailed)
+				return x.SetCount(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Detailed).HasCount()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Detailed).ClearCount()
+			},
+		},
+	},
+}
+
+func (m *Detailed) ProtoReflect() *proto.MessageInfo {
+	return reflectionDetailed
+}
+
+func init() {
+	proto.RegisterType((*Sample)(nil), "stringer.Sample")
+	proto.RegisterType((*Summary)(nil), "stringer.Summary")
+	proto.RegisterType((*Detailed)(nil), "stringer.Detailed")
+}
+func (this *Detailed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Detailed{`,
+		`count:` + fmt.Sprintf("%v", this.GetCount()) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
@@ -0,0 +1,1131 @@
+// Code generated by protoc-gen-dgo.
+// source: stringer.proto
+// DO NOT EDIT!
+
+/*
+Package stringer is a generated protocol buffer package.
+
+It is generated from these files:
+
+	stringer.proto
+	hotpath.proto
+
+It has these top-level messages:
+
+	Point
+	Path
+*/
+package stringer
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Point struct {
+	xxx_sizeCached   int
+	x                int32
+	y                int32
+	label            string
+	XXX_unrecognized []byte
+	xxx_IsXSet       bool
+	xxx_IsYSet       bool
+	xxx_IsLabelSet   bool
+}
+
+func (m *Point) Reset()         { *m = Point{} }
+func (m *Point) String() string { return proto.CompactTextString(m) }
+func (*Point) ProtoMessage()    {}
+
+func (m *Point) GetX() int32 {
+	if m != nil && m.xxx_IsXSet {
+		return m.x
+	}
+	return 0
+}
+
+func (m *Point) GetY() int32 {
+	if m != nil && m.xxx_IsYSet {
+		return m.y
+	}
+	return 0
+}
+
+func (m *Point) GetLabel() string {
+	if m != nil && m.xxx_IsLabelSet {
+		return m.label
+	}
+	return ""
+}
+
+func (m *Point) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Point) SetX(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsXSet = true
+	m.x = value
+	return nil
+}
+
+func (m *Point) HasX() (isSet bool) {
+	if m != nil && m.xxx_IsXSet {
+		return true
+	}
+	return false
+}
+
+func (m *Point) ClearX() {
+	if m != nil {
+		m.xxx_IsXSet = false
+	}
+}
+
+func (m *Point) SetY(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsYSet = true
+	m.y = value
+	return nil
+}
+
+func (m *Point) HasY() (isSet bool) {
+	if m != nil && m.xxx_IsYSet {
+		return true
+	}
+	return false
+}
+
+func (m *Point) ClearY() {
+	if m != nil {
+		m.xxx_IsYSet = false
+	}
+}
+
+func (m *Point) SetLabel(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsLabelSet = true
+	m.label = value
+	return nil
+}
+
+func (m *Point) HasLabel() (isSet bool) {
+	if m != nil && m.xxx_IsLabelSet {
+		return true
+	}
+	return false
+}
+
+func (m *Point) ClearLabel() {
+	if m != nil {
+		m.xxx_IsLabelSet = false
+		m.label = ""
+	}
+}
+
+func (m *Point) Clear() {
+	if m != nil {
+		m.ClearX()
+		m.ClearY()
+		m.ClearLabel()
+	}
+}
+
+type Path struct {
+	xxx_sizeCached   int
+	points           []*Point
+	closed           bool
+	XXX_unrecognized []byte
+	xxx_LenPoints    int
+	xxx_IsClosedSet  bool
+}
+
+func (m *Path) Reset()         { *m = Path{} }
+func (m *Path) String() string { return proto.CompactTextString(m) }
+func (*Path) ProtoMessage()    {}
+
+func (m *Path) GetClosed() bool {
+	if m != nil && m.xxx_IsClosedSet {
+		return m.closed
+	}
+	return false
+}
+
+func (m *Path) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Path) AddPoints() (field *Point, err error) {
+	if m != nil {
+		if len(m.points) <= m.xxx_LenPoints {
+			newCapacity := 0
+			if len(m.points) == 0 {
+				newCapacity = 8
+			} else if len(m.points) < 1000000 {
+				newCapacity = m.xxx_LenPoints * 2
+			} else {
+				newCapacity = m.xxx_LenPoints + 1000000
+			}
+			t := make([]*Point, newCapacity, newCapacity)
+			copy(t, m.points)
+			m.points = t
+		}
+		field = m.points[m.xxx_LenPoints]
+		if field == nil {
+			field = new(Point)
+			m.points[m.xxx_LenPoints] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenPoints += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Path) MutatePoints(index int) (field *Point, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPoints {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.points[index] == nil {
+		m.points[index] = new(Point)
+	}
+	return m.points[index], nil
+}
+
+func (m *Path) PointsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenPoints
+	}
+	return 0
+}
+
+func (m *Path) ClearPoints() {
+	if m != nil {
+		for i := 0; i < m.PointsSize(); i++ {
+			m.points[i].Clear()
+		}
+		m.xxx_LenPoints = 0
+
+	}
+}
+
+func (m *Path) GetPoints(index int) (field *Point, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenPoints {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.points[index], nil
+}
+
+func (m *Path) SetClosed(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsClosedSet = true
+	m.closed = value
+	return nil
+}
+
+func (m *Path) HasClosed() (isSet bool) {
+	if m != nil && m.xxx_IsClosedSet {
+		return true
+	}
+	return false
+}
+
+func (m *Path) ClearClosed() {
+	if m != nil {
+		m.xxx_IsClosedSet = false
+	}
+}
+
+func (m *Path) Clear() {
+	if m != nil {
+		for i := 0; i < m.PointsSize(); i++ {
+			m.points[i].Clear()
+		}
+		m.xxx_LenPoints = 0
+
+		m.ClearClosed()
+	}
+}
+
+func (m *Point) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsXSet {
+		n += 1 + sovStringer(uint64(uint32(m.x)))
+	}
+	if m.xxx_IsYSet {
+		n += 1 + sovStringer(uint64(uint32(m.y)))
+	}
+	if m.xxx_IsLabelSet {
+		l = len(m.label)
+		n += 1 + l + sovStringer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Path) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenPoints > 0 {
+		for i := 0; i < m.xxx_LenPoints; i++ {
+			e := m.points[i]
+			l = e.Size()
+			n += 1 + l + sovStringer(uint64(l))
+		}
+	}
+	if m.xxx_IsClosedSet {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovStringer(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStringer(x uint64) (n int) {
+	return sovStringer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Point) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Point) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Point) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsXSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintStringer(data, i, uint64(uint32(m.x)))
+	}
+	if m.xxx_IsYSet {
+		data[i] = 0x10
+		i++
+		i = encodeVarintStringer(data, i, uint64(uint32(m.y)))
+	}
+	if m.xxx_IsLabelSet {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStringer(data, i, uint64(len(m.label)))
+		i += copy(data[i:], m.label)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Path) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Path) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Path) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenPoints > 0 {
+		for idx := 0; idx < m.xxx_LenPoints; idx++ {
+			msg := m.points[idx]
+			data[i] = 0xa
+			i++
+			i = encodeVarintStringer(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.xxx_IsClosedSet {
+		data[i] = 0x10
+		i++
+		if m.closed {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Stringer(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Stringer(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintStringer(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Point) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field x", wireType)
+			}
+			m.xxx_IsXSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.x |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field y", wireType)
+			}
+			m.xxx_IsYSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.y |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field label", wireType)
+			}
+			m.xxx_IsLabelSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.label = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Path) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddPoints()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field closed", wireType)
+			}
+			m.xxx_IsClosedSet = true
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.closed = bool(bool(v != 0))
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Point) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Point) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Path) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Path) CheckInitialized() error {
+	return nil
+}
+
+func (m *Point) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsXSet || w.EmitDefaults() {
+		w.Field("x", "x")
+		w.Int32(m.GetX())
+	}
+	if m.xxx_IsYSet || w.EmitDefaults() {
+		w.Field("y", "y")
+		w.Int32(m.GetY())
+	}
+	if m.xxx_IsLabelSet || w.EmitDefaults() {
+		w.Field("label", "label")
+		w.String(m.GetLabel())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Point) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("x", "x"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetX(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("y", "y"); ok {
+		v, err := jsonpb.Int32(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetY(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("label", "label"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetLabel(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Point) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Point) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Path) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_LenPoints > 0 || w.EmitDefaults() {
+		w.Field("points", "points")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenPoints; i++ {
+			w.Message(m.points[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_IsClosedSet || w.EmitDefaults() {
+		w.Field("closed", "closed")
+		w.Bool(m.GetClosed())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Path) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("points", "points"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddPoints()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("closed", "closed"); ok {
+		v, err := jsonpb.Bool(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetClosed(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Path) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Path) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Point) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsXSet {
+		w.Field("x")
+		w.Value(m.x)
+	}
+	if m.xxx_IsYSet {
+		w.Field("y")
+		w.Value(m.y)
+	}
+	if m.xxx_IsLabelSet {
+		w.Field("label")
+		w.Value(m.label)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Point) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "x":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetX(v)
+	case "y":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetY(v)
+	case "label":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetLabel(v)
+	}
+	return false, nil
+}
+
+func (m *Path) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenPoints; i++ {
+		w.Field("points")
+		w.Message(m.points[i])
+	}
+	if m.xxx_IsClosedSet {
+		w.Field("closed")
+		w.Value(m.closed)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Path) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "points":
+		v, err := m.AddPoints()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "closed":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetClosed(v)
+	}
+	return false, nil
+}
+
+func (m *Point) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Point{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Point) MergeFrom(src proto.Message) {
+	s, ok := src.(*Point)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsXSet {
+		m.SetX(s.x)
+	}
+	if s.xxx_IsYSet {
+		m.SetY(s.y)
+	}
+	if s.xxx_IsLabelSet {
+		m.SetLabel(s.label)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Point) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Point)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsXSet != o.xxx_IsXSet {
+		return false
+	}
+	if m.xxx_IsXSet && m.x != o.x {
+		return false
+	}
+	if m.xxx_IsYSet != o.xxx_IsYSet {
+		return false
+	}
+	if m.xxx_IsYSet && m.y != o.y {
+		return false
+	}
+	if m.xxx_IsLabelSet != o.xxx_IsLabelSet {
+		return false
+	}
+	if m.xxx_IsLabelSet && m.label != o.label {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Path) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Path{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Path) MergeFrom(src proto.Message) {
+	s, ok := src.(*Path)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenPoints; i++ {
+		v, _ := m.AddPoints()
+		v.MergeFrom(s.points[i])
+	}
+	if s.xxx_IsClosedSet {
+		m.SetClosed(s.closed)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Path) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Path)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenPoints != o.xxx_LenPoints {
+		return false
+	}
+	for i := 0; i < m.xxx_LenPoints; i++ {
+		if !m.points[i].Equal(o.points[i]) {
+			return false
+		}
+	}
+	if m.xxx_IsClosedSet != o.xxx_IsClosedSet {
+		return false
+	}
+	if m.xxx_IsClosedSet && m.closed != o.closed {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionPoint = &proto.MessageInfo{
+	Name: "stringer.Point",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "x",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Point)(nil).GetX(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Point).GetX()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "x", v)
+				}
+				x := m.(*Point)
+				return x.SetX(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Point).HasX()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Point).ClearX()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "y",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Point)(nil).GetY(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Point).GetY()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "y", v)
+				}
+				x := m.(*Point)
+				return x.SetY(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Point).HasY()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Point).ClearY()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "label",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Point)(nil).GetLabel(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Point).GetLabel()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "label", v)
+				}
+				x := m.(*Point)
+				return x.SetLabel(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Point).HasLabel()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Point).ClearLabel()
+			},
+		},
+	},
+}
+
+func (m *Point) ProtoReflect() *proto.MessageInfo {
+	return reflectionPoint
+}
+
+var reflectionPath = &proto.MessageInfo{
+	Name: "stringer.Path",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "points",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Point)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Path)
+				v := make([]*Point, x.xxx_LenPoints)
+				copy(v, x.points)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Point)
+				if !ok {
+					return proto.NewFieldTypeError(m, "points", v)
+				}
+				x := m.(*Path)
+				x.ClearPoints()
+				for _, e := range value {
+					field, err := x.AddPoints()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Path).PointsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Path).ClearPoints()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "closed",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Path)(nil).GetClosed(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Path).GetClosed()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "closed", v)
+				}
+				x := m.(*Path)
+				return x.SetClosed(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Path).HasClosed()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Path).ClearClosed()
+			},
+		},
+	},
+}
+
+func (m *Path) ProtoReflect() *proto.MessageInfo {
+	return reflectionPath
+}
+
+func init() {
+	proto.RegisterType((*Point)(nil), "stringer.Point")
+	proto.RegisterType((*Path)(nil), "stringer.Path")
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package stringer
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	path := &Path{}
+	p, _ := path.AddPoints()
+	p.SetX(1)
+	p.SetY(-2)
+	p, _ = path.AddPoints()
+	p.SetLabel("end")
+	path.SetClosed(true)
+
+	want := `points:<x:1 y:-2 > points:<label:"end" > closed:true `
+	if got := path.String(); got != want {
+		t.Fatalf("String want %q got %q", want, got)
+	}
+	if got := fmt.Sprint(path); got != want {
+		t.Fatalf("Sprint want %q got %q", want, got)
+	}
+	if got := (&Point{}).String(); got != "" {
+		t.Fatalf("empty message String got %q", got)
+	}
+}
+
+func TestStringerOptOut(t *testing.T) {
+	m := &Sample{}
+	m.SetValue(7)
+	if got, want := fmt.Sprint(m), "sample(7)"; got != want {
+		t.Fatalf("String want %q got %q", want, got)
+	}
+	s := &Summary{}
+	s.SetCount(3)
+	if got, want := s.String(), "count:3 "; got != want {
+		t.Fatalf("String want %q got %q", want, got)
+	}
+}
+
+func TestStringerPlugin(t *testing.T) {
+	m := &Detailed{}
+	m.SetCount(3)
+	if got, want := m.String(), "&Detailed{count:3,XXX_unrecognized:[],}"; got != want {
+		t.Fatalf("String want %q got %q", want, got)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package text
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newOuter() *Outer {
+	m := &Outer{}
+	m.SetIntValue(-1)
+	m.SetLongValue(2)
+	m.SetDoubleValue(0.5)
+	m.SetBoolValue(true)
+	m.SetStringValue("say \"hi\"\n")
+	m.SetBytesValue([]byte{0, 1, 'a'})
+	m.SetColor(Color_BLUE)
+	inner, _ := m.MutateInner()
+	inner.SetName("in")
+	m.AddLongs(3)
+	m.AddLongs(4)
+	inner, _ = m.AddInners()
+	inner.SetNumber(5)
+	named := &Inner{}
+	named.SetName("n")
+	m.PutNamed("b", named)
+	m.PutNamed("a", &Inner{})
+	m.SetText("text")
+	return m
+}
+
+const outerText = `int_value: -1
+long_value: 2
+double_value: 0.5
+bool_value: true
+string_value: "say \"hi\"\n"
+bytes_value: "\000\001a"
+color: BLUE
+inner: <
+  name: "in"
+>
+longs: 3
+longs: 4
+inners: <
+  number: 5
+>
+named: <
+  key: "a"
+  value: <
+  >
+>
+named: <
+  key: "b"
+  value: <
+    name: "n"
+  >
+>
+text: "text"
+`
+
+func TestMarshalText(t *testing.T) {
+	s := proto.MarshalTextString(newOuter())
+	if s != outerText {
+		t.Fatalf("expected\n%s\ngot\n%s", outerText, s)
+	}
+}
+
+func TestCompactText(t *testing.T) {
+	m := &Outer{}
+	m.SetIntValue(1)
+	inner, _ := m.MutateNested()
+	inner.SetName("x")
+	s := proto.CompactTextString(m)
+	if s != `int_value:1 nested:<name:"x" > ` {
+		t.Fatalf("unexpected output %q", s)
+	}
+}
+
+func equal(t *testing.T, m1 *Outer, m2 *Outer) bool {
+	data1, err := m1.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data2, err := m2.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Equal(data1, data2)
+}
+
+func TestRoundTrip(t *testing.T) {
+	m := &Outer{}
+	if err := proto.UnmarshalText(outerText, m); err != nil {
+		t.Fatal(err)
+	}
+	if !equal(t, m, newOuter()) {
+		t.Fatalf("round trip mismatch:\n%s", proto.MarshalTextString(m))
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	m := &Outer{}
+	input := `int_value: 0x10; color: 1, inner { number: 7 } longs: 1 longs: 2 nested: < name: "x" >`
+	if err := proto.UnmarshalText(input, m); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetIntValue() != 16 || m.GetColor() != Color_GREEN || m.GetInner().GetNumber() != 7 ||
+		m.LongsSize() != 2 || m.GetNested().GetName() != "x" {
+		t.Fatalf("unexpected message:\n%s", proto.MarshalTextString(m))
+	}
+	for _, input := range []string{
+		`unknown: 1`,
+		`int_value: "x"`,
+		`int_value 1`,
+		`color: PURPLE`,
+		`inner: 1`,
+	} {
+		if err := proto.UnmarshalText(input, &Outer{}); err == nil {
+			t.Fatalf("expected an error for %s", input)
+		}
+	}
+}
+
+func TestExtensionsAndUnknown(t *testing.T) {
+	m := &Outer{}
+	m.SetIntValue(1)
+	if err := proto.SetExtension(m, E_Extra, proto.Int32(9)); err != nil {
+		t.Fatal(err)
+	}
+	m.XXX_unrecognized = []byte{0xf8, 0x01, 0x05}
+	s := proto.CompactTextString(m)
+	if s != `int_value:1 [text.extra]:9 31:5 ` {
+		t.Fatalf("unexpected output %q", s)
+	}
+	m2 := &Outer{}
+	if err := proto.UnmarshalText(`[text.extra]: 9`, m2); err != nil {
+		t.Fatal(err)
+	}
+	v, err := proto.GetExtension(m2, E_Extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *v.(*int32) != 9 {
+		t.Fatalf("expected 9, got %d", *v.(*int32))
+	}
+}
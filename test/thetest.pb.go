@@ -9574,7 +9574,7 @@ func (m *MyExtendable) Reset()      { *m = MyExtendable{} }
 func (*MyExtendable) ProtoMessage() {}
 
 var extRange_MyExtendable = []proto.ExtensionRange{
-	{100, 199},
+	{Start: 100, End: 199},
 }
 
 func (m *MyExtendable) ExtensionRangeArray() []proto.ExtensionRange {
@@ -9642,8 +9642,8 @@ func (m *OtherExtenable) Reset()      { *m = OtherExtenable{} }
 func (*OtherExtenable) ProtoMessage() {}
 
 var extRange_OtherExtenable = []proto.ExtensionRange{
-	{14, 16},
-	{10, 12},
+	{Start: 14, End: 16},
+	{Start: 10, End: 12},
 }
 
 func (m *OtherExtenable) ExtensionRangeArray() []proto.ExtensionRange {
@@ -13467,7 +13467,7 @@ func (m *NoExtensionsMap) Reset()      { *m = NoExtensionsMap{} }
 func (*NoExtensionsMap) ProtoMessage() {}
 
 var extRange_NoExtensionsMap = []proto.ExtensionRange{
-	{100, 199},
+	{Start: 100, End: 199},
 }
 
 func (m *NoExtensionsMap) ExtensionRangeArray() []proto.ExtensionRange {
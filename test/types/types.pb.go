@@ -0,0 +1,1367 @@
+// Code generated by protoc-gen-dgo.
+// source: types.proto
+// DO NOT EDIT!
+
+/*
+Package types is a generated protocol buffer package.
+
+It is generated from these files:
+
+	types.proto
+
+It has these top-level messages:
+
+	Event
+*/
+package types
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+// renamed import github.com/dropbox/goprotoc/protobuf/google/protobuf/any.pb to github.com/dropbox/goprotoc/types
+import google_protobuf1 "github.com/dropbox/goprotoc/types"
+
+// renamed import github.com/dropbox/goprotoc/protobuf/google/protobuf/duration.pb to github.com/dropbox/goprotoc/types
+import google_protobuf2 "github.com/dropbox/goprotoc/types"
+
+// renamed import github.com/dropbox/goprotoc/protobuf/google/protobuf/struct.pb to github.com/dropbox/goprotoc/types
+import google_protobuf3 "github.com/dropbox/goprotoc/types"
+
+// renamed import github.com/dropbox/goprotoc/protobuf/google/protobuf/timestamp.pb to github.com/dropbox/goprotoc/types
+import google_protobuf4 "github.com/dropbox/goprotoc/types"
+
+// renamed import github.com/dropbox/goprotoc/protobuf/google/protobuf/wrappers.pb to github.com/dropbox/goprotoc/types
+import google_protobuf5 "github.com/dropbox/goprotoc/types"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Event struct {
+	xxx_sizeCached      int
+	name                string
+	at                  *google_protobuf4.Timestamp
+	took                *google_protobuf2.Duration
+	detail              *google_protobuf1.Any
+	attributes          *google_protobuf3.Struct
+	retries             *google_protobuf5.Int32Value
+	tags                []*google_protobuf5.StringValue
+	XXX_unrecognized    []byte
+	xxx_IsNameSet       bool
+	xxx_IsAtSet         bool
+	xxx_IsTookSet       bool
+	xxx_IsDetailSet     bool
+	xxx_IsAttributesSet bool
+	xxx_IsRetriesSet    bool
+	xxx_LenTags         int
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+
+func (m *Event) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Event) GetAt() *google_protobuf4.Timestamp {
+	if m != nil && m.xxx_IsAtSet {
+		return m.at
+	}
+	return nil
+}
+func (m *Event) GetTook() *google_protobuf2.Duration {
+	if m != nil && m.xxx_IsTookSet {
+		return m.took
+	}
+	return nil
+}
+func (m *Event) GetDetail() *google_protobuf1.Any {
+	if m != nil && m.xxx_IsDetailSet {
+		return m.detail
+	}
+	return nil
+}
+func (m *Event) GetAttributes() *google_protobuf3.Struct {
+	if m != nil && m.xxx_IsAttributesSet {
+		return m.attributes
+	}
+	return nil
+}
+func (m *Event) GetRetries() *google_protobuf5.Int32Value {
+	if m != nil && m.xxx_IsRetriesSet {
+		return m.retries
+	}
+	return nil
+}
+func (m *Event) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Event) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Event) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Event) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Event) MutateAt() (field *google_protobuf4.Timestamp, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsAtSet {
+		m.xxx_IsAtSet = true
+		if m.at == nil {
+			m.at = new(google_protobuf4.Timestamp)
+		} else {
+			m.at.Clear()
+		}
+	}
+	return m.at, nil
+}
+
+func (m *Event) HasAt() (isSet bool) {
+	if m != nil && m.xxx_IsAtSet {
+		return true
+	}
+	return false
+}
+
+func (m *Event) ClearAt() {
+	if m != nil {
+		m.at.Clear()
+		m.xxx_IsAtSet = false
+
+	}
+}
+
+func (m *Event) MutateTook() (field *google_protobuf2.Duration, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsTookSet {
+		m.xxx_IsTookSet = true
+		if m.took == nil {
+			m.took = new(google_protobuf2.Duration)
+		} else {
+			m.took.Clear()
+		}
+	}
+	return m.took, nil
+}
+
+func (m *Event) HasTook() (isSet bool) {
+	if m != nil && m.xxx_IsTookSet {
+		return true
+	}
+	return false
+}
+
+func (m *Event) ClearTook() {
+	if m != nil {
+		m.took.Clear()
+		m.xxx_IsTookSet = false
+
+	}
+}
+
+func (m *Event) MutateDetail() (field *google_protobuf1.Any, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsDetailSet {
+		m.xxx_IsDetailSet = true
+		if m.detail == nil {
+			m.detail = new(google_protobuf1.Any)
+		} else {
+			m.detail.Clear()
+		}
+	}
+	return m.detail, nil
+}
+
+func (m *Event) HasDetail() (isSet bool) {
+	if m != nil && m.xxx_IsDetailSet {
+		return true
+	}
+	return false
+}
+
+func (m *Event) ClearDetail() {
+	if m != nil {
+		m.detail.Clear()
+		m.xxx_IsDetailSet = false
+
+	}
+}
+
+func (m *Event) MutateAttributes() (field *google_protobuf3.Struct, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsAttributesSet {
+		m.xxx_IsAttributesSet = true
+		if m.attributes == nil {
+			m.attributes = new(google_protobuf3.Struct)
+		} else {
+			m.attributes.Clear()
+		}
+	}
+	return m.attributes, nil
+}
+
+func (m *Event) HasAttributes() (isSet bool) {
+	if m != nil && m.xxx_IsAttributesSet {
+		return true
+	}
+	return false
+}
+
+func (m *Event) ClearAttributes() {
+	if m != nil {
+		m.attributes.Clear()
+		m.xxx_IsAttributesSet = false
+
+	}
+}
+
+func (m *Event) MutateRetries() (field *google_protobuf5.Int32Value, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsRetriesSet {
+		m.xxx_IsRetriesSet = true
+		if m.retries == nil {
+			m.retries = new(google_protobuf5.Int32Value)
+		} else {
+			m.retries.Clear()
+		}
+	}
+	return m.retries, nil
+}
+
+func (m *Event) HasRetries() (isSet bool) {
+	if m != nil && m.xxx_IsRetriesSet {
+		return true
+	}
+	return false
+}
+
+func (m *Event) ClearRetries() {
+	if m != nil {
+		m.retries.Clear()
+		m.xxx_IsRetriesSet = false
+
+	}
+}
+
+func (m *Event) AddTags() (field *google_protobuf5.StringValue, err error) {
+	if m != nil {
+		if len(m.tags) <= m.xxx_LenTags {
+			newCapacity := 0
+			if len(m.tags) == 0 {
+				newCapacity = 8
+			} else if len(m.tags) < 1000000 {
+				newCapacity = m.xxx_LenTags * 2
+			} else {
+				newCapacity = m.xxx_LenTags + 1000000
+			}
+			t := make([]*google_protobuf5.StringValue, newCapacity, newCapacity)
+			copy(t, m.tags)
+			m.tags = t
+		}
+		field = m.tags[m.xxx_LenTags]
+		if field == nil {
+			field = new(google_protobuf5.StringValue)
+			m.tags[m.xxx_LenTags] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenTags += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *Event) MutateTags(index int) (field *google_protobuf5.StringValue, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.tags[index] == nil {
+		m.tags[index] = new(google_protobuf5.StringValue)
+	}
+	return m.tags[index], nil
+}
+
+func (m *Event) TagsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenTags
+	}
+	return 0
+}
+
+func (m *Event) ClearTags() {
+	if m != nil {
+		for i := 0; i < m.TagsSize(); i++ {
+			m.tags[i].Clear()
+		}
+		m.xxx_LenTags = 0
+
+	}
+}
+
+func (m *Event) GetTags(index int) (field *google_protobuf5.StringValue, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.tags[index], nil
+}
+
+func (m *Event) Clear() {
+	if m != nil {
+		m.ClearName()
+		m.at.Clear()
+		m.xxx_IsAtSet = false
+
+		m.took.Clear()
+		m.xxx_IsTookSet = false
+
+		m.detail.Clear()
+		m.xxx_IsDetailSet = false
+
+		m.attributes.Clear()
+		m.xxx_IsAttributesSet = false
+
+		m.retries.Clear()
+		m.xxx_IsRetriesSet = false
+
+		for i := 0; i < m.TagsSize(); i++ {
+			m.tags[i].Clear()
+		}
+		m.xxx_LenTags = 0
+
+	}
+}
+
+func (m *Event) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.xxx_IsAtSet {
+		l = m.at.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.xxx_IsTookSet {
+		l = m.took.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.xxx_IsDetailSet {
+		l = m.detail.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.xxx_IsAttributesSet {
+		l = m.attributes.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.xxx_IsRetriesSet {
+		l = m.retries.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.xxx_LenTags > 0 {
+		for i := 0; i < m.xxx_LenTags; i++ {
+			e := m.tags[i]
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Event) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Event) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Event) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintTypes(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsAtSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintTypes(data, i, uint64(m.at.SizeCached()))
+		n1, err := m.at.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_IsTookSet {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintTypes(data, i, uint64(m.took.SizeCached()))
+		n2, err := m.took.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.xxx_IsDetailSet {
+		data[i] = 0x22
+		i++
+		i = encodeVarintTypes(data, i, uint64(m.detail.SizeCached()))
+		n3, err := m.detail.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.xxx_IsAttributesSet {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintTypes(data, i, uint64(m.attributes.SizeCached()))
+		n4, err := m.attributes.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.xxx_IsRetriesSet {
+		data[i] = 0x32
+		i++
+		i = encodeVarintTypes(data, i, uint64(m.retries.SizeCached()))
+		n5, err := m.retries.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.xxx_LenTags > 0 {
+		for idx := 0; idx < m.xxx_LenTags; idx++ {
+			msg := m.tags[idx]
+			data[i] = 0x3a
+			i++
+			i = encodeVarintTypes(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Types(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Types(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintTypes(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Event) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field at", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateAt()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field took", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateTook()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field detail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateDetail()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateAttributes()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateRetries()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddTags()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Event) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Event) CheckInitialized() error {
+	return nil
+}
+
+func (m *Event) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_IsAtSet {
+		w.Field("at", "at")
+		w.Message(m.GetAt())
+	} else if w.EmitDefaults() {
+		w.Field("at", "at")
+		w.Null()
+	}
+	if m.xxx_IsTookSet {
+		w.Field("took", "took")
+		w.Message(m.GetTook())
+	} else if w.EmitDefaults() {
+		w.Field("took", "took")
+		w.Null()
+	}
+	if m.xxx_IsDetailSet {
+		w.Field("detail", "detail")
+		w.Message(m.GetDetail())
+	} else if w.EmitDefaults() {
+		w.Field("detail", "detail")
+		w.Null()
+	}
+	if m.xxx_IsAttributesSet {
+		w.Field("attributes", "attributes")
+		w.Message(m.GetAttributes())
+	} else if w.EmitDefaults() {
+		w.Field("attributes", "attributes")
+		w.Null()
+	}
+	if m.xxx_IsRetriesSet {
+		w.Field("retries", "retries")
+		w.Message(m.GetRetries())
+	} else if w.EmitDefaults() {
+		w.Field("retries", "retries")
+		w.Null()
+	}
+	if m.xxx_LenTags > 0 || w.EmitDefaults() {
+		w.Field("tags", "tags")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenTags; i++ {
+			w.Message(m.tags[i])
+		}
+		w.EndArray()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Event) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("at", "at"); ok {
+		v, err := m.MutateAt()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("took", "took"); ok {
+		v, err := m.MutateTook()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("detail", "detail"); ok {
+		v, err := m.MutateDetail()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("attributes", "attributes"); ok {
+		v, err := m.MutateAttributes()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("retries", "retries"); ok {
+		v, err := m.MutateRetries()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("tags", "tags"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := m.AddTags()
+			if err != nil {
+				return err
+			}
+			if err := u.Message(elem, v); err != nil {
+				return err
+			}
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Event) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Event) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Event) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_IsAtSet {
+		w.Field("at")
+		w.Message(m.at)
+	}
+	if m.xxx_IsTookSet {
+		w.Field("took")
+		w.Message(m.took)
+	}
+	if m.xxx_IsDetailSet {
+		w.Field("detail")
+		w.Message(m.detail)
+	}
+	if m.xxx_IsAttributesSet {
+		w.Field("attributes")
+		w.Message(m.attributes)
+	}
+	if m.xxx_IsRetriesSet {
+		w.Field("retries")
+		w.Message(m.retries)
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		w.Field("tags")
+		w.Message(m.tags[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Event) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "at":
+		v, err := m.MutateAt()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "took":
+		v, err := m.MutateTook()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "detail":
+		v, err := m.MutateDetail()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "attributes":
+		v, err := m.MutateAttributes()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "retries":
+		v, err := m.MutateRetries()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "tags":
+		v, err := m.AddTags()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Event) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Event{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Event) MergeFrom(src proto.Message) {
+	s, ok := src.(*Event)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsAtSet {
+		v, _ := m.MutateAt()
+		v.MergeFrom(s.at)
+	}
+	if s.xxx_IsTookSet {
+		v, _ := m.MutateTook()
+		v.MergeFrom(s.took)
+	}
+	if s.xxx_IsDetailSet {
+		v, _ := m.MutateDetail()
+		v.MergeFrom(s.detail)
+	}
+	if s.xxx_IsAttributesSet {
+		v, _ := m.MutateAttributes()
+		v.MergeFrom(s.attributes)
+	}
+	if s.xxx_IsRetriesSet {
+		v, _ := m.MutateRetries()
+		v.MergeFrom(s.retries)
+	}
+	for i := 0; i < s.xxx_LenTags; i++ {
+		v, _ := m.AddTags()
+		v.MergeFrom(s.tags[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Event) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Event)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsAtSet != o.xxx_IsAtSet {
+		return false
+	}
+	if m.xxx_IsAtSet && !m.at.Equal(o.at) {
+		return false
+	}
+	if m.xxx_IsTookSet != o.xxx_IsTookSet {
+		return false
+	}
+	if m.xxx_IsTookSet && !m.took.Equal(o.took) {
+		return false
+	}
+	if m.xxx_IsDetailSet != o.xxx_IsDetailSet {
+		return false
+	}
+	if m.xxx_IsDetailSet && !m.detail.Equal(o.detail) {
+		return false
+	}
+	if m.xxx_IsAttributesSet != o.xxx_IsAttributesSet {
+		return false
+	}
+	if m.xxx_IsAttributesSet && !m.attributes.Equal(o.attributes) {
+		return false
+	}
+	if m.xxx_IsRetriesSet != o.xxx_IsRetriesSet {
+		return false
+	}
+	if m.xxx_IsRetriesSet && !m.retries.Equal(o.retries) {
+		return false
+	}
+	if m.xxx_LenTags != o.xxx_LenTags {
+		return false
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		if !m.tags[i].Equal(o.tags[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionEvent = &proto.MessageInfo{
+	Name: "types.Event",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Event)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Event).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Event)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Event).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Event).ClearName()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "at",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(google_protobuf4.Timestamp)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Event).GetAt()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*google_protobuf4.Timestamp)
+				if !ok {
+					return proto.NewFieldTypeError(m, "at", v)
+				}
+				x := m.(*Event)
+				x.ClearAt()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateAt()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Event).HasAt()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Event).ClearAt()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "took",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(google_protobuf2.Duration)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Event).GetTook()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*google_protobuf2.Duration)
+				if !ok {
+					return proto.NewFieldTypeError(m, "took", v)
+				}
+				x := m.(*Event)
+				x.ClearTook()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateTook()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Event).HasTook()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Event).ClearTook()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "detail",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(google_protobuf1.Any)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Event).GetDetail()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*google_protobuf1.Any)
+				if !ok {
+					return proto.NewFieldTypeError(m, "detail", v)
+				}
+				x := m.(*Event)
+				x.ClearDetail()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateDetail()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Event).HasDetail()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Event).ClearDetail()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "attributes",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(google_protobuf3.Struct)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Event).GetAttributes()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*google_protobuf3.Struct)
+				if !ok {
+					return proto.NewFieldTypeError(m, "attributes", v)
+				}
+				x := m.(*Event)
+				x.ClearAttributes()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateAttributes()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Event).HasAttributes()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Event).ClearAttributes()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "retries",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(google_protobuf5.Int32Value)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Event).GetRetries()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*google_protobuf5.Int32Value)
+				if !ok {
+					return proto.NewFieldTypeError(m, "retries", v)
+				}
+				x := m.(*Event)
+				x.ClearRetries()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateRetries()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Event).HasRetries()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Event).ClearRetries()
+			},
+		},
+		{
+			Number: 7,
+			Name:   "tags",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(google_protobuf5.StringValue)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Event)
+				v := make([]*google_protobuf5.StringValue, x.xxx_LenTags)
+				copy(v, x.tags)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*google_protobuf5.StringValue)
+				if !ok {
+					return proto.NewFieldTypeError(m, "tags", v)
+				}
+				x := m.(*Event)
+				x.ClearTags()
+				for _, e := range value {
+					field, err := x.AddTags()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Event).TagsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Event).ClearTags()
+			},
+		},
+	},
+}
+
+func (m *Event) ProtoReflect() *proto.MessageInfo {
+	return reflectionEvent
+}
+
+func init() {
+	proto.RegisterType((*Event)(nil), "types.Event")
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package types
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+	"github.com/dropbox/goprotoc/proto"
+	wkt "github.com/dropbox/goprotoc/types"
+)
+
+func TestTimestamp(t *testing.T) {
+	now := time.Date(2014, 7, 1, 12, 30, 15, 21000000, time.FixedZone("CEST", 2*3600))
+	ts, err := wkt.TimestampProto(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts.GetSeconds() != now.Unix() || ts.GetNanos() != 21000000 {
+		t.Errorf("timestamp is %v", ts)
+	}
+	got, err := wkt.TimestampFromProto(ts)
+	if err != nil || !got.Equal(now) || got.Location() != time.UTC {
+		t.Errorf("time is %v, %v, want %v", got, err, now)
+	}
+	if _, err := wkt.TimestampProto(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Errorf("year 10000 is a valid timestamp")
+	}
+	if _, err := wkt.TimestampFromProto(nil); err == nil {
+		t.Errorf("nil is a valid timestamp")
+	}
+}
+
+func TestDuration(t *testing.T) {
+	for _, d := range []time.Duration{0, time.Nanosecond, -1500 * time.Millisecond, 90 * time.Minute} {
+		got, err := wkt.DurationFromProto(wkt.DurationProto(d))
+		if err != nil || got != d {
+			t.Errorf("%v converted to %v, %v", d, got, err)
+		}
+	}
+	d := &wkt.Duration{}
+	d.SetSeconds(1)
+	d.SetNanos(-1)
+	if _, err := wkt.DurationFromProto(d); err == nil {
+		t.Errorf("%v is a valid duration", d)
+	}
+	d.SetSeconds(315576000000)
+	d.SetNanos(0)
+	if _, err := wkt.DurationFromProto(d); err == nil {
+		t.Errorf("%v fits in a time.Duration", d)
+	}
+}
+
+func TestAny(t *testing.T) {
+	e := &Event{}
+	e.SetName("deploy")
+	any, err := wkt.MarshalAny(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if any.GetTypeUrl() != "type.googleapis.com/types.Event" {
+		t.Errorf("type URL is %q", any.GetTypeUrl())
+	}
+	if !wkt.Is(any, &Event{}) || wkt.Is(any, &wkt.Duration{}) {
+		t.Errorf("Is does not match the type of %v", any)
+	}
+	got := &Event{}
+	if err := wkt.UnmarshalAny(any, got); err != nil || !got.Equal(e) {
+		t.Errorf("unpacked %v, %v", got, err)
+	}
+	if err := wkt.UnmarshalAny(any, &wkt.Duration{}); err == nil {
+		t.Errorf("unpacked an Event into a Duration")
+	}
+	m, err := wkt.EmptyAny(any)
+	if _, ok := m.(*Event); !ok || err != nil {
+		t.Errorf("EmptyAny is %T, %v", m, err)
+	}
+	any.SetTypeUrl("type.googleapis.com/types.Missing")
+	if _, err := wkt.EmptyAny(any); err == nil {
+		t.Errorf("EmptyAny of an unregistered type")
+	}
+}
+
+func newEvent(t *testing.T) *Event {
+	e := &Event{}
+	e.SetName("deploy")
+	at, _ := wkt.TimestampProto(time.Date(2014, 7, 1, 10, 0, 20, 21000000, time.UTC))
+	a, _ := e.MutateAt()
+	a.MergeFrom(at)
+	took, _ := e.MutateTook()
+	took.MergeFrom(wkt.DurationProto(-1500 * time.Millisecond))
+	any, err := wkt.MarshalAny(wkt.DurationProto(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	detail, _ := e.MutateDetail()
+	detail.MergeFrom(any)
+	value, err := wkt.NewValue(map[string]interface{}{
+		"ok":    true,
+		"hosts": []interface{}{"a", 2},
+		"none":  nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	attributes, _ := e.MutateAttributes()
+	attributes.MergeFrom(value.GetStructValue())
+	retries, _ := e.MutateRetries()
+	retries.SetValue(3)
+	tag, _ := e.AddTags()
+	tag.SetValue("prod")
+	return e
+}
+
+func TestJSON(t *testing.T) {
+	e := newEvent(t)
+	data, err := jsonpb.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"deploy","at":"2014-07-01T10:00:20.021Z","took":"-1.500s",` +
+		`"detail":{"@type":"type.googleapis.com/google.protobuf.Duration","value":"1s"},` +
+		`"attributes":{"hosts":["a",2],"none":null,"ok":true},"retries":3,"tags":["prod"]}`
+	if string(data) != want {
+		t.Errorf("JSON is\n%s\nwant\n%s", data, want)
+	}
+	got := &Event{}
+	if err := jsonpb.Unmarshal(data, got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(e) {
+		t.Errorf("unmarshaled %v, want %v", got, e)
+	}
+	if v := got.GetAttributes().AsMap(); !reflect.DeepEqual(v["hosts"], []interface{}{"a", float64(2)}) {
+		t.Errorf("attributes are %v", v)
+	}
+}
+
+func TestJSONAnyMessage(t *testing.T) {
+	e := &Event{}
+	e.SetName("inner")
+	any, _ := wkt.MarshalAny(e)
+	outer := &Event{}
+	detail, _ := outer.MutateDetail()
+	detail.MergeFrom(any)
+	data, err := jsonpb.Marshal(outer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"detail":{"@type":"type.googleapis.com/types.Event","name":"inner"}}`
+	if string(data) != want {
+		t.Errorf("JSON is %s, want %s", data, want)
+	}
+	got := &Event{}
+	if err := jsonpb.Unmarshal(data, got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(outer) {
+		t.Errorf("unmarshaled %v, want %v", got, outer)
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"at":"2014-07-01"}`,
+		`{"took":"1.5"}`,
+		`{"took":"1.0000000001s"}`,
+		`{"detail":{"value":"1s"}}`,
+		`{"detail":{"@type":"type.googleapis.com/types.Missing"}}`,
+	} {
+		if err := jsonpb.Unmarshal([]byte(data), &Event{}); err == nil {
+			t.Errorf("unmarshaled %s", data)
+		}
+	}
+	if _, err := jsonpb.Marshal(&wkt.Value{}); err == nil || !strings.Contains(err.Error(), "no kind") {
+		t.Errorf("marshaled an empty Value: %v", err)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	if name := proto.MessageName(&Event{}); name != "types.Event" {
+		t.Errorf("name of Event is %q", name)
+	}
+	if name := proto.MessageName(&wkt.UInt64Value{}); name != "google.protobuf.UInt64Value" {
+		t.Errorf("name of UInt64Value is %q", name)
+	}
+	if typ := proto.MessageType("types.Event"); typ != reflect.TypeOf(&Event{}) {
+		t.Errorf("type of types.Event is %v", typ)
+	}
+	if typ := proto.MessageType("types.Missing"); typ != nil {
+		t.Errorf("type of types.Missing is %v", typ)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("registered types.Event twice")
+		}
+	}()
+	proto.RegisterType((*Event)(nil), "types.Event")
+}
+
+func TestTextAny(t *testing.T) {
+	inner := &Event{}
+	inner.SetName("inner")
+	any, _ := wkt.MarshalAny(inner)
+	e := &Event{}
+	detail, _ := e.MutateDetail()
+	detail.MergeFrom(any)
+	text := proto.MarshalTextString(e)
+	want := "detail: <\n  [type.googleapis.com/types.Event] {\n    name: \"inner\"\n  }\n>\n"
+	if text != want {
+		t.Errorf("text is\n%s\nwant\n%s", text, want)
+	}
+	got := &Event{}
+	if err := proto.UnmarshalText(text, got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(e) {
+		t.Errorf("unmarshaled %v, want %v", got, e)
+	}
+
+	// Messages of unknown types are written as bytes.
+	detail.SetTypeUrl("type.googleapis.com/types.Missing")
+	if text := proto.CompactTextString(e); !strings.Contains(text, `type_url:"type.googleapis.com/types.Missing"`) {
+		t.Errorf("text is %s", text)
+	}
+	if err := proto.UnmarshalText(`detail { [type.googleapis.com/types.Missing] {} }`, got); err == nil {
+		t.Errorf("unmarshaled an unknown type")
+	}
+	if err := proto.UnmarshalText(`[type.googleapis.com/types.Event] {}`, got); err == nil {
+		t.Errorf("unmarshaled a type URL outside of an Any")
+	}
+}
@@ -6,9 +6,11 @@
 Package unrecognizedgroup is a generated protocol buffer package.
 
 It is generated from these files:
+
 	unrecognizedgroup.proto
 
 It has these top-level messages:
+
 	NewNoGroup
 	A
 	OldWithGroup
@@ -18,43 +20,29 @@ package unrecognizedgroup
 import testing "testing"
 import math_rand "math/rand"
 import time "time"
-import dropbox_gogoprotobuf_proto "github.com/dropbox/goprotoc/proto"
+import github_com_dropbox_goprotoc_proto "github.com/dropbox/goprotoc/proto"
 import testing1 "testing"
 import math_rand1 "math/rand"
 import time1 "time"
-import encoding_json "encoding/json"
 import testing2 "testing"
 import math_rand2 "math/rand"
 import time2 "time"
-import dropbox_gogoprotobuf_proto1 "github.com/dropbox/goprotoc/proto"
+import testing3 "testing"
+import github_com_dropbox_goprotoc_proto1 "github.com/dropbox/goprotoc/proto"
 import math_rand3 "math/rand"
 import time3 "time"
-import testing3 "testing"
-import fmt "fmt"
-import math_rand4 "math/rand"
-import time4 "time"
 import testing4 "testing"
-import dropbox_gogoprotobuf_proto2 "github.com/dropbox/goprotoc/proto"
-import math_rand5 "math/rand"
-import time5 "time"
-import testing5 "testing"
 import fmt1 "fmt"
-import go_parser "go/parser"
-import math_rand6 "math/rand"
-import time6 "time"
-import testing6 "testing"
-import dropbox_gogoprotobuf_proto3 "github.com/dropbox/goprotoc/proto"
-import testing7 "testing"
 
 func TestNewNoGroupProto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
 	p := NewPopulatedNewNoGroup(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &NewNoGroup{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -81,7 +69,7 @@ func TestNewNoGroupMarshalTo(t *testing.T) {
 		panic(err)
 	}
 	msg := &NewNoGroup{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -98,12 +86,12 @@ func TestNewNoGroupMarshalTo(t *testing.T) {
 func TestAProto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
 	p := NewPopulatedA(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &A{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -130,7 +118,7 @@ func TestAMarshalTo(t *testing.T) {
 		panic(err)
 	}
 	msg := &A{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -147,12 +135,12 @@ func TestAMarshalTo(t *testing.T) {
 func TestOldWithGroupProto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
 	p := NewPopulatedOldWithGroup(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &OldWithGroup{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -166,15 +154,20 @@ func TestOldWithGroupProto(t *testing.T) {
 	}
 }
 
-func TestOldWithGroup_Group1Proto(t *testing.T) {
+func TestOldWithGroupMarshalTo(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group1(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	p := NewPopulatedOldWithGroup(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	_, err := p.MarshalTo(data)
 	if err != nil {
 		panic(err)
 	}
-	msg := &OldWithGroup_Group1{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	msg := &OldWithGroup{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -188,15 +181,15 @@ func TestOldWithGroup_Group1Proto(t *testing.T) {
 	}
 }
 
-func TestOldWithGroup_Group2Proto(t *testing.T) {
+func TestOldWithGroup_Group1Proto(t *testing.T) {
 	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group2(popr, false)
-	data, err := dropbox_gogoprotobuf_proto.Marshal(p)
+	p := NewPopulatedOldWithGroup_Group1(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
-	msg := &OldWithGroup_Group2{}
-	if err := dropbox_gogoprotobuf_proto.Unmarshal(data, msg); err != nil {
+	msg := &OldWithGroup_Group1{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	for i := range data {
@@ -210,490 +203,477 @@ func TestOldWithGroup_Group2Proto(t *testing.T) {
 	}
 }
 
-func TestNewNoGroupJSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedNewNoGroup(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
-		panic(err)
+func TestOldWithGroup_Group1MarshalTo(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup_Group1(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
 	}
-	msg := &NewNoGroup{}
-	err = encoding_json.Unmarshal(jsondata, msg)
+	_, err := p.MarshalTo(data)
 	if err != nil {
 		panic(err)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
-	}
-}
-func TestAJSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedA(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
+	msg := &OldWithGroup_Group1{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
-	msg := &A{}
-	err = encoding_json.Unmarshal(jsondata, msg)
-	if err != nil {
-		panic(err)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
 	}
 	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
+		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
 }
-func TestOldWithGroupJSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
-		panic(err)
-	}
-	msg := &OldWithGroup{}
-	err = encoding_json.Unmarshal(jsondata, msg)
+
+func TestOldWithGroup_Group2Proto(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup_Group2(popr, false)
+	data, err := github_com_dropbox_goprotoc_proto.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
-	}
-}
-func TestOldWithGroup_Group1JSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group1(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
-	if err != nil {
+	msg := &OldWithGroup_Group2{}
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
-	msg := &OldWithGroup_Group1{}
-	err = encoding_json.Unmarshal(jsondata, msg)
-	if err != nil {
-		panic(err)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
 	}
 	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
+		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
 }
-func TestOldWithGroup_Group2JSON(t *testing1.T) {
-	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group2(popr, true)
-	jsondata, err := encoding_json.Marshal(p)
+
+func TestOldWithGroup_Group2MarshalTo(t *testing.T) {
+	popr := math_rand.New(math_rand.NewSource(time.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup_Group2(popr, false)
+	size := p.Size()
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
+	_, err := p.MarshalTo(data)
 	if err != nil {
 		panic(err)
 	}
 	msg := &OldWithGroup_Group2{}
-	err = encoding_json.Unmarshal(jsondata, msg)
-	if err != nil {
+	if err := github_com_dropbox_goprotoc_proto.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
+	for i := range data {
+		data[i] = byte(popr.Intn(256))
+	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
 	}
 	if !p.Equal(msg) {
-		t.Fatalf("%#v !Json Equal %#v", msg, p)
+		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
 }
-func TestNewNoGroupProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedNewNoGroup(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
+
+func TestNewNoGroupAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedNewNoGroup(popr, false)
 	msg := &NewNoGroup{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+	if !apiEmptyNewNoGroup(msg, t) {
+		t.Fatalf("NewNoGroup should be empty")
 	}
+	apiCopyNewNoGroup(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyNewNoGroup(p, t) != apiEmptyNewNoGroup(msg, t) {
+		t.Fatalf("NewNoGroup should not be empty")
 	}
 	if !p.Equal(msg) {
 		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
+	msg.Clear()
+	if !apiEmptyNewNoGroup(msg, t) {
+		t.Fatalf("NewNoGroup should be empty")
+	}
 }
 
-func TestNewNoGroupProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedNewNoGroup(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
-	msg := &NewNoGroup{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiCopyNewNoGroup(dst *NewNoGroup, src *NewNoGroup, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	if src.HasField1() {
+		dst.SetField1(src.GetField1())
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	for i := 0; i < src.Field3Size(); i++ {
+		value, _ := src.GetField3(i)
+		dst.AddField3(value)
 	}
+	if src.HasA() {
+		srcA := src.GetA()
+		dstA, _ := dst.MutateA()
+		apiCopyA(dstA, srcA, t)
+	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
 
-func TestAProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedA(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
-	msg := &A{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiEmptyNewNoGroup(msg *NewNoGroup, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	if msg.HasField1() {
+		return false
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if msg.Field3Size() != 0 {
+		return false
+	}
+	if msg.HasA() {
+		return false
 	}
+	return true
 }
 
-func TestAProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedA(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
+func TestAAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedA(popr, false)
 	msg := &A{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+	if !apiEmptyA(msg, t) {
+		t.Fatalf("A should be empty")
 	}
+	apiCopyA(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyA(p, t) != apiEmptyA(msg, t) {
+		t.Fatalf("A should not be empty")
 	}
 	if !p.Equal(msg) {
 		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
+	msg.Clear()
+	if !apiEmptyA(msg, t) {
+		t.Fatalf("A should be empty")
+	}
 }
 
-func TestOldWithGroupProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
-	msg := &OldWithGroup{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiCopyA(dst *A, src *A, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if src.HasAField() {
+		dst.SetAField(src.GetAField())
 	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
 
-func TestOldWithGroupProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
-	msg := &OldWithGroup{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiEmptyA(msg *A, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
-	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if msg.HasAField() {
+		return false
 	}
+	return true
 }
 
-func TestOldWithGroup_Group1ProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group1(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
-	msg := &OldWithGroup_Group1{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func TestOldWithGroupAPI(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup(popr, false)
+	msg := &OldWithGroup{}
+	if !apiEmptyOldWithGroup(msg, t) {
+		t.Fatalf("OldWithGroup should be empty")
 	}
+	apiCopyOldWithGroup(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyOldWithGroup(p, t) != apiEmptyOldWithGroup(msg, t) {
+		t.Fatalf("OldWithGroup should not be empty")
 	}
 	if !p.Equal(msg) {
 		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
+	msg.Clear()
+	if !apiEmptyOldWithGroup(msg, t) {
+		t.Fatalf("OldWithGroup should be empty")
+	}
 }
 
-func TestOldWithGroup_Group1ProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group1(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
-	msg := &OldWithGroup_Group1{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiCopyOldWithGroup(dst *OldWithGroup, src *OldWithGroup, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	if src.HasField1() {
+		dst.SetField1(src.GetField1())
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if src.HasGroup1() {
+		srcGroup1 := src.GetGroup1()
+		dstGroup1, _ := dst.MutateGroup1()
+		apiCopyOldWithGroup_Group1(dstGroup1, srcGroup1, t)
+	}
+	for i := 0; i < src.Field3Size(); i++ {
+		value, _ := src.GetField3(i)
+		dst.AddField3(value)
 	}
+	if src.HasGroup2() {
+		srcGroup2 := src.GetGroup2()
+		dstGroup2, _ := dst.MutateGroup2()
+		apiCopyOldWithGroup_Group2(dstGroup2, srcGroup2, t)
+	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
 
-func TestOldWithGroup_Group2ProtoText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group2(popr, true)
-	data := dropbox_gogoprotobuf_proto1.MarshalTextString(p)
-	msg := &OldWithGroup_Group2{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func apiEmptyOldWithGroup(msg *OldWithGroup, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+	if msg.HasField1() {
+		return false
 	}
-	if !p.Equal(msg) {
-		t.Fatalf("%#v !Proto %#v", msg, p)
+	if msg.HasGroup1() {
+		return false
+	}
+	if msg.Field3Size() != 0 {
+		return false
 	}
+	if msg.HasGroup2() {
+		return false
+	}
+	return true
 }
 
-func TestOldWithGroup_Group2ProtoCompactText(t *testing2.T) {
-	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group2(popr, true)
-	data := dropbox_gogoprotobuf_proto1.CompactTextString(p)
-	msg := &OldWithGroup_Group2{}
-	if err := dropbox_gogoprotobuf_proto1.UnmarshalText(data, msg); err != nil {
-		panic(err)
+func TestOldWithGroup_Group1API(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup_Group1(popr, false)
+	msg := &OldWithGroup_Group1{}
+	if !apiEmptyOldWithGroup_Group1(msg, t) {
+		t.Fatalf("OldWithGroup_Group1 should be empty")
 	}
+	apiCopyOldWithGroup_Group1(msg, p, t)
 	if err := p.VerboseEqual(msg); err != nil {
-		t.Fatalf("%#v !VerboseProto %#v, since %v", msg, p, err)
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
+	}
+	if apiEmptyOldWithGroup_Group1(p, t) != apiEmptyOldWithGroup_Group1(msg, t) {
+		t.Fatalf("OldWithGroup_Group1 should not be empty")
 	}
 	if !p.Equal(msg) {
 		t.Fatalf("%#v !Proto %#v", msg, p)
 	}
-}
-
-func TestNewNoGroupStringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedNewNoGroup(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
+	msg.Clear()
+	if !apiEmptyOldWithGroup_Group1(msg, t) {
+		t.Fatalf("OldWithGroup_Group1 should be empty")
 	}
 }
-func TestAStringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedA(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
+
+func apiCopyOldWithGroup_Group1(dst *OldWithGroup_Group1, src *OldWithGroup_Group1, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-}
-func TestOldWithGroupStringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
+	if src.HasField1() {
+		dst.SetField1(src.GetField1())
 	}
-}
-func TestOldWithGroup_Group1Stringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group1(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
+	if src.HasField2() {
+		dst.SetField2(src.GetField2())
 	}
-}
-func TestOldWithGroup_Group2Stringer(t *testing3.T) {
-	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group2(popr, false)
-	s1 := p.String()
-	s2 := fmt.Sprintf("%v", p)
-	if s1 != s2 {
-		t.Fatalf("String want %v got %v", s1, s2)
+	for i := 0; i < src.Field3Size(); i++ {
+		value, _ := src.GetField3(i)
+		dst.AddField3(value)
 	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
-func TestNewNoGroupSize(t *testing4.T) {
-	popr := math_rand4.New(math_rand4.NewSource(time4.Now().UnixNano()))
-	p := NewPopulatedNewNoGroup(popr, true)
-	size2 := dropbox_gogoprotobuf_proto2.Size(p)
-	data, err := dropbox_gogoprotobuf_proto2.Marshal(p)
-	if err != nil {
-		panic(err)
+
+func apiEmptyOldWithGroup_Group1(msg *OldWithGroup_Group1, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-	size := p.Size()
-	if len(data) != size {
-		t.Fatalf("size %v != marshalled size %v", size, len(data))
+	if msg.HasField1() {
+		return false
 	}
-	if size2 != size {
-		t.Fatalf("size %v != before marshal proto.Size %v", size, size2)
+	if msg.HasField2() {
+		return false
 	}
-	size3 := dropbox_gogoprotobuf_proto2.Size(p)
-	if size3 != size {
-		t.Fatalf("size %v != after marshal proto.Size %v", size, size3)
+	if msg.Field3Size() != 0 {
+		return false
 	}
+	return true
 }
 
-func TestASize(t *testing4.T) {
-	popr := math_rand4.New(math_rand4.NewSource(time4.Now().UnixNano()))
-	p := NewPopulatedA(popr, true)
-	size2 := dropbox_gogoprotobuf_proto2.Size(p)
-	data, err := dropbox_gogoprotobuf_proto2.Marshal(p)
-	if err != nil {
-		panic(err)
+func TestOldWithGroup_Group2API(t *testing1.T) {
+	popr := math_rand1.New(math_rand1.NewSource(time1.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup_Group2(popr, false)
+	msg := &OldWithGroup_Group2{}
+	if !apiEmptyOldWithGroup_Group2(msg, t) {
+		t.Fatalf("OldWithGroup_Group2 should be empty")
 	}
-	size := p.Size()
-	if len(data) != size {
-		t.Fatalf("size %v != marshalled size %v", size, len(data))
+	apiCopyOldWithGroup_Group2(msg, p, t)
+	if err := p.VerboseEqual(msg); err != nil {
+		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
-	if size2 != size {
-		t.Fatalf("size %v != before marshal proto.Size %v", size, size2)
+	if apiEmptyOldWithGroup_Group2(p, t) != apiEmptyOldWithGroup_Group2(msg, t) {
+		t.Fatalf("OldWithGroup_Group2 should not be empty")
 	}
-	size3 := dropbox_gogoprotobuf_proto2.Size(p)
-	if size3 != size {
-		t.Fatalf("size %v != after marshal proto.Size %v", size, size3)
+	if !p.Equal(msg) {
+		t.Fatalf("%#v !Proto %#v", msg, p)
+	}
+	msg.Clear()
+	if !apiEmptyOldWithGroup_Group2(msg, t) {
+		t.Fatalf("OldWithGroup_Group2 should be empty")
 	}
 }
 
-func TestNewNoGroupGoString(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
-	p := NewPopulatedNewNoGroup(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
+func apiCopyOldWithGroup_Group2(dst *OldWithGroup_Group2, src *OldWithGroup_Group2, t *testing1.T) {
+	if dst == nil || src == nil {
+		t.Fatalf("Cannot copy to(%v) or from(%v) nil message", dst, src)
 	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
-	}
-}
-func TestAGoString(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
-	p := NewPopulatedA(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
+	if src.HasField1() {
+		dst.SetField1(src.GetField1())
 	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
+	for i := 0; i < src.Field2Size(); i++ {
+		value, _ := src.GetField2(i)
+		dst.AddField2(value)
 	}
+	src.XXX_unrecognized = dst.XXX_unrecognized
 }
-func TestOldWithGroupGoString(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
-	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
+
+func apiEmptyOldWithGroup_Group2(msg *OldWithGroup_Group2, t *testing1.T) bool {
+	if msg == nil {
+		return true
 	}
-}
-func TestOldWithGroup_Group1GoString(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group1(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
+	if msg.HasField1() {
+		return false
 	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
+	if msg.Field2Size() != 0 {
+		return false
 	}
+	return true
 }
-func TestOldWithGroup_Group2GoString(t *testing5.T) {
-	popr := math_rand5.New(math_rand5.NewSource(time5.Now().UnixNano()))
-	p := NewPopulatedOldWithGroup_Group2(popr, false)
-	s1 := p.GoString()
-	s2 := fmt1.Sprintf("%#v", p)
-	if s1 != s2 {
-		t.Fatalf("GoString want %v got %v", s1, s2)
-	}
-	_, err := go_parser.ParseExpr(s1)
-	if err != nil {
-		panic(err)
-	}
+
+func TestUnrecognizedgroupDescription(t *testing2.T) {
+	UnrecognizedgroupDescription()
 }
-func TestNewNoGroupVerboseEqual(t *testing6.T) {
-	popr := math_rand6.New(math_rand6.NewSource(time6.Now().UnixNano()))
+func TestNewNoGroupVerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedNewNoGroup(popr, false)
-	data, err := dropbox_gogoprotobuf_proto3.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &NewNoGroup{}
-	if err := dropbox_gogoprotobuf_proto3.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestAVerboseEqual(t *testing6.T) {
-	popr := math_rand6.New(math_rand6.NewSource(time6.Now().UnixNano()))
+func TestAVerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedA(popr, false)
-	data, err := dropbox_gogoprotobuf_proto3.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &A{}
-	if err := dropbox_gogoprotobuf_proto3.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestOldWithGroupVerboseEqual(t *testing6.T) {
-	popr := math_rand6.New(math_rand6.NewSource(time6.Now().UnixNano()))
+func TestOldWithGroupVerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedOldWithGroup(popr, false)
-	data, err := dropbox_gogoprotobuf_proto3.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &OldWithGroup{}
-	if err := dropbox_gogoprotobuf_proto3.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestOldWithGroup_Group1VerboseEqual(t *testing6.T) {
-	popr := math_rand6.New(math_rand6.NewSource(time6.Now().UnixNano()))
+func TestOldWithGroup_Group1VerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedOldWithGroup_Group1(popr, false)
-	data, err := dropbox_gogoprotobuf_proto3.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &OldWithGroup_Group1{}
-	if err := dropbox_gogoprotobuf_proto3.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestOldWithGroup_Group2VerboseEqual(t *testing6.T) {
-	popr := math_rand6.New(math_rand6.NewSource(time6.Now().UnixNano()))
+func TestOldWithGroup_Group2VerboseEqual(t *testing3.T) {
+	popr := math_rand2.New(math_rand2.NewSource(time2.Now().UnixNano()))
 	p := NewPopulatedOldWithGroup_Group2(popr, false)
-	data, err := dropbox_gogoprotobuf_proto3.Marshal(p)
+	data, err := github_com_dropbox_goprotoc_proto1.Marshal(p)
 	if err != nil {
 		panic(err)
 	}
 	msg := &OldWithGroup_Group2{}
-	if err := dropbox_gogoprotobuf_proto3.Unmarshal(data, msg); err != nil {
+	if err := github_com_dropbox_goprotoc_proto1.Unmarshal(data, msg); err != nil {
 		panic(err)
 	}
 	if err := p.VerboseEqual(msg); err != nil {
 		t.Fatalf("%#v !VerboseEqual %#v, since %v", msg, p, err)
 	}
 }
-func TestUnrecognizedgroupDescription(t *testing7.T) {
-	UnrecognizedgroupDescription()
+func TestNewNoGroupStringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedNewNoGroup(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
+}
+func TestAStringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedA(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
+}
+func TestOldWithGroupStringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
+}
+func TestOldWithGroup_Group1Stringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup_Group1(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
+}
+func TestOldWithGroup_Group2Stringer(t *testing4.T) {
+	popr := math_rand3.New(math_rand3.NewSource(time3.Now().UnixNano()))
+	p := NewPopulatedOldWithGroup_Group2(popr, false)
+	s1 := p.String()
+	s2 := fmt1.Sprintf("%v", p)
+	if s1 != s2 {
+		t.Fatalf("String want %v got %v", s1, s2)
+	}
 }
 
-//These tests are generated by code.google.com/p/gogoprotobuf/plugin/testgen
+//These tests are generated by github.com/dropbox/goprotoc/plugin/testgen
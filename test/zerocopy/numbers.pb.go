@@ -0,0 +1,887 @@
+// Code generated by protoc-gen-dgo.
+// source: numbers.proto
+// DO NOT EDIT!
+
+package zerocopy
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+// Zero copy messages without string fields do not use the unsafe package.
+type Numbers struct {
+	xxx_sizeCached   int
+	id               int64
+	values           []uint32
+	raw              []byte
+	XXX_unrecognized []byte
+	xxx_IsIdSet      bool
+	xxx_LenValues    int
+	xxx_IsRawSet     bool
+}
+
+func (m *Numbers) Reset()         { *m = Numbers{} }
+func (m *Numbers) String() string { return proto.CompactTextString(m) }
+func (*Numbers) ProtoMessage()    {}
+
+func (m *Numbers) GetId() int64 {
+	if m != nil && m.xxx_IsIdSet {
+		return m.id
+	}
+	return 0
+}
+
+func (m *Numbers) GetRaw() []byte {
+	if m != nil && m.xxx_IsRawSet {
+		return m.raw
+	}
+	return nil
+}
+func (m *Numbers) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Numbers) SetId(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsIdSet = true
+	m.id = value
+	return nil
+}
+
+func (m *Numbers) HasId() (isSet bool) {
+	if m != nil && m.xxx_IsIdSet {
+		return true
+	}
+	return false
+}
+
+func (m *Numbers) ClearId() {
+	if m != nil {
+		m.xxx_IsIdSet = false
+	}
+}
+
+func (m *Numbers) AddValues(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.values) <= m.xxx_LenValues {
+		newCapacity := 0
+		if len(m.values) == 0 {
+			newCapacity = 8
+		} else if len(m.values) < 1000000 {
+			newCapacity = m.xxx_LenValues * 2
+		} else {
+			newCapacity = m.xxx_LenValues + 1000000
+		}
+		t := make([]uint32, newCapacity, newCapacity)
+		copy(t, m.values)
+		m.values = t
+	}
+	m.values[m.xxx_LenValues] = value
+	m.xxx_LenValues += 1
+	return nil
+}
+
+func (m *Numbers) SetValues(value uint32, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenValues {
+		return errors.New("Index is out of bounds")
+	}
+	m.values[index] = value
+	return nil
+}
+
+func (m *Numbers) ValuesSize() (size int) {
+	if m != nil {
+		return m.xxx_LenValues
+	}
+	return 0
+}
+
+func (m *Numbers) ClearValues() {
+	if m != nil {
+		m.xxx_LenValues = 0
+	}
+}
+
+func (m *Numbers) GetValues(index int) (field uint32, err error) {
+	if m == nil {
+		return 0, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenValues {
+		return 0, errors.New("Index is out of bounds")
+	}
+	return m.values[index], nil
+}
+
+func (m *Numbers) SetRaw(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.xxx_IsRawSet = true
+	m.raw = value
+	return nil
+}
+
+func (m *Numbers) HasRaw() (isSet bool) {
+	if m != nil && m.xxx_IsRawSet {
+		return true
+	}
+	return false
+}
+
+func (m *Numbers) ClearRaw() {
+	if m != nil {
+		m.xxx_IsRawSet = false
+		m.raw = nil
+	}
+}
+
+func (m *Numbers) Clear() {
+	if m != nil {
+		m.ClearId()
+		m.ClearValues()
+		m.ClearRaw()
+	}
+}
+
+func (m *Numbers) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		n += 1 + sovNumbers(uint64(m.id))
+	}
+	if m.xxx_LenValues > 0 {
+		n += 5 * m.xxx_LenValues
+	}
+	if m.xxx_IsRawSet {
+		l = len(m.raw)
+		n += 1 + l + sovNumbers(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovNumbers(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozNumbers(x uint64) (n int) {
+	return sovNumbers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Numbers) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Numbers) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Numbers) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsIdSet {
+		data[i] = 0x8
+		i++
+		i = encodeVarintNumbers(data, i, uint64(m.id))
+	}
+	if m.xxx_LenValues > 0 {
+		for idx := 0; idx < m.xxx_LenValues; idx++ {
+			num := m.values[idx]
+			data[i] = 0x15
+			i++
+			data[i] = uint8(num)
+			i++
+			data[i] = uint8(num >> 8)
+			i++
+			data[i] = uint8(num >> 16)
+			i++
+			data[i] = uint8(num >> 24)
+			i++
+		}
+	}
+	if m.xxx_IsRawSet {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintNumbers(data, i, uint64(len(m.raw)))
+		i += copy(data[i:], m.raw)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Numbers(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Numbers(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintNumbers(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Numbers) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field id", wireType)
+			}
+			m.xxx_IsIdSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.values) <= m.xxx_LenValues {
+						newCapacity := 0
+						if len(m.values) == 0 {
+							newCapacity = 8
+						} else if len(m.values) < 1000000 {
+							newCapacity = m.xxx_LenValues * 2
+						} else {
+							newCapacity = m.xxx_LenValues + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.values)
+						m.values = t
+					}
+					m.values[m.xxx_LenValues] = uint32(v)
+					m.xxx_LenValues += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				if len(m.values) <= m.xxx_LenValues {
+					newCapacity := 0
+					if len(m.values) == 0 {
+						newCapacity = 8
+					} else if len(m.values) < 1000000 {
+						newCapacity = m.xxx_LenValues * 2
+					} else {
+						newCapacity = m.xxx_LenValues + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.values)
+					m.values = t
+				}
+				m.values[m.xxx_LenValues] = uint32(v)
+				m.xxx_LenValues += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field values", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field raw", wireType)
+			}
+			m.xxx_IsRawSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.raw = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// UnmarshalZeroCopy is like Unmarshal, except that bytes and string fields
+// alias data instead of copying it, recursively for nested messages that
+// are zero copy as well. data must not be modified while m, or any value
+// read from it, is in use.
+func (m *Numbers) UnmarshalZeroCopy(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field id", wireType)
+			}
+			m.xxx_IsIdSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v uint32
+					i := index + 4
+					if i > l {
+						return io.ErrUnexpectedEOF
+					}
+					index = i
+					v = uint32(data[i-4])
+					v |= uint32(data[i-3]) << 8
+					v |= uint32(data[i-2]) << 16
+					v |= uint32(data[i-1]) << 24
+					if len(m.values) <= m.xxx_LenValues {
+						newCapacity := 0
+						if len(m.values) == 0 {
+							newCapacity = 8
+						} else if len(m.values) < 1000000 {
+							newCapacity = m.xxx_LenValues * 2
+						} else {
+							newCapacity = m.xxx_LenValues + 1000000
+						}
+						t := make([]uint32, newCapacity, newCapacity)
+						copy(t, m.values)
+						m.values = t
+					}
+					m.values[m.xxx_LenValues] = uint32(v)
+					m.xxx_LenValues += 1
+				}
+			} else if wireType == 5 {
+				var v uint32
+				i := index + 4
+				if i > l {
+					return io.ErrUnexpectedEOF
+				}
+				index = i
+				v = uint32(data[i-4])
+				v |= uint32(data[i-3]) << 8
+				v |= uint32(data[i-2]) << 16
+				v |= uint32(data[i-1]) << 24
+				if len(m.values) <= m.xxx_LenValues {
+					newCapacity := 0
+					if len(m.values) == 0 {
+						newCapacity = 8
+					} else if len(m.values) < 1000000 {
+						newCapacity = m.xxx_LenValues * 2
+					} else {
+						newCapacity = m.xxx_LenValues + 1000000
+					}
+					t := make([]uint32, newCapacity, newCapacity)
+					copy(t, m.values)
+					m.values = t
+				}
+				m.values[m.xxx_LenValues] = uint32(v)
+				m.xxx_LenValues += 1
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field values", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field raw", wireType)
+			}
+			m.xxx_IsRawSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.raw = data[index:postIndex:postIndex]
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Numbers) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Numbers) CheckInitialized() error {
+	return nil
+}
+
+func (m *Numbers) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsIdSet || w.EmitDefaults() {
+		w.Field("id", "id")
+		w.Int64(m.GetId())
+	}
+	if m.xxx_LenValues > 0 || w.EmitDefaults() {
+		w.Field("values", "values")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenValues; i++ {
+			w.Uint32(m.values[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_IsRawSet || w.EmitDefaults() {
+		w.Field("raw", "raw")
+		w.Base64(m.GetRaw())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Numbers) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("id", "id"); ok {
+		v, err := jsonpb.Int64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetId(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("values", "values"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Uint32(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddValues(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("raw", "raw"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetRaw(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Numbers) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Numbers) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Numbers) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsIdSet {
+		w.Field("id")
+		w.Value(m.id)
+	}
+	for i := 0; i < m.xxx_LenValues; i++ {
+		w.Field("values")
+		w.Value(m.values[i])
+	}
+	if m.xxx_IsRawSet {
+		w.Field("raw")
+		w.Value(m.raw)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Numbers) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "id":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetId(v)
+	case "values":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddValues(v)
+	case "raw":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetRaw(v)
+	}
+	return false, nil
+}
+
+func (m *Numbers) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Numbers{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Numbers) MergeFrom(src proto.Message) {
+	s, ok := src.(*Numbers)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsIdSet {
+		m.SetId(s.id)
+	}
+	for i := 0; i < s.xxx_LenValues; i++ {
+		m.AddValues(s.values[i])
+	}
+	if s.xxx_IsRawSet {
+		m.SetRaw(append([]byte{}, s.raw...))
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Numbers) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Numbers)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsIdSet != o.xxx_IsIdSet {
+		return false
+	}
+	if m.xxx_IsIdSet && m.id != o.id {
+		return false
+	}
+	if m.xxx_LenValues != o.xxx_LenValues {
+		return false
+	}
+	for i := 0; i < m.xxx_LenValues; i++ {
+		if m.values[i] != o.values[i] {
+			return false
+		}
+	}
+	if m.xxx_IsRawSet != o.xxx_IsRawSet {
+		return false
+	}
+	if m.xxx_IsRawSet && !bytes.Equal(m.raw, o.raw) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionNumbers = &proto.MessageInfo{
+	Name: "zerocopy.Numbers",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "id",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Numbers)(nil).GetId(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Numbers).GetId()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "id", v)
+				}
+				x := m.(*Numbers)
+				return x.SetId(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Numbers).HasId()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Numbers).ClearId()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "values",
+			Kind:   proto.Fixed32Kind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Numbers)
+				v := make([]uint32, x.xxx_LenValues)
+				copy(v, x.values)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "values", v)
+				}
+				x := m.(*Numbers)
+				x.ClearValues()
+				for _, e := range value {
+					if err := x.AddValues(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Numbers).ValuesSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Numbers).ClearValues()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "raw",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Numbers)(nil).GetRaw(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Numbers).GetRaw()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "raw", v)
+				}
+				x := m.(*Numbers)
+				return x.SetRaw(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Numbers).HasRaw()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Numbers).ClearRaw()
+			},
+		},
+	},
+}
+
+func (m *Numbers) ProtoReflect() *proto.MessageInfo {
+	return reflectionNumbers
+}
+
+func init() {
+	proto.RegisterType((*Numbers)(nil), "zerocopy.Numbers")
+}
@@ -0,0 +1,1741 @@
+// Code generated by protoc-gen-dgo.
+// source: zerocopy.proto
+// DO NOT EDIT!
+
+/*
+Package zerocopy is a generated protocol buffer package.
+
+It is generated from these files:
+
+	zerocopy.proto
+	numbers.proto
+
+It has these top-level messages:
+
+	Blob
+	Copied
+*/
+package zerocopy
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+import unsafe "unsafe"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+type Blob struct {
+	xxx_sizeCached   int
+	name             string
+	data             []byte
+	tags             []string
+	chunks           [][]byte
+	child            *Blob
+	copied           *Copied
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+	xxx_IsDataSet    bool
+	xxx_LenTags      int
+	xxx_LenChunks    int
+	xxx_IsChildSet   bool
+	xxx_IsCopiedSet  bool
+}
+
+func (m *Blob) Reset()         { *m = Blob{} }
+func (m *Blob) String() string { return proto.CompactTextString(m) }
+func (*Blob) ProtoMessage()    {}
+
+func (m *Blob) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Blob) GetData() []byte {
+	if m != nil && m.xxx_IsDataSet {
+		return m.data
+	}
+	return nil
+}
+func (m *Blob) GetChild() *Blob {
+	if m != nil && m.xxx_IsChildSet {
+		return m.child
+	}
+	return nil
+}
+func (m *Blob) GetCopied() *Copied {
+	if m != nil && m.xxx_IsCopiedSet {
+		return m.copied
+	}
+	return nil
+}
+func (m *Blob) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Blob) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Blob) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Blob) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Blob) SetData(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.xxx_IsDataSet = true
+	m.data = value
+	return nil
+}
+
+func (m *Blob) HasData() (isSet bool) {
+	if m != nil && m.xxx_IsDataSet {
+		return true
+	}
+	return false
+}
+
+func (m *Blob) ClearData() {
+	if m != nil {
+		m.xxx_IsDataSet = false
+		m.data = nil
+	}
+}
+
+func (m *Blob) AddTags(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if len(m.tags) <= m.xxx_LenTags {
+		newCapacity := 0
+		if len(m.tags) == 0 {
+			newCapacity = 8
+		} else if len(m.tags) < 1000000 {
+			newCapacity = m.xxx_LenTags * 2
+		} else {
+			newCapacity = m.xxx_LenTags + 1000000
+		}
+		t := make([]string, newCapacity, newCapacity)
+		copy(t, m.tags)
+		m.tags = t
+	}
+	m.tags[m.xxx_LenTags] = value
+	m.xxx_LenTags += 1
+	return nil
+}
+
+func (m *Blob) SetTags(value string, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return errors.New("Index is out of bounds")
+	}
+	m.tags[index] = value
+	return nil
+}
+
+func (m *Blob) TagsSize() (size int) {
+	if m != nil {
+		return m.xxx_LenTags
+	}
+	return 0
+}
+
+func (m *Blob) ClearTags() {
+	if m != nil {
+		m.xxx_LenTags = 0
+	}
+}
+
+func (m *Blob) GetTags(index int) (field string, err error) {
+	if m == nil {
+		return "", errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenTags {
+		return "", errors.New("Index is out of bounds")
+	}
+	return m.tags[index], nil
+}
+
+func (m *Blob) AddChunks(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot append to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if len(m.chunks) <= m.xxx_LenChunks {
+		newCapacity := 0
+		if len(m.chunks) == 0 {
+			newCapacity = 8
+		} else if len(m.chunks) < 1000000 {
+			newCapacity = m.xxx_LenChunks * 2
+		} else {
+			newCapacity = m.xxx_LenChunks + 1000000
+		}
+		t := make([][]byte, newCapacity, newCapacity)
+		copy(t, m.chunks)
+		m.chunks = t
+	}
+	m.chunks[m.xxx_LenChunks] = value
+	m.xxx_LenChunks += 1
+	return nil
+}
+
+func (m *Blob) SetChunks(value []byte, index int) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if index < 0 || index >= m.xxx_LenChunks {
+		return errors.New("Index is out of bounds")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.chunks[index] = value
+	return nil
+}
+
+func (m *Blob) ChunksSize() (size int) {
+	if m != nil {
+		return m.xxx_LenChunks
+	}
+	return 0
+}
+
+func (m *Blob) ClearChunks() {
+	if m != nil {
+		m.xxx_LenChunks = 0
+	}
+}
+
+func (m *Blob) GetChunks(index int) (field []byte, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenChunks {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.chunks[index], nil
+}
+
+func (m *Blob) MutateChild() (field *Blob, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsChildSet {
+		m.xxx_IsChildSet = true
+		if m.child == nil {
+			m.child = new(Blob)
+		} else {
+			m.child.Clear()
+		}
+	}
+	return m.child, nil
+}
+
+func (m *Blob) HasChild() (isSet bool) {
+	if m != nil && m.xxx_IsChildSet {
+		return true
+	}
+	return false
+}
+
+func (m *Blob) ClearChild() {
+	if m != nil {
+		m.child.Clear()
+		m.xxx_IsChildSet = false
+
+	}
+}
+
+func (m *Blob) MutateCopied() (field *Copied, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsCopiedSet {
+		m.xxx_IsCopiedSet = true
+		if m.copied == nil {
+			m.copied = new(Copied)
+		} else {
+			m.copied.Clear()
+		}
+	}
+	return m.copied, nil
+}
+
+func (m *Blob) HasCopied() (isSet bool) {
+	if m != nil && m.xxx_IsCopiedSet {
+		return true
+	}
+	return false
+}
+
+func (m *Blob) ClearCopied() {
+	if m != nil {
+		m.copied.Clear()
+		m.xxx_IsCopiedSet = false
+
+	}
+}
+
+func (m *Blob) Clear() {
+	if m != nil {
+		m.ClearName()
+		m.ClearData()
+		m.ClearTags()
+		m.ClearChunks()
+		m.child.Clear()
+		m.xxx_IsChildSet = false
+
+		m.copied.Clear()
+		m.xxx_IsCopiedSet = false
+
+	}
+}
+
+type Copied struct {
+	xxx_sizeCached   int
+	name             string
+	XXX_unrecognized []byte
+	xxx_IsNameSet    bool
+}
+
+func (m *Copied) Reset()         { *m = Copied{} }
+func (m *Copied) String() string { return proto.CompactTextString(m) }
+func (*Copied) ProtoMessage()    {}
+
+func (m *Copied) GetName() string {
+	if m != nil && m.xxx_IsNameSet {
+		return m.name
+	}
+	return ""
+}
+
+func (m *Copied) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Copied) SetName(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.xxx_IsNameSet = true
+	m.name = value
+	return nil
+}
+
+func (m *Copied) HasName() (isSet bool) {
+	if m != nil && m.xxx_IsNameSet {
+		return true
+	}
+	return false
+}
+
+func (m *Copied) ClearName() {
+	if m != nil {
+		m.xxx_IsNameSet = false
+		m.name = ""
+	}
+}
+
+func (m *Copied) Clear() {
+	if m != nil {
+		m.ClearName()
+	}
+}
+
+func (m *Blob) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovZerocopy(uint64(l))
+	}
+	if m.xxx_IsDataSet {
+		l = len(m.data)
+		n += 1 + l + sovZerocopy(uint64(l))
+	}
+	if m.xxx_LenTags > 0 {
+		for i := 0; i < m.xxx_LenTags; i++ {
+			s := m.tags[i]
+			l = len(s)
+			n += 1 + l + sovZerocopy(uint64(l))
+		}
+	}
+	if m.xxx_LenChunks > 0 {
+		for i := 0; i < m.xxx_LenChunks; i++ {
+			b := m.chunks[i]
+			l = len(b)
+			n += 1 + l + sovZerocopy(uint64(l))
+		}
+	}
+	if m.xxx_IsChildSet {
+		l = m.child.Size()
+		n += 1 + l + sovZerocopy(uint64(l))
+	}
+	if m.xxx_IsCopiedSet {
+		l = m.copied.Size()
+		n += 1 + l + sovZerocopy(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Copied) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		l = len(m.name)
+		n += 1 + l + sovZerocopy(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovZerocopy(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozZerocopy(x uint64) (n int) {
+	return sovZerocopy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Blob) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Blob) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Blob) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintZerocopy(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.xxx_IsDataSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintZerocopy(data, i, uint64(len(m.data)))
+		i += copy(data[i:], m.data)
+	}
+	if m.xxx_LenTags > 0 {
+		for idx := 0; idx < m.xxx_LenTags; idx++ {
+			s := m.tags[idx]
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.xxx_LenChunks > 0 {
+		for idx := 0; idx < m.xxx_LenChunks; idx++ {
+			b := m.chunks[idx]
+			data[i] = 0x22
+			i++
+			i = encodeVarintZerocopy(data, i, uint64(len(b)))
+			i += copy(data[i:], b)
+		}
+	}
+	if m.xxx_IsChildSet {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintZerocopy(data, i, uint64(m.child.SizeCached()))
+		n1, err := m.child.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.xxx_IsCopiedSet {
+		data[i] = 0x32
+		i++
+		i = encodeVarintZerocopy(data, i, uint64(m.copied.SizeCached()))
+		n2, err := m.copied.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Copied) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Copied) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Copied) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_IsNameSet {
+		data[i] = 0xa
+		i++
+		i = encodeVarintZerocopy(data, i, uint64(len(m.name)))
+		i += copy(data[i:], m.name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Zerocopy(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Zerocopy(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintZerocopy(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Blob) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field data", wireType)
+			}
+			m.xxx_IsDataSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.data = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.tags) <= m.xxx_LenTags {
+				newCapacity := 0
+				if len(m.tags) == 0 {
+					newCapacity = 8
+				} else if len(m.tags) < 1000000 {
+					newCapacity = m.xxx_LenTags * 2
+				} else {
+					newCapacity = m.xxx_LenTags + 1000000
+				}
+				t := make([]string, newCapacity, newCapacity)
+				copy(t, m.tags)
+				m.tags = t
+			}
+			m.tags[m.xxx_LenTags] = string(data[index:postIndex])
+			m.xxx_LenTags += 1
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field chunks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.chunks) <= m.xxx_LenChunks {
+				newCapacity := 0
+				if len(m.chunks) == 0 {
+					newCapacity = 8
+				} else if len(m.chunks) < 1000000 {
+					newCapacity = m.xxx_LenChunks * 2
+				} else {
+					newCapacity = m.xxx_LenChunks + 1000000
+				}
+				t := make([][]byte, newCapacity, newCapacity)
+				copy(t, m.chunks)
+				m.chunks = t
+			}
+			m.chunks[m.xxx_LenChunks] = append([]byte{}, data[index:postIndex]...)
+			m.xxx_LenChunks += 1
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateChild()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field copied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateCopied()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// UnmarshalZeroCopy is like Unmarshal, except that bytes and string fields
+// alias data instead of copying it, recursively for nested messages that
+// are zero copy as well. data must not be modified while m, or any value
+// read from it, is in use.
+func (m *Blob) UnmarshalZeroCopy(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index:postIndex]
+			m.name = *(*string)(unsafe.Pointer(&b))
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field data", wireType)
+			}
+			m.xxx_IsDataSet = true
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.data = data[index:postIndex:postIndex]
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index:postIndex]
+			if len(m.tags) <= m.xxx_LenTags {
+				newCapacity := 0
+				if len(m.tags) == 0 {
+					newCapacity = 8
+				} else if len(m.tags) < 1000000 {
+					newCapacity = m.xxx_LenTags * 2
+				} else {
+					newCapacity = m.xxx_LenTags + 1000000
+				}
+				t := make([]string, newCapacity, newCapacity)
+				copy(t, m.tags)
+				m.tags = t
+			}
+			m.tags[m.xxx_LenTags] = *(*string)(unsafe.Pointer(&b))
+			m.xxx_LenTags += 1
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field chunks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.chunks) <= m.xxx_LenChunks {
+				newCapacity := 0
+				if len(m.chunks) == 0 {
+					newCapacity = 8
+				} else if len(m.chunks) < 1000000 {
+					newCapacity = m.xxx_LenChunks * 2
+				} else {
+					newCapacity = m.xxx_LenChunks + 1000000
+				}
+				t := make([][]byte, newCapacity, newCapacity)
+				copy(t, m.chunks)
+				m.chunks = t
+			}
+			m.chunks[m.xxx_LenChunks] = data[index:postIndex:postIndex]
+			m.xxx_LenChunks += 1
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateChild()
+			if err := v.UnmarshalZeroCopy(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field copied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateCopied()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Copied) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field name", wireType)
+			}
+			m.xxx_IsNameSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.name = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Blob) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Blob) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Copied) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Copied) CheckInitialized() error {
+	return nil
+}
+
+func (m *Blob) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	if m.xxx_IsDataSet || w.EmitDefaults() {
+		w.Field("data", "data")
+		w.Base64(m.GetData())
+	}
+	if m.xxx_LenTags > 0 || w.EmitDefaults() {
+		w.Field("tags", "tags")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenTags; i++ {
+			w.String(m.tags[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_LenChunks > 0 || w.EmitDefaults() {
+		w.Field("chunks", "chunks")
+		w.BeginArray()
+		for i := 0; i < m.xxx_LenChunks; i++ {
+			w.Base64(m.chunks[i])
+		}
+		w.EndArray()
+	}
+	if m.xxx_IsChildSet {
+		w.Field("child", "child")
+		w.Message(m.GetChild())
+	} else if w.EmitDefaults() {
+		w.Field("child", "child")
+		w.Null()
+	}
+	if m.xxx_IsCopiedSet {
+		w.Field("copied", "copied")
+		w.Message(m.GetCopied())
+	} else if w.EmitDefaults() {
+		w.Field("copied", "copied")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Blob) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("data", "data"); ok {
+		v, err := jsonpb.Base64(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetData(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("tags", "tags"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.String(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddTags(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("chunks", "chunks"); ok {
+		elems, err := jsonpb.Array(raw)
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			v, err := jsonpb.Base64(elem)
+			if err != nil {
+				return err
+			}
+			if err := m.AddChunks(v); err != nil {
+				return err
+			}
+		}
+	}
+	if raw, ok := fields.Get("child", "child"); ok {
+		v, err := m.MutateChild()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("copied", "copied"); ok {
+		v, err := m.MutateCopied()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Blob) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Blob) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Copied) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if m.xxx_IsNameSet || w.EmitDefaults() {
+		w.Field("name", "name")
+		w.String(m.GetName())
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Copied) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("name", "name"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetName(v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Copied) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Copied) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Blob) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	if m.xxx_IsDataSet {
+		w.Field("data")
+		w.Value(m.data)
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		w.Field("tags")
+		w.Value(m.tags[i])
+	}
+	for i := 0; i < m.xxx_LenChunks; i++ {
+		w.Field("chunks")
+		w.Value(m.chunks[i])
+	}
+	if m.xxx_IsChildSet {
+		w.Field("child")
+		w.Message(m.child)
+	}
+	if m.xxx_IsCopiedSet {
+		w.Field("copied")
+		w.Message(m.copied)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Blob) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	case "data":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetData(v)
+	case "tags":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddTags(v)
+	case "chunks":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.AddChunks(v)
+	case "child":
+		v, err := m.MutateChild()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "copied":
+		v, err := m.MutateCopied()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Copied) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_IsNameSet {
+		w.Field("name")
+		w.Value(m.name)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Copied) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "name":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetName(v)
+	}
+	return false, nil
+}
+
+func (m *Blob) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Blob{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Blob) MergeFrom(src proto.Message) {
+	s, ok := src.(*Blob)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	if s.xxx_IsDataSet {
+		m.SetData(append([]byte{}, s.data...))
+	}
+	for i := 0; i < s.xxx_LenTags; i++ {
+		m.AddTags(s.tags[i])
+	}
+	for i := 0; i < s.xxx_LenChunks; i++ {
+		m.AddChunks(append([]byte{}, s.chunks[i]...))
+	}
+	if s.xxx_IsChildSet {
+		v, _ := m.MutateChild()
+		v.MergeFrom(s.child)
+	}
+	if s.xxx_IsCopiedSet {
+		v, _ := m.MutateCopied()
+		v.MergeFrom(s.copied)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Blob) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Blob)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if m.xxx_IsDataSet != o.xxx_IsDataSet {
+		return false
+	}
+	if m.xxx_IsDataSet && !bytes.Equal(m.data, o.data) {
+		return false
+	}
+	if m.xxx_LenTags != o.xxx_LenTags {
+		return false
+	}
+	for i := 0; i < m.xxx_LenTags; i++ {
+		if m.tags[i] != o.tags[i] {
+			return false
+		}
+	}
+	if m.xxx_LenChunks != o.xxx_LenChunks {
+		return false
+	}
+	for i := 0; i < m.xxx_LenChunks; i++ {
+		if !bytes.Equal(m.chunks[i], o.chunks[i]) {
+			return false
+		}
+	}
+	if m.xxx_IsChildSet != o.xxx_IsChildSet {
+		return false
+	}
+	if m.xxx_IsChildSet && !m.child.Equal(o.child) {
+		return false
+	}
+	if m.xxx_IsCopiedSet != o.xxx_IsCopiedSet {
+		return false
+	}
+	if m.xxx_IsCopiedSet && !m.copied.Equal(o.copied) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Copied) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Copied{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Copied) MergeFrom(src proto.Message) {
+	s, ok := src.(*Copied)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_IsNameSet {
+		m.SetName(s.name)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Copied) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Copied)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_IsNameSet != o.xxx_IsNameSet {
+		return false
+	}
+	if m.xxx_IsNameSet && m.name != o.name {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionBlob = &proto.MessageInfo{
+	Name: "zerocopy.Blob",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Blob)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Blob).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Blob)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Blob).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Blob).ClearName()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "data",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Blob)(nil).GetData(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Blob).GetData()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "data", v)
+				}
+				x := m.(*Blob)
+				return x.SetData(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Blob).HasData()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Blob).ClearData()
+			},
+		},
+		{
+			Number: 3,
+			Name:   "tags",
+			Kind:   proto.StringKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Blob)
+				v := make([]string, x.xxx_LenTags)
+				copy(v, x.tags)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "tags", v)
+				}
+				x := m.(*Blob)
+				x.ClearTags()
+				for _, e := range value {
+					if err := x.AddTags(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Blob).TagsSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Blob).ClearTags()
+			},
+		},
+		{
+			Number: 4,
+			Name:   "chunks",
+			Kind:   proto.BytesKind,
+			Label:  proto.RepeatedLabel,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Blob)
+				v := make([][]byte, x.xxx_LenChunks)
+				copy(v, x.chunks)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([][]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "chunks", v)
+				}
+				x := m.(*Blob)
+				x.ClearChunks()
+				for _, e := range value {
+					if err := x.AddChunks(append([]byte{}, e...)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Blob).ChunksSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Blob).ClearChunks()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "child",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Blob)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Blob).GetChild()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Blob)
+				if !ok {
+					return proto.NewFieldTypeError(m, "child", v)
+				}
+				x := m.(*Blob)
+				x.ClearChild()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateChild()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Blob).HasChild()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Blob).ClearChild()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "copied",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Copied)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Blob).GetCopied()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Copied)
+				if !ok {
+					return proto.NewFieldTypeError(m, "copied", v)
+				}
+				x := m.(*Blob)
+				x.ClearCopied()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateCopied()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Blob).HasCopied()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Blob).ClearCopied()
+			},
+		},
+	},
+}
+
+func (m *Blob) ProtoReflect() *proto.MessageInfo {
+	return reflectionBlob
+}
+
+var reflectionCopied = &proto.MessageInfo{
+	Name: "zerocopy.Copied",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "name",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Copied)(nil).GetName(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Copied).GetName()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "name", v)
+				}
+				x := m.(*Copied)
+				return x.SetName(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Copied).HasName()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Copied).ClearName()
+			},
+		},
+	},
+}
+
+func (m *Copied) ProtoReflect() *proto.MessageInfo {
+	return reflectionCopied
+}
+
+func init() {
+	proto.RegisterType((*Blob)(nil), "zerocopy.Blob")
+	proto.RegisterType((*Copied)(nil), "zerocopy.Copied")
+}
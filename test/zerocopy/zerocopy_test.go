@@ -0,0 +1,140 @@
+// Copyright (c) 2013, Vastech SA (PTY) LTD. All rights reserved.
+// http://code.google.com/p/gogoprotobuf/gogoproto
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package zerocopy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dropbox/goprotoc/proto"
+)
+
+func newBlob() *Blob {
+	m := &Blob{}
+	m.SetName("name")
+	m.SetData([]byte("data"))
+	m.AddTags("tag")
+	m.AddChunks([]byte("chunk"))
+	child, _ := m.MutateChild()
+	child.SetName("child")
+	copied, _ := m.MutateCopied()
+	copied.SetName("copied")
+	return m
+}
+
+func marshal(t *testing.T) []byte {
+	data, err := proto.Marshal(newBlob())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// Overwrites every byte of data, destroying the values aliased from it.
+func scribble(data []byte) {
+	for i := range data {
+		data[i] = 'x'
+	}
+}
+
+func TestUnmarshalCopies(t *testing.T) {
+	data := marshal(t)
+	m := &Blob{}
+	if err := m.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	scribble(data)
+	if got, want := proto.CompactTextString(m), proto.CompactTextString(newBlob()); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestUnmarshalZeroCopy(t *testing.T) {
+	data := marshal(t)
+	m := &Blob{}
+	if err := m.UnmarshalZeroCopy(data); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := proto.CompactTextString(m), proto.CompactTextString(newBlob()); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	scribble(data)
+	if got := m.GetName(); got != "xxxx" {
+		t.Errorf("name is %q, want it to alias the scribbled buffer", got)
+	}
+	if got := m.GetData(); !bytes.Equal(got, []byte("xxxx")) {
+		t.Errorf("data is %q, want it to alias the scribbled buffer", got)
+	}
+	if got, _ := m.GetTags(0); got != "xxx" {
+		t.Errorf("tag is %q, want it to alias the scribbled buffer", got)
+	}
+	if got, _ := m.GetChunks(0); !bytes.Equal(got, []byte("xxxxx")) {
+		t.Errorf("chunk is %q, want it to alias the scribbled buffer", got)
+	}
+	if got := m.GetChild().GetName(); got != "xxxxx" {
+		t.Errorf("child name is %q, want it to alias the scribbled buffer", got)
+	}
+	if got := m.GetCopied().GetName(); got != "copied" {
+		t.Errorf("copied name is %q, want it copied out of the buffer", got)
+	}
+}
+
+func TestZeroCopyAppend(t *testing.T) {
+	data := marshal(t)
+	orig := append([]byte{}, data...)
+	m := &Blob{}
+	if err := m.UnmarshalZeroCopy(data); err != nil {
+		t.Fatal(err)
+	}
+	_ = append(m.GetData(), "more"...)
+	if !bytes.Equal(data, orig) {
+		t.Fatalf("appending to a bytes field overwrote the buffer")
+	}
+}
+
+func TestZeroCopyWithoutStrings(t *testing.T) {
+	m := &Numbers{}
+	m.SetId(1)
+	m.AddValues(2)
+	m.SetRaw([]byte("raw"))
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &Numbers{}
+	if err := m2.UnmarshalZeroCopy(data); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Equal(m2) {
+		t.Fatalf("%v != %v", m, m2)
+	}
+	scribble(data)
+	if got := m2.GetRaw(); !bytes.Equal(got, []byte("xxx")) {
+		t.Errorf("raw is %q, want it to alias the scribbled buffer", got)
+	}
+}
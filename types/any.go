@@ -0,0 +1,233 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package types
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/dropbox/godropbox/errors"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+	"github.com/dropbox/goprotoc/proto"
+)
+
+// The prefix of the type URLs written by MarshalAny.
+const googleApis = "type.googleapis.com/"
+
+// Returns the full proto name of m, which must be registered with
+// proto.RegisterType, as the generated code does.
+func messageName(m proto.Message) (string, error) {
+	name := proto.MessageName(m)
+	if name == "" {
+		return "", errors.Newf("types: %T is not a registered message type", m)
+	}
+	return name, nil
+}
+
+// MarshalAny packs m into an Any, with a type URL of the form
+// "type.googleapis.com/full.name".
+func MarshalAny(m proto.Message) (*Any, error) {
+	name, err := messageName(m)
+	if err != nil {
+		return nil, err
+	}
+	value, err := proto.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	any := &Any{}
+	any.SetTypeUrl(googleApis + name)
+	any.SetValue(value)
+	return any, nil
+}
+
+// AnyMessageName returns the full proto name of the message held by any,
+// which is the part of its type URL after the last "/".
+func AnyMessageName(any *Any) (string, error) {
+	if any == nil {
+		return "", errors.New("types: nil Any")
+	}
+	url := any.GetTypeUrl()
+	name := url[strings.LastIndex(url, "/")+1:]
+	if name == "" {
+		return "", errors.Newf("types: invalid type URL %q", url)
+	}
+	return name, nil
+}
+
+// Is returns true if any holds a message of the same type as m.
+func Is(any *Any, m proto.Message) bool {
+	name, err := AnyMessageName(any)
+	if err != nil {
+		return false
+	}
+	want, err := messageName(m)
+	return err == nil && name == want
+}
+
+// UnmarshalAny unpacks the message held by any into m, which must be of the
+// type named by the type URL.
+func UnmarshalAny(any *Any, m proto.Message) error {
+	name, err := AnyMessageName(any)
+	if err != nil {
+		return err
+	}
+	want, err := messageName(m)
+	if err != nil {
+		return err
+	}
+	if name != want {
+		return errors.Newf("types: Any holds a %s, not a %s", name, want)
+	}
+	return proto.Unmarshal(any.GetValue(), m)
+}
+
+// EmptyAny returns a new message of the type named by the type URL of any,
+// as registered with proto.RegisterType. The message is empty; use
+// UnmarshalAny to fill it.
+func EmptyAny(any *Any) (proto.Message, error) {
+	name, err := AnyMessageName(any)
+	if err != nil {
+		return nil, err
+	}
+	t := proto.MessageType(name)
+	if t == nil {
+		return nil, errors.Newf("types: unknown message type %q", name)
+	}
+	return reflect.New(t.Elem()).Interface().(proto.Message), nil
+}
+
+// UnmarshalAnyNew unpacks the message held by any into a new message of the
+// registered type named by the type URL.
+func UnmarshalAnyNew(any *Any) (proto.Message, error) {
+	m, err := EmptyAny(any)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(any.GetValue(), m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// The well known types whose JSON representation is not an object, which are
+// held in the "value" member of the JSON of an Any.
+var specialJSON = map[string]bool{
+	"google.protobuf.Any":         true,
+	"google.protobuf.Duration":    true,
+	"google.protobuf.Timestamp":   true,
+	"google.protobuf.Struct":      true,
+	"google.protobuf.Value":       true,
+	"google.protobuf.ListValue":   true,
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+// MarshalJSONPB writes the JSON of the message held by the Any, with an
+// additional "@type" member holding the type URL. The type of the message
+// must be registered with proto.RegisterType.
+func (m *Any) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	msg, err := UnmarshalAnyNew(m)
+	if err != nil {
+		return err
+	}
+	value, ok := msg.(jsonpb.Message)
+	if !ok {
+		return errors.Newf("types: %T cannot be written as JSON", msg)
+	}
+	name, _ := AnyMessageName(m)
+	w.BeginObject()
+	w.Key("@type")
+	w.String(m.GetTypeUrl())
+	if specialJSON[name] {
+		w.Key("value")
+		w.Message(value)
+	} else {
+		w.Embed(value)
+	}
+	w.EndObject()
+	return nil
+}
+
+// UnmarshalJSONPB reads the JSON written by MarshalJSONPB. The type of the
+// message must be registered with proto.RegisterType.
+func (m *Any) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	raw, ok := fields.Get("@type", "@type")
+	if !ok {
+		return errors.New("types: JSON of Any has no @type")
+	}
+	url, err := jsonpb.String(raw)
+	if err != nil {
+		return err
+	}
+	any := &Any{}
+	any.SetTypeUrl(url)
+	msg, err := EmptyAny(any)
+	if err != nil {
+		return err
+	}
+	value, ok := msg.(jsonpb.Message)
+	if !ok {
+		return errors.Newf("types: %T cannot be read from JSON", msg)
+	}
+	name, _ := AnyMessageName(any)
+	if specialJSON[name] {
+		if raw, ok := fields.Get("value", "value"); ok {
+			if err := u.Message(raw, value); err != nil {
+				return err
+			}
+		}
+		if err := fields.Done(); err != nil {
+			return err
+		}
+	} else if err := u.Message(fields.Rest(), value); err != nil {
+		return err
+	}
+	data, err = proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	m.SetTypeUrl(url)
+	m.SetValue(data)
+	return nil
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-dgo.
+// source: any.proto
+// DO NOT EDIT!
+
+/*
+Package types is a generated protocol buffer package.
+
+It is generated from these files:
+
+	any.proto
+	duration.proto
+	empty.proto
+	struct.proto
+	timestamp.proto
+	wrappers.proto
+
+It has these top-level messages:
+
+	Any
+*/
+package types
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+// An Any holds an arbitrary serialized message along with a URL that
+// describes its type, such as "type.googleapis.com/google.protobuf.Duration".
+// The part of the URL after the last "/" is the full name of the type.
+//
+// In JSON an Any is written as the JSON of the message it holds with an
+// additional "@type" field holding the type URL. Messages with a special
+// JSON representation are written in a "value" field instead.
+type Any struct {
+	xxx_sizeCached int
+	// A URL whose last path segment is the full name of the type of value.
+	typeUrl string
+	// A valid serialized message of the type named by type_url.
+	value            []byte
+	XXX_unrecognized []byte
+}
+
+func (m *Any) Reset()         { *m = Any{} }
+func (m *Any) String() string { return proto.CompactTextString(m) }
+func (*Any) ProtoMessage()    {}
+
+func (m *Any) GetTypeUrl() string {
+	if m != nil {
+		return m.typeUrl
+	}
+	return ""
+}
+
+func (m *Any) GetValue() []byte {
+	if m != nil {
+		return m.value
+	}
+	return nil
+}
+func (m *Any) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Any) SetTypeUrl(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.typeUrl = value
+	return nil
+}
+
+func (m *Any) ClearTypeUrl() {
+	if m != nil {
+		m.typeUrl = ""
+	}
+}
+
+func (m *Any) SetValue(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *Any) ClearValue() {
+	if m != nil {
+		m.value = nil
+	}
+}
+
+func (m *Any) Clear() {
+	if m != nil {
+		m.ClearTypeUrl()
+		m.ClearValue()
+	}
+}
+
+func (m *Any) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.typeUrl) > 0 {
+		l = len(m.typeUrl)
+		n += 1 + l + sovAny(uint64(l))
+	}
+	if len(m.value) > 0 {
+		l = len(m.value)
+		n += 1 + l + sovAny(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovAny(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAny(x uint64) (n int) {
+	return sovAny(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Any) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Any) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Any) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.typeUrl) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAny(data, i, uint64(len(m.typeUrl)))
+		i += copy(data[i:], m.typeUrl)
+	}
+	if len(m.value) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintAny(data, i, uint64(len(m.value)))
+		i += copy(data[i:], m.value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Any(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Any(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintAny(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Any) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field typeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.typeUrl = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.value = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Any) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Any) CheckInitialized() error {
+	return nil
+}
+
+func (m *Any) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Any) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Any) MarshalTextFields(w *proto.TextWriter) {
+	if len(m.typeUrl) > 0 {
+		w.Field("type_url")
+		w.Value(m.typeUrl)
+	}
+	if len(m.value) > 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Any) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "type_url":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetTypeUrl(v)
+	case "value":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Any) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Any{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Any) MergeFrom(src proto.Message) {
+	s, ok := src.(*Any)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if len(s.typeUrl) > 0 {
+		m.SetTypeUrl(s.typeUrl)
+	}
+	if len(s.value) > 0 {
+		m.SetValue(append([]byte{}, s.value...))
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Any) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Any)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.typeUrl != o.typeUrl {
+		return false
+	}
+	if !bytes.Equal(m.value, o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionAny = &proto.MessageInfo{
+	Name: "google.protobuf.Any",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "type_url",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Any)(nil).GetTypeUrl(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Any).GetTypeUrl()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "type_url", v)
+				}
+				x := m.(*Any)
+				return x.SetTypeUrl(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Any)
+				return len(x.typeUrl) > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Any).ClearTypeUrl()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "value",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Any)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Any).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Any)
+				return x.SetValue(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Any)
+				return len(x.value) > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Any).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Any) ProtoReflect() *proto.MessageInfo {
+	return reflectionAny
+}
+
+func init() {
+	proto.RegisterType((*Any)(nil), "google.protobuf.Any")
+}
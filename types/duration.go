@@ -0,0 +1,151 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dropbox/godropbox/errors"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+)
+
+// The seconds of the longest valid Duration, about 10,000 years.
+const maxDurationSeconds = 315576000000
+
+// Returns an error if d is nil, out of range, or has seconds and nanos of
+// different signs.
+func validateDuration(d *Duration) error {
+	if d == nil {
+		return errors.New("types: nil Duration")
+	}
+	seconds, nanos := d.GetSeconds(), d.GetNanos()
+	if seconds < -maxDurationSeconds || seconds > maxDurationSeconds {
+		return errors.Newf("types: Duration %v is out of range", d)
+	}
+	if nanos <= -1e9 || nanos >= 1e9 {
+		return errors.Newf("types: Duration %v has out of range nanos", d)
+	}
+	if (seconds < 0 && nanos > 0) || (seconds > 0 && nanos < 0) {
+		return errors.Newf("types: Duration %v has seconds and nanos of different signs", d)
+	}
+	return nil
+}
+
+// DurationFromProto converts a Duration to a time.Duration. An error is
+// returned if d is nil or invalid, or if it does not fit in a time.Duration.
+func DurationFromProto(d *Duration) (time.Duration, error) {
+	if err := validateDuration(d); err != nil {
+		return 0, err
+	}
+	seconds := time.Duration(d.GetSeconds())
+	if seconds*time.Second/time.Second != seconds {
+		return 0, errors.Newf("types: Duration %v does not fit in a time.Duration", d)
+	}
+	td := seconds*time.Second + time.Duration(d.GetNanos())
+	if (td < 0) != (d.GetSeconds() < 0 || d.GetNanos() < 0) {
+		return 0, errors.Newf("types: Duration %v does not fit in a time.Duration", d)
+	}
+	return td, nil
+}
+
+// DurationProto converts a time.Duration to a Duration.
+func DurationProto(d time.Duration) *Duration {
+	m := &Duration{}
+	m.SetSeconds(int64(d / time.Second))
+	m.SetNanos(int32(d % time.Second))
+	return m
+}
+
+// MarshalJSONPB writes the duration as a number of seconds followed by "s",
+// with 0, 3, 6 or 9 fractional digits, such as "-1.500s".
+func (m *Duration) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	if err := validateDuration(m); err != nil {
+		return err
+	}
+	seconds, nanos := m.GetSeconds(), m.GetNanos()
+	sign := ""
+	if seconds < 0 || nanos < 0 {
+		sign = "-"
+		seconds, nanos = -seconds, -nanos
+	}
+	w.String(sign + strconv.FormatInt(seconds, 10) + formatNanos(nanos) + "s")
+	return nil
+}
+
+// UnmarshalJSONPB reads a number of seconds followed by "s", with up to 9
+// fractional digits.
+func (m *Duration) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	s, err := jsonpb.String(data)
+	if err != nil {
+		return err
+	}
+	invalid := errors.Newf("types: invalid Duration %q", s)
+	if !strings.HasSuffix(s, "s") {
+		return invalid
+	}
+	number := s[:len(s)-1]
+	negative := strings.HasPrefix(number, "-")
+	if negative {
+		number = number[1:]
+	}
+	integer, fraction := number, ""
+	if i := strings.Index(number, "."); i >= 0 {
+		integer, fraction = number[:i], number[i+1:]
+	}
+	if integer == "" || len(fraction) > 9 || strings.Trim(integer+fraction, "0123456789") != "" {
+		return invalid
+	}
+	seconds, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return invalid
+	}
+	var nanos int64
+	if fraction != "" {
+		if nanos, err = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32); err != nil {
+			return invalid
+		}
+	}
+	if negative {
+		seconds, nanos = -seconds, -nanos
+	}
+	d := &Duration{}
+	d.SetSeconds(seconds)
+	d.SetNanos(int32(nanos))
+	if err := validateDuration(d); err != nil {
+		return err
+	}
+	m.SetSeconds(seconds)
+	m.SetNanos(int32(nanos))
+	return nil
+}
@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-dgo.
+// source: duration.proto
+// DO NOT EDIT!
+
+package types
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+// A Duration represents a signed, fixed-length span of time as a count of
+// seconds and fractions of seconds at nanosecond resolution. The range is
+// approximately +-10,000 years.
+//
+// In JSON a Duration is written as a number of seconds with the suffix "s",
+// such as "1.5s".
+type Duration struct {
+	xxx_sizeCached int
+	// Signed seconds of the span of time. Must be from -315,576,000,000 to
+	// +315,576,000,000 inclusive.
+	seconds int64
+	// Signed fractions of a second at nanosecond resolution. Durations of
+	// less than one second have a 0 seconds field and a positive or negative
+	// nanos field. Otherwise nanos must have the same sign as seconds. Must be
+	// from -999,999,999 to +999,999,999 inclusive.
+	nanos            int32
+	XXX_unrecognized []byte
+}
+
+func (m *Duration) Reset()         { *m = Duration{} }
+func (m *Duration) String() string { return proto.CompactTextString(m) }
+func (*Duration) ProtoMessage()    {}
+
+func (m *Duration) GetSeconds() int64 {
+	if m != nil {
+		return m.seconds
+	}
+	return 0
+}
+
+func (m *Duration) GetNanos() int32 {
+	if m != nil {
+		return m.nanos
+	}
+	return 0
+}
+
+func (m *Duration) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Duration) SetSeconds(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.seconds = value
+	return nil
+}
+
+func (m *Duration) ClearSeconds() {
+	if m != nil {
+		m.seconds = 0
+	}
+}
+
+func (m *Duration) SetNanos(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.nanos = value
+	return nil
+}
+
+func (m *Duration) ClearNanos() {
+	if m != nil {
+		m.nanos = 0
+	}
+}
+
+func (m *Duration) Clear() {
+	if m != nil {
+		m.ClearSeconds()
+		m.ClearNanos()
+	}
+}
+
+func (m *Duration) Size() (n int) {
+	var l int
+	_ = l
+	if m.seconds != 0 {
+		n += 1 + sovDuration(uint64(m.seconds))
+	}
+	if m.nanos != 0 {
+		n += 1 + sovDuration(uint64(uint32(m.nanos)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovDuration(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDuration(x uint64) (n int) {
+	return sovDuration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Duration) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Duration) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Duration) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.seconds != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintDuration(data, i, uint64(m.seconds))
+	}
+	if m.nanos != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintDuration(data, i, uint64(uint32(m.nanos)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Duration(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Duration(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDuration(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Duration) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field seconds", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.seconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field nanos", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.nanos |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Duration) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Duration) CheckInitialized() error {
+	return nil
+}
+
+func (m *Duration) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Duration) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Duration) MarshalTextFields(w *proto.TextWriter) {
+	if m.seconds != 0 {
+		w.Field("seconds")
+		w.Value(m.seconds)
+	}
+	if m.nanos != 0 {
+		w.Field("nanos")
+		w.Value(m.nanos)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Duration) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "seconds":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetSeconds(v)
+	case "nanos":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNanos(v)
+	}
+	return false, nil
+}
+
+func (m *Duration) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Duration{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Duration) MergeFrom(src proto.Message) {
+	s, ok := src.(*Duration)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.seconds != 0 {
+		m.SetSeconds(s.seconds)
+	}
+	if s.nanos != 0 {
+		m.SetNanos(s.nanos)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Duration) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Duration)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.seconds != o.seconds {
+		return false
+	}
+	if m.nanos != o.nanos {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionDuration = &proto.MessageInfo{
+	Name: "google.protobuf.Duration",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "seconds",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Duration)(nil).GetSeconds(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Duration).GetSeconds()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "seconds", v)
+				}
+				x := m.(*Duration)
+				return x.SetSeconds(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Duration)
+				return x.seconds != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Duration).ClearSeconds()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "nanos",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Duration)(nil).GetNanos(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Duration).GetNanos()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "nanos", v)
+				}
+				x := m.(*Duration)
+				return x.SetNanos(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Duration)
+				return x.nanos != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Duration).ClearNanos()
+			},
+		},
+	},
+}
+
+func (m *Duration) ProtoReflect() *proto.MessageInfo {
+	return reflectionDuration
+}
+
+func init() {
+	proto.RegisterType((*Duration)(nil), "google.protobuf.Duration")
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package types
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/dropbox/godropbox/errors"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+)
+
+// NewValue converts a Go value to a Value. It accepts nil, bool, the numeric
+// types, string, []interface{} and map[string]interface{}, which are
+// converted recursively.
+func NewValue(v interface{}) (*Value, error) {
+	m := &Value{}
+	switch v := v.(type) {
+	case nil:
+		m.SetNullValue(NullValue_NULL_VALUE)
+	case bool:
+		m.SetBoolValue(v)
+	case int:
+		m.SetNumberValue(float64(v))
+	case int32:
+		m.SetNumberValue(float64(v))
+	case int64:
+		m.SetNumberValue(float64(v))
+	case uint:
+		m.SetNumberValue(float64(v))
+	case uint32:
+		m.SetNumberValue(float64(v))
+	case uint64:
+		m.SetNumberValue(float64(v))
+	case float32:
+		m.SetNumberValue(float64(v))
+	case float64:
+		m.SetNumberValue(v)
+	case string:
+		m.SetStringValue(v)
+	case []interface{}:
+		list, _ := m.MutateListValue()
+		for _, elem := range v {
+			value, err := NewValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			added, _ := list.AddValues()
+			added.MergeFrom(value)
+		}
+	case map[string]interface{}:
+		s, _ := m.MutateStructValue()
+		for key, elem := range v {
+			value, err := NewValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			s.PutFields(key, value)
+		}
+	default:
+		return nil, errors.Newf("types: cannot convert %T to a Value", v)
+	}
+	return m, nil
+}
+
+// AsInterface converts the Value to the Go value that NewValue converts from,
+// using float64 for numbers. An unset Value is converted to nil.
+func (m *Value) AsInterface() interface{} {
+	switch m.WhichKind() {
+	case Value_KindCase_NumberValue:
+		return m.GetNumberValue()
+	case Value_KindCase_StringValue:
+		return m.GetStringValue()
+	case Value_KindCase_BoolValue:
+		return m.GetBoolValue()
+	case Value_KindCase_StructValue:
+		return m.GetStructValue().AsMap()
+	case Value_KindCase_ListValue:
+		return m.GetListValue().AsSlice()
+	}
+	return nil
+}
+
+// AsMap converts the Struct to a map of Go values, see Value.AsInterface.
+func (m *Struct) AsMap() map[string]interface{} {
+	v := make(map[string]interface{}, m.FieldsLen())
+	m.RangeFields(func(key string, value *Value) bool {
+		v[key] = value.AsInterface()
+		return true
+	})
+	return v
+}
+
+// AsSlice converts the ListValue to a slice of Go values, see
+// Value.AsInterface.
+func (m *ListValue) AsSlice() []interface{} {
+	v := make([]interface{}, m.ValuesSize())
+	for i := range v {
+		value, _ := m.GetValues(i)
+		v[i] = value.AsInterface()
+	}
+	return v
+}
+
+// MarshalJSONPB writes the Struct as a JSON object, with its keys sorted.
+func (m *Struct) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	keys := make([]string, 0, m.FieldsLen())
+	m.RangeFields(func(key string, value *Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Strings(keys)
+	w.BeginObject()
+	for _, key := range keys {
+		value, _ := m.GetFields(key)
+		w.Key(key)
+		w.Message(value)
+	}
+	w.EndObject()
+	return nil
+}
+
+// UnmarshalJSONPB reads a JSON object into the Struct.
+func (m *Struct) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	members, err := jsonpb.Object(data)
+	if err != nil {
+		return err
+	}
+	for key, raw := range members {
+		value := &Value{}
+		if err := value.UnmarshalJSONPB(u, raw); err != nil {
+			return err
+		}
+		m.PutFields(key, value)
+	}
+	return nil
+}
+
+// MarshalJSONPB writes the Value as the JSON value of its kind. Numbers which
+// are not finite cannot be written.
+func (m *Value) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	switch m.WhichKind() {
+	case Value_KindCase_NullValue:
+		w.Null()
+	case Value_KindCase_NumberValue:
+		v := m.GetNumberValue()
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errors.Newf("types: Value %v is not a valid JSON number", v)
+		}
+		w.Float64(v)
+	case Value_KindCase_StringValue:
+		w.String(m.GetStringValue())
+	case Value_KindCase_BoolValue:
+		w.Bool(m.GetBoolValue())
+	case Value_KindCase_StructValue:
+		w.Message(m.GetStructValue())
+	case Value_KindCase_ListValue:
+		w.Message(m.GetListValue())
+	default:
+		return errors.New("types: Value has no kind set")
+	}
+	return nil
+}
+
+// UnmarshalJSONPB reads any JSON value into the Value.
+func (m *Value) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return errors.New("types: empty JSON for Value")
+	}
+	switch data[0] {
+	case 'n':
+		if string(data) != "null" {
+			return errors.Newf("types: invalid JSON %s", data)
+		}
+		return m.SetNullValue(NullValue_NULL_VALUE)
+	case 't', 'f':
+		v, err := jsonpb.Bool(data)
+		if err != nil {
+			return err
+		}
+		return m.SetBoolValue(v)
+	case '"':
+		v, err := jsonpb.String(data)
+		if err != nil {
+			return err
+		}
+		return m.SetStringValue(v)
+	case '{':
+		s := &Struct{}
+		if err := s.UnmarshalJSONPB(u, data); err != nil {
+			return err
+		}
+		m.ClearKind()
+		field, _ := m.MutateStructValue()
+		field.MergeFrom(s)
+	case '[':
+		list := &ListValue{}
+		if err := list.UnmarshalJSONPB(u, data); err != nil {
+			return err
+		}
+		m.ClearKind()
+		field, _ := m.MutateListValue()
+		field.MergeFrom(list)
+	default:
+		v, err := jsonpb.Float64(data)
+		if err != nil {
+			return err
+		}
+		return m.SetNumberValue(v)
+	}
+	return nil
+}
+
+// MarshalJSONPB writes the ListValue as a JSON array.
+func (m *ListValue) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginArray()
+	for i := 0; i < m.ValuesSize(); i++ {
+		value, _ := m.GetValues(i)
+		w.Message(value)
+	}
+	w.EndArray()
+	return nil
+}
+
+// UnmarshalJSONPB appends the elements of a JSON array to the ListValue.
+func (m *ListValue) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	elems, err := jsonpb.Array(data)
+	if err != nil {
+		return err
+	}
+	for _, raw := range elems {
+		value, err := m.AddValues()
+		if err != nil {
+			return err
+		}
+		if err := value.UnmarshalJSONPB(u, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,2246 @@
+// Code generated by protoc-gen-dgo.
+// source: struct.proto
+// DO NOT EDIT!
+
+package types
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+// NullValue is a singleton enumeration representing the null value of the
+// Value type union. In JSON it is written as null.
+type NullValue int32
+
+const (
+	// Null value.
+	NullValue_NULL_VALUE NullValue = 0
+)
+
+var NullValue_name = map[int32]string{
+	0: "NULL_VALUE",
+}
+var NullValue_value = map[string]int32{
+	"NULL_VALUE": 0,
+}
+
+func (x NullValue) Enum() *NullValue {
+	p := new(NullValue)
+	*p = x
+	return p
+}
+func (x NullValue) String() string {
+	return proto.EnumName(NullValue_name, int32(x))
+}
+
+// A Struct represents a structured data value, made of fields which map to
+// dynamically typed values. In JSON a Struct is written as an object.
+type Struct struct {
+	xxx_sizeCached int
+	// The dynamically typed values of the fields.
+	fields           map[string]*Value
+	XXX_unrecognized []byte
+}
+
+func (m *Struct) Reset()         { *m = Struct{} }
+func (m *Struct) String() string { return proto.CompactTextString(m) }
+func (*Struct) ProtoMessage()    {}
+
+func (m *Struct) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Struct) GetFields(key string) (value *Value, ok bool) {
+	if m != nil {
+		value, ok = m.fields[key]
+	}
+	return value, ok
+}
+
+func (m *Struct) PutFields(key string, value *Value) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	if m.fields == nil {
+		m.fields = make(map[string]*Value)
+	}
+	m.fields[key] = value
+	return nil
+}
+
+func (m *Struct) DeleteFields(key string) {
+	if m != nil {
+		delete(m.fields, key)
+	}
+}
+
+func (m *Struct) FieldsLen() (size int) {
+	if m != nil {
+		return len(m.fields)
+	}
+	return 0
+}
+
+func (m *Struct) RangeFields(f func(key string, value *Value) bool) {
+	if m != nil {
+		for k, v := range m.fields {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Struct) ClearFields() {
+	if m != nil {
+		m.fields = nil
+	}
+}
+
+func (m *Struct) Clear() {
+	if m != nil {
+		m.ClearFields()
+	}
+}
+
+type Struct_FieldsEntry struct {
+	xxx_sizeCached   int
+	key              string
+	value            *Value
+	XXX_unrecognized []byte
+	xxx_IsValueSet   bool
+}
+
+func (m *Struct_FieldsEntry) Reset()         { *m = Struct_FieldsEntry{} }
+func (m *Struct_FieldsEntry) String() string { return proto.CompactTextString(m) }
+func (*Struct_FieldsEntry) ProtoMessage()    {}
+
+func (m *Struct_FieldsEntry) GetKey() string {
+	if m != nil {
+		return m.key
+	}
+	return ""
+}
+
+func (m *Struct_FieldsEntry) GetValue() *Value {
+	if m != nil && m.xxx_IsValueSet {
+		return m.value
+	}
+	return nil
+}
+func (m *Struct_FieldsEntry) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Struct_FieldsEntry) SetKey(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.key = value
+	return nil
+}
+
+func (m *Struct_FieldsEntry) ClearKey() {
+	if m != nil {
+		m.key = ""
+	}
+}
+
+func (m *Struct_FieldsEntry) MutateValue() (field *Value, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if !m.xxx_IsValueSet {
+		m.xxx_IsValueSet = true
+		if m.value == nil {
+			m.value = new(Value)
+		} else {
+			m.value.Clear()
+		}
+	}
+	return m.value, nil
+}
+
+func (m *Struct_FieldsEntry) HasValue() (isSet bool) {
+	if m != nil && m.xxx_IsValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Struct_FieldsEntry) ClearValue() {
+	if m != nil {
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+func (m *Struct_FieldsEntry) Clear() {
+	if m != nil {
+		m.ClearKey()
+		m.value.Clear()
+		m.xxx_IsValueSet = false
+
+	}
+}
+
+// A Value represents a dynamically typed value, which is either null, a
+// number, a string, a boolean, a recursive struct or a list of values. In
+// JSON a Value is written as the corresponding JSON value.
+type Value struct {
+	xxx_sizeCached int
+	// Represents a null value.
+	nullValue NullValue
+	// Represents a double value.
+	numberValue float64
+	// Represents a string value.
+	stringValue string
+	// Represents a boolean value.
+	boolValue bool
+	// Represents a structured value.
+	structValue *Struct
+	// Represents a repeated Value.
+	listValue            *ListValue
+	XXX_unrecognized     []byte
+	xxx_IsNullValueSet   bool
+	xxx_IsNumberValueSet bool
+	xxx_IsStringValueSet bool
+	xxx_IsBoolValueSet   bool
+	xxx_IsStructValueSet bool
+	xxx_IsListValueSet   bool
+	xxx_KindCase         Value_KindCase
+}
+
+func (m *Value) Reset()         { *m = Value{} }
+func (m *Value) String() string { return proto.CompactTextString(m) }
+func (*Value) ProtoMessage()    {}
+
+func (m *Value) GetNullValue() NullValue {
+	if m != nil && m.xxx_IsNullValueSet {
+		return m.nullValue
+	}
+	return NullValue_NULL_VALUE
+}
+
+func (m *Value) GetNumberValue() float64 {
+	if m != nil && m.xxx_IsNumberValueSet {
+		return m.numberValue
+	}
+	return 0
+}
+
+func (m *Value) GetStringValue() string {
+	if m != nil && m.xxx_IsStringValueSet {
+		return m.stringValue
+	}
+	return ""
+}
+
+func (m *Value) GetBoolValue() bool {
+	if m != nil && m.xxx_IsBoolValueSet {
+		return m.boolValue
+	}
+	return false
+}
+
+func (m *Value) GetStructValue() *Struct {
+	if m != nil && m.xxx_IsStructValueSet {
+		return m.structValue
+	}
+	return nil
+}
+func (m *Value) GetListValue() *ListValue {
+	if m != nil && m.xxx_IsListValueSet {
+		return m.listValue
+	}
+	return nil
+}
+func (m *Value) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+type Value_KindCase int32
+
+const (
+	Value_KindCase_NotSet      Value_KindCase = 0
+	Value_KindCase_NullValue   Value_KindCase = 1
+	Value_KindCase_NumberValue Value_KindCase = 2
+	Value_KindCase_StringValue Value_KindCase = 3
+	Value_KindCase_BoolValue   Value_KindCase = 4
+	Value_KindCase_StructValue Value_KindCase = 5
+	Value_KindCase_ListValue   Value_KindCase = 6
+)
+
+func (m *Value) WhichKind() Value_KindCase {
+	if m != nil {
+		return m.xxx_KindCase
+	}
+	return Value_KindCase_NotSet
+}
+
+func (m *Value) ClearKind() {
+	if m != nil {
+		switch m.xxx_KindCase {
+		case Value_KindCase_NullValue:
+			m.ClearNullValue()
+		case Value_KindCase_NumberValue:
+			m.ClearNumberValue()
+		case Value_KindCase_StringValue:
+			m.ClearStringValue()
+		case Value_KindCase_BoolValue:
+			m.ClearBoolValue()
+		case Value_KindCase_StructValue:
+			m.ClearStructValue()
+		case Value_KindCase_ListValue:
+			m.ClearListValue()
+		}
+		m.xxx_KindCase = Value_KindCase_NotSet
+	}
+}
+
+func (m *Value) SetNullValue(value NullValue) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_KindCase != Value_KindCase_NullValue {
+		m.ClearKind()
+		m.xxx_KindCase = Value_KindCase_NullValue
+	}
+	m.xxx_IsNullValueSet = true
+	m.nullValue = value
+	return nil
+}
+
+func (m *Value) HasNullValue() (isSet bool) {
+	if m != nil && m.xxx_IsNullValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Value) ClearNullValue() {
+	if m != nil {
+		m.xxx_IsNullValueSet = false
+		if m.xxx_KindCase == Value_KindCase_NullValue {
+			m.xxx_KindCase = Value_KindCase_NotSet
+		}
+	}
+}
+
+func (m *Value) SetNumberValue(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_KindCase != Value_KindCase_NumberValue {
+		m.ClearKind()
+		m.xxx_KindCase = Value_KindCase_NumberValue
+	}
+	m.xxx_IsNumberValueSet = true
+	m.numberValue = value
+	return nil
+}
+
+func (m *Value) HasNumberValue() (isSet bool) {
+	if m != nil && m.xxx_IsNumberValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Value) ClearNumberValue() {
+	if m != nil {
+		m.xxx_IsNumberValueSet = false
+		if m.xxx_KindCase == Value_KindCase_NumberValue {
+			m.xxx_KindCase = Value_KindCase_NotSet
+		}
+	}
+}
+
+func (m *Value) SetStringValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_KindCase != Value_KindCase_StringValue {
+		m.ClearKind()
+		m.xxx_KindCase = Value_KindCase_StringValue
+	}
+	m.xxx_IsStringValueSet = true
+	m.stringValue = value
+	return nil
+}
+
+func (m *Value) HasStringValue() (isSet bool) {
+	if m != nil && m.xxx_IsStringValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Value) ClearStringValue() {
+	if m != nil {
+		m.xxx_IsStringValueSet = false
+		m.stringValue = ""
+		if m.xxx_KindCase == Value_KindCase_StringValue {
+			m.xxx_KindCase = Value_KindCase_NotSet
+		}
+	}
+}
+
+func (m *Value) SetBoolValue(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if m.xxx_KindCase != Value_KindCase_BoolValue {
+		m.ClearKind()
+		m.xxx_KindCase = Value_KindCase_BoolValue
+	}
+	m.xxx_IsBoolValueSet = true
+	m.boolValue = value
+	return nil
+}
+
+func (m *Value) HasBoolValue() (isSet bool) {
+	if m != nil && m.xxx_IsBoolValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Value) ClearBoolValue() {
+	if m != nil {
+		m.xxx_IsBoolValueSet = false
+		if m.xxx_KindCase == Value_KindCase_BoolValue {
+			m.xxx_KindCase = Value_KindCase_NotSet
+		}
+	}
+}
+
+func (m *Value) MutateStructValue() (field *Struct, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if m.xxx_KindCase != Value_KindCase_StructValue {
+		m.ClearKind()
+		m.xxx_KindCase = Value_KindCase_StructValue
+	}
+	if !m.xxx_IsStructValueSet {
+		m.xxx_IsStructValueSet = true
+		if m.structValue == nil {
+			m.structValue = new(Struct)
+		} else {
+			m.structValue.Clear()
+		}
+	}
+	return m.structValue, nil
+}
+
+func (m *Value) HasStructValue() (isSet bool) {
+	if m != nil && m.xxx_IsStructValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Value) ClearStructValue() {
+	if m != nil {
+		m.structValue.Clear()
+		m.xxx_IsStructValueSet = false
+
+		if m.xxx_KindCase == Value_KindCase_StructValue {
+			m.xxx_KindCase = Value_KindCase_NotSet
+		}
+	}
+}
+
+func (m *Value) MutateListValue() (field *ListValue, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if m.xxx_KindCase != Value_KindCase_ListValue {
+		m.ClearKind()
+		m.xxx_KindCase = Value_KindCase_ListValue
+	}
+	if !m.xxx_IsListValueSet {
+		m.xxx_IsListValueSet = true
+		if m.listValue == nil {
+			m.listValue = new(ListValue)
+		} else {
+			m.listValue.Clear()
+		}
+	}
+	return m.listValue, nil
+}
+
+func (m *Value) HasListValue() (isSet bool) {
+	if m != nil && m.xxx_IsListValueSet {
+		return true
+	}
+	return false
+}
+
+func (m *Value) ClearListValue() {
+	if m != nil {
+		m.listValue.Clear()
+		m.xxx_IsListValueSet = false
+
+		if m.xxx_KindCase == Value_KindCase_ListValue {
+			m.xxx_KindCase = Value_KindCase_NotSet
+		}
+	}
+}
+
+func (m *Value) Clear() {
+	if m != nil {
+		m.ClearNullValue()
+		m.ClearNumberValue()
+		m.ClearStringValue()
+		m.ClearBoolValue()
+		m.structValue.Clear()
+		m.xxx_IsStructValueSet = false
+
+		m.listValue.Clear()
+		m.xxx_IsListValueSet = false
+
+		m.xxx_KindCase = Value_KindCase_NotSet
+	}
+}
+
+// A ListValue is a wrapper around a repeated field of values. In JSON a
+// ListValue is written as an array.
+type ListValue struct {
+	xxx_sizeCached int
+	// The repeated dynamically typed values.
+	values           []*Value
+	XXX_unrecognized []byte
+	xxx_LenValues    int
+}
+
+func (m *ListValue) Reset()         { *m = ListValue{} }
+func (m *ListValue) String() string { return proto.CompactTextString(m) }
+func (*ListValue) ProtoMessage()    {}
+
+func (m *ListValue) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *ListValue) AddValues() (field *Value, err error) {
+	if m != nil {
+		if len(m.values) <= m.xxx_LenValues {
+			newCapacity := 0
+			if len(m.values) == 0 {
+				newCapacity = 8
+			} else if len(m.values) < 1000000 {
+				newCapacity = m.xxx_LenValues * 2
+			} else {
+				newCapacity = m.xxx_LenValues + 1000000
+			}
+			t := make([]*Value, newCapacity, newCapacity)
+			copy(t, m.values)
+			m.values = t
+		}
+		field = m.values[m.xxx_LenValues]
+		if field == nil {
+			field = new(Value)
+			m.values[m.xxx_LenValues] = field
+		} else {
+			field.Clear()
+		}
+		m.xxx_LenValues += 1
+		return field, nil
+	}
+	return nil, errors.New("Cannot append to nil message")
+}
+
+func (m *ListValue) MutateValues(index int) (field *Value, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot mutate a nil message")
+	}
+	if index < 0 || index >= m.xxx_LenValues {
+		return nil, errors.New("Index is out of bounds")
+	}
+	if m.values[index] == nil {
+		m.values[index] = new(Value)
+	}
+	return m.values[index], nil
+}
+
+func (m *ListValue) ValuesSize() (size int) {
+	if m != nil {
+		return m.xxx_LenValues
+	}
+	return 0
+}
+
+func (m *ListValue) ClearValues() {
+	if m != nil {
+		for i := 0; i < m.ValuesSize(); i++ {
+			m.values[i].Clear()
+		}
+		m.xxx_LenValues = 0
+
+	}
+}
+
+func (m *ListValue) GetValues(index int) (field *Value, err error) {
+	if m == nil {
+		return nil, errors.New("Cannot get nil message")
+	}
+	if index < 0 || index >= m.xxx_LenValues {
+		return nil, errors.New("Index is out of bounds")
+	}
+	return m.values[index], nil
+}
+
+func (m *ListValue) Clear() {
+	if m != nil {
+		for i := 0; i < m.ValuesSize(); i++ {
+			m.values[i].Clear()
+		}
+		m.xxx_LenValues = 0
+
+	}
+}
+
+func (m *Struct) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.fields) > 0 {
+		for k, v := range m.fields {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovStruct(uint64(len(k))) + 1 + l + sovStruct(uint64(l))
+			n += 1 + mapEntrySize + sovStruct(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Struct_FieldsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.key) > 0 {
+		l = len(m.key)
+		n += 1 + l + sovStruct(uint64(l))
+	}
+	if m.xxx_IsValueSet {
+		l = m.value.Size()
+		n += 1 + l + sovStruct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_KindCase == Value_KindCase_NullValue {
+		n += 1 + sovStruct(uint64(m.nullValue))
+	}
+	if m.xxx_KindCase == Value_KindCase_NumberValue {
+		n += 9
+	}
+	if m.xxx_KindCase == Value_KindCase_StringValue {
+		l = len(m.stringValue)
+		n += 1 + l + sovStruct(uint64(l))
+	}
+	if m.xxx_KindCase == Value_KindCase_BoolValue {
+		n += 2
+	}
+	if m.xxx_KindCase == Value_KindCase_StructValue {
+		l = m.structValue.Size()
+		n += 1 + l + sovStruct(uint64(l))
+	}
+	if m.xxx_KindCase == Value_KindCase_ListValue {
+		l = m.listValue.Size()
+		n += 1 + l + sovStruct(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *ListValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.xxx_LenValues > 0 {
+		for i := 0; i < m.xxx_LenValues; i++ {
+			e := m.values[i]
+			l = e.Size()
+			n += 1 + l + sovStruct(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovStruct(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStruct(x uint64) (n int) {
+	return sovStruct(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Struct) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Struct) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Struct) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.fields) > 0 {
+		keys := make([]string, 0, len(m.fields))
+		for k := range m.fields {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.fields[k]
+			data[i] = 0xa
+			i++
+			mapEntrySize := 1 + len(k) + sovStruct(uint64(len(k))) + 1 + v.SizeCached() + sovStruct(uint64(v.SizeCached()))
+			i = encodeVarintStruct(data, i, uint64(mapEntrySize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintStruct(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintStruct(data, i, uint64(v.SizeCached()))
+			nn, err := v.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += nn
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Struct_FieldsEntry) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Struct_FieldsEntry) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Struct_FieldsEntry) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.key) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintStruct(data, i, uint64(len(m.key)))
+		i += copy(data[i:], m.key)
+	}
+	if m.xxx_IsValueSet {
+		data[i] = 0x12
+		i++
+		i = encodeVarintStruct(data, i, uint64(m.value.SizeCached()))
+		n1, err := m.value.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Value) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Value) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Value) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_KindCase == Value_KindCase_NullValue {
+		data[i] = 0x8
+		i++
+		i = encodeVarintStruct(data, i, uint64(m.nullValue))
+	}
+	if m.xxx_KindCase == Value_KindCase_NumberValue {
+		data[i] = 0x11
+		i++
+		i = encodeFixed64Struct(data, i, uint64(math.Float64bits(float64(m.numberValue))))
+	}
+	if m.xxx_KindCase == Value_KindCase_StringValue {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStruct(data, i, uint64(len(m.stringValue)))
+		i += copy(data[i:], m.stringValue)
+	}
+	if m.xxx_KindCase == Value_KindCase_BoolValue {
+		data[i] = 0x20
+		i++
+		if m.boolValue {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.xxx_KindCase == Value_KindCase_StructValue {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintStruct(data, i, uint64(m.structValue.SizeCached()))
+		n2, err := m.structValue.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.xxx_KindCase == Value_KindCase_ListValue {
+		data[i] = 0x32
+		i++
+		i = encodeVarintStruct(data, i, uint64(m.listValue.SizeCached()))
+		n3, err := m.listValue.MarshalToUsingCachedSize(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *ListValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ListValue) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *ListValue) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.xxx_LenValues > 0 {
+		for idx := 0; idx < m.xxx_LenValues; idx++ {
+			msg := m.values[idx]
+			data[i] = 0xa
+			i++
+			i = encodeVarintStruct(data, i, uint64(msg.SizeCached()))
+			n, err := msg.MarshalToUsingCachedSize(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Struct(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Struct(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintStruct(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Struct) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entry := &Struct_FieldsEntry{}
+			if err := entry.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			if m.fields == nil {
+				m.fields = make(map[string]*Value)
+			}
+			if entry.value == nil {
+				entry.value = new(Value)
+			}
+			m.fields[entry.key] = entry.value
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Struct_FieldsEntry) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.key = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Value) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field nullValue", wireType)
+			}
+			if m.xxx_KindCase != Value_KindCase_NullValue {
+				m.ClearKind()
+				m.xxx_KindCase = Value_KindCase_NullValue
+			}
+			m.xxx_IsNullValueSet = true
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.nullValue |= (NullValue(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field numberValue", wireType)
+			}
+			if m.xxx_KindCase != Value_KindCase_NumberValue {
+				m.ClearKind()
+				m.xxx_KindCase = Value_KindCase_NumberValue
+			}
+			m.xxx_IsNumberValueSet = true
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			m.numberValue = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field stringValue", wireType)
+			}
+			if m.xxx_KindCase != Value_KindCase_StringValue {
+				m.ClearKind()
+				m.xxx_KindCase = Value_KindCase_StringValue
+			}
+			m.xxx_IsStringValueSet = true
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.stringValue = string(data[index:postIndex])
+			index = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field boolValue", wireType)
+			}
+			if m.xxx_KindCase != Value_KindCase_BoolValue {
+				m.ClearKind()
+				m.xxx_KindCase = Value_KindCase_BoolValue
+			}
+			m.xxx_IsBoolValueSet = true
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.boolValue = bool(bool(v != 0))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field structValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateStructValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field listValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.MutateListValue()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *ListValue) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v, _ := m.AddValues()
+			if err := v.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Struct) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Struct) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Struct_FieldsEntry) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Struct_FieldsEntry) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Value) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Value) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *ListValue) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *ListValue) CheckInitialized() error {
+	return nil
+}
+
+func (m *Struct) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Struct) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Struct_FieldsEntry) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.BeginObject()
+	if len(m.key) > 0 || w.EmitDefaults() {
+		w.Field("key", "key")
+		w.String(m.GetKey())
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value", "value")
+		w.Message(m.GetValue())
+	} else if w.EmitDefaults() {
+		w.Field("value", "value")
+		w.Null()
+	}
+	w.EndObject()
+	return nil
+}
+
+func (m *Struct_FieldsEntry) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	fields, err := u.Fields(data)
+	if err != nil {
+		return err
+	}
+	if raw, ok := fields.Get("key", "key"); ok {
+		v, err := jsonpb.String(raw)
+		if err != nil {
+			return err
+		}
+		if err := m.SetKey(v); err != nil {
+			return err
+		}
+	}
+	if raw, ok := fields.Get("value", "value"); ok {
+		v, err := m.MutateValue()
+		if err != nil {
+			return err
+		}
+		if err := u.Message(raw, v); err != nil {
+			return err
+		}
+	}
+	return fields.Done()
+}
+
+func (m *Struct_FieldsEntry) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Struct_FieldsEntry) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Value) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Value) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *ListValue) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *ListValue) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Struct) MarshalTextFields(w *proto.TextWriter) {
+	if len(m.fields) > 0 {
+		keys := make([]string, 0, len(m.fields))
+		for k := range m.fields {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, k := range keys {
+			v := m.fields[k]
+			w.Field("fields")
+			w.Message(&Struct_FieldsEntry{key: k, value: v, xxx_IsValueSet: true})
+		}
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Struct) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "fields":
+		entry := &Struct_FieldsEntry{}
+		if err := p.ReadMessage(entry); err != nil {
+			return true, err
+		}
+		if m.fields == nil {
+			m.fields = make(map[string]*Value)
+		}
+		if entry.value == nil {
+			entry.value = new(Value)
+		}
+		m.fields[entry.key] = entry.value
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *Struct_FieldsEntry) MarshalTextFields(w *proto.TextWriter) {
+	if len(m.key) > 0 {
+		w.Field("key")
+		w.Value(m.key)
+	}
+	if m.xxx_IsValueSet {
+		w.Field("value")
+		w.Message(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Struct_FieldsEntry) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "key":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetKey(v)
+	case "value":
+		v, err := m.MutateValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Value) MarshalTextFields(w *proto.TextWriter) {
+	if m.xxx_KindCase == Value_KindCase_NullValue {
+		w.Field("null_value")
+		w.Enum(int32(m.nullValue), NullValue_name)
+	}
+	if m.xxx_KindCase == Value_KindCase_NumberValue {
+		w.Field("number_value")
+		w.Value(m.numberValue)
+	}
+	if m.xxx_KindCase == Value_KindCase_StringValue {
+		w.Field("string_value")
+		w.Value(m.stringValue)
+	}
+	if m.xxx_KindCase == Value_KindCase_BoolValue {
+		w.Field("bool_value")
+		w.Value(m.boolValue)
+	}
+	if m.xxx_KindCase == Value_KindCase_StructValue {
+		w.Field("struct_value")
+		w.Message(m.structValue)
+	}
+	if m.xxx_KindCase == Value_KindCase_ListValue {
+		w.Field("list_value")
+		w.Message(m.listValue)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Value) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "null_value":
+		v, err := p.ReadEnum(NullValue_value)
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNullValue(NullValue(v))
+	case "number_value":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNumberValue(v)
+	case "string_value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetStringValue(v)
+	case "bool_value":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetBoolValue(v)
+	case "struct_value":
+		v, err := m.MutateStructValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	case "list_value":
+		v, err := m.MutateListValue()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *ListValue) MarshalTextFields(w *proto.TextWriter) {
+	for i := 0; i < m.xxx_LenValues; i++ {
+		w.Field("values")
+		w.Message(m.values[i])
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *ListValue) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "values":
+		v, err := m.AddValues()
+		if err != nil {
+			return true, err
+		}
+		return true, p.ReadMessage(v)
+	}
+	return false, nil
+}
+
+func (m *Struct) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Struct{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Struct) MergeFrom(src proto.Message) {
+	s, ok := src.(*Struct)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if len(s.fields) > 0 {
+		if m.fields == nil {
+			m.fields = make(map[string]*Value, len(s.fields))
+		}
+		for k, v := range s.fields {
+			m.fields[k] = v.Clone().(*Value)
+		}
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Struct) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Struct)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if len(m.fields) != len(o.fields) {
+		return false
+	}
+	for k, v := range m.fields {
+		if v2, ok := o.fields[k]; !ok || !v.Equal(v2) {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Struct_FieldsEntry) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Struct_FieldsEntry{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Struct_FieldsEntry) MergeFrom(src proto.Message) {
+	s, ok := src.(*Struct_FieldsEntry)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if len(s.key) > 0 {
+		m.SetKey(s.key)
+	}
+	if s.xxx_IsValueSet {
+		v, _ := m.MutateValue()
+		v.MergeFrom(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Struct_FieldsEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Struct_FieldsEntry)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.key != o.key {
+		return false
+	}
+	if m.xxx_IsValueSet != o.xxx_IsValueSet {
+		return false
+	}
+	if m.xxx_IsValueSet && !m.value.Equal(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Value) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Value{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Value) MergeFrom(src proto.Message) {
+	s, ok := src.(*Value)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.xxx_KindCase == Value_KindCase_NullValue {
+		m.SetNullValue(s.nullValue)
+	}
+	if s.xxx_KindCase == Value_KindCase_NumberValue {
+		m.SetNumberValue(s.numberValue)
+	}
+	if s.xxx_KindCase == Value_KindCase_StringValue {
+		m.SetStringValue(s.stringValue)
+	}
+	if s.xxx_KindCase == Value_KindCase_BoolValue {
+		m.SetBoolValue(s.boolValue)
+	}
+	if s.xxx_KindCase == Value_KindCase_StructValue {
+		v, _ := m.MutateStructValue()
+		v.MergeFrom(s.structValue)
+	}
+	if s.xxx_KindCase == Value_KindCase_ListValue {
+		v, _ := m.MutateListValue()
+		v.MergeFrom(s.listValue)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Value) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Value)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.xxx_KindCase == Value_KindCase_NullValue) != (o.xxx_KindCase == Value_KindCase_NullValue) {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_NullValue) && m.nullValue != o.nullValue {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_NumberValue) != (o.xxx_KindCase == Value_KindCase_NumberValue) {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_NumberValue) && m.numberValue != o.numberValue {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_StringValue) != (o.xxx_KindCase == Value_KindCase_StringValue) {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_StringValue) && m.stringValue != o.stringValue {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_BoolValue) != (o.xxx_KindCase == Value_KindCase_BoolValue) {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_BoolValue) && m.boolValue != o.boolValue {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_StructValue) != (o.xxx_KindCase == Value_KindCase_StructValue) {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_StructValue) && !m.structValue.Equal(o.structValue) {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_ListValue) != (o.xxx_KindCase == Value_KindCase_ListValue) {
+		return false
+	}
+	if (m.xxx_KindCase == Value_KindCase_ListValue) && !m.listValue.Equal(o.listValue) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *ListValue) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &ListValue{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *ListValue) MergeFrom(src proto.Message) {
+	s, ok := src.(*ListValue)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.xxx_LenValues; i++ {
+		v, _ := m.AddValues()
+		v.MergeFrom(s.values[i])
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *ListValue) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*ListValue)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.xxx_LenValues != o.xxx_LenValues {
+		return false
+	}
+	for i := 0; i < m.xxx_LenValues; i++ {
+		if !m.values[i].Equal(o.values[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionStruct = &proto.MessageInfo{
+	Name: "google.protobuf.Struct",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "fields",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			Map:    true,
+			Get: func(m proto.Message) interface{} {
+				x := m.(*Struct)
+				v := make(map[string]*Value, x.FieldsLen())
+				x.RangeFields(func(key string, value *Value) bool {
+					v[key] = value
+					return true
+				})
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(map[string]*Value)
+				if !ok {
+					return proto.NewFieldTypeError(m, "fields", v)
+				}
+				x := m.(*Struct)
+				x.ClearFields()
+				for k, e := range value {
+					if err := x.PutFields(k, e.Clone().(*Value)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Struct).FieldsLen() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Struct).ClearFields()
+			},
+		},
+	},
+}
+
+func (m *Struct) ProtoReflect() *proto.MessageInfo {
+	return reflectionStruct
+}
+
+var reflectionStruct_FieldsEntry = &proto.MessageInfo{
+	Name: "google.protobuf.Struct.FieldsEntry",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "key",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*Struct_FieldsEntry)(nil).GetKey(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Struct_FieldsEntry).GetKey()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "key", v)
+				}
+				x := m.(*Struct_FieldsEntry)
+				return x.SetKey(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Struct_FieldsEntry)
+				return len(x.key) > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Struct_FieldsEntry).ClearKey()
+			},
+		},
+		{
+			Number: 2,
+			Name:   "value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			New: func() proto.Message {
+				return new(Value)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Struct_FieldsEntry).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Value)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Struct_FieldsEntry)
+				x.ClearValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Struct_FieldsEntry).HasValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Struct_FieldsEntry).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Struct_FieldsEntry) ProtoReflect() *proto.MessageInfo {
+	return reflectionStruct_FieldsEntry
+}
+
+var reflectionValue = &proto.MessageInfo{
+	Name: "google.protobuf.Value",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "null_value",
+			Kind:    proto.EnumKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "kind",
+			Default: (*Value)(nil).GetNullValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Value).GetNullValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(NullValue)
+				if !ok {
+					return proto.NewFieldTypeError(m, "null_value", v)
+				}
+				x := m.(*Value)
+				return x.SetNullValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Value).HasNullValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Value).ClearNullValue()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "number_value",
+			Kind:    proto.DoubleKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "kind",
+			Default: (*Value)(nil).GetNumberValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Value).GetNumberValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "number_value", v)
+				}
+				x := m.(*Value)
+				return x.SetNumberValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Value).HasNumberValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Value).ClearNumberValue()
+			},
+		},
+		{
+			Number:  3,
+			Name:    "string_value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "kind",
+			Default: (*Value)(nil).GetStringValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Value).GetStringValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "string_value", v)
+				}
+				x := m.(*Value)
+				return x.SetStringValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Value).HasStringValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Value).ClearStringValue()
+			},
+		},
+		{
+			Number:  4,
+			Name:    "bool_value",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Oneof:   "kind",
+			Default: (*Value)(nil).GetBoolValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Value).GetBoolValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "bool_value", v)
+				}
+				x := m.(*Value)
+				return x.SetBoolValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Value).HasBoolValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Value).ClearBoolValue()
+			},
+		},
+		{
+			Number: 5,
+			Name:   "struct_value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			Oneof:  "kind",
+			New: func() proto.Message {
+				return new(Struct)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Value).GetStructValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*Struct)
+				if !ok {
+					return proto.NewFieldTypeError(m, "struct_value", v)
+				}
+				x := m.(*Value)
+				x.ClearStructValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateStructValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Value).HasStructValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Value).ClearStructValue()
+			},
+		},
+		{
+			Number: 6,
+			Name:   "list_value",
+			Kind:   proto.MessageKind,
+			Label:  proto.OptionalLabel,
+			Oneof:  "kind",
+			New: func() proto.Message {
+				return new(ListValue)
+			},
+			Get: func(m proto.Message) interface{} {
+				return m.(*Value).GetListValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(*ListValue)
+				if !ok {
+					return proto.NewFieldTypeError(m, "list_value", v)
+				}
+				x := m.(*Value)
+				x.ClearListValue()
+				if value == nil {
+					return nil
+				}
+				field, err := x.MutateListValue()
+				if err != nil {
+					return err
+				}
+				field.MergeFrom(value)
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*Value).HasListValue()
+			},
+			Clear: func(m proto.Message) {
+				m.(*Value).ClearListValue()
+			},
+		},
+	},
+}
+
+func (m *Value) ProtoReflect() *proto.MessageInfo {
+	return reflectionValue
+}
+
+var reflectionListValue = &proto.MessageInfo{
+	Name: "google.protobuf.ListValue",
+	Fields: []*proto.FieldInfo{
+		{
+			Number: 1,
+			Name:   "values",
+			Kind:   proto.MessageKind,
+			Label:  proto.RepeatedLabel,
+			New: func() proto.Message {
+				return new(Value)
+			},
+			Get: func(m proto.Message) interface{} {
+				x := m.(*ListValue)
+				v := make([]*Value, x.xxx_LenValues)
+				copy(v, x.values)
+				return v
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]*Value)
+				if !ok {
+					return proto.NewFieldTypeError(m, "values", v)
+				}
+				x := m.(*ListValue)
+				x.ClearValues()
+				for _, e := range value {
+					field, err := x.AddValues()
+					if err != nil {
+						return err
+					}
+					field.MergeFrom(e)
+				}
+				return nil
+			},
+			Has: func(m proto.Message) bool {
+				return m.(*ListValue).ValuesSize() > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*ListValue).ClearValues()
+			},
+		},
+	},
+}
+
+func (m *ListValue) ProtoReflect() *proto.MessageInfo {
+	return reflectionListValue
+}
+
+func init() {
+	proto.RegisterEnum("google.protobuf.NullValue", NullValue_name, NullValue_value)
+	proto.RegisterType((*Struct)(nil), "google.protobuf.Struct")
+	proto.RegisterType((*Value)(nil), "google.protobuf.Value")
+	proto.RegisterType((*ListValue)(nil), "google.protobuf.ListValue")
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dropbox/godropbox/errors"
+
+	"github.com/dropbox/goprotoc/jsonpb"
+)
+
+const (
+	// The seconds of 0001-01-01T00:00:00Z, the earliest valid Timestamp.
+	minValidSeconds = -62135596800
+	// The seconds of 10000-01-01T00:00:00Z, just after the latest valid
+	// Timestamp.
+	maxValidSeconds = 253402300800
+)
+
+// Returns an error if ts is nil or outside of the range of valid timestamps.
+func validateTimestamp(ts *Timestamp) error {
+	if ts == nil {
+		return errors.New("types: nil Timestamp")
+	}
+	if ts.GetSeconds() < minValidSeconds || ts.GetSeconds() >= maxValidSeconds {
+		return errors.Newf("types: Timestamp %v is out of range", ts)
+	}
+	if ts.GetNanos() < 0 || ts.GetNanos() >= 1e9 {
+		return errors.Newf("types: Timestamp %v has out of range nanos", ts)
+	}
+	return nil
+}
+
+// TimestampFromProto converts a Timestamp to a time.Time in UTC. An error is
+// returned if ts is nil or out of range, along with the time it would
+// represent if it was not nil.
+func TimestampFromProto(ts *Timestamp) (time.Time, error) {
+	var t time.Time
+	if ts == nil {
+		t = time.Unix(0, 0).UTC()
+	} else {
+		t = time.Unix(ts.GetSeconds(), int64(ts.GetNanos())).UTC()
+	}
+	return t, validateTimestamp(ts)
+}
+
+// TimestampProto converts a time.Time to a Timestamp. An error is returned
+// if t is before year 1 or after year 9999.
+func TimestampProto(t time.Time) (*Timestamp, error) {
+	ts := &Timestamp{}
+	ts.SetSeconds(t.Unix())
+	ts.SetNanos(int32(t.Nanosecond()))
+	if err := validateTimestamp(ts); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// TimestampNow returns the current time as a Timestamp.
+func TimestampNow() *Timestamp {
+	ts, err := TimestampProto(time.Now())
+	if err != nil {
+		panic("types: the current time is out of range: " + err.Error())
+	}
+	return ts
+}
+
+// Formats nanos as a fraction of a second using 3, 6 or 9 digits, or none if
+// nanos is 0.
+func formatNanos(nanos int32) string {
+	if nanos == 0 {
+		return ""
+	}
+	s := fmt.Sprintf("%09d", nanos)
+	for strings.HasSuffix(s, "000") {
+		s = s[:len(s)-3]
+	}
+	return "." + s
+}
+
+// MarshalJSONPB writes the timestamp as an RFC 3339 string in UTC, such as
+// "1972-01-01T10:00:20.021Z".
+func (m *Timestamp) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	t, err := TimestampFromProto(m)
+	if err != nil {
+		return err
+	}
+	w.String(t.Format("2006-01-02T15:04:05") + formatNanos(m.GetNanos()) + "Z")
+	return nil
+}
+
+// UnmarshalJSONPB reads an RFC 3339 string, which may have any time zone
+// offset.
+func (m *Timestamp) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	s, err := jsonpb.String(data)
+	if err != nil {
+		return err
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return errors.Newf("types: invalid Timestamp %q: %v", s, err)
+	}
+	ts, err := TimestampProto(t)
+	if err != nil {
+		return err
+	}
+	m.SetSeconds(ts.GetSeconds())
+	m.SetNanos(ts.GetNanos())
+	return nil
+}
@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-dgo.
+// source: timestamp.proto
+// DO NOT EDIT!
+
+package types
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+// A Timestamp represents a point in time independent of any time zone, as a
+// count of seconds and fractions of seconds at nanosecond resolution since
+// the Unix epoch, 1970-01-01T00:00:00Z. The range is from
+// 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z.
+//
+// In JSON a Timestamp is written as an RFC 3339 string such as
+// "1972-01-01T10:00:20.021Z".
+type Timestamp struct {
+	xxx_sizeCached int
+	// Seconds since the Unix epoch. Must be from -62135596800 to 253402300799
+	// inclusive.
+	seconds int64
+	// Non-negative fractions of a second at nanosecond resolution. Negative
+	// times with fractions must still have non-negative nanos that count
+	// forward in time. Must be from 0 to 999,999,999 inclusive.
+	nanos            int32
+	XXX_unrecognized []byte
+}
+
+func (m *Timestamp) Reset()         { *m = Timestamp{} }
+func (m *Timestamp) String() string { return proto.CompactTextString(m) }
+func (*Timestamp) ProtoMessage()    {}
+
+func (m *Timestamp) GetSeconds() int64 {
+	if m != nil {
+		return m.seconds
+	}
+	return 0
+}
+
+func (m *Timestamp) GetNanos() int32 {
+	if m != nil {
+		return m.nanos
+	}
+	return 0
+}
+
+func (m *Timestamp) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Timestamp) SetSeconds(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.seconds = value
+	return nil
+}
+
+func (m *Timestamp) ClearSeconds() {
+	if m != nil {
+		m.seconds = 0
+	}
+}
+
+func (m *Timestamp) SetNanos(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.nanos = value
+	return nil
+}
+
+func (m *Timestamp) ClearNanos() {
+	if m != nil {
+		m.nanos = 0
+	}
+}
+
+func (m *Timestamp) Clear() {
+	if m != nil {
+		m.ClearSeconds()
+		m.ClearNanos()
+	}
+}
+
+func (m *Timestamp) Size() (n int) {
+	var l int
+	_ = l
+	if m.seconds != 0 {
+		n += 1 + sovTimestamp(uint64(m.seconds))
+	}
+	if m.nanos != 0 {
+		n += 1 + sovTimestamp(uint64(uint32(m.nanos)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovTimestamp(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTimestamp(x uint64) (n int) {
+	return sovTimestamp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Timestamp) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Timestamp) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Timestamp) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.seconds != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintTimestamp(data, i, uint64(m.seconds))
+	}
+	if m.nanos != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintTimestamp(data, i, uint64(uint32(m.nanos)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Timestamp(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Timestamp(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintTimestamp(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Timestamp) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field seconds", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.seconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field nanos", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.nanos |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Timestamp) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Timestamp) CheckInitialized() error {
+	return nil
+}
+
+func (m *Timestamp) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Timestamp) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Timestamp) MarshalTextFields(w *proto.TextWriter) {
+	if m.seconds != 0 {
+		w.Field("seconds")
+		w.Value(m.seconds)
+	}
+	if m.nanos != 0 {
+		w.Field("nanos")
+		w.Value(m.nanos)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Timestamp) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "seconds":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetSeconds(v)
+	case "nanos":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetNanos(v)
+	}
+	return false, nil
+}
+
+func (m *Timestamp) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Timestamp{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Timestamp) MergeFrom(src proto.Message) {
+	s, ok := src.(*Timestamp)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.seconds != 0 {
+		m.SetSeconds(s.seconds)
+	}
+	if s.nanos != 0 {
+		m.SetNanos(s.nanos)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Timestamp) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Timestamp)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.seconds != o.seconds {
+		return false
+	}
+	if m.nanos != o.nanos {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionTimestamp = &proto.MessageInfo{
+	Name: "google.protobuf.Timestamp",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "seconds",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Timestamp)(nil).GetSeconds(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Timestamp).GetSeconds()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "seconds", v)
+				}
+				x := m.(*Timestamp)
+				return x.SetSeconds(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Timestamp)
+				return x.seconds != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Timestamp).ClearSeconds()
+			},
+		},
+		{
+			Number:  2,
+			Name:    "nanos",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Timestamp)(nil).GetNanos(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Timestamp).GetNanos()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "nanos", v)
+				}
+				x := m.(*Timestamp)
+				return x.SetNanos(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Timestamp)
+				return x.nanos != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Timestamp).ClearNanos()
+			},
+		},
+	},
+}
+
+func (m *Timestamp) ProtoReflect() *proto.MessageInfo {
+	return reflectionTimestamp
+}
+
+func init() {
+	proto.RegisterType((*Timestamp)(nil), "google.protobuf.Timestamp")
+}
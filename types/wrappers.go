@@ -0,0 +1,187 @@
+// Copyright (c) 2014, Dropbox INC. All rights reserved.
+// www.dropbox.com
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// `AS IS` AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package types
+
+import (
+	"github.com/dropbox/goprotoc/jsonpb"
+)
+
+// The wrappers are written in JSON as the value they hold, using the same
+// representation as a field of the wrapped type.
+
+func (m *DoubleValue) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Float64(m.GetValue())
+	return nil
+}
+
+func (m *DoubleValue) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Float64(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *FloatValue) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Float32(m.GetValue())
+	return nil
+}
+
+func (m *FloatValue) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Float32(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *Int64Value) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Int64(m.GetValue())
+	return nil
+}
+
+func (m *Int64Value) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Int64(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *UInt64Value) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Uint64(m.GetValue())
+	return nil
+}
+
+func (m *UInt64Value) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Uint64(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *Int32Value) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Int32(m.GetValue())
+	return nil
+}
+
+func (m *Int32Value) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Int32(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *UInt32Value) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Uint32(m.GetValue())
+	return nil
+}
+
+func (m *UInt32Value) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Uint32(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *BoolValue) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Bool(m.GetValue())
+	return nil
+}
+
+func (m *BoolValue) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Bool(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *StringValue) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.String(m.GetValue())
+	return nil
+}
+
+func (m *StringValue) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.String(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
+
+func (m *BytesValue) MarshalJSONPB(w *jsonpb.Writer) error {
+	if m == nil {
+		w.Null()
+		return nil
+	}
+	w.Base64(m.GetValue())
+	return nil
+}
+
+func (m *BytesValue) UnmarshalJSONPB(u *jsonpb.Unmarshaler, data []byte) error {
+	v, err := jsonpb.Base64(data)
+	if err != nil {
+		return err
+	}
+	return m.SetValue(v)
+}
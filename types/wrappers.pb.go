@@ -0,0 +1,2453 @@
+// Code generated by protoc-gen-dgo.
+// source: wrappers.proto
+// DO NOT EDIT!
+
+package types
+
+import proto "github.com/dropbox/goprotoc/proto"
+import bytes "bytes"
+import fmt "fmt"
+import io "io"
+import math "math"
+import errors "github.com/dropbox/godropbox/errors"
+import reflect "reflect"
+import sort "sort"
+import jsonpb "github.com/dropbox/goprotoc/jsonpb"
+
+// discarding unused import gogoproto "github.com/dropbox/goprotoc/gogoproto/gogo.pb"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = bytes.Equal
+var _ = fmt.Print
+var _ = io.Copy
+var _ = math.Inf
+var _ = errors.New
+var _ = reflect.Copy
+var _ = sort.Sort
+var _ = jsonpb.Marshal
+
+// Wrapper message for double.
+type DoubleValue struct {
+	xxx_sizeCached int
+	// The double value.
+	value            float64
+	XXX_unrecognized []byte
+}
+
+func (m *DoubleValue) Reset()         { *m = DoubleValue{} }
+func (m *DoubleValue) String() string { return proto.CompactTextString(m) }
+func (*DoubleValue) ProtoMessage()    {}
+
+func (m *DoubleValue) GetValue() float64 {
+	if m != nil {
+		return m.value
+	}
+	return 0
+}
+
+func (m *DoubleValue) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *DoubleValue) SetValue(value float64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *DoubleValue) ClearValue() {
+	if m != nil {
+		m.value = 0.0
+	}
+}
+
+func (m *DoubleValue) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for float.
+type FloatValue struct {
+	xxx_sizeCached int
+	// The float value.
+	value            float32
+	XXX_unrecognized []byte
+}
+
+func (m *FloatValue) Reset()         { *m = FloatValue{} }
+func (m *FloatValue) String() string { return proto.CompactTextString(m) }
+func (*FloatValue) ProtoMessage()    {}
+
+func (m *FloatValue) GetValue() float32 {
+	if m != nil {
+		return m.value
+	}
+	return 0
+}
+
+func (m *FloatValue) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *FloatValue) SetValue(value float32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *FloatValue) ClearValue() {
+	if m != nil {
+		m.value = 0.0
+	}
+}
+
+func (m *FloatValue) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for int64.
+type Int64Value struct {
+	xxx_sizeCached int
+	// The int64 value.
+	value            int64
+	XXX_unrecognized []byte
+}
+
+func (m *Int64Value) Reset()         { *m = Int64Value{} }
+func (m *Int64Value) String() string { return proto.CompactTextString(m) }
+func (*Int64Value) ProtoMessage()    {}
+
+func (m *Int64Value) GetValue() int64 {
+	if m != nil {
+		return m.value
+	}
+	return 0
+}
+
+func (m *Int64Value) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Int64Value) SetValue(value int64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *Int64Value) ClearValue() {
+	if m != nil {
+		m.value = 0
+	}
+}
+
+func (m *Int64Value) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for uint64.
+type UInt64Value struct {
+	xxx_sizeCached int
+	// The uint64 value.
+	value            uint64
+	XXX_unrecognized []byte
+}
+
+func (m *UInt64Value) Reset()         { *m = UInt64Value{} }
+func (m *UInt64Value) String() string { return proto.CompactTextString(m) }
+func (*UInt64Value) ProtoMessage()    {}
+
+func (m *UInt64Value) GetValue() uint64 {
+	if m != nil {
+		return m.value
+	}
+	return 0
+}
+
+func (m *UInt64Value) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *UInt64Value) SetValue(value uint64) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *UInt64Value) ClearValue() {
+	if m != nil {
+		m.value = 0
+	}
+}
+
+func (m *UInt64Value) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for int32.
+type Int32Value struct {
+	xxx_sizeCached int
+	// The int32 value.
+	value            int32
+	XXX_unrecognized []byte
+}
+
+func (m *Int32Value) Reset()         { *m = Int32Value{} }
+func (m *Int32Value) String() string { return proto.CompactTextString(m) }
+func (*Int32Value) ProtoMessage()    {}
+
+func (m *Int32Value) GetValue() int32 {
+	if m != nil {
+		return m.value
+	}
+	return 0
+}
+
+func (m *Int32Value) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *Int32Value) SetValue(value int32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *Int32Value) ClearValue() {
+	if m != nil {
+		m.value = 0
+	}
+}
+
+func (m *Int32Value) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for uint32.
+type UInt32Value struct {
+	xxx_sizeCached int
+	// The uint32 value.
+	value            uint32
+	XXX_unrecognized []byte
+}
+
+func (m *UInt32Value) Reset()         { *m = UInt32Value{} }
+func (m *UInt32Value) String() string { return proto.CompactTextString(m) }
+func (*UInt32Value) ProtoMessage()    {}
+
+func (m *UInt32Value) GetValue() uint32 {
+	if m != nil {
+		return m.value
+	}
+	return 0
+}
+
+func (m *UInt32Value) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *UInt32Value) SetValue(value uint32) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *UInt32Value) ClearValue() {
+	if m != nil {
+		m.value = 0
+	}
+}
+
+func (m *UInt32Value) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for bool.
+type BoolValue struct {
+	xxx_sizeCached int
+	// The bool value.
+	value            bool
+	XXX_unrecognized []byte
+}
+
+func (m *BoolValue) Reset()         { *m = BoolValue{} }
+func (m *BoolValue) String() string { return proto.CompactTextString(m) }
+func (*BoolValue) ProtoMessage()    {}
+
+func (m *BoolValue) GetValue() bool {
+	if m != nil {
+		return m.value
+	}
+	return false
+}
+
+func (m *BoolValue) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *BoolValue) SetValue(value bool) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *BoolValue) ClearValue() {
+	if m != nil {
+		m.value = false
+	}
+}
+
+func (m *BoolValue) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for string.
+type StringValue struct {
+	xxx_sizeCached int
+	// The string value.
+	value            string
+	XXX_unrecognized []byte
+}
+
+func (m *StringValue) Reset()         { *m = StringValue{} }
+func (m *StringValue) String() string { return proto.CompactTextString(m) }
+func (*StringValue) ProtoMessage()    {}
+
+func (m *StringValue) GetValue() string {
+	if m != nil {
+		return m.value
+	}
+	return ""
+}
+
+func (m *StringValue) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *StringValue) SetValue(value string) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *StringValue) ClearValue() {
+	if m != nil {
+		m.value = ""
+	}
+}
+
+func (m *StringValue) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+// Wrapper message for bytes.
+type BytesValue struct {
+	xxx_sizeCached int
+	// The bytes value.
+	value            []byte
+	XXX_unrecognized []byte
+}
+
+func (m *BytesValue) Reset()         { *m = BytesValue{} }
+func (m *BytesValue) String() string { return proto.CompactTextString(m) }
+func (*BytesValue) ProtoMessage()    {}
+
+func (m *BytesValue) GetValue() []byte {
+	if m != nil {
+		return m.value
+	}
+	return nil
+}
+func (m *BytesValue) SizeCached() int {
+	return m.xxx_sizeCached
+}
+
+func (m *BytesValue) SetValue(value []byte) (err error) {
+	if m == nil {
+		return errors.New("Cannot assign to nil message")
+	}
+	if value == nil {
+		return errors.New("Cannot set with a nil value.")
+	}
+	m.value = value
+	return nil
+}
+
+func (m *BytesValue) ClearValue() {
+	if m != nil {
+		m.value = nil
+	}
+}
+
+func (m *BytesValue) Clear() {
+	if m != nil {
+		m.ClearValue()
+	}
+}
+
+func (m *DoubleValue) Size() (n int) {
+	var l int
+	_ = l
+	if math.Float64bits(m.value) != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *FloatValue) Size() (n int) {
+	var l int
+	_ = l
+	if math.Float32bits(m.value) != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Int64Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.value != 0 {
+		n += 1 + sovWrappers(uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *UInt64Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.value != 0 {
+		n += 1 + sovWrappers(uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *Int32Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.value != 0 {
+		n += 1 + sovWrappers(uint64(uint32(m.value)))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *UInt32Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.value != 0 {
+		n += 1 + sovWrappers(uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *BoolValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.value {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *StringValue) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.value) > 0 {
+		l = len(m.value)
+		n += 1 + l + sovWrappers(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+func (m *BytesValue) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.value) > 0 {
+		l = len(m.value)
+		n += 1 + l + sovWrappers(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	m.xxx_sizeCached = n
+	return n
+}
+
+func sovWrappers(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozWrappers(x uint64) (n int) {
+	return sovWrappers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoubleValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DoubleValue) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *DoubleValue) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if math.Float64bits(m.value) != 0 {
+		data[i] = 0x9
+		i++
+		i = encodeFixed64Wrappers(data, i, uint64(math.Float64bits(float64(m.value))))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *FloatValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *FloatValue) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *FloatValue) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if math.Float32bits(m.value) != 0 {
+		data[i] = 0xd
+		i++
+		i = encodeFixed32Wrappers(data, i, uint32(math.Float32bits(float32(m.value))))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Int64Value) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Int64Value) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Int64Value) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.value != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintWrappers(data, i, uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *UInt64Value) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UInt64Value) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *UInt64Value) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.value != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintWrappers(data, i, uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *Int32Value) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Int32Value) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *Int32Value) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.value != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintWrappers(data, i, uint64(uint32(m.value)))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *UInt32Value) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UInt32Value) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *UInt32Value) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.value != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintWrappers(data, i, uint64(m.value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *BoolValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BoolValue) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *BoolValue) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.value {
+		data[i] = 0x8
+		i++
+		if m.value {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *StringValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StringValue) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *StringValue) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.value) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintWrappers(data, i, uint64(len(m.value)))
+		i += copy(data[i:], m.value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func (m *BytesValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalToUsingCachedSize(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BytesValue) MarshalTo(data []byte) (n int, err error) {
+	m.Size()
+	return m.MarshalToUsingCachedSize(data)
+}
+
+func (m *BytesValue) MarshalToUsingCachedSize(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.value) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintWrappers(data, i, uint64(len(m.value)))
+		i += copy(data[i:], m.value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+func encodeFixed64Wrappers(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Wrappers(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintWrappers(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DoubleValue) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			m.value = float64(math.Float64frombits(v))
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *FloatValue) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var v uint32
+			i := index + 4
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint32(data[i-4])
+			v |= uint32(data[i-3]) << 8
+			v |= uint32(data[i-2]) << 16
+			v |= uint32(data[i-1]) << 24
+			m.value = float32(math.Float32frombits(v))
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Int64Value) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *UInt64Value) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Int32Value) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *UInt32Value) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.value |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *BoolValue) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.value = bool(bool(v != 0))
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StringValue) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.value = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *BytesValue) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.value = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *DoubleValue) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *DoubleValue) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *FloatValue) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *FloatValue) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Int64Value) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Int64Value) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *UInt64Value) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *UInt64Value) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *Int32Value) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *Int32Value) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *UInt32Value) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *UInt32Value) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *BoolValue) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *BoolValue) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *StringValue) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *StringValue) CheckInitialized() error {
+	return nil
+}
+
+// IsInitialized returns true if all the required fields of m, and of the
+// messages held by m, are set.
+func (m *BytesValue) IsInitialized() bool {
+	return m.CheckInitialized() == nil
+}
+
+// CheckInitialized returns a RequiredNotSetError naming the path of the
+// first required field of m, or of the messages held by m, that is not set.
+func (m *BytesValue) CheckInitialized() error {
+	return nil
+}
+
+func (m *DoubleValue) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *DoubleValue) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *FloatValue) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *FloatValue) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Int64Value) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Int64Value) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *UInt64Value) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *UInt64Value) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *Int32Value) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *Int32Value) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *UInt32Value) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *UInt32Value) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *BoolValue) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *BoolValue) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *StringValue) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *StringValue) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *BytesValue) MarshalJSON() ([]byte, error) {
+	return jsonpb.Marshal(m)
+}
+
+func (m *BytesValue) UnmarshalJSON(data []byte) error {
+	return jsonpb.Unmarshal(data, m)
+}
+
+func (m *DoubleValue) MarshalTextFields(w *proto.TextWriter) {
+	if math.Float64bits(m.value) != 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *DoubleValue) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadFloat64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *FloatValue) MarshalTextFields(w *proto.TextWriter) {
+	if math.Float32bits(m.value) != 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *FloatValue) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadFloat32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Int64Value) MarshalTextFields(w *proto.TextWriter) {
+	if m.value != 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Int64Value) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadInt64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *UInt64Value) MarshalTextFields(w *proto.TextWriter) {
+	if m.value != 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *UInt64Value) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadUint64()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *Int32Value) MarshalTextFields(w *proto.TextWriter) {
+	if m.value != 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *Int32Value) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadInt32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *UInt32Value) MarshalTextFields(w *proto.TextWriter) {
+	if m.value != 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *UInt32Value) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadUint32()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *BoolValue) MarshalTextFields(w *proto.TextWriter) {
+	if m.value {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *BoolValue) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadBool()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *StringValue) MarshalTextFields(w *proto.TextWriter) {
+	if len(m.value) > 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *StringValue) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadString()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *BytesValue) MarshalTextFields(w *proto.TextWriter) {
+	if len(m.value) > 0 {
+		w.Field("value")
+		w.Value(m.value)
+	}
+	w.Unknown(m.XXX_unrecognized)
+}
+
+func (m *BytesValue) UnmarshalTextField(p *proto.TextParser, name string) (bool, error) {
+	switch name {
+	case "value":
+		v, err := p.ReadBytes()
+		if err != nil {
+			return true, err
+		}
+		return true, m.SetValue(v)
+	}
+	return false, nil
+}
+
+func (m *DoubleValue) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &DoubleValue{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *DoubleValue) MergeFrom(src proto.Message) {
+	s, ok := src.(*DoubleValue)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if math.Float64bits(s.value) != 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *DoubleValue) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*DoubleValue)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if math.Float64bits(m.value) != math.Float64bits(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *FloatValue) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &FloatValue{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *FloatValue) MergeFrom(src proto.Message) {
+	s, ok := src.(*FloatValue)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if math.Float32bits(s.value) != 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *FloatValue) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*FloatValue)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if math.Float32bits(m.value) != math.Float32bits(o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Int64Value) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Int64Value{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Int64Value) MergeFrom(src proto.Message) {
+	s, ok := src.(*Int64Value)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.value != 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Int64Value) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Int64Value)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *UInt64Value) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &UInt64Value{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *UInt64Value) MergeFrom(src proto.Message) {
+	s, ok := src.(*UInt64Value)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.value != 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *UInt64Value) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*UInt64Value)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *Int32Value) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &Int32Value{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *Int32Value) MergeFrom(src proto.Message) {
+	s, ok := src.(*Int32Value)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.value != 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *Int32Value) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*Int32Value)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *UInt32Value) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &UInt32Value{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *UInt32Value) MergeFrom(src proto.Message) {
+	s, ok := src.(*UInt32Value)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.value != 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *UInt32Value) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*UInt32Value)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *BoolValue) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &BoolValue{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *BoolValue) MergeFrom(src proto.Message) {
+	s, ok := src.(*BoolValue)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if s.value {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *BoolValue) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*BoolValue)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *StringValue) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &StringValue{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *StringValue) MergeFrom(src proto.Message) {
+	s, ok := src.(*StringValue)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if len(s.value) > 0 {
+		m.SetValue(s.value)
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *StringValue) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*StringValue)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.value != o.value {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+func (m *BytesValue) Clone() proto.Message {
+	if m == nil {
+		return m
+	}
+	c := &BytesValue{}
+	c.MergeFrom(m)
+	return c
+}
+
+func (m *BytesValue) MergeFrom(src proto.Message) {
+	s, ok := src.(*BytesValue)
+	if !ok {
+		panic("proto: type mismatch")
+	}
+	if s == nil {
+		return
+	}
+	if len(s.value) > 0 {
+		m.SetValue(append([]byte{}, s.value...))
+	}
+	m.XXX_unrecognized = append(m.XXX_unrecognized, s.XXX_unrecognized...)
+}
+
+func (m *BytesValue) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+	o, ok := that.(*BytesValue)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if !bytes.Equal(m.value, o.value) {
+		return false
+	}
+	if !bytes.Equal(m.XXX_unrecognized, o.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+var reflectionDoubleValue = &proto.MessageInfo{
+	Name: "google.protobuf.DoubleValue",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.DoubleKind,
+			Label:   proto.OptionalLabel,
+			Default: (*DoubleValue)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*DoubleValue).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*DoubleValue)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*DoubleValue)
+				return math.Float64bits(x.value) != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*DoubleValue).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *DoubleValue) ProtoReflect() *proto.MessageInfo {
+	return reflectionDoubleValue
+}
+
+var reflectionFloatValue = &proto.MessageInfo{
+	Name: "google.protobuf.FloatValue",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.FloatKind,
+			Label:   proto.OptionalLabel,
+			Default: (*FloatValue)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*FloatValue).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(float32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*FloatValue)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*FloatValue)
+				return math.Float32bits(x.value) != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*FloatValue).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *FloatValue) ProtoReflect() *proto.MessageInfo {
+	return reflectionFloatValue
+}
+
+var reflectionInt64Value = &proto.MessageInfo{
+	Name: "google.protobuf.Int64Value",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.Int64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Int64Value)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Int64Value).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Int64Value)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Int64Value)
+				return x.value != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Int64Value).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Int64Value) ProtoReflect() *proto.MessageInfo {
+	return reflectionInt64Value
+}
+
+var reflectionUInt64Value = &proto.MessageInfo{
+	Name: "google.protobuf.UInt64Value",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.Uint64Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*UInt64Value)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*UInt64Value).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint64)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*UInt64Value)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*UInt64Value)
+				return x.value != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*UInt64Value).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *UInt64Value) ProtoReflect() *proto.MessageInfo {
+	return reflectionUInt64Value
+}
+
+var reflectionInt32Value = &proto.MessageInfo{
+	Name: "google.protobuf.Int32Value",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.Int32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*Int32Value)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*Int32Value).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(int32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*Int32Value)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*Int32Value)
+				return x.value != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*Int32Value).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *Int32Value) ProtoReflect() *proto.MessageInfo {
+	return reflectionInt32Value
+}
+
+var reflectionUInt32Value = &proto.MessageInfo{
+	Name: "google.protobuf.UInt32Value",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.Uint32Kind,
+			Label:   proto.OptionalLabel,
+			Default: (*UInt32Value)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*UInt32Value).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(uint32)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*UInt32Value)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*UInt32Value)
+				return x.value != 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*UInt32Value).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *UInt32Value) ProtoReflect() *proto.MessageInfo {
+	return reflectionUInt32Value
+}
+
+var reflectionBoolValue = &proto.MessageInfo{
+	Name: "google.protobuf.BoolValue",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.BoolKind,
+			Label:   proto.OptionalLabel,
+			Default: (*BoolValue)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*BoolValue).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(bool)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*BoolValue)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*BoolValue)
+				return x.value
+			},
+			Clear: func(m proto.Message) {
+				m.(*BoolValue).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *BoolValue) ProtoReflect() *proto.MessageInfo {
+	return reflectionBoolValue
+}
+
+var reflectionStringValue = &proto.MessageInfo{
+	Name: "google.protobuf.StringValue",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.StringKind,
+			Label:   proto.OptionalLabel,
+			Default: (*StringValue)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*StringValue).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.(string)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*StringValue)
+				return x.SetValue(value)
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*StringValue)
+				return len(x.value) > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*StringValue).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *StringValue) ProtoReflect() *proto.MessageInfo {
+	return reflectionStringValue
+}
+
+var reflectionBytesValue = &proto.MessageInfo{
+	Name: "google.protobuf.BytesValue",
+	Fields: []*proto.FieldInfo{
+		{
+			Number:  1,
+			Name:    "value",
+			Kind:    proto.BytesKind,
+			Label:   proto.OptionalLabel,
+			Default: (*BytesValue)(nil).GetValue(),
+			Get: func(m proto.Message) interface{} {
+				return m.(*BytesValue).GetValue()
+			},
+			Set: func(m proto.Message, v interface{}) error {
+				value, ok := v.([]byte)
+				if !ok {
+					return proto.NewFieldTypeError(m, "value", v)
+				}
+				x := m.(*BytesValue)
+				return x.SetValue(append([]byte{}, value...))
+			},
+			Has: func(m proto.Message) bool {
+				x := m.(*BytesValue)
+				return len(x.value) > 0
+			},
+			Clear: func(m proto.Message) {
+				m.(*BytesValue).ClearValue()
+			},
+		},
+	},
+}
+
+func (m *BytesValue) ProtoReflect() *proto.MessageInfo {
+	return reflectionBytesValue
+}
+
+func init() {
+	proto.RegisterType((*DoubleValue)(nil), "google.protobuf.DoubleValue")
+	proto.RegisterType((*FloatValue)(nil), "google.protobuf.FloatValue")
+	proto.RegisterType((*Int64Value)(nil), "google.protobuf.Int64Value")
+	proto.RegisterType((*UInt64Value)(nil), "google.protobuf.UInt64Value")
+	proto.RegisterType((*Int32Value)(nil), "google.protobuf.Int32Value")
+	proto.RegisterType((*UInt32Value)(nil), "google.protobuf.UInt32Value")
+	proto.RegisterType((*BoolValue)(nil), "google.protobuf.BoolValue")
+	proto.RegisterType((*StringValue)(nil), "google.protobuf.StringValue")
+	proto.RegisterType((*BytesValue)(nil), "google.protobuf.BytesValue")
+}